@@ -507,7 +507,7 @@ func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 
-//*
+// *
 // StartEventId defines the beginning of the event to fetch. The first event is exclusive.
 // EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
 type GetWorkflowExecutionRawHistoryV2Request struct {
@@ -1061,6 +1061,7 @@ type AddSearchAttributesRequest struct {
 	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
+	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *AddSearchAttributesRequest) Reset()      { *m = AddSearchAttributesRequest{} }
@@ -1116,6 +1117,13 @@ func (m *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
 	return false
 }
 
+func (m *AddSearchAttributesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type AddSearchAttributesResponse struct {
 }
 
@@ -1154,6 +1162,7 @@ var xxx_messageInfo_AddSearchAttributesResponse proto.InternalMessageInfo
 type RemoveSearchAttributesRequest struct {
 	SearchAttributes []string `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	IndexName        string   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace        string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *RemoveSearchAttributesRequest) Reset()      { *m = RemoveSearchAttributesRequest{} }
@@ -1202,6 +1211,13 @@ func (m *RemoveSearchAttributesRequest) GetIndexName() string {
 	return ""
 }
 
+func (m *RemoveSearchAttributesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type RemoveSearchAttributesResponse struct {
 }
 
@@ -1239,6 +1255,7 @@ var xxx_messageInfo_RemoveSearchAttributesResponse proto.InternalMessageInfo
 
 type GetSearchAttributesRequest struct {
 	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *GetSearchAttributesRequest) Reset()      { *m = GetSearchAttributesRequest{} }
@@ -1280,6 +1297,13 @@ func (m *GetSearchAttributesRequest) GetIndexName() string {
 	return ""
 }
 
+func (m *GetSearchAttributesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type GetSearchAttributesResponse struct {
 	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
@@ -1526,9 +1550,10 @@ func (m *GetDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesResponse struct {
-	Type             v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks []*v15.ReplicationTask  `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	NextPageToken    []byte                  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Type             v13.DeadLetterQueueType   `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks []*v15.ReplicationTask    `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	NextPageToken    []byte                    `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryTasks     []*v11.HistoryDLQTaskInfo `protobuf:"bytes,4,rep,name=history_tasks,json=historyTasks,proto3" json:"history_tasks,omitempty"`
 }
 
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
@@ -1584,6 +1609,13 @@ func (m *GetDLQMessagesResponse) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *GetDLQMessagesResponse) GetHistoryTasks() []*v11.HistoryDLQTaskInfo {
+	if m != nil {
+		return m.HistoryTasks
+	}
+	return nil
+}
+
 type PurgeDLQMessagesRequest struct {
 	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -2032,574 +2064,1156 @@ func (m *ResendReplicationTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResendReplicationTasksResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v15.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
-	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
-	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
-	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
-	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
-	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
-	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
-	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
-	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
-	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+type DescribeShardQueuesRequest struct {
+	ShardId       int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+func (m *DescribeShardQueuesRequest) Reset()      { *m = DescribeShardQueuesRequest{} }
+func (*DescribeShardQueuesRequest) ProtoMessage() {}
+func (*DescribeShardQueuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{36}
 }
-
-var fileDescriptor_cc07c1a2abe7cb51 = []byte{
-	// 2051 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcb, 0x6f, 0x1b, 0xd7,
-	0xd5, 0xd7, 0x90, 0xd6, 0x83, 0x47, 0x12, 0x65, 0x4e, 0x2c, 0x8b, 0xa1, 0x2c, 0x5a, 0xa6, 0xf3,
-	0xd9, 0x8a, 0xbf, 0x60, 0x54, 0x2b, 0x45, 0xe2, 0x3a, 0x28, 0x0a, 0x4b, 0x56, 0x15, 0x01, 0x56,
-	0xe0, 0x8c, 0x1c, 0xb9, 0x28, 0x50, 0x4c, 0x2f, 0x39, 0x47, 0xd4, 0x40, 0x9c, 0x47, 0xe7, 0x5e,
-	0xd2, 0xa6, 0x81, 0x3e, 0xd0, 0x07, 0xd0, 0xa5, 0xd7, 0xf9, 0x0b, 0xba, 0x29, 0xba, 0xeb, 0xbe,
-	0xbb, 0x2c, 0x8d, 0xae, 0x82, 0xb6, 0x40, 0x6a, 0x79, 0xd3, 0xee, 0xb2, 0xea, 0xba, 0xb8, 0xaf,
-	0xe1, 0x90, 0x1c, 0xd2, 0x52, 0x6d, 0x67, 0x91, 0x1d, 0xe7, 0xbc, 0xee, 0x39, 0xbf, 0x73, 0xee,
-	0xb9, 0xe7, 0x5e, 0xc2, 0x6d, 0x86, 0x7e, 0x14, 0xc6, 0xa4, 0xb5, 0x4e, 0x31, 0xee, 0x60, 0xbc,
-	0x4e, 0x22, 0x6f, 0x9d, 0xb8, 0xbe, 0x17, 0xf0, 0x6f, 0xaf, 0x81, 0xeb, 0x9d, 0x9b, 0xeb, 0x31,
-	0xfe, 0xac, 0x8d, 0x94, 0x39, 0x31, 0xd2, 0x28, 0x0c, 0x28, 0x5a, 0x51, 0x1c, 0xb2, 0xd0, 0xbc,
-	0xaa, 0x75, 0x2d, 0xa9, 0x6b, 0x91, 0xc8, 0xb3, 0xd2, 0xba, 0x56, 0xe7, 0x66, 0xe5, 0x72, 0x33,
-	0x0c, 0x9b, 0x2d, 0x5c, 0x17, 0x2a, 0xf5, 0xf6, 0xe1, 0x3a, 0xf3, 0x7c, 0xa4, 0x8c, 0xf8, 0x91,
-	0xb4, 0x52, 0xb9, 0xe2, 0x62, 0x84, 0x81, 0x8b, 0x41, 0xc3, 0x43, 0xba, 0xde, 0x0c, 0x9b, 0xa1,
-	0xa0, 0x8b, 0x5f, 0x4a, 0xa4, 0x96, 0x38, 0xc9, 0xbd, 0xc3, 0xa0, 0xed, 0x53, 0xee, 0x56, 0x23,
-	0xf4, 0xfd, 0x30, 0x50, 0x32, 0xef, 0xf4, 0xc9, 0x48, 0x16, 0x17, 0xf2, 0x91, 0x52, 0xd2, 0x54,
-	0x2e, 0x57, 0xae, 0xf5, 0x49, 0x3d, 0x0a, 0xe3, 0xe3, 0xc3, 0x56, 0xf8, 0x68, 0x58, 0xee, 0xbd,
-	0x2c, 0x58, 0x1a, 0xad, 0x36, 0x65, 0x18, 0x0f, 0x4b, 0xbf, 0x9b, 0x25, 0x9d, 0xed, 0xe6, 0xf5,
-	0xb1, 0xa2, 0x8c, 0xd0, 0x63, 0x25, 0x68, 0x65, 0x09, 0x06, 0xc4, 0x47, 0x1a, 0x11, 0x99, 0x95,
-	0x53, 0x78, 0x7c, 0xe4, 0x51, 0x16, 0xc6, 0xdd, 0x61, 0xe9, 0xef, 0x64, 0x49, 0xc7, 0x18, 0xb5,
-	0xbc, 0x06, 0x61, 0x5e, 0x16, 0x72, 0x3f, 0xc8, 0xd2, 0x88, 0x30, 0xa6, 0x1e, 0x65, 0x18, 0x48,
-	0x8f, 0x34, 0x9e, 0x8e, 0xdf, 0x66, 0xa4, 0xde, 0x42, 0x87, 0x32, 0xc2, 0x94, 0x81, 0xda, 0x6f,
-	0x0d, 0x58, 0xbe, 0x8b, 0xb4, 0x11, 0x7b, 0x75, 0xdc, 0x93, 0xfc, 0x7d, 0xce, 0xb6, 0x65, 0x71,
-	0x99, 0x97, 0xa0, 0x90, 0x84, 0x57, 0x36, 0x56, 0x8d, 0xb5, 0x82, 0xdd, 0x23, 0x98, 0x3b, 0x50,
-	0xc0, 0xc7, 0xd8, 0x68, 0x73, 0xe7, 0xca, 0xb9, 0x55, 0x63, 0x6d, 0x76, 0xe3, 0xdd, 0x04, 0x22,
-	0x51, 0x78, 0x0a, 0xe6, 0xce, 0x4d, 0xeb, 0xa1, 0x72, 0x63, 0x5b, 0x2b, 0xd8, 0x3d, 0xdd, 0xda,
-	0x9f, 0x73, 0x70, 0x29, 0xdb, 0x0d, 0x59, 0xdb, 0xe6, 0xdb, 0x30, 0x43, 0x8f, 0x48, 0xec, 0x3a,
-	0x9e, 0xab, 0xdc, 0x98, 0x16, 0xdf, 0xbb, 0xae, 0x79, 0x05, 0xe6, 0x14, 0xa2, 0x0e, 0x71, 0xdd,
-	0x58, 0xf8, 0x51, 0xb0, 0x67, 0x15, 0xed, 0x8e, 0xeb, 0xc6, 0xe6, 0x11, 0xbc, 0xd5, 0x20, 0x8d,
-	0x23, 0xec, 0x87, 0xa0, 0x9c, 0x17, 0x1e, 0xdf, 0xb2, 0xb2, 0x76, 0x4c, 0x0a, 0xc4, 0xb4, 0xf7,
-	0x7d, 0xce, 0x95, 0x84, 0xd1, 0x34, 0xc9, 0x0c, 0xe0, 0xa2, 0x4b, 0x18, 0xa9, 0x13, 0x3a, 0xb8,
-	0xd8, 0xb9, 0x57, 0x5c, 0xec, 0x82, 0xb6, 0x9b, 0xa6, 0xd6, 0xfe, 0x6a, 0x40, 0x45, 0x03, 0xf7,
-	0xb1, 0x8c, 0xf8, 0xe3, 0x90, 0x32, 0x9d, 0x3e, 0x8e, 0x4d, 0x48, 0x99, 0x00, 0x06, 0x29, 0x55,
-	0xd0, 0xcd, 0x72, 0xda, 0x1d, 0x49, 0xea, 0x43, 0x96, 0x43, 0x37, 0xd9, 0x43, 0xb6, 0x2f, 0xf9,
-	0xf9, 0xc1, 0xe4, 0xff, 0x08, 0xcc, 0xa4, 0xb4, 0x7a, 0x55, 0x70, 0xee, 0xac, 0x55, 0x50, 0x7a,
-	0x34, 0x48, 0xaa, 0x3d, 0xcd, 0xf5, 0x8a, 0xb2, 0x2f, 0x28, 0x55, 0x0c, 0x57, 0x61, 0x5e, 0xb8,
-	0x48, 0x9d, 0xa0, 0xed, 0xd7, 0x31, 0x16, 0x61, 0x4d, 0xda, 0x73, 0x92, 0xf8, 0x89, 0xa0, 0x99,
-	0xcb, 0x50, 0xd0, 0x71, 0xd1, 0x72, 0x6e, 0x35, 0xbf, 0x36, 0x69, 0xcf, 0xa8, 0xc0, 0xa8, 0xf9,
-	0x13, 0x58, 0x48, 0x02, 0x71, 0x44, 0x16, 0x55, 0x31, 0x7c, 0x37, 0x33, 0x3f, 0x89, 0x2c, 0x0f,
-	0xe1, 0x13, 0xfd, 0xb1, 0xc5, 0xf5, 0x76, 0x83, 0xc3, 0xd0, 0x2e, 0x06, 0x7d, 0x34, 0xf3, 0x03,
-	0x58, 0x92, 0x6b, 0x37, 0xc2, 0x80, 0xc5, 0x61, 0xab, 0x85, 0xb1, 0xa8, 0x82, 0x36, 0x15, 0xf8,
-	0x14, 0xec, 0x45, 0xc1, 0xde, 0x4a, 0xb8, 0xfb, 0x82, 0x69, 0x96, 0x61, 0x5a, 0x67, 0x6a, 0x52,
-	0x16, 0xb9, 0xfa, 0xac, 0x59, 0x50, 0xda, 0x6a, 0x85, 0x14, 0xf7, 0xb9, 0x9e, 0xce, 0xee, 0xe0,
-	0xa6, 0xe8, 0xa5, 0xae, 0x76, 0x01, 0xcc, 0xb4, 0xbc, 0x04, 0xae, 0xf6, 0x37, 0x03, 0x4a, 0x36,
-	0xfa, 0x61, 0x07, 0x1f, 0x10, 0x7a, 0xfc, 0x72, 0x33, 0xe6, 0x0f, 0x61, 0xa6, 0x41, 0x18, 0x36,
-	0xc3, 0xb8, 0x2b, 0x8a, 0xa3, 0xb8, 0x71, 0x23, 0x13, 0x20, 0xd1, 0x2b, 0x39, 0x38, 0xdc, 0xee,
-	0x96, 0xd2, 0xb0, 0x13, 0x5d, 0x73, 0x09, 0xa6, 0x79, 0x17, 0xe5, 0x2b, 0x70, 0x9c, 0xf3, 0xf6,
-	0x14, 0xff, 0xdc, 0x75, 0xcd, 0x5d, 0x58, 0xe8, 0x78, 0xd4, 0xab, 0x7b, 0x2d, 0x8f, 0x75, 0x1d,
-	0x7e, 0x0a, 0xa9, 0x0a, 0xaa, 0x58, 0xf2, 0x88, 0xb2, 0xf4, 0x11, 0x65, 0x3d, 0xd0, 0x47, 0xd4,
-	0xe6, 0xb9, 0xa7, 0x5f, 0x5d, 0x36, 0xec, 0x62, 0x4f, 0x91, 0xb3, 0x78, 0xc8, 0xe9, 0xd8, 0x54,
-	0xc8, 0xbf, 0xcf, 0xc3, 0xf5, 0x1d, 0x64, 0xc3, 0x75, 0x47, 0x1e, 0xa9, 0xd2, 0x3a, 0xd8, 0xf8,
-	0x66, 0x9b, 0x9d, 0xf9, 0x0e, 0x14, 0x29, 0x23, 0x31, 0x73, 0xb0, 0x83, 0x01, 0xeb, 0x61, 0x32,
-	0x27, 0xa8, 0xdb, 0x9c, 0xb8, 0xeb, 0x9a, 0x16, 0xbc, 0x95, 0x96, 0xea, 0xf0, 0x16, 0xa1, 0xf6,
-	0x57, 0xde, 0x2e, 0xf5, 0x44, 0x0f, 0x24, 0xc3, 0x5c, 0x85, 0x39, 0x0c, 0xdc, 0x9e, 0xcd, 0x49,
-	0x21, 0x08, 0x18, 0xb8, 0xda, 0xe2, 0x0d, 0x28, 0xf5, 0x24, 0xb4, 0xbd, 0x29, 0x21, 0xb6, 0xa0,
-	0xc5, 0xb4, 0xb5, 0x1b, 0x50, 0xf2, 0xc9, 0x63, 0xcf, 0x6f, 0xfb, 0x4e, 0x44, 0x9a, 0xe8, 0x50,
-	0xef, 0x09, 0x96, 0xa7, 0x45, 0x71, 0x2c, 0x28, 0xc6, 0x7d, 0xd2, 0xc4, 0x7d, 0xef, 0x09, 0x9a,
-	0xd7, 0x60, 0x21, 0xc0, 0xc7, 0x4c, 0x0a, 0xb2, 0xf0, 0x18, 0x83, 0xf2, 0xcc, 0xaa, 0xb1, 0x36,
-	0x67, 0xcf, 0x73, 0x32, 0x17, 0x7b, 0xc0, 0x89, 0xb5, 0xff, 0x18, 0xb0, 0xf6, 0xf2, 0x54, 0xa8,
-	0x3d, 0x9e, 0x61, 0xd4, 0xc8, 0x30, 0xca, 0x0b, 0x48, 0x77, 0xff, 0x3a, 0x61, 0x8d, 0x23, 0x94,
-	0x9b, 0x7d, 0x76, 0x63, 0x75, 0x54, 0x6e, 0xee, 0x12, 0x46, 0x36, 0x5b, 0x61, 0xdd, 0x2e, 0x2a,
-	0xc5, 0x4d, 0xa9, 0x67, 0x3e, 0x84, 0x05, 0x85, 0x8a, 0xa3, 0x38, 0xaa, 0x29, 0x58, 0x99, 0x35,
-	0xaf, 0x64, 0xb8, 0x49, 0x85, 0x9a, 0x8a, 0xc2, 0x2e, 0x76, 0xfa, 0xbe, 0x6b, 0x4f, 0x0d, 0x58,
-	0xd9, 0x41, 0x66, 0xf7, 0x4e, 0xf2, 0x3d, 0x79, 0x8a, 0x53, 0x5d, 0x79, 0xf7, 0x60, 0x4a, 0xc4,
-	0xc8, 0x3b, 0x74, 0x7e, 0x64, 0x1b, 0x4a, 0x8d, 0x02, 0x7c, 0xd5, 0x94, 0x3d, 0x81, 0x85, 0xad,
-	0x6c, 0xf0, 0xae, 0xaf, 0xa6, 0x22, 0x87, 0x97, 0xaf, 0x3e, 0x11, 0x15, 0x8d, 0xf7, 0xaf, 0xda,
-	0xe7, 0x39, 0xa8, 0x8e, 0x72, 0x49, 0x65, 0xe0, 0xe7, 0x50, 0x94, 0x6d, 0x41, 0x8d, 0x1c, 0xda,
-	0xb7, 0x03, 0xeb, 0x14, 0x13, 0xa6, 0x35, 0xde, 0xb8, 0x25, 0xfa, 0x92, 0xa6, 0x6e, 0x07, 0x2c,
-	0xee, 0xda, 0xb2, 0xa7, 0x6b, 0x5a, 0xa5, 0x0b, 0xe6, 0xb0, 0x90, 0x79, 0x1e, 0xf2, 0xc7, 0xd8,
-	0x55, 0x6d, 0x8a, 0xff, 0x34, 0xf7, 0x60, 0xb2, 0x43, 0x5a, 0x6d, 0x54, 0x5b, 0xf2, 0xc3, 0x33,
-	0x22, 0x97, 0x78, 0x26, 0xad, 0xdc, 0xce, 0xdd, 0x32, 0x6a, 0x7f, 0x31, 0xe0, 0xda, 0x0e, 0xb2,
-	0xa4, 0xd1, 0x8f, 0x49, 0xdc, 0xf7, 0xe0, 0xed, 0x16, 0x11, 0x43, 0x38, 0x8b, 0x3d, 0xec, 0x60,
-	0x82, 0x96, 0x6e, 0xa6, 0x79, 0xfb, 0x22, 0x17, 0xb0, 0x35, 0x5f, 0x19, 0xd8, 0x75, 0x13, 0xd5,
-	0x28, 0x0e, 0x1b, 0x48, 0x69, 0xbf, 0x6a, 0xae, 0xa7, 0x7a, 0x5f, 0xf3, 0x7b, 0xaa, 0x83, 0x09,
-	0xce, 0x0f, 0x27, 0xf8, 0x17, 0xa2, 0xed, 0x8d, 0x0f, 0x41, 0x25, 0x7a, 0x1f, 0x66, 0x52, 0x29,
-	0x7e, 0x25, 0x10, 0x13, 0x43, 0xb5, 0x27, 0xb0, 0xba, 0x83, 0xec, 0xee, 0xbd, 0x4f, 0xc7, 0x80,
-	0x77, 0x00, 0x20, 0x4f, 0x85, 0xe0, 0x30, 0xd4, 0xd5, 0x75, 0xd6, 0xa5, 0x79, 0xb3, 0x17, 0x67,
-	0x70, 0x81, 0xa9, 0x5f, 0xb4, 0xf6, 0x3b, 0x03, 0xae, 0x8c, 0x59, 0x5c, 0x85, 0xfd, 0x53, 0x28,
-	0xa5, 0xcc, 0x3a, 0x5c, 0x5d, 0x3b, 0xf1, 0xfe, 0xff, 0xe0, 0x84, 0x7d, 0x3e, 0xee, 0x27, 0xd0,
-	0xda, 0x17, 0x06, 0x5c, 0xb0, 0x91, 0x44, 0x51, 0xab, 0x2b, 0x9a, 0x2b, 0x3d, 0xdd, 0x41, 0x93,
-	0x3d, 0x58, 0xe5, 0x5e, 0x7d, 0xb0, 0x32, 0x6f, 0xc1, 0x94, 0xe8, 0xfe, 0x54, 0x35, 0xb6, 0x97,
-	0xf7, 0x48, 0x25, 0x5f, 0x5b, 0x82, 0xc5, 0x81, 0x48, 0xd4, 0xf9, 0xfa, 0x8f, 0x1c, 0x54, 0xee,
-	0xb8, 0xee, 0x3e, 0x92, 0xb8, 0x71, 0x74, 0x87, 0xb1, 0xd8, 0xab, 0xb7, 0x59, 0x2f, 0xc5, 0xbf,
-	0x36, 0xa0, 0x44, 0x05, 0xcf, 0x21, 0x09, 0x53, 0xa1, 0xfc, 0xd9, 0xa9, 0x1a, 0xc9, 0x68, 0xe3,
-	0xd6, 0x20, 0x5d, 0xf6, 0x91, 0xf3, 0x74, 0x80, 0x6c, 0xae, 0x00, 0x78, 0x81, 0x8b, 0x8f, 0xd3,
-	0xdd, 0xb0, 0x20, 0x28, 0x7c, 0x7f, 0x98, 0xef, 0x81, 0x49, 0x8f, 0xbd, 0xc8, 0xa1, 0x8d, 0x23,
-	0xf4, 0x89, 0xd3, 0x8e, 0x5c, 0x7d, 0x39, 0x98, 0xb1, 0xcf, 0x73, 0xce, 0xbe, 0x60, 0x7c, 0x26,
-	0xe8, 0x95, 0x16, 0x2c, 0x66, 0xae, 0x9b, 0x6e, 0x4d, 0x05, 0xd9, 0x9a, 0xbe, 0x9f, 0x6e, 0x4d,
-	0xc5, 0x8d, 0xeb, 0xfd, 0x68, 0x27, 0x33, 0xd3, 0x2e, 0xf7, 0x04, 0xdd, 0x03, 0x2e, 0xfa, 0xa0,
-	0x1b, 0x61, 0xba, 0x15, 0xad, 0xc0, 0x72, 0x26, 0x00, 0x0a, 0xfd, 0x63, 0x58, 0x91, 0x33, 0xcf,
-	0x28, 0xfc, 0xff, 0x7f, 0x14, 0xfc, 0x85, 0x33, 0xe3, 0x54, 0x5b, 0x85, 0xea, 0xa8, 0xc5, 0x94,
-	0x3b, 0x1f, 0x41, 0x65, 0x07, 0xd9, 0x28, 0x5f, 0xfa, 0xcd, 0x1b, 0x83, 0xe6, 0x3f, 0x9f, 0x82,
-	0xe5, 0x4c, 0x6d, 0xb5, 0x5f, 0x7f, 0x63, 0x40, 0xa9, 0xd1, 0xa6, 0x2c, 0xf4, 0x87, 0x4b, 0xe9,
-	0xd4, 0x67, 0xd2, 0x28, 0xeb, 0xd6, 0x96, 0xb0, 0x3c, 0x54, 0x4b, 0x8d, 0x01, 0xb2, 0xf0, 0x82,
-	0x76, 0x29, 0xc3, 0x3e, 0x2f, 0x72, 0xaf, 0xc9, 0x8b, 0x7d, 0x61, 0x79, 0xb8, 0xa2, 0x07, 0xc8,
-	0x66, 0x13, 0xa6, 0x7d, 0x12, 0x45, 0x5e, 0xd0, 0x2c, 0xe7, 0xc5, 0xd2, 0x7b, 0xaf, 0xbc, 0xf4,
-	0x9e, 0xb4, 0x27, 0x57, 0xd4, 0xd6, 0xcd, 0x00, 0x96, 0x89, 0xeb, 0x3a, 0xc3, 0xfd, 0x48, 0x34,
-	0x6d, 0x35, 0xab, 0xaf, 0xf7, 0x17, 0xb6, 0x16, 0xce, 0x6c, 0x4b, 0xa2, 0x57, 0x97, 0x89, 0xeb,
-	0x66, 0x72, 0xf8, 0xee, 0xca, 0xcc, 0xc4, 0x1b, 0xd9, 0x5d, 0x62, 0x2f, 0x67, 0x21, 0xfe, 0x66,
-	0x56, 0xbb, 0x0d, 0x73, 0x69, 0x90, 0x33, 0x16, 0xb9, 0x90, 0x5e, 0xa4, 0x90, 0xee, 0x03, 0x65,
-	0xb8, 0xa8, 0x6f, 0xc4, 0x5b, 0xf2, 0x94, 0x57, 0xbb, 0xaa, 0xf6, 0x55, 0x0e, 0x96, 0x86, 0x58,
-	0x6a, 0xcb, 0xfc, 0x12, 0x4a, 0xb4, 0x1d, 0x45, 0x61, 0xcc, 0xd0, 0x75, 0x1a, 0x2d, 0x4f, 0xb4,
-	0x7e, 0xb9, 0x63, 0xec, 0x53, 0x15, 0xcc, 0x08, 0xc3, 0xd6, 0xbe, 0xb6, 0xba, 0x25, 0x8d, 0xea,
-	0x3a, 0x1d, 0x20, 0x9b, 0xff, 0x07, 0x45, 0x69, 0x3d, 0xb9, 0x6f, 0xc8, 0xc8, 0xe6, 0x25, 0x55,
-	0xdf, 0x36, 0x1e, 0xc2, 0x82, 0x8f, 0xfc, 0xd6, 0x4e, 0x8f, 0xbc, 0x48, 0x56, 0xd6, 0xb8, 0xc9,
-	0x5b, 0xcd, 0x39, 0xdc, 0xc1, 0xbd, 0x44, 0x4d, 0x5e, 0xc4, 0xfd, 0xbe, 0xef, 0xca, 0x16, 0x2c,
-	0x66, 0xba, 0x7a, 0x26, 0xec, 0xff, 0x98, 0x83, 0x45, 0x39, 0x4e, 0x0c, 0x0e, 0x30, 0xdb, 0x70,
-	0x8e, 0x75, 0x23, 0xd9, 0xcb, 0x8a, 0x1b, 0x37, 0xc7, 0x5f, 0x8d, 0xef, 0x22, 0x71, 0xef, 0x21,
-	0x63, 0x18, 0x7f, 0xda, 0x46, 0x55, 0x1d, 0x42, 0x7d, 0xdc, 0x13, 0x0c, 0x07, 0x30, 0x6c, 0xc7,
-	0x0d, 0x74, 0x54, 0xd0, 0x6a, 0xd6, 0x9b, 0x97, 0x54, 0x95, 0x17, 0xf3, 0x43, 0x28, 0x7b, 0x01,
-	0x97, 0xf0, 0x3a, 0xe8, 0xf0, 0x4b, 0x5e, 0x6a, 0x94, 0x94, 0x37, 0xc6, 0xc5, 0x84, 0xbf, 0x1d,
-	0xa4, 0x26, 0xc9, 0xcc, 0x7b, 0xde, 0xe4, 0xa9, 0xef, 0x79, 0x53, 0x59, 0xf7, 0xbc, 0x7f, 0x1b,
-	0x70, 0x71, 0x10, 0x2f, 0x55, 0x90, 0xaf, 0x09, 0xb0, 0xcc, 0xd1, 0x2d, 0xf7, 0x1a, 0x47, 0xb7,
-	0xac, 0x58, 0xf3, 0x59, 0xb1, 0xfe, 0xdd, 0x80, 0xa5, 0xfb, 0xed, 0xb8, 0x89, 0xdf, 0xc6, 0xea,
-	0xa8, 0x55, 0xa0, 0x3c, 0x1c, 0x9c, 0x3a, 0xeb, 0xff, 0x94, 0x83, 0xa5, 0x3d, 0xfc, 0x96, 0x46,
-	0xfe, 0x46, 0xf6, 0xc5, 0x26, 0x94, 0x87, 0x01, 0x3b, 0xdb, 0x73, 0x87, 0x78, 0xaf, 0xb7, 0xf1,
-	0x30, 0x46, 0x7a, 0xa4, 0x0f, 0x50, 0x51, 0xb0, 0xdf, 0xf0, 0x7b, 0x7d, 0x15, 0x2e, 0x65, 0x7b,
-	0xd1, 0x2b, 0x8e, 0x15, 0x1b, 0x29, 0x06, 0xee, 0xc0, 0x56, 0xa3, 0xa9, 0x97, 0xe9, 0xde, 0x0b,
-	0x6c, 0xf2, 0xa8, 0x3f, 0x9b, 0xd0, 0x76, 0x5d, 0xf3, 0x32, 0xcc, 0x26, 0x73, 0x87, 0xaa, 0x80,
-	0x82, 0x0d, 0x9a, 0xb4, 0xeb, 0x9a, 0x8b, 0x30, 0x15, 0xb7, 0x03, 0xfd, 0x80, 0x56, 0xb0, 0x27,
-	0xe3, 0x76, 0x20, 0x6b, 0x23, 0x46, 0x3f, 0x64, 0xbd, 0xda, 0x90, 0x8f, 0xae, 0xf3, 0x92, 0xaa,
-	0x6b, 0x63, 0xf8, 0x19, 0x6e, 0x32, 0xe3, 0x19, 0xee, 0x2a, 0xcc, 0x4b, 0xa9, 0xfe, 0x07, 0x33,
-	0x29, 0x34, 0xea, 0xed, 0x6d, 0x7a, 0xe8, 0xed, 0xed, 0x32, 0xcc, 0x72, 0x09, 0x6d, 0x64, 0x26,
-	0x11, 0x50, 0x26, 0xe4, 0x70, 0x9d, 0x0d, 0x98, 0xc4, 0x74, 0xb3, 0xf5, 0xec, 0x79, 0x75, 0xe2,
-	0xcb, 0xe7, 0xd5, 0x89, 0xaf, 0x9f, 0x57, 0x8d, 0x5f, 0x9d, 0x54, 0x8d, 0x3f, 0x9c, 0x54, 0x8d,
-	0x2f, 0x4e, 0xaa, 0xc6, 0xb3, 0x93, 0xaa, 0xf1, 0xcf, 0x93, 0xaa, 0xf1, 0xaf, 0x93, 0xea, 0xc4,
-	0xd7, 0x27, 0x55, 0xe3, 0xe9, 0x8b, 0xea, 0xc4, 0xb3, 0x17, 0xd5, 0x89, 0x2f, 0x5f, 0x54, 0x27,
-	0x7e, 0xfc, 0x41, 0x33, 0xec, 0x65, 0xd8, 0x0b, 0xc7, 0xfc, 0xa1, 0xf8, 0x51, 0xfa, 0xbb, 0x3e,
-	0x25, 0xde, 0x5d, 0xdf, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x00, 0x94, 0x1d, 0x8b,
-	0x1c, 0x00, 0x00,
+func (m *DescribeShardQueuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*DescribeMutableStateRequest)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DescribeShardQueuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeShardQueuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
+}
+func (m *DescribeShardQueuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeShardQueuesRequest.Merge(m, src)
+}
+func (m *DescribeShardQueuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeShardQueuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeShardQueuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeShardQueuesRequest proto.InternalMessageInfo
+
+func (m *DescribeShardQueuesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return 0
+}
+
+func (m *DescribeShardQueuesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return true
+	return 0
 }
-func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeShardQueuesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeMutableStateResponse)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DescribeShardQueuesResponse struct {
+	ShardId       int32             `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Queues        []*v14.QueueState `protobuf:"bytes,2,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte            `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *DescribeShardQueuesResponse) Reset()      { *m = DescribeShardQueuesResponse{} }
+func (*DescribeShardQueuesResponse) ProtoMessage() {}
+func (*DescribeShardQueuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{37}
+}
+func (m *DescribeShardQueuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeShardQueuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeShardQueuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.HistoryAddr != that1.HistoryAddr {
-		return false
-	}
-	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
-		return false
+}
+func (m *DescribeShardQueuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeShardQueuesResponse.Merge(m, src)
+}
+func (m *DescribeShardQueuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeShardQueuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeShardQueuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeShardQueuesResponse proto.InternalMessageInfo
+
+func (m *DescribeShardQueuesResponse) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
-		return false
+	return 0
+}
+
+func (m *DescribeShardQueuesResponse) GetQueues() []*v14.QueueState {
+	if m != nil {
+		return m.Queues
 	}
-	return true
+	return nil
 }
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeShardQueuesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeHistoryHostRequest)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetDynamicConfigRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *GetDynamicConfigRequest) Reset()      { *m = GetDynamicConfigRequest{} }
+func (*GetDynamicConfigRequest) ProtoMessage() {}
+func (*GetDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{38}
+}
+func (m *GetDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.HostAddress != that1.HostAddress {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	return true
 }
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDynamicConfigRequest.Merge(m, src)
+}
+func (m *GetDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *GetDynamicConfigRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
+	return ""
+}
 
-	that1, ok := that.(*DescribeHistoryHostResponse)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetDynamicConfigResponse struct {
+	Values []*v11.DynamicConfigValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *GetDynamicConfigResponse) Reset()      { *m = GetDynamicConfigResponse{} }
+func (*GetDynamicConfigResponse) ProtoMessage() {}
+func (*GetDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{39}
+}
+func (m *GetDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardsNumber != that1.ShardsNumber {
-		return false
-	}
-	if len(this.ShardIds) != len(that1.ShardIds) {
-		return false
-	}
-	for i := range this.ShardIds {
-		if this.ShardIds[i] != that1.ShardIds[i] {
-			return false
-		}
-	}
-	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
-		return false
-	}
-	if this.ShardControllerStatus != that1.ShardControllerStatus {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	return true
 }
-func (this *CloseShardRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDynamicConfigResponse.Merge(m, src)
+}
+func (m *GetDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDynamicConfigResponse proto.InternalMessageInfo
+
+func (m *GetDynamicConfigResponse) GetValues() []*v11.DynamicConfigValue {
+	if m != nil {
+		return m.Values
 	}
+	return nil
+}
 
-	that1, ok := that.(*CloseShardRequest)
-	if !ok {
-		that2, ok := that.(CloseShardRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetDynamicConfigRequest struct {
+	Value    *v11.DynamicConfigValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Identity string                  `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason   string                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SetDynamicConfigRequest) Reset()      { *m = SetDynamicConfigRequest{} }
+func (*SetDynamicConfigRequest) ProtoMessage() {}
+func (*SetDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{40}
+}
+func (m *SetDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SetDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicConfigRequest.Merge(m, src)
+}
+func (m *SetDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *SetDynamicConfigRequest) GetValue() *v11.DynamicConfigValue {
+	if m != nil {
+		return m.Value
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return nil
+}
+
+func (m *SetDynamicConfigRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *CloseShardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetDynamicConfigRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*CloseShardResponse)
-	if !ok {
-		that2, ok := that.(CloseShardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetDynamicConfigResponse struct {
+}
+
+func (m *SetDynamicConfigResponse) Reset()      { *m = SetDynamicConfigResponse{} }
+func (*SetDynamicConfigResponse) ProtoMessage() {}
+func (*SetDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{41}
+}
+func (m *SetDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SetDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicConfigResponse.Merge(m, src)
+}
+func (m *SetDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicConfigResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RemoveTaskRequest)
-	if !ok {
-		that2, ok := that.(RemoveTaskRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_SetDynamicConfigResponse proto.InternalMessageInfo
+
+type DeleteDynamicConfigRequest struct {
+	Key         string                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *v11.DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	Identity    string                        `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason      string                        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *DeleteDynamicConfigRequest) Reset()      { *m = DeleteDynamicConfigRequest{} }
+func (*DeleteDynamicConfigRequest) ProtoMessage() {}
+func (*DeleteDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{42}
+}
+func (m *DeleteDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DeleteDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDynamicConfigRequest.Merge(m, src)
+}
+func (m *DeleteDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *DeleteDynamicConfigRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return ""
+}
+
+func (m *DeleteDynamicConfigRequest) GetConstraints() *v11.DynamicConfigConstraints {
+	if m != nil {
+		return m.Constraints
 	}
-	if this.Category != that1.Category {
-		return false
+	return nil
+}
+
+func (m *DeleteDynamicConfigRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if this.TaskId != that1.TaskId {
-		return false
+	return ""
+}
+
+func (m *DeleteDynamicConfigRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if that1.VisibilityTime == nil {
-		if this.VisibilityTime != nil {
-			return false
+	return ""
+}
+
+type DeleteDynamicConfigResponse struct {
+}
+
+func (m *DeleteDynamicConfigResponse) Reset()      { *m = DeleteDynamicConfigResponse{} }
+func (*DeleteDynamicConfigResponse) ProtoMessage() {}
+func (*DeleteDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{43}
+}
+func (m *DeleteDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDynamicConfigResponse.Merge(m, src)
+}
+func (m *DeleteDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDynamicConfigResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RemoveTaskResponse)
-	if !ok {
-		that2, ok := that.(RemoveTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeleteDynamicConfigResponse proto.InternalMessageInfo
+
+type ListDynamicConfigRequest struct {
+}
+
+func (m *ListDynamicConfigRequest) Reset()      { *m = ListDynamicConfigRequest{} }
+func (*ListDynamicConfigRequest) ProtoMessage() {}
+func (*ListDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{44}
+}
+func (m *ListDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigRequest.Merge(m, src)
+}
+func (m *ListDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ListDynamicConfigRequest proto.InternalMessageInfo
+
+type ListDynamicConfigResponse struct {
+	Values []*v11.DynamicConfigValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *ListDynamicConfigResponse) Reset()      { *m = ListDynamicConfigResponse{} }
+func (*ListDynamicConfigResponse) ProtoMessage() {}
+func (*ListDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{45}
+}
+func (m *ListDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ListDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigResponse.Merge(m, src)
+}
+func (m *ListDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDynamicConfigResponse proto.InternalMessageInfo
+
+func (m *ListDynamicConfigResponse) GetValues() []*v11.DynamicConfigValue {
+	if m != nil {
+		return m.Values
 	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if this.StartEventId != that1.StartEventId {
-		return false
+	return nil
+}
+
+type ListDynamicConfigHistoryRequest struct {
+	// Only return the changes of this key if specified.
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListDynamicConfigHistoryRequest) Reset()      { *m = ListDynamicConfigHistoryRequest{} }
+func (*ListDynamicConfigHistoryRequest) ProtoMessage() {}
+func (*ListDynamicConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{46}
+}
+func (m *ListDynamicConfigHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.StartEventVersion != that1.StartEventVersion {
-		return false
+}
+func (m *ListDynamicConfigHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigHistoryRequest.Merge(m, src)
+}
+func (m *ListDynamicConfigHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDynamicConfigHistoryRequest proto.InternalMessageInfo
+
+func (m *ListDynamicConfigHistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.EndEventId != that1.EndEventId {
-		return false
+	return ""
+}
+
+func (m *ListDynamicConfigHistoryRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	if this.EndEventVersion != that1.EndEventVersion {
-		return false
+	return 0
+}
+
+func (m *ListDynamicConfigHistoryRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
+	return nil
+}
+
+type ListDynamicConfigHistoryResponse struct {
+	Changes       []*v11.DynamicConfigChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken []byte                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListDynamicConfigHistoryResponse) Reset()      { *m = ListDynamicConfigHistoryResponse{} }
+func (*ListDynamicConfigHistoryResponse) ProtoMessage() {}
+func (*ListDynamicConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{47}
+}
+func (m *ListDynamicConfigHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+}
+func (m *ListDynamicConfigHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigHistoryResponse.Merge(m, src)
+}
+func (m *ListDynamicConfigHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDynamicConfigHistoryResponse proto.InternalMessageInfo
+
+func (m *ListDynamicConfigHistoryResponse) GetChanges() []*v11.DynamicConfigChange {
+	if m != nil {
+		return m.Changes
 	}
-	return true
+	return nil
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListDynamicConfigHistoryResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeleteNamespaceRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Identity  string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *DeleteNamespaceRequest) Reset()      { *m = DeleteNamespaceRequest{} }
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{48}
+}
+func (m *DeleteNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DeleteNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNamespaceRequest.Merge(m, src)
+}
+func (m *DeleteNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNamespaceRequest proto.InternalMessageInfo
+
+func (m *DeleteNamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	return ""
+}
+
+func (m *DeleteNamespaceRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
-		return false
+	return ""
+}
+
+func (m *DeleteNamespaceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	for i := range this.HistoryBatches {
-		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
-			return false
+	return ""
+}
+
+type DeleteNamespaceResponse struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Id of the system workflow running the deletion in temporal-system namespace.
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId      string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (m *DeleteNamespaceResponse) Reset()      { *m = DeleteNamespaceResponse{} }
+func (*DeleteNamespaceResponse) ProtoMessage() {}
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
+}
+func (m *DeleteNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.VersionHistory.Equal(that1.VersionHistory) {
-		return false
-	}
-	return true
 }
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNamespaceResponse.Merge(m, src)
+}
+func (m *DeleteNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNamespaceResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_DeleteNamespaceResponse proto.InternalMessageInfo
+
+func (m *DeleteNamespaceResponse) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DeleteNamespaceResponse) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	if len(this.Tokens) != len(that1.Tokens) {
-		return false
+	return ""
+}
+
+func (m *DeleteNamespaceResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
 	}
-	for i := range this.Tokens {
-		if !this.Tokens[i].Equal(that1.Tokens[i]) {
-			return false
+	return ""
+}
+
+type RenameNamespaceRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewName   string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// How long the previous name keeps resolving to the namespace. Server default is used if not set.
+	AliasTtl *time.Duration `protobuf:"bytes,3,opt,name=alias_ttl,json=aliasTtl,proto3,stdduration" json:"alias_ttl,omitempty"`
+	Identity string         `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason   string         `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RenameNamespaceRequest) Reset()      { *m = RenameNamespaceRequest{} }
+func (*RenameNamespaceRequest) ProtoMessage() {}
+func (*RenameNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
+}
+func (m *RenameNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ClusterName != that1.ClusterName {
-		return false
-	}
-	return true
 }
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RenameNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameNamespaceRequest.Merge(m, src)
+}
+func (m *RenameNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameNamespaceRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_RenameNamespaceRequest proto.InternalMessageInfo
+
+func (m *RenameNamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *RenameNamespaceRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
 	}
-	if len(this.ShardMessages) != len(that1.ShardMessages) {
-		return false
+	return ""
+}
+
+func (m *RenameNamespaceRequest) GetAliasTtl() *time.Duration {
+	if m != nil {
+		return m.AliasTtl
 	}
-	for i := range this.ShardMessages {
-		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *RenameNamespaceRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RenameNamespaceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
+type RenameNamespaceResponse struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+}
+
+func (m *RenameNamespaceResponse) Reset()      { *m = RenameNamespaceResponse{} }
+func (*RenameNamespaceResponse) ProtoMessage() {}
+func (*RenameNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
+}
+func (m *RenameNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameNamespaceResponse.Merge(m, src)
+}
+func (m *RenameNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameNamespaceResponse proto.InternalMessageInfo
+
+func (m *RenameNamespaceResponse) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+type ReclaimSearchAttributeFieldsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	IndexName string `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) Reset()      { *m = ReclaimSearchAttributeFieldsRequest{} }
+func (*ReclaimSearchAttributeFieldsRequest) ProtoMessage() {}
+func (*ReclaimSearchAttributeFieldsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
+}
+func (m *ReclaimSearchAttributeFieldsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimSearchAttributeFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimSearchAttributeFieldsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReclaimSearchAttributeFieldsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimSearchAttributeFieldsRequest.Merge(m, src)
+}
+func (m *ReclaimSearchAttributeFieldsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimSearchAttributeFieldsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimSearchAttributeFieldsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimSearchAttributeFieldsRequest proto.InternalMessageInfo
+
+func (m *ReclaimSearchAttributeFieldsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+type ReclaimSearchAttributeFieldsResponse struct {
+}
+
+func (m *ReclaimSearchAttributeFieldsResponse) Reset()      { *m = ReclaimSearchAttributeFieldsResponse{} }
+func (*ReclaimSearchAttributeFieldsResponse) ProtoMessage() {}
+func (*ReclaimSearchAttributeFieldsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
+}
+func (m *ReclaimSearchAttributeFieldsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimSearchAttributeFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimSearchAttributeFieldsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReclaimSearchAttributeFieldsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimSearchAttributeFieldsResponse.Merge(m, src)
+}
+func (m *ReclaimSearchAttributeFieldsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimSearchAttributeFieldsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimSearchAttributeFieldsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimSearchAttributeFieldsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v15.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
+	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
+	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
+	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
+	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
+	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
+	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
+	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
+	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+	proto.RegisterType((*DescribeShardQueuesRequest)(nil), "temporal.server.api.adminservice.v1.DescribeShardQueuesRequest")
+	proto.RegisterType((*DescribeShardQueuesResponse)(nil), "temporal.server.api.adminservice.v1.DescribeShardQueuesResponse")
+	proto.RegisterType((*GetDynamicConfigRequest)(nil), "temporal.server.api.adminservice.v1.GetDynamicConfigRequest")
+	proto.RegisterType((*GetDynamicConfigResponse)(nil), "temporal.server.api.adminservice.v1.GetDynamicConfigResponse")
+	proto.RegisterType((*SetDynamicConfigRequest)(nil), "temporal.server.api.adminservice.v1.SetDynamicConfigRequest")
+	proto.RegisterType((*SetDynamicConfigResponse)(nil), "temporal.server.api.adminservice.v1.SetDynamicConfigResponse")
+	proto.RegisterType((*DeleteDynamicConfigRequest)(nil), "temporal.server.api.adminservice.v1.DeleteDynamicConfigRequest")
+	proto.RegisterType((*DeleteDynamicConfigResponse)(nil), "temporal.server.api.adminservice.v1.DeleteDynamicConfigResponse")
+	proto.RegisterType((*ListDynamicConfigRequest)(nil), "temporal.server.api.adminservice.v1.ListDynamicConfigRequest")
+	proto.RegisterType((*ListDynamicConfigResponse)(nil), "temporal.server.api.adminservice.v1.ListDynamicConfigResponse")
+	proto.RegisterType((*ListDynamicConfigHistoryRequest)(nil), "temporal.server.api.adminservice.v1.ListDynamicConfigHistoryRequest")
+	proto.RegisterType((*ListDynamicConfigHistoryResponse)(nil), "temporal.server.api.adminservice.v1.ListDynamicConfigHistoryResponse")
+	proto.RegisterType((*DeleteNamespaceRequest)(nil), "temporal.server.api.adminservice.v1.DeleteNamespaceRequest")
+	proto.RegisterType((*DeleteNamespaceResponse)(nil), "temporal.server.api.adminservice.v1.DeleteNamespaceResponse")
+	proto.RegisterType((*RenameNamespaceRequest)(nil), "temporal.server.api.adminservice.v1.RenameNamespaceRequest")
+	proto.RegisterType((*RenameNamespaceResponse)(nil), "temporal.server.api.adminservice.v1.RenameNamespaceResponse")
+	proto.RegisterType((*ReclaimSearchAttributeFieldsRequest)(nil), "temporal.server.api.adminservice.v1.ReclaimSearchAttributeFieldsRequest")
+	proto.RegisterType((*ReclaimSearchAttributeFieldsResponse)(nil), "temporal.server.api.adminservice.v1.ReclaimSearchAttributeFieldsResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+}
+
+var fileDescriptor_cc07c1a2abe7cb51 = []byte{
+	// 2513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x49, 0x6c, 0x1b, 0xc9,
+	0xb5, 0x6a, 0x52, 0x1b, 0x9f, 0x36, 0xab, 0xc7, 0x92, 0x68, 0x6a, 0x44, 0x6b, 0xda, 0xfe, 0xb6,
+	0xc7, 0x33, 0xa0, 0xbe, 0xe5, 0xc0, 0x76, 0x1c, 0x07, 0x81, 0x25, 0x79, 0x34, 0x02, 0x24, 0xc3,
+	0x6e, 0x79, 0xec, 0x2c, 0x48, 0x98, 0x62, 0x77, 0x89, 0xea, 0x51, 0x6f, 0xd3, 0x55, 0xa4, 0x4c,
+	0x23, 0x1b, 0xb2, 0x00, 0xc9, 0xcd, 0xc7, 0x60, 0x82, 0xdc, 0x72, 0xc8, 0x25, 0xc8, 0x2d, 0xf7,
+	0x24, 0x40, 0x30, 0x47, 0x23, 0xa7, 0x41, 0x72, 0x98, 0x58, 0xbe, 0xe4, 0x38, 0xa7, 0x1c, 0x72,
+	0x0a, 0x6a, 0x6b, 0x76, 0x93, 0x4d, 0x9a, 0xf2, 0x32, 0x87, 0xb9, 0xb1, 0x5f, 0xbd, 0xf7, 0xea,
+	0xed, 0xf5, 0xea, 0x15, 0xe1, 0x3a, 0xc5, 0x5e, 0x18, 0x44, 0xc8, 0x5d, 0x21, 0x38, 0x6a, 0xe2,
+	0x68, 0x05, 0x85, 0xce, 0x0a, 0xb2, 0x3d, 0xc7, 0x67, 0xdf, 0x8e, 0x85, 0x57, 0x9a, 0x97, 0x56,
+	0x22, 0xfc, 0x51, 0x03, 0x13, 0x5a, 0x8d, 0x30, 0x09, 0x03, 0x9f, 0xe0, 0x4a, 0x18, 0x05, 0x34,
+	0xd0, 0xcf, 0x28, 0xda, 0x8a, 0xa0, 0xad, 0xa0, 0xd0, 0xa9, 0x24, 0x69, 0x2b, 0xcd, 0x4b, 0xa5,
+	0x72, 0x3d, 0x08, 0xea, 0x2e, 0x5e, 0xe1, 0x24, 0xb5, 0xc6, 0xde, 0x8a, 0xdd, 0x88, 0x10, 0x75,
+	0x02, 0x5f, 0x30, 0x29, 0x9d, 0xee, 0x5c, 0xa7, 0x8e, 0x87, 0x09, 0x45, 0x5e, 0x28, 0x11, 0xde,
+	0xb2, 0x71, 0x88, 0x7d, 0x1b, 0xfb, 0x96, 0x83, 0xc9, 0x4a, 0x3d, 0xa8, 0x07, 0x1c, 0xce, 0x7f,
+	0x49, 0x14, 0x23, 0x56, 0x82, 0x49, 0x8f, 0xfd, 0x86, 0x47, 0x98, 0xd8, 0x56, 0xe0, 0x79, 0xf1,
+	0x3e, 0x67, 0x53, 0x38, 0x62, 0x89, 0x21, 0x79, 0x98, 0x10, 0x54, 0x97, 0x2a, 0x95, 0xce, 0xa5,
+	0xb0, 0x0e, 0x83, 0xe8, 0x60, 0xcf, 0x0d, 0x0e, 0xbb, 0xf1, 0xde, 0xcd, 0x32, 0x9b, 0xe5, 0x36,
+	0x08, 0xc5, 0x51, 0x37, 0xf6, 0xdb, 0x59, 0xd8, 0xd9, 0x62, 0x9e, 0xef, 0x8b, 0x4a, 0x11, 0x39,
+	0x90, 0x88, 0x95, 0x2c, 0x44, 0x1f, 0x79, 0x98, 0x84, 0xc8, 0xc2, 0xdd, 0x32, 0x64, 0x4a, 0xbc,
+	0xef, 0x10, 0x1a, 0x44, 0xad, 0x6e, 0xec, 0xff, 0xcf, 0xc2, 0x8e, 0x70, 0xe8, 0x3a, 0x16, 0x77,
+	0x5e, 0x37, 0xc5, 0xd5, 0x2c, 0x8a, 0x10, 0x47, 0xc4, 0x21, 0x14, 0xfb, 0x42, 0x22, 0xbb, 0xe5,
+	0x23, 0xcf, 0xb1, 0xaa, 0x56, 0xe0, 0xef, 0x39, 0x75, 0x49, 0x78, 0x79, 0x00, 0x42, 0xfc, 0x10,
+	0x5b, 0x0d, 0xb6, 0x2f, 0x91, 0x44, 0xdf, 0x18, 0x80, 0x48, 0x79, 0xaf, 0xea, 0x35, 0x28, 0xaa,
+	0xb9, 0xb8, 0x4a, 0x28, 0xa2, 0x52, 0x5c, 0xe3, 0xe7, 0x1a, 0x2c, 0x6e, 0x60, 0x62, 0x45, 0x4e,
+	0x0d, 0xef, 0x88, 0xf5, 0x5d, 0xb6, 0x6c, 0x8a, 0x50, 0xd7, 0xdf, 0x84, 0x42, 0x6c, 0xcc, 0xa2,
+	0xb6, 0xac, 0x5d, 0x28, 0x98, 0x6d, 0x80, 0xbe, 0x09, 0x85, 0x58, 0xa4, 0x62, 0x6e, 0x59, 0xbb,
+	0x30, 0xb1, 0xfa, 0x76, 0xec, 0x10, 0x9e, 0x06, 0xd2, 0xa9, 0xcd, 0x4b, 0x95, 0x07, 0x52, 0x8c,
+	0x5b, 0x8a, 0xc0, 0x6c, 0xd3, 0x1a, 0x7f, 0xca, 0xc1, 0x9b, 0xd9, 0x62, 0x88, 0x4c, 0xd3, 0x4f,
+	0xc1, 0x38, 0xd9, 0x47, 0x91, 0x5d, 0x75, 0x6c, 0x29, 0xc6, 0x18, 0xff, 0xde, 0xb2, 0xf5, 0xb7,
+	0x60, 0x52, 0xfa, 0xaf, 0x8a, 0x6c, 0x3b, 0xe2, 0x72, 0x14, 0xcc, 0x09, 0x09, 0xbb, 0x69, 0xdb,
+	0x91, 0xbe, 0x0f, 0x6f, 0x58, 0xc8, 0xda, 0xc7, 0x69, 0x13, 0x14, 0xf3, 0x5c, 0xe2, 0x6b, 0x95,
+	0xac, 0xfc, 0x4d, 0x18, 0x31, 0x29, 0x7d, 0x4a, 0xb8, 0x59, 0xce, 0x34, 0x09, 0xd2, 0x7d, 0x98,
+	0xb7, 0x11, 0x45, 0x35, 0x44, 0x3a, 0x37, 0x1b, 0x7e, 0xc9, 0xcd, 0x4e, 0x2a, 0xbe, 0x49, 0xa8,
+	0xf1, 0x77, 0x0d, 0x4a, 0xca, 0x70, 0xef, 0x0b, 0x8d, 0xdf, 0x0f, 0x08, 0x55, 0xee, 0x63, 0xb6,
+	0x09, 0x08, 0xe5, 0x86, 0xc1, 0x84, 0x48, 0xd3, 0x4d, 0x30, 0xd8, 0x4d, 0x01, 0x4a, 0x59, 0x96,
+	0x99, 0x6e, 0xa4, 0x6d, 0xd9, 0x94, 0xf3, 0xf3, 0x9d, 0xce, 0xff, 0x26, 0xe8, 0x71, 0x68, 0xb5,
+	0xa3, 0x60, 0xf8, 0xb8, 0x51, 0x30, 0x7b, 0xd8, 0x09, 0x32, 0x1e, 0xe7, 0x60, 0x31, 0x53, 0x29,
+	0x19, 0x0c, 0x67, 0x60, 0x8a, 0x8b, 0x48, 0xaa, 0x7e, 0xc3, 0xab, 0xe1, 0x88, 0xab, 0x35, 0x62,
+	0x4e, 0x0a, 0xe0, 0x6d, 0x0e, 0xd3, 0x17, 0xa1, 0xa0, 0xf4, 0x22, 0xc5, 0xdc, 0x72, 0xfe, 0xc2,
+	0x88, 0x39, 0x2e, 0x15, 0x23, 0xfa, 0x77, 0x61, 0x26, 0x56, 0xa4, 0xca, 0xbd, 0x28, 0x83, 0xe1,
+	0x2b, 0x99, 0xfe, 0x89, 0x71, 0x99, 0x0a, 0xb7, 0xd5, 0xc7, 0x3a, 0xa3, 0xdb, 0xf2, 0xf7, 0x02,
+	0x73, 0xda, 0x4f, 0xc1, 0xf4, 0x2b, 0xb0, 0x20, 0xf6, 0xb6, 0x02, 0x9f, 0x46, 0x81, 0xeb, 0xe2,
+	0x88, 0x47, 0x41, 0x83, 0x70, 0xfb, 0x14, 0xcc, 0x39, 0xbe, 0xbc, 0x1e, 0xaf, 0xee, 0xf2, 0x45,
+	0xbd, 0x08, 0x63, 0xca, 0x53, 0x23, 0x22, 0xc8, 0xe5, 0xa7, 0x51, 0x81, 0xd9, 0x75, 0x37, 0x20,
+	0x78, 0x97, 0xd1, 0x29, 0xef, 0x76, 0x26, 0x45, 0xdb, 0x75, 0xc6, 0x49, 0xd0, 0x93, 0xf8, 0xc2,
+	0x70, 0xc6, 0x3f, 0x34, 0x98, 0x35, 0xb1, 0x17, 0x34, 0xf1, 0x3d, 0x44, 0x0e, 0x9e, 0xcf, 0x46,
+	0x7f, 0x0f, 0xc6, 0x2d, 0x44, 0x71, 0x3d, 0x88, 0x5a, 0x3c, 0x38, 0xa6, 0x57, 0x2f, 0x66, 0x1a,
+	0x88, 0x57, 0x66, 0x66, 0x1c, 0xc6, 0x77, 0x5d, 0x52, 0x98, 0x31, 0xad, 0xbe, 0x00, 0x63, 0xac,
+	0x66, 0xb3, 0x1d, 0x98, 0x9d, 0xf3, 0xe6, 0x28, 0xfb, 0xdc, 0xb2, 0xf5, 0x2d, 0x98, 0x69, 0x3a,
+	0xc4, 0xa9, 0x39, 0xae, 0x43, 0x5b, 0x55, 0x76, 0xe6, 0xc9, 0x08, 0x2a, 0x55, 0xc4, 0x81, 0x58,
+	0x51, 0x07, 0x62, 0xe5, 0x9e, 0x3a, 0x10, 0xd7, 0x86, 0x1f, 0x7f, 0x76, 0x5a, 0x33, 0xa7, 0xdb,
+	0x84, 0x6c, 0x89, 0xa9, 0x9c, 0xd4, 0x4d, 0xaa, 0xfc, 0xcb, 0x3c, 0x9c, 0xdf, 0xc4, 0xb4, 0x3b,
+	0xee, 0xd0, 0xa1, 0x0c, 0xad, 0xfb, 0xab, 0x5f, 0x6c, 0xb1, 0xd3, 0xcf, 0xc2, 0x34, 0xa1, 0x28,
+	0xa2, 0x55, 0xdc, 0xc4, 0x3e, 0x6d, 0xdb, 0x64, 0x92, 0x43, 0x6f, 0x31, 0xe0, 0x96, 0xad, 0x57,
+	0xe0, 0x8d, 0x24, 0x56, 0x13, 0x47, 0x44, 0xe5, 0x57, 0xde, 0x9c, 0x6d, 0xa3, 0xde, 0x17, 0x0b,
+	0xfa, 0x32, 0x4c, 0x62, 0xdf, 0x6e, 0xf3, 0x1c, 0xe1, 0x88, 0x80, 0x7d, 0x5b, 0x71, 0xbc, 0x08,
+	0xb3, 0x6d, 0x0c, 0xc5, 0x6f, 0x94, 0xa3, 0xcd, 0x28, 0x34, 0xc5, 0xed, 0x22, 0xcc, 0x7a, 0xe8,
+	0xa1, 0xe3, 0x35, 0xbc, 0x6a, 0x88, 0xea, 0xb8, 0x4a, 0x9c, 0x47, 0xb8, 0x38, 0xc6, 0x83, 0x63,
+	0x46, 0x2e, 0xdc, 0x41, 0x75, 0xbc, 0xeb, 0x3c, 0xc2, 0xfa, 0x39, 0x98, 0xf1, 0xf1, 0x43, 0x2a,
+	0x10, 0x69, 0x70, 0x80, 0xfd, 0xe2, 0xf8, 0xb2, 0x76, 0x61, 0xd2, 0x9c, 0x62, 0x60, 0x86, 0x76,
+	0x8f, 0x01, 0x8d, 0xff, 0x68, 0x70, 0xe1, 0xf9, 0xae, 0x90, 0x39, 0x9e, 0xc1, 0x54, 0xcb, 0x60,
+	0xca, 0x02, 0x48, 0x55, 0xff, 0x1a, 0xa2, 0xd6, 0x3e, 0x16, 0xc9, 0x3e, 0xb1, 0xba, 0xdc, 0xcb,
+	0x37, 0x1b, 0x88, 0xa2, 0x35, 0x37, 0xa8, 0x99, 0xd3, 0x92, 0x70, 0x4d, 0xd0, 0xe9, 0x0f, 0x60,
+	0x46, 0x5a, 0xa5, 0x2a, 0x57, 0x64, 0x51, 0xa8, 0x64, 0xc6, 0xbc, 0xc4, 0x61, 0x2c, 0xa5, 0xd5,
+	0xa4, 0x16, 0xe6, 0x74, 0x33, 0xf5, 0x6d, 0x3c, 0xd6, 0x60, 0x69, 0x13, 0x53, 0xb3, 0xdd, 0x37,
+	0xec, 0x88, 0x9e, 0x81, 0xa8, 0xc8, 0xdb, 0x86, 0x51, 0xae, 0x23, 0xab, 0xd0, 0xf9, 0x9e, 0x65,
+	0x28, 0xd1, 0x78, 0xb0, 0x5d, 0x13, 0xfc, 0xb8, 0x2d, 0x4c, 0xc9, 0x83, 0x55, 0x7d, 0xd9, 0x83,
+	0x55, 0x59, 0xf8, 0xaa, 0x13, 0x51, 0xc2, 0x58, 0xfd, 0x32, 0x3e, 0xce, 0x41, 0xb9, 0x97, 0x48,
+	0xd2, 0x03, 0x3f, 0x84, 0x69, 0x51, 0x16, 0x64, 0x83, 0xa3, 0x64, 0xbb, 0x5f, 0x19, 0xa0, 0xdf,
+	0xad, 0xf4, 0x67, 0x5e, 0xe1, 0x75, 0x49, 0x41, 0x6f, 0xf9, 0x34, 0x6a, 0x99, 0x53, 0x24, 0x09,
+	0x2b, 0xb5, 0x40, 0xef, 0x46, 0xd2, 0x4f, 0x40, 0xfe, 0x00, 0xb7, 0x64, 0x99, 0x62, 0x3f, 0xf5,
+	0x1d, 0x18, 0x69, 0x22, 0xb7, 0x81, 0x65, 0x4a, 0x5e, 0x3d, 0xa6, 0xe5, 0x62, 0xc9, 0x04, 0x97,
+	0xeb, 0xb9, 0x6b, 0x9a, 0xf1, 0x67, 0x0d, 0xce, 0x6d, 0x62, 0x1a, 0x17, 0xfa, 0x3e, 0x8e, 0xfb,
+	0x2a, 0x9c, 0x72, 0x11, 0xbf, 0x12, 0xd0, 0xc8, 0xc1, 0x4d, 0x1c, 0x5b, 0x4b, 0x15, 0xd3, 0xbc,
+	0x39, 0xcf, 0x10, 0x4c, 0xb5, 0x2e, 0x19, 0x6c, 0xd9, 0x31, 0x69, 0x18, 0x05, 0x16, 0x26, 0x24,
+	0x4d, 0x9a, 0x6b, 0x93, 0xde, 0x51, 0xeb, 0x6d, 0xd2, 0x4e, 0x07, 0xe7, 0xbb, 0x1d, 0xfc, 0x23,
+	0x5e, 0xf6, 0xfa, 0xab, 0x20, 0x1d, 0xbd, 0x0b, 0xe3, 0x09, 0x17, 0xbf, 0x94, 0x11, 0x63, 0x46,
+	0xc6, 0x23, 0x58, 0xde, 0xc4, 0x74, 0x63, 0xfb, 0x6e, 0x1f, 0xe3, 0xdd, 0x07, 0x10, 0xa7, 0x82,
+	0xbf, 0x17, 0xa8, 0xe8, 0x3a, 0xee, 0xd6, 0xac, 0xd8, 0xf3, 0x33, 0xb8, 0x40, 0xe5, 0x2f, 0x62,
+	0xfc, 0x42, 0x83, 0xb7, 0xfa, 0x6c, 0x2e, 0xd5, 0xfe, 0x3e, 0xcc, 0x26, 0xd8, 0x56, 0x19, 0xb9,
+	0x12, 0xe2, 0xf2, 0x0b, 0x08, 0x61, 0x9e, 0x88, 0xd2, 0x00, 0x62, 0x7c, 0xa2, 0xc1, 0x49, 0x13,
+	0xa3, 0x30, 0x74, 0x5b, 0xbc, 0xb8, 0x92, 0xc1, 0x0e, 0x9a, 0xec, 0xc6, 0x2a, 0xf7, 0xf2, 0x8d,
+	0x95, 0x7e, 0x0d, 0x46, 0x79, 0xf5, 0x27, 0xb2, 0xb0, 0x3d, 0xbf, 0x46, 0x4a, 0x7c, 0x63, 0x01,
+	0xe6, 0x3a, 0x34, 0x91, 0xe7, 0xeb, 0x7f, 0x73, 0x50, 0xba, 0x69, 0xdb, 0xbb, 0x18, 0x45, 0xd6,
+	0xfe, 0x4d, 0x4a, 0x23, 0xa7, 0xd6, 0xa0, 0x6d, 0x17, 0xff, 0x54, 0x83, 0x59, 0xc2, 0xd7, 0xaa,
+	0x28, 0x5e, 0x94, 0x56, 0xfe, 0x60, 0xa0, 0x42, 0xd2, 0x9b, 0x79, 0xa5, 0x13, 0x2e, 0xea, 0xc8,
+	0x09, 0xd2, 0x01, 0xd6, 0x97, 0x00, 0x1c, 0xdf, 0xc6, 0x0f, 0x93, 0xd5, 0xb0, 0xc0, 0x21, 0x2c,
+	0x3f, 0xf4, 0x77, 0x41, 0x27, 0x07, 0x4e, 0x58, 0x25, 0xd6, 0x3e, 0xf6, 0x50, 0xb5, 0x11, 0xda,
+	0xea, 0x72, 0x30, 0x6e, 0x9e, 0x60, 0x2b, 0xbb, 0x7c, 0xe1, 0x03, 0x0e, 0x4f, 0xfb, 0x6e, 0xb8,
+	0xc3, 0x77, 0x25, 0x17, 0xe6, 0x32, 0xa5, 0x4a, 0x16, 0xae, 0x82, 0x28, 0x5c, 0x5f, 0x4f, 0x16,
+	0xae, 0xe9, 0xd5, 0xf3, 0x69, 0x5f, 0xc4, 0x1d, 0xd5, 0x16, 0x93, 0x13, 0xdb, 0xf7, 0x19, 0xea,
+	0xbd, 0x56, 0x88, 0x93, 0x85, 0x6a, 0x09, 0x16, 0x33, 0xcd, 0x23, 0x7d, 0xf3, 0x2b, 0x0d, 0x96,
+	0x44, 0x4b, 0xd4, 0xcb, 0x3d, 0xef, 0xf4, 0xf2, 0x4e, 0xe1, 0xf8, 0x66, 0xec, 0x7b, 0x5b, 0x30,
+	0x96, 0xa1, 0xdc, 0x4b, 0x14, 0x29, 0xed, 0xb7, 0xa0, 0xb4, 0x89, 0x69, 0x2f, 0x49, 0xd3, 0x9b,
+	0x6b, 0x7d, 0x37, 0xcf, 0x75, 0x6e, 0xfe, 0xf1, 0x28, 0x2c, 0x66, 0xf2, 0x96, 0xa5, 0xe0, 0x67,
+	0x1a, 0xcc, 0x5a, 0x0d, 0x42, 0x03, 0xaf, 0x3b, 0x4a, 0x07, 0x3e, 0xee, 0x7a, 0x71, 0xaf, 0xac,
+	0x73, 0xce, 0x5d, 0x61, 0x6a, 0x75, 0x80, 0xb9, 0x14, 0xa4, 0x45, 0x28, 0x4e, 0x49, 0x91, 0x7b,
+	0x45, 0x52, 0xec, 0x72, 0xce, 0xdd, 0xc9, 0xd2, 0x01, 0xd6, 0xeb, 0x30, 0xe6, 0xa1, 0x30, 0x74,
+	0xfc, 0x7a, 0x31, 0xcf, 0xb7, 0xde, 0x79, 0xe9, 0xad, 0x77, 0x04, 0x3f, 0xb1, 0xa3, 0xe2, 0xae,
+	0xfb, 0xb0, 0x88, 0x6c, 0xbb, 0xda, 0x5d, 0xea, 0xf8, 0x79, 0x20, 0xaf, 0x01, 0x2b, 0xe9, 0xac,
+	0x50, 0xc8, 0x99, 0x15, 0x8f, 0x1f, 0x03, 0x45, 0x64, 0xdb, 0x99, 0x2b, 0x2c, 0x35, 0x33, 0x3d,
+	0xf1, 0x5a, 0x52, 0x93, 0x17, 0x82, 0x2c, 0x8b, 0xbf, 0x9e, 0xdd, 0xae, 0xc3, 0x64, 0xd2, 0xc8,
+	0x19, 0x9b, 0x9c, 0x4c, 0x6e, 0x52, 0x48, 0x16, 0x91, 0x22, 0xcc, 0xab, 0xcb, 0xf6, 0xba, 0x68,
+	0x20, 0x64, 0xce, 0x19, 0x9f, 0xe5, 0x60, 0xa1, 0x6b, 0x49, 0xa6, 0xcc, 0x8f, 0x61, 0x96, 0x34,
+	0xc2, 0x30, 0x88, 0x28, 0xb6, 0xab, 0x96, 0xeb, 0xf0, 0x53, 0x45, 0x64, 0x8c, 0x39, 0x50, 0xc0,
+	0xf4, 0x60, 0x5c, 0xd9, 0x55, 0x5c, 0xd7, 0x05, 0x53, 0x15, 0xa7, 0x1d, 0x60, 0xfd, 0xff, 0x60,
+	0x5a, 0x70, 0x8f, 0xaf, 0x32, 0x42, 0xb3, 0x29, 0x01, 0x55, 0x17, 0x99, 0x07, 0x30, 0xe3, 0x61,
+	0x36, 0x10, 0x20, 0xfb, 0x4e, 0x28, 0x22, 0xab, 0x5f, 0x53, 0x2f, 0x5b, 0x28, 0x26, 0xe0, 0x4e,
+	0x4c, 0x26, 0xee, 0xf8, 0x5e, 0xea, 0xbb, 0xb4, 0x0e, 0x73, 0x99, 0xa2, 0x1e, 0xcb, 0xf6, 0x7f,
+	0xc8, 0xc1, 0x9c, 0xe8, 0x54, 0x3a, 0x7b, 0xa3, 0x5b, 0x30, 0x4c, 0x5b, 0xa1, 0xa8, 0x74, 0xd3,
+	0xab, 0x97, 0xfa, 0xdf, 0xba, 0x37, 0x30, 0xb2, 0xb7, 0x31, 0xa5, 0x38, 0xba, 0xdb, 0xc0, 0x32,
+	0x3a, 0x38, 0x79, 0xbf, 0xe9, 0x0e, 0x33, 0x60, 0xd0, 0x88, 0xd8, 0x00, 0x44, 0x28, 0x2d, 0x8b,
+	0xf6, 0x94, 0x80, 0x4a, 0xbf, 0xe8, 0x57, 0xa1, 0xe8, 0xf8, 0x0c, 0xc3, 0x69, 0xe2, 0x2a, 0xbb,
+	0x3f, 0x26, 0xba, 0x54, 0x71, 0x19, 0x9d, 0x8b, 0xd7, 0x6f, 0xf9, 0x89, 0x26, 0x35, 0xf3, 0x0a,
+	0x39, 0x32, 0xf0, 0x15, 0x72, 0x34, 0xeb, 0x0a, 0xf9, 0xb7, 0x1c, 0xcc, 0x77, 0xda, 0x4b, 0x06,
+	0xe4, 0x2b, 0x32, 0x58, 0x66, 0x57, 0x98, 0x7b, 0x85, 0x5d, 0x61, 0x96, 0xae, 0xf9, 0xac, 0x9b,
+	0xed, 0x77, 0x60, 0x4a, 0xdd, 0x6c, 0x85, 0x14, 0xc3, 0x5c, 0x8a, 0x2b, 0x83, 0x4c, 0x10, 0xe5,
+	0xcd, 0x73, 0x63, 0xfb, 0x6e, 0xdc, 0x1f, 0xab, 0x21, 0xa9, 0x68, 0x4d, 0xff, 0xa9, 0xc1, 0xc2,
+	0x9d, 0x46, 0x54, 0xc7, 0x5f, 0xc6, 0xd0, 0x33, 0x4a, 0x50, 0xec, 0x56, 0x4e, 0xb6, 0x19, 0x7f,
+	0xcc, 0xc1, 0xc2, 0x0e, 0xfe, 0x92, 0x6a, 0xfe, 0x5a, 0x92, 0x6e, 0x0d, 0x8a, 0x3b, 0x38, 0xdb,
+	0x9a, 0x83, 0x8e, 0x69, 0xf8, 0x3b, 0x83, 0x89, 0xf7, 0x22, 0x4c, 0xf6, 0xd5, 0xe9, 0xcc, 0x03,
+	0xf1, 0x0b, 0x7e, 0x67, 0x28, 0xc3, 0x9b, 0xd9, 0x52, 0xb4, 0x83, 0x63, 0xc9, 0xc4, 0x04, 0xfb,
+	0x76, 0x47, 0x1e, 0x93, 0xc4, 0x44, 0xbd, 0x3d, 0x39, 0x8e, 0x1f, 0x23, 0x26, 0x62, 0xd8, 0x96,
+	0xad, 0x9f, 0x86, 0x89, 0xb8, 0xa9, 0x91, 0x11, 0x50, 0x30, 0x41, 0x81, 0xb6, 0x6c, 0x7d, 0x0e,
+	0x46, 0xa3, 0x86, 0xaf, 0x06, 0x7f, 0x05, 0x73, 0x24, 0x6a, 0xf8, 0x22, 0x36, 0x22, 0xec, 0x05,
+	0xb4, 0x1d, 0x1b, 0xe2, 0x7a, 0x31, 0x25, 0xa0, 0x2a, 0x36, 0xba, 0xc7, 0x87, 0x23, 0x19, 0xe3,
+	0x43, 0x36, 0x23, 0xe7, 0x58, 0xe9, 0x41, 0x9f, 0x40, 0xea, 0x35, 0x33, 0x1c, 0xeb, 0x9a, 0x19,
+	0x9e, 0x86, 0x09, 0x86, 0xa1, 0x98, 0x8c, 0xc7, 0x08, 0x92, 0x85, 0xe8, 0xeb, 0xb3, 0x0d, 0x26,
+	0x6d, 0xfa, 0x83, 0xf6, 0x0b, 0x05, 0x1f, 0xe8, 0xf0, 0x6c, 0x21, 0x03, 0x0c, 0x9f, 0x17, 0xa1,
+	0xd0, 0x8e, 0x61, 0x91, 0x47, 0xe3, 0x61, 0x9f, 0xe0, 0xcd, 0xaa, 0xa2, 0xc6, 0xef, 0x12, 0x0f,
+	0x5c, 0xa9, 0xed, 0x7b, 0x3c, 0x2c, 0x25, 0xf6, 0x5f, 0x83, 0xd1, 0x8f, 0x38, 0xb2, 0xac, 0xff,
+	0x17, 0x9f, 0x37, 0x06, 0xe4, 0xac, 0xc5, 0x6b, 0x8d, 0xa4, 0x1c, 0x58, 0xcc, 0x77, 0x60, 0x81,
+	0x9d, 0x6b, 0xe2, 0x61, 0x70, 0x9d, 0xbf, 0x0b, 0x2a, 0x0b, 0x75, 0xf5, 0x13, 0xc6, 0x87, 0x50,
+	0xec, 0x46, 0x96, 0xfa, 0xdc, 0x86, 0x51, 0xde, 0x5e, 0xa8, 0x66, 0x6c, 0xa0, 0xe3, 0x22, 0xc5,
+	0x8a, 0x37, 0x98, 0xa6, 0xe4, 0x62, 0xfc, 0x46, 0x83, 0x85, 0xdd, 0x1e, 0x92, 0x6d, 0xab, 0xbe,
+	0x46, 0x4c, 0x8d, 0x5e, 0x74, 0x2b, 0xc1, 0x44, 0x2f, 0xc1, 0xb8, 0x63, 0x63, 0x9f, 0x3a, 0xb4,
+	0x25, 0x73, 0x26, 0xfe, 0xd6, 0xe7, 0x61, 0x34, 0xc2, 0x88, 0x04, 0xbe, 0xcc, 0x18, 0xf9, 0xc5,
+	0x0a, 0xfd, 0x6e, 0x0f, 0x4b, 0x18, 0x7f, 0xe1, 0x4f, 0x63, 0x2e, 0xa6, 0x78, 0x30, 0xb3, 0xea,
+	0xdf, 0x83, 0x09, 0x2b, 0xf0, 0x09, 0x8d, 0x90, 0xc3, 0x9a, 0x59, 0x51, 0x67, 0x6e, 0x1c, 0x5b,
+	0xa9, 0xf5, 0x36, 0x0f, 0x33, 0xc9, 0x30, 0xa5, 0x60, 0xbe, 0xa7, 0x82, 0xc3, 0x29, 0x05, 0x97,
+	0x60, 0x31, 0x53, 0x07, 0xa9, 0x63, 0x09, 0x8a, 0xdb, 0x0e, 0xc9, 0xf4, 0x8e, 0x71, 0x00, 0xa7,
+	0x32, 0xd6, 0x5e, 0x53, 0x98, 0x3c, 0x84, 0xd3, 0x5d, 0x9b, 0xa9, 0x71, 0x78, 0x4f, 0x83, 0xbf,
+	0x92, 0x04, 0xff, 0xad, 0x06, 0xcb, 0xbd, 0xb7, 0x96, 0xea, 0xde, 0x85, 0x31, 0x6b, 0x1f, 0xf9,
+	0x75, 0xdc, 0x7f, 0xcc, 0xd8, 0xd7, 0xad, 0x9c, 0xde, 0x54, 0x7c, 0xb2, 0xe4, 0xcb, 0x65, 0xc9,
+	0xf7, 0x21, 0xcc, 0x0b, 0x0f, 0x26, 0x66, 0xb1, 0x83, 0x9c, 0x79, 0x2f, 0x92, 0x0e, 0x14, 0x16,
+	0xba, 0xf6, 0x92, 0x16, 0x78, 0x7d, 0xe7, 0x96, 0xf1, 0x57, 0x0d, 0xe6, 0x4d, 0xcc, 0x38, 0x1d,
+	0x53, 0xc5, 0x53, 0x30, 0xee, 0xe3, 0xc3, 0xe4, 0x38, 0x69, 0xcc, 0xc7, 0x87, 0x8c, 0x89, 0x7e,
+	0x03, 0x0a, 0xc8, 0x75, 0x10, 0xa9, 0x52, 0xea, 0xca, 0x0b, 0xdb, 0xa9, 0xae, 0x17, 0xc1, 0x0d,
+	0xf9, 0x17, 0x9a, 0xb5, 0xe1, 0x5f, 0xb3, 0x07, 0xc1, 0x71, 0x4e, 0x71, 0x8f, 0xba, 0x29, 0xdb,
+	0x0d, 0xf7, 0xb4, 0xdd, 0x48, 0xca, 0x76, 0x37, 0x60, 0xa1, 0x4b, 0x89, 0x81, 0x6d, 0x67, 0xd4,
+	0xe0, 0x8c, 0x89, 0x2d, 0x17, 0x39, 0x5e, 0xc7, 0x14, 0xe4, 0x3d, 0x07, 0xbb, 0xf6, 0x80, 0x6d,
+	0x4e, 0xff, 0x01, 0x9b, 0x71, 0x0e, 0xce, 0xf6, 0xdf, 0x43, 0x88, 0xbb, 0xe6, 0x3e, 0x79, 0x5a,
+	0x1e, 0xfa, 0xf4, 0x69, 0x79, 0xe8, 0xf3, 0xa7, 0x65, 0xed, 0x27, 0x47, 0x65, 0xed, 0xf7, 0x47,
+	0x65, 0xed, 0x93, 0xa3, 0xb2, 0xf6, 0xe4, 0xa8, 0xac, 0xfd, 0xeb, 0xa8, 0xac, 0xfd, 0xfb, 0xa8,
+	0x3c, 0xf4, 0xf9, 0x51, 0x59, 0x7b, 0xfc, 0xac, 0x3c, 0xf4, 0xe4, 0x59, 0x79, 0xe8, 0xd3, 0x67,
+	0xe5, 0xa1, 0x6f, 0x5f, 0xa9, 0x07, 0xed, 0x9c, 0x70, 0x82, 0x3e, 0xff, 0x83, 0xfa, 0x5a, 0xf2,
+	0xbb, 0x36, 0xca, 0xdd, 0x71, 0xf9, 0x7f, 0x03, 0x00, 0x0a, 0xd5, 0x98, 0x9d, 0x42, 0x25, 0x00,
+	0x00,
+}
+
+func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeMutableStateRequest)
 	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
+		that2, ok := that.(DescribeMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2611,25 +3225,22 @@ func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
-		return false
-	}
-	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if this.ClusterName != that1.ClusterName {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
+	that1, ok := that.(*DescribeMutableStateResponse)
 	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
+		that2, ok := that.(DescribeMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2641,19 +3252,28 @@ func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) boo
 	} else if this == nil {
 		return false
 	}
-	if !this.Messages.Equal(that1.Messages) {
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.HistoryAddr != that1.HistoryAddr {
+		return false
+	}
+	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
+		return false
+	}
+	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
 		return false
 	}
 	return true
 }
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQReplicationMessagesRequest)
+	that1, ok := that.(*DescribeHistoryHostRequest)
 	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesRequest)
+		that2, ok := that.(DescribeHistoryHostRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2665,24 +3285,28 @@ func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TaskInfos) != len(that1.TaskInfos) {
+	if this.HostAddress != that1.HostAddress {
 		return false
 	}
-	for i := range this.TaskInfos {
-		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
-			return false
-		}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
 	}
 	return true
 }
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQReplicationMessagesResponse)
+	that1, ok := that.(*DescribeHistoryHostResponse)
 	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesResponse)
+		that2, ok := that.(DescribeHistoryHostResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2694,24 +3318,36 @@ func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+	if this.ShardsNumber != that1.ShardsNumber {
 		return false
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+	if len(this.ShardIds) != len(that1.ShardIds) {
+		return false
+	}
+	for i := range this.ShardIds {
+		if this.ShardIds[i] != that1.ShardIds[i] {
 			return false
 		}
 	}
+	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
+		return false
+	}
+	if this.ShardControllerStatus != that1.ShardControllerStatus {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
 	return true
 }
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+func (this *CloseShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReapplyEventsRequest)
+	that1, ok := that.(*CloseShardRequest)
 	if !ok {
-		that2, ok := that.(ReapplyEventsRequest)
+		that2, ok := that.(CloseShardRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2723,25 +3359,19 @@ func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if !this.Events.Equal(that1.Events) {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
 	return true
 }
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+func (this *CloseShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReapplyEventsResponse)
+	that1, ok := that.(*CloseShardResponse)
 	if !ok {
-		that2, ok := that.(ReapplyEventsResponse)
+		that2, ok := that.(CloseShardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2755,14 +3385,14 @@ func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddSearchAttributesRequest)
+	that1, ok := that.(*RemoveTaskRequest)
 	if !ok {
-		that2, ok := that.(AddSearchAttributesRequest)
+		that2, ok := that.(RemoveTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2774,30 +3404,32 @@ func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	for i := range this.SearchAttributes {
-		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
-			return false
-		}
+	if this.Category != that1.Category {
+		return false
 	}
-	if this.IndexName != that1.IndexName {
+	if this.TaskId != that1.TaskId {
 		return false
 	}
-	if this.SkipSchemaUpdate != that1.SkipSchemaUpdate {
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
 	return true
 }
-func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddSearchAttributesResponse)
+	that1, ok := that.(*RemoveTaskResponse)
 	if !ok {
-		that2, ok := that.(AddSearchAttributesResponse)
+		that2, ok := that.(RemoveTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2811,14 +3443,14 @@ func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveSearchAttributesRequest)
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
 	if !ok {
-		that2, ok := that.(RemoveSearchAttributesRequest)
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2830,27 +3462,40 @@ func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	for i := range this.SearchAttributes {
-		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
-			return false
-		}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	if this.IndexName != that1.IndexName {
+	if this.StartEventId != that1.StartEventId {
+		return false
+	}
+	if this.StartEventVersion != that1.StartEventVersion {
+		return false
+	}
+	if this.EndEventId != that1.EndEventId {
+		return false
+	}
+	if this.EndEventVersion != that1.EndEventVersion {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
 		return false
 	}
 	return true
 }
-func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveSearchAttributesResponse)
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
 	if !ok {
-		that2, ok := that.(RemoveSearchAttributesResponse)
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2862,16 +3507,30 @@ func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
+		return false
+	}
+	for i := range this.HistoryBatches {
+		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
+			return false
+		}
+	}
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
+		return false
+	}
 	return true
 }
-func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetSearchAttributesRequest)
+	that1, ok := that.(*GetReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(GetSearchAttributesRequest)
+		that2, ok := that.(GetReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2883,19 +3542,27 @@ func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.IndexName != that1.IndexName {
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if this.ClusterName != that1.ClusterName {
 		return false
 	}
 	return true
 }
-func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetSearchAttributesResponse)
+	that1, ok := that.(*GetReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(GetSearchAttributesResponse)
+		that2, ok := that.(GetReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2907,43 +3574,24 @@ func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.CustomAttributes) != len(that1.CustomAttributes) {
-		return false
-	}
-	for i := range this.CustomAttributes {
-		if this.CustomAttributes[i] != that1.CustomAttributes[i] {
-			return false
-		}
-	}
-	if len(this.SystemAttributes) != len(that1.SystemAttributes) {
-		return false
-	}
-	for i := range this.SystemAttributes {
-		if this.SystemAttributes[i] != that1.SystemAttributes[i] {
-			return false
-		}
-	}
-	if len(this.Mapping) != len(that1.Mapping) {
+	if len(this.ShardMessages) != len(that1.ShardMessages) {
 		return false
 	}
-	for i := range this.Mapping {
-		if this.Mapping[i] != that1.Mapping[i] {
+	for i := range this.ShardMessages {
+		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
 			return false
 		}
 	}
-	if !this.AddWorkflowExecutionInfo.Equal(that1.AddWorkflowExecutionInfo) {
-		return false
-	}
 	return true
 }
-func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeClusterRequest)
+	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(DescribeClusterRequest)
+		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2955,16 +3603,25 @@ func (this *DescribeClusterRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
+		return false
+	}
+	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
+		return false
+	}
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
 	return true
 }
-func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeClusterResponse)
+	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(DescribeClusterResponse)
+		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2976,30 +3633,19 @@ func (this *DescribeClusterResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.SupportedClients) != len(that1.SupportedClients) {
-		return false
-	}
-	for i := range this.SupportedClients {
-		if this.SupportedClients[i] != that1.SupportedClients[i] {
-			return false
-		}
-	}
-	if this.ServerVersion != that1.ServerVersion {
-		return false
-	}
-	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
+	if !this.Messages.Equal(that1.Messages) {
 		return false
 	}
 	return true
 }
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesRequest)
+	that1, ok := that.(*GetDLQReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesRequest)
+		that2, ok := that.(GetDLQReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3011,34 +3657,24 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
-	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if len(this.TaskInfos) != len(that1.TaskInfos) {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	for i := range this.TaskInfos {
+		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesResponse)
+	that1, ok := that.(*GetDLQReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesResponse)
+		that2, ok := that.(GetDLQReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3050,9 +3686,6 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
 	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
 		return false
 	}
@@ -3061,19 +3694,16 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
 	return true
 }
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesRequest)
+	that1, ok := that.(*ReapplyEventsRequest)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
+		that2, ok := that.(ReapplyEventsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3085,28 +3715,25 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if !this.Events.Equal(that1.Events) {
 		return false
 	}
 	return true
 }
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesResponse)
+	that1, ok := that.(*ReapplyEventsResponse)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
+		that2, ok := that.(ReapplyEventsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3120,14 +3747,14 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
+	that1, ok := that.(*AddSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
+		that2, ok := that.(AddSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3139,34 +3766,33 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
+	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
+	for i := range this.SearchAttributes {
+		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
+			return false
+		}
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if this.IndexName != that1.IndexName {
 		return false
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if this.SkipSchemaUpdate != that1.SkipSchemaUpdate {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
 	return true
 }
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesResponse)
+	that1, ok := that.(*AddSearchAttributesResponse)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesResponse)
+		that2, ok := that.(AddSearchAttributesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3178,19 +3804,16 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
 	return true
 }
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	that1, ok := that.(*RemoveSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksRequest)
+		that2, ok := that.(RemoveSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3202,22 +3825,30 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Namespace != that1.Namespace {
+	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	for i := range this.SearchAttributes {
+		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
+			return false
+		}
+	}
+	if this.IndexName != that1.IndexName {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
 		return false
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	that1, ok := that.(*RemoveSearchAttributesResponse)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksResponse)
+		that2, ok := that.(RemoveSearchAttributesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3231,14 +3862,14 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResendReplicationTasksRequest)
+	that1, ok := that.(*GetSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(ResendReplicationTasksRequest)
+		that2, ok := that.(GetSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3250,40 +3881,70 @@ func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.IndexName != that1.IndexName {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if this.RunId != that1.RunId {
-		return false
+	return true
+}
+func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.RemoteCluster != that1.RemoteCluster {
+
+	that1, ok := that.(*GetSearchAttributesResponse)
+	if !ok {
+		that2, ok := that.(GetSearchAttributesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.StartEventId != that1.StartEventId {
+	if len(this.CustomAttributes) != len(that1.CustomAttributes) {
 		return false
 	}
-	if this.StartVersion != that1.StartVersion {
+	for i := range this.CustomAttributes {
+		if this.CustomAttributes[i] != that1.CustomAttributes[i] {
+			return false
+		}
+	}
+	if len(this.SystemAttributes) != len(that1.SystemAttributes) {
 		return false
 	}
-	if this.EndEventId != that1.EndEventId {
+	for i := range this.SystemAttributes {
+		if this.SystemAttributes[i] != that1.SystemAttributes[i] {
+			return false
+		}
+	}
+	if len(this.Mapping) != len(that1.Mapping) {
 		return false
 	}
-	if this.EndVersion != that1.EndVersion {
+	for i := range this.Mapping {
+		if this.Mapping[i] != that1.Mapping[i] {
+			return false
+		}
+	}
+	if !this.AddWorkflowExecutionInfo.Equal(that1.AddWorkflowExecutionInfo) {
 		return false
 	}
 	return true
 }
-func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *DescribeClusterRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResendReplicationTasksResponse)
+	that1, ok := that.(*DescribeClusterRequest)
 	if !ok {
-		that2, ok := that.(ResendReplicationTasksResponse)
+		that2, ok := that.(DescribeClusterRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3297,1952 +3958,1620 @@ func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DescribeMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.DescribeMutableStateRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*DescribeClusterResponse)
+	if !ok {
+		that2, ok := that.(DescribeClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeMutableStateResponse{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
-	if this.CacheMutableState != nil {
-		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+	if len(this.SupportedClients) != len(that1.SupportedClients) {
+		return false
 	}
-	if this.DatabaseMutableState != nil {
-		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	for i := range this.SupportedClients {
+		if this.SupportedClients[i] != that1.SupportedClients[i] {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeHistoryHostRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ServerVersion != that1.ServerVersion {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeHistoryHostResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
-	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
-	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
-	if this.NamespaceCache != nil {
-		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+
+	that1, ok := that.(*GetDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.CloseShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.CloseShardResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.RemoveTaskRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	if this.HistoryBatches != nil {
-		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+
+	that1, ok := that.(*GetDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
 	}
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
+	if len(this.HistoryTasks) != len(that1.HistoryTasks) {
+		return false
 	}
-	mapStringForShardMessages += "}"
-	if this.ShardMessages != nil {
-		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
+	for i := range this.HistoryTasks {
+		if !this.HistoryTasks[i].Equal(that1.HistoryTasks[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
-	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
-	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PurgeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
-	if this.Messages != nil {
-		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
-	if this.TaskInfos != nil {
-		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ReapplyEventsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.ReapplyEventsRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Events != nil {
-		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ReapplyEventsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ReapplyEventsResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.AddSearchAttributesRequest{")
-	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
-	for k, _ := range this.SearchAttributes {
-		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
-	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSearchAttributes {
-		mapStringForSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.SearchAttributes[k])
+	if this.Type != that1.Type {
+		return false
 	}
-	mapStringForSearchAttributes += "}"
-	if this.SearchAttributes != nil {
-		s = append(s, "SearchAttributes: "+mapStringForSearchAttributes+",\n")
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "SkipSchemaUpdate: "+fmt.Sprintf("%#v", this.SkipSchemaUpdate)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.AddSearchAttributesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RemoveSearchAttributesRequest{")
-	s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveSearchAttributesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetSearchAttributesRequest{")
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.GetSearchAttributesResponse{")
-	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
-	for k, _ := range this.CustomAttributes {
-		keysForCustomAttributes = append(keysForCustomAttributes, k)
+
+	that1, ok := that.(*MergeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
-	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForCustomAttributes {
-		mapStringForCustomAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomAttributes[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForCustomAttributes += "}"
-	if this.CustomAttributes != nil {
-		s = append(s, "CustomAttributes: "+mapStringForCustomAttributes+",\n")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
-	for k, _ := range this.SystemAttributes {
-		keysForSystemAttributes = append(keysForSystemAttributes, k)
+	return true
+}
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
-	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSystemAttributes {
-		mapStringForSystemAttributes += fmt.Sprintf("%#v: %#v,", k, this.SystemAttributes[k])
+
+	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	mapStringForSystemAttributes += "}"
-	if this.SystemAttributes != nil {
-		s = append(s, "SystemAttributes: "+mapStringForSystemAttributes+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	keysForMapping := make([]string, 0, len(this.Mapping))
-	for k, _ := range this.Mapping {
-		keysForMapping = append(keysForMapping, k)
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
-	mapStringForMapping := "map[string]string{"
-	for _, k := range keysForMapping {
-		mapStringForMapping += fmt.Sprintf("%#v: %#v,", k, this.Mapping[k])
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	mapStringForMapping += "}"
-	if this.Mapping != nil {
-		s = append(s, "Mapping: "+mapStringForMapping+",\n")
+	return true
+}
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.AddWorkflowExecutionInfo != nil {
-		s = append(s, "AddWorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.AddWorkflowExecutionInfo)+",\n")
+
+	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeClusterRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.DescribeClusterRequest{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeClusterResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.DescribeClusterResponse{")
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
+
+	that1, ok := that.(*ResendReplicationTasksRequest)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForSupportedClients += "}"
-	if this.SupportedClients != nil {
-		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
-	if this.MembershipInfo != nil {
-		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.RunId != that1.RunId {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.GetDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.RemoteCluster != that1.RemoteCluster {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetDLQMessagesResponse{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	if this.StartEventId != that1.StartEventId {
+		return false
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PurgeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.StartVersion != that1.StartVersion {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PurgeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.EndEventId != that1.EndEventId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *MergeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.EndVersion != that1.EndVersion {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *MergeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RefreshWorkflowTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ResendReplicationTasksResponse)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RefreshWorkflowTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeShardQueuesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResendReplicationTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*DescribeShardQueuesRequest)
+	if !ok {
+		that2, ok := that.(DescribeShardQueuesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResendReplicationTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PageSize != that1.PageSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *DescribeShardQueuesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*DescribeShardQueuesResponse)
+	if !ok {
+		that2, ok := that.(DescribeShardQueuesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	return dAtA[:n], nil
+	if len(this.Queues) != len(that1.Queues) {
+		return false
+	}
+	for i := range this.Queues {
+		if !this.Queues[i].Equal(that1.Queues[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*GetDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(GetDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
 }
+func (this *GetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DatabaseMutableState != nil {
-		{
-			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(GetDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.CacheMutableState != nil {
-		{
-			size, err := m.CacheMutableState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.HistoryAddr) > 0 {
-		i -= len(m.HistoryAddr)
-		copy(dAtA[i:], m.HistoryAddr)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	if len(m.ShardId) > 0 {
-		i -= len(m.ShardId)
-		copy(dAtA[i:], m.ShardId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*SetDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(SetDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if !this.Value.Equal(that1.Value) {
+		return false
 	}
-	if len(m.HostAddress) > 0 {
-		i -= len(m.HostAddress)
-		copy(dAtA[i:], m.HostAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *SetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*SetDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(SetDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *DeleteDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*DeleteDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(DeleteDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.ShardControllerStatus) > 0 {
-		i -= len(m.ShardControllerStatus)
-		copy(dAtA[i:], m.ShardControllerStatus)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NamespaceCache != nil {
-		{
-			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.Key != that1.Key {
+		return false
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA7 := make([]byte, len(m.ShardIds)*10)
-		var j6 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0x12
+	if !this.Constraints.Equal(that1.Constraints) {
+		return false
 	}
-	if m.ShardsNumber != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
-		i--
-		dAtA[i] = 0x8
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+func (this *DeleteDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DeleteDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(DeleteDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ListDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(ListDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ListDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*ListDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(ListDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ListDynamicConfigHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListDynamicConfigHistoryRequest)
+	if !ok {
+		that2, ok := that.(ListDynamicConfigHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *ListDynamicConfigHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListDynamicConfigHistoryResponse)
+	if !ok {
+		that2, ok := that.(ListDynamicConfigHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *DeleteNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VisibilityTime != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err8 != nil {
-			return 0, err8
+	that1, ok := that.(*DeleteNamespaceRequest)
+	if !ok {
+		that2, ok := that.(DeleteNamespaceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n8
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
-		i--
-		dAtA[i] = 0x10
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
 }
+func (this *DeleteNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DeleteNamespaceResponse)
+	if !ok {
+		that2, ok := that.(DeleteNamespaceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x42
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x38
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	if m.EndEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
-		i--
-		dAtA[i] = 0x30
+	if this.RunId != that1.RunId {
+		return false
 	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x28
+	return true
+}
+func (this *RenameNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.StartEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
-		i--
-		dAtA[i] = 0x20
+
+	that1, ok := that.(*RenameNamespaceRequest)
+	if !ok {
+		that2, ok := that.(RenameNamespaceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.NewName != that1.NewName {
+		return false
+	}
+	if this.AliasTtl != nil && that1.AliasTtl != nil {
+		if *this.AliasTtl != *that1.AliasTtl {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
+	} else if this.AliasTtl != nil {
+		return false
+	} else if that1.AliasTtl != nil {
+		return false
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *RenameNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*RenameNamespaceResponse)
+	if !ok {
+		that2, ok := that.(RenameNamespaceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.HistoryBatches) > 0 {
-		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReclaimSearchAttributeFieldsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ReclaimSearchAttributeFieldsRequest)
+	if !ok {
+		that2, ok := that.(ReclaimSearchAttributeFieldsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.IndexName != that1.IndexName {
+		return false
+	}
+	return true
 }
+func (this *ReclaimSearchAttributeFieldsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ReclaimSearchAttributeFieldsResponse)
+	if !ok {
+		that2, ok := that.(ReclaimSearchAttributeFieldsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
-
-func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeMutableStateRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x1a
+func (this *DescribeMutableStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastProcessedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeMutableStateResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
+	if this.CacheMutableState != nil {
+		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x8
+	if this.DatabaseMutableState != nil {
+		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DescribeHistoryHostRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeHistoryHostResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
+	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
+	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
+	if this.NamespaceCache != nil {
+		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+	}
+	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Messages != nil {
-		{
-			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *CloseShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.CloseShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CloseShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.CloseShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *RemoveTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.RemoveTaskRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (this *RemoveTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryBatches != nil {
+		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
 	}
-	return dAtA[:n], nil
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (this *GetReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	mapStringForShardMessages += "}"
+	if this.ShardMessages != nil {
+		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
 	}
-	return dAtA[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
+	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
+	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetDLQReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
+	if this.TaskInfos != nil {
+		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetDLQReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SkipSchemaUpdate {
-		i--
-		if m.SkipSchemaUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func (this *ReapplyEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ReapplyEventsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if len(m.SearchAttributes) > 0 {
-		for k := range m.SearchAttributes {
-			v := m.SearchAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReapplyEventsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ReapplyEventsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.AddSearchAttributesRequest{")
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
 	}
-	if len(m.SearchAttributes) > 0 {
-		for iNdEx := len(m.SearchAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SearchAttributes[iNdEx])
-			copy(dAtA[i:], m.SearchAttributes[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SearchAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.SearchAttributes[k])
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	mapStringForSearchAttributes += "}"
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+mapStringForSearchAttributes+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "SkipSchemaUpdate: "+fmt.Sprintf("%#v", this.SkipSchemaUpdate)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.AddSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0xa
+func (this *RemoveSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.RemoveSearchAttributesRequest{")
+	s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemoveSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetSearchAttributesRequest{")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddWorkflowExecutionInfo != nil {
-		{
-			size, err := m.AddWorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (this *GetSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Mapping) > 0 {
-		for k := range m.Mapping {
-			v := m.Mapping[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.GetSearchAttributesResponse{")
+	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
+	for k, _ := range this.CustomAttributes {
+		keysForCustomAttributes = append(keysForCustomAttributes, k)
 	}
-	if len(m.SystemAttributes) > 0 {
-		for k := range m.SystemAttributes {
-			v := m.SystemAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
+	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForCustomAttributes {
+		mapStringForCustomAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomAttributes[k])
 	}
-	if len(m.CustomAttributes) > 0 {
-		for k := range m.CustomAttributes {
-			v := m.CustomAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	mapStringForCustomAttributes += "}"
+	if this.CustomAttributes != nil {
+		s = append(s, "CustomAttributes: "+mapStringForCustomAttributes+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
+	for k, _ := range this.SystemAttributes {
+		keysForSystemAttributes = append(keysForSystemAttributes, k)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
+	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForSystemAttributes {
+		mapStringForSystemAttributes += fmt.Sprintf("%#v: %#v,", k, this.SystemAttributes[k])
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MembershipInfo != nil {
-		{
-			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	mapStringForSystemAttributes += "}"
+	if this.SystemAttributes != nil {
+		s = append(s, "SystemAttributes: "+mapStringForSystemAttributes+",\n")
 	}
-	if len(m.ServerVersion) > 0 {
-		i -= len(m.ServerVersion)
-		copy(dAtA[i:], m.ServerVersion)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
-		i--
-		dAtA[i] = 0x12
+	keysForMapping := make([]string, 0, len(this.Mapping))
+	for k, _ := range this.Mapping {
+		keysForMapping = append(keysForMapping, k)
 	}
-	if len(m.SupportedClients) > 0 {
-		for k := range m.SupportedClients {
-			v := m.SupportedClients[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
+	mapStringForMapping := "map[string]string{"
+	for _, k := range keysForMapping {
+		mapStringForMapping += fmt.Sprintf("%#v: %#v,", k, this.Mapping[k])
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	mapStringForMapping += "}"
+	if this.Mapping != nil {
+		s = append(s, "Mapping: "+mapStringForMapping+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.AddWorkflowExecutionInfo != nil {
+		s = append(s, "AddWorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.AddWorkflowExecutionInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
+func (this *DescribeClusterRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.DescribeClusterRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeClusterResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DescribeClusterResponse{")
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	mapStringForSupportedClients += "}"
+	if this.SupportedClients != nil {
+		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
+	if this.MembershipInfo != nil {
+		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.GetDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x1a
+func (this *GetDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.GetDLQMessagesResponse{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryTasks != nil {
+		s = append(s, "HistoryTasks: "+fmt.Sprintf("%#v", this.HistoryTasks)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PurgeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *PurgeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+func (this *MergeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RefreshWorkflowTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *ResendReplicationTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ResendReplicationTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeShardQueuesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DescribeShardQueuesRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
+func (this *DescribeShardQueuesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DescribeShardQueuesResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	if this.Queues != nil {
+		s = append(s, "Queues: "+fmt.Sprintf("%#v", this.Queues)+",\n")
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDynamicConfigRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDynamicConfigResponse{")
+	if this.Values != nil {
+		s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.SetDynamicConfigRequest{")
+	if this.Value != nil {
+		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SetDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.SetDynamicConfigResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DeleteDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DeleteDynamicConfigRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Constraints != nil {
+		s = append(s, "Constraints: "+fmt.Sprintf("%#v", this.Constraints)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+func (this *DeleteDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.DeleteDynamicConfigResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ListDynamicConfigRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func (this *ListDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.ListDynamicConfigResponse{")
+	if this.Values != nil {
+		s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListDynamicConfigHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ListDynamicConfigHistoryRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListDynamicConfigHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListDynamicConfigHistoryResponse{")
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteNamespaceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DeleteNamespaceRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteNamespaceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DeleteNamespaceResponse{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenameNamespaceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.RenameNamespaceRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NewName: "+fmt.Sprintf("%#v", this.NewName)+",\n")
+	s = append(s, "AliasTtl: "+fmt.Sprintf("%#v", this.AliasTtl)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenameNamespaceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.RenameNamespaceResponse{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReclaimSearchAttributeFieldsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ReclaimSearchAttributeFieldsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReclaimSearchAttributeFieldsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ReclaimSearchAttributeFieldsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5269,7 +5598,7 @@ func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5279,20 +5608,58 @@ func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DatabaseMutableState != nil {
+		{
+			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CacheMutableState != nil {
+		{
+			size, err := m.CacheMutableState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HistoryAddr) > 0 {
+		i -= len(m.HistoryAddr)
+		copy(dAtA[i:], m.HistoryAddr)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ShardId) > 0 {
+		i -= len(m.ShardId)
+		copy(dAtA[i:], m.ShardId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5302,68 +5669,51 @@ func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EndVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.StartVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.RemoteCluster) > 0 {
-		i -= len(m.RemoteCluster)
-		copy(dAtA[i:], m.RemoteCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5373,1222 +5723,5929 @@ func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DescribeMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ShardControllerStatus) > 0 {
+		i -= len(m.ShardControllerStatus)
+		copy(dAtA[i:], m.ShardControllerStatus)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.NamespaceCache != nil {
+		{
+			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ShardIds) > 0 {
+		dAtA7 := make([]byte, len(m.ShardIds)*10)
+		var j6 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ShardsNumber != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ShardId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CacheMutableState != nil {
-		l = m.CacheMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.DatabaseMutableState != nil {
-		l = m.DatabaseMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
 	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
-	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
-	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	return n
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CloseShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
+	if m.VisibilityTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.StartEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	if m.EndEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.EndEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	if m.StartEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.HistoryBatches) > 0 {
-		for _, e := range m.HistoryBatches {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.LastProcessedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Messages != nil {
-		l = m.Messages.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	return n
+func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Messages != nil {
+		{
+			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SearchAttributes) > 0 {
-		for k, v := range m.SearchAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.SkipSchemaUpdate {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SearchAttributes) > 0 {
-		for _, s := range m.SearchAttributes {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CustomAttributes) > 0 {
-		for k, v := range m.CustomAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	if len(m.SystemAttributes) > 0 {
-		for k, v := range m.SystemAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Mapping) > 0 {
-		for k, v := range m.Mapping {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AddWorkflowExecutionInfo != nil {
-		l = m.AddWorkflowExecutionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SupportedClients) > 0 {
-		for k, v := range m.SupportedClients {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.ServerVersion)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.MembershipInfo != nil {
-		l = m.MembershipInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
-	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *AddSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if m.SkipSchemaUpdate {
+		i--
+		if m.SkipSchemaUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if len(m.SearchAttributes) > 0 {
+		for k := range m.SearchAttributes {
+			v := m.SearchAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.SearchAttributes) > 0 {
+		for iNdEx := len(m.SearchAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SearchAttributes[iNdEx])
+			copy(dAtA[i:], m.SearchAttributes[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SearchAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *GetSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResendReplicationTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RemoteCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	if m.AddWorkflowExecutionInfo != nil {
+		{
+			size, err := m.AddWorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.StartVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	if len(m.Mapping) > 0 {
+		for k := range m.Mapping {
+			v := m.Mapping[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	if len(m.SystemAttributes) > 0 {
+		for k := range m.SystemAttributes {
+			v := m.SystemAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.EndVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	if len(m.CustomAttributes) > 0 {
+		for k := range m.CustomAttributes {
+			v := m.CustomAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DescribeMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MembershipInfo != nil {
+		{
+			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ServerVersion) > 0 {
+		i -= len(m.ServerVersion)
+		copy(dAtA[i:], m.ServerVersion)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
-		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
-		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.SupportedClients) > 0 {
+		for k := range m.SupportedClients {
+			v := m.SupportedClients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (this *DescribeHistoryHostRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
-		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
-		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
-		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v12.NamespaceCacheInfo", 1) + `,`,
-		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *CloseShardRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CloseShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *CloseShardResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	s := strings.Join([]string{`&CloseShardResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	s := strings.Join([]string{`&RemoveTaskRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	s := strings.Join([]string{`&RemoveTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	repeatedStringForHistoryBatches := "[]*DataBlob{"
-	for _, f := range this.HistoryBatches {
-		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	repeatedStringForHistoryBatches += "}"
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v14.VersionHistory", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForTokens := "[]*ReplicationToken{"
-	for _, f := range this.Tokens {
-		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v15.ReplicationToken", 1) + ","
+
+func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForTokens += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
-		`Tokens:` + repeatedStringForTokens + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HistoryTasks) > 0 {
+		for iNdEx := len(m.HistoryTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	mapStringForShardMessages += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
-		`ShardMessages:` + mapStringForShardMessages + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetNamespaceReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
-		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
-		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetNamespaceReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
-		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v15.ReplicationMessages", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetDLQReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
-	for _, f := range this.TaskInfos {
-		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v15.ReplicationTaskInfo", 1) + ","
-	}
-	repeatedStringForTaskInfos += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
-		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetDLQReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReapplyEventsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&ReapplyEventsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReapplyEventsResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&ReapplyEventsResponse{`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AddSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
-	for k, _ := range this.SearchAttributes {
-		keysForSearchAttributes = append(keysForSearchAttributes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
-	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSearchAttributes {
-		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
+
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForSearchAttributes += "}"
-	s := strings.Join([]string{`&AddSearchAttributesRequest{`,
-		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`SkipSchemaUpdate:` + fmt.Sprintf("%v", this.SkipSchemaUpdate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *AddSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AddSearchAttributesResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *RemoveSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveSearchAttributesRequest{`,
-		`SearchAttributes:` + fmt.Sprintf("%v", this.SearchAttributes) + `,`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
-func (this *RemoveSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RemoveSearchAttributesResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetSearchAttributesRequest{`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
-	for k, _ := range this.CustomAttributes {
-		keysForCustomAttributes = append(keysForCustomAttributes, k)
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
-	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForCustomAttributes {
-		mapStringForCustomAttributes += fmt.Sprintf("%v: %v,", k, this.CustomAttributes[k])
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	mapStringForCustomAttributes += "}"
-	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
-	for k, _ := range this.SystemAttributes {
-		keysForSystemAttributes = append(keysForSystemAttributes, k)
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
-	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSystemAttributes {
-		mapStringForSystemAttributes += fmt.Sprintf("%v: %v,", k, this.SystemAttributes[k])
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	mapStringForSystemAttributes += "}"
-	keysForMapping := make([]string, 0, len(this.Mapping))
-	for k, _ := range this.Mapping {
-		keysForMapping = append(keysForMapping, k)
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
-	mapStringForMapping := "map[string]string{"
-	for _, k := range keysForMapping {
-		mapStringForMapping += fmt.Sprintf("%v: %v,", k, this.Mapping[k])
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForMapping += "}"
-	s := strings.Join([]string{`&GetSearchAttributesResponse{`,
-		`CustomAttributes:` + mapStringForCustomAttributes + `,`,
-		`SystemAttributes:` + mapStringForSystemAttributes + `,`,
-		`Mapping:` + mapStringForMapping + `,`,
-		`AddWorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.AddWorkflowExecutionInfo), "WorkflowExecutionInfo", "v17.WorkflowExecutionInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DescribeClusterRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DescribeClusterRequest{`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DescribeClusterResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	mapStringForSupportedClients += "}"
-	s := strings.Join([]string{`&DescribeClusterResponse{`,
-		`SupportedClients:` + mapStringForSupportedClients + `,`,
-		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
-		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v18.MembershipInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
+		i--
+		dAtA[i] = 0x40
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.EndEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *PurgeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PurgeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeShardQueuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MergeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeShardQueuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeShardQueuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (this *MergeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeShardQueuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeShardQueuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeShardQueuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AliasTtl != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.AliasTtl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AliasTtl):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimSearchAttributeFieldsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimSearchAttributeFieldsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimSearchAttributeFieldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DescribeMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShardId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.HistoryAddr)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CacheMutableState != nil {
+		l = m.CacheMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DatabaseMutableState != nil {
+		l = m.DatabaseMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	}
+	if m.LastProcessedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Messages != nil {
+		l = m.Messages.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttributes) > 0 {
+		for k, v := range m.SearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SkipSchemaUpdate {
+		n += 2
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttributes) > 0 {
+		for _, s := range m.SearchAttributes {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomAttributes) > 0 {
+		for k, v := range m.CustomAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SystemAttributes) > 0 {
+		for k, v := range m.SystemAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mapping) > 0 {
+		for k, v := range m.Mapping {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if m.AddWorkflowExecutionInfo != nil {
+		l = m.AddWorkflowExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SupportedClients) > 0 {
+		for k, v := range m.SupportedClients {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ServerVersion)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MembershipInfo != nil {
+		l = m.MembershipInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryTasks) > 0 {
+		for _, e := range m.HistoryTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResendReplicationTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	}
+	return n
+}
+
+func (m *ResendReplicationTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeShardQueuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeShardQueuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *SetDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListDynamicConfigHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListDynamicConfigHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RenameNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AliasTtl != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AliasTtl)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RenameNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReclaimSearchAttributeFieldsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReclaimSearchAttributeFieldsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DescribeMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
+		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
+		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
+		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
+		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
+		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v12.NamespaceCacheInfo", 1) + `,`,
+		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistoryBatches := "[]*DataBlob{"
+	for _, f := range this.HistoryBatches {
+		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+	}
+	repeatedStringForHistoryBatches += "}"
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v14.VersionHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ReplicationToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v15.ReplicationToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
+		`ShardMessages:` + mapStringForShardMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
+		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
+		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
+		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v15.ReplicationMessages", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
+	for _, f := range this.TaskInfos {
+		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v15.ReplicationTaskInfo", 1) + ","
+	}
+	repeatedStringForTaskInfos += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
+		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
+	}
+	mapStringForSearchAttributes += "}"
+	s := strings.Join([]string{`&AddSearchAttributesRequest{`,
+		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`SkipSchemaUpdate:` + fmt.Sprintf("%v", this.SkipSchemaUpdate) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSearchAttributesRequest{`,
+		`SearchAttributes:` + fmt.Sprintf("%v", this.SearchAttributes) + `,`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSearchAttributesRequest{`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
+	for k, _ := range this.CustomAttributes {
+		keysForCustomAttributes = append(keysForCustomAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
+	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForCustomAttributes {
+		mapStringForCustomAttributes += fmt.Sprintf("%v: %v,", k, this.CustomAttributes[k])
+	}
+	mapStringForCustomAttributes += "}"
+	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
+	for k, _ := range this.SystemAttributes {
+		keysForSystemAttributes = append(keysForSystemAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
+	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
+	for _, k := range keysForSystemAttributes {
+		mapStringForSystemAttributes += fmt.Sprintf("%v: %v,", k, this.SystemAttributes[k])
+	}
+	mapStringForSystemAttributes += "}"
+	keysForMapping := make([]string, 0, len(this.Mapping))
+	for k, _ := range this.Mapping {
+		keysForMapping = append(keysForMapping, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
+	mapStringForMapping := "map[string]string{"
+	for _, k := range keysForMapping {
+		mapStringForMapping += fmt.Sprintf("%v: %v,", k, this.Mapping[k])
+	}
+	mapStringForMapping += "}"
+	s := strings.Join([]string{`&GetSearchAttributesResponse{`,
+		`CustomAttributes:` + mapStringForCustomAttributes + `,`,
+		`SystemAttributes:` + mapStringForSystemAttributes + `,`,
+		`Mapping:` + mapStringForMapping + `,`,
+		`AddWorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.AddWorkflowExecutionInfo), "WorkflowExecutionInfo", "v17.WorkflowExecutionInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeClusterRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+	}
+	mapStringForSupportedClients += "}"
+	s := strings.Join([]string{`&DescribeClusterResponse{`,
+		`SupportedClients:` + mapStringForSupportedClients + `,`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v18.MembershipInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	repeatedStringForHistoryTasks := "[]*HistoryDLQTaskInfo{"
+	for _, f := range this.HistoryTasks {
+		repeatedStringForHistoryTasks += strings.Replace(fmt.Sprintf("%v", f), "HistoryDLQTaskInfo", "v11.HistoryDLQTaskInfo", 1) + ","
+	}
+	repeatedStringForHistoryTasks += "}"
+	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`HistoryTasks:` + repeatedStringForHistoryTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeShardQueuesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeShardQueuesRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeShardQueuesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueState{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(fmt.Sprintf("%v", f), "QueueState", "v14.QueueState", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&DescribeShardQueuesResponse{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDynamicConfigRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValues := "[]*DynamicConfigValue{"
+	for _, f := range this.Values {
+		repeatedStringForValues += strings.Replace(fmt.Sprintf("%v", f), "DynamicConfigValue", "v11.DynamicConfigValue", 1) + ","
+	}
+	repeatedStringForValues += "}"
+	s := strings.Join([]string{`&GetDynamicConfigResponse{`,
+		`Values:` + repeatedStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetDynamicConfigRequest{`,
+		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "DynamicConfigValue", "v11.DynamicConfigValue", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetDynamicConfigResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDynamicConfigRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Constraints:` + strings.Replace(fmt.Sprintf("%v", this.Constraints), "DynamicConfigConstraints", "v11.DynamicConfigConstraints", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDynamicConfigResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListDynamicConfigRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValues := "[]*DynamicConfigValue{"
+	for _, f := range this.Values {
+		repeatedStringForValues += strings.Replace(fmt.Sprintf("%v", f), "DynamicConfigValue", "v11.DynamicConfigValue", 1) + ","
+	}
+	repeatedStringForValues += "}"
+	s := strings.Join([]string{`&ListDynamicConfigResponse{`,
+		`Values:` + repeatedStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListDynamicConfigHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListDynamicConfigHistoryRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListDynamicConfigHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*DynamicConfigChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(fmt.Sprintf("%v", f), "DynamicConfigChange", "v11.DynamicConfigChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&ListDynamicConfigHistoryResponse{`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteNamespaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteNamespaceRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteNamespaceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteNamespaceResponse{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenameNamespaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenameNamespaceRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NewName:` + fmt.Sprintf("%v", this.NewName) + `,`,
+		`AliasTtl:` + strings.Replace(fmt.Sprintf("%v", this.AliasTtl), "Duration", "types.Duration", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenameNamespaceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenameNamespaceResponse{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReclaimSearchAttributeFieldsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReclaimSearchAttributeFieldsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReclaimSearchAttributeFieldsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReclaimSearchAttributeFieldsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CacheMutableState == nil {
+				m.CacheMutableState = &v11.WorkflowMutableState{}
+			}
+			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatabaseMutableState == nil {
+				m.DatabaseMutableState = &v11.WorkflowMutableState{}
+			}
+			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v12.NamespaceCacheInfo{}
+			}
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v13.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
+			}
+			m.StartEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
+			}
+			m.EndEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
+			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v14.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &v15.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v15.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v15.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v15.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			}
+			m.LastProcessedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RefreshWorkflowTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *RefreshWorkflowTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Messages == nil {
+				m.Messages = &v15.ReplicationMessages{}
+			}
+			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResendReplicationTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *ResendReplicationTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v15.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6611,47 +11668,15 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6678,10 +11703,8 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6709,7 +11732,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6732,15 +11755,15 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6768,43 +11791,11 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardId = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6831,16 +11822,16 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CacheMutableState == nil {
-				m.CacheMutableState = &v11.WorkflowMutableState{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6867,10 +11858,10 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatabaseMutableState == nil {
-				m.DatabaseMutableState = &v11.WorkflowMutableState{}
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
 			}
-			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6898,7 +11889,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6921,131 +11912,12 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7070,7 +11942,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7093,17 +11965,17 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSearchAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
 			}
-			m.ShardsNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7113,14 +11985,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = make(map[string]v16.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v16.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowRequestResponse
@@ -7130,51 +12017,42 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int32(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRequestResponse
@@ -7184,21 +12062,33 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= int32(b&0x7F) << shift
+						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.ShardIds = append(m.ShardIds, v)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
+			m.SearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7208,33 +12098,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v12.NamespaceCacheInfo{}
-			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaUpdate", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7244,27 +12130,15 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.SkipSchemaUpdate = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7292,7 +12166,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7318,79 +12192,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7413,10 +12215,10 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSearchAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7443,7 +12245,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7466,17 +12268,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSearchAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7486,35 +12288,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.Category = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Category |= v13.TaskCategory(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = 0
+			m.SearchAttributes = append(m.SearchAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7524,16 +12320,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7543,27 +12352,23 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7589,7 +12394,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7612,10 +12417,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSearchAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7642,7 +12447,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7665,15 +12470,15 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSearchAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7701,13 +12506,13 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7717,90 +12522,82 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.StartEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.StartEventVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartEventVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
 			}
-			m.EndEventVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7810,35 +12607,110 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomAttributes == nil {
+				m.CustomAttributes = make(map[string]v16.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v16.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 8:
+			m.CustomAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemAttributes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7848,84 +12720,110 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SystemAttributes == nil {
+				m.SystemAttributes = make(map[string]v16.IndexedValueType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue v16.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SystemAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7935,63 +12833,122 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			if m.Mapping == nil {
+				m.Mapping = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
-			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mapping[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddWorkflowExecutionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8018,10 +12975,10 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v14.VersionHistory{}
+			if m.AddWorkflowExecutionInfo == nil {
+				m.AddWorkflowExecutionInfo = &v17.WorkflowExecutionInfo{}
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8049,7 +13006,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8072,78 +13029,12 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokens = append(m.Tokens, &v15.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8168,7 +13059,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8191,15 +13082,15 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8226,11 +13117,11 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v15.ReplicationMessages)
+			if m.SupportedClients == nil {
+				m.SupportedClients = make(map[string]string)
 			}
-			var mapkey int32
-			var mapvalue *v15.ReplicationMessages
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -8250,6 +13141,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRequestResponse
@@ -8259,13 +13151,26 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRequestResponse
@@ -8275,26 +13180,24 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthRequestResponse
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthRequestResponse
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v15.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8310,7 +13213,75 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ShardMessages[mapkey] = mapvalue
+			m.SupportedClients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MembershipInfo == nil {
+				m.MembershipInfo = &v18.MembershipInfo{}
+			}
+			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8336,7 +13307,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8359,17 +13330,17 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8379,16 +13350,16 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.LastProcessedMessageId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8398,14 +13369,14 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8433,66 +13404,51 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8502,26 +13458,24 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Messages == nil {
-				m.Messages = &v15.ReplicationMessages{}
-			}
-			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8548,7 +13502,7 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8571,15 +13525,34 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8606,67 +13579,48 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v15.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8693,8 +13647,8 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistoryTasks = append(m.HistoryTasks, &v11.HistoryDLQTaskInfo{})
+			if err := m.HistoryTasks[len(m.HistoryTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8722,7 +13676,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8745,17 +13699,17 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8765,29 +13719,16 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8797,33 +13738,16 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8833,28 +13757,43 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8879,7 +13818,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8902,10 +13841,10 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8932,7 +13871,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8955,17 +13894,17 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8975,108 +13914,33 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SearchAttributes == nil {
-				m.SearchAttributes = make(map[string]v16.IndexedValueType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SearchAttributes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9104,13 +13968,51 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var v int
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9120,12 +14022,26 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipSchemaUpdate = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9150,7 +14066,7 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9173,12 +14089,46 @@ func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9203,7 +14153,7 @@ func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9226,15 +14176,15 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9262,13 +14212,13 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SearchAttributes = append(m.SearchAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9278,23 +14228,27 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9320,7 +14274,7 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9343,10 +14297,10 @@ func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSearchAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9373,7 +14327,7 @@ func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9396,15 +14350,15 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9432,66 +14386,13 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetSearchAttributesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9501,110 +14402,29 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomAttributes == nil {
-				m.CustomAttributes = make(map[string]v16.IndexedValueType)
-			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CustomAttributes[mapkey] = mapvalue
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9614,110 +14434,29 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SystemAttributes == nil {
-				m.SystemAttributes = make(map[string]v16.IndexedValueType)
-			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SystemAttributes[mapkey] = mapvalue
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9727,124 +14466,86 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mapping == nil {
-				m.Mapping = make(map[string]string)
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Mapping[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddWorkflowExecutionInfo", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			}
+			m.StartVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			}
+			m.EndVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9854,28 +14555,64 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AddWorkflowExecutionInfo == nil {
-				m.AddWorkflowExecutionInfo = &v17.WorkflowExecutionInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9900,7 +14637,7 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeShardQueuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9923,12 +14660,84 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeShardQueuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeShardQueuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9953,7 +14762,7 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeShardQueuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9976,15 +14785,34 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeShardQueuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeShardQueuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10008,112 +14836,19 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SupportedClients == nil {
-				m.SupportedClients = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &v14.QueueState{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SupportedClients[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10123,29 +14858,84 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10155,27 +14945,23 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MembershipInfo == nil {
-				m.MembershipInfo = &v18.MembershipInfo{}
-			}
-			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10201,7 +14987,7 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10224,17 +15010,17 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10244,16 +15030,84 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.ShardId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &v11.DynamicConfigValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10263,14 +15117,31 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &v11.DynamicConfigValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10298,13 +15169,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10314,64 +15185,77 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10396,7 +15280,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10419,17 +15303,17 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDynamicConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10439,14 +15323,27 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10473,16 +15370,18 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Constraints == nil {
+				m.Constraints = &v11.DynamicConfigConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10492,25 +15391,55 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10536,7 +15465,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10559,101 +15488,65 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10678,7 +15571,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ListDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10701,12 +15594,46 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &v11.DynamicConfigValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10731,7 +15658,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDynamicConfigHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10754,53 +15681,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDynamicConfigHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDynamicConfigHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10828,32 +15717,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.MaximumPageSize = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10863,12 +15733,12 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -10926,7 +15796,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ListDynamicConfigHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10949,13 +15819,47 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDynamicConfigHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDynamicConfigHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &v11.DynamicConfigChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -11013,7 +15917,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteNamespaceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11036,10 +15940,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteNamespaceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11076,9 +15980,9 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11088,27 +15992,55 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11134,7 +16066,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11153,16 +16085,112 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11187,7 +16215,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *RenameNamespaceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11210,15 +16238,15 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameNamespaceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11246,11 +16274,11 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11278,13 +16306,13 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AliasTtl", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11294,27 +16322,31 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			if m.AliasTtl == nil {
+				m.AliasTtl = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.AliasTtl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11342,13 +16374,13 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.StartEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11358,35 +16390,82 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.StartVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndEventId = 0
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReclaimSearchAttributeFieldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReclaimSearchAttributeFieldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReclaimSearchAttributeFieldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11396,16 +16475,29 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.EndVersion = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11415,11 +16507,24 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndVersion |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11444,7 +16549,7 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *ReclaimSearchAttributeFieldsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11467,12 +16572,97 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReclaimSearchAttributeFieldsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReclaimSearchAttributeFieldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -54,49 +54,59 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xcb, 0x6f, 0x38, 0xfd, 0xf8, 0x23, 0x83, 0x2a, 0xd1, 0xe1, 0x40, 0xb0, 0x3b,
-	0x6a, 0x91, 0x8a, 0x68, 0x41, 0x34, 0x4d, 0x4b, 0x2a, 0xd1, 0x20, 0x70, 0x10, 0x48, 0x2c, 0xe8,
-	0xe2, 0x3c, 0x4d, 0xac, 0x3a, 0x39, 0x73, 0x77, 0x4e, 0xe9, 0x04, 0x23, 0x12, 0x12, 0x82, 0x15,
-	0x09, 0x31, 0xb0, 0x30, 0xf0, 0x1a, 0x90, 0xd8, 0x18, 0x33, 0x76, 0x24, 0xce, 0xc2, 0xd8, 0x97,
-	0x80, 0x82, 0x73, 0x8e, 0x93, 0x1e, 0xe5, 0xec, 0x74, 0xb3, 0xa5, 0xfb, 0x7c, 0x9f, 0xcf, 0x9d,
-	0xf4, 0x3c, 0x77, 0x78, 0x49, 0x42, 0x27, 0x64, 0x9c, 0x06, 0x25, 0x01, 0xbc, 0x07, 0xbc, 0x44,
-	0x43, 0xbf, 0x44, 0x9b, 0x1d, 0xbf, 0x3b, 0xfa, 0xf7, 0x3d, 0x28, 0xf5, 0x96, 0x4a, 0xe3, 0x4f,
-	0x27, 0xe4, 0x4c, 0x32, 0xfb, 0x9a, 0x42, 0x9c, 0x04, 0x71, 0x68, 0xe8, 0x3b, 0x59, 0xc4, 0xe9,
-	0x2d, 0x2d, 0xae, 0x9a, 0xe4, 0x72, 0x78, 0x1e, 0x81, 0x90, 0xcf, 0x38, 0x88, 0x90, 0x75, 0xc5,
-	0xb8, 0xc0, 0xf2, 0xa7, 0x05, 0xfc, 0x7f, 0x79, 0xb4, 0xb4, 0x9e, 0x2c, 0xb5, 0x3f, 0x22, 0x7c,
-	0x71, 0x13, 0x84, 0xc7, 0xfd, 0x06, 0xd4, 0x22, 0x49, 0x1b, 0x01, 0xd4, 0x25, 0x95, 0x60, 0xaf,
-	0x3b, 0x06, 0x2e, 0x8e, 0x0e, 0x75, 0x93, 0xd2, 0x8b, 0xe5, 0x39, 0x12, 0x12, 0xe9, 0xab, 0x96,
-	0xfd, 0x01, 0xe1, 0x0b, 0x6a, 0xc9, 0xb6, 0x2f, 0x24, 0xe3, 0x07, 0xdb, 0x4c, 0x48, 0xfb, 0x4e,
-	0xae, 0xf0, 0x0c, 0xa9, 0xec, 0xd6, 0x8b, 0x07, 0xa4, 0x72, 0x2f, 0x31, 0xae, 0x04, 0x4c, 0x40,
-	0xbd, 0x4d, 0x79, 0xd3, 0x5e, 0x31, 0x4a, 0x9c, 0x00, 0xca, 0xe4, 0x46, 0x6e, 0x2e, 0x2b, 0xe0,
-	0x42, 0x87, 0xf5, 0xe0, 0x11, 0x15, 0x7b, 0x86, 0x02, 0x13, 0x20, 0x9f, 0x40, 0x96, 0x4b, 0x05,
-	0xbe, 0x23, 0x7c, 0xa5, 0x0a, 0xf2, 0x09, 0xe3, 0x7b, 0xbb, 0x01, 0xdb, 0xdf, 0x7a, 0x01, 0x5e,
-	0x24, 0x7d, 0xd6, 0x75, 0xe9, 0xfe, 0xf8, 0xc8, 0x1e, 0x2f, 0xdb, 0x3b, 0x46, 0xf9, 0xff, 0x8a,
-	0x51, 0xb6, 0xb5, 0x53, 0x4a, 0x4b, 0xf7, 0xf0, 0x19, 0xe1, 0x85, 0x2a, 0x48, 0x17, 0xc2, 0xc0,
-	0xf7, 0xe8, 0x68, 0x61, 0x0d, 0x84, 0xa0, 0x2d, 0x10, 0xf6, 0x86, 0x69, 0x2d, 0x0d, 0xac, 0x7c,
-	0x2b, 0x73, 0x65, 0xa4, 0x96, 0xdf, 0x10, 0xbe, 0x5c, 0x05, 0x79, 0x9f, 0x76, 0x40, 0x84, 0xd4,
-	0x03, 0x9d, 0xee, 0x3d, 0xd3, 0x52, 0x27, 0xa5, 0x28, 0xef, 0x9d, 0xd3, 0x09, 0x4b, 0x37, 0xf0,
-	0x15, 0xe1, 0x4b, 0x55, 0x90, 0x9b, 0x3b, 0x0f, 0x75, 0xea, 0x5b, 0xa6, 0xd5, 0xf4, 0xbc, 0x92,
-	0xbe, 0x3b, 0x6f, 0x4c, 0xaa, 0xfb, 0x1a, 0xe1, 0x33, 0x2e, 0xd0, 0x30, 0x0c, 0x0e, 0xb6, 0x7a,
-	0xd0, 0x95, 0xc2, 0xbe, 0x69, 0xd8, 0x26, 0x19, 0x46, 0x69, 0xad, 0x16, 0x41, 0xa7, 0x66, 0x60,
-	0xb9, 0xd9, 0xac, 0x03, 0xe5, 0x5e, 0xbb, 0x2c, 0x25, 0xf7, 0x1b, 0x91, 0x04, 0x61, 0x38, 0x03,
-	0x35, 0x64, 0xbe, 0x19, 0xa8, 0x0d, 0x98, 0xea, 0x9e, 0x64, 0x34, 0x1c, 0xf3, 0xdb, 0xc8, 0x31,
-	0x57, 0xfe, 0xa6, 0x58, 0x99, 0x2b, 0x63, 0xea, 0x08, 0xab, 0x20, 0x0b, 0x1e, 0xa1, 0x86, 0xcc,
-	0x77, 0x84, 0xda, 0x80, 0x54, 0xee, 0x2d, 0xc2, 0xe7, 0xd4, 0x45, 0x53, 0x09, 0x22, 0x21, 0x81,
-	0xdb, 0x6b, 0xb9, 0xae, 0xa7, 0x31, 0xa5, 0xa4, 0x6e, 0x15, 0x83, 0x53, 0xa1, 0x37, 0x08, 0x9f,
-	0x4d, 0x7a, 0x24, 0xed, 0xcf, 0xd5, 0x1c, 0x8d, 0x35, 0xdb, 0x94, 0x6b, 0x85, 0xd8, 0xd4, 0xe6,
-	0x3d, 0xc2, 0xe7, 0x1f, 0x44, 0xbc, 0x05, 0x59, 0x1f, 0xb3, 0x2d, 0xce, 0x62, 0xca, 0xe8, 0x76,
-	0x41, 0x7a, 0xca, 0xa9, 0x06, 0x85, 0x9c, 0x66, 0xb1, 0x7c, 0x4e, 0xc7, 0xe9, 0xd4, 0x69, 0xf4,
-	0x94, 0x73, 0x61, 0x97, 0x83, 0x68, 0xab, 0xab, 0x6f, 0x74, 0x5b, 0x0b, 0xc3, 0xa7, 0x9c, 0x0e,
-	0xcd, 0xf7, 0x94, 0xd3, 0x27, 0xcc, 0x4c, 0x0a, 0x01, 0xdd, 0x66, 0x66, 0xf2, 0x26, 0x86, 0xa6,
-	0x93, 0x42, 0x07, 0xe7, 0x9d, 0x14, 0xfa, 0x0c, 0x65, 0xb9, 0x11, 0xf4, 0x07, 0xc4, 0x3a, 0x1c,
-	0x10, 0xeb, 0x68, 0x40, 0xd0, 0xab, 0x98, 0xa0, 0x2f, 0x31, 0x41, 0x3f, 0x62, 0x82, 0xfa, 0x31,
-	0x41, 0x3f, 0x63, 0x82, 0x7e, 0xc5, 0xc4, 0x3a, 0x8a, 0x09, 0x7a, 0x37, 0x24, 0x56, 0x7f, 0x48,
-	0xac, 0xc3, 0x21, 0xb1, 0x9e, 0xae, 0xb4, 0xd8, 0xa4, 0xbc, 0xcf, 0x4e, 0x78, 0x99, 0xaf, 0x65,
-	0xff, 0x1b, 0xff, 0xfd, 0x79, 0x96, 0x5f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0x50,
-	0xae, 0x2c, 0x0c, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x73, 0xcb, 0x6f, 0x38, 0xfd, 0xde, 0x30, 0x08, 0x41, 0x85, 0xcc, 0xdb, 0x9e, 0xa8,
+	0x45, 0x2a, 0xa2, 0xe5, 0xa5, 0x69, 0x9a, 0xa6, 0x88, 0x04, 0x51, 0x07, 0x81, 0xc4, 0x82, 0x2e,
+	0xce, 0xd3, 0xd4, 0xaa, 0x63, 0x9b, 0xbb, 0x73, 0x4a, 0x27, 0x18, 0x91, 0x90, 0x10, 0x48, 0x4c,
+	0x48, 0xb0, 0xb0, 0x80, 0xc4, 0xca, 0x8a, 0xc4, 0xc6, 0xd8, 0xb1, 0x23, 0x4d, 0x17, 0xc6, 0xfe,
+	0x09, 0x28, 0x75, 0xce, 0xb5, 0x9d, 0x4b, 0xb9, 0x73, 0xba, 0x35, 0xea, 0x7d, 0xbe, 0xf7, 0xb9,
+	0xb3, 0xef, 0xb9, 0x47, 0xc6, 0xd3, 0x1c, 0xba, 0x81, 0x4f, 0x89, 0x5b, 0x62, 0x40, 0x7b, 0x40,
+	0x4b, 0x24, 0x70, 0x4a, 0xa4, 0xdd, 0x75, 0xbc, 0xc1, 0x6f, 0xc7, 0x86, 0x52, 0x6f, 0xba, 0x34,
+	0xfc, 0xb3, 0x18, 0x50, 0x9f, 0xfb, 0xc6, 0x65, 0x81, 0x14, 0x23, 0xa4, 0x48, 0x02, 0xa7, 0x98,
+	0x44, 0x8a, 0xbd, 0xe9, 0xa9, 0x39, 0x95, 0x5c, 0x0a, 0x4f, 0x42, 0x60, 0xfc, 0x31, 0x05, 0x16,
+	0xf8, 0x1e, 0x1b, 0x4e, 0x30, 0xf3, 0xe1, 0x22, 0xfe, 0xbb, 0x3c, 0x18, 0xda, 0x8c, 0x86, 0x1a,
+	0xef, 0x11, 0x3e, 0xb5, 0x04, 0xcc, 0xa6, 0x4e, 0x0b, 0x1a, 0x21, 0x27, 0x2d, 0x17, 0x9a, 0x9c,
+	0x70, 0x30, 0x16, 0x8a, 0x0a, 0x2e, 0x45, 0x19, 0x6a, 0x45, 0x53, 0x4f, 0x95, 0x27, 0x48, 0x88,
+	0xa4, 0x2f, 0x15, 0x8c, 0x77, 0x08, 0x9f, 0x14, 0x43, 0x56, 0x1c, 0xc6, 0x7d, 0xba, 0xb5, 0xe2,
+	0x33, 0x6e, 0xdc, 0xd2, 0x0a, 0x4f, 0x90, 0xc2, 0x6e, 0x21, 0x7f, 0x40, 0x2c, 0xf7, 0x0c, 0xe3,
+	0x8a, 0xeb, 0x33, 0x68, 0xae, 0x13, 0xda, 0x36, 0x66, 0x95, 0x12, 0x0f, 0x01, 0x61, 0x72, 0x55,
+	0x9b, 0x4b, 0x0a, 0x58, 0xd0, 0xf5, 0x7b, 0x70, 0x9f, 0xb0, 0x0d, 0x45, 0x81, 0x43, 0x40, 0x4f,
+	0x20, 0xc9, 0xc5, 0x02, 0xdf, 0x11, 0xbe, 0x50, 0x03, 0xfe, 0xd0, 0xa7, 0x1b, 0x6b, 0xae, 0xbf,
+	0x59, 0x7d, 0x0a, 0x76, 0xc8, 0x1d, 0xdf, 0xb3, 0xc8, 0xe6, 0x70, 0xcb, 0x1e, 0xcc, 0x18, 0x75,
+	0xa5, 0xfc, 0x3f, 0xc5, 0x08, 0xdb, 0xc6, 0x31, 0xa5, 0xc5, 0x6b, 0xf8, 0x88, 0xf0, 0xe9, 0x1a,
+	0x70, 0x0b, 0x02, 0xd7, 0xb1, 0xc9, 0x60, 0x60, 0x03, 0x18, 0x23, 0x1d, 0x60, 0xc6, 0xa2, 0xea,
+	0x5c, 0x12, 0x58, 0xf8, 0x56, 0x26, 0xca, 0x88, 0x2d, 0xbf, 0x21, 0x7c, 0xbe, 0x06, 0xfc, 0x2e,
+	0xe9, 0x02, 0x0b, 0x88, 0x0d, 0x32, 0xdd, 0x3b, 0xaa, 0x53, 0x1d, 0x95, 0x22, 0xbc, 0xeb, 0xc7,
+	0x13, 0x16, 0x2f, 0xe0, 0x0b, 0xc2, 0x67, 0x6b, 0xc0, 0x97, 0xea, 0xab, 0x32, 0xf5, 0xaa, 0xea,
+	0x6c, 0x72, 0x5e, 0x48, 0x2f, 0x4f, 0x1a, 0x13, 0xeb, 0xbe, 0x40, 0xf8, 0x1f, 0x0b, 0x48, 0x10,
+	0xb8, 0x5b, 0xd5, 0x1e, 0x78, 0x9c, 0x19, 0xd7, 0x14, 0x8f, 0x49, 0x82, 0x11, 0x5a, 0x73, 0x79,
+	0xd0, 0x54, 0x0d, 0x2c, 0xb7, 0xdb, 0x4d, 0x20, 0xd4, 0x5e, 0x2f, 0x73, 0x4e, 0x9d, 0x56, 0xc8,
+	0x81, 0x29, 0xd6, 0x40, 0x09, 0xa9, 0x57, 0x03, 0xa5, 0x01, 0xa9, 0xd3, 0x13, 0x95, 0x86, 0x11,
+	0xbf, 0x45, 0x8d, 0xba, 0x32, 0x4e, 0xb1, 0x32, 0x51, 0x46, 0x6a, 0x0b, 0x6b, 0xc0, 0x73, 0x6e,
+	0xa1, 0x84, 0xd4, 0xdb, 0x42, 0x69, 0x40, 0x2c, 0xf7, 0x0a, 0xe1, 0xff, 0xc4, 0x45, 0x53, 0x71,
+	0x43, 0xc6, 0x81, 0x1a, 0xf3, 0x5a, 0xd7, 0xd3, 0x90, 0x12, 0x52, 0xd7, 0xf3, 0xc1, 0xb1, 0xd0,
+	0x4b, 0x84, 0xff, 0x8d, 0xce, 0x48, 0x7c, 0x3e, 0xe7, 0x34, 0x0e, 0x56, 0xf6, 0x50, 0xce, 0xe7,
+	0x62, 0x63, 0x9b, 0x37, 0x08, 0xff, 0x7f, 0x2f, 0xa4, 0x1d, 0x48, 0xfa, 0xa8, 0x2d, 0x31, 0x8b,
+	0x09, 0xa3, 0x1b, 0x39, 0xe9, 0x94, 0x53, 0x03, 0x72, 0x39, 0x35, 0x60, 0x12, 0xa7, 0x06, 0x8c,
+	0x75, 0x1a, 0xb4, 0x72, 0x16, 0xac, 0x51, 0x60, 0xeb, 0xe2, 0xea, 0x1b, 0xdc, 0xd6, 0x4c, 0xb1,
+	0x95, 0x93, 0xa1, 0x7a, 0xad, 0x9c, 0x3c, 0x21, 0x53, 0x29, 0x18, 0x78, 0xed, 0x44, 0xe5, 0x8d,
+	0x0c, 0x55, 0x2b, 0x85, 0x0c, 0xd6, 0xad, 0x14, 0xf2, 0x0c, 0x69, 0xc3, 0x79, 0xd0, 0x6e, 0xad,
+	0x86, 0x10, 0x2a, 0x57, 0x0a, 0x09, 0x99, 0xaf, 0xe1, 0x4c, 0x05, 0xa4, 0x5e, 0xbb, 0xc1, 0x39,
+	0xd9, 0xf2, 0x48, 0xd7, 0xb1, 0x2b, 0xbe, 0xb7, 0xe6, 0x74, 0x14, 0x5f, 0xbb, 0x2c, 0xa6, 0xf7,
+	0xda, 0x8d, 0xd2, 0x29, 0xa7, 0x66, 0x3e, 0xa7, 0xe6, 0x44, 0x4e, 0xcd, 0xf1, 0x4e, 0xd1, 0x43,
+	0x74, 0x81, 0x43, 0x5a, 0x4b, 0xf5, 0x21, 0x8e, 0x90, 0xba, 0x0f, 0x51, 0x12, 0x10, 0xcb, 0xbd,
+	0x45, 0xf8, 0x44, 0xdd, 0x61, 0x99, 0x1d, 0x53, 0x5b, 0xf3, 0x08, 0x27, 0xc4, 0x6e, 0xe6, 0xc5,
+	0x63, 0xad, 0xcf, 0x08, 0x9f, 0x19, 0xf9, 0xff, 0xb0, 0x5f, 0x36, 0x96, 0xf2, 0xc5, 0x0f, 0x71,
+	0x21, 0x59, 0x9d, 0x30, 0x25, 0x73, 0x63, 0x0e, 0x36, 0x39, 0x6e, 0x3e, 0x95, 0x6f, 0xcc, 0x14,
+	0xa5, 0x7b, 0x63, 0x66, 0xe0, 0x94, 0x90, 0x05, 0x1e, 0xe9, 0x6a, 0x0b, 0x65, 0x28, 0x3d, 0xa1,
+	0x11, 0x38, 0x16, 0xfa, 0x8a, 0xf0, 0x39, 0x0b, 0x6c, 0x97, 0x38, 0xdd, 0x4c, 0xe7, 0xb1, 0xec,
+	0x80, 0xdb, 0x66, 0xc6, 0x8a, 0xe2, 0x04, 0xe3, 0x23, 0x84, 0xea, 0xed, 0x63, 0x48, 0x12, 0xde,
+	0x8b, 0xee, 0xf6, 0xae, 0x59, 0xd8, 0xd9, 0x35, 0x0b, 0xfb, 0xbb, 0x26, 0x7a, 0xde, 0x37, 0xd1,
+	0xa7, 0xbe, 0x89, 0x7e, 0xf4, 0x4d, 0xb4, 0xdd, 0x37, 0xd1, 0xcf, 0xbe, 0x89, 0x7e, 0xf5, 0xcd,
+	0xc2, 0x7e, 0xdf, 0x44, 0xaf, 0xf7, 0xcc, 0xc2, 0xf6, 0x9e, 0x59, 0xd8, 0xd9, 0x33, 0x0b, 0x8f,
+	0x66, 0x3b, 0xfe, 0xa1, 0x84, 0xe3, 0x1f, 0xf1, 0x61, 0x64, 0x3e, 0xf9, 0xbb, 0xf5, 0xd7, 0xc1,
+	0x57, 0x91, 0x2b, 0xbf, 0x07, 0x00, 0x53, 0x9d, 0xb4, 0xb5, 0xab, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +160,28 @@ type AdminServiceClient interface {
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// DescribeShardQueues returns the in-memory state of the task queue processors of a shard.
+	DescribeShardQueues(ctx context.Context, in *DescribeShardQueuesRequest, opts ...grpc.CallOption) (*DescribeShardQueuesResponse, error)
+	// GetDynamicConfig returns the persisted values of a dynamic config key.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig persists a dynamic config value, replacing the value with the same key and constraints.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig removes a persisted dynamic config value.
+	DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error)
+	// ListDynamicConfig returns all persisted dynamic config values.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the audit history of the persisted dynamic config changes.
+	ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error)
+	// DeleteNamespace marks a namespace as deleted and starts a system workflow which terminates and deletes all of
+	// its executions, task queues and visibility records before removing the namespace itself.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// RenameNamespace changes the name of a namespace while keeping its id. The previous name stays
+	// resolvable as an alias of the namespace until the alias expires.
+	RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error)
+	// ReclaimSearchAttributeFields clears the values of the fields released by removed search attributes of
+	// the namespace from its documents and makes the fields available to new search attributes of the namespace.
+	// Fields must be released long enough ago for every namespace cache to stop writing values into them.
+	ReclaimSearchAttributeFields(ctx context.Context, in *ReclaimSearchAttributeFieldsRequest, opts ...grpc.CallOption) (*ReclaimSearchAttributeFieldsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -322,6 +354,87 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeShardQueues(ctx context.Context, in *DescribeShardQueuesRequest, opts ...grpc.CallOption) (*DescribeShardQueuesResponse, error) {
+	out := new(DescribeShardQueuesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeShardQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error) {
+	out := new(DeleteDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error) {
+	out := new(ListDynamicConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error) {
+	out := new(RenameNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReclaimSearchAttributeFields(ctx context.Context, in *ReclaimSearchAttributeFieldsRequest, opts ...grpc.CallOption) (*ReclaimSearchAttributeFieldsResponse, error) {
+	out := new(ReclaimSearchAttributeFieldsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ReclaimSearchAttributeFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -363,6 +476,28 @@ type AdminServiceServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// DescribeShardQueues returns the in-memory state of the task queue processors of a shard.
+	DescribeShardQueues(context.Context, *DescribeShardQueuesRequest) (*DescribeShardQueuesResponse, error)
+	// GetDynamicConfig returns the persisted values of a dynamic config key.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig persists a dynamic config value, replacing the value with the same key and constraints.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig removes a persisted dynamic config value.
+	DeleteDynamicConfig(context.Context, *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error)
+	// ListDynamicConfig returns all persisted dynamic config values.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the audit history of the persisted dynamic config changes.
+	ListDynamicConfigHistory(context.Context, *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error)
+	// DeleteNamespace marks a namespace as deleted and starts a system workflow which terminates and deletes all of
+	// its executions, task queues and visibility records before removing the namespace itself.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// RenameNamespace changes the name of a namespace while keeping its id. The previous name stays
+	// resolvable as an alias of the namespace until the alias expires.
+	RenameNamespace(context.Context, *RenameNamespaceRequest) (*RenameNamespaceResponse, error)
+	// ReclaimSearchAttributeFields clears the values of the fields released by removed search attributes of
+	// the namespace from its documents and makes the fields available to new search attributes of the namespace.
+	// Fields must be released long enough ago for every namespace cache to stop writing values into them.
+	ReclaimSearchAttributeFields(context.Context, *ReclaimSearchAttributeFieldsRequest) (*ReclaimSearchAttributeFieldsResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -423,6 +558,33 @@ func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) DescribeShardQueues(ctx context.Context, req *DescribeShardQueuesRequest) (*DescribeShardQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShardQueues not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfig(ctx context.Context, req *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteDynamicConfig(ctx context.Context, req *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfigHistory(ctx context.Context, req *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) RenameNamespace(ctx context.Context, req *RenameNamespaceRequest) (*RenameNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) ReclaimSearchAttributeFields(ctx context.Context, req *ReclaimSearchAttributeFieldsRequest) (*ReclaimSearchAttributeFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimSearchAttributeFields not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -752,6 +914,168 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeShardQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeShardQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeShardQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeShardQueues(ctx, req.(*DescribeShardQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, req.(*DeleteDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, req.(*ListDynamicConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, req.(*RenameNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReclaimSearchAttributeFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReclaimSearchAttributeFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReclaimSearchAttributeFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReclaimSearchAttributeFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReclaimSearchAttributeFields(ctx, req.(*ReclaimSearchAttributeFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -828,6 +1152,42 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "DescribeShardQueues",
+			Handler:    _AdminService_DescribeShardQueues_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfig",
+			Handler:    _AdminService_DeleteDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigHistory",
+			Handler:    _AdminService_ListDynamicConfigHistory_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AdminService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "RenameNamespace",
+			Handler:    _AdminService_RenameNamespace_Handler,
+		},
+		{
+			MethodName: "ReclaimSearchAttributeFields",
+			Handler:    _AdminService_ReclaimSearchAttributeFields_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
@@ -100,6 +100,46 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// DeleteDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) DeleteDynamicConfig(ctx context.Context, in *adminservice.DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.DeleteDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfig indicates an expected call of DeleteDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) DeleteDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteDynamicConfig), varargs...)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceClient) DeleteNamespace(ctx context.Context, in *adminservice.DeleteNamespaceRequest, opts ...grpc.CallOption) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceClientMockRecorder) DeleteNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteNamespace), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +200,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeShardQueues mocks base method.
+func (m *MockAdminServiceClient) DescribeShardQueues(ctx context.Context, in *adminservice.DescribeShardQueuesRequest, opts ...grpc.CallOption) (*adminservice.DescribeShardQueuesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeShardQueues", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeShardQueuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardQueues indicates an expected call of DescribeShardQueues.
+func (mr *MockAdminServiceClientMockRecorder) DescribeShardQueues(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeShardQueues), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +260,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +360,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *adminservice.ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigHistory), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +460,26 @@ func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
 }
 
+// ReclaimSearchAttributeFields mocks base method.
+func (m *MockAdminServiceClient) ReclaimSearchAttributeFields(ctx context.Context, in *adminservice.ReclaimSearchAttributeFieldsRequest, opts ...grpc.CallOption) (*adminservice.ReclaimSearchAttributeFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReclaimSearchAttributeFields", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReclaimSearchAttributeFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReclaimSearchAttributeFields indicates an expected call of ReclaimSearchAttributeFields.
+func (mr *MockAdminServiceClientMockRecorder) ReclaimSearchAttributeFields(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimSearchAttributeFields", reflect.TypeOf((*MockAdminServiceClient)(nil).ReclaimSearchAttributeFields), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +540,26 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceClient) RenameNamespace(ctx context.Context, in *adminservice.RenameNamespaceRequest, opts ...grpc.CallOption) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceClientMockRecorder) RenameNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).RenameNamespace), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +580,26 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfig(ctx context.Context, in *adminservice.SetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfig), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -473,6 +653,36 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// DeleteDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) DeleteDynamicConfig(arg0 context.Context, arg1 *adminservice.DeleteDynamicConfigRequest) (*adminservice.DeleteDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfig indicates an expected call of DeleteDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) DeleteDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteDynamicConfig), arg0, arg1)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceServer) DeleteNamespace(arg0 context.Context, arg1 *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceServerMockRecorder) DeleteNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteNamespace), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -518,6 +728,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeShardQueues mocks base method.
+func (m *MockAdminServiceServer) DescribeShardQueues(arg0 context.Context, arg1 *adminservice.DescribeShardQueuesRequest) (*adminservice.DescribeShardQueuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardQueues", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeShardQueuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardQueues indicates an expected call of DescribeShardQueues.
+func (mr *MockAdminServiceServerMockRecorder) DescribeShardQueues(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeShardQueues), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -548,6 +773,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -608,6 +848,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigHistory(arg0 context.Context, arg1 *adminservice.ListDynamicConfigHistoryRequest) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigHistory), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -653,6 +923,21 @@ func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// ReclaimSearchAttributeFields mocks base method.
+func (m *MockAdminServiceServer) ReclaimSearchAttributeFields(arg0 context.Context, arg1 *adminservice.ReclaimSearchAttributeFieldsRequest) (*adminservice.ReclaimSearchAttributeFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReclaimSearchAttributeFields", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReclaimSearchAttributeFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReclaimSearchAttributeFields indicates an expected call of ReclaimSearchAttributeFields.
+func (mr *MockAdminServiceServerMockRecorder) ReclaimSearchAttributeFields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimSearchAttributeFields", reflect.TypeOf((*MockAdminServiceServer)(nil).ReclaimSearchAttributeFields), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +983,21 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceServer) RenameNamespace(arg0 context.Context, arg1 *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceServerMockRecorder) RenameNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).RenameNamespace), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -712,3 +1012,18 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfig(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRequest) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfig), arg0, arg1)
+}
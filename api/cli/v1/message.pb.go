@@ -139,6 +139,8 @@ type WorkflowExecutionInfo struct {
 	SearchAttributes     *SearchAttributes           `protobuf:"bytes,11,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	AutoResetPoints      *v1.ResetPoints             `protobuf:"bytes,12,opt,name=auto_reset_points,json=autoResetPoints,proto3" json:"auto_reset_points,omitempty"`
 	StateTransitionCount int64                       `protobuf:"varint,13,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	CronSchedule         string                      `protobuf:"bytes,14,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	CronTimeZone         string                      `protobuf:"bytes,15,opt,name=cron_time_zone,json=cronTimeZone,proto3" json:"cron_time_zone,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -264,6 +266,20 @@ func (m *WorkflowExecutionInfo) GetStateTransitionCount() int64 {
 	return 0
 }
 
+func (m *WorkflowExecutionInfo) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetCronTimeZone() string {
+	if m != nil {
+		return m.CronTimeZone
+	}
+	return ""
+}
+
 type PendingActivityInfo struct {
 	ActivityId         string                   `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
 	ActivityType       *v11.ActivityType        `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
@@ -606,87 +622,90 @@ func init() {
 }
 
 var fileDescriptor_ad471f2cfe5ee207 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x63, 0x4b, 0xfe, 0x75, 0x7c, 0x91, 0x3c, 0xb9, 0xfc, 0x84, 0xd3, 0x32, 0x8e, 0x9b,
-	0x14, 0x0e, 0x52, 0x50, 0xb1, 0xd3, 0x45, 0x9a, 0x2e, 0x52, 0xc7, 0xb9, 0x54, 0x40, 0x52, 0xa4,
-	0xb4, 0x81, 0xa0, 0x41, 0x11, 0x62, 0x4c, 0x1e, 0xc9, 0x83, 0xf0, 0x06, 0xce, 0xd0, 0xb1, 0x76,
-	0x79, 0x81, 0x02, 0x79, 0x8c, 0x2e, 0xfa, 0x04, 0x45, 0x1f, 0xa0, 0xcb, 0x2c, 0xb3, 0x6b, 0xa3,
-	0x74, 0xd1, 0x65, 0x1e, 0xa1, 0x98, 0x43, 0x52, 0xb2, 0x2d, 0x59, 0x95, 0xd3, 0x9d, 0xe6, 0x3b,
-	0xe7, 0xfb, 0xe6, 0xf0, 0x9c, 0xf9, 0x86, 0x14, 0xac, 0x29, 0x0c, 0x93, 0x38, 0xe5, 0x41, 0x53,
-	0x62, 0xba, 0x8f, 0x69, 0x93, 0x27, 0xa2, 0xe9, 0x05, 0xa2, 0xb9, 0xbf, 0xde, 0x0c, 0x51, 0x4a,
-	0xde, 0x41, 0x3b, 0x49, 0x63, 0x15, 0xb3, 0xe5, 0x32, 0xd3, 0xce, 0x33, 0x6d, 0x9e, 0x08, 0xdb,
-	0x0b, 0x84, 0xbd, 0xbf, 0xbe, 0x7c, 0xa9, 0x13, 0xc7, 0x9d, 0x00, 0x9b, 0x94, 0xb9, 0x9b, 0xb5,
-	0x9b, 0x4a, 0x84, 0x28, 0x15, 0x0f, 0x93, 0x9c, 0xbc, 0x7c, 0xd9, 0xc7, 0x04, 0x23, 0x1f, 0x23,
-	0x4f, 0xa0, 0x6c, 0x76, 0xe2, 0x4e, 0x4c, 0x38, 0xfd, 0x2a, 0x52, 0xae, 0xf4, 0x2b, 0xa1, 0x12,
-	0xe2, 0x30, 0x8c, 0xa3, 0xa1, 0x2a, 0x8e, 0x65, 0x61, 0x94, 0x85, 0x52, 0x27, 0xbd, 0x8c, 0xd3,
-	0x17, 0xed, 0x20, 0x7e, 0x59, 0x64, 0x7d, 0x7e, 0x24, 0xab, 0x0c, 0x0e, 0xa9, 0xad, 0xfe, 0x32,
-	0x0d, 0x97, 0xef, 0xa1, 0xf4, 0x52, 0xb1, 0x8b, 0x4f, 0x8b, 0xac, 0xfb, 0x07, 0xe8, 0x65, 0x4a,
-	0xc4, 0x91, 0x83, 0x32, 0x89, 0x23, 0x89, 0xec, 0x47, 0x68, 0x60, 0x09, 0xba, 0x5e, 0x1c, 0xb5,
-	0x45, 0xc7, 0x34, 0x56, 0x8c, 0xb5, 0xb9, 0x8d, 0x75, 0xbb, 0xdf, 0x14, 0xdd, 0x8d, 0x7e, 0x15,
-	0xfb, 0xeb, 0xf6, 0x90, 0xdc, 0x16, 0x11, 0x9d, 0x3a, 0x1e, 0x05, 0x98, 0x80, 0xff, 0x97, 0x3c,
-	0x77, 0xb0, 0x8d, 0x88, 0xda, 0xb1, 0x79, 0xe6, 0xf8, 0x26, 0x43, 0x9d, 0x1f, 0xde, 0xa6, 0x15,
-	0xb5, 0x63, 0xe7, 0xfc, 0xcb, 0x51, 0x30, 0x7b, 0x0e, 0x4c, 0x4f, 0x41, 0x44, 0x1d, 0x97, 0x7b,
-	0x4a, 0xec, 0x0b, 0x25, 0x50, 0x9a, 0xd3, 0x2b, 0xd3, 0x6b, 0x73, 0x1b, 0xcd, 0x71, 0xbb, 0x3c,
-	0xc9, 0x59, 0x9b, 0x39, 0xa9, 0x4b, 0x7b, 0x2c, 0x25, 0x47, 0x40, 0x81, 0x92, 0x3d, 0x87, 0x46,
-	0xa9, 0xef, 0xed, 0x89, 0xc0, 0x4f, 0x31, 0x32, 0x67, 0x48, 0xfd, 0xe6, 0xc9, 0x8d, 0x2a, 0xb4,
-	0xb7, 0x34, 0xe1, 0xe8, 0x53, 0xd4, 0x93, 0x43, 0xa1, 0x14, 0xa3, 0xd5, 0x0f, 0x55, 0x38, 0x3f,
-	0xf2, 0x81, 0xd9, 0x43, 0xa8, 0xf5, 0x7b, 0x57, 0xcc, 0xe6, 0xda, 0xd1, 0x2d, 0xf3, 0x03, 0x35,
-	0xb2, 0x65, 0xce, 0x80, 0xcb, 0x6e, 0xc1, 0x8c, 0xea, 0x26, 0x58, 0xb4, 0xfe, 0xca, 0xbf, 0x69,
-	0xec, 0x74, 0x13, 0x74, 0x88, 0xc1, 0xee, 0x00, 0x48, 0xc5, 0x53, 0xe5, 0xea, 0xb3, 0x6f, 0x4e,
-	0x13, 0x7f, 0xd9, 0xce, 0x8d, 0x61, 0x97, 0xc6, 0xb0, 0x77, 0x4a, 0x63, 0xdc, 0x9d, 0x79, 0xfd,
-	0xc7, 0x25, 0xc3, 0xa9, 0x11, 0x47, 0xa3, 0x5a, 0xc0, 0x0b, 0x62, 0x89, 0xb9, 0xc0, 0xcc, 0xa4,
-	0x02, 0xc4, 0x21, 0x81, 0x07, 0x50, 0x95, 0x8a, 0xab, 0x4c, 0x9a, 0x95, 0x15, 0x63, 0x6d, 0x71,
-	0xc3, 0x3e, 0x5a, 0x3d, 0x99, 0x65, 0x64, 0x03, 0xb6, 0x89, 0xe5, 0x14, 0x6c, 0x76, 0x15, 0x16,
-	0xf7, 0x84, 0x54, 0x71, 0xda, 0x75, 0x03, 0x8c, 0x3a, 0x6a, 0xcf, 0xac, 0xae, 0x18, 0x6b, 0xd3,
-	0xce, 0x42, 0x81, 0x3e, 0x22, 0x90, 0xd9, 0x70, 0x36, 0xe1, 0x29, 0x46, 0xca, 0x8d, 0x78, 0x88,
-	0x32, 0xe1, 0x1e, 0xba, 0xc2, 0x37, 0x67, 0x57, 0x8c, 0xb5, 0x9a, 0xb3, 0x94, 0x87, 0xbe, 0x2b,
-	0x23, 0x2d, 0x9f, 0xed, 0x40, 0xa3, 0xc8, 0x1f, 0x8c, 0xea, 0x7f, 0xa7, 0x1d, 0x55, 0x3d, 0x97,
-	0xe8, 0x03, 0xec, 0x21, 0x2c, 0x0e, 0x5c, 0x43, 0x9d, 0xab, 0x4d, 0xd8, 0xb9, 0x85, 0x3e, 0x8f,
-	0xba, 0x77, 0x03, 0x66, 0x42, 0x0c, 0x63, 0x13, 0x88, 0xfe, 0xc9, 0x49, 0x25, 0x3d, 0xc6, 0x30,
-	0x76, 0x28, 0x93, 0xfd, 0x00, 0x4b, 0x12, 0x79, 0xea, 0xed, 0xb9, 0x5c, 0xa9, 0x54, 0xec, 0x66,
-	0x0a, 0xa5, 0x39, 0x47, 0xf4, 0x2f, 0xc6, 0xb9, 0x69, 0x9b, 0x48, 0x9b, 0x7d, 0x8e, 0xd3, 0x90,
-	0xc7, 0x10, 0xf6, 0x3d, 0x2c, 0xf1, 0x4c, 0xc5, 0x6e, 0x8a, 0x12, 0x95, 0x9b, 0xc4, 0x22, 0x52,
-	0xd2, 0x9c, 0x27, 0xe9, 0xab, 0x27, 0x5b, 0xc9, 0xd1, 0xd9, 0x4f, 0x28, 0xd9, 0xa9, 0x6b, 0xfe,
-	0x21, 0x80, 0x7d, 0x09, 0x17, 0xf4, 0x7c, 0xd1, 0x55, 0x29, 0x8f, 0xa4, 0x28, 0x2e, 0xb3, 0x2c,
-	0x52, 0xe6, 0x02, 0x4d, 0xf7, 0x1c, 0x45, 0x77, 0xfa, 0xc1, 0x2d, 0x1d, 0x5b, 0xfd, 0xab, 0x02,
-	0x67, 0x47, 0xb8, 0x9f, 0x5d, 0x82, 0xb9, 0xe2, 0x0a, 0xe9, 0xea, 0xa1, 0x1b, 0x34, 0x74, 0x28,
-	0xa1, 0x96, 0xcf, 0x5a, 0xb0, 0xd0, 0x4f, 0x98, 0xc4, 0x51, 0xa5, 0x3a, 0x39, 0x6a, 0x9e, 0x1f,
-	0x5a, 0xb1, 0x4d, 0xa8, 0x50, 0x6d, 0x64, 0xaa, 0xc5, 0x8d, 0xeb, 0x27, 0x1c, 0xeb, 0x63, 0x65,
-	0xea, 0x43, 0x8d, 0x4e, 0xce, 0x64, 0xd7, 0x61, 0x69, 0x0f, 0x79, 0xaa, 0x76, 0x91, 0x2b, 0xd7,
-	0x47, 0xc5, 0x45, 0x20, 0xc9, 0x62, 0x35, 0xa7, 0xd1, 0x0f, 0xdc, 0xcb, 0x71, 0xf6, 0x04, 0xce,
-	0x06, 0x5c, 0x2a, 0x77, 0xc0, 0xa0, 0x73, 0x55, 0x99, 0xf0, 0x5c, 0x2d, 0x69, 0xf2, 0xb7, 0x25,
-	0x97, 0xce, 0xd6, 0x23, 0x20, 0xd0, 0x25, 0xb3, 0xa3, 0x9f, 0xeb, 0x55, 0x27, 0xd4, 0xab, 0x6b,
-	0xea, 0x76, 0xce, 0x24, 0x35, 0x13, 0x66, 0xb9, 0xd2, 0x3d, 0x50, 0x64, 0xb6, 0x8a, 0x53, 0x2e,
-	0xd9, 0x35, 0x68, 0x84, 0xfc, 0x40, 0x84, 0x59, 0xe8, 0x16, 0x90, 0x24, 0x8b, 0x55, 0x9c, 0x7a,
-	0x81, 0x6f, 0x16, 0xb0, 0xf6, 0x8d, 0xf4, 0xf6, 0xd0, 0xcf, 0x82, 0xb2, 0x9e, 0x89, 0x7d, 0xd3,
-	0xe7, 0x51, 0x35, 0x2d, 0xa8, 0xe3, 0x41, 0x22, 0x52, 0x3e, 0x70, 0x20, 0x4c, 0xa8, 0xb4, 0x38,
-	0x20, 0x16, 0x17, 0xd8, 0x3c, 0xb5, 0xa9, 0xcd, 0x45, 0x90, 0xa5, 0x58, 0x78, 0xe9, 0xb3, 0x71,
-	0x5e, 0x7a, 0x90, 0xa7, 0x3a, 0x73, 0x9a, 0x58, 0x2c, 0xd8, 0x0d, 0x38, 0x47, 0x3a, 0xda, 0x1b,
-	0x98, 0xba, 0xc2, 0xc7, 0x48, 0x09, 0xd5, 0x25, 0x03, 0xd5, 0x1c, 0xa6, 0x63, 0x4f, 0x29, 0xd4,
-	0x2a, 0x22, 0xab, 0xbf, 0x19, 0xd0, 0x38, 0x6e, 0x4b, 0xd6, 0x86, 0x45, 0x11, 0xf9, 0x78, 0x80,
-	0xbe, 0xdb, 0x16, 0x18, 0xf8, 0xd2, 0x34, 0xe8, 0x65, 0x76, 0xe7, 0x34, 0xe6, 0xb6, 0x5b, 0xb9,
-	0xc4, 0x03, 0x52, 0xb8, 0x1f, 0xa9, 0xb4, 0xeb, 0x2c, 0x88, 0xc3, 0xd8, 0xf2, 0x37, 0xc0, 0x86,
-	0x93, 0x58, 0x03, 0xa6, 0x5f, 0x60, 0xb7, 0x70, 0x96, 0xfe, 0xc9, 0xce, 0x41, 0x65, 0x9f, 0x07,
-	0x59, 0x6e, 0xa5, 0x9a, 0x93, 0x2f, 0x6e, 0x9f, 0xb9, 0x65, 0xac, 0xfe, 0x6a, 0xc0, 0x6c, 0xf9,
-	0xf0, 0x26, 0xcc, 0x16, 0x1f, 0x39, 0x05, 0xb7, 0x5c, 0xb2, 0x0b, 0x50, 0x95, 0x71, 0x96, 0x7a,
-	0xa5, 0x40, 0xb1, 0xd2, 0x5e, 0x96, 0x8a, 0x7b, 0x2f, 0xf4, 0xcd, 0xe0, 0xe5, 0x2e, 0xab, 0x39,
-	0x40, 0xd0, 0x8e, 0x46, 0xd8, 0x57, 0x50, 0xf1, 0x78, 0x26, 0xcb, 0x97, 0xd2, 0x44, 0x03, 0xc9,
-	0x19, 0xec, 0x32, 0xcc, 0x17, 0xd3, 0xcc, 0x6f, 0x81, 0x0a, 0x89, 0xcf, 0x15, 0x98, 0xb6, 0xf7,
-	0xea, 0xab, 0x2a, 0x5c, 0xdc, 0xf4, 0xfd, 0xa1, 0x5b, 0xb1, 0xfc, 0xfc, 0xfa, 0x14, 0x80, 0xfa,
-	0x45, 0xaf, 0x99, 0xe2, 0x99, 0x6a, 0x84, 0xe8, 0xb7, 0x0b, 0xfb, 0xc9, 0x00, 0xd3, 0xcb, 0xa4,
-	0x8a, 0x43, 0x77, 0xf8, 0x36, 0x3e, 0x43, 0x03, 0xdb, 0x1e, 0x57, 0xf0, 0x98, 0xad, 0xed, 0x2d,
-	0xd2, 0x3d, 0x1e, 0xce, 0x87, 0x78, 0xc1, 0x1b, 0x19, 0xa4, 0x7a, 0x64, 0x57, 0x2a, 0x1c, 0x55,
-	0xcf, 0xf4, 0x7f, 0xab, 0x67, 0x9b, 0x74, 0x4f, 0xa8, 0x47, 0x8e, 0x0c, 0xb2, 0xe7, 0x30, 0x1b,
-	0xf2, 0x24, 0x11, 0x51, 0xa7, 0xf8, 0x16, 0xbb, 0xf7, 0xb1, 0xbb, 0x3f, 0xce, 0x65, 0xf2, 0xed,
-	0x4a, 0x51, 0x96, 0xc0, 0x45, 0xee, 0xfb, 0xee, 0x49, 0xdf, 0xb0, 0x95, 0x8f, 0xfd, 0x86, 0x35,
-	0xb9, 0xef, 0x8f, 0x8c, 0x2c, 0xb7, 0xe0, 0xe2, 0x98, 0xc1, 0x9c, 0xc6, 0x38, 0x5a, 0x6a, 0x4c,
-	0x4f, 0x4f, 0x25, 0x75, 0x1b, 0xe6, 0x0f, 0x37, 0xe8, 0x34, 0xdc, 0xbb, 0xcf, 0xde, 0xbc, 0xb3,
-	0xa6, 0xde, 0xbe, 0xb3, 0xa6, 0x3e, 0xbc, 0xb3, 0x8c, 0x57, 0x3d, 0xcb, 0xf8, 0xb9, 0x67, 0x19,
-	0xbf, 0xf7, 0x2c, 0xe3, 0x4d, 0xcf, 0x32, 0xfe, 0xec, 0x59, 0xc6, 0xdf, 0x3d, 0x6b, 0xea, 0x43,
-	0xcf, 0x32, 0x5e, 0xbf, 0xb7, 0xa6, 0xde, 0xbc, 0xb7, 0xa6, 0xde, 0xbe, 0xb7, 0xa6, 0x9e, 0x5d,
-	0xe9, 0xc4, 0x83, 0xb6, 0x8a, 0x78, 0xf8, 0x1f, 0xdc, 0xd7, 0x5e, 0x20, 0x76, 0xab, 0x74, 0xfd,
-	0xde, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x9e, 0xd8, 0x26, 0xea, 0x0d, 0x00, 0x00,
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x36, 0x23, 0xcb, 0xbe, 0x1a, 0xdb, 0x92, 0x3c, 0xf9, 0xb9, 0x84, 0x73, 0xaf, 0xe2, 0x38,
+	0x4e, 0xe1, 0x20, 0x05, 0x15, 0x3b, 0x5d, 0xa4, 0xe9, 0x22, 0x75, 0x9c, 0x9f, 0x0a, 0x48, 0x8a,
+	0x94, 0x36, 0x10, 0x34, 0x28, 0x42, 0x8c, 0xc9, 0x23, 0x79, 0x10, 0x92, 0x43, 0x70, 0x86, 0x8e,
+	0xd5, 0x55, 0x5e, 0xa0, 0x40, 0x1e, 0xa3, 0x8b, 0x3e, 0x41, 0xdb, 0x07, 0xe8, 0x32, 0xcb, 0xec,
+	0xda, 0x28, 0x5d, 0x74, 0x99, 0x47, 0x28, 0xe6, 0x70, 0x28, 0xd9, 0x96, 0xac, 0xca, 0xe9, 0x4e,
+	0xf3, 0x9d, 0xf3, 0x7d, 0x73, 0x78, 0xce, 0x7c, 0x43, 0x8a, 0xac, 0x29, 0x88, 0x12, 0x91, 0xb2,
+	0xb0, 0x29, 0x21, 0xdd, 0x87, 0xb4, 0xc9, 0x12, 0xde, 0xf4, 0x43, 0xde, 0xdc, 0x5f, 0x6f, 0x46,
+	0x20, 0x25, 0xeb, 0x80, 0x93, 0xa4, 0x42, 0x09, 0xba, 0x54, 0x64, 0x3a, 0x79, 0xa6, 0xc3, 0x12,
+	0xee, 0xf8, 0x21, 0x77, 0xf6, 0xd7, 0x97, 0x2e, 0x75, 0x84, 0xe8, 0x84, 0xd0, 0xc4, 0xcc, 0xdd,
+	0xac, 0xdd, 0x54, 0x3c, 0x02, 0xa9, 0x58, 0x94, 0xe4, 0xe4, 0xa5, 0xcb, 0x01, 0x24, 0x10, 0x07,
+	0x10, 0xfb, 0x1c, 0x64, 0xb3, 0x23, 0x3a, 0x02, 0x71, 0xfc, 0x65, 0x52, 0x56, 0xfb, 0x95, 0x60,
+	0x09, 0x22, 0x8a, 0x44, 0x3c, 0x54, 0xc5, 0xb1, 0x2c, 0x88, 0xb3, 0x48, 0xea, 0xa4, 0x97, 0x22,
+	0x7d, 0xd1, 0x0e, 0xc5, 0x4b, 0x93, 0xf5, 0xc9, 0x91, 0xac, 0x22, 0x38, 0xa4, 0xb6, 0xf2, 0x53,
+	0x89, 0x5c, 0xbe, 0x07, 0xd2, 0x4f, 0xf9, 0x2e, 0x3c, 0x35, 0x59, 0xf7, 0x0f, 0xc0, 0xcf, 0x14,
+	0x17, 0xb1, 0x0b, 0x32, 0x11, 0xb1, 0x04, 0xfa, 0x1d, 0xa9, 0x43, 0x01, 0x7a, 0xbe, 0x88, 0xdb,
+	0xbc, 0x63, 0x5b, 0xcb, 0xd6, 0xda, 0xdc, 0xc6, 0xba, 0xd3, 0x6f, 0x8a, 0xee, 0x46, 0xbf, 0x8a,
+	0xfd, 0x75, 0x67, 0x48, 0x6e, 0x0b, 0x89, 0x6e, 0x0d, 0x8e, 0x02, 0x94, 0x93, 0xff, 0x16, 0x3c,
+	0x6f, 0xb0, 0x0d, 0x8f, 0xdb, 0xc2, 0x3e, 0x73, 0x7c, 0x93, 0xa1, 0xce, 0x0f, 0x6f, 0xd3, 0x8a,
+	0xdb, 0xc2, 0x3d, 0xff, 0x72, 0x14, 0x4c, 0x9f, 0x13, 0xaa, 0xa7, 0xc0, 0xe3, 0x8e, 0xc7, 0x7c,
+	0xc5, 0xf7, 0xb9, 0xe2, 0x20, 0xed, 0xd2, 0x72, 0x69, 0x6d, 0x6e, 0xa3, 0x39, 0x6e, 0x97, 0x27,
+	0x39, 0x6b, 0x33, 0x27, 0x75, 0x71, 0x8f, 0xc5, 0xe4, 0x08, 0xc8, 0x41, 0xd2, 0xe7, 0xa4, 0x5e,
+	0xe8, 0xfb, 0x7b, 0x3c, 0x0c, 0x52, 0x88, 0xed, 0x69, 0x54, 0xbf, 0x79, 0x72, 0xa3, 0x8c, 0xf6,
+	0x96, 0x26, 0x1c, 0x7d, 0x8a, 0x5a, 0x72, 0x28, 0x94, 0x42, 0xbc, 0xf2, 0xcb, 0x2c, 0x39, 0x3f,
+	0xf2, 0x81, 0xe9, 0x43, 0x52, 0xe9, 0xf7, 0xce, 0xcc, 0xe6, 0xda, 0xd1, 0x2d, 0xf3, 0x03, 0x35,
+	0xb2, 0x65, 0xee, 0x80, 0x4b, 0x6f, 0x91, 0x69, 0xd5, 0x4d, 0xc0, 0xb4, 0x7e, 0xf5, 0x9f, 0x34,
+	0x76, 0xba, 0x09, 0xb8, 0xc8, 0xa0, 0x77, 0x08, 0x91, 0x8a, 0xa5, 0xca, 0xd3, 0x67, 0xdf, 0x2e,
+	0x21, 0x7f, 0xc9, 0xc9, 0x8d, 0xe1, 0x14, 0xc6, 0x70, 0x76, 0x0a, 0x63, 0xdc, 0x9d, 0x7e, 0xfd,
+	0xfb, 0x25, 0xcb, 0xad, 0x20, 0x47, 0xa3, 0x5a, 0xc0, 0x0f, 0x85, 0x84, 0x5c, 0x60, 0x7a, 0x52,
+	0x01, 0xe4, 0xa0, 0xc0, 0x03, 0x32, 0x23, 0x15, 0x53, 0x99, 0xb4, 0xcb, 0xcb, 0xd6, 0x5a, 0x75,
+	0xc3, 0x39, 0x5a, 0x3d, 0x9a, 0x65, 0x64, 0x03, 0xb6, 0x91, 0xe5, 0x1a, 0x36, 0xbd, 0x4a, 0xaa,
+	0x7b, 0x5c, 0x2a, 0x91, 0x76, 0xbd, 0x10, 0xe2, 0x8e, 0xda, 0xb3, 0x67, 0x96, 0xad, 0xb5, 0x92,
+	0xbb, 0x60, 0xd0, 0x47, 0x08, 0x52, 0x87, 0x9c, 0x4d, 0x58, 0x0a, 0xb1, 0xf2, 0x62, 0x16, 0x81,
+	0x4c, 0x98, 0x0f, 0x1e, 0x0f, 0xec, 0xd9, 0x65, 0x6b, 0xad, 0xe2, 0x2e, 0xe6, 0xa1, 0xaf, 0x8b,
+	0x48, 0x2b, 0xa0, 0x3b, 0xa4, 0x6e, 0xf2, 0x07, 0xa3, 0xfa, 0xcf, 0x69, 0x47, 0x55, 0xcb, 0x25,
+	0xfa, 0x00, 0x7d, 0x48, 0xaa, 0x03, 0xd7, 0x60, 0xe7, 0x2a, 0x13, 0x76, 0x6e, 0xa1, 0xcf, 0xc3,
+	0xee, 0xdd, 0x20, 0xd3, 0x11, 0x44, 0xc2, 0x26, 0x48, 0xff, 0xdf, 0x49, 0x25, 0x3d, 0x86, 0x48,
+	0xb8, 0x98, 0x49, 0xbf, 0x25, 0x8b, 0x12, 0x58, 0xea, 0xef, 0x79, 0x4c, 0xa9, 0x94, 0xef, 0x66,
+	0x0a, 0xa4, 0x3d, 0x87, 0xf4, 0x4f, 0xc7, 0xb9, 0x69, 0x1b, 0x49, 0x9b, 0x7d, 0x8e, 0x5b, 0x97,
+	0xc7, 0x10, 0xfa, 0x0d, 0x59, 0x64, 0x99, 0x12, 0x5e, 0x0a, 0x12, 0x94, 0x97, 0x08, 0x1e, 0x2b,
+	0x69, 0xcf, 0xa3, 0xf4, 0xd5, 0x93, 0xad, 0xe4, 0xea, 0xec, 0x27, 0x98, 0xec, 0xd6, 0x34, 0xff,
+	0x10, 0x40, 0x3f, 0x23, 0x17, 0xf4, 0x7c, 0xc1, 0x53, 0x29, 0x8b, 0x25, 0x37, 0x97, 0x59, 0x16,
+	0x2b, 0x7b, 0x01, 0xa7, 0x7b, 0x0e, 0xa3, 0x3b, 0xfd, 0xe0, 0x96, 0x8e, 0xd1, 0x2b, 0x64, 0xc1,
+	0x4f, 0x45, 0xec, 0x49, 0x7f, 0x0f, 0x82, 0x2c, 0x04, 0xbb, 0x8a, 0xe3, 0x9d, 0xd7, 0xe0, 0xb6,
+	0xc1, 0xe8, 0x2a, 0xa9, 0xfa, 0xa9, 0x69, 0xbf, 0xf7, 0xbd, 0x88, 0xc1, 0xae, 0x0d, 0xb2, 0x74,
+	0x73, 0x9f, 0x89, 0x18, 0x56, 0xfe, 0x2c, 0x93, 0xb3, 0x23, 0x2e, 0x12, 0x7a, 0x89, 0xcc, 0x99,
+	0xdb, 0xa8, 0xab, 0xcf, 0x8f, 0x85, 0x54, 0x52, 0x40, 0xad, 0x80, 0xb6, 0xc8, 0x42, 0x3f, 0x61,
+	0x12, 0x73, 0x16, 0xea, 0x68, 0xce, 0x79, 0x76, 0x68, 0x45, 0x37, 0x49, 0x19, 0x1f, 0x13, 0xfd,
+	0x59, 0xdd, 0xb8, 0x7e, 0x82, 0x43, 0x8e, 0x95, 0xa9, 0xfd, 0x01, 0x6e, 0xce, 0xa4, 0xd7, 0xc9,
+	0xe2, 0x1e, 0xb0, 0x54, 0xed, 0x02, 0x53, 0x5e, 0x00, 0x8a, 0xf1, 0x50, 0xa2, 0x5b, 0x2b, 0x6e,
+	0xbd, 0x1f, 0xb8, 0x97, 0xe3, 0xf4, 0x09, 0x39, 0x1b, 0x32, 0xa9, 0xbc, 0x01, 0x03, 0x8f, 0x68,
+	0x79, 0xc2, 0x23, 0xba, 0xa8, 0xc9, 0x5f, 0x15, 0x5c, 0x3c, 0xa6, 0x8f, 0x08, 0x82, 0x1e, 0xde,
+	0x1b, 0x10, 0xe4, 0x7a, 0x33, 0x13, 0xea, 0xd5, 0x34, 0x75, 0x3b, 0x67, 0xa2, 0x9a, 0x4d, 0x66,
+	0x99, 0xd2, 0x3d, 0x50, 0xe8, 0xdb, 0xb2, 0x5b, 0x2c, 0xe9, 0x35, 0x52, 0x8f, 0xd8, 0x01, 0x8f,
+	0xb2, 0xc8, 0x33, 0x90, 0x44, 0xb7, 0x96, 0xdd, 0x9a, 0xc1, 0x37, 0x0d, 0xac, 0x2d, 0x58, 0x1c,
+	0x8f, 0xe0, 0x94, 0x16, 0xec, 0xf3, 0xb0, 0x9a, 0x16, 0xa9, 0xc1, 0x41, 0xc2, 0x53, 0x36, 0x30,
+	0x33, 0x99, 0x50, 0xa9, 0x3a, 0x20, 0x9a, 0xbb, 0x70, 0x1e, 0xdb, 0xd4, 0x66, 0x3c, 0xcc, 0x52,
+	0x30, 0xb6, 0xbc, 0x32, 0xce, 0x96, 0x0f, 0xf2, 0x54, 0x77, 0x4e, 0x13, 0xcd, 0x82, 0xde, 0x20,
+	0xe7, 0x50, 0x47, 0xdb, 0x0c, 0x52, 0x8f, 0x07, 0x10, 0x2b, 0xae, 0xba, 0xe8, 0xc5, 0x8a, 0x4b,
+	0x75, 0xec, 0x29, 0x86, 0x5a, 0x26, 0xb2, 0xf2, 0xab, 0x45, 0xea, 0xc7, 0x1d, 0x4e, 0xdb, 0xa4,
+	0xca, 0xe3, 0x00, 0x0e, 0x20, 0xf0, 0xda, 0x1c, 0xc2, 0x40, 0xda, 0x16, 0xbe, 0x17, 0xef, 0x9c,
+	0xe6, 0x9e, 0x70, 0x5a, 0xb9, 0xc4, 0x03, 0x54, 0xb8, 0x1f, 0xab, 0xb4, 0xeb, 0x2e, 0xf0, 0xc3,
+	0xd8, 0xd2, 0x97, 0x84, 0x0e, 0x27, 0xd1, 0x3a, 0x29, 0xbd, 0x80, 0xae, 0x71, 0x96, 0xfe, 0x49,
+	0xcf, 0x91, 0xf2, 0x3e, 0x0b, 0xb3, 0xdc, 0x4a, 0x15, 0x37, 0x5f, 0xdc, 0x3e, 0x73, 0xcb, 0x5a,
+	0xf9, 0xd9, 0x22, 0xb3, 0xc5, 0xc3, 0xdb, 0x64, 0xd6, 0x7c, 0x2f, 0x19, 0x6e, 0xb1, 0xa4, 0x17,
+	0xc8, 0x8c, 0x14, 0x59, 0xea, 0x17, 0x02, 0x66, 0xa5, 0xbd, 0x2c, 0x15, 0xf3, 0x5f, 0xe8, 0x4b,
+	0xc6, 0xcf, 0x5d, 0x56, 0x71, 0x09, 0x42, 0x3b, 0x1a, 0xa1, 0x9f, 0x93, 0xb2, 0xcf, 0x32, 0x59,
+	0xbc, 0xdf, 0x26, 0x1a, 0x48, 0xce, 0xa0, 0x97, 0xc9, 0xbc, 0x99, 0x66, 0x7e, 0x0b, 0x94, 0x51,
+	0x7c, 0xce, 0x60, 0xda, 0xde, 0x2b, 0xaf, 0x66, 0xc8, 0xc5, 0xcd, 0x20, 0x18, 0xba, 0x60, 0x8b,
+	0x2f, 0xb9, 0xff, 0x13, 0x82, 0xfd, 0xc2, 0x37, 0x96, 0x79, 0xa6, 0x0a, 0x22, 0xfa, 0x45, 0x45,
+	0x7f, 0xb0, 0x88, 0xed, 0x67, 0x52, 0x89, 0xc8, 0x1b, 0xbe, 0xd8, 0xcf, 0xe0, 0xc0, 0xb6, 0xc7,
+	0x15, 0x3c, 0x66, 0x6b, 0x67, 0x0b, 0x75, 0x8f, 0x87, 0xf3, 0x21, 0x5e, 0xf0, 0x47, 0x06, 0xb1,
+	0x1e, 0xd9, 0x95, 0x0a, 0x46, 0xd5, 0x53, 0xfa, 0x77, 0xf5, 0x6c, 0xa3, 0xee, 0x09, 0xf5, 0xc8,
+	0x91, 0x41, 0xfa, 0x9c, 0xcc, 0x46, 0x2c, 0x49, 0x78, 0xdc, 0x31, 0x9f, 0x75, 0xf7, 0x3e, 0x76,
+	0xf7, 0xc7, 0xb9, 0x4c, 0xbe, 0x5d, 0x21, 0x4a, 0x13, 0x72, 0x91, 0x05, 0x81, 0x77, 0xd2, 0xe7,
+	0x70, 0xf9, 0x63, 0x3f, 0x87, 0x6d, 0x16, 0x04, 0x23, 0x23, 0x4b, 0x2d, 0x72, 0x71, 0xcc, 0x60,
+	0x4e, 0x63, 0x1c, 0x2d, 0x35, 0xa6, 0xa7, 0xa7, 0x92, 0xba, 0x4d, 0xe6, 0x0f, 0x37, 0xe8, 0x34,
+	0xdc, 0xbb, 0xcf, 0xde, 0xbc, 0x6b, 0x4c, 0xbd, 0x7d, 0xd7, 0x98, 0xfa, 0xf0, 0xae, 0x61, 0xbd,
+	0xea, 0x35, 0xac, 0x1f, 0x7b, 0x0d, 0xeb, 0xb7, 0x5e, 0xc3, 0x7a, 0xd3, 0x6b, 0x58, 0x7f, 0xf4,
+	0x1a, 0xd6, 0x5f, 0xbd, 0xc6, 0xd4, 0x87, 0x5e, 0xc3, 0x7a, 0xfd, 0xbe, 0x31, 0xf5, 0xe6, 0x7d,
+	0x63, 0xea, 0xed, 0xfb, 0xc6, 0xd4, 0xb3, 0xd5, 0x8e, 0x18, 0xb4, 0x95, 0x8b, 0xe1, 0x3f, 0x83,
+	0x5f, 0xf8, 0x21, 0xdf, 0x9d, 0xc1, 0xeb, 0xf7, 0xe6, 0xdf, 0x03, 0x00, 0x19, 0x16, 0xf0, 0xdb,
+	0x35, 0x0e, 0x00, 0x00,
 }
 
 func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -802,6 +821,12 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.StateTransitionCount != that1.StateTransitionCount {
 		return false
 	}
+	if this.CronSchedule != that1.CronSchedule {
+		return false
+	}
+	if this.CronTimeZone != that1.CronTimeZone {
+		return false
+	}
 	return true
 }
 func (this *PendingActivityInfo) Equal(that interface{}) bool {
@@ -1018,7 +1043,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&cli.WorkflowExecutionInfo{")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
@@ -1045,6 +1070,8 @@ func (this *WorkflowExecutionInfo) GoString() string {
 		s = append(s, "AutoResetPoints: "+fmt.Sprintf("%#v", this.AutoResetPoints)+",\n")
 	}
 	s = append(s, "StateTransitionCount: "+fmt.Sprintf("%#v", this.StateTransitionCount)+",\n")
+	s = append(s, "CronSchedule: "+fmt.Sprintf("%#v", this.CronSchedule)+",\n")
+	s = append(s, "CronTimeZone: "+fmt.Sprintf("%#v", this.CronTimeZone)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1266,6 +1293,20 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CronTimeZone) > 0 {
+		i -= len(m.CronTimeZone)
+		copy(dAtA[i:], m.CronTimeZone)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CronTimeZone)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.StateTransitionCount != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.StateTransitionCount))
 		i--
@@ -1815,6 +1856,14 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.StateTransitionCount != 0 {
 		n += 1 + sovMessage(uint64(m.StateTransitionCount))
 	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CronTimeZone)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2006,6 +2055,8 @@ func (this *WorkflowExecutionInfo) String() string {
 		`SearchAttributes:` + strings.Replace(this.SearchAttributes.String(), "SearchAttributes", "SearchAttributes", 1) + `,`,
 		`AutoResetPoints:` + strings.Replace(fmt.Sprintf("%v", this.AutoResetPoints), "ResetPoints", "v1.ResetPoints", 1) + `,`,
 		`StateTransitionCount:` + fmt.Sprintf("%v", this.StateTransitionCount) + `,`,
+		`CronSchedule:` + fmt.Sprintf("%v", this.CronSchedule) + `,`,
+		`CronTimeZone:` + fmt.Sprintf("%v", this.CronTimeZone) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2752,6 +2803,70 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronTimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronTimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -49,21 +49,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DeadLetterQueueType int32
 
 const (
-	DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED DeadLetterQueueType = 0
-	DEAD_LETTER_QUEUE_TYPE_REPLICATION DeadLetterQueueType = 1
-	DEAD_LETTER_QUEUE_TYPE_NAMESPACE   DeadLetterQueueType = 2
+	DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED  DeadLetterQueueType = 0
+	DEAD_LETTER_QUEUE_TYPE_REPLICATION  DeadLetterQueueType = 1
+	DEAD_LETTER_QUEUE_TYPE_NAMESPACE    DeadLetterQueueType = 2
+	DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK DeadLetterQueueType = 3
 )
 
 var DeadLetterQueueType_name = map[int32]string{
 	0: "Unspecified",
 	1: "Replication",
 	2: "Namespace",
+	3: "HistoryTask",
 }
 
 var DeadLetterQueueType_value = map[string]int32{
 	"Unspecified": 0,
 	"Replication": 1,
 	"Namespace":   2,
+	"HistoryTask": 3,
 }
 
 func (DeadLetterQueueType) EnumDescriptor() ([]byte, []int) {
@@ -101,28 +104,29 @@ func init() {
 }
 
 var fileDescriptor_4a3bfa9c01eff6e4 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0x31, 0x4b, 0xf3, 0x40,
-	0x1c, 0xc7, 0xf1, 0xdc, 0x33, 0x3c, 0xc3, 0x0d, 0x12, 0xe2, 0xa8, 0x9c, 0x22, 0x22, 0x5a, 0x30,
-	0xa1, 0x76, 0x74, 0x4a, 0x2f, 0xff, 0x62, 0x30, 0x4d, 0xd2, 0x6b, 0x52, 0xa8, 0x83, 0x47, 0x6c,
-	0x0f, 0x2d, 0x36, 0xbd, 0x90, 0x26, 0x01, 0x37, 0x77, 0x17, 0x5f, 0x86, 0x2f, 0xc5, 0xb1, 0x63,
-	0x47, 0x7b, 0x5d, 0x1c, 0xfb, 0x12, 0x84, 0x8a, 0x0e, 0x45, 0xdd, 0x7e, 0xc3, 0x67, 0xf8, 0xf1,
-	0xc5, 0x27, 0x85, 0x48, 0x33, 0x99, 0x27, 0x63, 0x6b, 0x2a, 0xf2, 0x4a, 0xe4, 0x56, 0x92, 0x8d,
-	0x2c, 0x31, 0x29, 0xd3, 0xa9, 0x55, 0xd5, 0xad, 0x81, 0x4c, 0x53, 0x39, 0x31, 0xb3, 0x5c, 0x16,
-	0xd2, 0xd8, 0xfd, 0xa2, 0xe6, 0x27, 0x35, 0x93, 0x6c, 0x64, 0xae, 0xa9, 0x59, 0xd5, 0x6b, 0x4f,
-	0x08, 0x6f, 0x3b, 0x22, 0x19, 0x7a, 0xa2, 0x28, 0x44, 0xde, 0x29, 0x45, 0x29, 0xa2, 0x87, 0x4c,
-	0x18, 0x47, 0xf8, 0xc0, 0x01, 0xdb, 0xe1, 0x1e, 0x44, 0x11, 0x30, 0xde, 0x89, 0x21, 0x06, 0x1e,
-	0xf5, 0x43, 0xe0, 0xb1, 0xdf, 0x0d, 0x81, 0xba, 0x2d, 0x17, 0x1c, 0x5d, 0xfb, 0xc3, 0x31, 0x08,
-	0x3d, 0x97, 0xda, 0x91, 0x1b, 0xf8, 0x3a, 0x32, 0x0e, 0xf1, 0xfe, 0x2f, 0xce, 0xb7, 0xdb, 0xd0,
-	0x0d, 0x6d, 0x0a, 0xfa, 0xbf, 0xda, 0x10, 0x6f, 0xd1, 0x3b, 0x31, 0xb8, 0x9f, 0x96, 0x69, 0x6b,
-	0x9c, 0x54, 0x32, 0x37, 0xf6, 0xf0, 0x0e, 0xbd, 0x00, 0x7a, 0xd9, 0x8d, 0xdb, 0xbc, 0xe5, 0xd9,
-	0xbd, 0x80, 0x6d, 0x1c, 0xa8, 0xe3, 0xd3, 0x4d, 0xe0, 0x02, 0x00, 0xa7, 0x8c, 0x36, 0xce, 0x78,
-	0xd0, 0x03, 0xc6, 0x43, 0x16, 0x44, 0x41, 0x83, 0x37, 0x5d, 0xdf, 0x66, 0x7d, 0x1d, 0x35, 0xaf,
-	0x67, 0x0b, 0xa2, 0xcd, 0x17, 0x44, 0x5b, 0x2d, 0x08, 0x7a, 0x54, 0x04, 0xbd, 0x28, 0x82, 0x5e,
-	0x15, 0x41, 0x33, 0x45, 0xd0, 0x9b, 0x22, 0xe8, 0x5d, 0x11, 0x6d, 0xa5, 0x08, 0x7a, 0x5e, 0x12,
-	0x6d, 0xb6, 0x24, 0xda, 0x7c, 0x49, 0xb4, 0xab, 0xe3, 0x5b, 0x69, 0x7e, 0xa7, 0x1c, 0xc9, 0x9f,
-	0xc2, 0x9f, 0xaf, 0xc7, 0xcd, 0xff, 0x75, 0xf8, 0xc6, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x8a, 0xe1, 0xa4, 0xa5, 0x01, 0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd1, 0x3f, 0x6f, 0xda, 0x40,
+	0x18, 0xc7, 0x71, 0x5f, 0x2b, 0x75, 0xb8, 0xa1, 0xb2, 0xdc, 0xb1, 0xd5, 0xb5, 0x6a, 0xab, 0xfe,
+	0x41, 0xaa, 0x2d, 0xca, 0x98, 0xc9, 0x9c, 0x1f, 0x84, 0x85, 0xb1, 0xcd, 0xf9, 0x8c, 0x44, 0x86,
+	0x9c, 0x1c, 0x38, 0x25, 0x28, 0x98, 0xb3, 0x8c, 0x6d, 0x29, 0x5b, 0x5e, 0x42, 0x5e, 0x46, 0x5e,
+	0x40, 0x5e, 0x44, 0x46, 0x46, 0xc6, 0x60, 0x96, 0x8c, 0xbc, 0x84, 0x48, 0x44, 0xc9, 0x80, 0x42,
+	0xb6, 0x67, 0xf8, 0x0c, 0x8f, 0x7e, 0x5f, 0xfc, 0xb7, 0x90, 0x69, 0xa6, 0xf2, 0x64, 0x66, 0x2d,
+	0x64, 0x5e, 0xc9, 0xdc, 0x4a, 0xb2, 0xa9, 0x25, 0xe7, 0x65, 0xba, 0xb0, 0xaa, 0xa6, 0x35, 0x56,
+	0x69, 0xaa, 0xe6, 0x66, 0x96, 0xab, 0x42, 0x19, 0x5f, 0x9e, 0xa9, 0xf9, 0x44, 0xcd, 0x24, 0x9b,
+	0x9a, 0x3b, 0x6a, 0x56, 0xcd, 0xc6, 0x2d, 0xc2, 0x9f, 0x1c, 0x99, 0x4c, 0x3c, 0x59, 0x14, 0x32,
+	0x1f, 0x94, 0xb2, 0x94, 0xfc, 0x32, 0x93, 0xc6, 0x2f, 0xfc, 0xdd, 0x01, 0xdb, 0x11, 0x1e, 0x70,
+	0x0e, 0x4c, 0x0c, 0x62, 0x88, 0x41, 0xf0, 0x51, 0x08, 0x22, 0xf6, 0xa3, 0x10, 0xa8, 0xdb, 0x71,
+	0xc1, 0xd1, 0xb5, 0x37, 0x1c, 0x83, 0xd0, 0x73, 0xa9, 0xcd, 0xdd, 0xc0, 0xd7, 0x91, 0xf1, 0x13,
+	0x7f, 0x3b, 0xe0, 0x7c, 0xbb, 0x0f, 0x51, 0x68, 0x53, 0xd0, 0xdf, 0x19, 0xbf, 0xf1, 0x8f, 0x03,
+	0xaa, 0xeb, 0x46, 0x3c, 0x60, 0x23, 0xc1, 0xed, 0xa8, 0xa7, 0xbf, 0x6f, 0x4c, 0xf0, 0x47, 0x7a,
+	0x2e, 0xc7, 0x17, 0x8b, 0x32, 0xed, 0xcc, 0x92, 0x4a, 0xe5, 0xc6, 0x57, 0xfc, 0x99, 0x76, 0x81,
+	0xf6, 0xa2, 0xb8, 0x2f, 0x3a, 0x9e, 0x3d, 0x0c, 0xd8, 0xde, 0xa7, 0x4d, 0xfc, 0x6f, 0x1f, 0xb8,
+	0x00, 0x20, 0x28, 0xa3, 0xad, 0xff, 0x22, 0x18, 0x02, 0x13, 0x21, 0x0b, 0x78, 0xd0, 0x12, 0x6d,
+	0xd7, 0xb7, 0xd9, 0x48, 0x47, 0xed, 0x93, 0xe5, 0x9a, 0x68, 0xab, 0x35, 0xd1, 0xb6, 0x6b, 0x82,
+	0xae, 0x6a, 0x82, 0x6e, 0x6a, 0x82, 0xee, 0x6a, 0x82, 0x96, 0x35, 0x41, 0xf7, 0x35, 0x41, 0x0f,
+	0x35, 0xd1, 0xb6, 0x35, 0x41, 0xd7, 0x1b, 0xa2, 0x2d, 0x37, 0x44, 0x5b, 0x6d, 0x88, 0x76, 0xfc,
+	0xe7, 0x4c, 0x99, 0x2f, 0x9b, 0x4f, 0xd5, 0x6b, 0x85, 0x8e, 0x76, 0xc7, 0xe9, 0x87, 0x5d, 0xa1,
+	0xd6, 0xe3, 0x00, 0x8c, 0x44, 0x68, 0xba, 0xce, 0x01, 0x00, 0x00,
 }
 
 func (x DeadLetterQueueType) String() string {
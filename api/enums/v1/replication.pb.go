@@ -88,18 +88,21 @@ const (
 	NAMESPACE_OPERATION_UNSPECIFIED NamespaceOperation = 0
 	NAMESPACE_OPERATION_CREATE      NamespaceOperation = 1
 	NAMESPACE_OPERATION_UPDATE      NamespaceOperation = 2
+	NAMESPACE_OPERATION_RENAME      NamespaceOperation = 3
 )
 
 var NamespaceOperation_name = map[int32]string{
 	0: "Unspecified",
 	1: "Create",
 	2: "Update",
+	3: "Rename",
 }
 
 var NamespaceOperation_value = map[string]int32{
 	"Unspecified": 0,
 	"Create":      1,
 	"Update":      2,
+	"Rename":      3,
 }
 
 func (NamespaceOperation) EnumDescriptor() ([]byte, []int) {
@@ -116,31 +119,31 @@ func init() {
 }
 
 var fileDescriptor_3f4df3039790445d = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
-	0x18, 0xc6, 0x73, 0x55, 0x3b, 0xdc, 0x54, 0xce, 0x4d, 0xe4, 0xc4, 0x3f, 0x95, 0x5a, 0x4b, 0x62,
-	0x75, 0x74, 0x3a, 0x93, 0x93, 0x06, 0x6d, 0x12, 0x72, 0xd7, 0x42, 0x1d, 0x0c, 0xb1, 0x1c, 0x12,
-	0x6c, 0x9b, 0x23, 0xa9, 0x85, 0x6e, 0x7e, 0x04, 0x3f, 0x86, 0x1f, 0x45, 0x9c, 0x3a, 0x76, 0xb4,
-	0xe9, 0xe2, 0xd8, 0x8f, 0x20, 0x26, 0x6a, 0x11, 0x62, 0xb7, 0xe3, 0xde, 0xdf, 0xef, 0xe5, 0xe5,
-	0xe1, 0x81, 0xea, 0x50, 0xf4, 0x65, 0x18, 0xf9, 0x3d, 0x2d, 0x16, 0xd1, 0x48, 0x44, 0x9a, 0x2f,
-	0x03, 0x4d, 0x0c, 0x1e, 0xfb, 0xb1, 0x36, 0xaa, 0x6b, 0x91, 0x90, 0xbd, 0xa0, 0xeb, 0x0f, 0x83,
-	0x70, 0xa0, 0xca, 0x28, 0x1c, 0x86, 0x68, 0xfb, 0x87, 0x57, 0x33, 0x5e, 0xf5, 0x65, 0xa0, 0xa6,
-	0xbc, 0x3a, 0xaa, 0x57, 0xdf, 0x0a, 0x70, 0xd3, 0x5d, 0x3a, 0xdc, 0x8f, 0x1f, 0xf8, 0x58, 0x0a,
-	0x54, 0x86, 0xbb, 0x2e, 0x75, 0xae, 0x4d, 0x9d, 0x70, 0xd3, 0xb6, 0x3c, 0x4e, 0xd8, 0x95, 0xc7,
-	0x3b, 0x0e, 0xf5, 0x5a, 0x16, 0x73, 0xa8, 0x6e, 0x5e, 0x9a, 0xd4, 0x28, 0x29, 0xa8, 0x02, 0x0f,
-	0xf2, 0x31, 0x8b, 0x34, 0x29, 0x73, 0x88, 0x4e, 0xd3, 0xbf, 0x12, 0x40, 0x87, 0x70, 0x2f, 0x9f,
-	0x6c, 0x98, 0x8c, 0xdb, 0x6e, 0x27, 0xe3, 0x0a, 0xe8, 0x04, 0xd6, 0xf2, 0x39, 0xd6, 0xb1, 0x74,
-	0x8f, 0x35, 0x88, 0x6b, 0x78, 0x8c, 0x13, 0xde, 0x62, 0x99, 0xb1, 0x86, 0x6a, 0xb0, 0xb2, 0xc2,
-	0x20, 0x3a, 0x37, 0xdb, 0x26, 0xff, 0xde, 0xbf, 0x8e, 0x34, 0x78, 0xbc, 0xfa, 0x8e, 0x26, 0xe5,
-	0xc4, 0x20, 0x9c, 0x64, 0xc2, 0x06, 0x3a, 0x82, 0xe5, 0xd5, 0x42, 0xfb, 0x34, 0x43, 0x8b, 0xd5,
-	0x31, 0x44, 0x96, 0xdf, 0x17, 0xb1, 0xf4, 0xbb, 0xc2, 0x96, 0x22, 0x4a, 0x23, 0x45, 0xfb, 0x70,
-	0x67, 0x99, 0x86, 0xed, 0x50, 0x37, 0x5b, 0xf4, 0x37, 0x48, 0x0c, 0xb7, 0xf2, 0x20, 0xdd, 0xa5,
-	0x84, 0xd3, 0x12, 0xf8, 0x6f, 0xde, 0x72, 0x8c, 0xaf, 0x79, 0xe1, 0xe2, 0x76, 0x32, 0xc3, 0xca,
-	0x74, 0x86, 0x95, 0xc5, 0x0c, 0x83, 0xa7, 0x04, 0x83, 0x97, 0x04, 0x83, 0xd7, 0x04, 0x83, 0x49,
-	0x82, 0xc1, 0x7b, 0x82, 0xc1, 0x47, 0x82, 0x95, 0x45, 0x82, 0xc1, 0xf3, 0x1c, 0x2b, 0x93, 0x39,
-	0x56, 0xa6, 0x73, 0xac, 0xdc, 0x54, 0xee, 0xc3, 0xdf, 0x3a, 0xa9, 0x41, 0x98, 0xd7, 0xa8, 0xf3,
-	0xf4, 0x71, 0x57, 0x4c, 0xcb, 0x74, 0xf6, 0x19, 0x00, 0x00, 0xff, 0xff, 0xab, 0x9f, 0x42, 0xee,
-	0x7e, 0x02, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x4f, 0xe2, 0x40,
+	0x18, 0xc6, 0x3b, 0xb0, 0xcb, 0x61, 0x4e, 0xcd, 0xec, 0x6d, 0xb3, 0x99, 0xcd, 0xfe, 0xc1, 0x20,
+	0x92, 0x56, 0xf4, 0xe8, 0x69, 0x6c, 0xc7, 0xd0, 0x28, 0x6d, 0xd3, 0x19, 0x48, 0xf0, 0x60, 0x53,
+	0xc9, 0xc4, 0x34, 0x02, 0x9d, 0xb4, 0x48, 0xe2, 0xcd, 0x8f, 0xe0, 0xd5, 0x6f, 0xe0, 0x47, 0x31,
+	0x9e, 0x38, 0x72, 0x94, 0x72, 0xf1, 0xc8, 0x47, 0x30, 0xb4, 0x2a, 0x31, 0x29, 0xdc, 0x26, 0xef,
+	0xfb, 0xfb, 0xbd, 0x99, 0x3c, 0x79, 0xa0, 0x36, 0x16, 0x43, 0x19, 0xc5, 0xc1, 0x40, 0x4f, 0x44,
+	0x3c, 0x11, 0xb1, 0x1e, 0xc8, 0x50, 0x17, 0xa3, 0x9b, 0x61, 0xa2, 0x4f, 0x9a, 0x7a, 0x2c, 0xe4,
+	0x20, 0xec, 0x07, 0xe3, 0x30, 0x1a, 0x69, 0x32, 0x8e, 0xc6, 0x11, 0xfa, 0xf5, 0xc1, 0x6b, 0x39,
+	0xaf, 0x05, 0x32, 0xd4, 0x32, 0x5e, 0x9b, 0x34, 0xeb, 0xcf, 0x25, 0xf8, 0xc3, 0x5b, 0x3b, 0x3c,
+	0x48, 0xae, 0xf9, 0xad, 0x14, 0xa8, 0x0a, 0xff, 0x78, 0xd4, 0x3d, 0xb3, 0x0c, 0xc2, 0x2d, 0xc7,
+	0xf6, 0x39, 0x61, 0xa7, 0x3e, 0xef, 0xb9, 0xd4, 0xef, 0xd8, 0xcc, 0xa5, 0x86, 0x75, 0x62, 0x51,
+	0x53, 0x55, 0x50, 0x0d, 0xfe, 0x2f, 0xc6, 0x6c, 0xd2, 0xa6, 0xcc, 0x25, 0x06, 0xcd, 0x66, 0x2a,
+	0x40, 0x3b, 0xf0, 0x6f, 0x31, 0xd9, 0xb2, 0x18, 0x77, 0xbc, 0x5e, 0xce, 0x95, 0xd0, 0x3e, 0x6c,
+	0x14, 0x73, 0xac, 0x67, 0x1b, 0x3e, 0x6b, 0x11, 0xcf, 0xf4, 0x19, 0x27, 0xbc, 0xc3, 0x72, 0xa3,
+	0x8c, 0x1a, 0xb0, 0xb6, 0xc5, 0x20, 0x06, 0xb7, 0xba, 0x16, 0x7f, 0xbf, 0xff, 0x0d, 0xe9, 0x70,
+	0x6f, 0xfb, 0x3f, 0xda, 0x94, 0x13, 0x93, 0x70, 0x92, 0x0b, 0xdf, 0xd1, 0x2e, 0xac, 0x6e, 0x17,
+	0xba, 0x07, 0x39, 0x5a, 0xa9, 0x3f, 0x00, 0x88, 0xec, 0x60, 0x28, 0x12, 0x19, 0xf4, 0x85, 0x23,
+	0x45, 0x9c, 0x65, 0x8a, 0xfe, 0xc1, 0xdf, 0xeb, 0x38, 0x1c, 0x97, 0x7a, 0xf9, 0xa5, 0xaf, 0x49,
+	0x62, 0xf8, 0xb3, 0x08, 0x32, 0x3c, 0x4a, 0x38, 0x55, 0xc1, 0xa6, 0x7d, 0xc7, 0x35, 0x57, 0xfb,
+	0xd2, 0xa6, 0xbd, 0x47, 0x57, 0x53, 0xb5, 0x7c, 0x7c, 0x31, 0x9d, 0x63, 0x65, 0x36, 0xc7, 0xca,
+	0x72, 0x8e, 0xc1, 0x5d, 0x8a, 0xc1, 0x63, 0x8a, 0xc1, 0x53, 0x8a, 0xc1, 0x34, 0xc5, 0xe0, 0x25,
+	0xc5, 0xe0, 0x35, 0xc5, 0xca, 0x32, 0xc5, 0xe0, 0x7e, 0x81, 0x95, 0xe9, 0x02, 0x2b, 0xb3, 0x05,
+	0x56, 0xce, 0x6b, 0x57, 0xd1, 0x67, 0xdf, 0xb4, 0x30, 0x2a, 0xaa, 0xdc, 0x51, 0xf6, 0xb8, 0xac,
+	0x64, 0x6d, 0x3b, 0x7c, 0x1b, 0x00, 0x16, 0x18, 0x32, 0x2e, 0x9f, 0x02, 0x00, 0x00,
 }
 
 func (x ReplicationTaskType) String() string {
@@ -35,15 +35,21 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/history/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -258,11 +264,225 @@ func (m *VersionHistories) GetHistories() []*VersionHistory {
 	return nil
 }
 
+// QueueState contains the in-memory state of a history task queue processor of a shard.
+type QueueState struct {
+	Category v11.TaskCategory `protobuf:"varint,1,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	// Cluster the tasks are processed for, either the current cluster or a standby cluster.
+	ClusterName string `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Ack and read levels are task ids. Timer queues also set the visibility time of the level.
+	AckLevel      int64      `protobuf:"varint,3,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	AckLevelTime  *time.Time `protobuf:"bytes,4,opt,name=ack_level_time,json=ackLevelTime,proto3,stdtime" json:"ack_level_time,omitempty"`
+	ReadLevel     int64      `protobuf:"varint,5,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	ReadLevelTime *time.Time `protobuf:"bytes,6,opt,name=read_level_time,json=readLevelTime,proto3,stdtime" json:"read_level_time,omitempty"`
+	// Tasks loaded in memory which the ack level has not moved past yet.
+	OutstandingTasks []*QueueTaskState `protobuf:"bytes,7,rep,name=outstanding_tasks,json=outstandingTasks,proto3" json:"outstanding_tasks,omitempty"`
+	// Age of the oldest loaded task which is not acked yet.
+	OldestPendingTaskAge *time.Duration `protobuf:"bytes,8,opt,name=oldest_pending_task_age,json=oldestPendingTaskAge,proto3,stdduration" json:"oldest_pending_task_age,omitempty"`
+}
+
+func (m *QueueState) Reset()      { *m = QueueState{} }
+func (*QueueState) ProtoMessage() {}
+func (*QueueState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{4}
+}
+func (m *QueueState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueState.Merge(m, src)
+}
+func (m *QueueState) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueState) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueState proto.InternalMessageInfo
+
+func (m *QueueState) GetCategory() v11.TaskCategory {
+	if m != nil {
+		return m.Category
+	}
+	return v11.TASK_CATEGORY_UNSPECIFIED
+}
+
+func (m *QueueState) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *QueueState) GetAckLevel() int64 {
+	if m != nil {
+		return m.AckLevel
+	}
+	return 0
+}
+
+func (m *QueueState) GetAckLevelTime() *time.Time {
+	if m != nil {
+		return m.AckLevelTime
+	}
+	return nil
+}
+
+func (m *QueueState) GetReadLevel() int64 {
+	if m != nil {
+		return m.ReadLevel
+	}
+	return 0
+}
+
+func (m *QueueState) GetReadLevelTime() *time.Time {
+	if m != nil {
+		return m.ReadLevelTime
+	}
+	return nil
+}
+
+func (m *QueueState) GetOutstandingTasks() []*QueueTaskState {
+	if m != nil {
+		return m.OutstandingTasks
+	}
+	return nil
+}
+
+func (m *QueueState) GetOldestPendingTaskAge() *time.Duration {
+	if m != nil {
+		return m.OldestPendingTaskAge
+	}
+	return nil
+}
+
+// QueueTaskState contains the in-memory state of a task loaded by a history task queue processor.
+type QueueTaskState struct {
+	TaskId         int64        `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType       v11.TaskType `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	NamespaceId    string       `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId     string       `protobuf:"bytes,4,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId          string       `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	VisibilityTime *time.Time   `protobuf:"bytes,6,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+	Acked          bool         `protobuf:"varint,7,opt,name=acked,proto3" json:"acked,omitempty"`
+	Attempt        int32        `protobuf:"varint,8,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError      string       `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *QueueTaskState) Reset()      { *m = QueueTaskState{} }
+func (*QueueTaskState) ProtoMessage() {}
+func (*QueueTaskState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{5}
+}
+func (m *QueueTaskState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueTaskState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueTaskState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueTaskState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueTaskState.Merge(m, src)
+}
+func (m *QueueTaskState) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueTaskState) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueTaskState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueTaskState proto.InternalMessageInfo
+
+func (m *QueueTaskState) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *QueueTaskState) GetTaskType() v11.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v11.TASK_TYPE_UNSPECIFIED
+}
+
+func (m *QueueTaskState) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *QueueTaskState) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *QueueTaskState) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *QueueTaskState) GetVisibilityTime() *time.Time {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+func (m *QueueTaskState) GetAcked() bool {
+	if m != nil {
+		return m.Acked
+	}
+	return false
+}
+
+func (m *QueueTaskState) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *QueueTaskState) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
 	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
 	proto.RegisterType((*VersionHistory)(nil), "temporal.server.api.history.v1.VersionHistory")
 	proto.RegisterType((*VersionHistories)(nil), "temporal.server.api.history.v1.VersionHistories")
+	proto.RegisterType((*QueueState)(nil), "temporal.server.api.history.v1.QueueState")
+	proto.RegisterType((*QueueTaskState)(nil), "temporal.server.api.history.v1.QueueTaskState")
 }
 
 func init() {
@@ -270,34 +490,61 @@ func init() {
 }
 
 var fileDescriptor_670cd05c700ece14 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
-	0x1c, 0xc6, 0x33, 0x5d, 0x6a, 0x75, 0x76, 0xad, 0x32, 0xa7, 0x6d, 0xc1, 0xa1, 0x06, 0x0a, 0x3d,
-	0x94, 0x09, 0x5d, 0x8f, 0x9e, 0x14, 0x84, 0x46, 0xc4, 0x43, 0x58, 0x14, 0xbc, 0x84, 0xd9, 0xcd,
-	0xbf, 0xcd, 0xb0, 0x9b, 0x99, 0x30, 0x33, 0x8d, 0x7a, 0x10, 0x7c, 0x04, 0xdf, 0xc1, 0x8b, 0x6f,
-	0xe0, 0x2b, 0x78, 0xdc, 0x63, 0x8f, 0x6e, 0xf6, 0xe2, 0xb1, 0x8f, 0x20, 0x99, 0xcc, 0xa6, 0xac,
-	0x96, 0x42, 0x6f, 0xf3, 0x4d, 0xbe, 0xff, 0xef, 0xfb, 0xc2, 0xfc, 0xf1, 0xb1, 0x85, 0xa2, 0x54,
-	0x9a, 0xcf, 0x23, 0x03, 0xba, 0x02, 0x1d, 0xf1, 0x52, 0x44, 0xb9, 0x30, 0x56, 0xe9, 0xcf, 0x51,
-	0x75, 0x12, 0x15, 0x60, 0x0c, 0x3f, 0x07, 0x56, 0x6a, 0x65, 0x15, 0xa1, 0x6b, 0x37, 0x6b, 0xdd,
-	0x8c, 0x97, 0x82, 0x79, 0x37, 0xab, 0x4e, 0xf6, 0x0f, 0x3b, 0xda, 0x6d, 0x98, 0xf0, 0x27, 0xc2,
-	0x7b, 0x63, 0xcd, 0xa5, 0x11, 0x20, 0xed, 0x7b, 0xa5, 0x67, 0x67, 0x73, 0xf5, 0x71, 0xcc, 0xcd,
-	0x2c, 0x96, 0x67, 0x8a, 0xbc, 0xc5, 0x8f, 0xcc, 0x34, 0x87, 0xec, 0x62, 0x0e, 0x59, 0x0a, 0x15,
-	0x48, 0x3b, 0x44, 0x07, 0xe8, 0xa8, 0x3f, 0x3a, 0x64, 0x5d, 0xfc, 0x66, 0x2e, 0x3b, 0x6d, 0x8f,
-	0xaf, 0x1a, 0x73, 0xb2, 0xdb, 0x4d, 0x3b, 0x4d, 0x5e, 0xe3, 0x87, 0xc6, 0x72, 0x6d, 0x3b, 0xda,
-	0xd6, 0x5d, 0x68, 0x03, 0x3f, 0xeb, 0x54, 0x18, 0x63, 0xf2, 0x0e, 0xb4, 0x11, 0x4a, 0x7a, 0x53,
-	0x6c, 0xa1, 0x20, 0x7b, 0xf8, 0xbe, 0x23, 0xa7, 0x22, 0x73, 0x55, 0x7b, 0xc9, 0x8e, 0xd3, 0x71,
-	0x46, 0x86, 0x78, 0xa7, 0x6a, 0x07, 0x5c, 0x6c, 0x2f, 0x59, 0xcb, 0xf0, 0x0b, 0xde, 0xdd, 0x44,
-	0x91, 0xa7, 0x78, 0x30, 0xd1, 0x5c, 0x4e, 0xf3, 0xd4, 0xaa, 0x19, 0x48, 0x87, 0x1a, 0x24, 0xfd,
-	0xf6, 0x6e, 0xdc, 0x5c, 0x91, 0x53, 0xbc, 0x2d, 0x2c, 0x14, 0x66, 0xb8, 0x75, 0xd0, 0x3b, 0xea,
-	0x8f, 0x46, 0xec, 0xf6, 0x07, 0x61, 0xff, 0x97, 0x4d, 0x5a, 0x40, 0xf8, 0x1d, 0xe1, 0xc7, 0x1b,
-	0x5f, 0x05, 0x18, 0xf2, 0x02, 0x3f, 0x99, 0x5e, 0x68, 0xdd, 0xfc, 0x8a, 0xaf, 0x99, 0x7a, 0x58,
-	0x2a, 0x64, 0x06, 0x9f, 0x5c, 0xa5, 0xed, 0x64, 0xdf, 0x9b, 0xfe, 0xa1, 0x37, 0x0e, 0xf2, 0x06,
-	0x3f, 0xc8, 0xd7, 0x3c, 0xdf, 0x92, 0xdd, 0xad, 0x65, 0x72, 0x0d, 0x78, 0x39, 0x59, 0x2c, 0x69,
-	0x70, 0xb9, 0xa4, 0xc1, 0xd5, 0x92, 0xa2, 0xaf, 0x35, 0x45, 0x3f, 0x6a, 0x8a, 0x7e, 0xd5, 0x14,
-	0x2d, 0x6a, 0x8a, 0x7e, 0xd7, 0x14, 0xfd, 0xa9, 0x69, 0x70, 0x55, 0x53, 0xf4, 0x6d, 0x45, 0x83,
-	0xc5, 0x8a, 0x06, 0x97, 0x2b, 0x1a, 0x7c, 0x38, 0x3e, 0x57, 0xd7, 0x91, 0x42, 0xdd, 0xbc, 0xda,
-	0xcf, 0xfd, 0x71, 0x72, 0xcf, 0x2d, 0xe5, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xc0,
-	0xb3, 0x54, 0x0b, 0x03, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0xcb, 0x92, 0x4e, 0x8e, 0x9c, 0x1e, 0x52, 0x84, 0x76, 0x11, 0xda, 0x11, 0x90,
+	0xd6, 0x28, 0x02, 0x0a, 0x51, 0xc7, 0x4e, 0x71, 0x9a, 0xc0, 0x2c, 0x82, 0xa0, 0x65, 0x85, 0x14,
+	0x68, 0x07, 0xe2, 0x44, 0x3e, 0xd3, 0x07, 0x91, 0x77, 0xc4, 0xdd, 0x51, 0xa9, 0x86, 0x02, 0xfd,
+	0x09, 0x19, 0xbb, 0x77, 0xe9, 0x3f, 0xe8, 0x5f, 0xe8, 0xe8, 0x31, 0x5b, 0x6b, 0x79, 0xe9, 0x98,
+	0xb9, 0x53, 0x71, 0xc7, 0x23, 0x55, 0x27, 0x46, 0x12, 0x6f, 0xf7, 0xde, 0xfb, 0xde, 0xf7, 0xbe,
+	0x7b, 0xef, 0x1d, 0x89, 0xee, 0x2b, 0xc8, 0x0b, 0x2e, 0x48, 0x36, 0x96, 0x20, 0x16, 0x20, 0xc6,
+	0xa4, 0xa0, 0xe3, 0x53, 0x2a, 0x15, 0x17, 0xcb, 0xf1, 0xe2, 0xc1, 0x38, 0x07, 0x29, 0x49, 0x0a,
+	0x7e, 0x21, 0xb8, 0xe2, 0xd8, 0xab, 0xd1, 0x7e, 0x85, 0xf6, 0x49, 0x41, 0x7d, 0x8b, 0xf6, 0x17,
+	0x0f, 0xf6, 0xbc, 0x94, 0xf3, 0x34, 0x83, 0xb1, 0x41, 0xcf, 0xca, 0x93, 0x71, 0x52, 0x0a, 0xa2,
+	0x28, 0x67, 0x55, 0xfe, 0xde, 0xfe, 0x9b, 0x71, 0x45, 0x73, 0x90, 0x8a, 0xe4, 0x85, 0x05, 0xdc,
+	0x4d, 0xa0, 0x00, 0x96, 0x00, 0x8b, 0x29, 0xc8, 0x71, 0xca, 0x53, 0x6e, 0xfc, 0xe6, 0x64, 0x21,
+	0x9f, 0x5d, 0xa5, 0x18, 0x58, 0x99, 0x4b, 0xad, 0x57, 0x11, 0x39, 0xb7, 0xc0, 0x7b, 0x0d, 0xf0,
+	0x5d, 0x77, 0x1a, 0xfd, 0xe1, 0xa0, 0xdd, 0xa9, 0x20, 0x4c, 0x52, 0x60, 0xea, 0x7b, 0x2e, 0xe6,
+	0x27, 0x19, 0x7f, 0x31, 0x25, 0x72, 0x1e, 0xb0, 0x13, 0x8e, 0x9f, 0xa1, 0x1d, 0x19, 0x9f, 0x42,
+	0x52, 0x66, 0x90, 0x44, 0xb0, 0x00, 0xa6, 0x5c, 0xe7, 0xc0, 0x39, 0x1c, 0x4c, 0xee, 0xf9, 0x4d,
+	0x2f, 0x2e, 0x37, 0xc1, 0x3f, 0xae, 0x8e, 0x8f, 0x35, 0x38, 0x1c, 0x36, 0xd9, 0xc6, 0xc6, 0x5f,
+	0xa3, 0x1b, 0x52, 0x11, 0xa1, 0x1a, 0xb6, 0x8d, 0xeb, 0xb0, 0x6d, 0xdb, 0x5c, 0x63, 0x8d, 0x02,
+	0x84, 0x9f, 0x83, 0x90, 0x94, 0x33, 0x0b, 0x0a, 0x14, 0xe4, 0x78, 0x17, 0xf5, 0x0c, 0x73, 0x44,
+	0x13, 0x23, 0xb5, 0x1d, 0x76, 0x8d, 0x1d, 0x24, 0xd8, 0x45, 0xdd, 0x45, 0x95, 0x60, 0xca, 0xb6,
+	0xc3, 0xda, 0x1c, 0xfd, 0x8c, 0x86, 0x97, 0xa9, 0xf0, 0x5d, 0xb4, 0x3d, 0x13, 0x84, 0xc5, 0xa7,
+	0x91, 0xe2, 0x73, 0x60, 0x86, 0x6a, 0x3b, 0x1c, 0x54, 0xbe, 0xa9, 0x76, 0xe1, 0x63, 0xd4, 0xa1,
+	0x0a, 0x72, 0xe9, 0x6e, 0x1c, 0xb4, 0x0f, 0x07, 0x93, 0x89, 0xff, 0xee, 0xed, 0xf0, 0xdf, 0x16,
+	0x1b, 0x56, 0x04, 0xa3, 0xdf, 0x1c, 0x74, 0xf3, 0x52, 0x94, 0x82, 0xc4, 0x0f, 0xd1, 0x9d, 0xb8,
+	0x14, 0x42, 0x5f, 0xc5, 0xca, 0x8c, 0x2c, 0x59, 0x44, 0x59, 0x02, 0x3f, 0x19, 0x49, 0x9d, 0x70,
+	0xcf, 0x82, 0xde, 0x60, 0xd7, 0x08, 0xfc, 0x14, 0xf5, 0x4f, 0x6b, 0x3e, 0xab, 0xd2, 0xbf, 0x9e,
+	0xca, 0x70, 0x4d, 0x30, 0xfa, 0xb7, 0x8d, 0xd0, 0xb7, 0x25, 0x94, 0xf0, 0x9d, 0x22, 0x0a, 0xf0,
+	0x13, 0xd4, 0x8b, 0x89, 0x82, 0x94, 0x8b, 0xa5, 0x91, 0x32, 0x9c, 0x7c, 0x7e, 0x25, 0xb7, 0xd9,
+	0x4d, 0xcd, 0xac, 0x97, 0xea, 0x91, 0xcd, 0x08, 0x9b, 0x5c, 0xdd, 0xe9, 0x38, 0x2b, 0xa5, 0x02,
+	0x11, 0x31, 0x92, 0x83, 0x19, 0x4d, 0x3f, 0x1c, 0x58, 0xdf, 0x33, 0x92, 0x03, 0xfe, 0x04, 0xf5,
+	0x49, 0x3c, 0x8f, 0x32, 0x58, 0x40, 0xe6, 0xb6, 0xcd, 0xe8, 0x7a, 0x24, 0x9e, 0x3f, 0xd5, 0x36,
+	0x7e, 0x82, 0x86, 0x4d, 0x30, 0xd2, 0x0f, 0xca, 0xdd, 0x34, 0x3b, 0xb5, 0xe7, 0x57, 0xaf, 0xcd,
+	0xaf, 0x5f, 0x9b, 0x3f, 0xad, 0x5f, 0xdb, 0xd1, 0xe6, 0xcb, 0xbf, 0xf6, 0x9d, 0x70, 0xbb, 0xe6,
+	0xd0, 0x01, 0x7c, 0x07, 0x21, 0x01, 0x24, 0xb1, 0x55, 0x3a, 0xa6, 0x4a, 0x5f, 0x7b, 0xaa, 0x32,
+	0xc7, 0x68, 0x67, 0x1d, 0xae, 0xea, 0x6c, 0x7d, 0x60, 0x9d, 0x1b, 0x0d, 0x8b, 0x29, 0xf4, 0x23,
+	0xfa, 0x88, 0x97, 0x4a, 0x2a, 0xc2, 0x12, 0xca, 0xd2, 0x48, 0x3f, 0x59, 0xe9, 0x76, 0x3f, 0x6c,
+	0x3a, 0xa6, 0xff, 0xba, 0x91, 0x66, 0x06, 0xe1, 0xcd, 0xff, 0x11, 0x69, 0xaf, 0xc4, 0xcf, 0xd1,
+	0x6d, 0x9e, 0x25, 0x20, 0x55, 0x54, 0xc0, 0x9a, 0x3f, 0x22, 0x29, 0xb8, 0x3d, 0x23, 0x77, 0xf7,
+	0x2d, 0xb9, 0x5f, 0xd9, 0x8f, 0xd4, 0xd1, 0xe6, 0xaf, 0x5a, 0xed, 0xad, 0x2a, 0xff, 0x1b, 0x68,
+	0x58, 0x1f, 0xa6, 0x30, 0xba, 0xd8, 0x40, 0xc3, 0xcb, 0xc5, 0xf1, 0x6d, 0xd4, 0x35, 0xdc, 0xcd,
+	0x43, 0xdb, 0xd2, 0x66, 0x90, 0xe0, 0x47, 0xa8, 0x6f, 0x02, 0x6a, 0x59, 0x54, 0xe3, 0x1c, 0x4e,
+	0x3e, 0x7d, 0xff, 0x6a, 0x4c, 0x97, 0x05, 0x84, 0x3d, 0x65, 0x4f, 0x7a, 0x2d, 0xf4, 0x3a, 0xc8,
+	0x82, 0xc4, 0xa0, 0x4b, 0xb4, 0xab, 0xb5, 0x68, 0x7c, 0x41, 0x82, 0xf7, 0xd1, 0xe0, 0x85, 0xfd,
+	0x60, 0x69, 0xc4, 0xa6, 0x41, 0xa0, 0xda, 0x15, 0x24, 0xf8, 0x63, 0xb4, 0x25, 0x4a, 0xa6, 0x63,
+	0x1d, 0x13, 0xeb, 0x88, 0x92, 0x05, 0x09, 0x0e, 0xd0, 0xce, 0x82, 0x4a, 0x3a, 0xa3, 0x19, 0x55,
+	0xcb, 0xeb, 0x8d, 0x72, 0xb8, 0x4e, 0x34, 0xb3, 0xbc, 0x85, 0x3a, 0x24, 0x9e, 0x43, 0xe2, 0x76,
+	0x0f, 0x9c, 0xc3, 0x5e, 0x58, 0x19, 0xfa, 0x43, 0x43, 0x94, 0xbe, 0xb0, 0x32, 0x4d, 0xef, 0x84,
+	0xb5, 0xa9, 0x97, 0x2c, 0x23, 0x52, 0x45, 0x20, 0x04, 0x17, 0x6e, 0xdf, 0xa8, 0xea, 0x6b, 0xcf,
+	0x63, 0xed, 0x38, 0x9a, 0x9d, 0x9d, 0x7b, 0xad, 0x57, 0xe7, 0x5e, 0xeb, 0xf5, 0xb9, 0xe7, 0xfc,
+	0xb2, 0xf2, 0x9c, 0xdf, 0x57, 0x9e, 0xf3, 0xe7, 0xca, 0x73, 0xce, 0x56, 0x9e, 0xf3, 0xf7, 0xca,
+	0x73, 0xfe, 0x59, 0x79, 0xad, 0xd7, 0x2b, 0xcf, 0x79, 0x79, 0xe1, 0xb5, 0xce, 0x2e, 0xbc, 0xd6,
+	0xab, 0x0b, 0xaf, 0xf5, 0xc3, 0xfd, 0x94, 0xaf, 0xdb, 0x4b, 0xf9, 0xd5, 0xbf, 0xb2, 0x2f, 0xed,
+	0x71, 0xb6, 0x65, 0x2e, 0xf7, 0xc5, 0x7f, 0x03, 0x00, 0x97, 0xa4, 0x3c, 0x29, 0xfb, 0x06, 0x00,
+	0x00,
 }
 
 func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
@@ -418,6 +665,122 @@ func (this *VersionHistories) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueueState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueState)
+	if !ok {
+		that2, ok := that.(QueueState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
+	if this.AckLevel != that1.AckLevel {
+		return false
+	}
+	if that1.AckLevelTime == nil {
+		if this.AckLevelTime != nil {
+			return false
+		}
+	} else if !this.AckLevelTime.Equal(*that1.AckLevelTime) {
+		return false
+	}
+	if this.ReadLevel != that1.ReadLevel {
+		return false
+	}
+	if that1.ReadLevelTime == nil {
+		if this.ReadLevelTime != nil {
+			return false
+		}
+	} else if !this.ReadLevelTime.Equal(*that1.ReadLevelTime) {
+		return false
+	}
+	if len(this.OutstandingTasks) != len(that1.OutstandingTasks) {
+		return false
+	}
+	for i := range this.OutstandingTasks {
+		if !this.OutstandingTasks[i].Equal(that1.OutstandingTasks[i]) {
+			return false
+		}
+	}
+	if this.OldestPendingTaskAge != nil && that1.OldestPendingTaskAge != nil {
+		if *this.OldestPendingTaskAge != *that1.OldestPendingTaskAge {
+			return false
+		}
+	} else if this.OldestPendingTaskAge != nil {
+		return false
+	} else if that1.OldestPendingTaskAge != nil {
+		return false
+	}
+	return true
+}
+func (this *QueueTaskState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueTaskState)
+	if !ok {
+		that2, ok := that.(QueueTaskState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if this.TaskType != that1.TaskType {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
+		return false
+	}
+	if this.Acked != that1.Acked {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	return true
+}
 func (this *TransientWorkflowTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -470,6 +833,43 @@ func (this *VersionHistories) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *QueueState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&history.QueueState{")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "AckLevel: "+fmt.Sprintf("%#v", this.AckLevel)+",\n")
+	s = append(s, "AckLevelTime: "+fmt.Sprintf("%#v", this.AckLevelTime)+",\n")
+	s = append(s, "ReadLevel: "+fmt.Sprintf("%#v", this.ReadLevel)+",\n")
+	s = append(s, "ReadLevelTime: "+fmt.Sprintf("%#v", this.ReadLevelTime)+",\n")
+	if this.OutstandingTasks != nil {
+		s = append(s, "OutstandingTasks: "+fmt.Sprintf("%#v", this.OutstandingTasks)+",\n")
+	}
+	s = append(s, "OldestPendingTaskAge: "+fmt.Sprintf("%#v", this.OldestPendingTaskAge)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueueTaskState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&history.QueueTaskState{")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "Acked: "+fmt.Sprintf("%#v", this.Acked)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -644,42 +1044,217 @@ func (m *VersionHistories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueueState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TransientWorkflowTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.StartedEvent != nil {
-		l = m.StartedEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+
+func (m *QueueState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersionHistoryItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventId != 0 {
-		n += 1 + sovMessage(uint64(m.EventId))
+	if m.OldestPendingTaskAge != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.OldestPendingTaskAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestPendingTaskAge):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OutstandingTasks) > 0 {
+		for iNdEx := len(m.OutstandingTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ReadLevelTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReadLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadLevelTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReadLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReadLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckLevelTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckLevelTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AckLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.AckLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Category != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueTaskState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueTaskState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueTaskState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Acked {
+		i--
+		if m.Acked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VisibilityTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransientWorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedEvent != nil {
+		l = m.StartedEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionHistoryItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventId != 0 {
+		n += 1 + sovMessage(uint64(m.EventId))
 	}
 	if m.Version != 0 {
 		n += 1 + sovMessage(uint64(m.Version))
@@ -724,6 +1299,87 @@ func (m *VersionHistories) Size() (n int) {
 	return n
 }
 
+func (m *QueueState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Category != 0 {
+		n += 1 + sovMessage(uint64(m.Category))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.AckLevel != 0 {
+		n += 1 + sovMessage(uint64(m.AckLevel))
+	}
+	if m.AckLevelTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckLevelTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ReadLevel != 0 {
+		n += 1 + sovMessage(uint64(m.ReadLevel))
+	}
+	if m.ReadLevelTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadLevelTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.OutstandingTasks) > 0 {
+		for _, e := range m.OutstandingTasks {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.OldestPendingTaskAge != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestPendingTaskAge)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueTaskState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovMessage(uint64(m.TaskId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskType))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Acked {
+		n += 2
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1440,46 @@ func (this *VersionHistories) String() string {
 	}, "")
 	return s
 }
+func (this *QueueState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutstandingTasks := "[]*QueueTaskState{"
+	for _, f := range this.OutstandingTasks {
+		repeatedStringForOutstandingTasks += strings.Replace(f.String(), "QueueTaskState", "QueueTaskState", 1) + ","
+	}
+	repeatedStringForOutstandingTasks += "}"
+	s := strings.Join([]string{`&QueueState{`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
+		`AckLevelTime:` + strings.Replace(fmt.Sprintf("%v", this.AckLevelTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ReadLevel:` + fmt.Sprintf("%v", this.ReadLevel) + `,`,
+		`ReadLevelTime:` + strings.Replace(fmt.Sprintf("%v", this.ReadLevelTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`OutstandingTasks:` + repeatedStringForOutstandingTasks + `,`,
+		`OldestPendingTaskAge:` + strings.Replace(fmt.Sprintf("%v", this.OldestPendingTaskAge), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueTaskState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueTaskState{`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Acked:` + fmt.Sprintf("%v", this.Acked) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1235,6 +1931,584 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueueState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v11.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			}
+			m.AckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevelTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckLevelTime == nil {
+				m.AckLevelTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AckLevelTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadLevel", wireType)
+			}
+			m.ReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadLevelTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadLevelTime == nil {
+				m.ReadLevelTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReadLevelTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingTasks = append(m.OutstandingTasks, &QueueTaskState{})
+			if err := m.OutstandingTasks[len(m.OutstandingTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskAge == nil {
+				m.OldestPendingTaskAge = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.OldestPendingTaskAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueTaskState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueTaskState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueTaskState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acked = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
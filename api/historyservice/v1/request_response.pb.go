@@ -2343,7 +2343,7 @@ func (m *ScheduleWorkflowTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ScheduleWorkflowTaskResponse proto.InternalMessageInfo
 
-//*
+// *
 // RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
 // execution which started it.  When a child execution is completed it creates this request and calls the
 // RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
@@ -2515,6 +2515,8 @@ type DescribeWorkflowExecutionResponse struct {
 	WorkflowExecutionInfo *v110.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
 	PendingActivities     []*v110.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren       []*v110.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	// Cron schedule of the execution, including an optional CRON_TZ/TZ time zone prefix.
+	CronSchedule string `protobuf:"bytes,5,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
@@ -2577,6 +2579,13 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v110.Pending
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
 type ReplicateEventsV2Request struct {
 	NamespaceId         string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -3834,9 +3843,10 @@ func (m *GetDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesResponse struct {
-	Type             v16.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks []*v113.ReplicationTask `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	NextPageToken    []byte                  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Type             v16.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks []*v113.ReplicationTask    `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	NextPageToken    []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryTasks     []*v111.HistoryDLQTaskInfo `protobuf:"bytes,4,rep,name=history_tasks,json=historyTasks,proto3" json:"history_tasks,omitempty"`
 }
 
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
@@ -3892,6 +3902,13 @@ func (m *GetDLQMessagesResponse) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *GetDLQMessagesResponse) GetHistoryTasks() []*v111.HistoryDLQTaskInfo {
+	if m != nil {
+		return m.HistoryTasks
+	}
+	return nil
+}
+
 type PurgeDLQMessagesRequest struct {
 	Type                  v16.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -4206,6 +4223,210 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type DeleteWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+}
+
+func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionResponse struct {
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
+type DescribeShardQueuesRequest struct {
+	ShardId       int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *DescribeShardQueuesRequest) Reset()      { *m = DescribeShardQueuesRequest{} }
+func (*DescribeShardQueuesRequest) ProtoMessage() {}
+func (*DescribeShardQueuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
+}
+func (m *DescribeShardQueuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeShardQueuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeShardQueuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeShardQueuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeShardQueuesRequest.Merge(m, src)
+}
+func (m *DescribeShardQueuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeShardQueuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeShardQueuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeShardQueuesRequest proto.InternalMessageInfo
+
+func (m *DescribeShardQueuesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DescribeShardQueuesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *DescribeShardQueuesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type DescribeShardQueuesResponse struct {
+	ShardId       int32             `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Queues        []*v17.QueueState `protobuf:"bytes,2,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte            `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *DescribeShardQueuesResponse) Reset()      { *m = DescribeShardQueuesResponse{} }
+func (*DescribeShardQueuesResponse) ProtoMessage() {}
+func (*DescribeShardQueuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
+}
+func (m *DescribeShardQueuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeShardQueuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeShardQueuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeShardQueuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeShardQueuesResponse.Merge(m, src)
+}
+func (m *DescribeShardQueuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeShardQueuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeShardQueuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeShardQueuesResponse proto.InternalMessageInfo
+
+func (m *DescribeShardQueuesResponse) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DescribeShardQueuesResponse) GetQueues() []*v17.QueueState {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+func (m *DescribeShardQueuesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
@@ -4281,6 +4502,10 @@ func init() {
 	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeShardQueuesRequest)(nil), "temporal.server.api.historyservice.v1.DescribeShardQueuesRequest")
+	proto.RegisterType((*DescribeShardQueuesResponse)(nil), "temporal.server.api.historyservice.v1.DescribeShardQueuesResponse")
 }
 
 func init() {
@@ -4288,238 +4513,246 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 3696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x49, 0x6c, 0x1c, 0xc7,
-	0xd5, 0x56, 0x73, 0xb8, 0x0c, 0xdf, 0x0c, 0x87, 0x33, 0xcd, 0x6d, 0x48, 0x4a, 0x23, 0xb2, 0xb5,
-	0xd1, 0x8b, 0x86, 0x5a, 0xfc, 0x5b, 0xb2, 0xfe, 0xd8, 0x8e, 0x48, 0x6d, 0x23, 0x58, 0x32, 0xdd,
-	0x64, 0x24, 0xc3, 0x5b, 0xbb, 0x39, 0x5d, 0x24, 0x3b, 0x9c, 0xe9, 0x1e, 0x75, 0xf5, 0x90, 0x1c,
-	0xe7, 0x90, 0x0d, 0x39, 0x38, 0x01, 0x02, 0x01, 0xb9, 0x04, 0x88, 0x73, 0xc9, 0x25, 0x46, 0x80,
-	0x20, 0x87, 0x1c, 0x02, 0x1f, 0x72, 0x0d, 0x72, 0x8b, 0x11, 0x20, 0x88, 0x91, 0x1c, 0x12, 0xcb,
-	0x08, 0x90, 0x20, 0x39, 0xf8, 0x90, 0x43, 0x8e, 0x41, 0x6d, 0x3d, 0xbd, 0xcd, 0x46, 0x4a, 0xb1,
-	0xe3, 0xf8, 0x36, 0x5d, 0xf5, 0xde, 0xab, 0x7a, 0xaf, 0xde, 0xfb, 0xaa, 0xea, 0xd5, 0x1b, 0xf8,
-	0x82, 0x8b, 0xaa, 0x35, 0xdb, 0xd1, 0x2b, 0x8b, 0x18, 0x39, 0x3b, 0xc8, 0x59, 0xd4, 0x6b, 0xe6,
-	0xe2, 0x96, 0x89, 0x5d, 0xdb, 0x69, 0x90, 0x16, 0xb3, 0x8c, 0x16, 0x77, 0xce, 0x2e, 0x3a, 0xe8,
-	0x5e, 0x1d, 0x61, 0x57, 0x73, 0x10, 0xae, 0xd9, 0x16, 0x46, 0xc5, 0x9a, 0x63, 0xbb, 0xb6, 0x7c,
-	0x42, 0x70, 0x17, 0x19, 0x77, 0x51, 0xaf, 0x99, 0xc5, 0x20, 0x77, 0x71, 0xe7, 0xec, 0x4c, 0x61,
-	0xd3, 0xb6, 0x37, 0x2b, 0x68, 0x91, 0x32, 0xad, 0xd7, 0x37, 0x16, 0x8d, 0xba, 0xa3, 0xbb, 0xa6,
-	0x6d, 0x31, 0x31, 0x33, 0x47, 0xc3, 0xfd, 0xae, 0x59, 0x45, 0xd8, 0xd5, 0xab, 0x35, 0x4e, 0x30,
-	0x6f, 0xa0, 0x1a, 0xb2, 0x0c, 0x64, 0x95, 0x4d, 0x84, 0x17, 0x37, 0xed, 0x4d, 0x9b, 0xb6, 0xd3,
-	0x5f, 0x9c, 0xe4, 0xb8, 0xa7, 0x08, 0xd1, 0xa0, 0x6c, 0x57, 0xab, 0xb6, 0x45, 0x66, 0x5e, 0x45,
-	0x18, 0xeb, 0x9b, 0x7c, 0xc2, 0x33, 0x27, 0x02, 0x54, 0x7c, 0xa6, 0x51, 0xb2, 0x53, 0x01, 0x32,
-	0x57, 0xc7, 0xdb, 0xf7, 0xea, 0xa8, 0x8e, 0xa2, 0x84, 0xc1, 0x51, 0x91, 0x55, 0xaf, 0x62, 0x42,
-	0xb4, 0x6b, 0x3b, 0xdb, 0x1b, 0x15, 0x7b, 0x97, 0x53, 0x9d, 0x0c, 0x50, 0x89, 0xce, 0xa8, 0xb4,
-	0x63, 0x01, 0xba, 0x7b, 0x75, 0x14, 0x37, 0xb7, 0xa0, 0x0a, 0x1b, 0xba, 0x59, 0xa9, 0x3b, 0x31,
-	0x33, 0x7b, 0xb2, 0xcd, 0xc2, 0x46, 0xa9, 0x1f, 0x8b, 0xa3, 0xf6, 0xd4, 0x61, 0xd6, 0xe4, 0xa4,
-	0x4f, 0xb4, 0x25, 0x0d, 0x69, 0x7e, 0xaa, 0x2d, 0x31, 0x31, 0x2c, 0x27, 0x3c, 0x1d, 0x47, 0xd8,
-	0xda, 0x52, 0xc5, 0x38, 0x72, 0x4b, 0xaf, 0x22, 0x5c, 0xd3, 0xcb, 0x31, 0xd6, 0x38, 0x13, 0x47,
-	0xef, 0xa0, 0x5a, 0xc5, 0x2c, 0x53, 0x47, 0x8c, 0x72, 0x3c, 0x1f, 0xc7, 0x51, 0x43, 0x0e, 0x36,
-	0xb1, 0x8b, 0x2c, 0x36, 0x86, 0x98, 0x9f, 0x56, 0xad, 0xbb, 0xfa, 0x7a, 0x05, 0x69, 0xd8, 0xd5,
-	0x5d, 0x21, 0xe0, 0xe9, 0xd8, 0x45, 0xef, 0x18, 0x53, 0x33, 0x97, 0xe2, 0x06, 0xd6, 0x8d, 0xaa,
-	0x69, 0x75, 0xe4, 0x55, 0xbe, 0x33, 0x08, 0x47, 0x56, 0x5d, 0xdd, 0x71, 0xef, 0xf2, 0xe1, 0xae,
-	0xee, 0xa1, 0x72, 0x9d, 0x28, 0xa8, 0x32, 0x06, 0x79, 0x1e, 0xd2, 0x9e, 0x99, 0x34, 0xd3, 0xc8,
-	0x4b, 0x73, 0xd2, 0xc2, 0xb0, 0x9a, 0xf2, 0xda, 0x4a, 0x86, 0x5c, 0x86, 0x11, 0x4c, 0x64, 0x68,
-	0x7c, 0x90, 0x7c, 0xdf, 0x9c, 0xb4, 0x90, 0x3a, 0xf7, 0x9c, 0x67, 0x73, 0x1a, 0xe5, 0x21, 0x85,
-	0x8a, 0x3b, 0x67, 0x8b, 0x6d, 0x47, 0x56, 0xd3, 0x54, 0xa8, 0x98, 0xc7, 0x16, 0x4c, 0xd4, 0x74,
-	0x07, 0x59, 0xae, 0x86, 0x04, 0xa1, 0x66, 0x5a, 0x1b, 0x76, 0x3e, 0x41, 0x07, 0x7b, 0xaa, 0x18,
-	0x87, 0x2c, 0x9e, 0x73, 0xed, 0x9c, 0x2d, 0xae, 0x50, 0x6e, 0x6f, 0x94, 0x92, 0xb5, 0x61, 0xab,
-	0x63, 0xb5, 0x68, 0xa3, 0x9c, 0x87, 0x21, 0xdd, 0x25, 0xd2, 0xdc, 0x7c, 0xff, 0x9c, 0xb4, 0x30,
-	0xa0, 0x8a, 0x4f, 0xb9, 0x0a, 0x8a, 0xb7, 0x82, 0xcd, 0x59, 0xa0, 0xbd, 0x9a, 0xc9, 0xd0, 0x49,
-	0x23, 0x30, 0x94, 0x1f, 0xa0, 0x13, 0x9a, 0x29, 0x32, 0x8c, 0x2a, 0x0a, 0x8c, 0x2a, 0xae, 0x09,
-	0x8c, 0x5a, 0xea, 0xbf, 0xff, 0xa7, 0xa3, 0x92, 0x7a, 0x74, 0x37, 0xac, 0xf9, 0x55, 0x4f, 0x12,
-	0xa1, 0x95, 0xb7, 0x60, 0xba, 0x6c, 0x5b, 0xae, 0x69, 0xd5, 0x91, 0xa6, 0x63, 0xcd, 0x42, 0xbb,
-	0x9a, 0x69, 0x99, 0xae, 0xa9, 0xbb, 0xb6, 0x93, 0x1f, 0x9c, 0x93, 0x16, 0x32, 0xe7, 0x4e, 0x07,
-	0x6d, 0x4c, 0x03, 0x85, 0x28, 0xbb, 0xcc, 0xf9, 0x2e, 0xe3, 0xdb, 0x68, 0xb7, 0x24, 0x98, 0xd4,
-	0xc9, 0x72, 0x6c, 0xbb, 0x7c, 0x0b, 0x72, 0xa2, 0xc7, 0xd0, 0x38, 0x42, 0xe4, 0x87, 0xa8, 0x1e,
-	0x73, 0xc1, 0x11, 0x78, 0x27, 0x19, 0xe3, 0x1a, 0xfb, 0xa9, 0x66, 0x3d, 0x56, 0xde, 0x22, 0xdf,
-	0x81, 0xc9, 0x8a, 0x8e, 0x5d, 0xad, 0x6c, 0x57, 0x6b, 0x15, 0x44, 0x2d, 0xe3, 0x20, 0x5c, 0xaf,
-	0xb8, 0xf9, 0x64, 0x9c, 0x4c, 0x8e, 0x16, 0x74, 0x8d, 0x1a, 0x15, 0x5b, 0x37, 0xb0, 0x3a, 0x4e,
-	0xf8, 0x97, 0x3d, 0x76, 0x95, 0x72, 0xcb, 0x6f, 0xc0, 0xec, 0x86, 0xe9, 0x60, 0x57, 0xf3, 0x56,
-	0x81, 0x00, 0x82, 0xb6, 0xae, 0x97, 0xb7, 0xed, 0x8d, 0x8d, 0xfc, 0x30, 0x15, 0x3e, 0x1d, 0x31,
-	0xfc, 0x15, 0xbe, 0x79, 0x2c, 0xf5, 0x7f, 0x9f, 0xd8, 0x3d, 0x4f, 0x65, 0x08, 0xb7, 0x5b, 0xd3,
-	0xf1, 0xf6, 0x12, 0x13, 0xa0, 0x5c, 0x80, 0x42, 0x2b, 0x97, 0x64, 0x51, 0x23, 0x4f, 0xc0, 0xa0,
-	0x53, 0xb7, 0x9a, 0x71, 0x30, 0xe0, 0xd4, 0xad, 0x92, 0xa1, 0xfc, 0x5d, 0x82, 0xc9, 0xeb, 0xc8,
-	0xbd, 0xc5, 0xa2, 0x7a, 0x95, 0x04, 0x75, 0x0f, 0xf1, 0x73, 0x1d, 0x86, 0x3d, 0x6f, 0xe2, 0xb1,
-	0xf3, 0x58, 0x2b, 0x0b, 0x45, 0xa7, 0xd6, 0xe4, 0x95, 0xcf, 0xc3, 0x24, 0xda, 0xab, 0xa1, 0xb2,
-	0x8b, 0x0c, 0xcd, 0x42, 0x7b, 0xae, 0x86, 0x76, 0x48, 0xc0, 0x98, 0x06, 0x0d, 0x92, 0x84, 0x3a,
-	0x26, 0x7a, 0x6f, 0xa3, 0x3d, 0xf7, 0x2a, 0xe9, 0x2b, 0x19, 0xf2, 0x19, 0x18, 0x2f, 0xd7, 0x1d,
-	0x1a, 0x59, 0xeb, 0x8e, 0x6e, 0x95, 0xb7, 0x34, 0xd7, 0xde, 0x46, 0x16, 0xf5, 0xfd, 0xb4, 0x2a,
-	0xf3, 0xbe, 0x25, 0xda, 0xb5, 0x46, 0x7a, 0x94, 0x9f, 0x24, 0x61, 0x2a, 0xa2, 0x2d, 0x37, 0x50,
-	0x40, 0x17, 0xe9, 0x00, 0xba, 0x94, 0x60, 0xa4, 0xb9, 0xca, 0x8d, 0x1a, 0xe2, 0x86, 0x39, 0xde,
-	0x49, 0xd8, 0x5a, 0xa3, 0x86, 0xd4, 0xf4, 0xae, 0xef, 0x4b, 0x56, 0x60, 0x24, 0xce, 0x1a, 0x29,
-	0xcb, 0x67, 0x85, 0x67, 0x60, 0xba, 0xe6, 0xa0, 0x1d, 0xd3, 0xae, 0x63, 0x8d, 0xe2, 0x0e, 0x32,
-	0x9a, 0xf4, 0xfd, 0x94, 0x7e, 0x52, 0x10, 0xac, 0xb2, 0x7e, 0xc1, 0x7a, 0x1a, 0xc6, 0xa8, 0xb7,
-	0x33, 0xd7, 0xf4, 0x98, 0x06, 0x28, 0x53, 0x96, 0x74, 0x5d, 0x23, 0x3d, 0x82, 0x7c, 0x19, 0x80,
-	0x7a, 0x2d, 0x3d, 0x20, 0xd0, 0x30, 0x8e, 0x68, 0xe5, 0x9d, 0x1f, 0x88, 0x62, 0xc4, 0x41, 0x5f,
-	0x22, 0x1f, 0xea, 0xb0, 0x2b, 0x7e, 0xca, 0x2b, 0x90, 0xc3, 0xae, 0x59, 0xde, 0x6e, 0x68, 0x3e,
-	0x59, 0x43, 0x3d, 0xc8, 0x1a, 0x65, 0xec, 0x5e, 0x83, 0xfc, 0x15, 0x78, 0x22, 0x22, 0x51, 0xc3,
-	0xe5, 0x2d, 0x64, 0xd4, 0x2b, 0x48, 0x73, 0x6d, 0x66, 0x15, 0x8a, 0x70, 0x76, 0xdd, 0xcd, 0xa7,
-	0xba, 0x8b, 0xb5, 0x13, 0xa1, 0x61, 0x56, 0xb9, 0xc0, 0x35, 0x9b, 0x1a, 0x71, 0x8d, 0x49, 0x6b,
-	0xe9, 0x83, 0x23, 0xad, 0x7c, 0x50, 0x7e, 0x15, 0x32, 0x9e, 0x7b, 0xd0, 0x4d, 0x34, 0x3f, 0x4a,
-	0x01, 0x31, 0x7e, 0x1f, 0xf0, 0x70, 0x31, 0xe2, 0x72, 0xcc, 0x7b, 0x3d, 0x57, 0xa3, 0x9f, 0xf2,
-	0x5d, 0x18, 0x0d, 0x08, 0xaf, 0xe3, 0x7c, 0x96, 0x4a, 0x2f, 0xb6, 0x80, 0xdb, 0x58, 0xb1, 0x75,
-	0xac, 0x66, 0xfc, 0x72, 0xeb, 0x58, 0x7e, 0x1d, 0x72, 0x3b, 0xe4, 0x44, 0x60, 0x5b, 0x1a, 0x3b,
-	0x59, 0x99, 0x08, 0xe7, 0x73, 0xd4, 0x94, 0x67, 0x8a, 0x6d, 0x8e, 0xc6, 0x64, 0x8c, 0x3b, 0x8c,
-	0xf1, 0x86, 0xe0, 0x53, 0xb3, 0x3b, 0xa1, 0x16, 0xf9, 0x39, 0x38, 0x6c, 0x12, 0xf7, 0x0d, 0x2f,
-	0x23, 0xb2, 0x48, 0xa0, 0x1a, 0x79, 0x79, 0x4e, 0x5a, 0x48, 0xaa, 0x79, 0x13, 0xaf, 0x06, 0x57,
-	0xe5, 0x2a, 0xeb, 0x97, 0x9f, 0x82, 0xa9, 0x88, 0x27, 0xbb, 0x7b, 0x14, 0xee, 0xc6, 0x18, 0x80,
-	0x04, 0xbd, 0x79, 0x6d, 0xcf, 0x2a, 0x19, 0x37, 0xfb, 0x93, 0xc9, 0xec, 0xf0, 0xcd, 0xfe, 0xe4,
-	0x70, 0x16, 0x6e, 0xf6, 0x27, 0x21, 0x9b, 0xba, 0xd9, 0x9f, 0x4c, 0x67, 0x47, 0x6e, 0xf6, 0x27,
-	0x33, 0xd9, 0x51, 0xe5, 0x1f, 0x12, 0x4c, 0xad, 0xd8, 0x95, 0xca, 0xff, 0x08, 0x36, 0xfe, 0x65,
-	0x08, 0xf2, 0x51, 0x75, 0x3f, 0x07, 0xc7, 0xcf, 0xc1, 0xf1, 0xa1, 0x83, 0x63, 0xba, 0x25, 0x38,
-	0xc6, 0xc2, 0x4c, 0xe6, 0xa1, 0xc1, 0xcc, 0x7f, 0x27, 0xf6, 0xb6, 0x01, 0xb7, 0x5c, 0x6f, 0xe0,
-	0x36, 0x92, 0xcd, 0x28, 0x6f, 0x4b, 0x30, 0xab, 0x22, 0x8c, 0xdc, 0x10, 0x94, 0x7e, 0x02, 0xd0,
-	0xa6, 0x14, 0xe0, 0x70, 0xfc, 0x54, 0x18, 0xec, 0x28, 0x7f, 0xe8, 0x83, 0x39, 0x15, 0x95, 0x6d,
-	0xc7, 0xf0, 0x1f, 0x7a, 0x79, 0xa0, 0xf6, 0x30, 0xe1, 0x97, 0x41, 0x8e, 0x5e, 0x7f, 0x7a, 0x9f,
-	0x79, 0x2e, 0x72, 0xef, 0x91, 0x8f, 0x42, 0xca, 0x8b, 0x26, 0x0f, 0x82, 0x40, 0x34, 0x95, 0x0c,
-	0x79, 0x0a, 0x86, 0x68, 0xe4, 0x79, 0x78, 0x33, 0x48, 0x3e, 0x4b, 0x86, 0x7c, 0x04, 0x40, 0x5c,
-	0x6d, 0x39, 0xac, 0x0c, 0xab, 0xc3, 0xbc, 0xa5, 0x64, 0xc8, 0x6f, 0x42, 0xba, 0x66, 0x57, 0x2a,
-	0xde, 0xcd, 0x94, 0x21, 0xca, 0xb3, 0x1d, 0x6f, 0xa6, 0x04, 0xc2, 0xfd, 0xc6, 0xf2, 0xaf, 0xad,
-	0x9a, 0x22, 0x22, 0xf9, 0x87, 0xf2, 0xbb, 0x21, 0x98, 0x6f, 0x63, 0x5c, 0x8e, 0xfc, 0x11, 0xc0,
-	0x96, 0xf6, 0x0d, 0xd8, 0x6d, 0xc1, 0xb8, 0xaf, 0x2d, 0x18, 0x3f, 0x09, 0xb2, 0xb0, 0xa9, 0x11,
-	0x06, 0xfc, 0xac, 0xd7, 0x23, 0xa8, 0x17, 0x20, 0xdb, 0x02, 0xec, 0x33, 0x38, 0x28, 0x37, 0xb2,
-	0x87, 0x0c, 0x44, 0xf7, 0x10, 0xdf, 0xad, 0x7a, 0x30, 0x78, 0xab, 0xbe, 0x08, 0x79, 0x0e, 0xae,
-	0xbe, 0x3b, 0x35, 0x3f, 0xb1, 0x0c, 0xd1, 0x13, 0xcb, 0x24, 0xeb, 0x6f, 0xde, 0x93, 0xf9, 0x79,
-	0x65, 0xd3, 0xe7, 0x90, 0xcc, 0x3d, 0xac, 0x0d, 0x9b, 0xdf, 0x31, 0x9f, 0xe9, 0x04, 0x74, 0x6b,
-	0x8e, 0x6e, 0x61, 0x13, 0x59, 0x81, 0x9b, 0x20, 0xcd, 0x0a, 0x64, 0x77, 0x43, 0x2d, 0xf2, 0x26,
-	0x1c, 0x89, 0xb9, 0xf8, 0xfb, 0x76, 0x97, 0xe1, 0x1e, 0x76, 0x97, 0x99, 0x88, 0xff, 0x37, 0x37,
-	0x9a, 0x79, 0x48, 0x07, 0x30, 0x3e, 0x45, 0x31, 0x3e, 0xb5, 0xee, 0x03, 0xf7, 0xeb, 0x90, 0x69,
-	0x2e, 0x22, 0x4d, 0x38, 0xa4, 0xbb, 0x4c, 0x38, 0x8c, 0x78, 0x7c, 0x34, 0xbd, 0xb0, 0x0c, 0x69,
-	0xb1, 0xbe, 0x54, 0xcc, 0x48, 0x97, 0x62, 0x52, 0x9c, 0x8b, 0x0a, 0xb1, 0x61, 0xe8, 0x5e, 0x1d,
-	0xf1, 0x0d, 0x26, 0xb1, 0x90, 0x3a, 0xf7, 0xa5, 0x62, 0x57, 0x29, 0xde, 0x62, 0xc7, 0x98, 0x29,
-	0xbe, 0xc4, 0xe4, 0x5e, 0xb5, 0x5c, 0xa7, 0xa1, 0x8a, 0x51, 0x66, 0xde, 0x84, 0xb4, 0xbf, 0x43,
-	0xce, 0x42, 0x62, 0x1b, 0x35, 0x38, 0x5c, 0x91, 0x9f, 0xf2, 0x25, 0x18, 0xd8, 0xd1, 0x2b, 0xf5,
-	0x16, 0x87, 0x22, 0x9a, 0x24, 0xf5, 0x87, 0x18, 0x91, 0xd6, 0x50, 0x19, 0xcb, 0xa5, 0xbe, 0x8b,
-	0x12, 0x83, 0x79, 0x1f, 0x68, 0x5e, 0x2e, 0xbb, 0xe6, 0x8e, 0xe9, 0x36, 0x3e, 0x07, 0xcd, 0x2e,
-	0x40, 0xd3, 0x6f, 0xac, 0xd6, 0xa0, 0xf9, 0x8d, 0x7e, 0x01, 0x9a, 0xb1, 0xc6, 0xe5, 0xa0, 0x79,
-	0x1b, 0x46, 0x43, 0x70, 0xc5, 0x61, 0xf3, 0x44, 0x70, 0x2a, 0xbe, 0xa0, 0x66, 0x87, 0x94, 0x06,
-	0x05, 0x1d, 0x35, 0x13, 0x84, 0xb4, 0x88, 0xc3, 0xf7, 0xed, 0xc7, 0xe1, 0x7d, 0x38, 0x96, 0x08,
-	0xe2, 0x18, 0x82, 0x82, 0x38, 0xa7, 0xf1, 0x26, 0x2d, 0x14, 0xa8, 0xfd, 0x5d, 0x0e, 0x38, 0xcb,
-	0xe5, 0x5c, 0x66, 0x62, 0x56, 0x03, 0x61, 0x7b, 0x0b, 0x72, 0x5b, 0x48, 0x77, 0xdc, 0x75, 0xa4,
-	0xbb, 0x9a, 0x81, 0x5c, 0xdd, 0xac, 0x60, 0x9e, 0x73, 0xec, 0x9c, 0x57, 0xcb, 0x7a, 0xac, 0x57,
-	0x18, 0x67, 0x74, 0x67, 0x1a, 0xdc, 0xf7, 0xce, 0x74, 0xda, 0xe7, 0xea, 0x5e, 0x08, 0x50, 0x08,
-	0x1f, 0x6e, 0xfa, 0xef, 0x6d, 0xd1, 0xa1, 0xbc, 0x27, 0xc1, 0x31, 0xb6, 0xd6, 0x01, 0x18, 0xe0,
-	0x59, 0xbf, 0x9e, 0x82, 0xcc, 0x86, 0x2c, 0xcf, 0x35, 0xa2, 0x50, 0x12, 0xfa, 0x4a, 0x47, 0xaf,
-	0xed, 0x62, 0x0a, 0xea, 0xa8, 0x90, 0x2e, 0x1c, 0xf8, 0x07, 0x12, 0x1c, 0x6f, 0xcf, 0xc8, 0x7d,
-	0x18, 0x37, 0x37, 0x51, 0x91, 0x7a, 0xe7, 0x4e, 0x7c, 0xe3, 0x61, 0x01, 0x25, 0xb9, 0xae, 0x04,
-	0x1a, 0x94, 0x9f, 0x49, 0x04, 0xbb, 0x22, 0xb3, 0xbb, 0xa6, 0x9b, 0x95, 0x9e, 0xcc, 0xba, 0x05,
-	0x99, 0x0d, 0xca, 0x13, 0x32, 0xea, 0xe5, 0xfd, 0x18, 0x35, 0x30, 0xba, 0x3a, 0xb2, 0xe1, 0xff,
-	0x54, 0x8e, 0x11, 0x3c, 0x68, 0xc9, 0xc2, 0xd5, 0x7a, 0x4f, 0x02, 0x25, 0x8a, 0x1a, 0x37, 0x84,
-	0x47, 0xf7, 0xa0, 0x58, 0xcd, 0x1f, 0x43, 0x41, 0xdd, 0x96, 0xbb, 0xd0, 0xad, 0xd3, 0x14, 0x7c,
-	0x61, 0x26, 0x14, 0x5c, 0x21, 0xbe, 0xde, 0x86, 0x8f, 0xbb, 0xcb, 0x63, 0x90, 0x2d, 0xeb, 0x56,
-	0x19, 0x79, 0xe0, 0x8b, 0xd8, 0xfc, 0x93, 0xea, 0x28, 0x6b, 0x57, 0x45, 0xb3, 0x3f, 0x7c, 0xfc,
-	0x32, 0x3f, 0xa1, 0xf0, 0x69, 0x37, 0x85, 0x68, 0xf8, 0x9c, 0xf4, 0xa2, 0xa7, 0x05, 0x5f, 0xd4,
-	0x91, 0xfd, 0x84, 0xff, 0x79, 0x47, 0x6e, 0x39, 0x7a, 0x6b, 0x47, 0x8e, 0x63, 0xe1, 0x6a, 0xfd,
-	0x9c, 0x3a, 0x72, 0x54, 0x7f, 0xba, 0xc2, 0x3d, 0x29, 0xf6, 0x65, 0xc8, 0x04, 0xfd, 0xa5, 0x07,
-	0x2f, 0xee, 0x34, 0xbe, 0x3a, 0x12, 0x70, 0x39, 0xe5, 0x44, 0xbc, 0xbf, 0x79, 0x4c, 0x5c, 0xb9,
-	0x5f, 0xf5, 0x41, 0x61, 0xd5, 0xdc, 0xb4, 0xf4, 0xca, 0x41, 0xde, 0x14, 0x37, 0x20, 0x83, 0xa9,
-	0x90, 0x90, 0x62, 0xcf, 0x77, 0x7e, 0x54, 0x6c, 0x3b, 0xb6, 0x3a, 0xc2, 0xc4, 0x8a, 0xa9, 0x98,
-	0x30, 0x8b, 0xf6, 0x5c, 0xe4, 0x90, 0x91, 0x62, 0xce, 0x69, 0x89, 0x5e, 0xcf, 0x69, 0xd3, 0x42,
-	0x5a, 0xa4, 0x4b, 0x2e, 0xc2, 0x58, 0x79, 0xcb, 0xac, 0x18, 0xcd, 0x71, 0x6c, 0xab, 0xd2, 0xa0,
-	0x87, 0x82, 0xa4, 0x9a, 0xa3, 0x5d, 0x82, 0xe9, 0x45, 0xab, 0xd2, 0x50, 0xe6, 0xe1, 0x68, 0x4b,
-	0x5d, 0xb8, 0xad, 0x7f, 0x2b, 0xc1, 0x29, 0x4e, 0x63, 0xba, 0x5b, 0x07, 0x7e, 0xc8, 0xfd, 0xa6,
-	0x04, 0xd3, 0xdc, 0xea, 0xbb, 0xa6, 0xbb, 0xa5, 0xc5, 0xbd, 0xea, 0xde, 0xe8, 0x76, 0x01, 0x3a,
-	0x4d, 0x48, 0x9d, 0xc4, 0x41, 0x42, 0xe1, 0x67, 0x97, 0x61, 0xa1, 0xb3, 0x88, 0xf6, 0xef, 0x71,
-	0xbf, 0x94, 0xe0, 0xa8, 0x8a, 0xaa, 0xf6, 0x0e, 0x62, 0x92, 0xf6, 0x99, 0x7c, 0x7e, 0x74, 0x67,
-	0xf7, 0xe0, 0x09, 0x3c, 0x11, 0x3a, 0x81, 0x2b, 0x0a, 0x81, 0xbd, 0x56, 0xd3, 0xe7, 0x6b, 0xff,
-	0x0b, 0x09, 0xe6, 0xd7, 0x90, 0x53, 0x35, 0x2d, 0xdd, 0x45, 0x07, 0x59, 0x75, 0x1b, 0x72, 0xae,
-	0x90, 0x13, 0x5a, 0xec, 0xa5, 0x8e, 0x8b, 0xdd, 0x71, 0x06, 0x6a, 0xd6, 0x13, 0x2e, 0x16, 0xf8,
-	0x38, 0x28, 0xed, 0xd8, 0xb8, 0x7e, 0x3f, 0x96, 0xe0, 0x08, 0x4d, 0x6b, 0x1d, 0xb0, 0x34, 0xc1,
-	0x21, 0x32, 0x7a, 0x2e, 0x4d, 0x68, 0x3b, 0xb2, 0x9a, 0xa6, 0x42, 0x85, 0x3e, 0x17, 0xa0, 0xd0,
-	0x8a, 0xbc, 0xbd, 0x9b, 0x7e, 0x2f, 0x01, 0x27, 0xb8, 0x10, 0x06, 0xa3, 0x07, 0x51, 0xb5, 0xda,
-	0x62, 0x2b, 0xb8, 0xd6, 0x85, 0xae, 0x5d, 0x4c, 0x21, 0xb4, 0x1b, 0xc8, 0xcf, 0xfa, 0x80, 0x93,
-	0x57, 0x25, 0x44, 0x93, 0x4a, 0x79, 0x41, 0x52, 0x12, 0x14, 0x22, 0x1d, 0xd4, 0x01, 0x77, 0xfb,
-	0x1f, 0x3d, 0xee, 0x0e, 0xb4, 0xc2, 0xdd, 0x05, 0x38, 0xd9, 0xc9, 0x22, 0xdc, 0x45, 0x7f, 0x23,
-	0xc1, 0xac, 0xb8, 0x9c, 0xf9, 0xcf, 0xad, 0x9f, 0x0a, 0x88, 0x39, 0x0f, 0x93, 0x26, 0xd6, 0x62,
-	0xea, 0x25, 0xe8, 0xda, 0x24, 0xd5, 0x31, 0x13, 0x5f, 0x0b, 0x17, 0x42, 0x28, 0x05, 0x38, 0x1c,
-	0xaf, 0x10, 0xd7, 0xf8, 0x9f, 0x7d, 0xe4, 0xe4, 0x46, 0xce, 0xb1, 0xcb, 0xc4, 0x6e, 0xde, 0x68,
-	0xfb, 0x39, 0x75, 0x3e, 0x3a, 0xd5, 0xe7, 0x21, 0xdd, 0x74, 0xc9, 0xe6, 0x93, 0x96, 0xd7, 0x56,
-	0x32, 0xe4, 0x57, 0x60, 0x4c, 0x1c, 0x4a, 0x8d, 0x83, 0xf8, 0x9d, 0xec, 0x49, 0x69, 0x0e, 0xbf,
-	0xe2, 0x1d, 0xa7, 0x69, 0x2a, 0x93, 0x26, 0x2e, 0x06, 0x7a, 0x49, 0x5c, 0x8c, 0x36, 0xd9, 0x69,
-	0x83, 0x72, 0x8a, 0xe0, 0x44, 0x5b, 0xab, 0xf3, 0xf5, 0xf9, 0x91, 0x04, 0x73, 0x57, 0x10, 0x2e,
-	0x3b, 0xe6, 0xfa, 0x81, 0xf6, 0x84, 0x57, 0x61, 0xa8, 0xd7, 0x93, 0x72, 0xa7, 0x61, 0x55, 0x21,
-	0x51, 0x79, 0x37, 0x01, 0xf3, 0x6d, 0xa8, 0x39, 0x66, 0xbe, 0x06, 0xd9, 0x66, 0xaa, 0xb5, 0x6c,
-	0x5b, 0x1b, 0xe6, 0x26, 0xbf, 0x39, 0x9f, 0x8d, 0x9f, 0x4b, 0xec, 0x02, 0x2d, 0x53, 0x46, 0x75,
-	0x14, 0x05, 0x1b, 0xe4, 0x4d, 0x98, 0x8a, 0xc9, 0xe8, 0xd2, 0xfc, 0x31, 0x53, 0x78, 0xb1, 0x87,
-	0x41, 0x68, 0xd6, 0x78, 0x62, 0x37, 0xae, 0x59, 0x7e, 0x0d, 0xe4, 0x1a, 0xb2, 0x0c, 0xd3, 0xda,
-	0xd4, 0x74, 0x76, 0x6c, 0x36, 0x11, 0xce, 0x27, 0x68, 0xae, 0xf4, 0x74, 0xeb, 0x31, 0x56, 0x18,
-	0x8f, 0x38, 0x69, 0xd3, 0x11, 0x72, 0xb5, 0x40, 0xa3, 0x89, 0xb0, 0xfc, 0x06, 0x64, 0x85, 0x74,
-	0x0a, 0x64, 0x0e, 0x7d, 0x9c, 0x26, 0xb2, 0xcf, 0x77, 0x94, 0x1d, 0xf4, 0x25, 0x3a, 0xc2, 0x68,
-	0xcd, 0xd7, 0xe5, 0x20, 0x4b, 0xf9, 0x7a, 0x02, 0xf2, 0x2a, 0xaf, 0x7a, 0x44, 0xd4, 0x17, 0xf1,
-	0x9d, 0x73, 0x9f, 0x8a, 0x18, 0xdf, 0x80, 0x89, 0xe0, 0x1b, 0x67, 0x43, 0x33, 0x5d, 0x54, 0x15,
-	0xa6, 0x3d, 0xd7, 0xd3, 0x3b, 0x67, 0xa3, 0xe4, 0xa2, 0xaa, 0x3a, 0xb6, 0x13, 0x69, 0xc3, 0xf2,
-	0x45, 0x18, 0xa4, 0x11, 0x8c, 0x39, 0x36, 0xb4, 0xcc, 0xb1, 0x5d, 0xd1, 0x5d, 0x7d, 0xa9, 0x62,
-	0xaf, 0xab, 0x9c, 0x5e, 0xbe, 0x06, 0x19, 0x0b, 0xed, 0x6a, 0x64, 0xe3, 0xe7, 0x12, 0x06, 0xba,
-	0x94, 0x90, 0xb6, 0xd0, 0xae, 0x5a, 0x67, 0xb1, 0x8f, 0x95, 0x59, 0x98, 0x8e, 0x59, 0x02, 0x1e,
-	0xf0, 0x3f, 0x94, 0x60, 0x72, 0xb5, 0x61, 0x95, 0x57, 0xb7, 0x74, 0xc7, 0xe0, 0x2f, 0x9f, 0x7c,
-	0x79, 0x4e, 0x40, 0x06, 0xdb, 0x75, 0xa7, 0x8c, 0xb4, 0x72, 0xa5, 0x8e, 0x5d, 0xe4, 0xf0, 0x05,
-	0x1a, 0x61, 0xad, 0xcb, 0xac, 0x51, 0x9e, 0x86, 0x24, 0x26, 0xcc, 0xe2, 0xf9, 0x68, 0x40, 0x1d,
-	0xa2, 0xdf, 0x25, 0x43, 0xbe, 0x0c, 0x29, 0xf6, 0x04, 0xcb, 0xd2, 0x97, 0x89, 0x2e, 0xd3, 0x97,
-	0xc0, 0x98, 0x48, 0xb3, 0x32, 0x0d, 0x53, 0x91, 0xe9, 0x89, 0xcb, 0xcb, 0x00, 0x8c, 0x91, 0x3e,
-	0xe1, 0xe3, 0x3d, 0xb8, 0xd5, 0x51, 0x48, 0x79, 0x6e, 0xc5, 0xa7, 0x3d, 0xac, 0x82, 0x68, 0x2a,
-	0x19, 0xbe, 0x03, 0x57, 0xc2, 0x77, 0xe0, 0x92, 0xf3, 0x30, 0xc4, 0xd7, 0x98, 0x67, 0xc4, 0xc5,
-	0x27, 0x19, 0xb4, 0x99, 0xac, 0x6d, 0xbe, 0x60, 0x79, 0x6d, 0xf4, 0xbd, 0x36, 0xfc, 0xf0, 0x32,
-	0xb8, 0xbf, 0x87, 0x97, 0x23, 0x00, 0x22, 0x27, 0x68, 0xb2, 0x27, 0xae, 0x84, 0x3a, 0xcc, 0x5b,
-	0x68, 0x0d, 0x44, 0x30, 0x4d, 0x9d, 0xdc, 0x4f, 0x9a, 0x7a, 0x85, 0xd7, 0x5d, 0x34, 0xd3, 0x5c,
-	0x54, 0xd6, 0x70, 0x97, 0xb2, 0x72, 0x84, 0xd9, 0x4b, 0x4f, 0x51, 0x89, 0x97, 0x60, 0x48, 0x64,
-	0x9b, 0xa1, 0xcb, 0x6c, 0xb3, 0x60, 0xf0, 0x27, 0xcd, 0x53, 0xc1, 0xa4, 0xf9, 0x32, 0xa4, 0xd9,
-	0xab, 0x3c, 0x2f, 0x3a, 0x4d, 0x77, 0x59, 0x74, 0x9a, 0xa2, 0x8f, 0xf5, 0xbc, 0xde, 0xf4, 0x0c,
-	0xd0, 0x7a, 0x51, 0x7a, 0xcc, 0x41, 0x8e, 0x66, 0x1a, 0xc8, 0x72, 0x4d, 0xb7, 0x41, 0x5f, 0xb4,
-	0x86, 0x55, 0x99, 0xf4, 0xdd, 0xa5, 0x5d, 0x25, 0xde, 0x23, 0xdf, 0x85, 0xd1, 0x10, 0x7a, 0xf0,
-	0xfa, 0x88, 0x62, 0x6f, 0xb8, 0xa1, 0x66, 0x82, 0x98, 0xa1, 0x4c, 0xc2, 0x78, 0xd0, 0xa7, 0xb9,
-	0xb3, 0xbf, 0x2d, 0xc1, 0xac, 0xd8, 0xf3, 0x3e, 0xe1, 0x52, 0x28, 0xe5, 0x5f, 0x12, 0x1c, 0x8e,
-	0x9f, 0x0b, 0xdf, 0x7a, 0xb7, 0x60, 0xac, 0xac, 0x97, 0xb7, 0x50, 0xb0, 0x4c, 0x9d, 0xef, 0xbe,
-	0x17, 0x63, 0x2d, 0xe4, 0x2b, 0x74, 0xf7, 0x8f, 0x1f, 0x10, 0x9f, 0xa3, 0x42, 0xfd, 0x4d, 0xb2,
-	0x05, 0x93, 0x86, 0xee, 0xea, 0xeb, 0x3a, 0x0e, 0x0f, 0xd6, 0x77, 0xc0, 0xc1, 0xc6, 0x85, 0x5c,
-	0x7f, 0xab, 0xf2, 0x7b, 0x09, 0x66, 0x84, 0xea, 0x7c, 0xc9, 0x6e, 0xd8, 0xd8, 0x9f, 0x3a, 0xde,
-	0xb2, 0xb1, 0xab, 0xe9, 0x86, 0xe1, 0x20, 0x8c, 0xc5, 0x2a, 0x90, 0xb6, 0xcb, 0xac, 0xa9, 0x1d,
-	0x5c, 0x86, 0xd7, 0x30, 0xd1, 0xed, 0x7e, 0xd8, 0x7f, 0xf0, 0xfd, 0x50, 0xb9, 0xdf, 0xd7, 0x74,
-	0xb0, 0x80, 0x66, 0x7c, 0x4d, 0x8f, 0xc1, 0x08, 0x9d, 0x27, 0xd6, 0xac, 0x7a, 0x75, 0x9d, 0x6f,
-	0x06, 0x03, 0x6a, 0x9a, 0x35, 0xde, 0xa6, 0x6d, 0xf2, 0x2c, 0x0c, 0x0b, 0xe5, 0x70, 0xbe, 0x6f,
-	0x2e, 0xb1, 0x30, 0xa0, 0x26, 0xb9, 0x76, 0x58, 0x7e, 0x1d, 0x46, 0x9b, 0xea, 0xd1, 0xa5, 0x6c,
-	0x5b, 0x7b, 0xef, 0xd1, 0x12, 0x15, 0xbc, 0x57, 0x9f, 0x65, 0xc2, 0x47, 0xcf, 0x1a, 0x19, 0x2b,
-	0xd0, 0x26, 0x3f, 0x0d, 0x53, 0x6c, 0xec, 0xb2, 0x6d, 0xb9, 0x8e, 0x5d, 0xa9, 0x20, 0x47, 0x14,
-	0x00, 0xf5, 0x53, 0x43, 0x4e, 0xd0, 0xee, 0x65, 0xaf, 0x97, 0xd7, 0xf5, 0x10, 0x6c, 0xe1, 0xcb,
-	0xc5, 0x5e, 0x32, 0xc5, 0xa7, 0x52, 0x84, 0xdc, 0x72, 0xc5, 0xc6, 0x88, 0x6e, 0x3e, 0x62, 0x89,
-	0xfd, 0xeb, 0x27, 0x05, 0xd6, 0x4f, 0x19, 0x07, 0xd9, 0x4f, 0x2f, 0xaa, 0x67, 0x24, 0xc8, 0xb1,
-	0x64, 0x8c, 0xff, 0x6a, 0xd7, 0x5a, 0x8c, 0x7c, 0x0d, 0x92, 0x64, 0xab, 0xde, 0x24, 0xa0, 0xd2,
-	0x47, 0x4b, 0x97, 0x1e, 0x6f, 0x5f, 0x18, 0xc5, 0xd2, 0xa8, 0x8c, 0x43, 0xf5, 0x78, 0xfd, 0xcf,
-	0xb7, 0x89, 0xc0, 0xf3, 0x6d, 0x09, 0x46, 0x77, 0x4c, 0x6c, 0xae, 0x9b, 0x15, 0xd3, 0x6d, 0xf4,
-	0xf6, 0xb2, 0x98, 0x69, 0x32, 0xd2, 0xed, 0x79, 0x1c, 0x64, 0xbf, 0x6e, 0x5c, 0xe5, 0xfb, 0x12,
-	0x1c, 0xb9, 0x8e, 0x5c, 0xb5, 0xf9, 0x77, 0x97, 0x5b, 0xec, 0xaf, 0x2e, 0xde, 0xd9, 0xe2, 0x05,
-	0x18, 0xa4, 0x05, 0x0a, 0x24, 0x44, 0x12, 0x2d, 0x5d, 0xc0, 0xf7, 0x7f, 0x19, 0x96, 0x67, 0xf0,
-	0x3e, 0x69, 0x29, 0x83, 0xca, 0x65, 0x90, 0xc0, 0xe1, 0x47, 0x14, 0xfa, 0x6e, 0xc8, 0xf7, 0xf3,
-	0x14, 0x6f, 0x23, 0xbe, 0xa3, 0xbc, 0xd3, 0x07, 0x85, 0x56, 0x53, 0xe2, 0x1e, 0xfe, 0x55, 0xc8,
-	0xb0, 0x25, 0xe1, 0xff, 0xcb, 0x11, 0x73, 0x7b, 0xb9, 0xcb, 0x87, 0xb6, 0xf6, 0xe2, 0x8b, 0xd4,
-	0x2b, 0x44, 0x2b, 0x2b, 0x4a, 0x60, 0x11, 0x25, 0xda, 0x66, 0x1a, 0x20, 0x47, 0x89, 0xfc, 0x05,
-	0x0a, 0x03, 0xac, 0x40, 0xe1, 0x56, 0xb0, 0x40, 0xe1, 0x42, 0x8f, 0xb6, 0xf3, 0x66, 0xd6, 0xac,
-	0x59, 0x50, 0xde, 0x82, 0xb9, 0xeb, 0xc8, 0xbd, 0xf2, 0xc2, 0x4b, 0x6d, 0xd6, 0xec, 0x0e, 0xaf,
-	0xad, 0x24, 0x97, 0x1c, 0x61, 0x9b, 0x5e, 0xc7, 0xf6, 0x6a, 0x64, 0x68, 0xb9, 0x25, 0xf9, 0x85,
-	0x95, 0x6f, 0x49, 0x30, 0xdf, 0x66, 0x70, 0xbe, 0x3a, 0x6f, 0x42, 0xce, 0x27, 0x96, 0x26, 0x22,
-	0xc4, 0x24, 0xce, 0xef, 0x63, 0x12, 0x6a, 0xd6, 0x09, 0x36, 0x60, 0xe5, 0xdb, 0x12, 0x8c, 0xd3,
-	0x62, 0x0e, 0x81, 0x97, 0x3d, 0xec, 0xad, 0x2f, 0x86, 0xef, 0xbb, 0xff, 0xd7, 0xf1, 0xbe, 0x1b,
-	0x37, 0x54, 0xf3, 0x8e, 0xbb, 0x0d, 0x13, 0x21, 0x02, 0x6e, 0x07, 0x15, 0x92, 0xa1, 0x87, 0xe0,
-	0xa7, 0x7b, 0x1d, 0x8a, 0x3f, 0xfb, 0x7a, 0x72, 0x94, 0xef, 0x4a, 0x30, 0xae, 0x22, 0xbd, 0x56,
-	0xab, 0xb0, 0x04, 0x02, 0xee, 0x41, 0xf3, 0xd5, 0xb0, 0xe6, 0xf1, 0x85, 0x53, 0xfe, 0xff, 0x93,
-	0xb1, 0xe5, 0x88, 0x0e, 0xd7, 0xd4, 0x7e, 0x0a, 0x26, 0x42, 0x04, 0x7c, 0xa6, 0x3f, 0xed, 0x83,
-	0x09, 0xe6, 0x2b, 0x61, 0xef, 0xbc, 0x0a, 0xfd, 0x5e, 0x61, 0x5c, 0xc6, 0x7f, 0xc5, 0x8f, 0x43,
-	0xcc, 0x2b, 0x48, 0x37, 0x5e, 0x40, 0xae, 0x8b, 0x1c, 0x5a, 0x63, 0x42, 0x6b, 0x11, 0x28, 0x7b,
-	0xbb, 0xed, 0x39, 0x7a, 0x1f, 0x4a, 0xc4, 0xdd, 0x87, 0x2e, 0x40, 0xde, 0xb4, 0x08, 0x85, 0xb9,
-	0x83, 0x34, 0x64, 0x79, 0x70, 0xd2, 0x2c, 0xa3, 0x99, 0xf0, 0xfa, 0xaf, 0x5a, 0x22, 0xd8, 0x4b,
-	0x86, 0xfc, 0x38, 0xe4, 0xaa, 0xfa, 0x9e, 0x59, 0xad, 0x57, 0xb5, 0x1a, 0xa1, 0xc7, 0xe6, 0x5b,
-	0xec, 0xcf, 0x60, 0x03, 0xea, 0x28, 0xef, 0x58, 0xd1, 0x37, 0xd1, 0xaa, 0xf9, 0x16, 0x92, 0x4f,
-	0xc2, 0x28, 0xad, 0x98, 0xa3, 0x84, 0xac, 0xd4, 0x6b, 0x90, 0x96, 0x7a, 0xd1, 0x42, 0x3a, 0x42,
-	0xc6, 0xca, 0xc9, 0xff, 0xc6, 0xfe, 0x58, 0x14, 0xb0, 0x17, 0x77, 0xa4, 0x87, 0x64, 0xb0, 0xd8,
-	0xb8, 0xec, 0x7b, 0x88, 0x71, 0x19, 0xa7, 0x6b, 0x22, 0x4e, 0xd7, 0x3f, 0x4a, 0x30, 0xb5, 0x52,
-	0x77, 0x36, 0xd1, 0x67, 0xd1, 0x3b, 0x94, 0x19, 0xc8, 0x47, 0x95, 0x13, 0xcf, 0xdc, 0x7d, 0x30,
-	0x75, 0x0b, 0x7d, 0x46, 0x35, 0x7f, 0x24, 0x71, 0xb1, 0x04, 0xf9, 0xa8, 0xc1, 0x78, 0x60, 0xc4,
-	0xc8, 0x90, 0xe2, 0x64, 0xbc, 0x43, 0x4b, 0xb8, 0x37, 0x1c, 0x84, 0xb7, 0xfc, 0xb9, 0xee, 0x5e,
-	0xc0, 0xf3, 0x95, 0x30, 0x78, 0x7e, 0xb1, 0x4b, 0xf0, 0x6c, 0x39, 0x6a, 0x13, 0x43, 0x69, 0x55,
-	0x77, 0x1c, 0x1d, 0x53, 0x73, 0xa9, 0xf6, 0xfe, 0x87, 0x85, 0x43, 0x1f, 0x7c, 0x58, 0x38, 0xf4,
-	0xf1, 0x87, 0x05, 0xe9, 0x6b, 0x0f, 0x0a, 0xd2, 0xbb, 0x0f, 0x0a, 0xd2, 0xaf, 0x1f, 0x14, 0xa4,
-	0xf7, 0x1f, 0x14, 0xa4, 0x3f, 0x3f, 0x28, 0x48, 0x7f, 0x7d, 0x50, 0x38, 0xf4, 0xf1, 0x83, 0x82,
-	0x74, 0xff, 0xa3, 0xc2, 0xa1, 0xf7, 0x3f, 0x2a, 0x1c, 0xfa, 0xe0, 0xa3, 0xc2, 0xa1, 0x57, 0x2e,
-	0x6d, 0xda, 0xcd, 0x29, 0x9a, 0x76, 0xdb, 0x3f, 0xf1, 0xff, 0x7f, 0xb0, 0x65, 0x7d, 0x90, 0x1e,
-	0x2b, 0xcf, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x94, 0x51, 0xc6, 0x6e, 0x03, 0x40, 0x00, 0x00,
+	// 3812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x6c, 0x24, 0x57,
+	0x57, 0x9e, 0xea, 0x87, 0xdd, 0x7d, 0xfa, 0xe1, 0xee, 0xf2, 0xab, 0x6d, 0x67, 0x7a, 0xec, 0x9a,
+	0xf1, 0x8c, 0x93, 0xff, 0x9f, 0x76, 0x66, 0xe6, 0x67, 0x66, 0xfe, 0x81, 0x3f, 0x61, 0x6c, 0xcf,
+	0xa3, 0x47, 0x33, 0x13, 0x4f, 0xd9, 0x4c, 0xa2, 0x24, 0xa4, 0x52, 0xee, 0xba, 0xb6, 0x0b, 0x77,
+	0x57, 0x75, 0xea, 0x56, 0xdb, 0xee, 0x80, 0xc4, 0x4b, 0x2c, 0x00, 0x09, 0x8d, 0xc4, 0x06, 0x89,
+	0xb0, 0x41, 0x48, 0x20, 0x24, 0xc4, 0x82, 0x05, 0xca, 0x82, 0x6d, 0xc4, 0x06, 0x11, 0x21, 0x21,
+	0x22, 0x58, 0x40, 0x26, 0x42, 0x42, 0x82, 0x45, 0x16, 0x2c, 0x58, 0xa2, 0xfb, 0xaa, 0xae, 0xea,
+	0xaa, 0x7e, 0xd9, 0x33, 0x24, 0xe4, 0xcf, 0xce, 0x75, 0xef, 0x79, 0xdc, 0x73, 0xee, 0xb9, 0xdf,
+	0xbd, 0xf7, 0xdc, 0xd3, 0x86, 0x9f, 0x73, 0x51, 0xa3, 0x69, 0x3b, 0x7a, 0x7d, 0x15, 0x23, 0xe7,
+	0x10, 0x39, 0xab, 0x7a, 0xd3, 0x5c, 0xdd, 0x37, 0xb1, 0x6b, 0x3b, 0x6d, 0xd2, 0x62, 0xd6, 0xd0,
+	0xea, 0xe1, 0x95, 0x55, 0x07, 0x7d, 0xd4, 0x42, 0xd8, 0xd5, 0x1c, 0x84, 0x9b, 0xb6, 0x85, 0x51,
+	0xa5, 0xe9, 0xd8, 0xae, 0x2d, 0x2f, 0x0b, 0xee, 0x0a, 0xe3, 0xae, 0xe8, 0x4d, 0xb3, 0x12, 0xe4,
+	0xae, 0x1c, 0x5e, 0x99, 0x2f, 0xef, 0xd9, 0xf6, 0x5e, 0x1d, 0xad, 0x52, 0xa6, 0x9d, 0xd6, 0xee,
+	0xaa, 0xd1, 0x72, 0x74, 0xd7, 0xb4, 0x2d, 0x26, 0x66, 0xfe, 0x5c, 0x77, 0xbf, 0x6b, 0x36, 0x10,
+	0x76, 0xf5, 0x46, 0x93, 0x13, 0x2c, 0x19, 0xa8, 0x89, 0x2c, 0x03, 0x59, 0x35, 0x13, 0xe1, 0xd5,
+	0x3d, 0x7b, 0xcf, 0xa6, 0xed, 0xf4, 0x2f, 0x4e, 0x72, 0xc1, 0x33, 0x84, 0x58, 0x50, 0xb3, 0x1b,
+	0x0d, 0xdb, 0x22, 0x23, 0x6f, 0x20, 0x8c, 0xf5, 0x3d, 0x3e, 0xe0, 0xf9, 0xe5, 0x00, 0x15, 0x1f,
+	0x69, 0x98, 0xec, 0x52, 0x80, 0xcc, 0xd5, 0xf1, 0xc1, 0x47, 0x2d, 0xd4, 0x42, 0x61, 0xc2, 0xa0,
+	0x56, 0x64, 0xb5, 0x1a, 0x98, 0x10, 0x1d, 0xd9, 0xce, 0xc1, 0x6e, 0xdd, 0x3e, 0xe2, 0x54, 0x17,
+	0x03, 0x54, 0xa2, 0x33, 0x2c, 0xed, 0x7c, 0x80, 0xee, 0xa3, 0x16, 0x72, 0xda, 0x83, 0x4c, 0xd8,
+	0xd5, 0xcd, 0x7a, 0xcb, 0x89, 0x18, 0xd9, 0x0f, 0xfb, 0x4c, 0x6c, 0x98, 0xfa, 0xd5, 0x28, 0x6a,
+	0xcf, 0x1c, 0xe6, 0x4d, 0x4e, 0xfa, 0x83, 0xbe, 0xa4, 0x5d, 0x96, 0x5f, 0xea, 0x4b, 0x4c, 0x1c,
+	0xcb, 0x09, 0x2f, 0x47, 0x11, 0xf6, 0xf6, 0x54, 0x25, 0x8a, 0xdc, 0xd2, 0x1b, 0x08, 0x37, 0xf5,
+	0x5a, 0x84, 0x37, 0x5e, 0x8f, 0xa2, 0x77, 0x50, 0xb3, 0x6e, 0xd6, 0x68, 0x20, 0x86, 0x39, 0xae,
+	0x45, 0x71, 0x34, 0x91, 0x83, 0x4d, 0xec, 0x22, 0x8b, 0xe9, 0x40, 0xc7, 0xa8, 0xd6, 0x22, 0xec,
+	0x98, 0x33, 0xbd, 0x39, 0x04, 0x93, 0x30, 0x4a, 0x6b, 0xb4, 0x5c, 0x7d, 0xa7, 0x8e, 0x34, 0xec,
+	0xea, 0xae, 0xd0, 0x7a, 0x3d, 0x32, 0x52, 0x06, 0x2e, 0xc4, 0xf9, 0x5b, 0x51, 0x8a, 0x75, 0xa3,
+	0x61, 0x5a, 0x03, 0x79, 0x95, 0xdf, 0x1d, 0x83, 0xb3, 0x5b, 0xae, 0xee, 0xb8, 0x6f, 0x73, 0x75,
+	0x77, 0x84, 0x59, 0x2a, 0x63, 0x90, 0x97, 0x20, 0xeb, 0xf9, 0x56, 0x33, 0x8d, 0x92, 0xb4, 0x28,
+	0xad, 0xa4, 0xd5, 0x8c, 0xd7, 0x56, 0x35, 0xe4, 0x1a, 0xe4, 0x30, 0x91, 0xa1, 0x71, 0x25, 0xa5,
+	0xd8, 0xa2, 0xb4, 0x92, 0xb9, 0xfa, 0x86, 0x37, 0x51, 0x14, 0x1a, 0xba, 0x0c, 0xaa, 0x1c, 0x5e,
+	0xa9, 0xf4, 0xd5, 0xac, 0x66, 0xa9, 0x50, 0x31, 0x8e, 0x7d, 0x98, 0x6e, 0xea, 0x0e, 0xb2, 0x5c,
+	0xcd, 0xf3, 0xbc, 0x66, 0x5a, 0xbb, 0x76, 0x29, 0x4e, 0x95, 0xfd, 0xa8, 0x12, 0x05, 0x47, 0x5e,
+	0x44, 0x1e, 0x5e, 0xa9, 0x6c, 0x52, 0x6e, 0x4f, 0x4b, 0xd5, 0xda, 0xb5, 0xd5, 0xc9, 0x66, 0xb8,
+	0x51, 0x2e, 0xc1, 0xb8, 0xee, 0x12, 0x69, 0x6e, 0x29, 0xb1, 0x28, 0xad, 0x24, 0x55, 0xf1, 0x29,
+	0x37, 0x40, 0xf1, 0x66, 0xb0, 0x33, 0x0a, 0x74, 0xdc, 0x34, 0x19, 0xa4, 0x69, 0x04, 0xbb, 0x4a,
+	0x49, 0x3a, 0xa0, 0xf9, 0x0a, 0x03, 0xb6, 0x8a, 0x00, 0xb6, 0xca, 0xb6, 0x00, 0xb6, 0xb5, 0xc4,
+	0xb3, 0x7f, 0x3d, 0x27, 0xa9, 0xe7, 0x8e, 0xba, 0x2d, 0xbf, 0xe3, 0x49, 0x22, 0xb4, 0xf2, 0x3e,
+	0xcc, 0xd5, 0x6c, 0xcb, 0x35, 0xad, 0x16, 0xd2, 0x74, 0xac, 0x59, 0xe8, 0x48, 0x33, 0x2d, 0xd3,
+	0x35, 0x75, 0xd7, 0x76, 0x4a, 0x63, 0x8b, 0xd2, 0x4a, 0xfe, 0xea, 0xe5, 0xa0, 0x8f, 0xe9, 0xea,
+	0x22, 0xc6, 0xae, 0x73, 0xbe, 0xdb, 0xf8, 0x31, 0x3a, 0xaa, 0x0a, 0x26, 0x75, 0xa6, 0x16, 0xd9,
+	0x2e, 0x3f, 0x82, 0xa2, 0xe8, 0x31, 0x34, 0x0e, 0x2b, 0xa5, 0x71, 0x6a, 0xc7, 0x62, 0x50, 0x03,
+	0xef, 0x24, 0x3a, 0xee, 0xb2, 0x3f, 0xd5, 0x82, 0xc7, 0xca, 0x5b, 0xe4, 0xa7, 0x30, 0x53, 0xd7,
+	0xb1, 0xab, 0xd5, 0xec, 0x46, 0xb3, 0x8e, 0xa8, 0x67, 0x1c, 0x84, 0x5b, 0x75, 0xb7, 0x94, 0x8a,
+	0x92, 0xc9, 0x21, 0x86, 0xce, 0x51, 0xbb, 0x6e, 0xeb, 0x06, 0x56, 0xa7, 0x08, 0xff, 0xba, 0xc7,
+	0xae, 0x52, 0x6e, 0xf9, 0x03, 0x58, 0xd8, 0x35, 0x1d, 0xec, 0x6a, 0xde, 0x2c, 0x10, 0x14, 0xd1,
+	0x76, 0xf4, 0xda, 0x81, 0xbd, 0xbb, 0x5b, 0x4a, 0x53, 0xe1, 0x73, 0x21, 0xc7, 0x6f, 0xf0, 0x1d,
+	0x67, 0x2d, 0xf1, 0x07, 0xc4, 0xef, 0x25, 0x2a, 0x43, 0x84, 0xdd, 0xb6, 0x8e, 0x0f, 0xd6, 0x98,
+	0x00, 0xe5, 0x06, 0x94, 0x7b, 0x85, 0x24, 0x5b, 0x35, 0xf2, 0x34, 0x8c, 0x39, 0x2d, 0xab, 0xb3,
+	0x0e, 0x92, 0x4e, 0xcb, 0xaa, 0x1a, 0xca, 0x7f, 0x4a, 0x30, 0x73, 0x0f, 0xb9, 0x8f, 0xd8, 0xaa,
+	0xde, 0x22, 0x8b, 0x7a, 0x84, 0xf5, 0x73, 0x0f, 0xd2, 0x5e, 0x34, 0xf1, 0xb5, 0xf3, 0x6a, 0x2f,
+	0x0f, 0x85, 0x87, 0xd6, 0xe1, 0x95, 0xaf, 0xc1, 0x0c, 0x3a, 0x6e, 0xa2, 0x9a, 0x8b, 0x0c, 0xcd,
+	0x42, 0xc7, 0xae, 0x86, 0x0e, 0xc9, 0x82, 0x31, 0x0d, 0xba, 0x48, 0xe2, 0xea, 0xa4, 0xe8, 0x7d,
+	0x8c, 0x8e, 0xdd, 0x3b, 0xa4, 0xaf, 0x6a, 0xc8, 0xaf, 0xc3, 0x54, 0xad, 0xe5, 0xd0, 0x95, 0xb5,
+	0xe3, 0xe8, 0x56, 0x6d, 0x5f, 0x73, 0xed, 0x03, 0x64, 0xd1, 0xd8, 0xcf, 0xaa, 0x32, 0xef, 0x5b,
+	0xa3, 0x5d, 0xdb, 0xa4, 0x47, 0xf9, 0xf3, 0x14, 0xcc, 0x86, 0xac, 0xe5, 0x0e, 0x0a, 0xd8, 0x22,
+	0x9d, 0xc2, 0x96, 0x2a, 0xe4, 0x3a, 0xb3, 0xdc, 0x6e, 0x22, 0xee, 0x98, 0x0b, 0x83, 0x84, 0x6d,
+	0xb7, 0x9b, 0x48, 0xcd, 0x1e, 0xf9, 0xbe, 0x64, 0x05, 0x72, 0x51, 0xde, 0xc8, 0x58, 0x3e, 0x2f,
+	0xfc, 0x18, 0xe6, 0x9a, 0x0e, 0x3a, 0x34, 0xed, 0x16, 0xd6, 0x28, 0xee, 0x20, 0xa3, 0x43, 0x9f,
+	0xa0, 0xf4, 0x33, 0x82, 0x60, 0x8b, 0xf5, 0x0b, 0xd6, 0xcb, 0x30, 0x49, 0xa3, 0x9d, 0x85, 0xa6,
+	0xc7, 0x94, 0xa4, 0x4c, 0x05, 0xd2, 0x75, 0x97, 0xf4, 0x08, 0xf2, 0x75, 0x00, 0x1a, 0xb5, 0xf4,
+	0x54, 0x51, 0x1a, 0x8b, 0xb2, 0xca, 0x3b, 0x74, 0x10, 0xc3, 0x48, 0x80, 0x3e, 0x21, 0x1f, 0x6a,
+	0xda, 0x15, 0x7f, 0xca, 0x9b, 0x50, 0xc4, 0xae, 0x59, 0x3b, 0x68, 0x6b, 0x3e, 0x59, 0xe3, 0x23,
+	0xc8, 0x9a, 0x60, 0xec, 0x5e, 0x83, 0xfc, 0xcb, 0xf0, 0x83, 0x90, 0x44, 0x0d, 0xd7, 0xf6, 0x91,
+	0xd1, 0xaa, 0x23, 0xcd, 0xb5, 0x99, 0x57, 0x28, 0xc2, 0xd9, 0x2d, 0xb7, 0x94, 0x19, 0x6e, 0xad,
+	0x2d, 0x77, 0xa9, 0xd9, 0xe2, 0x02, 0xb7, 0x6d, 0xea, 0xc4, 0x6d, 0x26, 0xad, 0x67, 0x0c, 0xe6,
+	0x7a, 0xc5, 0xa0, 0xfc, 0x1e, 0xe4, 0xbd, 0xf0, 0xa0, 0x9b, 0x68, 0x69, 0x82, 0x02, 0x62, 0xf4,
+	0x3e, 0xe0, 0xe1, 0x62, 0x28, 0xe4, 0x58, 0xf4, 0x7a, 0xa1, 0x46, 0x3f, 0xe5, 0xb7, 0x61, 0x22,
+	0x20, 0xbc, 0x85, 0x4b, 0x05, 0x2a, 0xbd, 0xd2, 0x03, 0x6e, 0x23, 0xc5, 0xb6, 0xb0, 0x9a, 0xf7,
+	0xcb, 0x6d, 0x61, 0xf9, 0x17, 0xa1, 0x78, 0x88, 0x1c, 0x4c, 0x00, 0x91, 0x1d, 0xc7, 0x4c, 0x84,
+	0x4b, 0x45, 0xea, 0xca, 0xd7, 0x2b, 0x7d, 0xce, 0xd3, 0x44, 0xc7, 0x53, 0xc6, 0x78, 0x5f, 0xf0,
+	0xa9, 0x85, 0xc3, 0xae, 0x16, 0xf9, 0x0d, 0x78, 0xc5, 0xc4, 0x1a, 0x73, 0xb9, 0x7f, 0x1a, 0x91,
+	0x45, 0x16, 0xaa, 0x51, 0x92, 0x17, 0xa5, 0x95, 0x94, 0x5a, 0x32, 0xf1, 0x56, 0x70, 0x56, 0xee,
+	0xb0, 0x7e, 0xf9, 0x47, 0x30, 0x1b, 0x8a, 0x64, 0xf7, 0x98, 0xc2, 0xdd, 0x24, 0x03, 0x90, 0x60,
+	0x34, 0x6f, 0x1f, 0x5b, 0x55, 0xe3, 0x41, 0x22, 0x95, 0x2a, 0xa4, 0x1f, 0x24, 0x52, 0xe9, 0x02,
+	0x3c, 0x48, 0xa4, 0xa0, 0x90, 0x79, 0x90, 0x48, 0x65, 0x0b, 0xb9, 0x07, 0x89, 0x54, 0xbe, 0x30,
+	0xa1, 0xfc, 0x97, 0x04, 0xb3, 0x9b, 0x76, 0xbd, 0xfe, 0x53, 0x82, 0x8d, 0xff, 0x3e, 0x0e, 0xa5,
+	0xb0, 0xb9, 0xdf, 0x83, 0xe3, 0xf7, 0xe0, 0xf8, 0xc2, 0xc1, 0x31, 0xdb, 0x13, 0x1c, 0x23, 0x61,
+	0x26, 0xff, 0xc2, 0x60, 0xe6, 0xff, 0x27, 0xf6, 0xf6, 0x01, 0xb7, 0xe2, 0x68, 0xe0, 0x96, 0x2b,
+	0xe4, 0x95, 0xdf, 0x96, 0x60, 0x41, 0x45, 0x18, 0xb9, 0x5d, 0x50, 0xfa, 0x0d, 0x40, 0x9b, 0x52,
+	0x86, 0x57, 0xa2, 0x87, 0xc2, 0x60, 0x47, 0xf9, 0xe7, 0x18, 0x2c, 0xaa, 0xa8, 0x66, 0x3b, 0x86,
+	0xff, 0xd0, 0xcb, 0x17, 0xea, 0x08, 0x03, 0x7e, 0x07, 0xe4, 0xf0, 0xf5, 0x67, 0xf4, 0x91, 0x17,
+	0x43, 0xf7, 0x1e, 0xf9, 0x1c, 0x64, 0xbc, 0xd5, 0xe4, 0x41, 0x10, 0x88, 0xa6, 0xaa, 0x21, 0xcf,
+	0xc2, 0x38, 0x5d, 0x79, 0x1e, 0xde, 0x8c, 0x91, 0xcf, 0xaa, 0x21, 0x9f, 0x05, 0x10, 0x57, 0x5b,
+	0x0e, 0x2b, 0x69, 0x35, 0xcd, 0x5b, 0xaa, 0x86, 0xfc, 0x21, 0x64, 0x9b, 0x76, 0xbd, 0xee, 0xdd,
+	0x4c, 0x19, 0xa2, 0xfc, 0x64, 0xe0, 0xcd, 0x94, 0x40, 0xb8, 0xdf, 0x59, 0xfe, 0xb9, 0x55, 0x33,
+	0x44, 0x24, 0xff, 0x50, 0xfe, 0x71, 0x1c, 0x96, 0xfa, 0x38, 0x97, 0x23, 0x7f, 0x08, 0xb0, 0xa5,
+	0x13, 0x03, 0x76, 0x5f, 0x30, 0x8e, 0xf5, 0x05, 0xe3, 0x1f, 0x82, 0x2c, 0x7c, 0x6a, 0x74, 0x03,
+	0x7e, 0xc1, 0xeb, 0x11, 0xd4, 0x2b, 0x50, 0xe8, 0x01, 0xf6, 0x79, 0x1c, 0x94, 0x1b, 0xda, 0x43,
+	0x92, 0xe1, 0x3d, 0xc4, 0x77, 0xab, 0x1e, 0x0b, 0xde, 0xaa, 0x6f, 0x42, 0x89, 0x83, 0xab, 0xef,
+	0x4e, 0xcd, 0x4f, 0x2c, 0xe3, 0xf4, 0xc4, 0x32, 0xc3, 0xfa, 0x3b, 0xf7, 0x64, 0xd6, 0x2b, 0xef,
+	0xf9, 0x02, 0x92, 0x85, 0x07, 0x49, 0x08, 0xb0, 0x3b, 0xe6, 0x8f, 0x07, 0x01, 0xdd, 0xb6, 0xa3,
+	0x5b, 0xd8, 0x44, 0x56, 0xe0, 0x26, 0x48, 0xb3, 0x02, 0x85, 0xa3, 0xae, 0x16, 0x79, 0x0f, 0xce,
+	0x46, 0x5c, 0xfc, 0x7d, 0xbb, 0x4b, 0x7a, 0x84, 0xdd, 0x65, 0x3e, 0x14, 0xff, 0x5e, 0x1f, 0x59,
+	0x85, 0x01, 0x8c, 0xcf, 0x50, 0x8c, 0xcf, 0xec, 0xf8, 0xc0, 0xfd, 0x1e, 0xe4, 0x3b, 0x93, 0x48,
+	0x13, 0x0e, 0xd9, 0x21, 0x13, 0x0e, 0x39, 0x8f, 0x8f, 0xf4, 0xc8, 0xeb, 0x90, 0x15, 0xf3, 0x4b,
+	0xc5, 0xe4, 0x86, 0x14, 0x93, 0xe1, 0x5c, 0x54, 0x88, 0x0d, 0xe3, 0x24, 0x57, 0xc9, 0x36, 0x98,
+	0xf8, 0x4a, 0xe6, 0xea, 0x2f, 0x54, 0x86, 0xca, 0x0b, 0x57, 0x06, 0xae, 0x99, 0xca, 0x13, 0x26,
+	0xf7, 0x8e, 0xe5, 0x3a, 0x6d, 0x55, 0x68, 0x99, 0xff, 0x10, 0xb2, 0xfe, 0x0e, 0xb9, 0x00, 0xf1,
+	0x03, 0xd4, 0xe6, 0x70, 0x45, 0xfe, 0x94, 0x6f, 0x41, 0xf2, 0x50, 0xaf, 0xb7, 0x7a, 0x1c, 0x8a,
+	0x68, 0x66, 0xd5, 0xbf, 0xc4, 0x88, 0xb4, 0xb6, 0xca, 0x58, 0x6e, 0xc5, 0x6e, 0x4a, 0x0c, 0xe6,
+	0x7d, 0xa0, 0x79, 0xbb, 0xe6, 0x9a, 0x87, 0xa6, 0xdb, 0xfe, 0x1e, 0x34, 0x87, 0x00, 0x4d, 0xbf,
+	0xb3, 0x7a, 0x83, 0xe6, 0x6f, 0x24, 0x04, 0x68, 0x46, 0x3a, 0x97, 0x83, 0xe6, 0x63, 0x98, 0xe8,
+	0x82, 0x2b, 0x0e, 0x9b, 0xcb, 0xc1, 0xa1, 0xf8, 0x16, 0x35, 0x3b, 0xa4, 0xb4, 0x29, 0xe8, 0xa8,
+	0xf9, 0x20, 0xa4, 0x85, 0x02, 0x3e, 0x76, 0x92, 0x80, 0xf7, 0xe1, 0x58, 0x3c, 0x88, 0x63, 0x08,
+	0xca, 0xe2, 0x9c, 0xc6, 0x9b, 0xb4, 0xae, 0x85, 0x9a, 0x18, 0x52, 0xe1, 0x02, 0x97, 0x73, 0x9b,
+	0x89, 0xd9, 0x0a, 0x2c, 0xdb, 0x47, 0x50, 0xdc, 0x47, 0xba, 0xe3, 0xee, 0x20, 0xdd, 0xd5, 0x0c,
+	0xe4, 0xea, 0x66, 0x1d, 0x97, 0x92, 0x43, 0xe6, 0xd5, 0x0a, 0x1e, 0xeb, 0x06, 0xe3, 0x0c, 0xef,
+	0x4c, 0x63, 0x27, 0xde, 0x99, 0x2e, 0xfb, 0x42, 0xdd, 0x5b, 0x02, 0x14, 0xc2, 0xd3, 0x9d, 0xf8,
+	0x7d, 0x2c, 0x3a, 0x94, 0x4f, 0x25, 0x38, 0xcf, 0xe6, 0x3a, 0x00, 0x03, 0x3c, 0xeb, 0x37, 0xd2,
+	0x22, 0xb3, 0xa1, 0xc0, 0x73, 0x8d, 0xa8, 0x2b, 0x09, 0xbd, 0x31, 0x30, 0x6a, 0x87, 0x18, 0x82,
+	0x3a, 0x21, 0xa4, 0x8b, 0x00, 0xfe, 0x43, 0x09, 0x2e, 0xf4, 0x67, 0xe4, 0x31, 0x8c, 0x3b, 0x9b,
+	0xa8, 0x48, 0xbd, 0xf3, 0x20, 0xbe, 0xff, 0xa2, 0x80, 0x92, 0x5c, 0x57, 0x02, 0x0d, 0xca, 0x5f,
+	0x4a, 0xb0, 0xc8, 0x3e, 0x02, 0x7c, 0x24, 0x3d, 0x3b, 0x92, 0x5b, 0xf7, 0x21, 0xbf, 0x4b, 0x79,
+	0xba, 0x9c, 0x7a, 0xfb, 0x24, 0x4e, 0x0d, 0x68, 0x57, 0x73, 0xbb, 0xfe, 0x4f, 0xe5, 0x3c, 0x2c,
+	0xf5, 0x61, 0xe1, 0x66, 0x7d, 0x2a, 0x81, 0x12, 0x46, 0x8d, 0xfb, 0x22, 0xa2, 0x47, 0x30, 0xac,
+	0xe9, 0x5f, 0x43, 0x41, 0xdb, 0xd6, 0x87, 0xb0, 0x6d, 0xd0, 0x10, 0x7c, 0xcb, 0x4c, 0x18, 0xb8,
+	0x09, 0xe7, 0xfb, 0xf2, 0xf1, 0x70, 0x79, 0x15, 0x0a, 0x35, 0xdd, 0xaa, 0x21, 0x0f, 0x7c, 0x11,
+	0x1b, 0x7f, 0x4a, 0x9d, 0x60, 0xed, 0xaa, 0x68, 0xf6, 0x2f, 0x1f, 0xbf, 0xcc, 0x6f, 0x68, 0xf9,
+	0xf4, 0x1b, 0x42, 0x78, 0xf9, 0x5c, 0x84, 0x0b, 0xfd, 0xf9, 0xc2, 0x81, 0xec, 0x27, 0xfc, 0xbf,
+	0x0f, 0xe4, 0x9e, 0xda, 0x7b, 0x07, 0x72, 0x14, 0x0b, 0x37, 0xeb, 0xaf, 0x68, 0x20, 0x87, 0xed,
+	0xa7, 0x33, 0x3c, 0x92, 0x61, 0xbf, 0x04, 0xf9, 0x60, 0xbc, 0x8c, 0x10, 0xc5, 0x83, 0xf4, 0xab,
+	0xb9, 0x40, 0xc8, 0x29, 0xcb, 0xd1, 0xf1, 0xe6, 0x31, 0x71, 0xe3, 0x3e, 0x8b, 0x41, 0x79, 0xcb,
+	0xdc, 0xb3, 0xf4, 0xfa, 0x69, 0xde, 0x14, 0x77, 0x21, 0x8f, 0xa9, 0x90, 0x2e, 0xc3, 0xde, 0x1c,
+	0xfc, 0xa8, 0xd8, 0x57, 0xb7, 0x9a, 0x63, 0x62, 0xc5, 0x50, 0x4c, 0x58, 0x40, 0xc7, 0x2e, 0x72,
+	0x88, 0xa6, 0x88, 0x73, 0x5a, 0x7c, 0xd4, 0x73, 0xda, 0x9c, 0x90, 0x16, 0xea, 0x92, 0x2b, 0x30,
+	0x59, 0xdb, 0x37, 0xeb, 0x46, 0x47, 0x8f, 0x6d, 0xd5, 0xdb, 0xf4, 0x50, 0x90, 0x52, 0x8b, 0xb4,
+	0x4b, 0x30, 0xbd, 0x65, 0xd5, 0xdb, 0xca, 0x12, 0x9c, 0xeb, 0x69, 0x0b, 0xf7, 0xf5, 0x3f, 0x48,
+	0x70, 0x89, 0xd3, 0x98, 0xee, 0xfe, 0xa9, 0x1f, 0x72, 0x7f, 0x53, 0x82, 0x39, 0xee, 0xf5, 0x23,
+	0xd3, 0xdd, 0xd7, 0xa2, 0x5e, 0x75, 0xef, 0x0f, 0x3b, 0x01, 0x83, 0x06, 0xa4, 0xce, 0xe0, 0x20,
+	0xa1, 0x88, 0xb3, 0xdb, 0xb0, 0x32, 0x58, 0x44, 0xff, 0xf7, 0xb8, 0xbf, 0x91, 0xe0, 0x9c, 0x8a,
+	0x1a, 0xf6, 0x21, 0x62, 0x92, 0x4e, 0x98, 0x7c, 0x7e, 0x79, 0x67, 0xf7, 0xe0, 0x09, 0x3c, 0xde,
+	0x75, 0x02, 0x57, 0x14, 0x58, 0xec, 0x3d, 0x7c, 0x3e, 0xf7, 0x7f, 0x2d, 0xc1, 0xd2, 0x36, 0x72,
+	0x1a, 0xa6, 0xa5, 0xbb, 0xe8, 0x34, 0xb3, 0x6e, 0x43, 0xd1, 0x15, 0x72, 0xba, 0x26, 0x7b, 0x6d,
+	0xe0, 0x64, 0x0f, 0x1c, 0x81, 0x5a, 0xf0, 0x84, 0x8b, 0x09, 0xbe, 0x00, 0x4a, 0x3f, 0x36, 0x6e,
+	0xdf, 0x9f, 0x4a, 0x70, 0x96, 0xa6, 0xb5, 0x4e, 0x59, 0x9a, 0xe0, 0x10, 0x19, 0x23, 0x97, 0x26,
+	0xf4, 0xd5, 0xac, 0x66, 0xa9, 0x50, 0x61, 0xcf, 0x0d, 0x28, 0xf7, 0x22, 0xef, 0x1f, 0xa6, 0xbf,
+	0x1f, 0x87, 0x65, 0x2e, 0x84, 0xc1, 0xe8, 0x69, 0x4c, 0x6d, 0xf4, 0xd8, 0x0a, 0xee, 0x0e, 0x61,
+	0xeb, 0x10, 0x43, 0xe8, 0xda, 0x0d, 0xe4, 0x9f, 0xf8, 0x80, 0x93, 0x57, 0x25, 0x84, 0x93, 0x4a,
+	0x25, 0x41, 0x52, 0x15, 0x14, 0x22, 0x1d, 0x34, 0x00, 0x77, 0x13, 0x2f, 0x1f, 0x77, 0x93, 0xbd,
+	0x70, 0x77, 0x05, 0x2e, 0x0e, 0xf2, 0x08, 0x0f, 0xd1, 0xbf, 0x97, 0x60, 0x41, 0x5c, 0xce, 0xfc,
+	0xe7, 0xd6, 0x6f, 0x05, 0xc4, 0x5c, 0x83, 0x19, 0x13, 0x6b, 0x11, 0xf5, 0x12, 0x74, 0x6e, 0x52,
+	0xea, 0xa4, 0x89, 0xef, 0x76, 0x17, 0x42, 0x90, 0x54, 0x72, 0xb4, 0x41, 0xdc, 0xe2, 0xff, 0x8e,
+	0xc1, 0x05, 0x76, 0x8e, 0x5d, 0x27, 0x7e, 0xf3, 0xb4, 0x9d, 0xe4, 0xd4, 0xf9, 0xf2, 0x4c, 0x5f,
+	0x82, 0x6c, 0x27, 0x24, 0x3b, 0x4f, 0x5a, 0x5e, 0x5b, 0xd5, 0x90, 0xdf, 0x85, 0x49, 0x71, 0x28,
+	0x35, 0x4e, 0x13, 0x77, 0xb2, 0x27, 0xa5, 0xa3, 0x7e, 0xd3, 0x3b, 0x4e, 0xd3, 0x54, 0x26, 0x4d,
+	0x5c, 0x24, 0x47, 0x49, 0x5c, 0x4c, 0x74, 0xd8, 0x69, 0x83, 0x72, 0x09, 0x96, 0x07, 0x78, 0x9d,
+	0xcf, 0xcf, 0x1f, 0x4b, 0xb0, 0xb8, 0x81, 0x70, 0xcd, 0x31, 0x77, 0x4e, 0xb5, 0x27, 0xbc, 0x07,
+	0xe3, 0xa3, 0x9e, 0x94, 0x07, 0xa9, 0x55, 0x85, 0x44, 0xe5, 0xef, 0xe2, 0xb0, 0xd4, 0x87, 0x9a,
+	0x63, 0xe6, 0xfb, 0x50, 0xe8, 0xa4, 0x5a, 0x6b, 0xb6, 0xb5, 0x6b, 0xee, 0xf1, 0x9b, 0xf3, 0x95,
+	0xe8, 0xb1, 0x44, 0x4e, 0xd0, 0x3a, 0x65, 0x54, 0x27, 0x50, 0xb0, 0x41, 0xde, 0x83, 0xd9, 0x88,
+	0x8c, 0x2e, 0xcd, 0x1f, 0x33, 0x83, 0x57, 0x47, 0x50, 0x42, 0xb3, 0xc6, 0xd3, 0x47, 0x51, 0xcd,
+	0xf2, 0xfb, 0x20, 0x37, 0x91, 0x65, 0x98, 0xd6, 0x9e, 0xa6, 0xb3, 0x63, 0xb3, 0x89, 0x70, 0x29,
+	0x4e, 0x73, 0xa5, 0x97, 0x7b, 0xeb, 0xd8, 0x64, 0x3c, 0xe2, 0xa4, 0x4d, 0x35, 0x14, 0x9b, 0x81,
+	0x46, 0x13, 0x61, 0xf9, 0x03, 0x28, 0x08, 0xe9, 0x14, 0xc8, 0x1c, 0xfa, 0x38, 0x4d, 0x64, 0x5f,
+	0x1b, 0x28, 0x3b, 0x18, 0x4b, 0x54, 0xc3, 0x44, 0xd3, 0xd7, 0xe5, 0x20, 0x4b, 0x3e, 0x0f, 0xb9,
+	0x9a, 0x63, 0x5b, 0x5e, 0x22, 0x8b, 0x27, 0x0b, 0xb3, 0xa4, 0x51, 0x00, 0x85, 0xf2, 0xeb, 0x71,
+	0x28, 0xa9, 0xbc, 0x9e, 0x12, 0xd1, 0x80, 0xc5, 0x4f, 0xaf, 0x7e, 0x2b, 0x80, 0x60, 0x17, 0xa6,
+	0x83, 0x0f, 0xa1, 0x6d, 0xcd, 0x74, 0x51, 0x43, 0xf8, 0xff, 0xea, 0x48, 0x8f, 0xa1, 0xed, 0xaa,
+	0x8b, 0x1a, 0xea, 0xe4, 0x61, 0xa8, 0x0d, 0xcb, 0x37, 0x61, 0x8c, 0x2e, 0x73, 0x5c, 0x4a, 0xf4,
+	0x4f, 0xc4, 0x6d, 0xe8, 0xae, 0xbe, 0x56, 0xb7, 0x77, 0x54, 0x4e, 0x2f, 0xdf, 0x85, 0x3c, 0xa9,
+	0xeb, 0x23, 0xa7, 0x03, 0x2e, 0x21, 0x39, 0xa4, 0x84, 0xac, 0x85, 0x8e, 0xd4, 0x16, 0x03, 0x08,
+	0xac, 0x2c, 0xc0, 0x5c, 0xc4, 0x14, 0x70, 0x54, 0xf8, 0x23, 0x09, 0x66, 0xb6, 0xda, 0x56, 0x6d,
+	0x6b, 0x5f, 0x77, 0x0c, 0xfe, 0x3c, 0xca, 0xa7, 0x67, 0x19, 0xf2, 0xd8, 0x6e, 0x39, 0x35, 0xa4,
+	0xd5, 0xea, 0x2d, 0xec, 0x22, 0x87, 0x4f, 0x50, 0x8e, 0xb5, 0xae, 0xb3, 0x46, 0x79, 0x0e, 0x52,
+	0x98, 0x30, 0x8b, 0x37, 0xa6, 0xa4, 0x3a, 0x4e, 0xbf, 0xab, 0x86, 0x7c, 0x1b, 0x32, 0xec, 0x9d,
+	0x96, 0xe5, 0x38, 0xe3, 0x43, 0xe6, 0x38, 0x81, 0x31, 0x91, 0x66, 0x65, 0x0e, 0x66, 0x43, 0xc3,
+	0x13, 0x37, 0x9c, 0x24, 0x4c, 0x92, 0x3e, 0xb1, 0x10, 0x46, 0x08, 0xab, 0x73, 0x90, 0xf1, 0xc2,
+	0x8a, 0x0f, 0x3b, 0xad, 0x82, 0x68, 0xaa, 0x1a, 0xbe, 0x53, 0x59, 0xdc, 0x77, 0x2a, 0x23, 0x19,
+	0x5e, 0x3e, 0xc7, 0x3c, 0x6d, 0x2e, 0x3e, 0x89, 0xd2, 0x4e, 0x46, 0xb7, 0xf3, 0xcc, 0xe5, 0xb5,
+	0xd1, 0x47, 0xdd, 0xee, 0xd7, 0x99, 0xb1, 0x93, 0xbd, 0xce, 0x9c, 0x05, 0x10, 0x89, 0x43, 0x93,
+	0xbd, 0x83, 0xc5, 0xd5, 0x34, 0x6f, 0xa9, 0x1a, 0xa1, 0x5c, 0x76, 0xea, 0x24, 0xb9, 0xec, 0x4d,
+	0x5e, 0x9c, 0xd1, 0xc9, 0x85, 0x51, 0x59, 0xe9, 0x21, 0x65, 0x15, 0x09, 0xb3, 0x97, 0xc3, 0xa2,
+	0x12, 0x6f, 0xc1, 0xb8, 0x48, 0x49, 0xc3, 0x90, 0x29, 0x69, 0xc1, 0xe0, 0xcf, 0xac, 0x67, 0x82,
+	0x99, 0xf5, 0x75, 0xc8, 0xb2, 0xa7, 0x7b, 0x5e, 0x99, 0x9a, 0x1d, 0xb2, 0x32, 0x35, 0x43, 0x5f,
+	0xf4, 0xd9, 0x07, 0x29, 0xa3, 0xa0, 0x42, 0x48, 0x00, 0x20, 0x47, 0x33, 0x0d, 0x64, 0xb9, 0xa6,
+	0xdb, 0xa6, 0xcf, 0x5e, 0x69, 0x55, 0x26, 0x7d, 0x6f, 0xd3, 0xae, 0x2a, 0xef, 0x21, 0xa5, 0x08,
+	0x5d, 0xe8, 0xc1, 0x8b, 0x28, 0x2a, 0xa3, 0xe1, 0x86, 0x9a, 0x0f, 0x62, 0x86, 0x32, 0x03, 0x53,
+	0xc1, 0x98, 0xe6, 0xc1, 0x4e, 0x8a, 0x0a, 0xc4, 0xc6, 0xf8, 0x0d, 0xd7, 0x4b, 0x29, 0xff, 0x23,
+	0xc1, 0x2b, 0xd1, 0x63, 0xe1, 0xfb, 0xf3, 0x3e, 0x4c, 0xd6, 0xf4, 0xda, 0x3e, 0x0a, 0xd6, 0xb2,
+	0xf3, 0x2d, 0xfa, 0x66, 0xa4, 0x87, 0x7c, 0xd5, 0xf0, 0x7e, 0xfd, 0x01, 0xf1, 0x45, 0x2a, 0xd4,
+	0xdf, 0x24, 0x5b, 0x30, 0x63, 0xe8, 0xae, 0xbe, 0xa3, 0xe3, 0x6e, 0x65, 0xb1, 0x53, 0x2a, 0x9b,
+	0x12, 0x72, 0xfd, 0xad, 0xca, 0x3f, 0x49, 0x30, 0x2f, 0x4c, 0xe7, 0x53, 0x76, 0xdf, 0xc6, 0xfe,
+	0xfc, 0xf2, 0xbe, 0x8d, 0x5d, 0x4d, 0x37, 0x0c, 0x07, 0x61, 0x2c, 0x66, 0x81, 0xb4, 0xdd, 0x66,
+	0x4d, 0xfd, 0xe0, 0xb2, 0x7b, 0x0e, 0xe3, 0xc3, 0xee, 0x87, 0x89, 0xd3, 0xef, 0x87, 0xca, 0xb3,
+	0x18, 0x2c, 0x44, 0x5a, 0xc6, 0xe7, 0xf4, 0x3c, 0xe4, 0xe8, 0x38, 0xb1, 0x66, 0xb5, 0x1a, 0x3b,
+	0x7c, 0x33, 0x48, 0xaa, 0x59, 0xd6, 0xf8, 0x98, 0xb6, 0xc9, 0x0b, 0x90, 0x16, 0xc6, 0xe1, 0x52,
+	0x6c, 0x31, 0xbe, 0x92, 0x54, 0x53, 0xdc, 0x3a, 0x52, 0xe1, 0x38, 0xd1, 0x31, 0x8f, 0x4e, 0x65,
+	0xdf, 0x02, 0x7d, 0x8f, 0x96, 0x98, 0xe0, 0x3d, 0x0d, 0xad, 0x13, 0x3e, 0x7a, 0x20, 0xc9, 0x5b,
+	0x81, 0x36, 0xf9, 0x3a, 0xcc, 0x32, 0xdd, 0x35, 0xdb, 0x72, 0x1d, 0xbb, 0x5e, 0x47, 0x8e, 0xa8,
+	0x12, 0x4a, 0x50, 0x47, 0x4e, 0xd3, 0xee, 0x75, 0xaf, 0x97, 0x17, 0xff, 0x10, 0x6c, 0xe1, 0xd3,
+	0xc5, 0x4e, 0x30, 0xe2, 0x53, 0xa9, 0x40, 0x71, 0xbd, 0x6e, 0x63, 0x44, 0x37, 0x1f, 0x31, 0xc5,
+	0xfe, 0xf9, 0x93, 0x02, 0xf3, 0xa7, 0x4c, 0x81, 0xec, 0xa7, 0x17, 0x25, 0x36, 0x12, 0x14, 0x59,
+	0xc6, 0xc6, 0x7f, 0xff, 0xeb, 0x2d, 0x46, 0xbe, 0x0b, 0x29, 0xb2, 0x55, 0xef, 0x11, 0x50, 0x89,
+	0xd1, 0xfa, 0xa6, 0xd7, 0xfa, 0x57, 0x4f, 0xb1, 0x5c, 0x2b, 0xe3, 0x50, 0x3d, 0x5e, 0xff, 0x1b,
+	0x6f, 0x3c, 0xf0, 0xc6, 0x5b, 0x85, 0x89, 0x43, 0x13, 0x9b, 0x3b, 0x66, 0xdd, 0x74, 0xdb, 0xa3,
+	0x3d, 0x3f, 0xe6, 0x3b, 0x8c, 0x74, 0x7b, 0x9e, 0x02, 0xd9, 0x6f, 0x1b, 0x37, 0xf9, 0x99, 0x04,
+	0x67, 0xef, 0x21, 0x57, 0xed, 0xfc, 0x90, 0xe6, 0x11, 0xfb, 0x11, 0x8d, 0x77, 0xb6, 0x78, 0x08,
+	0x63, 0xb4, 0x8a, 0x81, 0x2c, 0x91, 0x78, 0xcf, 0x10, 0xf0, 0xfd, 0x12, 0x87, 0x25, 0x23, 0xbc,
+	0x4f, 0x5a, 0xef, 0xa0, 0x72, 0x19, 0x64, 0xe1, 0xf0, 0x23, 0x0a, 0x7d, 0x5c, 0xe4, 0xfb, 0x79,
+	0x86, 0xb7, 0x91, 0xd8, 0x51, 0x3e, 0x89, 0x41, 0xb9, 0xd7, 0x90, 0x78, 0x84, 0xff, 0x2a, 0xe4,
+	0xd9, 0x94, 0xf0, 0x5f, 0xfc, 0x88, 0xb1, 0xbd, 0x33, 0xe4, 0x6b, 0x5c, 0x7f, 0xf1, 0x15, 0x1a,
+	0x15, 0xa2, 0x95, 0x55, 0x2e, 0xe4, 0xb0, 0xbf, 0x6d, 0xbe, 0x0d, 0x72, 0x98, 0xc8, 0x5f, 0xc5,
+	0x90, 0x64, 0x55, 0x0c, 0x8f, 0x82, 0x55, 0x0c, 0x37, 0x46, 0xf4, 0x9d, 0x37, 0xb2, 0x4e, 0x61,
+	0x83, 0xf2, 0x31, 0x2c, 0xde, 0x43, 0xee, 0xc6, 0xc3, 0x27, 0x7d, 0xe6, 0xec, 0x29, 0x2f, 0xc0,
+	0x24, 0x37, 0x21, 0xe1, 0x9b, 0x51, 0x75, 0x7b, 0x85, 0x34, 0x69, 0x97, 0xff, 0x85, 0x95, 0xdf,
+	0x92, 0x60, 0xa9, 0x8f, 0x72, 0x3e, 0x3b, 0x1f, 0x42, 0xd1, 0x27, 0x96, 0x66, 0x2b, 0xc4, 0x20,
+	0xae, 0x9d, 0x60, 0x10, 0x6a, 0xc1, 0x09, 0x36, 0x60, 0xe5, 0x77, 0x24, 0x98, 0xa2, 0x15, 0x1f,
+	0x02, 0x2f, 0x47, 0xd8, 0x5b, 0xdf, 0xea, 0xbe, 0x14, 0xff, 0xcc, 0xc0, 0x4b, 0x71, 0x94, 0xaa,
+	0xce, 0x45, 0xf8, 0x00, 0xa6, 0xbb, 0x08, 0xb8, 0x1f, 0x54, 0x48, 0x75, 0xbd, 0x16, 0x5f, 0x1f,
+	0x55, 0x15, 0xe3, 0x56, 0x3d, 0x39, 0xca, 0xef, 0x49, 0x30, 0xa5, 0x22, 0xbd, 0xd9, 0xac, 0xb3,
+	0x2c, 0x03, 0x1e, 0xc1, 0xf2, 0xad, 0x6e, 0xcb, 0xa3, 0xab, 0xab, 0xfc, 0x3f, 0x3a, 0x63, 0xd3,
+	0x11, 0x56, 0xd7, 0xb1, 0x7e, 0x16, 0xa6, 0xbb, 0x08, 0xf8, 0x48, 0xff, 0x22, 0x06, 0xd3, 0x2c,
+	0x56, 0xba, 0xa3, 0xf3, 0x0e, 0x24, 0xbc, 0xea, 0xb9, 0xbc, 0x3f, 0x0f, 0x10, 0x85, 0x98, 0x1b,
+	0x48, 0x37, 0x1e, 0x22, 0xd7, 0x45, 0x0e, 0x2d, 0x44, 0xa1, 0x05, 0x0b, 0x94, 0xbd, 0xdf, 0xf6,
+	0x1c, 0xbe, 0x0f, 0xc5, 0xa3, 0xee, 0x43, 0x37, 0xa0, 0x64, 0x5a, 0x84, 0xc2, 0x3c, 0x44, 0x1a,
+	0xb2, 0x3c, 0x38, 0xe9, 0xd4, 0xda, 0x4c, 0x7b, 0xfd, 0x77, 0x2c, 0xb1, 0xd8, 0xab, 0x86, 0xfc,
+	0x1a, 0x14, 0x1b, 0xfa, 0xb1, 0xd9, 0x68, 0x35, 0xb4, 0x26, 0xa1, 0xc7, 0xe6, 0xc7, 0xec, 0x52,
+	0x9d, 0x54, 0x27, 0x78, 0xc7, 0xa6, 0xbe, 0x87, 0xb6, 0xcc, 0x8f, 0x91, 0x7c, 0x11, 0x26, 0x68,
+	0x59, 0x1d, 0x25, 0x64, 0xf5, 0x60, 0x63, 0xb4, 0x1e, 0x8c, 0x56, 0xdb, 0x11, 0x32, 0x56, 0x73,
+	0xfe, 0x59, 0x0c, 0x66, 0xba, 0xfd, 0xc5, 0x03, 0xe9, 0x05, 0x39, 0x2c, 0x72, 0x5d, 0xc6, 0x5e,
+	0xe0, 0xba, 0x8c, 0xb2, 0x35, 0x1e, 0x61, 0xab, 0xfc, 0x1e, 0xe4, 0xc4, 0x4d, 0x9e, 0x8d, 0x82,
+	0x65, 0x3b, 0xae, 0x0f, 0x73, 0x04, 0xe4, 0x27, 0x9e, 0x8d, 0x87, 0x4f, 0x3c, 0x84, 0xca, 0x72,
+	0x61, 0x0c, 0x1c, 0xfe, 0x85, 0xfc, 0x56, 0xa1, 0xe5, 0xec, 0xa1, 0xef, 0x62, 0xe8, 0x29, 0xf3,
+	0x50, 0x0a, 0x1b, 0x27, 0x1e, 0xda, 0x63, 0x30, 0xfb, 0x08, 0x7d, 0x47, 0x2d, 0x7f, 0x29, 0x8b,
+	0x6e, 0x0d, 0x4a, 0x8f, 0x50, 0xb4, 0x37, 0xa3, 0x64, 0x48, 0x51, 0x32, 0x3e, 0xa1, 0x45, 0xe4,
+	0xbb, 0x0e, 0xc2, 0xfb, 0xfe, 0x6c, 0xfb, 0x28, 0xc8, 0xfc, 0x6e, 0x37, 0x32, 0xff, 0xfc, 0x90,
+	0xc8, 0xdc, 0x53, 0x6b, 0x07, 0xa0, 0x69, 0x5d, 0x79, 0x14, 0x5d, 0x27, 0xad, 0x54, 0xde, 0x40,
+	0x75, 0x74, 0xba, 0xe7, 0xc7, 0x97, 0x96, 0xfd, 0x23, 0x0f, 0xe8, 0x3d, 0x87, 0xc7, 0x4d, 0xf8,
+	0x95, 0xce, 0x4d, 0x8f, 0x9e, 0xca, 0x68, 0xd0, 0xe2, 0x21, 0xce, 0xef, 0x0b, 0x90, 0xee, 0x84,
+	0x12, 0x0b, 0xe7, 0x54, 0xb3, 0x4f, 0x0c, 0x45, 0x81, 0x99, 0xf2, 0x27, 0xbe, 0xfb, 0x7e, 0x40,
+	0x3d, 0x8f, 0xa3, 0x3e, 0xfa, 0xd7, 0x60, 0x8c, 0x96, 0x16, 0x0b, 0x18, 0x7e, 0x6d, 0x50, 0x4a,
+	0x82, 0xfd, 0xc2, 0x84, 0xde, 0x7a, 0x39, 0xe7, 0xb0, 0xc3, 0x5c, 0x6b, 0x7e, 0xfe, 0x65, 0xf9,
+	0xcc, 0x17, 0x5f, 0x96, 0xcf, 0x7c, 0xfd, 0x65, 0x59, 0xfa, 0xb5, 0xe7, 0x65, 0xe9, 0xcf, 0x9e,
+	0x97, 0xa5, 0xbf, 0x7d, 0x5e, 0x96, 0x3e, 0x7f, 0x5e, 0x96, 0xfe, 0xed, 0x79, 0x59, 0xfa, 0x8f,
+	0xe7, 0xe5, 0x33, 0x5f, 0x3f, 0x2f, 0x4b, 0xcf, 0xbe, 0x2a, 0x9f, 0xf9, 0xfc, 0xab, 0xf2, 0x99,
+	0x2f, 0xbe, 0x2a, 0x9f, 0x79, 0xf7, 0xd6, 0x9e, 0xdd, 0x19, 0x93, 0x69, 0xf7, 0xfd, 0x1f, 0x13,
+	0x3f, 0x1b, 0x6c, 0xd9, 0x19, 0xa3, 0x77, 0x93, 0x6b, 0xff, 0x3b, 0x00, 0x76, 0xea, 0x0e, 0xe8,
+	0xa2, 0x42, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -5836,6 +6069,9 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.CronSchedule != that1.CronSchedule {
+		return false
+	}
 	return true
 }
 func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
@@ -6572,6 +6808,14 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
 		return false
 	}
+	if len(this.HistoryTasks) != len(that1.HistoryTasks) {
+		return false
+	}
+	for i := range this.HistoryTasks {
+		if !this.HistoryTasks[i].Equal(that1.HistoryTasks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
@@ -6739,6 +6983,119 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	return true
+}
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DescribeShardQueuesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeShardQueuesRequest)
+	if !ok {
+		that2, ok := that.(DescribeShardQueuesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *DescribeShardQueuesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeShardQueuesResponse)
+	if !ok {
+		that2, ok := that.(DescribeShardQueuesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if len(this.Queues) != len(that1.Queues) {
+		return false
+	}
+	for i := range this.Queues {
+		if !this.Queues[i].Equal(that1.Queues[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
 func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -7341,7 +7698,7 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
 	if this.ExecutionConfig != nil {
 		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
@@ -7355,6 +7712,7 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this.PendingChildren != nil {
 		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
 	}
+	s = append(s, "CronSchedule: "+fmt.Sprintf("%#v", this.CronSchedule)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7673,13 +8031,16 @@ func (this *GetDLQMessagesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&historyservice.GetDLQMessagesResponse{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	if this.ReplicationTasks != nil {
 		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
 	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryTasks != nil {
+		s = append(s, "HistoryTasks: "+fmt.Sprintf("%#v", this.HistoryTasks)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7752,12 +8113,60 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *DeleteWorkflowExecutionRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeShardQueuesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.DescribeShardQueuesRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeShardQueuesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.DescribeShardQueuesResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	if this.Queues != nil {
+		s = append(s, "Queues: "+fmt.Sprintf("%#v", this.Queues)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -9836,6 +10245,13 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.PendingChildren) > 0 {
 		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10944,6 +11360,20 @@ func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistoryTasks) > 0 {
+		for iNdEx := len(m.HistoryTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.NextPageToken) > 0 {
 		i -= len(m.NextPageToken)
 		copy(dAtA[i:], m.NextPageToken)
@@ -11193,6 +11623,160 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeShardQueuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeShardQueuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeShardQueuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeShardQueuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeShardQueuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeShardQueuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestResponse(v)
 	base := offset
@@ -12066,6 +12650,10 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -12524,6 +13112,12 @@ func (m *GetDLQMessagesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.HistoryTasks) > 0 {
+		for _, e := range m.HistoryTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12626,47 +13220,114 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *StartWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
-		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
-		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
-		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
-		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *StartWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *GetMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeShardQueuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeShardQueuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StartWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
+		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
+		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
+		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
+		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
 		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
 		`}`,
@@ -13153,6 +13814,7 @@ func (this *DescribeWorkflowExecutionResponse) String() string {
 		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
 		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
 		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`CronSchedule:` + fmt.Sprintf("%v", this.CronSchedule) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13459,10 +14121,16 @@ func (this *GetDLQMessagesResponse) String() string {
 		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v113.ReplicationTask", 1) + ","
 	}
 	repeatedStringForReplicationTasks += "}"
+	repeatedStringForHistoryTasks := "[]*HistoryDLQTaskInfo{"
+	for _, f := range this.HistoryTasks {
+		repeatedStringForHistoryTasks += strings.Replace(fmt.Sprintf("%v", f), "HistoryDLQTaskInfo", "v111.HistoryDLQTaskInfo", 1) + ","
+	}
+	repeatedStringForHistoryTasks += "}"
 	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
 		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`HistoryTasks:` + repeatedStringForHistoryTasks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13534,6 +14202,55 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *DeleteWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeShardQueuesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeShardQueuesRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeShardQueuesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueState{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(fmt.Sprintf("%v", f), "QueueState", "v17.QueueState", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&DescribeShardQueuesResponse{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -19711,6 +20428,38 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22880,6 +23629,40 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryTasks = append(m.HistoryTasks, &v111.HistoryDLQTaskInfo{})
+			if err := m.HistoryTasks[len(m.HistoryTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23555,6 +24338,445 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeShardQueuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeShardQueuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeShardQueuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeShardQueuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeShardQueuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeShardQueuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &v17.QueueState{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestResponse(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
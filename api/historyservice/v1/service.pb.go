@@ -54,72 +54,74 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6b, 0x24, 0x45,
+	0x18, 0x87, 0xa7, 0x2e, 0x1e, 0x0a, 0x5d, 0xb5, 0x15, 0x3f, 0xa2, 0x36, 0x22, 0x78, 0x9d, 0x21,
+	0xbb, 0x97, 0xfd, 0xc8, 0xba, 0x6e, 0x26, 0xc9, 0x24, 0xbb, 0x19, 0x35, 0x33, 0x8b, 0x82, 0x17,
+	0xe9, 0xf4, 0xbc, 0x9b, 0x29, 0xd2, 0x99, 0x6a, 0xab, 0x6a, 0x46, 0xe7, 0x26, 0x78, 0x12, 0x04,
+	0x45, 0x10, 0x3c, 0x09, 0x9e, 0x14, 0x41, 0x10, 0x04, 0x41, 0x10, 0x3c, 0x09, 0x1e, 0x73, 0x5c,
+	0x3c, 0x99, 0xc9, 0xc5, 0x63, 0xfe, 0x84, 0x65, 0xa6, 0xa7, 0x2a, 0x53, 0xdd, 0xd5, 0x43, 0x55,
+	0xf5, 0xdc, 0x76, 0x93, 0xfa, 0x3d, 0xfd, 0x74, 0x7d, 0xbd, 0xd5, 0x15, 0x7c, 0x4d, 0xc0, 0x49,
+	0x4a, 0x59, 0x94, 0x34, 0x38, 0xb0, 0x11, 0xb0, 0x46, 0x94, 0x92, 0x46, 0x9f, 0x70, 0x41, 0xd9,
+	0x78, 0xfa, 0x13, 0x12, 0x43, 0x63, 0xb4, 0xde, 0x98, 0xff, 0xb3, 0x9e, 0x32, 0x2a, 0x68, 0xf0,
+	0xa6, 0x0c, 0xd5, 0xb3, 0x50, 0x3d, 0x4a, 0x49, 0x5d, 0x0f, 0xd5, 0x47, 0xeb, 0x6b, 0x1b, 0x76,
+	0x6c, 0x06, 0x1f, 0x0f, 0x81, 0x8b, 0x8f, 0x18, 0xf0, 0x94, 0x0e, 0xf8, 0xfc, 0x21, 0x57, 0xff,
+	0x5d, 0xc7, 0x57, 0x76, 0xb3, 0xc6, 0xdd, 0xac, 0x71, 0xf0, 0x23, 0xc2, 0x2f, 0x74, 0x45, 0xc4,
+	0xc4, 0x07, 0x94, 0x1d, 0x3f, 0x4c, 0xe8, 0x27, 0xdb, 0x9f, 0x42, 0x3c, 0x14, 0x84, 0x0e, 0x82,
+	0xad, 0xba, 0x95, 0x53, 0xdd, 0x1c, 0xef, 0x64, 0x0a, 0x6b, 0xdb, 0x15, 0x29, 0xd9, 0x0b, 0xbc,
+	0x51, 0x0b, 0xbe, 0x41, 0xf8, 0xe9, 0x16, 0x88, 0xf6, 0x50, 0x44, 0x87, 0x09, 0x74, 0x45, 0x24,
+	0x20, 0xb8, 0x6d, 0x09, 0xcf, 0xe5, 0xa4, 0xdb, 0x5b, 0xbe, 0x71, 0x25, 0xf5, 0x2d, 0xc2, 0xcf,
+	0xbc, 0x47, 0x93, 0x44, 0xb3, 0xb2, 0xc5, 0xe6, 0x83, 0x52, 0xeb, 0x8e, 0x77, 0x5e, 0x79, 0xfd,
+	0x80, 0xf0, 0xf3, 0x1d, 0xe0, 0x20, 0xba, 0x82, 0xc4, 0xc7, 0xe3, 0x07, 0x11, 0x3f, 0x3e, 0x18,
+	0xc2, 0x10, 0x82, 0x4d, 0x4b, 0xb6, 0x29, 0x2c, 0xfd, 0x9a, 0x95, 0x18, 0xca, 0xf1, 0x57, 0x84,
+	0x5f, 0xee, 0x40, 0x4c, 0x59, 0x4f, 0x0e, 0xfb, 0xb4, 0xd5, 0x6c, 0x1e, 0x40, 0x2f, 0x68, 0x59,
+	0x3f, 0xa4, 0x84, 0x20, 0x6d, 0x77, 0xab, 0x83, 0x0c, 0xca, 0x77, 0x63, 0x41, 0x46, 0x44, 0x8c,
+	0xfd, 0x95, 0x0d, 0x04, 0x3f, 0x65, 0x23, 0x48, 0x29, 0xff, 0x81, 0xf0, 0xab, 0xd9, 0x7f, 0xb5,
+	0x77, 0x6b, 0xd2, 0x93, 0x34, 0x81, 0xa9, 0xf5, 0x3d, 0xfb, 0xd1, 0x2c, 0x85, 0x48, 0xf1, 0xfb,
+	0x2b, 0x61, 0xe5, 0xba, 0xbb, 0xd0, 0x74, 0x27, 0x22, 0x89, 0x53, 0x77, 0x97, 0x10, 0xdc, 0xbb,
+	0xbb, 0x14, 0xa4, 0x94, 0x7f, 0x47, 0xf8, 0x95, 0xe2, 0xb0, 0xec, 0x42, 0xc4, 0xc4, 0x21, 0x44,
+	0x22, 0xd8, 0xf3, 0x1e, 0x5a, 0xc5, 0x90, 0xda, 0xf7, 0x56, 0x81, 0x32, 0xcd, 0x93, 0xc5, 0xa6,
+	0xde, 0xf3, 0xc4, 0x08, 0xf1, 0x9c, 0x27, 0x25, 0x2c, 0xd3, 0x3c, 0x59, 0x6c, 0xea, 0x37, 0x4f,
+	0x8a, 0x04, 0xcf, 0x79, 0x62, 0x02, 0xe5, 0xe6, 0x49, 0xf1, 0xed, 0xa2, 0x41, 0x0c, 0x53, 0xe9,
+	0xbd, 0x0a, 0x3d, 0x34, 0x67, 0xb8, 0xcf, 0x93, 0x25, 0x28, 0x25, 0xfe, 0x33, 0xc2, 0x2f, 0x76,
+	0xc9, 0xd1, 0x20, 0x4a, 0x8a, 0x27, 0x06, 0xeb, 0x5a, 0x6f, 0xce, 0x4b, 0xe1, 0x9d, 0xaa, 0x18,
+	0x25, 0xfb, 0x37, 0xc2, 0xaf, 0xcf, 0x5b, 0x11, 0xd1, 0x2f, 0x39, 0xe7, 0xbc, 0xe3, 0xf6, 0xb8,
+	0x52, 0x90, 0xd4, 0x7f, 0x77, 0x65, 0x3c, 0xf5, 0x1e, 0xbf, 0x20, 0xfc, 0x52, 0x07, 0x4e, 0xe8,
+	0x08, 0xb2, 0x90, 0x76, 0xdc, 0xd8, 0xb1, 0x1e, 0x5f, 0x33, 0x40, 0x7a, 0xb7, 0x2a, 0x73, 0x94,
+	0xef, 0x6f, 0x08, 0xaf, 0x3d, 0x00, 0x76, 0x42, 0x06, 0x91, 0x80, 0x62, 0x8f, 0xdb, 0x2e, 0xa4,
+	0x72, 0x84, 0x74, 0xde, 0x5b, 0x01, 0x49, 0x59, 0x4f, 0xcf, 0xc2, 0xb3, 0x33, 0x8b, 0xff, 0x59,
+	0xd8, 0x1c, 0x77, 0x3d, 0x0b, 0x97, 0x51, 0x94, 0xe9, 0x5f, 0x08, 0x87, 0x73, 0x68, 0xb6, 0x44,
+	0x8b, 0xc6, 0xfb, 0xd6, 0xcf, 0x5a, 0x86, 0x91, 0xe6, 0xed, 0x15, 0xd1, 0xb4, 0x03, 0x6a, 0x37,
+	0xee, 0x43, 0x6f, 0x98, 0xc0, 0x62, 0x41, 0xb5, 0x3e, 0xa0, 0x9a, 0xc2, 0xae, 0x07, 0x54, 0x33,
+	0x43, 0x39, 0xfe, 0x89, 0xf0, 0x6b, 0x59, 0xf1, 0x6c, 0xf6, 0x49, 0xd2, 0x53, 0xaf, 0x71, 0x59,
+	0x13, 0xef, 0x3b, 0x95, 0xe0, 0x12, 0x8a, 0xb4, 0xde, 0x5f, 0x0d, 0x4c, 0xab, 0x8a, 0x5b, 0xc0,
+	0x63, 0x46, 0x0e, 0x0d, 0x6b, 0xd0, 0x76, 0xb5, 0x97, 0x12, 0x5c, 0xab, 0xe2, 0x12, 0x90, 0x52,
+	0xfe, 0x0e, 0xe1, 0x67, 0x3b, 0x90, 0x26, 0x24, 0x8e, 0x04, 0x6c, 0x8f, 0x60, 0x20, 0xf8, 0xfb,
+	0x57, 0x83, 0x3b, 0xd6, 0x1d, 0x93, 0x4b, 0x4a, 0xc5, 0xb7, 0xfd, 0x01, 0xda, 0xe7, 0x67, 0x77,
+	0x3c, 0x88, 0xbb, 0xfd, 0x88, 0xf5, 0xa6, 0xfb, 0xdd, 0x90, 0x5b, 0x7f, 0x7e, 0xe6, 0x72, 0xae,
+	0x9f, 0x9f, 0x85, 0xb8, 0x92, 0xfa, 0x02, 0xe1, 0x27, 0xa7, 0xbf, 0x95, 0x35, 0x3b, 0xb8, 0xe9,
+	0x80, 0x94, 0x21, 0xa9, 0x73, 0xcb, 0x2b, 0xab, 0xad, 0x68, 0x39, 0xc6, 0x5a, 0x7d, 0xda, 0x74,
+	0x9c, 0x20, 0xa6, 0xda, 0xd4, 0xac, 0xc4, 0x50, 0x8e, 0xdf, 0x23, 0xfc, 0x9c, 0x6c, 0x32, 0xbf,
+	0x08, 0xd9, 0xa5, 0x5c, 0x04, 0x77, 0x1d, 0xf1, 0x0b, 0x59, 0x69, 0xb8, 0x59, 0x05, 0xa1, 0x04,
+	0x3f, 0x47, 0x18, 0x37, 0x13, 0xca, 0x61, 0x36, 0xde, 0xc1, 0x75, 0x4b, 0xe8, 0x65, 0x44, 0xea,
+	0xdc, 0xf0, 0x48, 0x6a, 0x16, 0x59, 0x95, 0x9f, 0x6d, 0xc9, 0xd7, 0x9d, 0x0e, 0x06, 0x8b, 0x1b,
+	0xf1, 0x0d, 0x8f, 0xa4, 0x56, 0x8e, 0x5b, 0x20, 0xe4, 0xa2, 0x24, 0x74, 0xd0, 0x06, 0xce, 0xa3,
+	0x23, 0xe0, 0xd6, 0xe5, 0xd8, 0x1c, 0x77, 0x2d, 0xc7, 0x65, 0x14, 0x6d, 0xa7, 0x6d, 0x81, 0xd8,
+	0xda, 0x3f, 0x30, 0xc9, 0xb6, 0xec, 0x1f, 0x63, 0x26, 0xb8, 0xee, 0xb4, 0x4b, 0x40, 0x4a, 0xf9,
+	0x4b, 0x84, 0x9f, 0x3a, 0x18, 0x02, 0x1b, 0xcb, 0xed, 0x38, 0xb0, 0x5d, 0xfe, 0x5a, 0x4a, 0xaa,
+	0x6d, 0xf8, 0x85, 0x35, 0x9d, 0x0e, 0x44, 0x69, 0x9a, 0x8c, 0xb3, 0xbd, 0xd7, 0x5a, 0x47, 0x4b,
+	0xb9, 0xea, 0xe4, 0xc2, 0x4a, 0xe7, 0x2b, 0x84, 0xaf, 0x64, 0xbd, 0xa8, 0x46, 0x71, 0xc3, 0xa9,
+	0xf3, 0xf3, 0x43, 0x77, 0xdb, 0x33, 0xad, 0x5f, 0x34, 0x0e, 0xd9, 0x11, 0x2c, 0x3a, 0x59, 0x5f,
+	0x34, 0xe6, 0x82, 0xce, 0x17, 0x8d, 0x85, 0xbc, 0xe6, 0xd5, 0x06, 0x4f, 0xaf, 0x36, 0x54, 0xf3,
+	0x6a, 0x43, 0xa9, 0x57, 0x76, 0x01, 0xfa, 0x90, 0x01, 0xef, 0x2f, 0x9e, 0xee, 0xb8, 0xc3, 0x05,
+	0x68, 0x31, 0xec, 0x7e, 0x01, 0x6a, 0x62, 0x68, 0x9f, 0xd2, 0x5b, 0x90, 0x80, 0xe9, 0x13, 0x69,
+	0xdb, 0xba, 0x9c, 0x18, 0xf3, 0xae, 0x9f, 0xd2, 0xa5, 0x18, 0x63, 0xe9, 0x9c, 0xd5, 0x8b, 0xd9,
+	0x75, 0x2e, 0x77, 0x2e, 0x9d, 0x0b, 0x59, 0xdf, 0xd2, 0xa9, 0x21, 0xa4, 0xe0, 0x66, 0x7a, 0x7a,
+	0x16, 0xd6, 0x1e, 0x9d, 0x85, 0xb5, 0x8b, 0xb3, 0x10, 0x7d, 0x36, 0x09, 0xd1, 0x4f, 0x93, 0x10,
+	0xfd, 0x33, 0x09, 0xd1, 0xe9, 0x24, 0x44, 0xff, 0x4d, 0x42, 0xf4, 0xff, 0x24, 0xac, 0x5d, 0x4c,
+	0x42, 0xf4, 0xf5, 0x79, 0x58, 0x3b, 0x3d, 0x0f, 0x6b, 0x8f, 0xce, 0xc3, 0xda, 0x87, 0x37, 0x8f,
+	0xe8, 0xe5, 0xd3, 0x09, 0x5d, 0xfa, 0x67, 0x95, 0x5b, 0xfa, 0x4f, 0x0e, 0x9f, 0x98, 0xfd, 0x55,
+	0xe5, 0xda, 0xe3, 0x01, 0x00, 0x2e, 0x60, 0xb6, 0xf9, 0xf1, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +264,10 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// DeleteWorkflowExecution deletes a closed workflow execution along with its history and visibility records.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// DescribeShardQueues returns the in-memory state of the task queue processors of a shard.
+	DescribeShardQueues(ctx context.Context, in *DescribeShardQueuesRequest, opts ...grpc.CallOption) (*DescribeShardQueuesResponse, error)
 }
 
 type historyServiceClient struct {
@@ -596,6 +602,24 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeShardQueues(ctx context.Context, in *DescribeShardQueuesRequest, opts ...grpc.CallOption) (*DescribeShardQueuesResponse, error) {
+	out := new(DescribeShardQueuesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeShardQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -726,6 +750,10 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// DeleteWorkflowExecution deletes a closed workflow execution along with its history and visibility records.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// DescribeShardQueues returns the in-memory state of the task queue processors of a shard.
+	DescribeShardQueues(context.Context, *DescribeShardQueuesRequest) (*DescribeShardQueuesResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -840,6 +868,12 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DescribeShardQueues(ctx context.Context, req *DescribeShardQueuesRequest) (*DescribeShardQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShardQueues not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1493,6 +1527,42 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeShardQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeShardQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeShardQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeShardQueues(ctx, req.(*DescribeShardQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1641,6 +1711,14 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _HistoryService_DeleteWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeShardQueues",
+			Handler:    _HistoryService_DescribeShardQueues_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
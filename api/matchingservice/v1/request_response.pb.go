@@ -537,6 +537,8 @@ type AddWorkflowTaskRequest struct {
 	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string         `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	// Dispatch priority of the task, 1 being the highest. 0 means the task queue default.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -620,6 +622,13 @@ func (m *AddWorkflowTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddWorkflowTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -666,6 +675,8 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string         `protobuf:"bytes,7,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	// Dispatch priority of the task, 1 being the highest. 0 means the task queue default.
+	Priority int32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -756,6 +767,13 @@ func (m *AddActivityTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type AddActivityTaskResponse struct {
 }
 
@@ -1344,114 +1362,116 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0x4f, 0x73, 0xdb, 0x58,
-	0x3d, 0x72, 0x12, 0x27, 0x7e, 0xb2, 0x13, 0x47, 0x0b, 0x59, 0x25, 0x6d, 0x94, 0xd4, 0xbb, 0xec,
-	0x66, 0x99, 0x45, 0x9e, 0x86, 0xd9, 0xce, 0xb6, 0xd0, 0x81, 0x34, 0xcd, 0xb4, 0x86, 0xb6, 0xa4,
-	0x8a, 0x07, 0x98, 0x0e, 0x33, 0xea, 0x8b, 0xf4, 0xe2, 0x88, 0xc8, 0x7a, 0x8e, 0xde, 0x93, 0x53,
-	0x73, 0x62, 0xa6, 0xc3, 0xbd, 0x33, 0x5c, 0x60, 0xf8, 0x02, 0x70, 0x86, 0x0f, 0xc1, 0x81, 0x43,
-	0x8f, 0xbd, 0x41, 0x93, 0x0b, 0x33, 0x5c, 0xca, 0x37, 0x60, 0xde, 0x1f, 0xc9, 0x92, 0x6c, 0x27,
-	0x4e, 0x9a, 0xa1, 0x7b, 0xb3, 0x7e, 0xff, 0xff, 0xff, 0x7e, 0xcf, 0xe0, 0x2e, 0x45, 0xed, 0x0e,
-	0x0e, 0xa1, 0x5f, 0x27, 0x28, 0xec, 0xa2, 0xb0, 0x0e, 0x3b, 0x5e, 0xbd, 0x0d, 0xa9, 0x73, 0xe0,
-	0x05, 0x2d, 0x06, 0xf2, 0x1c, 0x54, 0xef, 0xde, 0xac, 0x87, 0xe8, 0x28, 0x42, 0x84, 0xda, 0x21,
-	0x22, 0x1d, 0x1c, 0x10, 0x64, 0x76, 0x42, 0x4c, 0xb1, 0xf6, 0x59, 0xcc, 0x6e, 0x0a, 0x76, 0x13,
-	0x76, 0x3c, 0x33, 0xc7, 0x6e, 0x76, 0x6f, 0x2e, 0x1b, 0x2d, 0x8c, 0x5b, 0x3e, 0xaa, 0x73, 0xae,
-	0xbd, 0x68, 0xbf, 0xee, 0x46, 0x21, 0xa4, 0x1e, 0x0e, 0x84, 0x9c, 0xe5, 0xd5, 0x3c, 0x9e, 0x7a,
-	0x6d, 0x44, 0x28, 0x6c, 0x77, 0x24, 0xc1, 0x0d, 0x17, 0x75, 0x50, 0xe0, 0xa2, 0xc0, 0xf1, 0x10,
-	0xa9, 0xb7, 0x70, 0x0b, 0x73, 0x38, 0xff, 0x25, 0x49, 0x3e, 0x4d, 0x5c, 0x61, 0x3e, 0x38, 0xb8,
-	0xdd, 0xc6, 0x01, 0x33, 0xbd, 0x8d, 0x08, 0x81, 0x2d, 0x69, 0xf1, 0xf2, 0x67, 0x19, 0x2a, 0x14,
-	0x44, 0x6d, 0xc2, 0x88, 0x28, 0x24, 0x87, 0xf6, 0x51, 0x84, 0xa2, 0x98, 0xee, 0xf3, 0x0c, 0x1d,
-	0x43, 0x73, 0xec, 0xa0, 0xc0, 0x4f, 0x32, 0x84, 0x47, 0x11, 0x0a, 0x7b, 0x83, 0x44, 0x9f, 0x0f,
-	0x0b, 0x73, 0x46, 0xb9, 0x24, 0xfc, 0x72, 0x18, 0xe1, 0x81, 0x47, 0x28, 0x1e, 0x26, 0xf6, 0x56,
-	0x46, 0xf7, 0x31, 0x0e, 0x0f, 0xf7, 0x7d, 0x7c, 0x7c, 0x6e, 0xda, 0x6a, 0xff, 0x51, 0xc0, 0xf5,
-	0x1d, 0xec, 0xfb, 0xbf, 0x90, 0x1c, 0x4d, 0x48, 0x0e, 0x9f, 0x32, 0xf7, 0x2c, 0x41, 0xaf, 0xdd,
-	0x00, 0xe5, 0x00, 0xb6, 0x11, 0xe9, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x6b, 0xca, 0x7a, 0xc9,
-	0x52, 0x13, 0x58, 0xc3, 0xd5, 0xae, 0x81, 0x52, 0x07, 0xfb, 0x3e, 0x0a, 0x19, 0xbe, 0xc0, 0xf1,
-	0xb3, 0x02, 0xd0, 0x70, 0xb5, 0xe7, 0xa0, 0xcc, 0x7e, 0xdb, 0x52, 0xbf, 0x3e, 0xb9, 0xa6, 0xac,
-	0xab, 0x1b, 0x77, 0xcd, 0xa4, 0x5c, 0x58, 0x9d, 0xe4, 0xec, 0x35, 0xbb, 0x37, 0xcd, 0xb3, 0x8c,
-	0xb2, 0x54, 0x26, 0x32, 0xb6, 0xf0, 0x0b, 0x50, 0xdd, 0xc7, 0xe1, 0x31, 0x0c, 0x5d, 0xe4, 0xda,
-	0x04, 0x47, 0xa1, 0x83, 0xf4, 0x29, 0x6e, 0xc5, 0x7c, 0x02, 0xdf, 0xe5, 0xe0, 0xda, 0xcb, 0x12,
-	0x58, 0x19, 0x21, 0x58, 0x44, 0x45, 0x5b, 0x01, 0x80, 0x17, 0x00, 0xc5, 0x87, 0x28, 0xe0, 0xce,
-	0x96, 0xad, 0x12, 0x83, 0x34, 0x19, 0x40, 0xfb, 0x25, 0xd0, 0x62, 0x5b, 0x6d, 0xf4, 0x02, 0x39,
-	0x11, 0xab, 0x5c, 0xee, 0xb3, 0xba, 0xf1, 0x45, 0xd6, 0x27, 0x51, 0x76, 0xcc, 0x95, 0x58, 0xdb,
-	0x76, 0xcc, 0x60, 0x2d, 0x1c, 0xe7, 0x41, 0x5a, 0x03, 0x54, 0x12, 0xc9, 0xb4, 0xd7, 0x41, 0x32,
-	0x50, 0x9f, 0x9e, 0x27, 0xb4, 0xd9, 0xeb, 0x20, 0xab, 0x7c, 0x9c, 0xfa, 0xd2, 0x6e, 0x83, 0xa5,
-	0x4e, 0x88, 0xba, 0x1e, 0x8e, 0x88, 0x4d, 0x28, 0x0c, 0x29, 0x72, 0x6d, 0xd4, 0x45, 0x01, 0x65,
-	0xf9, 0x61, 0x91, 0x99, 0xb4, 0x16, 0x63, 0x82, 0x5d, 0x81, 0xdf, 0x66, 0xe8, 0x86, 0xab, 0xad,
-	0x83, 0xea, 0x00, 0xc7, 0x34, 0xe7, 0x98, 0x23, 0x59, 0x4a, 0x1d, 0xcc, 0x40, 0xca, 0x6c, 0xa3,
-	0x7a, 0x71, 0x4d, 0x59, 0x9f, 0xb6, 0xe2, 0x4f, 0xad, 0x06, 0x2a, 0x01, 0x7a, 0x41, 0xfb, 0x02,
-	0x66, 0xb8, 0x00, 0x95, 0x01, 0x63, 0xee, 0x2f, 0x81, 0xb6, 0x07, 0x9d, 0x43, 0x1f, 0xb7, 0x6c,
-	0x07, 0x47, 0x01, 0xb5, 0x0f, 0xbc, 0x80, 0xea, 0xb3, 0x9c, 0xb0, 0x2a, 0x31, 0x5b, 0x0c, 0xf1,
-	0xd0, 0x0b, 0xa8, 0xf6, 0x35, 0xd0, 0x09, 0xf5, 0x9c, 0xc3, 0x5e, 0x3f, 0xe6, 0x36, 0x0a, 0xe0,
-	0x9e, 0x8f, 0x5c, 0xbd, 0xb4, 0xa6, 0xac, 0xcf, 0x5a, 0x8b, 0x02, 0x9f, 0x84, 0x73, 0x5b, 0x60,
-	0xb5, 0x3b, 0x60, 0x9a, 0xf7, 0xa1, 0x0e, 0x86, 0x45, 0x93, 0xa3, 0xd2, 0xc1, 0x7c, 0xca, 0x00,
-	0x96, 0x60, 0xd1, 0x5a, 0xa9, 0x5c, 0xf3, 0x9a, 0xf0, 0x82, 0x7d, 0xac, 0xab, 0x5c, 0xd0, 0x6d,
-	0x73, 0xd8, 0xb8, 0x93, 0xdd, 0xc9, 0x24, 0x36, 0x43, 0x18, 0x10, 0x0f, 0x05, 0x34, 0x5d, 0x6a,
-	0x8d, 0x60, 0x1f, 0x5b, 0xd5, 0xe3, 0x1c, 0x44, 0x6b, 0x81, 0x95, 0xc1, 0xa2, 0xb2, 0xfb, 0x73,
-	0x48, 0x2f, 0x0f, 0x33, 0x3e, 0x19, 0x44, 0x5c, 0x5d, 0x52, 0xc8, 0xcb, 0x03, 0xa5, 0x95, 0xe0,
-	0x58, 0x2f, 0xef, 0x85, 0x30, 0x70, 0x0e, 0x64, 0x79, 0xcf, 0xf1, 0xf2, 0x56, 0x05, 0x4c, 0x14,
-	0xf8, 0x03, 0x30, 0x47, 0x9c, 0x03, 0xe4, 0x46, 0x3e, 0x72, 0x6d, 0x36, 0x7a, 0xf5, 0x79, 0xae,
-	0x7c, 0xd9, 0x14, 0x73, 0xd9, 0x8c, 0xe7, 0xb2, 0xd9, 0x8c, 0xe7, 0xf2, 0xbd, 0xa9, 0x57, 0xff,
-	0x5c, 0x55, 0xac, 0x4a, 0xc2, 0xc7, 0x30, 0xda, 0x16, 0x28, 0xc7, 0x95, 0xc4, 0xc5, 0x54, 0xc7,
-	0x14, 0xa3, 0x4a, 0x2e, 0x2e, 0xc4, 0x07, 0x33, 0x2c, 0x17, 0x1e, 0x22, 0xfa, 0xc2, 0xda, 0xe4,
-	0xba, 0xba, 0x61, 0x99, 0xe3, 0xad, 0x19, 0xf3, 0xcc, 0x2e, 0x37, 0x9f, 0x0a, 0xa1, 0xdb, 0x01,
-	0x0d, 0x7b, 0x56, 0xac, 0x62, 0xf9, 0x39, 0x28, 0xa7, 0x11, 0x5a, 0x15, 0x4c, 0x1e, 0xa2, 0x9e,
-	0x9c, 0x78, 0xec, 0x27, 0x2b, 0xa7, 0x2e, 0xf4, 0x23, 0x24, 0x3b, 0x7e, 0xcc, 0x72, 0xe2, 0x2c,
-	0x77, 0x0a, 0x5f, 0x2b, 0x3f, 0x99, 0x9a, 0xad, 0x54, 0xe7, 0x92, 0x99, 0xbb, 0xe9, 0x50, 0xaf,
-	0xeb, 0xd1, 0xde, 0x37, 0x6a, 0xe6, 0x8e, 0x32, 0xea, 0xd2, 0x33, 0xf7, 0x1f, 0xb3, 0x62, 0xe6,
-	0x0e, 0x11, 0xfc, 0xa1, 0x67, 0xee, 0x2a, 0x50, 0xa1, 0xb4, 0x8a, 0x85, 0x71, 0x92, 0x3b, 0x00,
-	0x62, 0x50, 0xc3, 0x65, 0x43, 0x39, 0x21, 0xe0, 0x43, 0x79, 0xea, 0xec, 0xa1, 0x9c, 0xf8, 0xc8,
-	0x87, 0x32, 0x4c, 0x7d, 0x69, 0xb7, 0xc0, 0xb4, 0x17, 0x74, 0x22, 0xca, 0xc7, 0xa9, 0xba, 0xb1,
-	0x36, 0x4a, 0xc4, 0x0e, 0xec, 0xf9, 0x18, 0xba, 0xc4, 0x12, 0xe4, 0x43, 0x1a, 0xb2, 0x78, 0xb9,
-	0x86, 0x7c, 0x06, 0x96, 0x62, 0x80, 0x4d, 0xb1, 0xed, 0xf8, 0x98, 0x20, 0x2e, 0x10, 0x47, 0x94,
-	0x8f, 0x68, 0x75, 0x63, 0x69, 0x40, 0xe6, 0x7d, 0x79, 0x9c, 0xdd, 0x9b, 0xfa, 0x03, 0x13, 0xb9,
-	0x18, 0x4b, 0x68, 0xe2, 0x2d, 0xc6, 0xdf, 0x14, 0xec, 0x03, 0xcd, 0x3e, 0x7b, 0x99, 0x66, 0x6f,
-	0x82, 0x45, 0xfe, 0x39, 0x68, 0x5d, 0x69, 0x3c, 0xeb, 0x3e, 0xe2, 0xec, 0x39, 0xd3, 0x1e, 0x81,
-	0x85, 0x03, 0x04, 0x43, 0xba, 0x87, 0x20, 0x4d, 0x04, 0x82, 0xf1, 0x04, 0x56, 0x13, 0xce, 0x58,
-	0x5a, 0x6a, 0xeb, 0xa9, 0xd9, 0xad, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x56, 0x9e, 0x04, 0xd9,
-	0xb9, 0xbc, 0x95, 0xc7, 0x0c, 0xca, 0x35, 0x29, 0x67, 0x53, 0x88, 0xd9, 0xcd, 0x64, 0xf1, 0x71,
-	0xda, 0x1d, 0x17, 0x51, 0xe8, 0xf9, 0x44, 0xaf, 0x8c, 0x59, 0x52, 0x7d, 0x7f, 0xee, 0x0b, 0xce,
-	0xc1, 0xab, 0x63, 0xee, 0xd2, 0x57, 0xc7, 0xf7, 0x52, 0x6d, 0x9a, 0x4c, 0x2a, 0xbe, 0x3d, 0x4a,
-	0xfd, 0xde, 0x7b, 0x12, 0x23, 0xb4, 0x5b, 0xa0, 0x78, 0x80, 0xa0, 0x8b, 0x42, 0xb9, 0x19, 0x8c,
-	0x51, 0x2a, 0x1f, 0x72, 0x2a, 0x4b, 0x52, 0xd7, 0xfe, 0x3a, 0x09, 0x16, 0x37, 0x5d, 0x37, 0x3d,
-	0xdb, 0x2f, 0x30, 0x36, 0x1f, 0x80, 0xd2, 0x7b, 0x8c, 0x90, 0x3e, 0xaf, 0xb6, 0x25, 0x67, 0x96,
-	0x58, 0xd0, 0x93, 0x17, 0x58, 0xd0, 0x7c, 0xb2, 0x89, 0x7d, 0xbc, 0x0a, 0xd4, 0xa4, 0x25, 0x93,
-	0xd3, 0x0c, 0xc4, 0xa0, 0x86, 0x9b, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0xa7, 0x2f, 0xdc, 0xb3,
-	0xfc, 0xd8, 0x8b, 0x4b, 0x79, 0xd8, 0x08, 0x2f, 0x0e, 0x1d, 0xe1, 0xda, 0x8f, 0x41, 0x51, 0x12,
-	0xb0, 0x39, 0x31, 0xb7, 0xb1, 0x3e, 0x74, 0x0b, 0xf3, 0x47, 0x4c, 0xec, 0xab, 0xe0, 0xb4, 0x24,
-	0x5f, 0x6d, 0x09, 0x7c, 0x3c, 0x90, 0x34, 0x31, 0xfd, 0x6b, 0xa7, 0x22, 0xa1, 0xe9, 0xf5, 0xf0,
-	0x21, 0x12, 0x6a, 0x82, 0x8f, 0x84, 0xad, 0x76, 0x46, 0xa5, 0xd8, 0x09, 0x0b, 0x02, 0xf5, 0x24,
-	0xa5, 0x38, 0x5b, 0x00, 0x53, 0x57, 0x52, 0x00, 0xd3, 0x17, 0x2b, 0x80, 0xe2, 0xd5, 0x17, 0xc0,
-	0xcc, 0x79, 0x05, 0x30, 0xfb, 0x5e, 0x05, 0x90, 0x4d, 0xb2, 0x2c, 0x80, 0xdf, 0x15, 0xc0, 0xb7,
-	0xf8, 0xa5, 0x14, 0xe7, 0xe7, 0x02, 0xe9, 0xcf, 0x66, 0xa1, 0x70, 0xb9, 0x2c, 0x3c, 0x03, 0x15,
-	0x7e, 0xba, 0xe5, 0xee, 0xa5, 0xaf, 0xce, 0xbd, 0x97, 0x86, 0x59, 0x6d, 0x95, 0xb9, 0xac, 0x4b,
-	0x1c, 0x4a, 0x7f, 0x51, 0xc0, 0xb7, 0x73, 0x12, 0xe5, 0x81, 0xb4, 0x05, 0xca, 0xb1, 0x81, 0x24,
-	0xf2, 0x29, 0x0f, 0xc4, 0x38, 0xf3, 0x5e, 0x95, 0xa6, 0x30, 0x26, 0xed, 0xa7, 0x60, 0x2e, 0x16,
-	0xf2, 0x6b, 0xe4, 0x50, 0xe4, 0x9e, 0x73, 0xc4, 0x8a, 0xe3, 0x55, 0xd2, 0x5a, 0x95, 0xa3, 0xf4,
-	0x67, 0xed, 0xf7, 0x05, 0xb0, 0x26, 0xcc, 0x73, 0x39, 0x1d, 0x8b, 0xeb, 0x16, 0x6e, 0x77, 0x7c,
-	0xc4, 0x88, 0xff, 0xcf, 0xf9, 0xfb, 0x18, 0xcc, 0x88, 0xf7, 0x59, 0xdc, 0xae, 0x45, 0xf6, 0xd9,
-	0x70, 0xb5, 0x00, 0x2c, 0x38, 0xb1, 0x51, 0x49, 0x72, 0x45, 0xab, 0x6e, 0x9e, 0x9b, 0xdc, 0xf3,
-	0xdc, 0xb3, 0xaa, 0x4e, 0x0e, 0x52, 0xfb, 0x04, 0xdc, 0x38, 0x83, 0x4b, 0x96, 0xfb, 0x7f, 0x15,
-	0x70, 0x7d, 0x0b, 0x06, 0x0e, 0xf2, 0x7f, 0x16, 0x51, 0x42, 0x61, 0xe0, 0x7a, 0x41, 0x6b, 0x27,
-	0x75, 0x5b, 0x8f, 0x11, 0xb6, 0x47, 0x60, 0xbe, 0x1f, 0x36, 0xb1, 0xb8, 0x0b, 0xbc, 0x31, 0x73,
-	0xb1, 0xcb, 0x74, 0x24, 0x0f, 0x16, 0x5f, 0xdc, 0x15, 0x9a, 0xfe, 0xbc, 0x9a, 0x5d, 0x96, 0x79,
-	0x90, 0x4c, 0x65, 0x1f, 0x24, 0xb5, 0x55, 0xb0, 0x32, 0xc2, 0x65, 0x19, 0x94, 0x3f, 0x29, 0x40,
-	0xbf, 0x8f, 0x88, 0x13, 0x7a, 0x7b, 0xe8, 0x32, 0xcf, 0xa1, 0x5f, 0x81, 0xb2, 0x8b, 0x88, 0x93,
-	0x24, 0xb9, 0x90, 0x7f, 0xa5, 0x8f, 0x48, 0xf2, 0x28, 0x9d, 0x96, 0xca, 0xc4, 0xc5, 0x79, 0xfd,
-	0x9b, 0x02, 0x96, 0x86, 0x50, 0xca, 0xee, 0xfc, 0x11, 0x98, 0x11, 0x8e, 0x12, 0x5d, 0xe1, 0x8f,
-	0xd4, 0xef, 0x9c, 0x11, 0xbb, 0x1d, 0x11, 0x92, 0x60, 0x1f, 0x5b, 0x31, 0x97, 0xf6, 0x73, 0xb0,
-	0x90, 0xca, 0x26, 0xa1, 0x90, 0x46, 0x44, 0x7a, 0xf0, 0xdd, 0x71, 0xd2, 0xb0, 0xcb, 0x39, 0xac,
-	0x79, 0x9a, 0x05, 0xd4, 0x5e, 0x2a, 0xc0, 0x78, 0xe4, 0x11, 0x9a, 0x10, 0xee, 0xc0, 0x90, 0x7a,
-	0x6c, 0x33, 0x90, 0x38, 0xb4, 0xd7, 0x41, 0xa9, 0x7f, 0xab, 0x89, 0xb8, 0xf6, 0x01, 0x57, 0xd2,
-	0x9d, 0xb5, 0x3f, 0x16, 0xc0, 0xea, 0x48, 0x2b, 0x64, 0x08, 0x7f, 0x03, 0x8c, 0xfe, 0x3b, 0xab,
-	0x1f, 0x8a, 0x4e, 0x42, 0x29, 0x23, 0xfb, 0xd5, 0x38, 0xca, 0x13, 0xf9, 0x8f, 0x11, 0x85, 0x2e,
-	0xa4, 0xd0, 0xba, 0x06, 0xf3, 0x6f, 0xcf, 0xbe, 0x0d, 0x4c, 0x77, 0xf6, 0x6f, 0x9e, 0x01, 0xdd,
-	0x85, 0xf7, 0xd2, 0x7d, 0x9c, 0xff, 0x17, 0xa2, 0xaf, 0xfb, 0x5e, 0xf8, 0xfa, 0xad, 0x31, 0xf1,
-	0xe6, 0xad, 0x31, 0xf1, 0xee, 0xad, 0xa1, 0xfc, 0xf6, 0xc4, 0x50, 0xfe, 0x7c, 0x62, 0x28, 0x7f,
-	0x3f, 0x31, 0x94, 0xd7, 0x27, 0x86, 0xf2, 0xaf, 0x13, 0x43, 0xf9, 0xf7, 0x89, 0x31, 0xf1, 0xee,
-	0xc4, 0x50, 0x5e, 0x9d, 0x1a, 0x13, 0xaf, 0x4f, 0x8d, 0x89, 0x37, 0xa7, 0xc6, 0xc4, 0xb3, 0x1f,
-	0xb6, 0x70, 0xdf, 0x16, 0x0f, 0x9f, 0xfd, 0x7f, 0xfd, 0x0f, 0x72, 0xa0, 0xbd, 0x22, 0xbf, 0x13,
-	0xbe, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x11, 0x60, 0x20, 0xf0, 0x17, 0x00, 0x00,
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x36, 0x65, 0x5b, 0x96, 0x46, 0x92, 0x2d, 0x73, 0x5b, 0x2f, 0xed, 0xc4, 0xb4, 0xa3, 0xdd,
+	0xee, 0x7a, 0x8b, 0x2d, 0x85, 0xb8, 0xd8, 0x60, 0x77, 0xdb, 0xa0, 0x75, 0x1c, 0x23, 0x51, 0x9b,
+	0xa4, 0x0e, 0x2d, 0xb4, 0x45, 0x50, 0x80, 0x19, 0x93, 0x63, 0x99, 0x35, 0xc5, 0xa1, 0x39, 0x43,
+	0x39, 0xea, 0xa9, 0x40, 0xd0, 0x7b, 0x80, 0x5e, 0x5a, 0xf4, 0x0f, 0xb4, 0xf7, 0xfe, 0x88, 0x1e,
+	0x0a, 0x34, 0xc7, 0xdc, 0xda, 0x38, 0x97, 0x02, 0xbd, 0xa4, 0xbf, 0xa0, 0xc5, 0x7c, 0x90, 0x22,
+	0x29, 0xc9, 0x96, 0x1d, 0xa3, 0xe9, 0x4d, 0x7c, 0x3f, 0x9e, 0x79, 0xbf, 0xdf, 0x19, 0x81, 0xdb,
+	0x14, 0x75, 0x03, 0x1c, 0x42, 0xaf, 0x49, 0x50, 0xd8, 0x43, 0x61, 0x13, 0x06, 0x6e, 0xb3, 0x0b,
+	0xa9, 0x7d, 0xe8, 0xfa, 0x1d, 0x46, 0x72, 0x6d, 0xd4, 0xec, 0xdd, 0x6c, 0x86, 0xe8, 0x38, 0x42,
+	0x84, 0x5a, 0x21, 0x22, 0x01, 0xf6, 0x09, 0x32, 0x82, 0x10, 0x53, 0xac, 0x7e, 0x12, 0xab, 0x1b,
+	0x42, 0xdd, 0x80, 0x81, 0x6b, 0xe4, 0xd4, 0x8d, 0xde, 0xcd, 0x15, 0xbd, 0x83, 0x71, 0xc7, 0x43,
+	0x4d, 0xae, 0xb5, 0x1f, 0x1d, 0x34, 0x9d, 0x28, 0x84, 0xd4, 0xc5, 0xbe, 0xc0, 0x59, 0x59, 0xcb,
+	0xf3, 0xa9, 0xdb, 0x45, 0x84, 0xc2, 0x6e, 0x20, 0x05, 0x6e, 0x38, 0x28, 0x40, 0xbe, 0x83, 0x7c,
+	0xdb, 0x45, 0xa4, 0xd9, 0xc1, 0x1d, 0xcc, 0xe9, 0xfc, 0x97, 0x14, 0xf9, 0x38, 0x71, 0x85, 0xf9,
+	0x60, 0xe3, 0x6e, 0x17, 0xfb, 0xcc, 0xf4, 0x2e, 0x22, 0x04, 0x76, 0xa4, 0xc5, 0x2b, 0x9f, 0x64,
+	0xa4, 0x90, 0x1f, 0x75, 0x09, 0x13, 0xa2, 0x90, 0x1c, 0x59, 0xc7, 0x11, 0x8a, 0x62, 0xb9, 0x4f,
+	0x33, 0x72, 0x8c, 0xcd, 0xb9, 0xc3, 0x80, 0x1f, 0x65, 0x04, 0x8f, 0x23, 0x14, 0xf6, 0x87, 0x85,
+	0x3e, 0x1d, 0x15, 0xe6, 0xcc, 0xe1, 0x52, 0xf0, 0xf3, 0x51, 0x82, 0x87, 0x2e, 0xa1, 0x78, 0x14,
+	0xec, 0xad, 0xcc, 0xd9, 0x27, 0x38, 0x3c, 0x3a, 0xf0, 0xf0, 0xc9, 0xb9, 0x69, 0x6b, 0xfc, 0x4b,
+	0x01, 0xd7, 0x77, 0xb1, 0xe7, 0xfd, 0x4c, 0x6a, 0xb4, 0x21, 0x39, 0x7a, 0xcc, 0xdc, 0x33, 0x85,
+	0xbc, 0x7a, 0x03, 0x54, 0x7d, 0xd8, 0x45, 0x24, 0x80, 0x36, 0xb2, 0x5c, 0x47, 0x53, 0xd6, 0x95,
+	0x8d, 0xb2, 0x59, 0x49, 0x68, 0x2d, 0x47, 0xbd, 0x06, 0xca, 0x01, 0xf6, 0x3c, 0x14, 0x32, 0x7e,
+	0x81, 0xf3, 0x4b, 0x82, 0xd0, 0x72, 0xd4, 0xa7, 0xa0, 0xca, 0x7e, 0x5b, 0xf2, 0x7c, 0x6d, 0x7a,
+	0x5d, 0xd9, 0xa8, 0x6c, 0xde, 0x36, 0x92, 0x72, 0x61, 0x75, 0x92, 0xb3, 0xd7, 0xe8, 0xdd, 0x34,
+	0xce, 0x32, 0xca, 0xac, 0x30, 0xc8, 0xd8, 0xc2, 0xcf, 0x40, 0xfd, 0x00, 0x87, 0x27, 0x30, 0x74,
+	0x90, 0x63, 0x11, 0x1c, 0x85, 0x36, 0xd2, 0x66, 0xb8, 0x15, 0x0b, 0x09, 0x7d, 0x8f, 0x93, 0x1b,
+	0xcf, 0xcb, 0x60, 0x75, 0x0c, 0xb0, 0x88, 0x8a, 0xba, 0x0a, 0x00, 0x2f, 0x00, 0x8a, 0x8f, 0x90,
+	0xcf, 0x9d, 0xad, 0x9a, 0x65, 0x46, 0x69, 0x33, 0x82, 0xfa, 0x73, 0xa0, 0xc6, 0xb6, 0x5a, 0xe8,
+	0x19, 0xb2, 0x23, 0x56, 0xb9, 0xdc, 0xe7, 0xca, 0xe6, 0x67, 0x59, 0x9f, 0x44, 0xd9, 0x31, 0x57,
+	0xe2, 0xd3, 0x76, 0x62, 0x05, 0x73, 0xf1, 0x24, 0x4f, 0x52, 0x5b, 0xa0, 0x96, 0x20, 0xd3, 0x7e,
+	0x80, 0x64, 0xa0, 0x3e, 0x3e, 0x0f, 0xb4, 0xdd, 0x0f, 0x90, 0x59, 0x3d, 0x49, 0x7d, 0xa9, 0x5f,
+	0x81, 0xe5, 0x20, 0x44, 0x3d, 0x17, 0x47, 0xc4, 0x22, 0x14, 0x86, 0x14, 0x39, 0x16, 0xea, 0x21,
+	0x9f, 0xb2, 0xfc, 0xb0, 0xc8, 0x4c, 0x9b, 0x4b, 0xb1, 0xc0, 0x9e, 0xe0, 0xef, 0x30, 0x76, 0xcb,
+	0x51, 0x37, 0x40, 0x7d, 0x48, 0x63, 0x96, 0x6b, 0xcc, 0x93, 0xac, 0xa4, 0x06, 0xe6, 0x20, 0x65,
+	0xb6, 0x51, 0xad, 0xb8, 0xae, 0x6c, 0xcc, 0x9a, 0xf1, 0xa7, 0xda, 0x00, 0x35, 0x1f, 0x3d, 0xa3,
+	0x03, 0x80, 0x39, 0x0e, 0x50, 0x61, 0xc4, 0x58, 0xfb, 0x73, 0xa0, 0xee, 0x43, 0xfb, 0xc8, 0xc3,
+	0x1d, 0xcb, 0xc6, 0x91, 0x4f, 0xad, 0x43, 0xd7, 0xa7, 0x5a, 0x89, 0x0b, 0xd6, 0x25, 0x67, 0x9b,
+	0x31, 0xee, 0xbb, 0x3e, 0x55, 0xbf, 0x04, 0x1a, 0xa1, 0xae, 0x7d, 0xd4, 0x1f, 0xc4, 0xdc, 0x42,
+	0x3e, 0xdc, 0xf7, 0x90, 0xa3, 0x95, 0xd7, 0x95, 0x8d, 0x92, 0xb9, 0x24, 0xf8, 0x49, 0x38, 0x77,
+	0x04, 0x57, 0xfd, 0x1a, 0xcc, 0xf2, 0x3e, 0xd4, 0xc0, 0xa8, 0x68, 0x72, 0x56, 0x3a, 0x98, 0x8f,
+	0x19, 0xc1, 0x14, 0x2a, 0x6a, 0x27, 0x95, 0x6b, 0x5e, 0x13, 0xae, 0x7f, 0x80, 0xb5, 0x0a, 0x07,
+	0xfa, 0xca, 0x18, 0x35, 0xee, 0x64, 0x77, 0x32, 0xc4, 0x76, 0x08, 0x7d, 0xe2, 0x22, 0x9f, 0xa6,
+	0x4b, 0xad, 0xe5, 0x1f, 0x60, 0xb3, 0x7e, 0x92, 0xa3, 0xa8, 0x1d, 0xb0, 0x3a, 0x5c, 0x54, 0xd6,
+	0x60, 0x0e, 0x69, 0xd5, 0x51, 0xc6, 0x27, 0x83, 0x88, 0x1f, 0x97, 0x14, 0xf2, 0xca, 0x50, 0x69,
+	0x25, 0x3c, 0xd6, 0xcb, 0xfb, 0x21, 0xf4, 0xed, 0x43, 0x59, 0xde, 0xf3, 0xbc, 0xbc, 0x2b, 0x82,
+	0x26, 0x0a, 0xfc, 0x1e, 0x98, 0x27, 0xf6, 0x21, 0x72, 0x22, 0x0f, 0x39, 0x16, 0x1b, 0xbd, 0xda,
+	0x02, 0x3f, 0x7c, 0xc5, 0x10, 0x73, 0xd9, 0x88, 0xe7, 0xb2, 0xd1, 0x8e, 0xe7, 0xf2, 0x9d, 0x99,
+	0x17, 0x7f, 0x5f, 0x53, 0xcc, 0x5a, 0xa2, 0xc7, 0x38, 0xea, 0x36, 0xa8, 0xc6, 0x95, 0xc4, 0x61,
+	0xea, 0x13, 0xc2, 0x54, 0xa4, 0x16, 0x07, 0xf1, 0xc0, 0x1c, 0xcb, 0x85, 0x8b, 0x88, 0xb6, 0xb8,
+	0x3e, 0xbd, 0x51, 0xd9, 0x34, 0x8d, 0xc9, 0xd6, 0x8c, 0x71, 0x66, 0x97, 0x1b, 0x8f, 0x05, 0xe8,
+	0x8e, 0x4f, 0xc3, 0xbe, 0x19, 0x1f, 0xb1, 0xf2, 0x14, 0x54, 0xd3, 0x0c, 0xb5, 0x0e, 0xa6, 0x8f,
+	0x50, 0x5f, 0x4e, 0x3c, 0xf6, 0x93, 0x95, 0x53, 0x0f, 0x7a, 0x11, 0xd2, 0x0a, 0xa3, 0x32, 0x32,
+	0xae, 0x9c, 0xb8, 0xca, 0xd7, 0x85, 0x2f, 0x95, 0x1f, 0xcd, 0x94, 0x6a, 0xf5, 0xf9, 0x64, 0xe6,
+	0x6e, 0xd9, 0xd4, 0xed, 0xb9, 0xb4, 0xff, 0x7f, 0x35, 0x73, 0xc7, 0x19, 0x75, 0xe9, 0x99, 0xfb,
+	0xd7, 0x12, 0x58, 0x1d, 0x03, 0xfc, 0xbe, 0x67, 0xee, 0x1a, 0xa8, 0x40, 0x69, 0x15, 0x0b, 0xe3,
+	0x34, 0x77, 0x00, 0xc4, 0xa4, 0x96, 0xc3, 0x86, 0x72, 0x22, 0xc0, 0x87, 0xf2, 0xcc, 0xd9, 0x43,
+	0x39, 0xf1, 0x91, 0x0f, 0x65, 0x98, 0xfa, 0x52, 0x6f, 0x81, 0x59, 0xd7, 0x0f, 0x22, 0xca, 0xc7,
+	0x69, 0x65, 0x73, 0x7d, 0x1c, 0xc4, 0x2e, 0xec, 0x7b, 0x18, 0x3a, 0xc4, 0x14, 0xe2, 0x23, 0x1a,
+	0xb2, 0x78, 0xb9, 0x86, 0x7c, 0x02, 0x96, 0x63, 0x82, 0x45, 0xb1, 0x65, 0x7b, 0x98, 0x20, 0x0e,
+	0x88, 0x23, 0xca, 0x47, 0x74, 0x65, 0x73, 0x79, 0x08, 0xf3, 0xae, 0xbc, 0x9c, 0xdd, 0x99, 0xf9,
+	0x1d, 0x83, 0x5c, 0x8a, 0x11, 0xda, 0x78, 0x9b, 0xe9, 0xb7, 0x85, 0xfa, 0x50, 0xb3, 0x97, 0x2e,
+	0xd3, 0xec, 0x6d, 0xb0, 0xc4, 0x3f, 0x87, 0xad, 0x2b, 0x4f, 0x66, 0xdd, 0x07, 0x5c, 0x3d, 0x67,
+	0xda, 0x03, 0xb0, 0x78, 0x88, 0x60, 0x48, 0xf7, 0x11, 0xa4, 0x09, 0x20, 0x98, 0x0c, 0xb0, 0x9e,
+	0x68, 0xc6, 0x68, 0xa9, 0xad, 0x57, 0xc9, 0x6e, 0x3d, 0x04, 0x74, 0x3b, 0x0a, 0x43, 0xb6, 0xf2,
+	0x24, 0xc9, 0xca, 0xe5, 0xad, 0x3a, 0x61, 0x50, 0xae, 0x49, 0x9c, 0x2d, 0x01, 0xb3, 0x97, 0xc9,
+	0xe2, 0xc3, 0xb4, 0x3b, 0x0e, 0xa2, 0xd0, 0xf5, 0x88, 0x56, 0x9b, 0xb0, 0xa4, 0x06, 0xfe, 0xdc,
+	0x15, 0x9a, 0xc3, 0xb7, 0x8e, 0xf9, 0x4b, 0xdf, 0x3a, 0xbe, 0x93, 0x6a, 0xd3, 0x64, 0x52, 0xf1,
+	0xed, 0x51, 0x1e, 0xf4, 0xde, 0xa3, 0x98, 0xa1, 0xde, 0x02, 0xc5, 0x43, 0x04, 0x1d, 0x14, 0xca,
+	0xcd, 0xa0, 0x8f, 0x3b, 0xf2, 0x3e, 0x97, 0x32, 0xa5, 0x74, 0xe3, 0x6f, 0xd3, 0x60, 0x69, 0xcb,
+	0x71, 0xd2, 0xb3, 0xfd, 0x02, 0x63, 0xf3, 0x1e, 0x28, 0xbf, 0xc3, 0x08, 0x19, 0xe8, 0xaa, 0xdb,
+	0x72, 0x66, 0x89, 0x05, 0x3d, 0x7d, 0x81, 0x05, 0x5d, 0xa6, 0xf1, 0x4f, 0x36, 0x7f, 0x92, 0x96,
+	0x4c, 0xae, 0x66, 0x20, 0x26, 0xb5, 0x9c, 0x7c, 0xcf, 0xca, 0xf6, 0x90, 0x45, 0x3c, 0x7b, 0xe1,
+	0x9e, 0xe5, 0x97, 0xbd, 0xb8, 0x94, 0x47, 0x8d, 0xf0, 0xe2, 0xc8, 0x11, 0xae, 0xfe, 0x10, 0x14,
+	0xa5, 0x00, 0x9b, 0x13, 0xf3, 0x9b, 0x1b, 0x23, 0xb7, 0x30, 0x7f, 0xc4, 0xc4, 0xbe, 0x0a, 0x4d,
+	0x53, 0xea, 0xa9, 0x2b, 0xa0, 0x14, 0x84, 0x2e, 0x0e, 0x5d, 0xda, 0xe7, 0xc3, 0x61, 0xd6, 0x4c,
+	0xbe, 0x1b, 0xcb, 0xe0, 0xc3, 0xa1, 0x84, 0x8a, 0xcd, 0xd0, 0xf8, 0x8f, 0x48, 0x76, 0x7a, 0x75,
+	0xbc, 0x8f, 0x64, 0x1b, 0xe0, 0x03, 0xe1, 0x87, 0x95, 0x39, 0x52, 0xec, 0x8b, 0x45, 0xc1, 0x7a,
+	0x94, 0x3a, 0x38, 0x5b, 0x1c, 0x33, 0x57, 0x52, 0x1c, 0xb3, 0x17, 0x2b, 0x8e, 0xe2, 0xd5, 0x17,
+	0xc7, 0xdc, 0x79, 0xc5, 0x51, 0xba, 0x82, 0xe2, 0x28, 0x8f, 0x2c, 0x8e, 0x6c, 0x01, 0xc8, 0xe2,
+	0xf8, 0x4d, 0x01, 0x7c, 0x83, 0xdf, 0xb0, 0xe2, 0xdc, 0x5d, 0xa0, 0x34, 0xb2, 0x19, 0x2a, 0x5c,
+	0x2e, 0x43, 0x4f, 0x40, 0x8d, 0x5f, 0xf9, 0x72, 0xf7, 0xac, 0x2f, 0xce, 0xbd, 0x67, 0x8d, 0xb2,
+	0xda, 0xac, 0x72, 0xac, 0x4b, 0x5c, 0xb0, 0xfe, 0xa4, 0x80, 0x6f, 0xe6, 0x10, 0xe5, 0xc5, 0x6a,
+	0x1b, 0x54, 0x63, 0x03, 0x49, 0xe4, 0x51, 0x4d, 0x99, 0x70, 0x4f, 0x54, 0xa4, 0x29, 0x4c, 0x49,
+	0xfd, 0x31, 0x98, 0x8f, 0x41, 0x7e, 0x89, 0x6c, 0x8a, 0x9c, 0x73, 0x2e, 0xbf, 0xe2, 0xd2, 0x2b,
+	0x65, 0xcd, 0xda, 0x71, 0xfa, 0xb3, 0xf1, 0xdb, 0x02, 0x58, 0x17, 0xe6, 0x39, 0x5c, 0x8e, 0xc5,
+	0x75, 0x1b, 0x77, 0x03, 0x0f, 0x31, 0xe1, 0xff, 0x71, 0xfe, 0x3e, 0x04, 0x73, 0x1c, 0x24, 0x69,
+	0xe5, 0x22, 0xfb, 0x6c, 0x39, 0xaa, 0x0f, 0x16, 0xed, 0xd8, 0xa8, 0x24, 0xb9, 0xa2, 0x8d, 0xb7,
+	0xce, 0x4d, 0xee, 0x79, 0xee, 0x99, 0x75, 0x3b, 0x47, 0x69, 0x7c, 0x04, 0x6e, 0x9c, 0xa1, 0x25,
+	0xcb, 0xfd, 0xdf, 0x0a, 0xb8, 0xbe, 0x0d, 0x7d, 0x1b, 0x79, 0x3f, 0x89, 0x28, 0xa1, 0xd0, 0x77,
+	0x5c, 0xbf, 0xb3, 0x9b, 0xba, 0x93, 0x4f, 0x10, 0xb6, 0x07, 0x60, 0x61, 0x10, 0x36, 0xb1, 0xf0,
+	0x0b, 0xbc, 0x69, 0x73, 0xb1, 0xcb, 0x74, 0x2b, 0x0f, 0x16, 0x5f, 0xf8, 0x35, 0x9a, 0xfe, 0xbc,
+	0x9a, 0x1d, 0x98, 0x79, 0xc8, 0xcc, 0x64, 0x1f, 0x32, 0x8d, 0x35, 0xb0, 0x3a, 0xc6, 0x65, 0x19,
+	0x94, 0x3f, 0x28, 0x40, 0xbb, 0x8b, 0x88, 0x1d, 0xba, 0xfb, 0xe8, 0x32, 0xcf, 0xa8, 0x5f, 0x80,
+	0xaa, 0x83, 0x88, 0x9d, 0x24, 0xb9, 0x90, 0x7f, 0xdd, 0x8f, 0x49, 0xf2, 0xb8, 0x33, 0xcd, 0x0a,
+	0x83, 0x8b, 0xf3, 0xfa, 0x67, 0x05, 0x2c, 0x8f, 0x90, 0x94, 0xdd, 0xf9, 0x03, 0x30, 0x27, 0x1c,
+	0x25, 0x9a, 0xc2, 0x1f, 0xb7, 0xdf, 0x3a, 0x23, 0x76, 0xbb, 0x22, 0x24, 0xec, 0x0f, 0x84, 0x58,
+	0x4b, 0xfd, 0x29, 0x58, 0x4c, 0x65, 0x93, 0x50, 0x48, 0x23, 0x22, 0x3d, 0xf8, 0xf6, 0x24, 0x69,
+	0xd8, 0xe3, 0x1a, 0xe6, 0x02, 0xcd, 0x12, 0x1a, 0xcf, 0x15, 0xa0, 0x3f, 0x70, 0x09, 0x4d, 0x04,
+	0x77, 0x61, 0x48, 0x5d, 0xb6, 0x35, 0x48, 0x1c, 0xda, 0xeb, 0xa0, 0x3c, 0xb8, 0xe3, 0x89, 0xb8,
+	0x0e, 0x08, 0x57, 0xd2, 0x9d, 0x8d, 0xdf, 0x17, 0xc0, 0xda, 0x58, 0x2b, 0x64, 0x08, 0x7f, 0x05,
+	0xf4, 0xc1, 0xfb, 0x6c, 0x10, 0x8a, 0x20, 0x91, 0x94, 0x91, 0xfd, 0x62, 0x92, 0xc3, 0x13, 0xfc,
+	0x87, 0x88, 0x42, 0x07, 0x52, 0x68, 0x5e, 0x83, 0xf9, 0x37, 0xeb, 0xc0, 0x06, 0x76, 0x76, 0xf6,
+	0xef, 0xa1, 0xa1, 0xb3, 0x0b, 0xef, 0x74, 0xf6, 0x49, 0xfe, 0xdf, 0x8b, 0xc1, 0xd9, 0x77, 0xc2,
+	0x97, 0xaf, 0xf5, 0xa9, 0x57, 0xaf, 0xf5, 0xa9, 0xb7, 0xaf, 0x75, 0xe5, 0xd7, 0xa7, 0xba, 0xf2,
+	0xc7, 0x53, 0x5d, 0xf9, 0xcb, 0xa9, 0xae, 0xbc, 0x3c, 0xd5, 0x95, 0x7f, 0x9c, 0xea, 0xca, 0x3f,
+	0x4f, 0xf5, 0xa9, 0xb7, 0xa7, 0xba, 0xf2, 0xe2, 0x8d, 0x3e, 0xf5, 0xf2, 0x8d, 0x3e, 0xf5, 0xea,
+	0x8d, 0x3e, 0xf5, 0xe4, 0xfb, 0x1d, 0x3c, 0xb0, 0xc5, 0xc5, 0x67, 0xff, 0xcf, 0xff, 0xbd, 0x1c,
+	0x69, 0xbf, 0xc8, 0xef, 0x10, 0xdf, 0xfd, 0xef, 0x00, 0x7e, 0x2f, 0x32, 0x1f, 0x28, 0x18, 0x00,
+	0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -1747,6 +1767,9 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -1819,6 +1842,9 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
@@ -2251,7 +2277,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2264,6 +2290,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
 	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2280,7 +2307,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2294,6 +2321,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
 	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2949,6 +2977,11 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3053,6 +3086,11 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3838,6 +3876,9 @@ func (m *AddWorkflowTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -3886,6 +3927,9 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -4185,6 +4229,7 @@ func (this *AddWorkflowTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4211,6 +4256,7 @@ func (this *AddActivityTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6187,6 +6233,25 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6535,6 +6600,25 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
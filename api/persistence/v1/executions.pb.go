@@ -1269,6 +1269,116 @@ func (m *TimerTaskInfo) GetVisibilityTime() *time.Time {
 	return nil
 }
 
+// history task dlq row
+type HistoryDLQTaskInfo struct {
+	// Types that are valid to be assigned to TaskInfo:
+	//	*HistoryDLQTaskInfo_TransferTaskInfo
+	//	*HistoryDLQTaskInfo_TimerTaskInfo
+	TaskInfo   isHistoryDLQTaskInfo_TaskInfo `protobuf_oneof:"task_info"`
+	Attempt    int32                         `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError  string                        `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreateTime *time.Time                    `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
+}
+
+func (m *HistoryDLQTaskInfo) Reset()      { *m = HistoryDLQTaskInfo{} }
+func (*HistoryDLQTaskInfo) ProtoMessage() {}
+func (*HistoryDLQTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+}
+func (m *HistoryDLQTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryDLQTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryDLQTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryDLQTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryDLQTaskInfo.Merge(m, src)
+}
+func (m *HistoryDLQTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryDLQTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryDLQTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryDLQTaskInfo proto.InternalMessageInfo
+
+type isHistoryDLQTaskInfo_TaskInfo interface {
+	isHistoryDLQTaskInfo_TaskInfo()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type HistoryDLQTaskInfo_TransferTaskInfo struct {
+	TransferTaskInfo *TransferTaskInfo `protobuf:"bytes,1,opt,name=transfer_task_info,json=transferTaskInfo,proto3,oneof" json:"transfer_task_info,omitempty"`
+}
+type HistoryDLQTaskInfo_TimerTaskInfo struct {
+	TimerTaskInfo *TimerTaskInfo `protobuf:"bytes,2,opt,name=timer_task_info,json=timerTaskInfo,proto3,oneof" json:"timer_task_info,omitempty"`
+}
+
+func (*HistoryDLQTaskInfo_TransferTaskInfo) isHistoryDLQTaskInfo_TaskInfo() {}
+func (*HistoryDLQTaskInfo_TimerTaskInfo) isHistoryDLQTaskInfo_TaskInfo()    {}
+
+func (m *HistoryDLQTaskInfo) GetTaskInfo() isHistoryDLQTaskInfo_TaskInfo {
+	if m != nil {
+		return m.TaskInfo
+	}
+	return nil
+}
+
+func (m *HistoryDLQTaskInfo) GetTransferTaskInfo() *TransferTaskInfo {
+	if x, ok := m.GetTaskInfo().(*HistoryDLQTaskInfo_TransferTaskInfo); ok {
+		return x.TransferTaskInfo
+	}
+	return nil
+}
+
+func (m *HistoryDLQTaskInfo) GetTimerTaskInfo() *TimerTaskInfo {
+	if x, ok := m.GetTaskInfo().(*HistoryDLQTaskInfo_TimerTaskInfo); ok {
+		return x.TimerTaskInfo
+	}
+	return nil
+}
+
+func (m *HistoryDLQTaskInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryDLQTaskInfo) GetCreateTime() *time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*HistoryDLQTaskInfo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*HistoryDLQTaskInfo_TransferTaskInfo)(nil),
+		(*HistoryDLQTaskInfo_TimerTaskInfo)(nil),
+	}
+}
+
 // activity_map column
 type ActivityInfo struct {
 	Version               int64            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1314,7 +1424,7 @@ type ActivityInfo struct {
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1580,7 +1690,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1773,7 @@ type ChildExecutionInfo struct {
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1787,7 +1897,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1858,7 +1968,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1946,7 +2056,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2011,6 +2121,7 @@ func init() {
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.persistence.v1.ReplicationTaskInfo")
 	proto.RegisterType((*VisibilityTaskInfo)(nil), "temporal.server.api.persistence.v1.VisibilityTaskInfo")
 	proto.RegisterType((*TimerTaskInfo)(nil), "temporal.server.api.persistence.v1.TimerTaskInfo")
+	proto.RegisterType((*HistoryDLQTaskInfo)(nil), "temporal.server.api.persistence.v1.HistoryDLQTaskInfo")
 	proto.RegisterType((*ActivityInfo)(nil), "temporal.server.api.persistence.v1.ActivityInfo")
 	proto.RegisterType((*TimerInfo)(nil), "temporal.server.api.persistence.v1.TimerInfo")
 	proto.RegisterType((*ChildExecutionInfo)(nil), "temporal.server.api.persistence.v1.ChildExecutionInfo")
@@ -2024,208 +2135,214 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0xf5, 0x86, 0x45, 0x49, 0xe4, 0x23, 0x45, 0x51, 0xd0, 0x17, 0x24, 0xcb, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0x38, 0x94, 0x2d, 0x3b, 0xdf, 0x99, 0xf9, 0x8d, 0x2d, 0xdb, 0x09, 0x39, 0x89, 0xe3, 0x40,
-	0x4a, 0x9c, 0xc9, 0x6f, 0x32, 0x1c, 0x08, 0x58, 0x4a, 0xa8, 0x40, 0x80, 0x06, 0x40, 0xda, 0xcc,
-	0xf4, 0x90, 0x43, 0xa7, 0xb9, 0xe6, 0xd8, 0x99, 0x9e, 0x7a, 0xeb, 0xb9, 0x33, 0xf9, 0x03, 0x3a,
-	0xbd, 0xf4, 0x98, 0x63, 0x4e, 0x6d, 0xe3, 0x5c, 0x7a, 0xc9, 0x34, 0x7f, 0x42, 0x67, 0xdf, 0xee,
-	0x02, 0x0b, 0x10, 0x92, 0x21, 0x37, 0x3e, 0xe4, 0x06, 0xec, 0xfb, 0xd8, 0xb7, 0x6f, 0xdf, 0x37,
-	0x00, 0xd7, 0x43, 0xd2, 0xeb, 0x7b, 0xbe, 0xe1, 0x6c, 0x05, 0xc4, 0x1f, 0x12, 0x7f, 0xcb, 0xe8,
-	0xdb, 0x5b, 0x7d, 0xe2, 0x07, 0x76, 0x10, 0x12, 0xd7, 0x24, 0x5b, 0xc3, 0x6b, 0x5b, 0xe4, 0x31,
-	0x31, 0x07, 0xa1, 0xed, 0xb9, 0x41, 0xb3, 0xef, 0x7b, 0xa1, 0xa7, 0x36, 0x04, 0x51, 0x93, 0x11,
-	0x35, 0x8d, 0xbe, 0xdd, 0x94, 0x88, 0x9a, 0xc3, 0x6b, 0xab, 0xf5, 0x03, 0xcf, 0x3b, 0x70, 0xc8,
-	0x16, 0x52, 0xec, 0x0f, 0xba, 0x5b, 0xd6, 0xc0, 0x37, 0x28, 0x13, 0xc6, 0x63, 0x75, 0x3d, 0x0d,
-	0x0f, 0xed, 0x1e, 0x09, 0x42, 0xa3, 0xd7, 0xe7, 0x08, 0x17, 0x2c, 0xd2, 0x27, 0xae, 0x45, 0x5c,
-	0xd3, 0x26, 0xc1, 0xd6, 0x81, 0x77, 0xe0, 0xe1, 0x3a, 0x3e, 0x71, 0x94, 0x8b, 0x91, 0xf0, 0x54,
-	0x6a, 0xd3, 0xeb, 0xf5, 0x3c, 0x97, 0x0a, 0xdc, 0x23, 0x41, 0x60, 0x1c, 0x90, 0x4c, 0x2c, 0xe2,
-	0x0e, 0x7a, 0x01, 0x45, 0x7a, 0xe4, 0xf9, 0x47, 0x5d, 0xc7, 0x7b, 0xc4, 0xb1, 0x2e, 0x25, 0xb0,
-	0xba, 0x86, 0xed, 0x0c, 0x7c, 0x32, 0xce, 0x2c, 0x89, 0x76, 0x68, 0x07, 0xa1, 0xe7, 0x8f, 0xc6,
-	0xd1, 0x5e, 0x4c, 0xa0, 0x89, 0xad, 0xc6, 0xf1, 0x2e, 0x67, 0xa9, 0x3f, 0x12, 0x91, 0x9d, 0x88,
-	0xa3, 0xbe, 0x72, 0x22, 0x6a, 0xea, 0x34, 0x2f, 0x9d, 0x88, 0x1c, 0x1a, 0xc1, 0x11, 0x47, 0xbc,
-	0x92, 0x85, 0x78, 0xdc, 0xb1, 0x1a, 0xff, 0x00, 0x28, 0xed, 0x1e, 0x1a, 0xbe, 0xd5, 0x72, 0xbb,
-	0x9e, 0xba, 0x02, 0xc5, 0x80, 0xbe, 0x74, 0x6c, 0x4b, 0x53, 0x36, 0x94, 0xcd, 0x49, 0x7d, 0x1a,
-	0xdf, 0x5b, 0x16, 0x05, 0xf9, 0x86, 0x7b, 0x40, 0x28, 0xe8, 0xec, 0x86, 0xb2, 0x39, 0xa1, 0x4f,
-	0xe3, 0x7b, 0xcb, 0x52, 0x17, 0x60, 0xd2, 0x7b, 0xe4, 0x12, 0x5f, 0x9b, 0xd8, 0x50, 0x36, 0x4b,
-	0x3a, 0x7b, 0x51, 0xb7, 0x61, 0xd1, 0x27, 0x7d, 0xc7, 0x36, 0xd1, 0x46, 0x3a, 0x86, 0x79, 0xd4,
-	0x71, 0xc8, 0x90, 0x38, 0x5a, 0x01, 0xa9, 0xe7, 0x25, 0xe0, 0x4d, 0xf3, 0xe8, 0x03, 0x0a, 0x52,
-	0xaf, 0x80, 0x1a, 0xfa, 0x86, 0x1b, 0x74, 0x89, 0x2f, 0x11, 0x4c, 0x22, 0x41, 0x4d, 0x40, 0x64,
-	0xec, 0x20, 0xf4, 0x1c, 0xe2, 0x76, 0x02, 0xdb, 0x35, 0x49, 0xc7, 0x27, 0x2e, 0x79, 0xa4, 0x4d,
-	0xa1, 0xdc, 0x35, 0x06, 0xd9, 0xa5, 0x00, 0x9d, 0xae, 0xab, 0x37, 0xa1, 0x3c, 0xe8, 0x5b, 0x46,
-	0x48, 0x3a, 0xd4, 0x2e, 0xb5, 0xe9, 0x0d, 0x65, 0xb3, 0xbc, 0xbd, 0xda, 0x64, 0x46, 0xdb, 0x14,
-	0x46, 0xdb, 0xdc, 0x13, 0x46, 0x7b, 0xab, 0xf0, 0xcd, 0x3f, 0xd7, 0x15, 0x1d, 0x18, 0x11, 0x5d,
-	0x56, 0x3f, 0x86, 0x05, 0x4a, 0x2b, 0xc9, 0xc6, 0x78, 0x15, 0x73, 0xf2, 0x9a, 0x43, 0x6a, 0x21,
-	0x3f, 0xb2, 0xbc, 0x0d, 0x75, 0xd7, 0xe8, 0x91, 0xa0, 0x6f, 0x98, 0xa4, 0xe3, 0x7a, 0xa1, 0xdd,
-	0x15, 0x0a, 0x1b, 0x52, 0xef, 0xf3, 0x5c, 0xad, 0x84, 0xa7, 0x5f, 0x8b, 0xb0, 0xee, 0x49, 0x48,
-	0x9f, 0x32, 0x1c, 0xf5, 0x6b, 0x05, 0x56, 0x4d, 0x67, 0x10, 0x84, 0xc4, 0xef, 0x64, 0x28, 0x10,
-	0x36, 0x26, 0x36, 0xcb, 0xdb, 0xed, 0xe6, 0xd3, 0x9d, 0xbc, 0x19, 0xd9, 0x42, 0x73, 0x87, 0xf1,
-	0xdb, 0x4b, 0x69, 0xfd, 0x8e, 0x1b, 0xfa, 0x23, 0x7d, 0xd9, 0xcc, 0x86, 0xaa, 0xbf, 0x53, 0x60,
-	0x39, 0x92, 0x24, 0xa9, 0x2b, 0xad, 0x8c, 0x62, 0xbc, 0xf7, 0x6c, 0x62, 0xc8, 0x9a, 0x43, 0x19,
-	0xb8, 0x4e, 0x17, 0xcc, 0x0c, 0x04, 0xf5, 0xf7, 0x0a, 0xac, 0x08, 0x31, 0x64, 0x2b, 0x64, 0x82,
-	0x54, 0xfe, 0x07, 0x7d, 0xe8, 0x31, 0xb7, 0x0c, 0x7d, 0xa4, 0xa1, 0x54, 0x1f, 0x2b, 0xb2, 0x00,
-	0x96, 0xf3, 0x50, 0xd2, 0xc8, 0x0c, 0x0a, 0xd2, 0x3a, 0x9d, 0x20, 0xd2, 0x1e, 0xb7, 0x9d, 0x87,
-	0xc9, 0x7b, 0x59, 0xf2, 0x33, 0x81, 0xea, 0x55, 0x58, 0x18, 0xda, 0x81, 0xbd, 0x6f, 0x3b, 0x76,
-	0x38, 0x92, 0x04, 0xa8, 0xa2, 0x71, 0xa9, 0x31, 0x4c, 0x50, 0xac, 0xb6, 0x61, 0xed, 0x24, 0x0b,
-	0x50, 0x6b, 0x30, 0x71, 0x44, 0x46, 0x18, 0x25, 0x4a, 0x3a, 0x7d, 0xa4, 0x61, 0x60, 0x68, 0x38,
-	0x03, 0xc2, 0xc3, 0x03, 0x7b, 0x79, 0xfb, 0xec, 0x9b, 0xca, 0xaa, 0x09, 0x2b, 0xc7, 0x5e, 0x63,
-	0x06, 0xa3, 0xab, 0x32, 0xa3, 0x13, 0xfd, 0x4a, 0xde, 0x24, 0x16, 0x38, 0xf3, 0x8a, 0x4e, 0x25,
-	0x70, 0x0b, 0xce, 0x9d, 0xa0, 0xe5, 0xd3, 0xb0, 0x6a, 0xfc, 0xb4, 0x06, 0x8b, 0x0f, 0x78, 0x28,
-	0xbf, 0x23, 0xd2, 0x2e, 0x06, 0xdb, 0x0b, 0x50, 0x89, 0x5d, 0x9f, 0x07, 0xdc, 0x92, 0x5e, 0x8e,
-	0xd6, 0x5a, 0x96, 0xba, 0x0e, 0x65, 0x91, 0x06, 0x44, 0xdc, 0x2d, 0xe9, 0x20, 0x96, 0x5a, 0x96,
-	0xda, 0x84, 0xf9, 0xbe, 0xe1, 0x13, 0x37, 0xec, 0x24, 0x58, 0xb1, 0x40, 0x3c, 0xc7, 0x40, 0xf7,
-	0x24, 0x86, 0x57, 0x40, 0xe5, 0xf8, 0x32, 0xdf, 0x02, 0xa2, 0xd7, 0x18, 0xe4, 0x41, 0xcc, 0xbd,
-	0x01, 0x33, 0x1c, 0xdb, 0x1f, 0xb8, 0x14, 0x71, 0x92, 0x89, 0xc8, 0x16, 0xf5, 0x81, 0xdb, 0xb2,
-	0xe8, 0x29, 0x6c, 0xd7, 0x0e, 0x6d, 0x23, 0x24, 0x98, 0x36, 0xa6, 0x50, 0x01, 0xe5, 0x68, 0xad,
-	0x65, 0xa9, 0x6f, 0xc1, 0x8a, 0xe9, 0xf5, 0xfa, 0x0e, 0x41, 0x0f, 0x20, 0x43, 0xca, 0x70, 0xdf,
-	0x08, 0xcd, 0x43, 0x8a, 0x3f, 0x8d, 0xf8, 0x4b, 0x31, 0xc2, 0x1d, 0x0a, 0xbf, 0x45, 0xc1, 0x2d,
-	0x4b, 0xbd, 0x0f, 0xb5, 0x34, 0x29, 0x8f, 0xb6, 0x97, 0x62, 0xa7, 0xa1, 0xde, 0xc2, 0x13, 0x1c,
-	0xf5, 0x94, 0xf7, 0xd9, 0x23, 0xf2, 0xd1, 0x67, 0x53, 0x8c, 0xd5, 0xf3, 0x00, 0x34, 0x59, 0x76,
-	0x1e, 0x0e, 0xc8, 0x80, 0x60, 0x70, 0x2d, 0xe9, 0x25, 0xba, 0xf2, 0x31, 0x5d, 0xa0, 0x0a, 0x8a,
-	0x34, 0x13, 0x8e, 0xfa, 0x04, 0xf5, 0xaa, 0x01, 0x53, 0x90, 0x80, 0xec, 0x8d, 0xfa, 0x84, 0x6a,
-	0x55, 0xfd, 0x02, 0x56, 0x23, 0xec, 0xa8, 0xa6, 0xc2, 0xb8, 0xe7, 0x0d, 0x42, 0xad, 0x8c, 0x82,
-	0xae, 0x8c, 0x99, 0xef, 0x6d, 0x5e, 0x37, 0xdd, 0x2a, 0xfc, 0x81, 0x46, 0x30, 0xed, 0x51, 0xda,
-	0x3c, 0xf6, 0x18, 0x03, 0x9a, 0x6f, 0x22, 0xf6, 0xf4, 0x06, 0x04, 0xe3, 0x4a, 0x3e, 0xc6, 0xd1,
-	0x49, 0xf4, 0x41, 0xc4, 0x72, 0x1f, 0xce, 0x5b, 0xa4, 0x6b, 0x0c, 0x1c, 0xc9, 0x02, 0x50, 0x1f,
-	0x82, 0xf7, 0x4c, 0x3e, 0xde, 0xab, 0x9c, 0x8b, 0xb0, 0x96, 0x3d, 0x23, 0x38, 0x12, 0x7b, 0xbc,
-	0x00, 0x33, 0x41, 0x68, 0xf8, 0x61, 0x94, 0xc2, 0x58, 0x94, 0xa9, 0xe0, 0xa2, 0x48, 0x59, 0xaf,
-	0x80, 0xea, 0x18, 0x41, 0xc8, 0xcd, 0x01, 0x45, 0xb0, 0x2d, 0x6d, 0x0e, 0x31, 0x67, 0x29, 0x04,
-	0xaf, 0x8b, 0xb2, 0x6d, 0x59, 0xea, 0xab, 0x30, 0x8f, 0xc8, 0x5d, 0xdb, 0x8f, 0x48, 0x6c, 0x4b,
-	0x53, 0x59, 0x61, 0x40, 0x41, 0x77, 0x29, 0x04, 0x49, 0x5a, 0x96, 0xfa, 0x2e, 0x9c, 0x43, 0xf4,
-	0xe4, 0x09, 0x99, 0x4c, 0xb6, 0xa5, 0xcd, 0x23, 0xd9, 0x32, 0x45, 0x91, 0xc5, 0xdf, 0xa5, 0xf0,
-	0x96, 0xa5, 0xfe, 0x1f, 0x00, 0x43, 0xc5, 0xdc, 0xbe, 0x90, 0x33, 0xb7, 0x97, 0x90, 0x06, 0x73,
-	0x7a, 0x1b, 0x50, 0xa4, 0x8e, 0x5c, 0x6e, 0x2c, 0xe6, 0x64, 0x53, 0xa5, 0x94, 0x9f, 0xc4, 0x25,
-	0xc7, 0x36, 0x2c, 0x26, 0x4f, 0x21, 0x74, 0xba, 0xc4, 0xaa, 0xa8, 0x47, 0xd2, 0x01, 0x84, 0x6a,
-	0xdf, 0x82, 0x95, 0xd4, 0xc9, 0xcd, 0x43, 0x62, 0x0d, 0x1c, 0x0c, 0x0d, 0xcb, 0xcc, 0xdf, 0x64,
-	0xba, 0x5d, 0x0e, 0x6e, 0x59, 0xea, 0x1b, 0xa0, 0x65, 0x28, 0x8d, 0x79, 0xb6, 0x86, 0x94, 0x8b,
-	0x8f, 0xd2, 0x2a, 0x43, 0x1f, 0xdf, 0x4d, 0xcb, 0x29, 0xec, 0x69, 0x25, 0x9f, 0x3d, 0x25, 0x0e,
-	0x22, 0x0c, 0x69, 0xec, 0xf0, 0x46, 0x48, 0x9d, 0x3e, 0xd4, 0x56, 0xb1, 0xc6, 0x4b, 0xd0, 0xdc,
-	0x64, 0xa0, 0x84, 0x4b, 0x26, 0x4e, 0x80, 0xd7, 0x70, 0x2e, 0xe7, 0x35, 0x2c, 0x67, 0x9c, 0x12,
-	0xef, 0xc3, 0x80, 0xb5, 0x6c, 0xdd, 0xf2, 0x0d, 0xd6, 0x72, 0x6e, 0xb0, 0x92, 0x75, 0x01, 0x6c,
-	0x8b, 0xcb, 0x50, 0x33, 0x0d, 0xd7, 0x24, 0x4e, 0xc7, 0x27, 0x0f, 0x07, 0x24, 0x08, 0x89, 0xa5,
-	0x9d, 0xdf, 0x50, 0x36, 0x8b, 0xfa, 0x2c, 0x5b, 0xd7, 0xc5, 0xb2, 0xea, 0xc3, 0xa5, 0xa4, 0x34,
-	0x9e, 0x6f, 0x1f, 0xd8, 0xae, 0xe1, 0xa4, 0xc5, 0xaa, 0xe7, 0x14, 0xeb, 0x82, 0x2c, 0xd6, 0x47,
-	0x9c, 0x59, 0x52, 0xbc, 0x31, 0x13, 0xe1, 0x52, 0x52, 0x13, 0x59, 0xc7, 0x38, 0x99, 0x30, 0x11,
-	0x2e, 0x6c, 0xcb, 0x52, 0x5f, 0x86, 0xb9, 0xe4, 0xb9, 0x28, 0xc5, 0x06, 0x52, 0x24, 0x0f, 0xc6,
-	0x70, 0x83, 0xd0, 0x36, 0x8f, 0x46, 0x1d, 0x29, 0x58, 0x5f, 0x60, 0xb8, 0x0c, 0xb0, 0x17, 0x85,
-	0xec, 0x03, 0xd8, 0xe0, 0xb8, 0x91, 0x9d, 0x87, 0x5e, 0x27, 0x76, 0x61, 0x6a, 0x85, 0x8d, 0x7c,
-	0x56, 0xb8, 0xc6, 0x18, 0x89, 0x03, 0xef, 0x79, 0xbb, 0xc2, 0xa9, 0xa9, 0x39, 0x6a, 0x30, 0x2d,
-	0x0c, 0xf0, 0x05, 0xd6, 0x1c, 0xf1, 0x57, 0xf5, 0x13, 0x58, 0xf2, 0x49, 0xe8, 0x8f, 0x3a, 0x2c,
-	0xed, 0x39, 0x1d, 0xdb, 0x0d, 0x89, 0x3f, 0x34, 0x1c, 0xed, 0x62, 0xbe, 0x8d, 0x17, 0x90, 0xbc,
-	0xc5, 0xa8, 0x5b, 0x9c, 0x38, 0x66, 0xdb, 0x33, 0x1e, 0xdb, 0xbd, 0x41, 0x2f, 0x66, 0x7b, 0xe9,
-	0x34, 0x6c, 0x3f, 0x64, 0xd4, 0x11, 0xdb, 0x1b, 0x69, 0xb6, 0xfc, 0x18, 0x81, 0xf6, 0x22, 0x1e,
-	0x2b, 0x41, 0xc5, 0xfd, 0x2a, 0x50, 0xdf, 0xa6, 0x85, 0x2c, 0xa5, 0xda, 0x37, 0xcc, 0x23, 0xaf,
-	0xdb, 0xed, 0x98, 0x1e, 0xe9, 0x76, 0x6d, 0xd3, 0xa6, 0x39, 0xf9, 0xa5, 0x0d, 0x65, 0x53, 0xd1,
-	0x97, 0x11, 0xe1, 0x16, 0x83, 0xef, 0xc4, 0x60, 0xb5, 0x07, 0x8d, 0x8c, 0x3c, 0x49, 0x1e, 0xf7,
-	0x6d, 0x26, 0x2e, 0x33, 0xd2, 0xcd, 0x9c, 0x46, 0xba, 0x3e, 0x96, 0x30, 0xef, 0x44, 0x9c, 0x78,
-	0x53, 0xb5, 0xce, 0x44, 0x75, 0x3d, 0xb7, 0x83, 0x4f, 0xc6, 0xbe, 0x43, 0x3a, 0xc4, 0xf7, 0x3d,
-	0x1f, 0xb3, 0x7a, 0xa0, 0x5d, 0xde, 0x98, 0xd8, 0x2c, 0xe9, 0xe7, 0x10, 0x78, 0xcf, 0x73, 0x75,
-	0x81, 0x74, 0x87, 0xe2, 0xd0, 0xfc, 0x1e, 0xa8, 0x9b, 0x50, 0x3b, 0x34, 0x02, 0x46, 0xdf, 0xe9,
-	0x7b, 0x8e, 0x6d, 0x8e, 0xb4, 0x97, 0xd1, 0x0f, 0xab, 0x87, 0x46, 0x80, 0x14, 0xf7, 0x71, 0x95,
-	0x26, 0x3c, 0xd3, 0xf7, 0xdc, 0xc8, 0xfe, 0xb4, 0x57, 0xd0, 0x52, 0x2b, 0x74, 0x51, 0xd8, 0x12,
-	0x2d, 0x94, 0x02, 0xfb, 0x80, 0xfa, 0xa6, 0xe9, 0x0d, 0xdc, 0x50, 0x6b, 0xb2, 0x42, 0x89, 0xad,
-	0xed, 0xd0, 0x25, 0xf5, 0x12, 0x54, 0x78, 0x1d, 0xd3, 0x09, 0xec, 0x2f, 0x89, 0xb6, 0x45, 0x51,
-	0x6e, 0x9d, 0xd5, 0x14, 0xbd, 0xcc, 0xd7, 0x77, 0xed, 0x2f, 0x69, 0x1b, 0x3a, 0x67, 0x0c, 0x42,
-	0xaf, 0xe3, 0x93, 0x80, 0x84, 0x9d, 0xbe, 0x67, 0xbb, 0x61, 0xa0, 0x5d, 0xcf, 0xaa, 0x8a, 0xa2,
-	0x19, 0xc2, 0xf0, 0x5a, 0x53, 0xa7, 0xd8, 0xf7, 0x11, 0x59, 0x9f, 0xa5, 0xf4, 0xd2, 0x82, 0xfa,
-	0x5b, 0x98, 0x0b, 0x88, 0xe1, 0x9b, 0x87, 0xd4, 0x16, 0x7c, 0x7b, 0x7f, 0x10, 0x92, 0x40, 0xbb,
-	0x81, 0xdd, 0xc9, 0x47, 0x79, 0xba, 0x93, 0xcc, 0x0a, 0xb7, 0xb9, 0x8b, 0x2c, 0x6f, 0x46, 0x1c,
-	0x59, 0x8f, 0x52, 0x0b, 0x52, 0xcb, 0xea, 0x03, 0x28, 0xf4, 0x48, 0xcf, 0xd3, 0x5e, 0xc3, 0x0d,
-	0x77, 0x9e, 0x7d, 0xc3, 0x0f, 0x49, 0xcf, 0x63, 0x9b, 0x20, 0x43, 0xf5, 0x0b, 0x98, 0xe3, 0xf9,
-	0xb2, 0xc3, 0x14, 0x68, 0x93, 0x40, 0x7b, 0x1d, 0x35, 0x75, 0x35, 0x73, 0x17, 0xa9, 0x8c, 0xe4,
-	0xd9, 0xf4, 0x7d, 0x41, 0xa7, 0xd7, 0x86, 0xa9, 0x15, 0xf5, 0x3a, 0x2c, 0xf1, 0x8a, 0x24, 0xb2,
-	0x69, 0x5e, 0x28, 0xbf, 0x81, 0x06, 0x30, 0x8f, 0xd0, 0x48, 0x44, 0x56, 0x30, 0xff, 0x3f, 0xcc,
-	0xc6, 0xe8, 0x41, 0x68, 0x84, 0x81, 0xf6, 0x26, 0x4a, 0xb4, 0x9d, 0xe7, 0xdc, 0x11, 0xb3, 0x5d,
-	0x4a, 0xa9, 0x57, 0x49, 0xe2, 0x3d, 0x91, 0x9e, 0xa8, 0x28, 0x69, 0x17, 0x7b, 0xeb, 0xb4, 0xe9,
-	0x49, 0x1f, 0xa4, 0x9d, 0xeb, 0x06, 0x2c, 0x8f, 0xd5, 0x62, 0xe1, 0x63, 0x3c, 0xf5, 0xdb, 0xac,
-	0x26, 0x49, 0xd6, 0x63, 0x7b, 0x8f, 0xe9, 0xa9, 0x6f, 0xc0, 0x12, 0x3d, 0x2b, 0x61, 0xe3, 0x09,
-	0x1b, 0x25, 0x62, 0x7e, 0xf0, 0x0e, 0x12, 0x2d, 0x20, 0x74, 0x2f, 0x02, 0x32, 0x87, 0x78, 0x0f,
-	0xaa, 0xc9, 0xb2, 0x5a, 0x7b, 0x37, 0xe7, 0x01, 0x66, 0x88, 0x5c, 0x4c, 0xaf, 0x5a, 0xb0, 0x98,
-	0x69, 0x8c, 0x19, 0xad, 0xdc, 0x6b, 0xc9, 0xee, 0x73, 0x3d, 0xe9, 0x51, 0x7c, 0x80, 0x37, 0xbc,
-	0xd6, 0xbc, 0x6f, 0x8c, 0x1c, 0xcf, 0xb0, 0xe4, 0xb6, 0xf1, 0x33, 0x28, 0x45, 0x16, 0xf8, 0x8b,
-	0x72, 0x6e, 0x17, 0x8a, 0xb3, 0xb5, 0x5a, 0xbb, 0x50, 0xac, 0xd5, 0xe6, 0xda, 0x85, 0xe2, 0x95,
-	0xda, 0xab, 0xed, 0x42, 0xf1, 0xd5, 0x5a, 0xb3, 0x5d, 0x28, 0x5e, 0xad, 0x5d, 0x6b, 0x17, 0x8a,
-	0xd7, 0x6a, 0xdb, 0xed, 0x42, 0x71, 0xbb, 0x76, 0xbd, 0x71, 0x1d, 0xaa, 0x49, 0x1b, 0xa1, 0x81,
-	0x27, 0x11, 0x55, 0x14, 0x16, 0x78, 0xa4, 0x88, 0xd2, 0xf8, 0x8f, 0x02, 0x4b, 0x63, 0x1e, 0x45,
-	0xa9, 0x09, 0x66, 0x6d, 0x9f, 0xd0, 0x9b, 0x93, 0xb2, 0xb6, 0xc2, 0xb3, 0x36, 0x02, 0xe2, 0xac,
-	0xbd, 0x08, 0x53, 0xdc, 0xfe, 0x59, 0xa7, 0x3a, 0xe9, 0xa3, 0xc5, 0xb7, 0x61, 0x12, 0x6f, 0x17,
-	0xdb, 0xd2, 0xea, 0xf6, 0x8d, 0x4c, 0x3b, 0xc7, 0x51, 0x66, 0xa6, 0x67, 0xa3, 0x1c, 0x3a, 0x63,
-	0xa1, 0xde, 0x85, 0x29, 0xfa, 0x30, 0x08, 0xb0, 0x69, 0xad, 0x6e, 0x37, 0x93, 0x4a, 0x3c, 0x99,
-	0xcb, 0x20, 0xd0, 0x39, 0x75, 0xe3, 0xdb, 0x02, 0xd4, 0xc4, 0x60, 0x03, 0x9b, 0x8c, 0x5f, 0xaa,
-	0x23, 0x8f, 0x75, 0x30, 0x21, 0xeb, 0x60, 0x07, 0x4a, 0xac, 0x2c, 0x1e, 0xf5, 0x09, 0x17, 0xfd,
-	0xc5, 0x93, 0xf5, 0x80, 0x85, 0xf0, 0xa8, 0x4f, 0xf4, 0x62, 0xc8, 0x9f, 0x68, 0xb7, 0x1f, 0x1a,
-	0xfe, 0x01, 0x49, 0x75, 0xfb, 0xac, 0x2b, 0x9f, 0x63, 0xa0, 0x54, 0xb7, 0xcf, 0xf1, 0x65, 0x99,
-	0xa7, 0x58, 0x33, 0xcb, 0x20, 0xc9, 0x6e, 0x9f, 0x63, 0xf3, 0x03, 0x4c, 0xb3, 0xe3, 0xb3, 0x45,
-	0x16, 0xbc, 0x92, 0xdd, 0x73, 0x31, 0xdd, 0x3d, 0xbf, 0x03, 0xab, 0x9c, 0x85, 0x79, 0x68, 0x3b,
-	0x56, 0xbc, 0xad, 0xe7, 0x3a, 0x23, 0x6c, 0xb6, 0x8b, 0xfa, 0x32, 0xc3, 0xd8, 0xa1, 0x08, 0x62,
-	0xf7, 0x8f, 0x5c, 0x67, 0x44, 0x55, 0x2b, 0x37, 0x2a, 0x80, 0x66, 0x0a, 0x41, 0xdc, 0x9c, 0x68,
-	0x30, 0x2d, 0xba, 0x9f, 0x32, 0x9b, 0x40, 0xf3, 0x57, 0x75, 0x19, 0xa6, 0x45, 0x07, 0x59, 0x41,
-	0xc8, 0x54, 0xc8, 0x1a, 0xc7, 0x16, 0xcc, 0x4a, 0x73, 0x2f, 0x8c, 0x20, 0x33, 0x79, 0x3b, 0xb1,
-	0x98, 0x90, 0x82, 0xda, 0x85, 0x62, 0xb5, 0x36, 0xdb, 0xf8, 0xeb, 0x04, 0xcc, 0x4b, 0xa3, 0xa1,
-	0x5f, 0x8d, 0xe9, 0x48, 0xba, 0x9b, 0x4c, 0xea, 0xee, 0x22, 0x54, 0x53, 0x6d, 0x35, 0x1b, 0xe1,
-	0x54, 0xba, 0x72, 0x4b, 0xdd, 0x80, 0x19, 0x97, 0x3c, 0x96, 0x90, 0xd8, 0xdc, 0xa6, 0x4c, 0x17,
-	0x05, 0x0e, 0xad, 0x70, 0xa2, 0xb6, 0xc3, 0xb6, 0xd0, 0x3c, 0x68, 0x85, 0x23, 0xd6, 0x18, 0xca,
-	0xbe, 0x6f, 0xb8, 0xe6, 0x61, 0x27, 0xf4, 0x8e, 0x08, 0xbb, 0xc7, 0x8a, 0x5e, 0x66, 0x6b, 0x7b,
-	0x74, 0x49, 0xdd, 0x82, 0x05, 0x97, 0xb0, 0xec, 0x95, 0x40, 0x9d, 0x41, 0xd4, 0x39, 0x97, 0xd0,
-	0x9c, 0x74, 0x4b, 0x22, 0x90, 0x2e, 0x7f, 0x56, 0xbe, 0xfc, 0x76, 0xa1, 0x58, 0xaa, 0x41, 0xbb,
-	0x50, 0x84, 0x5a, 0xb9, 0x5d, 0x28, 0x56, 0x6a, 0x33, 0xfc, 0x0e, 0xff, 0x72, 0x16, 0xd4, 0x4f,
-	0xe3, 0xcb, 0xfd, 0xf5, 0x5f, 0xa1, 0xa4, 0x81, 0xa9, 0xa7, 0x99, 0xff, 0xf4, 0xb3, 0x99, 0x7f,
-	0xe3, 0x4f, 0x05, 0x98, 0xc1, 0xe9, 0xed, 0xaf, 0x46, 0x5f, 0x77, 0xa0, 0xc2, 0xdb, 0x3f, 0xc6,
-	0x67, 0x12, 0xf9, 0x34, 0x8e, 0x49, 0x18, 0xbc, 0xc9, 0x43, 0x1e, 0xe5, 0x30, 0x7e, 0x51, 0x89,
-	0x34, 0x84, 0x10, 0xad, 0x0f, 0xf2, 0x9b, 0x42, 0x7e, 0xd7, 0xf2, 0x65, 0x33, 0xde, 0x14, 0x21,
-	0xfb, 0x68, 0x6e, 0x21, 0x2d, 0xca, 0xb7, 0x3b, 0x9d, 0xbc, 0xdd, 0xcb, 0x50, 0x8b, 0xe2, 0xa2,
-	0xe8, 0x3f, 0x8b, 0xd8, 0xa8, 0xcd, 0x8a, 0x75, 0x31, 0xfc, 0x58, 0x81, 0x62, 0xe4, 0xa0, 0xec,
-	0xbb, 0xd1, 0x34, 0xe1, 0xce, 0x29, 0xd9, 0x08, 0x3c, 0xcd, 0x46, 0xca, 0xcf, 0x68, 0x23, 0x7f,
-	0x9c, 0x85, 0xca, 0x4d, 0x33, 0xb4, 0x87, 0x76, 0x38, 0x42, 0x13, 0x91, 0x0e, 0xa5, 0x24, 0x0f,
-	0xf5, 0x06, 0x68, 0x71, 0xac, 0x48, 0x8d, 0x84, 0xd9, 0x0c, 0x7d, 0x31, 0x82, 0x27, 0x26, 0xc2,
-	0xf7, 0x60, 0x36, 0x45, 0x88, 0xa6, 0x93, 0x7b, 0x20, 0x5c, 0x4d, 0xb2, 0xa5, 0x25, 0x66, 0x6a,
-	0x56, 0x52, 0xc8, 0x5b, 0x62, 0x06, 0x89, 0xb9, 0xc8, 0x79, 0x3e, 0x36, 0x64, 0xb1, 0x8f, 0x79,
-	0x68, 0x29, 0x88, 0x06, 0x64, 0x6d, 0x3e, 0x14, 0x8d, 0xa4, 0x9e, 0x3a, 0x8d, 0xd4, 0x15, 0x4e,
-	0xcb, 0x64, 0xde, 0x81, 0x4a, 0x62, 0xaa, 0x95, 0xd7, 0xa7, 0xcb, 0x81, 0x34, 0xc9, 0x5a, 0x87,
-	0xb2, 0xc1, 0xef, 0x4a, 0x04, 0xeb, 0x92, 0x0e, 0x62, 0x89, 0xe5, 0x7a, 0xa9, 0xe4, 0xe3, 0x93,
-	0x72, 0x3f, 0x2a, 0xf6, 0x3e, 0x87, 0x95, 0xe3, 0xe7, 0x2d, 0x90, 0x6f, 0x3e, 0xb1, 0x14, 0x64,
-	0x4f, 0x5a, 0x52, 0xbc, 0x4d, 0xc7, 0x0b, 0xc8, 0x69, 0xc7, 0xea, 0x12, 0xef, 0x1d, 0x4a, 0x2f,
-	0x78, 0xef, 0x61, 0x27, 0x42, 0x65, 0x4d, 0x33, 0xce, 0x39, 0x56, 0x9f, 0x67, 0x93, 0xde, 0x24,
-	0xd7, 0x0f, 0x60, 0xee, 0x90, 0x18, 0x7e, 0xb8, 0x4f, 0x8c, 0xf0, 0xb4, 0xb3, 0xf4, 0x5a, 0x44,
-	0x29, 0xb8, 0x65, 0x8d, 0x00, 0xab, 0xd9, 0x23, 0xc0, 0xcc, 0xa9, 0x1a, 0xcb, 0x83, 0x59, 0x53,
-	0x35, 0xf6, 0x4d, 0x56, 0x0c, 0x46, 0x69, 0x1d, 0x5d, 0x63, 0xa1, 0x24, 0x14, 0xb1, 0x9d, 0x15,
-	0xca, 0xf2, 0xb0, 0x6b, 0x2e, 0x39, 0xec, 0x4a, 0xd6, 0x80, 0x6a, 0xba, 0x06, 0xa4, 0xe1, 0x2a,
-	0xf2, 0x03, 0xe2, 0x86, 0x76, 0x38, 0xc2, 0x89, 0x3b, 0x4e, 0xee, 0xb8, 0x37, 0xb0, 0xe5, 0xcc,
-	0x09, 0xcb, 0x42, 0xe6, 0x84, 0xe5, 0xf8, 0x01, 0xdb, 0xe2, 0xf3, 0x19, 0xb0, 0x2d, 0x3d, 0x9f,
-	0x01, 0xdb, 0xf2, 0x09, 0x03, 0xb6, 0x3d, 0x58, 0x64, 0x54, 0xe9, 0xa6, 0x5d, 0xcb, 0xe9, 0xde,
-	0xf3, 0x48, 0x9e, 0x6a, 0xd7, 0x4f, 0x1c, 0xdb, 0xad, 0x9c, 0x3c, 0xb6, 0xcb, 0x31, 0x47, 0x5b,
-	0x7d, 0xfa, 0x1c, 0xed, 0x1e, 0xa8, 0x8c, 0x0b, 0x1b, 0x1b, 0xb0, 0xff, 0x70, 0xf8, 0x24, 0x7e,
-	0x23, 0x19, 0xfe, 0x38, 0x90, 0x86, 0xbf, 0xbb, 0xec, 0x51, 0xaf, 0x21, 0xed, 0x07, 0x46, 0x10,
-	0xf2, 0x15, 0xda, 0x64, 0x48, 0xfc, 0x68, 0x2e, 0x25, 0x7e, 0x6c, 0x6a, 0x6b, 0x68, 0x6a, 0xcb,
-	0x11, 0xd5, 0x03, 0x84, 0x47, 0x26, 0x97, 0x2e, 0x5a, 0xce, 0x67, 0x16, 0x2d, 0x72, 0x1f, 0x52,
-	0x1f, 0xeb, 0x43, 0x3e, 0x85, 0x25, 0xdc, 0x3a, 0x76, 0x78, 0x8b, 0x84, 0x86, 0xed, 0x04, 0x38,
-	0xff, 0x1e, 0x3b, 0xd4, 0x58, 0x63, 0x1f, 0xe8, 0x0b, 0x94, 0xfe, 0x7d, 0x41, 0x7e, 0x9b, 0x51,
-	0xab, 0x5f, 0xc0, 0x6a, 0x8a, 0xaf, 0xfc, 0x05, 0x69, 0x23, 0xef, 0xa7, 0x8b, 0x04, 0xef, 0xf8,
-	0x53, 0x52, 0xe3, 0x6f, 0x0a, 0x94, 0xb0, 0x82, 0x7b, 0x4a, 0x6a, 0x4e, 0x26, 0xb2, 0xb3, 0xe9,
-	0x44, 0x76, 0x13, 0xca, 0x68, 0xa0, 0xbc, 0x56, 0x98, 0xc8, 0xfb, 0x1f, 0x0d, 0x23, 0x12, 0xa9,
-	0x47, 0x8e, 0x40, 0xec, 0x87, 0x20, 0x0c, 0x2a, 0x3c, 0xf8, 0xac, 0x40, 0x91, 0x05, 0xaa, 0xa8,
-	0xbb, 0x9d, 0xc6, 0xf7, 0x96, 0xd5, 0xf8, 0xa9, 0x00, 0x2a, 0xf6, 0x8e, 0xc9, 0x8f, 0xe9, 0x27,
-	0x56, 0x1a, 0xf1, 0x07, 0xea, 0xec, 0x4a, 0x23, 0x82, 0x27, 0x2a, 0x8d, 0xa4, 0x1e, 0x26, 0xd2,
-	0x7a, 0xb8, 0x07, 0xb3, 0x29, 0xbe, 0xbc, 0x72, 0xc8, 0x5b, 0x88, 0x24, 0x77, 0xa5, 0xcd, 0xbd,
-	0xd8, 0x4e, 0xae, 0x99, 0x79, 0x73, 0xcf, 0x41, 0x52, 0xbb, 0x7e, 0x11, 0xaa, 0x02, 0x9f, 0x97,
-	0xd0, 0xac, 0xb1, 0x17, 0xa5, 0x81, 0xce, 0x67, 0x2f, 0xa9, 0xb2, 0x63, 0xfa, 0xd9, 0xcb, 0x8e,
-	0xcc, 0x51, 0x50, 0x31, 0x7b, 0x14, 0xb4, 0x06, 0xa5, 0xc8, 0xa7, 0x44, 0xed, 0x10, 0x2d, 0x9c,
-	0xf2, 0x2b, 0xfb, 0x67, 0xd1, 0x4f, 0x0e, 0x2c, 0x5f, 0xf3, 0x4c, 0x51, 0xc6, 0xfa, 0x7b, 0xf3,
-	0x98, 0x7a, 0xfe, 0x3e, 0x52, 0x60, 0x8e, 0x66, 0x39, 0x44, 0xfc, 0x0e, 0x21, 0x2d, 0x8d, 0xfd,
-	0xbc, 0x50, 0x19, 0xfb, 0x79, 0xa1, 0xf1, 0xad, 0x02, 0x73, 0xfc, 0x58, 0x3b, 0x98, 0x4e, 0x9f,
-	0x97, 0xb9, 0x65, 0x26, 0xf2, 0x89, 0xec, 0xcf, 0x63, 0x69, 0xb9, 0x0b, 0xe3, 0x72, 0x7f, 0x7d,
-	0x16, 0x60, 0x17, 0xbf, 0x2d, 0x3c, 0x47, 0xff, 0x18, 0x93, 0x54, 0xaa, 0x0f, 0x55, 0x28, 0xe0,
-	0xad, 0xb2, 0x9f, 0x4b, 0xf0, 0x59, 0x7d, 0x1d, 0x26, 0x6d, 0xb7, 0x3f, 0x08, 0xd1, 0xaa, 0xf3,
-	0x04, 0x4a, 0x86, 0x4e, 0xa5, 0x37, 0x3d, 0x37, 0xf4, 0x3d, 0x87, 0x1b, 0xb9, 0x78, 0x1d, 0xd3,
-	0xc4, 0xf4, 0xb8, 0x26, 0xbe, 0x52, 0xa0, 0xb8, 0x73, 0x48, 0xcc, 0xa3, 0x60, 0xd0, 0x4b, 0xeb,
-	0x61, 0x32, 0xd6, 0xc3, 0x6d, 0x98, 0xea, 0x3a, 0xc6, 0xd0, 0xf3, 0xf1, 0xd4, 0xd5, 0xed, 0x2b,
-	0x27, 0x37, 0x76, 0x82, 0xe3, 0x5d, 0xa4, 0xd1, 0x39, 0x6d, 0xfc, 0x23, 0xd0, 0x04, 0x8e, 0x2b,
-	0xd8, 0xcb, 0xad, 0xdf, 0x7c, 0xf7, 0x43, 0xfd, 0xcc, 0xf7, 0x3f, 0xd4, 0xcf, 0xfc, 0xfc, 0x43,
-	0x5d, 0xf9, 0xea, 0x49, 0x5d, 0xf9, 0xf3, 0x93, 0xba, 0xf2, 0xf7, 0x27, 0x75, 0xe5, 0xbb, 0x27,
-	0x75, 0xe5, 0x5f, 0x4f, 0xea, 0xca, 0xbf, 0x9f, 0xd4, 0xcf, 0xfc, 0xfc, 0xa4, 0xae, 0x7c, 0xf3,
-	0x63, 0xfd, 0xcc, 0x77, 0x3f, 0xd6, 0xcf, 0x7c, 0xff, 0x63, 0xfd, 0xcc, 0xe7, 0x37, 0x0e, 0xbc,
-	0x58, 0x06, 0xdb, 0x3b, 0xfe, 0x7f, 0xde, 0x77, 0xa4, 0xd7, 0xfd, 0x29, 0x0c, 0xc1, 0xd7, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x26, 0xbc, 0x14, 0x15, 0x08, 0x2c, 0x00, 0x00,
+	// 3306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x6f, 0xdb, 0x56,
+	0xb6, 0x66, 0x2c, 0xdb, 0xd2, 0x91, 0x2c, 0xcb, 0xf4, 0x17, 0xed, 0x38, 0xb2, 0xa3, 0x26, 0xad,
+	0xd3, 0xa6, 0x72, 0xec, 0xa4, 0xdf, 0x05, 0x1e, 0x62, 0x27, 0x69, 0x24, 0xa4, 0x69, 0x4a, 0xbb,
+	0x4d, 0xd1, 0xa2, 0x10, 0x68, 0xf2, 0xca, 0xe6, 0x33, 0x45, 0x2a, 0xfc, 0xb0, 0xa3, 0xe2, 0x2d,
+	0xba, 0x78, 0x78, 0xdd, 0x76, 0xf9, 0x80, 0x59, 0xcd, 0x6e, 0xd6, 0x03, 0xf4, 0x07, 0x0c, 0x66,
+	0x33, 0xcb, 0x2e, 0xbb, 0x9a, 0x69, 0xdd, 0xcd, 0x6c, 0x8a, 0xe9, 0x4f, 0x18, 0xdc, 0x73, 0x2f,
+	0xc9, 0x4b, 0x8a, 0x76, 0xe8, 0x4c, 0xb3, 0xe8, 0x8e, 0x3c, 0x5f, 0xf7, 0xdc, 0x73, 0xcf, 0x3d,
+	0x5f, 0x24, 0xdc, 0xf4, 0x49, 0xaf, 0xef, 0xb8, 0x9a, 0xb5, 0xee, 0x11, 0xf7, 0x88, 0xb8, 0xeb,
+	0x5a, 0xdf, 0x5c, 0xef, 0x13, 0xd7, 0x33, 0x3d, 0x9f, 0xd8, 0x3a, 0x59, 0x3f, 0xda, 0x58, 0x27,
+	0x4f, 0x89, 0x1e, 0xf8, 0xa6, 0x63, 0x7b, 0xcd, 0xbe, 0xeb, 0xf8, 0x8e, 0xdc, 0x08, 0x99, 0x9a,
+	0x8c, 0xa9, 0xa9, 0xf5, 0xcd, 0xa6, 0xc0, 0xd4, 0x3c, 0xda, 0x58, 0xaa, 0xef, 0x3b, 0xce, 0xbe,
+	0x45, 0xd6, 0x91, 0x63, 0x2f, 0xe8, 0xae, 0x1b, 0x81, 0xab, 0x51, 0x21, 0x4c, 0xc6, 0xd2, 0x4a,
+	0x1a, 0xef, 0x9b, 0x3d, 0xe2, 0xf9, 0x5a, 0xaf, 0xcf, 0x09, 0x2e, 0x1b, 0xa4, 0x4f, 0x6c, 0x83,
+	0xd8, 0xba, 0x49, 0xbc, 0xf5, 0x7d, 0x67, 0xdf, 0x41, 0x38, 0x3e, 0x71, 0x92, 0x2b, 0x91, 0xf2,
+	0x54, 0x6b, 0xdd, 0xe9, 0xf5, 0x1c, 0x9b, 0x2a, 0xdc, 0x23, 0x9e, 0xa7, 0xed, 0x93, 0x4c, 0x2a,
+	0x62, 0x07, 0x3d, 0x8f, 0x12, 0x1d, 0x3b, 0xee, 0x61, 0xd7, 0x72, 0x8e, 0x39, 0xd5, 0xd5, 0x04,
+	0x55, 0x57, 0x33, 0xad, 0xc0, 0x25, 0xc3, 0xc2, 0x92, 0x64, 0x07, 0xa6, 0xe7, 0x3b, 0xee, 0x60,
+	0x98, 0xec, 0xe5, 0x04, 0x59, 0xb8, 0xd4, 0x30, 0xdd, 0xb5, 0x2c, 0xf3, 0x47, 0x2a, 0xb2, 0x1d,
+	0x71, 0xd2, 0xd7, 0xce, 0x24, 0x4d, 0xed, 0xe6, 0x95, 0x33, 0x89, 0x7d, 0xcd, 0x3b, 0xe4, 0x84,
+	0xd7, 0xb3, 0x08, 0x4f, 0xdb, 0x56, 0xe3, 0xef, 0x00, 0xa5, 0x9d, 0x03, 0xcd, 0x35, 0x5a, 0x76,
+	0xd7, 0x91, 0x17, 0xa1, 0xe8, 0xd1, 0x97, 0x8e, 0x69, 0x28, 0xd2, 0xaa, 0xb4, 0x36, 0xa6, 0x4e,
+	0xe0, 0x7b, 0xcb, 0xa0, 0x28, 0x57, 0xb3, 0xf7, 0x09, 0x45, 0x5d, 0x58, 0x95, 0xd6, 0x46, 0xd5,
+	0x09, 0x7c, 0x6f, 0x19, 0xf2, 0x2c, 0x8c, 0x39, 0xc7, 0x36, 0x71, 0x95, 0xd1, 0x55, 0x69, 0xad,
+	0xa4, 0xb2, 0x17, 0x79, 0x13, 0xe6, 0x5c, 0xd2, 0xb7, 0x4c, 0x1d, 0x7d, 0xa4, 0xa3, 0xe9, 0x87,
+	0x1d, 0x8b, 0x1c, 0x11, 0x4b, 0x29, 0x20, 0xf7, 0x8c, 0x80, 0xbc, 0xad, 0x1f, 0x3e, 0xa0, 0x28,
+	0xf9, 0x3a, 0xc8, 0xbe, 0xab, 0xd9, 0x5e, 0x97, 0xb8, 0x02, 0xc3, 0x18, 0x32, 0xd4, 0x42, 0x8c,
+	0x48, 0xed, 0xf9, 0x8e, 0x45, 0xec, 0x8e, 0x67, 0xda, 0x3a, 0xe9, 0xb8, 0xc4, 0x26, 0xc7, 0xca,
+	0x38, 0xea, 0x5d, 0x63, 0x98, 0x1d, 0x8a, 0x50, 0x29, 0x5c, 0xbe, 0x0d, 0xe5, 0xa0, 0x6f, 0x68,
+	0x3e, 0xe9, 0x50, 0xbf, 0x54, 0x26, 0x56, 0xa5, 0xb5, 0xf2, 0xe6, 0x52, 0x93, 0x39, 0x6d, 0x33,
+	0x74, 0xda, 0xe6, 0x6e, 0xe8, 0xb4, 0x5b, 0x85, 0x6f, 0xff, 0xb1, 0x22, 0xa9, 0xc0, 0x98, 0x28,
+	0x58, 0xfe, 0x18, 0x66, 0x29, 0xaf, 0xa0, 0x1b, 0x93, 0x55, 0xcc, 0x29, 0x6b, 0x1a, 0xb9, 0x43,
+	0xfd, 0x51, 0xe4, 0x1d, 0xa8, 0xdb, 0x5a, 0x8f, 0x78, 0x7d, 0x4d, 0x27, 0x1d, 0xdb, 0xf1, 0xcd,
+	0x6e, 0x68, 0xb0, 0x23, 0x7a, 0xfb, 0x1c, 0x5b, 0x29, 0xe1, 0xee, 0x97, 0x23, 0xaa, 0x87, 0x02,
+	0xd1, 0xa7, 0x8c, 0x46, 0xfe, 0x46, 0x82, 0x25, 0xdd, 0x0a, 0x3c, 0x9f, 0xb8, 0x9d, 0x0c, 0x03,
+	0xc2, 0xea, 0xe8, 0x5a, 0x79, 0xb3, 0xdd, 0x7c, 0xf6, 0x25, 0x6f, 0x46, 0xbe, 0xd0, 0xdc, 0x66,
+	0xf2, 0x76, 0x53, 0x56, 0xbf, 0x6b, 0xfb, 0xee, 0x40, 0x5d, 0xd0, 0xb3, 0xb1, 0xf2, 0xff, 0x4a,
+	0xb0, 0x10, 0x69, 0x92, 0xb4, 0x95, 0x52, 0x46, 0x35, 0x3e, 0x78, 0x3e, 0x35, 0xcc, 0x5e, 0x4a,
+	0x07, 0x6e, 0xd3, 0x59, 0x3d, 0x83, 0x40, 0xfe, 0x3f, 0x09, 0x16, 0x43, 0x35, 0x44, 0x2f, 0x64,
+	0x8a, 0x54, 0xfe, 0x03, 0x7b, 0xa8, 0xb1, 0xb4, 0x0c, 0x7b, 0xa4, 0xb1, 0xd4, 0x1e, 0x8b, 0xa2,
+	0x02, 0x86, 0xf5, 0x44, 0xb0, 0xc8, 0x24, 0x2a, 0xd2, 0x3a, 0x9f, 0x22, 0xc2, 0x1a, 0x77, 0xac,
+	0x27, 0xc9, 0x73, 0x99, 0x77, 0x33, 0x91, 0xf2, 0x0d, 0x98, 0x3d, 0x32, 0x3d, 0x73, 0xcf, 0xb4,
+	0x4c, 0x7f, 0x20, 0x28, 0x50, 0x45, 0xe7, 0x92, 0x63, 0x5c, 0xc8, 0xb1, 0xd4, 0x86, 0xe5, 0xb3,
+	0x3c, 0x40, 0xae, 0xc1, 0xe8, 0x21, 0x19, 0x60, 0x94, 0x28, 0xa9, 0xf4, 0x91, 0x86, 0x81, 0x23,
+	0xcd, 0x0a, 0x08, 0x0f, 0x0f, 0xec, 0xe5, 0xdd, 0x0b, 0x6f, 0x4b, 0x4b, 0x3a, 0x2c, 0x9e, 0x7a,
+	0x8c, 0x19, 0x82, 0x6e, 0x88, 0x82, 0xce, 0xbc, 0x57, 0xe2, 0x22, 0xb1, 0xc2, 0x99, 0x47, 0x74,
+	0x2e, 0x85, 0x5b, 0x70, 0xf1, 0x0c, 0x2b, 0x9f, 0x47, 0x54, 0xe3, 0x97, 0x65, 0x98, 0x7b, 0xcc,
+	0x43, 0xf9, 0xdd, 0x30, 0xed, 0x62, 0xb0, 0xbd, 0x0c, 0x95, 0xf8, 0xea, 0xf3, 0x80, 0x5b, 0x52,
+	0xcb, 0x11, 0xac, 0x65, 0xc8, 0x2b, 0x50, 0x0e, 0xd3, 0x40, 0x18, 0x77, 0x4b, 0x2a, 0x84, 0xa0,
+	0x96, 0x21, 0x37, 0x61, 0xa6, 0xaf, 0xb9, 0xc4, 0xf6, 0x3b, 0x09, 0x51, 0x2c, 0x10, 0x4f, 0x33,
+	0xd4, 0x43, 0x41, 0xe0, 0x75, 0x90, 0x39, 0xbd, 0x28, 0xb7, 0x80, 0xe4, 0x35, 0x86, 0x79, 0x1c,
+	0x4b, 0x6f, 0xc0, 0x24, 0xa7, 0x76, 0x03, 0x9b, 0x12, 0x8e, 0x31, 0x15, 0x19, 0x50, 0x0d, 0xec,
+	0x96, 0x41, 0x77, 0x61, 0xda, 0xa6, 0x6f, 0x6a, 0x3e, 0xc1, 0xb4, 0x31, 0x8e, 0x06, 0x28, 0x47,
+	0xb0, 0x96, 0x21, 0xbf, 0x03, 0x8b, 0xba, 0xd3, 0xeb, 0x5b, 0x04, 0x6f, 0x00, 0x39, 0xa2, 0x02,
+	0xf7, 0x34, 0x5f, 0x3f, 0xa0, 0xf4, 0x13, 0x48, 0x3f, 0x1f, 0x13, 0xdc, 0xa5, 0xf8, 0x2d, 0x8a,
+	0x6e, 0x19, 0xf2, 0x23, 0xa8, 0xa5, 0x59, 0x79, 0xb4, 0xbd, 0x1a, 0x5f, 0x1a, 0x7a, 0x5b, 0x78,
+	0x82, 0xa3, 0x37, 0xe5, 0x3e, 0x7b, 0x44, 0x39, 0xea, 0x54, 0x4a, 0xb0, 0x7c, 0x09, 0x80, 0x26,
+	0xcb, 0xce, 0x93, 0x80, 0x04, 0x04, 0x83, 0x6b, 0x49, 0x2d, 0x51, 0xc8, 0xc7, 0x14, 0x40, 0x0d,
+	0x14, 0x59, 0xc6, 0x1f, 0xf4, 0x09, 0xda, 0x55, 0x01, 0x66, 0xa0, 0x10, 0xb3, 0x3b, 0xe8, 0x13,
+	0x6a, 0x55, 0xf9, 0x4b, 0x58, 0x8a, 0xa8, 0xa3, 0x9a, 0x0a, 0xe3, 0x9e, 0x13, 0xf8, 0x4a, 0x19,
+	0x15, 0x5d, 0x1c, 0x72, 0xdf, 0x3b, 0xbc, 0x6e, 0xda, 0x2a, 0xfc, 0x3f, 0x8d, 0x60, 0xca, 0x71,
+	0xda, 0x3d, 0x76, 0x99, 0x00, 0x9a, 0x6f, 0x22, 0xf1, 0x6e, 0x10, 0x0b, 0xae, 0xe4, 0x13, 0x1c,
+	0xed, 0x44, 0x0d, 0x22, 0x91, 0x7b, 0x70, 0xc9, 0x20, 0x5d, 0x2d, 0xb0, 0x04, 0x0f, 0x40, 0x7b,
+	0x84, 0xb2, 0x27, 0xf3, 0xc9, 0x5e, 0xe2, 0x52, 0x42, 0x6f, 0xd9, 0xd5, 0xbc, 0xc3, 0x70, 0x8d,
+	0x97, 0x60, 0xd2, 0xf3, 0x35, 0xd7, 0x8f, 0x52, 0x18, 0x8b, 0x32, 0x15, 0x04, 0x86, 0x29, 0xeb,
+	0x35, 0x90, 0x2d, 0xcd, 0xf3, 0xb9, 0x3b, 0xa0, 0x0a, 0xa6, 0xa1, 0x4c, 0x23, 0xe5, 0x14, 0xc5,
+	0xe0, 0x71, 0x51, 0xb1, 0x2d, 0x43, 0x7e, 0x1d, 0x66, 0x90, 0xb8, 0x6b, 0xba, 0x11, 0x8b, 0x69,
+	0x28, 0x32, 0x2b, 0x0c, 0x28, 0xea, 0x9e, 0xe9, 0x72, 0x96, 0x96, 0x21, 0xbf, 0x0f, 0x17, 0x91,
+	0x3c, 0xb9, 0x43, 0xa6, 0x93, 0x69, 0x28, 0x33, 0xc8, 0xb6, 0x40, 0x49, 0x44, 0xf5, 0x77, 0x28,
+	0xbe, 0x65, 0xc8, 0xff, 0x05, 0xc0, 0x48, 0x31, 0xb7, 0xcf, 0xe6, 0xcc, 0xed, 0x25, 0xe4, 0xa1,
+	0x50, 0xb9, 0x0d, 0xa8, 0x52, 0x47, 0x2c, 0x37, 0xe6, 0x72, 0x8a, 0xa9, 0x52, 0xce, 0x4f, 0xe2,
+	0x92, 0x63, 0x13, 0xe6, 0x92, 0xbb, 0x08, 0x6d, 0x3a, 0xcf, 0xaa, 0xa8, 0x63, 0x61, 0x03, 0xa1,
+	0x69, 0xdf, 0x81, 0xc5, 0xd4, 0xce, 0xf5, 0x03, 0x62, 0x04, 0x16, 0x86, 0x86, 0x05, 0x76, 0xdf,
+	0x44, 0xbe, 0x1d, 0x8e, 0x6e, 0x19, 0xf2, 0x5b, 0xa0, 0x64, 0x18, 0x8d, 0xdd, 0x6c, 0x05, 0x39,
+	0xe7, 0x8e, 0xd3, 0x26, 0xc3, 0x3b, 0xbe, 0x93, 0xd6, 0x33, 0xf4, 0xa7, 0xc5, 0x7c, 0xfe, 0x94,
+	0xd8, 0x48, 0xe8, 0x48, 0x43, 0x9b, 0xd7, 0x7c, 0x7a, 0xe9, 0x7d, 0x65, 0x09, 0x6b, 0xbc, 0x04,
+	0xcf, 0x6d, 0x86, 0x4a, 0x5c, 0xc9, 0xc4, 0x0e, 0xf0, 0x18, 0x2e, 0xe6, 0x3c, 0x86, 0x85, 0x8c,
+	0x5d, 0xe2, 0x79, 0x68, 0xb0, 0x9c, 0x6d, 0x5b, 0xbe, 0xc0, 0x72, 0xce, 0x05, 0x16, 0xb3, 0x0e,
+	0x80, 0x2d, 0x71, 0x0d, 0x6a, 0xba, 0x66, 0xeb, 0xc4, 0xea, 0xb8, 0xe4, 0x49, 0x40, 0x3c, 0x9f,
+	0x18, 0xca, 0xa5, 0x55, 0x69, 0xad, 0xa8, 0x4e, 0x31, 0xb8, 0x1a, 0x82, 0x65, 0x17, 0xae, 0x26,
+	0xb5, 0x71, 0x5c, 0x73, 0xdf, 0xb4, 0x35, 0x2b, 0xad, 0x56, 0x3d, 0xa7, 0x5a, 0x97, 0x45, 0xb5,
+	0x3e, 0xe2, 0xc2, 0x92, 0xea, 0x0d, 0xb9, 0x08, 0xd7, 0x92, 0xba, 0xc8, 0x0a, 0xc6, 0xc9, 0x84,
+	0x8b, 0x70, 0x65, 0x5b, 0x86, 0xfc, 0x2a, 0x4c, 0x27, 0xf7, 0x45, 0x39, 0x56, 0x91, 0x23, 0xb9,
+	0x31, 0x46, 0xeb, 0xf9, 0xa6, 0x7e, 0x38, 0xe8, 0x08, 0xc1, 0xfa, 0x32, 0xa3, 0x65, 0x88, 0xdd,
+	0x28, 0x64, 0xef, 0xc3, 0x2a, 0xa7, 0x8d, 0xfc, 0xdc, 0x77, 0x3a, 0xf1, 0x15, 0xa6, 0x5e, 0xd8,
+	0xc8, 0xe7, 0x85, 0xcb, 0x4c, 0x50, 0xb8, 0xe1, 0x5d, 0x67, 0x27, 0xbc, 0xd4, 0xd4, 0x1d, 0x15,
+	0x98, 0x08, 0x1d, 0xf0, 0x25, 0xd6, 0x1c, 0xf1, 0x57, 0xf9, 0x13, 0x98, 0x77, 0x89, 0xef, 0x0e,
+	0x3a, 0x2c, 0xed, 0x59, 0x1d, 0xd3, 0xf6, 0x89, 0x7b, 0xa4, 0x59, 0xca, 0x95, 0x7c, 0x0b, 0xcf,
+	0x22, 0x7b, 0x8b, 0x71, 0xb7, 0x38, 0x73, 0x2c, 0xb6, 0xa7, 0x3d, 0x35, 0x7b, 0x41, 0x2f, 0x16,
+	0x7b, 0xf5, 0x3c, 0x62, 0x3f, 0x64, 0xdc, 0x91, 0xd8, 0x5b, 0x69, 0xb1, 0x7c, 0x1b, 0x9e, 0xf2,
+	0x32, 0x6e, 0x2b, 0xc1, 0xc5, 0xef, 0x95, 0x27, 0xbf, 0x0b, 0x8b, 0x8c, 0x6b, 0x4f, 0xd3, 0x0f,
+	0x9d, 0x6e, 0xb7, 0xa3, 0x3b, 0xa4, 0xdb, 0x35, 0x75, 0x93, 0xe6, 0xe4, 0x57, 0x56, 0xa5, 0x35,
+	0x49, 0x5d, 0x40, 0x82, 0x2d, 0x86, 0xdf, 0x8e, 0xd1, 0x72, 0x0f, 0x1a, 0x19, 0x79, 0x92, 0x3c,
+	0xed, 0x9b, 0x4c, 0x5d, 0xe6, 0xa4, 0x6b, 0x39, 0x9d, 0x74, 0x65, 0x28, 0x61, 0xde, 0x8d, 0x24,
+	0xf1, 0xa6, 0x6a, 0x85, 0xa9, 0x6a, 0x3b, 0x76, 0x07, 0x9f, 0xb4, 0x3d, 0x8b, 0x74, 0x88, 0xeb,
+	0x3a, 0x2e, 0x66, 0x75, 0x4f, 0xb9, 0xb6, 0x3a, 0xba, 0x56, 0x52, 0x2f, 0x22, 0xf2, 0xa1, 0x63,
+	0xab, 0x21, 0xd1, 0x5d, 0x4a, 0x43, 0xf3, 0xbb, 0x27, 0xaf, 0x41, 0xed, 0x40, 0xf3, 0x18, 0x7f,
+	0xa7, 0xef, 0x58, 0xa6, 0x3e, 0x50, 0x5e, 0xc5, 0x7b, 0x58, 0x3d, 0xd0, 0x3c, 0xe4, 0x78, 0x84,
+	0x50, 0x9a, 0xf0, 0x74, 0xd7, 0xb1, 0x23, 0xff, 0x53, 0x5e, 0x43, 0x4f, 0xad, 0x50, 0x60, 0xe8,
+	0x4b, 0xb4, 0x50, 0xf2, 0xcc, 0x7d, 0x7a, 0x37, 0x75, 0x27, 0xb0, 0x7d, 0xa5, 0xc9, 0x0a, 0x25,
+	0x06, 0xdb, 0xa6, 0x20, 0xf9, 0x2a, 0x54, 0x78, 0x1d, 0xd3, 0xf1, 0xcc, 0xaf, 0x88, 0xb2, 0x4e,
+	0x49, 0xb6, 0x2e, 0x28, 0x92, 0x5a, 0xe6, 0xf0, 0x1d, 0xf3, 0x2b, 0xda, 0x86, 0x4e, 0x6b, 0x81,
+	0xef, 0x74, 0x5c, 0xe2, 0x11, 0xbf, 0xd3, 0x77, 0x4c, 0xdb, 0xf7, 0x94, 0x9b, 0x59, 0x55, 0x51,
+	0x34, 0x43, 0x38, 0xda, 0x68, 0xaa, 0x94, 0xfa, 0x11, 0x12, 0xab, 0x53, 0x94, 0x5f, 0x00, 0xc8,
+	0xff, 0x03, 0xd3, 0x1e, 0xd1, 0x5c, 0xfd, 0x80, 0xfa, 0x82, 0x6b, 0xee, 0x05, 0x3e, 0xf1, 0x94,
+	0x5b, 0xd8, 0x9d, 0x7c, 0x94, 0xa7, 0x3b, 0xc9, 0xac, 0x70, 0x9b, 0x3b, 0x28, 0xf2, 0x76, 0x24,
+	0x91, 0xf5, 0x28, 0x35, 0x2f, 0x05, 0x96, 0x1f, 0x43, 0xa1, 0x47, 0x7a, 0x8e, 0xf2, 0x06, 0x2e,
+	0xb8, 0xfd, 0xfc, 0x0b, 0x7e, 0x48, 0x7a, 0x0e, 0x5b, 0x04, 0x05, 0xca, 0x5f, 0xc2, 0x34, 0xcf,
+	0x97, 0x1d, 0x66, 0x40, 0x93, 0x78, 0xca, 0x9b, 0x68, 0xa9, 0x1b, 0x99, 0xab, 0x08, 0x65, 0x24,
+	0xcf, 0xa6, 0xf7, 0x43, 0x3e, 0xb5, 0x76, 0x94, 0x82, 0xc8, 0x37, 0x61, 0x9e, 0x57, 0x24, 0x91,
+	0x4f, 0xf3, 0x42, 0xf9, 0x2d, 0x74, 0x80, 0x19, 0xc4, 0x46, 0x2a, 0xb2, 0x82, 0xf9, 0x0b, 0x98,
+	0x8a, 0xc9, 0x3d, 0x5f, 0xf3, 0x3d, 0xe5, 0x6d, 0xd4, 0x68, 0x33, 0xcf, 0xbe, 0x23, 0x61, 0x3b,
+	0x94, 0x53, 0xad, 0x92, 0xc4, 0x7b, 0x22, 0x3d, 0xb9, 0xc1, 0xf0, 0x15, 0x7b, 0xe7, 0xbc, 0xe9,
+	0x49, 0x0d, 0xd2, 0x97, 0xeb, 0x16, 0x2c, 0x0c, 0xd5, 0x62, 0xfe, 0x53, 0xdc, 0xf5, 0xbb, 0xac,
+	0x26, 0x49, 0xd6, 0x63, 0xbb, 0x4f, 0xe9, 0xae, 0x6f, 0xc1, 0x3c, 0xdd, 0x2b, 0x61, 0xe3, 0x09,
+	0x13, 0x35, 0x62, 0xf7, 0xe0, 0x3d, 0x64, 0x9a, 0x45, 0xec, 0x6e, 0x84, 0x64, 0x17, 0xe2, 0x03,
+	0xa8, 0x26, 0xcb, 0x6a, 0xe5, 0xfd, 0x9c, 0x1b, 0x98, 0x24, 0x62, 0x31, 0xbd, 0x64, 0xc0, 0x5c,
+	0xa6, 0x33, 0x66, 0xb4, 0x72, 0x6f, 0x24, 0xbb, 0xcf, 0x95, 0xe4, 0x8d, 0xe2, 0x03, 0xbc, 0xa3,
+	0x8d, 0xe6, 0x23, 0x6d, 0x60, 0x39, 0x9a, 0x21, 0xb6, 0x8d, 0x9f, 0x41, 0x29, 0xf2, 0xc0, 0xdf,
+	0x54, 0x72, 0xbb, 0x50, 0x9c, 0xaa, 0xd5, 0xda, 0x85, 0x62, 0xad, 0x36, 0xdd, 0x2e, 0x14, 0xaf,
+	0xd7, 0x5e, 0x6f, 0x17, 0x8a, 0xaf, 0xd7, 0x9a, 0xed, 0x42, 0xf1, 0x46, 0x6d, 0xa3, 0x5d, 0x28,
+	0x6e, 0xd4, 0x36, 0xdb, 0x85, 0xe2, 0x66, 0xed, 0x66, 0xe3, 0x26, 0x54, 0x93, 0x3e, 0x42, 0x03,
+	0x4f, 0x22, 0xaa, 0x48, 0x2c, 0xf0, 0x08, 0x11, 0xa5, 0xf1, 0x2f, 0x09, 0xe6, 0x87, 0x6e, 0x14,
+	0xe5, 0x26, 0x98, 0xb5, 0x5d, 0x42, 0x4f, 0x4e, 0xc8, 0xda, 0x12, 0xcf, 0xda, 0x88, 0x88, 0xb3,
+	0xf6, 0x1c, 0x8c, 0x73, 0xff, 0x67, 0x9d, 0xea, 0x98, 0x8b, 0x1e, 0xdf, 0x86, 0x31, 0x3c, 0x5d,
+	0x6c, 0x4b, 0xab, 0x9b, 0xb7, 0x32, 0xfd, 0x1c, 0x47, 0x99, 0x99, 0x37, 0x1b, 0xf5, 0x50, 0x99,
+	0x08, 0xf9, 0x1e, 0x8c, 0xd3, 0x87, 0xc0, 0xc3, 0xa6, 0xb5, 0xba, 0xd9, 0x4c, 0x1a, 0xf1, 0x6c,
+	0x29, 0x81, 0xa7, 0x72, 0xee, 0xc6, 0x77, 0x05, 0xa8, 0x85, 0x83, 0x0d, 0x6c, 0x32, 0x7e, 0xab,
+	0x8e, 0x3c, 0xb6, 0xc1, 0xa8, 0x68, 0x83, 0x6d, 0x28, 0xb1, 0xb2, 0x78, 0xd0, 0x27, 0x5c, 0xf5,
+	0x97, 0xcf, 0xb6, 0x03, 0x16, 0xc2, 0x83, 0x3e, 0x51, 0x8b, 0x3e, 0x7f, 0xa2, 0xdd, 0xbe, 0xaf,
+	0xb9, 0xfb, 0x24, 0xd5, 0xed, 0xb3, 0xae, 0x7c, 0x9a, 0xa1, 0x52, 0xdd, 0x3e, 0xa7, 0x17, 0x75,
+	0x1e, 0x67, 0xcd, 0x2c, 0xc3, 0x24, 0xbb, 0x7d, 0x4e, 0xcd, 0x37, 0x30, 0xc1, 0xb6, 0xcf, 0x80,
+	0x2c, 0x78, 0x25, 0xbb, 0xe7, 0x62, 0xba, 0x7b, 0x7e, 0x0f, 0x96, 0xb8, 0x08, 0xfd, 0xc0, 0xb4,
+	0x8c, 0x78, 0x59, 0xc7, 0xb6, 0x06, 0xd8, 0x6c, 0x17, 0xd5, 0x05, 0x46, 0xb1, 0x4d, 0x09, 0xc2,
+	0xd5, 0x3f, 0xb2, 0xad, 0x01, 0x35, 0xad, 0xd8, 0xa8, 0x00, 0xba, 0x29, 0x78, 0x71, 0x73, 0xa2,
+	0xc0, 0x44, 0xd8, 0xfd, 0x94, 0x11, 0x19, 0xbe, 0xca, 0x0b, 0x30, 0x11, 0x76, 0x90, 0x15, 0xc4,
+	0x8c, 0xfb, 0xac, 0x71, 0x6c, 0xc1, 0x94, 0x30, 0xf7, 0xc2, 0x08, 0x32, 0x99, 0xb7, 0x13, 0x8b,
+	0x19, 0x29, 0xaa, 0x5d, 0x28, 0x56, 0x6b, 0x53, 0x8d, 0xbf, 0x8c, 0xc2, 0x8c, 0x30, 0x1a, 0xfa,
+	0xdd, 0xb8, 0x8e, 0x60, 0xbb, 0xb1, 0xa4, 0xed, 0xae, 0x40, 0x35, 0xd5, 0x56, 0xb3, 0x11, 0x4e,
+	0xa5, 0x2b, 0xb6, 0xd4, 0x0d, 0x98, 0xb4, 0xc9, 0x53, 0x81, 0x88, 0xcd, 0x6d, 0xca, 0x14, 0x18,
+	0xd2, 0xd0, 0x0a, 0x27, 0x6a, 0x3b, 0x4c, 0x43, 0x29, 0xf2, 0x0a, 0x27, 0x84, 0x31, 0x92, 0x3d,
+	0x57, 0xb3, 0xf5, 0x83, 0x8e, 0xef, 0x1c, 0x12, 0x76, 0x8e, 0x15, 0xb5, 0xcc, 0x60, 0xbb, 0x14,
+	0x24, 0xaf, 0xc3, 0xac, 0x4d, 0x58, 0xf6, 0x4a, 0x90, 0x4e, 0x22, 0xe9, 0xb4, 0x4d, 0x68, 0x4e,
+	0xda, 0x12, 0x18, 0x84, 0xc3, 0x9f, 0x12, 0x0f, 0xbf, 0x5d, 0x28, 0x96, 0x6a, 0xd0, 0x2e, 0x14,
+	0xa1, 0x56, 0x6e, 0x17, 0x8a, 0x95, 0xda, 0x24, 0x3f, 0xc3, 0x3f, 0x5f, 0x00, 0xf9, 0xd3, 0xf8,
+	0x70, 0x7f, 0xff, 0x47, 0x28, 0x58, 0x60, 0xfc, 0x59, 0xee, 0x3f, 0xf1, 0x7c, 0xee, 0xdf, 0xf8,
+	0x63, 0x01, 0x26, 0xe9, 0xc3, 0xef, 0x27, 0x5a, 0xde, 0x85, 0x0a, 0x6f, 0xff, 0x98, 0x9c, 0x31,
+	0x94, 0xd3, 0x38, 0x25, 0x61, 0xf0, 0x26, 0x0f, 0x65, 0x94, 0xfd, 0xf8, 0x45, 0x26, 0xc2, 0x10,
+	0x22, 0x6c, 0x7d, 0x50, 0xde, 0x38, 0xca, 0xdb, 0xc8, 0x97, 0xcd, 0x78, 0x53, 0x84, 0xe2, 0x67,
+	0x8e, 0x87, 0x81, 0xe2, 0xe9, 0x4e, 0x24, 0x4f, 0xf7, 0x1a, 0xd4, 0xa2, 0xb8, 0x18, 0xf6, 0x9f,
+	0x45, 0x6c, 0xd4, 0xa6, 0x42, 0x78, 0x38, 0xfc, 0x58, 0x84, 0x62, 0x74, 0x41, 0xd9, 0x77, 0xa3,
+	0x09, 0xc2, 0x2f, 0xa7, 0xe0, 0x23, 0xf0, 0x2c, 0x1f, 0x29, 0x3f, 0xa7, 0x8f, 0xfc, 0x78, 0x01,
+	0x64, 0x3e, 0x7d, 0xbd, 0xf3, 0xe0, 0xe3, 0xc8, 0x51, 0x0c, 0xe1, 0xab, 0x1e, 0xd3, 0xc1, 0xee,
+	0x3a, 0xe8, 0x2e, 0xe5, 0xcd, 0x5b, 0x79, 0x8a, 0xde, 0x74, 0xa2, 0xbe, 0x3f, 0x12, 0x7f, 0x0d,
+	0x8c, 0x56, 0xf9, 0x02, 0xa6, 0xd8, 0x07, 0xa7, 0x78, 0x09, 0x56, 0x67, 0x6d, 0xe4, 0x5a, 0x42,
+	0x74, 0xed, 0xfb, 0x23, 0xea, 0xa4, 0x9f, 0xf0, 0x75, 0xa1, 0xf5, 0x1f, 0x4d, 0xb6, 0xfe, 0x97,
+	0x00, 0xd8, 0x1c, 0xd3, 0x75, 0x1d, 0x97, 0x4f, 0xd2, 0x4b, 0x14, 0x82, 0x9d, 0x24, 0xfd, 0xea,
+	0xc8, 0xcb, 0x27, 0xb4, 0xec, 0x58, 0xde, 0xaf, 0x8e, 0x8c, 0x89, 0x82, 0xb7, 0xca, 0x50, 0x8a,
+	0xb6, 0xd4, 0xf8, 0xc3, 0x14, 0x54, 0x6e, 0xeb, 0xbe, 0x79, 0x64, 0xfa, 0x83, 0x50, 0xb3, 0xd0,
+	0x6f, 0xa4, 0xa4, 0xdf, 0xbc, 0x05, 0x4a, 0x1c, 0x8e, 0x53, 0x53, 0x77, 0xf6, 0x99, 0x62, 0x2e,
+	0xc2, 0x27, 0x86, 0xee, 0x0f, 0x61, 0x2a, 0xc5, 0xa8, 0x8c, 0x66, 0x75, 0x97, 0xa7, 0xcd, 0xdc,
+	0xab, 0x49, 0xb1, 0xb4, 0x8a, 0x4f, 0x8d, 0xa3, 0x0a, 0x79, 0xab, 0x78, 0x2f, 0x31, 0x7a, 0xba,
+	0xc4, 0x27, 0xb3, 0x2c, 0xbd, 0xb0, 0x20, 0x58, 0xf2, 0xa2, 0x19, 0x64, 0x9b, 0xcf, 0x9d, 0x23,
+	0xad, 0xc7, 0xcf, 0xa3, 0x75, 0x85, 0xf3, 0x32, 0x9d, 0xb7, 0xa1, 0x92, 0x18, 0x1c, 0xe6, 0x0d,
+	0x9b, 0x65, 0x4f, 0x18, 0x16, 0xae, 0x40, 0x59, 0xe3, 0x67, 0x15, 0xe6, 0xc3, 0x92, 0x0a, 0x21,
+	0x88, 0x95, 0x53, 0x42, 0x55, 0xcd, 0x3f, 0x46, 0xb8, 0x51, 0x3d, 0xfd, 0x39, 0x2c, 0x9e, 0x3e,
+	0xd2, 0x82, 0x7c, 0x23, 0xa0, 0x79, 0x2f, 0x7b, 0x98, 0x95, 0x92, 0xad, 0x5b, 0x8e, 0x47, 0xce,
+	0xfb, 0xe5, 0x42, 0x90, 0xbd, 0x4d, 0xf9, 0x43, 0xd9, 0xbb, 0x30, 0xcf, 0x75, 0x4d, 0x0b, 0xce,
+	0xf9, 0xe5, 0x62, 0x06, 0xd9, 0x53, 0x52, 0x1f, 0xc0, 0xf4, 0x01, 0xd1, 0x5c, 0x7f, 0x8f, 0x68,
+	0xfe, 0x79, 0x3f, 0x57, 0xd4, 0x22, 0xce, 0x50, 0x5a, 0xd6, 0x94, 0xb5, 0x9a, 0x3d, 0x65, 0xcd,
+	0x1c, 0x5c, 0xb2, 0x52, 0x23, 0x6b, 0x70, 0x29, 0x44, 0x21, 0xde, 0xaa, 0xd4, 0x58, 0xb4, 0x8e,
+	0x42, 0x0a, 0xeb, 0x45, 0xc4, 0xa0, 0x32, 0x3d, 0x14, 0x54, 0x84, 0x32, 0x5b, 0x4e, 0x97, 0xd9,
+	0x34, 0x23, 0x44, 0xf7, 0x80, 0xd8, 0xbe, 0xe9, 0x0f, 0x94, 0x99, 0x70, 0x38, 0xca, 0x6f, 0x03,
+	0x03, 0x67, 0x0e, 0xb1, 0x66, 0x33, 0x87, 0x58, 0xa7, 0xcf, 0x30, 0xe7, 0x5e, 0xcc, 0x0c, 0x73,
+	0xfe, 0xc5, 0xcc, 0x30, 0x17, 0xce, 0x98, 0x61, 0xee, 0xc2, 0x1c, 0xe3, 0x4a, 0xcf, 0x45, 0x94,
+	0x9c, 0xd7, 0x7b, 0x06, 0xd9, 0x53, 0x13, 0x91, 0x33, 0x27, 0xa3, 0x8b, 0x67, 0x4f, 0x46, 0x73,
+	0x8c, 0x2a, 0x97, 0x9e, 0x3d, 0xaa, 0x7c, 0x08, 0x32, 0x93, 0xc2, 0x26, 0x33, 0xec, 0x57, 0x27,
+	0xfe, 0xb1, 0x63, 0x35, 0x19, 0xfe, 0x38, 0x92, 0x86, 0xbf, 0x7b, 0xec, 0x51, 0xad, 0x21, 0xef,
+	0x03, 0x3a, 0xb5, 0x61, 0x10, 0xda, 0xc7, 0x09, 0xf2, 0x68, 0xb9, 0x42, 0xdc, 0xd8, 0xd5, 0x96,
+	0xd1, 0xd5, 0x16, 0x22, 0xae, 0xc7, 0x88, 0x8f, 0x5c, 0x2e, 0x5d, 0x17, 0x5e, 0xca, 0xac, 0x0b,
+	0xc5, 0x56, 0xaf, 0x3e, 0xd4, 0xea, 0x7d, 0x0a, 0xf3, 0xb8, 0x74, 0x7c, 0xe1, 0x0d, 0xe2, 0x6b,
+	0xa6, 0xe5, 0x29, 0x2b, 0x59, 0x9b, 0x1a, 0x9a, 0x9d, 0x78, 0xea, 0x2c, 0xe5, 0xbf, 0x1f, 0xb2,
+	0xdf, 0x61, 0xdc, 0xf4, 0xeb, 0x50, 0x4a, 0xae, 0xf8, 0x91, 0x6e, 0x35, 0xef, 0xd7, 0xa1, 0x84,
+	0xec, 0xf8, 0x6b, 0x5d, 0xe3, 0xaf, 0x12, 0x94, 0xe8, 0x83, 0xfb, 0x8c, 0xd4, 0x9c, 0x4c, 0x64,
+	0x17, 0xd2, 0x89, 0xec, 0x36, 0x94, 0xd1, 0x41, 0x79, 0x39, 0x36, 0x9a, 0xb7, 0x68, 0x60, 0x4c,
+	0x61, 0xea, 0x11, 0x23, 0x10, 0xfb, 0xe7, 0x0a, 0xfc, 0x38, 0xf8, 0x2c, 0x42, 0x91, 0x05, 0xaa,
+	0x68, 0x80, 0x30, 0x81, 0xef, 0x2d, 0xa3, 0xf1, 0x4b, 0x01, 0x64, 0x6c, 0xcf, 0x93, 0xff, 0x2b,
+	0x9c, 0x59, 0x69, 0xc4, 0xff, 0x00, 0x64, 0x57, 0x1a, 0x11, 0x3e, 0x51, 0x69, 0x24, 0xed, 0x30,
+	0x9a, 0xb6, 0xc3, 0x43, 0x98, 0x4a, 0xc9, 0x55, 0x0a, 0xe7, 0x49, 0xe9, 0xd5, 0xe4, 0xaa, 0x74,
+	0x7e, 0x12, 0x2e, 0x27, 0xb6, 0x25, 0x7c, 0x7e, 0xc2, 0x51, 0xc2, 0x44, 0xe4, 0x0a, 0x54, 0x43,
+	0x7a, 0xde, 0xa5, 0xb0, 0xd9, 0x49, 0x58, 0x1a, 0xa8, 0x81, 0x9d, 0x55, 0x76, 0x4c, 0x3c, 0x7f,
+	0xd9, 0x91, 0x39, 0x6d, 0x2b, 0x66, 0x4f, 0xdb, 0x96, 0xa1, 0x14, 0xdd, 0xa9, 0xb0, 0x76, 0x88,
+	0x00, 0xe7, 0xfc, 0x91, 0xe1, 0xb3, 0xe8, 0x3f, 0x12, 0x96, 0xaf, 0x79, 0xa6, 0x28, 0x63, 0x8b,
+	0xb3, 0x76, 0x4a, 0xcb, 0xf4, 0x08, 0x39, 0x30, 0x47, 0xb3, 0x1c, 0x12, 0xfe, 0x71, 0x22, 0x80,
+	0x86, 0xfe, 0x0f, 0xa9, 0x0c, 0xfd, 0x1f, 0xd2, 0xf8, 0x4e, 0x82, 0x69, 0xbe, 0xad, 0x6d, 0x4c,
+	0xa7, 0x2f, 0xca, 0xdd, 0x32, 0x13, 0xf9, 0x68, 0xf6, 0x17, 0xc8, 0xb4, 0xde, 0x85, 0x61, 0xbd,
+	0xbf, 0xb9, 0x00, 0xb0, 0x83, 0x9f, 0x6f, 0x5e, 0xe0, 0xfd, 0x18, 0xd2, 0x54, 0xa8, 0x0f, 0x65,
+	0x28, 0xe0, 0xa9, 0xb2, 0xae, 0x03, 0x9f, 0xe5, 0x37, 0x61, 0xcc, 0xb4, 0xfb, 0x81, 0xaf, 0x8c,
+	0xe5, 0x0c, 0x94, 0x8c, 0x9c, 0x6a, 0xaf, 0x3b, 0xb6, 0xef, 0x3a, 0x16, 0x77, 0xf2, 0xf0, 0x75,
+	0xc8, 0x12, 0x13, 0xc3, 0x96, 0xf8, 0x5a, 0x82, 0xe2, 0xf6, 0x01, 0xd1, 0x0f, 0xbd, 0xa0, 0x97,
+	0xb6, 0xc3, 0x58, 0x6c, 0x87, 0x3b, 0x30, 0xde, 0xb5, 0xb4, 0x23, 0xc7, 0xc5, 0x5d, 0x57, 0x37,
+	0xaf, 0x9f, 0xdd, 0x3b, 0x87, 0x12, 0xef, 0x21, 0x8f, 0xca, 0x79, 0xe3, 0x7f, 0xad, 0x46, 0x71,
+	0x22, 0xc4, 0x5e, 0xb6, 0xfe, 0xfb, 0xfb, 0x9f, 0xea, 0x23, 0x3f, 0xfc, 0x54, 0x1f, 0xf9, 0xf5,
+	0xa7, 0xba, 0xf4, 0xf5, 0x49, 0x5d, 0xfa, 0xd3, 0x49, 0x5d, 0xfa, 0xdb, 0x49, 0x5d, 0xfa, 0xfe,
+	0xa4, 0x2e, 0xfd, 0x78, 0x52, 0x97, 0xfe, 0x79, 0x52, 0x1f, 0xf9, 0xf5, 0xa4, 0x2e, 0x7d, 0xfb,
+	0x73, 0x7d, 0xe4, 0xfb, 0x9f, 0xeb, 0x23, 0x3f, 0xfc, 0x5c, 0x1f, 0xf9, 0xfc, 0xd6, 0xbe, 0x13,
+	0xeb, 0x60, 0x3a, 0xa7, 0xff, 0x32, 0xfd, 0x9e, 0xf0, 0xba, 0x37, 0x8e, 0x21, 0xf8, 0xe6, 0xbf,
+	0x07, 0x00, 0x10, 0x28, 0xd6, 0xaf, 0x6b, 0x2d, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2867,6 +2984,97 @@ func (this *TimerTaskInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryDLQTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryDLQTaskInfo)
+	if !ok {
+		that2, ok := that.(HistoryDLQTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.TaskInfo == nil {
+		if this.TaskInfo != nil {
+			return false
+		}
+	} else if this.TaskInfo == nil {
+		return false
+	} else if !this.TaskInfo.Equal(that1.TaskInfo) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if that1.CreateTime == nil {
+		if this.CreateTime != nil {
+			return false
+		}
+	} else if !this.CreateTime.Equal(*that1.CreateTime) {
+		return false
+	}
+	return true
+}
+func (this *HistoryDLQTaskInfo_TransferTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryDLQTaskInfo_TransferTaskInfo)
+	if !ok {
+		that2, ok := that.(HistoryDLQTaskInfo_TransferTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TransferTaskInfo.Equal(that1.TransferTaskInfo) {
+		return false
+	}
+	return true
+}
+func (this *HistoryDLQTaskInfo_TimerTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryDLQTaskInfo_TimerTaskInfo)
+	if !ok {
+		that2, ok := that.(HistoryDLQTaskInfo_TimerTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TimerTaskInfo.Equal(that1.TimerTaskInfo) {
+		return false
+	}
+	return true
+}
 func (this *ActivityInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3509,6 +3717,37 @@ func (this *TimerTaskInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryDLQTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&persistence.HistoryDLQTaskInfo{")
+	if this.TaskInfo != nil {
+		s = append(s, "TaskInfo: "+fmt.Sprintf("%#v", this.TaskInfo)+",\n")
+	}
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryDLQTaskInfo_TransferTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&persistence.HistoryDLQTaskInfo_TransferTaskInfo{` +
+		`TransferTaskInfo:` + fmt.Sprintf("%#v", this.TransferTaskInfo) + `}`}, ", ")
+	return s
+}
+func (this *HistoryDLQTaskInfo_TimerTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&persistence.HistoryDLQTaskInfo_TimerTaskInfo{` +
+		`TimerTaskInfo:` + fmt.Sprintf("%#v", this.TimerTaskInfo) + `}`}, ", ")
+	return s
+}
 func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4791,7 +5030,7 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivityInfo) Marshal() (dAtA []byte, err error) {
+func (m *HistoryDLQTaskInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4801,24 +5040,120 @@ func (m *ActivityInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivityInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoryDLQTaskInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoryDLQTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastHeartbeatUpdateTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+	if m.CreateTime != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
 		if err28 != nil {
 			return 0, err28
 		}
 		i -= n28
 		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskInfo != nil {
+		{
+			size := m.TaskInfo.Size()
+			i -= size
+			if _, err := m.TaskInfo.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryDLQTaskInfo_TransferTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryDLQTaskInfo_TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TransferTaskInfo != nil {
+		{
+			size, err := m.TransferTaskInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryDLQTaskInfo_TimerTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryDLQTaskInfo_TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TimerTaskInfo != nil {
+		{
+			size, err := m.TimerTaskInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActivityInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeartbeatUpdateTime != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0x82
@@ -4896,12 +5231,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err31 != nil {
-			return 0, err31
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4915,24 +5250,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err32 != nil {
-			return 0, err32
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err33 != nil {
-			return 0, err33
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4998,42 +5333,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err34 != nil {
-			return 0, err34
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err36 != nil {
-			return 0, err36
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err37 != nil {
-			return 0, err37
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n40
+		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5051,13 +5386,13 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	if m.StartedTime != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err38 != nil {
-			return 0, err38
+	if m.StartedTime != nil {
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5079,12 +5414,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err40 != nil {
-			return 0, err40
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5146,12 +5481,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err42 != nil {
-			return 0, err42
+		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n45
+		i = encodeVarintExecutions(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5961,6 +6296,53 @@ func (m *TimerTaskInfo) Size() (n int) {
 	return n
 }
 
+func (m *HistoryDLQTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskInfo != nil {
+		n += m.TaskInfo.Size()
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovExecutions(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.CreateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryDLQTaskInfo_TransferTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferTaskInfo != nil {
+		l = m.TransferTaskInfo.Size()
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
+func (m *HistoryDLQTaskInfo_TimerTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimerTaskInfo != nil {
+		l = m.TimerTaskInfo.Size()
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
 func (m *ActivityInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6494,6 +6876,39 @@ func (this *TimerTaskInfo) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryDLQTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryDLQTaskInfo{`,
+		`TaskInfo:` + fmt.Sprintf("%v", this.TaskInfo) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryDLQTaskInfo_TransferTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryDLQTaskInfo_TransferTaskInfo{`,
+		`TransferTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.TransferTaskInfo), "TransferTaskInfo", "TransferTaskInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryDLQTaskInfo_TimerTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryDLQTaskInfo_TimerTaskInfo{`,
+		`TimerTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.TimerTaskInfo), "TimerTaskInfo", "TimerTaskInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ActivityInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -10669,6 +11084,216 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryDLQTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryDLQTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryDLQTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTaskInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransferTaskInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.TaskInfo = &HistoryDLQTaskInfo_TransferTaskInfo{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerTaskInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TimerTaskInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.TaskInfo = &HistoryDLQTaskInfo_TimerTaskInfo{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
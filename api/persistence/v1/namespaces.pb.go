@@ -156,6 +156,8 @@ type NamespaceInfo struct {
 	Description string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Owner       string            `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 	Data        map[string]string `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Previous names of a renamed namespace which still resolve to it until they expire.
+	Aliases []*NamespaceAlias `protobuf:"bytes,7,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
 func (m *NamespaceInfo) Reset()      { *m = NamespaceInfo{} }
@@ -232,6 +234,64 @@ func (m *NamespaceInfo) GetData() map[string]string {
 	return nil
 }
 
+func (m *NamespaceInfo) GetAliases() []*NamespaceAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+type NamespaceAlias struct {
+	Name           string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpirationTime *time.Time `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *NamespaceAlias) Reset()      { *m = NamespaceAlias{} }
+func (*NamespaceAlias) ProtoMessage() {}
+func (*NamespaceAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{2}
+}
+func (m *NamespaceAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceAlias.Merge(m, src)
+}
+func (m *NamespaceAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceAlias proto.InternalMessageInfo
+
+func (m *NamespaceAlias) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceAlias) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 type NamespaceConfig struct {
 	Retention               *time.Duration   `protobuf:"bytes,1,opt,name=retention,proto3,stdduration" json:"retention,omitempty"`
 	ArchivalBucket          string           `protobuf:"bytes,2,opt,name=archival_bucket,json=archivalBucket,proto3" json:"archival_bucket,omitempty"`
@@ -240,12 +300,15 @@ type NamespaceConfig struct {
 	HistoryArchivalUri      string           `protobuf:"bytes,5,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
 	VisibilityArchivalState v1.ArchivalState `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
 	VisibilityArchivalUri   string           `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	// Generic Elasticsearch field name to custom search attribute alias registered in the namespace.
+	// Fields released by removed search attributes have empty alias and aren't reused until reclaimed.
+	CustomSearchAttributeAliases map[string]string `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
 func (*NamespaceConfig) ProtoMessage() {}
 func (*NamespaceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0486d93c2107d6bc, []int{2}
+	return fileDescriptor_0486d93c2107d6bc, []int{3}
 }
 func (m *NamespaceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,6 +386,13 @@ func (m *NamespaceConfig) GetVisibilityArchivalUri() string {
 	return ""
 }
 
+func (m *NamespaceConfig) GetCustomSearchAttributeAliases() map[string]string {
+	if m != nil {
+		return m.CustomSearchAttributeAliases
+	}
+	return nil
+}
+
 type NamespaceReplicationConfig struct {
 	ActiveClusterName string   `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
@@ -331,7 +401,7 @@ type NamespaceReplicationConfig struct {
 func (m *NamespaceReplicationConfig) Reset()      { *m = NamespaceReplicationConfig{} }
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0486d93c2107d6bc, []int{3}
+	return fileDescriptor_0486d93c2107d6bc, []int{4}
 }
 func (m *NamespaceReplicationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +448,9 @@ func init() {
 	proto.RegisterType((*NamespaceDetail)(nil), "temporal.server.api.persistence.v1.NamespaceDetail")
 	proto.RegisterType((*NamespaceInfo)(nil), "temporal.server.api.persistence.v1.NamespaceInfo")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceInfo.DataEntry")
+	proto.RegisterType((*NamespaceAlias)(nil), "temporal.server.api.persistence.v1.NamespaceAlias")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistence.v1.NamespaceConfig")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry")
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistence.v1.NamespaceReplicationConfig")
 }
 
@@ -387,58 +459,65 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x73, 0xe3, 0x34,
-	0x18, 0x8d, 0x93, 0x34, 0x8b, 0x15, 0x36, 0xdd, 0x8a, 0xc2, 0x66, 0xc3, 0xe0, 0x0d, 0x19, 0xca,
-	0x86, 0x8b, 0x4d, 0x5a, 0x06, 0x18, 0x3a, 0x30, 0x43, 0xda, 0x1e, 0x3a, 0x30, 0x65, 0xc6, 0x50,
-	0x0e, 0xbd, 0x18, 0xc5, 0x56, 0x52, 0x51, 0x47, 0xf2, 0x48, 0x8a, 0x99, 0xde, 0xf8, 0x09, 0x3d,
-	0xf2, 0x13, 0x38, 0xf3, 0x2b, 0x38, 0xf6, 0xd8, 0x1b, 0x34, 0xbd, 0x70, 0xe0, 0xd0, 0x23, 0x47,
-	0xc6, 0x92, 0x6c, 0x27, 0x0d, 0x1d, 0x26, 0x37, 0xeb, 0xd3, 0x7b, 0x4f, 0x9f, 0xbe, 0xf7, 0xa2,
-	0x80, 0x3d, 0x89, 0xa7, 0x09, 0xe3, 0x28, 0xf6, 0x04, 0xe6, 0x29, 0xe6, 0x1e, 0x4a, 0x88, 0x97,
-	0x60, 0x2e, 0x88, 0x90, 0x98, 0x86, 0xd8, 0x4b, 0x07, 0x1e, 0x45, 0x53, 0x2c, 0x12, 0x14, 0x62,
-	0xe1, 0x26, 0x9c, 0x49, 0x06, 0x7b, 0x39, 0xc9, 0xd5, 0x24, 0x17, 0x25, 0xc4, 0x5d, 0x20, 0xb9,
-	0xe9, 0xa0, 0xe3, 0x4c, 0x18, 0x9b, 0xc4, 0xd8, 0x53, 0x8c, 0xd1, 0x6c, 0xec, 0x45, 0x33, 0x8e,
-	0x24, 0x61, 0x54, 0x6b, 0x74, 0x5e, 0x3e, 0xdc, 0x97, 0x64, 0x8a, 0x85, 0x44, 0xd3, 0xc4, 0x00,
-	0xde, 0x8d, 0x70, 0x82, 0x69, 0x84, 0x69, 0x48, 0xb0, 0xf0, 0x26, 0x6c, 0xc2, 0x54, 0x5d, 0x7d,
-	0x19, 0xc8, 0x4e, 0xd1, 0x7c, 0xd6, 0x35, 0xa6, 0xb3, 0xa9, 0x58, 0xea, 0xd7, 0xc0, 0x5e, 0x2d,
-	0xc1, 0x8a, 0xdd, 0x0c, 0x3a, 0xc5, 0x42, 0xa0, 0x89, 0x01, 0xf6, 0xfe, 0xa9, 0x81, 0xcd, 0x93,
-	0x7c, 0xfb, 0x10, 0x4b, 0x44, 0x62, 0x78, 0x04, 0xea, 0x84, 0x8e, 0x59, 0xdb, 0xea, 0x5a, 0xfd,
-	0xe6, 0xee, 0xc0, 0xfd, 0xff, 0xab, 0xbb, 0x85, 0xc4, 0x31, 0x1d, 0x33, 0x5f, 0xd1, 0xe1, 0x57,
-	0xa0, 0x11, 0x32, 0x3a, 0x26, 0x93, 0x76, 0x55, 0x09, 0xed, 0xad, 0x25, 0x74, 0xa0, 0xa8, 0xbe,
-	0x91, 0x80, 0x53, 0x00, 0x39, 0x4e, 0x62, 0x12, 0xaa, 0x81, 0x06, 0x46, 0xb8, 0xa6, 0x84, 0xbf,
-	0x58, 0x4b, 0xd8, 0x2f, 0x65, 0xcc, 0x19, 0x5b, 0xfc, 0x61, 0x09, 0xee, 0x80, 0x96, 0x3e, 0x22,
-	0x48, 0x33, 0x19, 0x46, 0xdb, 0xf5, 0xae, 0xd5, 0xaf, 0xf9, 0x4f, 0x75, 0xf5, 0x7b, 0x5d, 0x84,
-	0x43, 0xf0, 0xce, 0x18, 0x91, 0x98, 0xa5, 0x98, 0x07, 0x94, 0x49, 0x32, 0xce, 0xfb, 0xcb, 0x59,
-	0x1b, 0x8a, 0xf5, 0x76, 0x0e, 0x3a, 0x59, 0xc0, 0xe4, 0x1a, 0x1f, 0x80, 0x67, 0x85, 0x46, 0x4e,
-	0x6b, 0x28, 0xda, 0x66, 0x5e, 0xcf, 0xa1, 0x5f, 0x83, 0xad, 0x02, 0x8a, 0x69, 0x14, 0x64, 0xf9,
-	0x69, 0x3f, 0x51, 0x33, 0xe8, 0xb8, 0x3a, 0x5c, 0x6e, 0x1e, 0x2e, 0xf7, 0xbb, 0x3c, 0x5c, 0xc3,
-	0xfa, 0xd5, 0x1f, 0x2f, 0xad, 0x52, 0xed, 0x88, 0x46, 0xd9, 0x5e, 0xef, 0xb7, 0x2a, 0x78, 0xba,
-	0xe4, 0x1b, 0x6c, 0x81, 0x2a, 0x89, 0x94, 0xed, 0xb6, 0x5f, 0x25, 0x11, 0xdc, 0x07, 0x1b, 0x42,
-	0x22, 0x89, 0x95, 0x81, 0xad, 0xdd, 0x9d, 0x72, 0xce, 0xd9, 0x80, 0x55, 0xf8, 0x96, 0x46, 0xfb,
-	0x6d, 0x06, 0xf6, 0x35, 0x07, 0x42, 0x50, 0xcf, 0x72, 0xa7, 0x3c, 0xb2, 0x7d, 0xf5, 0x0d, 0xbb,
-	0xa0, 0x19, 0x61, 0x11, 0x72, 0x92, 0xc8, 0x7c, 0xa6, 0xb6, 0xbf, 0x58, 0x82, 0xdb, 0x60, 0x83,
-	0xfd, 0x44, 0x31, 0x57, 0x93, 0xb3, 0x7d, 0xbd, 0x80, 0xdf, 0x80, 0x7a, 0x84, 0x24, 0x6a, 0x37,
-	0xba, 0xb5, 0x7e, 0x73, 0x77, 0x7f, 0xed, 0x44, 0xba, 0x87, 0x48, 0xa2, 0x23, 0x2a, 0xf9, 0xa5,
-	0xaf, 0x84, 0x3a, 0x9f, 0x00, 0xbb, 0x28, 0xc1, 0x67, 0xa0, 0x76, 0x81, 0x2f, 0xcd, 0xbd, 0xb3,
-	0xcf, 0xac, 0x8b, 0x14, 0xc5, 0x33, 0x7d, 0x71, 0xdb, 0xd7, 0x8b, 0xcf, 0xaa, 0x9f, 0x5a, 0xbd,
-	0xbf, 0x17, 0x7f, 0x2f, 0x26, 0x2c, 0x9f, 0x03, 0x9b, 0x63, 0x89, 0xa9, 0xba, 0x93, 0xfe, 0xd1,
-	0xbc, 0x58, 0xb1, 0xe3, 0xd0, 0xbc, 0x05, 0xc3, 0xfa, 0x2f, 0x99, 0x1b, 0x25, 0x03, 0xbe, 0x02,
-	0x9b, 0x88, 0x87, 0xe7, 0x24, 0x45, 0x71, 0x30, 0x9a, 0x85, 0x17, 0x58, 0x9a, 0x63, 0x5b, 0x79,
-	0x79, 0xa8, 0xaa, 0xf0, 0x18, 0xbc, 0x3e, 0x42, 0x51, 0x30, 0x22, 0x14, 0x71, 0x82, 0x85, 0x49,
-	0xff, 0xfb, 0xcb, 0xae, 0x94, 0x2f, 0x41, 0x3a, 0x70, 0x87, 0x28, 0x1a, 0x1a, 0xb4, 0xdf, 0x1c,
-	0x95, 0x0b, 0x78, 0x06, 0xde, 0x3a, 0x27, 0x42, 0x32, 0x7e, 0x19, 0x14, 0x67, 0x6b, 0xab, 0xeb,
-	0xca, 0xea, 0xf7, 0x1e, 0xb1, 0xfa, 0x4b, 0x03, 0xd6, 0x4e, 0x6f, 0x1b, 0x8d, 0xa5, 0x2a, 0xfc,
-	0x10, 0x6c, 0xaf, 0x68, 0xcf, 0x38, 0x31, 0x8e, 0xc2, 0x07, 0x9c, 0x53, 0x4e, 0xe0, 0x0f, 0xe0,
-	0x45, 0x4a, 0x04, 0x19, 0x91, 0x98, 0xc8, 0x95, 0x86, 0x1a, 0x6b, 0x34, 0xf4, 0xbc, 0x94, 0x59,
-	0xee, 0xe9, 0x63, 0xf0, 0xfc, 0xbf, 0x4e, 0xc8, 0xda, 0x7a, 0xa2, 0xda, 0x7a, 0x73, 0x95, 0x79,
-	0xca, 0x49, 0xef, 0x1c, 0x74, 0x1e, 0x7f, 0x38, 0xa0, 0x0b, 0xde, 0x40, 0xa1, 0x24, 0x29, 0x0e,
-	0xc2, 0x78, 0x26, 0x64, 0xf6, 0x08, 0x64, 0x89, 0xd7, 0x41, 0xda, 0xd2, 0x5b, 0x07, 0x7a, 0x27,
-	0x53, 0x81, 0x1d, 0xf0, 0x9a, 0x01, 0x8a, 0x76, 0xb5, 0x5b, 0xeb, 0xdb, 0x7e, 0xb1, 0x1e, 0xfe,
-	0x78, 0x7d, 0xeb, 0x54, 0x6e, 0x6e, 0x9d, 0xca, 0xfd, 0xad, 0x63, 0xfd, 0x3c, 0x77, 0xac, 0x5f,
-	0xe7, 0x8e, 0xf5, 0xfb, 0xdc, 0xb1, 0xae, 0xe7, 0x8e, 0xf5, 0xe7, 0xdc, 0xb1, 0xfe, 0x9a, 0x3b,
-	0x95, 0xfb, 0xb9, 0x63, 0x5d, 0xdd, 0x39, 0x95, 0xeb, 0x3b, 0xa7, 0x72, 0x73, 0xe7, 0x54, 0xce,
-	0x3e, 0x9a, 0xb0, 0x72, 0x30, 0x84, 0x3d, 0xfe, 0x8f, 0xb6, 0xbf, 0xb0, 0x1c, 0x35, 0x54, 0x2a,
-	0xf7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x1e, 0x04, 0xeb, 0x0a, 0x07, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x73, 0x1b, 0x35,
+	0x14, 0xc7, 0xbd, 0xb6, 0xe3, 0xd4, 0x0a, 0x75, 0x1a, 0x11, 0xa8, 0x6b, 0x60, 0xe3, 0x66, 0x08,
+	0x0d, 0x97, 0x35, 0x49, 0x18, 0x60, 0xc8, 0xc0, 0x4c, 0x9c, 0xe4, 0x90, 0xa1, 0xd3, 0xce, 0x6c,
+	0x29, 0x87, 0x5e, 0x16, 0x79, 0x57, 0x76, 0x44, 0xd7, 0xd2, 0x8e, 0xa4, 0x5d, 0xc8, 0xad, 0x7f,
+	0x42, 0x8f, 0x1c, 0xf8, 0x03, 0xf8, 0x53, 0x38, 0xe6, 0xd8, 0x03, 0x33, 0x10, 0xe7, 0xc2, 0xb1,
+	0x47, 0x8e, 0x8c, 0x7e, 0xec, 0xae, 0x1d, 0x13, 0x82, 0x7b, 0xb3, 0x9e, 0xde, 0xf7, 0xa3, 0xb7,
+	0xef, 0x7d, 0xa5, 0x31, 0xd8, 0x93, 0x78, 0x9c, 0x30, 0x8e, 0xe2, 0x9e, 0xc0, 0x3c, 0xc3, 0xbc,
+	0x87, 0x12, 0xd2, 0x4b, 0x30, 0x17, 0x44, 0x48, 0x4c, 0x43, 0xdc, 0xcb, 0x76, 0x7a, 0x14, 0x8d,
+	0xb1, 0x48, 0x50, 0x88, 0x85, 0x97, 0x70, 0x26, 0x19, 0xdc, 0xcc, 0x45, 0x9e, 0x11, 0x79, 0x28,
+	0x21, 0xde, 0x94, 0xc8, 0xcb, 0x76, 0x3a, 0xee, 0x88, 0xb1, 0x51, 0x8c, 0x7b, 0x5a, 0x31, 0x48,
+	0x87, 0xbd, 0x28, 0xe5, 0x48, 0x12, 0x46, 0x0d, 0xa3, 0xb3, 0x71, 0x75, 0x5f, 0x92, 0x31, 0x16,
+	0x12, 0x8d, 0x13, 0x9b, 0x70, 0x3f, 0xc2, 0x09, 0xa6, 0x11, 0xa6, 0x21, 0xc1, 0xa2, 0x37, 0x62,
+	0x23, 0xa6, 0xe3, 0xfa, 0x97, 0x4d, 0xd9, 0x2a, 0x8a, 0x57, 0x55, 0x63, 0x9a, 0x8e, 0xc5, 0x4c,
+	0xbd, 0x36, 0xed, 0xc1, 0x4c, 0x5a, 0xb1, 0xab, 0x52, 0xc7, 0x58, 0x08, 0x34, 0xb2, 0x89, 0x9b,
+	0x7f, 0xd7, 0xc0, 0xea, 0xa3, 0x7c, 0xfb, 0x08, 0x4b, 0x44, 0x62, 0x78, 0x0c, 0xea, 0x84, 0x0e,
+	0x59, 0xdb, 0xe9, 0x3a, 0xdb, 0x2b, 0xbb, 0x3b, 0xde, 0xcd, 0x9f, 0xee, 0x15, 0x88, 0x13, 0x3a,
+	0x64, 0xbe, 0x96, 0xc3, 0x6f, 0x40, 0x23, 0x64, 0x74, 0x48, 0x46, 0xed, 0xaa, 0x06, 0xed, 0x2d,
+	0x04, 0x3a, 0xd4, 0x52, 0xdf, 0x22, 0xe0, 0x18, 0x40, 0x8e, 0x93, 0x98, 0x84, 0xba, 0xa1, 0x81,
+	0x05, 0xd7, 0x34, 0xf8, 0xeb, 0x85, 0xc0, 0x7e, 0x89, 0xb1, 0x67, 0xac, 0xf1, 0xab, 0x21, 0xb8,
+	0x05, 0x5a, 0xe6, 0x88, 0x20, 0x53, 0x18, 0x46, 0xdb, 0xf5, 0xae, 0xb3, 0x5d, 0xf3, 0x6f, 0x9b,
+	0xe8, 0x77, 0x26, 0x08, 0xfb, 0xe0, 0x83, 0x21, 0x22, 0x31, 0xcb, 0x30, 0x0f, 0x28, 0x93, 0x64,
+	0x98, 0xd7, 0x97, 0xab, 0x96, 0xb4, 0xea, 0xbd, 0x3c, 0xe9, 0xd1, 0x54, 0x4e, 0xce, 0xf8, 0x18,
+	0xdc, 0x29, 0x18, 0xb9, 0xac, 0xa1, 0x65, 0xab, 0x79, 0x3c, 0x4f, 0x7d, 0x08, 0xd6, 0x8a, 0x54,
+	0x4c, 0xa3, 0x40, 0xf9, 0xa7, 0xbd, 0xac, 0x7b, 0xd0, 0xf1, 0x8c, 0xb9, 0xbc, 0xdc, 0x5c, 0xde,
+	0xb7, 0xb9, 0xb9, 0xfa, 0xf5, 0x97, 0x7f, 0x6c, 0x38, 0x25, 0xed, 0x98, 0x46, 0x6a, 0x6f, 0xf3,
+	0x45, 0x0d, 0xdc, 0x9e, 0x99, 0x1b, 0x6c, 0x81, 0x2a, 0x89, 0xf4, 0xd8, 0x9b, 0x7e, 0x95, 0x44,
+	0x70, 0x1f, 0x2c, 0x09, 0x89, 0x24, 0xd6, 0x03, 0x6c, 0xed, 0x6e, 0x95, 0x7d, 0x56, 0x0d, 0xd6,
+	0xe6, 0x9b, 0x69, 0xed, 0x13, 0x95, 0xec, 0x1b, 0x0d, 0x84, 0xa0, 0xae, 0x7c, 0xa7, 0x67, 0xd4,
+	0xf4, 0xf5, 0x6f, 0xd8, 0x05, 0x2b, 0x11, 0x16, 0x21, 0x27, 0x89, 0xcc, 0x7b, 0xda, 0xf4, 0xa7,
+	0x43, 0x70, 0x1d, 0x2c, 0xb1, 0x1f, 0x29, 0xe6, 0xba, 0x73, 0x4d, 0xdf, 0x2c, 0xe0, 0x63, 0x50,
+	0x8f, 0x90, 0x44, 0xed, 0x46, 0xb7, 0xb6, 0xbd, 0xb2, 0xbb, 0xbf, 0xb0, 0x23, 0xbd, 0x23, 0x24,
+	0xd1, 0x31, 0x95, 0xfc, 0xcc, 0xd7, 0x20, 0xf8, 0x10, 0x2c, 0xa3, 0x98, 0x20, 0x81, 0x45, 0x7b,
+	0x59, 0x33, 0x77, 0x17, 0x62, 0x1e, 0x28, 0xad, 0x9f, 0x23, 0x3a, 0x9f, 0x83, 0x66, 0x71, 0x00,
+	0xbc, 0x03, 0x6a, 0xcf, 0xf1, 0x99, 0xed, 0xa2, 0xfa, 0xa9, 0xbe, 0x29, 0x43, 0x71, 0x6a, 0xda,
+	0xd8, 0xf4, 0xcd, 0xe2, 0xcb, 0xea, 0x17, 0xce, 0x26, 0x03, 0xad, 0x59, 0x66, 0xd1, 0x35, 0x67,
+	0xaa, 0x6b, 0x27, 0x60, 0x15, 0xff, 0x94, 0x10, 0xf3, 0x96, 0x98, 0xa1, 0x57, 0xff, 0xe7, 0xd0,
+	0x5b, 0xa5, 0x50, 0xcf, 0xfc, 0xf7, 0xa5, 0xa9, 0xeb, 0x6e, 0xbd, 0xfe, 0x15, 0x68, 0x72, 0x2c,
+	0x31, 0xd5, 0x23, 0x31, 0x77, 0xfe, 0xde, 0x1c, 0xf8, 0xc8, 0x3e, 0x65, 0xfd, 0xfa, 0xcf, 0x8a,
+	0x5b, 0x2a, 0xe0, 0x03, 0xb0, 0x8a, 0x78, 0x78, 0x4a, 0x32, 0x14, 0x07, 0x83, 0x34, 0x7c, 0x8e,
+	0xa5, 0xfd, 0xce, 0x56, 0x1e, 0xee, 0xeb, 0x28, 0x3c, 0x01, 0x6f, 0x0d, 0x50, 0x14, 0x0c, 0x08,
+	0x45, 0x9c, 0x60, 0x61, 0x2f, 0xef, 0x47, 0xb3, 0xa6, 0x2a, 0x1f, 0xb2, 0x6c, 0xc7, 0xeb, 0xa3,
+	0xa8, 0x6f, 0xb3, 0xfd, 0x95, 0x41, 0xb9, 0x80, 0xcf, 0xc0, 0xbb, 0xa7, 0x44, 0x48, 0xc6, 0xcf,
+	0x82, 0xe2, 0x6c, 0xe3, 0xd4, 0xba, 0x76, 0xea, 0x87, 0xd7, 0x38, 0xf5, 0xc0, 0x26, 0x1b, 0xa3,
+	0xae, 0x5b, 0xc6, 0x4c, 0x14, 0x7e, 0x02, 0xd6, 0xe7, 0xd8, 0x29, 0x27, 0xd6, 0x90, 0xf0, 0x8a,
+	0xe6, 0x29, 0x27, 0xf0, 0x7b, 0x70, 0x2f, 0x23, 0x82, 0x0c, 0x48, 0x4c, 0xe4, 0x5c, 0x41, 0x8d,
+	0x05, 0x0a, 0xba, 0x5b, 0x62, 0x66, 0x6b, 0xfa, 0x0c, 0xdc, 0xfd, 0xb7, 0x13, 0x54, 0x59, 0xcb,
+	0xba, 0xac, 0x77, 0xe6, 0x95, 0xaa, 0xb2, 0x5f, 0x1c, 0xb0, 0x11, 0xa6, 0x42, 0xb2, 0x71, 0x20,
+	0xb0, 0x92, 0x05, 0x48, 0x4a, 0x4e, 0x06, 0xa9, 0xc4, 0x41, 0xee, 0xff, 0x5b, 0xda, 0xff, 0x4f,
+	0xdf, 0xe0, 0x71, 0xf6, 0x0e, 0x35, 0xfa, 0x89, 0x26, 0x1f, 0xe4, 0xe0, 0x03, 0xc3, 0x35, 0xb7,
+	0xed, 0xfd, 0xf0, 0x3f, 0x52, 0x3a, 0x8f, 0xc1, 0xfd, 0x1b, 0x11, 0x0b, 0xdd, 0xa7, 0x53, 0xd0,
+	0xb9, 0xfe, 0x9d, 0x87, 0x1e, 0x78, 0x1b, 0x85, 0x92, 0x64, 0x38, 0x08, 0xe3, 0x54, 0x48, 0xf5,
+	0x66, 0x97, 0x57, 0x6d, 0xcd, 0x6c, 0x1d, 0x9a, 0x1d, 0x45, 0x81, 0x1d, 0x70, 0xcb, 0x26, 0x8a,
+	0x76, 0xb5, 0x5b, 0xdb, 0x6e, 0xfa, 0xc5, 0xba, 0xff, 0xc3, 0xf9, 0x85, 0x5b, 0x79, 0x75, 0xe1,
+	0x56, 0x5e, 0x5f, 0xb8, 0xce, 0x8b, 0x89, 0xeb, 0xfc, 0x3a, 0x71, 0x9d, 0xdf, 0x26, 0xae, 0x73,
+	0x3e, 0x71, 0x9d, 0x3f, 0x27, 0xae, 0xf3, 0xd7, 0xc4, 0xad, 0xbc, 0x9e, 0xb8, 0xce, 0xcb, 0x4b,
+	0xb7, 0x72, 0x7e, 0xe9, 0x56, 0x5e, 0x5d, 0xba, 0x95, 0x67, 0x9f, 0x8e, 0x58, 0xd9, 0x67, 0xc2,
+	0xae, 0xff, 0x03, 0xb2, 0x3f, 0xb5, 0x1c, 0x34, 0xf4, 0x2d, 0xdc, 0xfb, 0x67, 0x00, 0x07, 0x76,
+	0x99, 0x62, 0xb9, 0x08, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -529,6 +608,45 @@ func (this *NamespaceInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if !this.Aliases[i].Equal(that1.Aliases[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NamespaceAlias) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceAlias)
+	if !ok {
+		that2, ok := that.(NamespaceAlias)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *NamespaceConfig) Equal(that interface{}) bool {
@@ -577,6 +695,14 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	if this.VisibilityArchivalUri != that1.VisibilityArchivalUri {
 		return false
 	}
+	if len(this.CustomSearchAttributeAliases) != len(that1.CustomSearchAttributeAliases) {
+		return false
+	}
+	for i := range this.CustomSearchAttributeAliases {
+		if this.CustomSearchAttributeAliases[i] != that1.CustomSearchAttributeAliases[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
@@ -637,7 +763,7 @@ func (this *NamespaceInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&persistence.NamespaceInfo{")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
@@ -657,6 +783,20 @@ func (this *NamespaceInfo) GoString() string {
 	if this.Data != nil {
 		s = append(s, "Data: "+mapStringForData+",\n")
 	}
+	if this.Aliases != nil {
+		s = append(s, "Aliases: "+fmt.Sprintf("%#v", this.Aliases)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceAlias) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.NamespaceAlias{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "ExpirationTime: "+fmt.Sprintf("%#v", this.ExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -664,7 +804,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceConfig{")
 	s = append(s, "Retention: "+fmt.Sprintf("%#v", this.Retention)+",\n")
 	s = append(s, "ArchivalBucket: "+fmt.Sprintf("%#v", this.ArchivalBucket)+",\n")
@@ -675,6 +815,19 @@ func (this *NamespaceConfig) GoString() string {
 	s = append(s, "HistoryArchivalUri: "+fmt.Sprintf("%#v", this.HistoryArchivalUri)+",\n")
 	s = append(s, "VisibilityArchivalState: "+fmt.Sprintf("%#v", this.VisibilityArchivalState)+",\n")
 	s = append(s, "VisibilityArchivalUri: "+fmt.Sprintf("%#v", this.VisibilityArchivalUri)+",\n")
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
+	if this.CustomSearchAttributeAliases != nil {
+		s = append(s, "CustomSearchAttributeAliases: "+mapStringForCustomSearchAttributeAliases+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -801,6 +954,20 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNamespaces(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Data) > 0 {
 		for k := range m.Data {
 			v := m.Data[k]
@@ -856,6 +1023,46 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintNamespaces(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NamespaceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -876,6 +1083,25 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k := range m.CustomSearchAttributeAliases {
+			v := m.CustomSearchAttributeAliases[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNamespaces(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.VisibilityArchivalUri) > 0 {
 		i -= len(m.VisibilityArchivalUri)
 		copy(dAtA[i:], m.VisibilityArchivalUri)
@@ -920,12 +1146,12 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1049,6 +1275,29 @@ func (m *NamespaceInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovNamespaces(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
 	return n
 }
 
@@ -1084,6 +1333,14 @@ func (m *NamespaceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNamespaces(uint64(l))
 	}
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k, v := range m.CustomSearchAttributeAliases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNamespaces(uint64(len(k))) + 1 + len(v) + sovNamespaces(uint64(len(v)))
+			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1132,6 +1389,11 @@ func (this *NamespaceInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAliases := "[]*NamespaceAlias{"
+	for _, f := range this.Aliases {
+		repeatedStringForAliases += strings.Replace(f.String(), "NamespaceAlias", "NamespaceAlias", 1) + ","
+	}
+	repeatedStringForAliases += "}"
 	keysForData := make([]string, 0, len(this.Data))
 	for k, _ := range this.Data {
 		keysForData = append(keysForData, k)
@@ -1149,6 +1411,18 @@ func (this *NamespaceInfo) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
 		`Data:` + mapStringForData + `,`,
+		`Aliases:` + repeatedStringForAliases + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceAlias) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceAlias{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1157,6 +1431,16 @@ func (this *NamespaceConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
 	s := strings.Join([]string{`&NamespaceConfig{`,
 		`Retention:` + strings.Replace(fmt.Sprintf("%v", this.Retention), "Duration", "types.Duration", 1) + `,`,
 		`ArchivalBucket:` + fmt.Sprintf("%v", this.ArchivalBucket) + `,`,
@@ -1165,6 +1449,7 @@ func (this *NamespaceConfig) String() string {
 		`HistoryArchivalUri:` + fmt.Sprintf("%v", this.HistoryArchivalUri) + `,`,
 		`VisibilityArchivalState:` + fmt.Sprintf("%v", this.VisibilityArchivalState) + `,`,
 		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
+		`CustomSearchAttributeAliases:` + mapStringForCustomSearchAttributeAliases + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1745,6 +2030,161 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, &NamespaceAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
@@ -2004,6 +2444,133 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributeAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributeAliases == nil {
+				m.CustomSearchAttributeAliases = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNamespaces
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNamespaces(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributeAliases[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
@@ -53,6 +53,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // data column
 type QueueMetadata struct {
 	ClusterAckLevels map[string]int64 `protobuf:"bytes,1,rep,name=cluster_ack_levels,json=clusterAckLevels,proto3" json:"cluster_ack_levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Only set for the dynamic config change log.
+	DynamicConfigSnapshot *DynamicConfigSnapshot `protobuf:"bytes,2,opt,name=dynamic_config_snapshot,json=dynamicConfigSnapshot,proto3" json:"dynamic_config_snapshot,omitempty"`
 }
 
 func (m *QueueMetadata) Reset()      { *m = QueueMetadata{} }
@@ -94,6 +96,13 @@ func (m *QueueMetadata) GetClusterAckLevels() map[string]int64 {
 	return nil
 }
 
+func (m *QueueMetadata) GetDynamicConfigSnapshot() *DynamicConfigSnapshot {
+	if m != nil {
+		return m.DynamicConfigSnapshot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueueMetadata)(nil), "temporal.server.api.persistence.v1.QueueMetadata")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.QueueMetadata.ClusterAckLevelsEntry")
@@ -104,25 +113,29 @@ func init() {
 }
 
 var fileDescriptor_2664d86473ff3b34 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2f, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0x2f, 0x48, 0x2d, 0x2a, 0xce, 0x2c, 0x2e, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0x4d, 0x8d, 0xcf, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x52, 0x82, 0x69, 0xd4, 0x83, 0x68, 0xd4, 0x4b, 0x2c, 0xc8, 0xd4, 0x43,
-	0xd2, 0xa8, 0x57, 0x66, 0xa8, 0x74, 0x9a, 0x91, 0x8b, 0x37, 0x10, 0xa4, 0xd9, 0x17, 0xaa, 0x57,
-	0xa8, 0x94, 0x4b, 0x28, 0x39, 0xa7, 0xb4, 0xb8, 0x24, 0xb5, 0x28, 0x3e, 0x31, 0x39, 0x3b, 0x3e,
-	0x27, 0xb5, 0x2c, 0x35, 0xa7, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0xc8, 0x5d, 0x8f, 0xb0,
-	0x91, 0x7a, 0x28, 0xc6, 0xe9, 0x39, 0x43, 0xcc, 0x72, 0x4c, 0xce, 0xf6, 0x01, 0x9b, 0xe4, 0x9a,
-	0x57, 0x52, 0x54, 0x19, 0x24, 0x90, 0x8c, 0x26, 0x2c, 0xe5, 0xcc, 0x25, 0x8a, 0x55, 0xa9, 0x90,
-	0x00, 0x17, 0x73, 0x76, 0x6a, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x88, 0x29, 0x24,
-	0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1c, 0x04, 0xe1,
-	0x58, 0x31, 0x59, 0x30, 0x3a, 0x65, 0x5d, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0xc3, 0x87,
-	0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x5f, 0x3c, 0x92, 0x63, 0xf8, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x49,
-	0xcf, 0x47, 0xf8, 0x2b, 0x33, 0x1f, 0x77, 0x30, 0x5b, 0x23, 0x71, 0x93, 0xd8, 0xc0, 0x81, 0x6c,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x87, 0x8a, 0x10, 0x9f, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x7b, 0x10, 0x4d, 0x3c, 0x62, 0x42, 0x1a, 0x89, 0x84, 0xe1, 0x42, 0x98, 0x98, 0xae,
+	0x01, 0x4d, 0xfc, 0x37, 0x29, 0x1a, 0x17, 0x1d, 0xac, 0x9b, 0x4b, 0x73, 0x1e, 0xaf, 0x58, 0x29,
+	0xbd, 0x72, 0x77, 0x6d, 0xc2, 0xe6, 0x47, 0xf0, 0x63, 0xf8, 0x29, 0x9c, 0x1d, 0x19, 0x19, 0xe5,
+	0x58, 0x1c, 0xf9, 0x08, 0x86, 0x16, 0x23, 0x18, 0x8c, 0x6c, 0xf7, 0xbe, 0xc9, 0xf3, 0x7b, 0x7e,
+	0x97, 0x17, 0x1f, 0x68, 0xe8, 0x45, 0x42, 0xb2, 0xc0, 0x51, 0x20, 0x13, 0x90, 0x0e, 0x8b, 0x7c,
+	0x27, 0x02, 0xa9, 0x7c, 0xa5, 0x21, 0xe4, 0xe0, 0x24, 0x0d, 0xa7, 0x1f, 0x43, 0x0c, 0x5e, 0x0f,
+	0x34, 0x6b, 0x33, 0xcd, 0x68, 0x24, 0x85, 0x16, 0x76, 0xed, 0x3b, 0x48, 0xb3, 0x20, 0x65, 0x91,
+	0x4f, 0x17, 0x82, 0x34, 0x69, 0x54, 0xd6, 0x81, 0xb7, 0x07, 0x21, 0xeb, 0xf9, 0xdc, 0xe3, 0x22,
+	0x7c, 0xf0, 0x3b, 0x19, 0xbc, 0xf6, 0x96, 0xc3, 0xdb, 0x37, 0xb3, 0xd6, 0xeb, 0x79, 0xa9, 0x1d,
+	0x63, 0x9b, 0x07, 0xb1, 0xd2, 0x20, 0x3d, 0xc6, 0xbb, 0x5e, 0x00, 0x09, 0x04, 0xaa, 0x8c, 0xaa,
+	0xf9, 0x7a, 0xa1, 0x79, 0x49, 0xff, 0x77, 0xa1, 0x4b, 0x38, 0xda, 0xca, 0x58, 0xa7, 0xbc, 0x7b,
+	0x95, 0x92, 0x2e, 0x42, 0x2d, 0x07, 0x6e, 0x91, 0xff, 0x5a, 0xdb, 0x7d, 0xbc, 0xbb, 0x2c, 0xe8,
+	0xa9, 0x90, 0x45, 0xea, 0x51, 0xe8, 0x72, 0xae, 0x8a, 0xea, 0x85, 0xe6, 0xd1, 0x3a, 0xdd, 0xe7,
+	0x19, 0xa2, 0x95, 0x12, 0x6e, 0xe7, 0x00, 0xb7, 0xd4, 0x5e, 0xb5, 0xae, 0xb4, 0x70, 0x69, 0xa5,
+	0x9d, 0x5d, 0xc4, 0xf9, 0x2e, 0x0c, 0xca, 0xa8, 0x8a, 0xea, 0x5b, 0xee, 0xec, 0x69, 0xef, 0xe0,
+	0x8d, 0x84, 0x05, 0x31, 0xa4, 0x2e, 0x79, 0x37, 0x1b, 0x8e, 0x73, 0x87, 0xe8, 0xec, 0x69, 0x38,
+	0x26, 0xd6, 0x68, 0x4c, 0xac, 0xe9, 0x98, 0xa0, 0x67, 0x43, 0xd0, 0xab, 0x21, 0xe8, 0xdd, 0x10,
+	0x34, 0x34, 0x04, 0x7d, 0x18, 0x82, 0x3e, 0x0d, 0xb1, 0xa6, 0x86, 0xa0, 0x97, 0x09, 0xb1, 0x86,
+	0x13, 0x62, 0x8d, 0x26, 0xc4, 0xba, 0xdb, 0xef, 0x88, 0x9f, 0xef, 0xf8, 0xe2, 0xef, 0xab, 0x9d,
+	0x2c, 0x8c, 0xf7, 0x9b, 0xe9, 0xcd, 0xf6, 0xbe, 0x06, 0x00, 0xc8, 0x9b, 0x65, 0xdb, 0x4b, 0x02,
+	0x00, 0x00,
 }
 
 func (this *QueueMetadata) Equal(that interface{}) bool {
@@ -152,13 +165,16 @@ func (this *QueueMetadata) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.DynamicConfigSnapshot.Equal(that1.DynamicConfigSnapshot) {
+		return false
+	}
 	return true
 }
 func (this *QueueMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&persistence.QueueMetadata{")
 	keysForClusterAckLevels := make([]string, 0, len(this.ClusterAckLevels))
 	for k, _ := range this.ClusterAckLevels {
@@ -173,6 +189,9 @@ func (this *QueueMetadata) GoString() string {
 	if this.ClusterAckLevels != nil {
 		s = append(s, "ClusterAckLevels: "+mapStringForClusterAckLevels+",\n")
 	}
+	if this.DynamicConfigSnapshot != nil {
+		s = append(s, "DynamicConfigSnapshot: "+fmt.Sprintf("%#v", this.DynamicConfigSnapshot)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -204,6 +223,18 @@ func (m *QueueMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicConfigSnapshot != nil {
+		{
+			size, err := m.DynamicConfigSnapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueueMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ClusterAckLevels) > 0 {
 		for k := range m.ClusterAckLevels {
 			v := m.ClusterAckLevels[k]
@@ -249,6 +280,10 @@ func (m *QueueMetadata) Size() (n int) {
 			n += mapEntrySize + 1 + sovQueueMetadata(uint64(mapEntrySize))
 		}
 	}
+	if m.DynamicConfigSnapshot != nil {
+		l = m.DynamicConfigSnapshot.Size()
+		n += 1 + l + sovQueueMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -274,6 +309,7 @@ func (this *QueueMetadata) String() string {
 	mapStringForClusterAckLevels += "}"
 	s := strings.Join([]string{`&QueueMetadata{`,
 		`ClusterAckLevels:` + mapStringForClusterAckLevels + `,`,
+		`DynamicConfigSnapshot:` + strings.Replace(fmt.Sprintf("%v", this.DynamicConfigSnapshot), "DynamicConfigSnapshot", "DynamicConfigSnapshot", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -428,6 +464,42 @@ func (m *QueueMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterAckLevels[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicConfigSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueueMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueueMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueueMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicConfigSnapshot == nil {
+				m.DynamicConfigSnapshot = &DynamicConfigSnapshot{}
+			}
+			if err := m.DynamicConfigSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueueMetadata(dAtA[iNdEx:])
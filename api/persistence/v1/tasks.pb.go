@@ -114,6 +114,7 @@ type TaskInfo struct {
 	ScheduleId  int64      `protobuf:"varint,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	CreateTime  *time.Time `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime  *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	Priority    int32      `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -190,6 +191,13 @@ func (m *TaskInfo) GetExpiryTime() *time.Time {
 	return nil
 }
 
+func (m *TaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -293,41 +301,42 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x6e, 0x13, 0x4b,
-	0x14, 0xc6, 0x77, 0x63, 0xc7, 0xb1, 0xc7, 0xf7, 0x46, 0x30, 0x12, 0xc2, 0x32, 0xd2, 0x38, 0xb1,
-	0x10, 0x4a, 0x81, 0x66, 0x95, 0x40, 0x81, 0x44, 0x83, 0xd3, 0x19, 0x68, 0x58, 0x99, 0x86, 0xc6,
-	0x9a, 0xec, 0x1c, 0x9b, 0x65, 0xd7, 0x33, 0xc3, 0xce, 0xac, 0x83, 0x3b, 0x1e, 0x80, 0x22, 0x8f,
-	0xc1, 0xa3, 0x50, 0xba, 0x4c, 0x07, 0x5e, 0x37, 0x74, 0xe4, 0x11, 0xd0, 0xcc, 0x66, 0x9d, 0x34,
-	0x08, 0x17, 0x74, 0x73, 0xfe, 0x7c, 0xdf, 0x39, 0xfe, 0x1d, 0x2f, 0xa2, 0x06, 0x66, 0x4a, 0x66,
-	0x2c, 0x0d, 0x34, 0x64, 0x73, 0xc8, 0x02, 0xa6, 0xe2, 0x40, 0x41, 0xa6, 0x63, 0x6d, 0x40, 0x44,
-	0x10, 0xcc, 0x8f, 0x03, 0xc3, 0x74, 0xa2, 0xa9, 0xca, 0xa4, 0x91, 0xb8, 0x5f, 0xf5, 0xd3, 0xb2,
-	0x9f, 0x32, 0x15, 0xd3, 0x5b, 0xfd, 0x74, 0x7e, 0xdc, 0xed, 0x4d, 0xa5, 0x9c, 0xa6, 0x10, 0x38,
-	0xc5, 0x59, 0x3e, 0x09, 0x4c, 0x3c, 0x03, 0x6d, 0xd8, 0x4c, 0x95, 0x26, 0xdd, 0x43, 0x0e, 0x0a,
-	0x04, 0x07, 0x11, 0xc5, 0xa0, 0x83, 0xa9, 0x9c, 0x4a, 0x97, 0x77, 0xaf, 0xeb, 0x96, 0x47, 0x9b,
-	0xbd, 0xec, 0x42, 0x20, 0xf2, 0x99, 0xae, 0x56, 0x19, 0x7f, 0xcc, 0x21, 0x87, 0xb2, 0xaf, 0x2f,
-	0xd0, 0xdd, 0x41, 0x9a, 0xca, 0x88, 0x19, 0xe0, 0x23, 0xa6, 0x93, 0xa1, 0x98, 0x48, 0xfc, 0x02,
-	0xd5, 0x39, 0x33, 0xac, 0xe3, 0x1f, 0xf8, 0x47, 0xed, 0x93, 0xc7, 0xf4, 0xef, 0x3b, 0xd3, 0x4a,
-	0x1b, 0x3a, 0x25, 0xbe, 0x8f, 0xf6, 0xdc, 0xa8, 0x98, 0x77, 0x76, 0x0e, 0xfc, 0xa3, 0x5a, 0xd8,
-	0xb0, 0xe1, 0x90, 0xf7, 0xbf, 0xec, 0xa0, 0xe6, 0x66, 0xce, 0x21, 0xfa, 0x4f, 0xb0, 0x19, 0x68,
-	0xc5, 0x22, 0xb0, 0xad, 0x76, 0x5e, 0x2b, 0x6c, 0x6f, 0x72, 0x43, 0x8e, 0x7b, 0xa8, 0x7d, 0x2e,
-	0xb3, 0x64, 0x92, 0xca, 0xf3, 0xca, 0xac, 0x15, 0xa2, 0x2a, 0x35, 0xe4, 0xf8, 0x1e, 0x6a, 0x64,
-	0xb9, 0xb0, 0xb5, 0x9a, 0xab, 0xed, 0x66, 0xb9, 0x28, 0x75, 0x3a, 0x7a, 0x0f, 0x3c, 0x4f, 0x9d,
-	0x73, 0xdd, 0x2d, 0x81, 0xaa, 0xd4, 0x90, 0xe3, 0x01, 0x6a, 0x47, 0x19, 0x30, 0x03, 0x63, 0x4b,
-	0xb7, 0xb3, 0xeb, 0x7e, 0x6a, 0x97, 0x96, 0xe8, 0x69, 0x85, 0x9e, 0x8e, 0x2a, 0xf4, 0xa7, 0xf5,
-	0x8b, 0xef, 0x3d, 0x3f, 0x44, 0xa5, 0xc8, 0xa6, 0xad, 0x05, 0x7c, 0x52, 0x71, 0xb6, 0x28, 0x2d,
-	0x1a, 0xdb, 0x5a, 0x94, 0x22, 0x9b, 0xee, 0xff, 0xda, 0x41, 0xff, 0x5b, 0x1c, 0x6f, 0xec, 0x49,
-	0xb6, 0x65, 0x82, 0x51, 0xdd, 0x86, 0xd7, 0x30, 0xdc, 0x1b, 0x0f, 0x50, 0xcb, 0x01, 0x37, 0x0b,
-	0x05, 0x8e, 0xc4, 0xfe, 0xc9, 0xc3, 0x9b, 0xbb, 0xd9, 0x83, 0xb9, 0xff, 0x40, 0x75, 0x2a, 0x37,
-	0x6f, 0xb4, 0x50, 0x10, 0x36, 0xad, 0xcc, 0xbe, 0xf0, 0x33, 0x54, 0x4f, 0x62, 0x51, 0xb2, 0xda,
-	0x42, 0xfd, 0x2a, 0x16, 0x3c, 0x74, 0x0a, 0xfc, 0x00, 0xb5, 0x58, 0x94, 0x8c, 0x53, 0x98, 0x43,
-	0xea, 0x48, 0xd6, 0xc2, 0x26, 0x8b, 0x92, 0xd7, 0x36, 0xfe, 0x07, 0x94, 0xf0, 0x4b, 0x74, 0x27,
-	0x65, 0xda, 0x8c, 0x73, 0xc5, 0x37, 0x07, 0xdb, 0xdb, 0xd2, 0x67, 0xdf, 0x2a, 0xdf, 0x3a, 0xa1,
-	0x2d, 0x9d, 0x7e, 0x58, 0xae, 0x88, 0x77, 0xb9, 0x22, 0xde, 0xd5, 0x8a, 0xf8, 0x9f, 0x0b, 0xe2,
-	0x7f, 0x2d, 0x88, 0xff, 0xad, 0x20, 0xfe, 0xb2, 0x20, 0xfe, 0x8f, 0x82, 0xf8, 0x3f, 0x0b, 0xe2,
-	0x5d, 0x15, 0xc4, 0xbf, 0x58, 0x13, 0x6f, 0xb9, 0x26, 0xde, 0xe5, 0x9a, 0x78, 0xef, 0x9e, 0x4e,
-	0xe5, 0x0d, 0x8f, 0x58, 0xfe, 0xf9, 0x63, 0x7f, 0x7e, 0x2b, 0x3c, 0x6b, 0xb8, 0xad, 0x9e, 0xfc,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xd6, 0x4b, 0x05, 0x25, 0x04, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x6e, 0x13, 0x4b,
+	0x14, 0xc6, 0x77, 0x12, 0xc7, 0xb1, 0xc7, 0xf7, 0x46, 0xb0, 0x12, 0xc2, 0x32, 0xd2, 0xc4, 0xb1,
+	0x10, 0x72, 0x81, 0x76, 0x95, 0x40, 0x81, 0x44, 0x83, 0xd3, 0x19, 0x68, 0x58, 0x99, 0x86, 0xc6,
+	0x9a, 0xec, 0x1c, 0x9b, 0x61, 0xd7, 0x3b, 0xc3, 0xcc, 0xac, 0x83, 0x3b, 0x1e, 0x21, 0x8f, 0x91,
+	0x47, 0xa1, 0x74, 0x99, 0x0e, 0xbc, 0x6e, 0xe8, 0xc8, 0x23, 0xa0, 0x99, 0xcd, 0x3a, 0x69, 0x10,
+	0x2e, 0xe8, 0xe6, 0xfc, 0xf9, 0xbe, 0x73, 0xfc, 0x3b, 0xd6, 0xe2, 0xc0, 0xc0, 0x4c, 0x0a, 0x45,
+	0xd3, 0x50, 0x83, 0x9a, 0x83, 0x0a, 0xa9, 0xe4, 0xa1, 0x04, 0xa5, 0xb9, 0x36, 0x90, 0xc5, 0x10,
+	0xce, 0x8f, 0x43, 0x43, 0x75, 0xa2, 0x03, 0xa9, 0x84, 0x11, 0x7e, 0xaf, 0xea, 0x0f, 0xca, 0xfe,
+	0x80, 0x4a, 0x1e, 0xdc, 0xe9, 0x0f, 0xe6, 0xc7, 0x9d, 0xc3, 0xa9, 0x10, 0xd3, 0x14, 0x42, 0xa7,
+	0x38, 0xcb, 0x27, 0xa1, 0xe1, 0x33, 0xd0, 0x86, 0xce, 0x64, 0x69, 0xd2, 0x39, 0x62, 0x20, 0x21,
+	0x63, 0x90, 0xc5, 0x1c, 0x74, 0x38, 0x15, 0x53, 0xe1, 0xf2, 0xee, 0x75, 0xd3, 0xf2, 0x64, 0xb3,
+	0x97, 0x5d, 0x08, 0xb2, 0x7c, 0xa6, 0xab, 0x55, 0xc6, 0x9f, 0x73, 0xc8, 0xa1, 0xec, 0xeb, 0x65,
+	0xf8, 0xfe, 0x20, 0x4d, 0x45, 0x4c, 0x0d, 0xb0, 0x11, 0xd5, 0xc9, 0x30, 0x9b, 0x08, 0xff, 0x15,
+	0xae, 0x31, 0x6a, 0x68, 0x1b, 0x75, 0x51, 0xbf, 0x75, 0xf2, 0x34, 0xf8, 0xfb, 0xce, 0x41, 0xa5,
+	0x8d, 0x9c, 0xd2, 0x7f, 0x88, 0xf7, 0xdd, 0x28, 0xce, 0xda, 0x3b, 0x5d, 0xd4, 0xdf, 0x8d, 0xea,
+	0x36, 0x1c, 0xb2, 0xde, 0xe5, 0x0e, 0x6e, 0x6c, 0xe6, 0x1c, 0xe1, 0xff, 0x32, 0x3a, 0x03, 0x2d,
+	0x69, 0x0c, 0xb6, 0xd5, 0xce, 0x6b, 0x46, 0xad, 0x4d, 0x6e, 0xc8, 0xfc, 0x43, 0xdc, 0x3a, 0x17,
+	0x2a, 0x99, 0xa4, 0xe2, 0xbc, 0x32, 0x6b, 0x46, 0xb8, 0x4a, 0x0d, 0x99, 0xff, 0x00, 0xd7, 0x55,
+	0x9e, 0xd9, 0xda, 0xae, 0xab, 0xed, 0xa9, 0x3c, 0x2b, 0x75, 0x3a, 0xfe, 0x08, 0x2c, 0x4f, 0x9d,
+	0x73, 0xcd, 0x2d, 0x81, 0xab, 0xd4, 0x90, 0xf9, 0x03, 0xdc, 0x8a, 0x15, 0x50, 0x03, 0x63, 0x4b,
+	0xb7, 0xbd, 0xe7, 0x7e, 0x6a, 0x27, 0x28, 0xd1, 0x07, 0x15, 0xfa, 0x60, 0x54, 0xa1, 0x3f, 0xad,
+	0x5d, 0x7c, 0x3f, 0x44, 0x11, 0x2e, 0x45, 0x36, 0x6d, 0x2d, 0xe0, 0x8b, 0xe4, 0x6a, 0x51, 0x5a,
+	0xd4, 0xb7, 0xb5, 0x28, 0x45, 0xce, 0xa2, 0x83, 0x1b, 0x52, 0x71, 0xa1, 0xb8, 0x59, 0xb4, 0xf7,
+	0xbb, 0xa8, 0xbf, 0x17, 0x6d, 0xe2, 0xde, 0xaf, 0x1d, 0xfc, 0xbf, 0x45, 0xf5, 0xce, 0x9e, 0x6b,
+	0x5b, 0x5e, 0x3e, 0xae, 0xd9, 0xf0, 0x06, 0x94, 0x7b, 0xfb, 0x03, 0xdc, 0x74, 0xc7, 0x30, 0x0b,
+	0x09, 0x8e, 0xd2, 0xc1, 0xc9, 0xe3, 0xdb, 0x9b, 0xda, 0x63, 0xba, 0xff, 0x47, 0x75, 0x46, 0x37,
+	0x6f, 0xb4, 0x90, 0x10, 0x35, 0xac, 0xcc, 0xbe, 0xfc, 0x17, 0xb8, 0x96, 0xf0, 0xac, 0xe4, 0xb8,
+	0x85, 0xfa, 0x0d, 0xcf, 0x58, 0xe4, 0x14, 0xfe, 0x23, 0xdc, 0xa4, 0x71, 0x32, 0x4e, 0x61, 0x0e,
+	0xa9, 0xa3, 0xbc, 0x1b, 0x35, 0x68, 0x9c, 0xbc, 0xb5, 0xf1, 0xbf, 0x20, 0xf8, 0x1a, 0xdf, 0x4b,
+	0xa9, 0x36, 0xe3, 0x5c, 0xb2, 0xcd, 0x31, 0xf7, 0xb7, 0xf4, 0x39, 0xb0, 0xca, 0xf7, 0x4e, 0x68,
+	0x4b, 0xa7, 0x9f, 0x96, 0x2b, 0xe2, 0x5d, 0xad, 0x88, 0x77, 0xbd, 0x22, 0xe8, 0x6b, 0x41, 0xd0,
+	0x65, 0x41, 0xd0, 0xb7, 0x82, 0xa0, 0x65, 0x41, 0xd0, 0x8f, 0x82, 0xa0, 0x9f, 0x05, 0xf1, 0xae,
+	0x0b, 0x82, 0x2e, 0xd6, 0xc4, 0x5b, 0xae, 0x89, 0x77, 0xb5, 0x26, 0xde, 0x87, 0xe7, 0x53, 0x71,
+	0xcb, 0x83, 0x8b, 0x3f, 0x7f, 0x08, 0x5e, 0xde, 0x09, 0xcf, 0xea, 0x6e, 0xab, 0x67, 0xbf, 0x07,
+	0x00, 0x9a, 0x9d, 0x28, 0xb9, 0x41, 0x04, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -402,6 +411,9 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -471,7 +483,7 @@ func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&persistence.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -479,6 +491,7 @@ func (this *TaskInfo) GoString() string {
 	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
 	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
 	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -566,6 +579,11 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.ExpiryTime != nil {
 		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
 		if err2 != nil {
@@ -743,6 +761,9 @@ func (m *TaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTasks(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -808,6 +829,7 @@ func (this *TaskInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1160,6 +1182,25 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -38,15 +38,17 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v14 "go.temporal.io/api/common/v1"
-	v15 "go.temporal.io/api/failure/v1"
-	v13 "go.temporal.io/api/history/v1"
+	v15 "go.temporal.io/api/common/v1"
+	v16 "go.temporal.io/api/failure/v1"
+	v14 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
-	v16 "go.temporal.io/server/api/history/v1"
+	v17 "go.temporal.io/server/api/history/v1"
+	v13 "go.temporal.io/server/api/persistence/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,13 +498,15 @@ func (m *ReplicationTaskInfo) GetScheduledId() int64 {
 }
 
 type NamespaceTaskAttributes struct {
-	NamespaceOperation v1.NamespaceOperation           `protobuf:"varint,1,opt,name=namespace_operation,json=namespaceOperation,proto3,enum=temporal.server.api.enums.v1.NamespaceOperation" json:"namespace_operation,omitempty"`
-	Id                 string                          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Info               *v11.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config             *v11.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	NamespaceOperation           v1.NamespaceOperation           `protobuf:"varint,1,opt,name=namespace_operation,json=namespaceOperation,proto3,enum=temporal.server.api.enums.v1.NamespaceOperation" json:"namespace_operation,omitempty"`
+	Id                           string                          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Info                         *v11.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config                       *v11.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig            *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion                int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion              int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	Aliases                      []*v13.NamespaceAlias           `protobuf:"bytes,8,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	CustomSearchAttributeAliases map[string]string               `protobuf:"bytes,9,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -586,6 +590,20 @@ func (m *NamespaceTaskAttributes) GetFailoverVersion() int64 {
 	return 0
 }
 
+func (m *NamespaceTaskAttributes) GetAliases() []*v13.NamespaceAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *NamespaceTaskAttributes) GetCustomSearchAttributeAliases() map[string]string {
+	if m != nil {
+		return m.CustomSearchAttributeAliases
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	TargetClusters []string     `protobuf:"bytes,1,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
 	NamespaceId    string       `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -594,8 +612,8 @@ type HistoryTaskAttributes struct {
 	FirstEventId   int64        `protobuf:"varint,5,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId    int64        `protobuf:"varint,6,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	Version        int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	History        *v13.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
-	NewRunHistory  *v13.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
+	History        *v14.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	NewRunHistory  *v14.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
 }
 
 func (m *HistoryTaskAttributes) Reset()      { *m = HistoryTaskAttributes{} }
@@ -679,14 +697,14 @@ func (m *HistoryTaskAttributes) GetVersion() int64 {
 	return 0
 }
 
-func (m *HistoryTaskAttributes) GetHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetHistory() *v14.History {
 	if m != nil {
 		return m.History
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetNewRunHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetNewRunHistory() *v14.History {
 	if m != nil {
 		return m.NewRunHistory
 	}
@@ -853,11 +871,11 @@ type SyncActivityTaskAttributes struct {
 	StartedId          int64               `protobuf:"varint,7,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
 	StartedTime        *time.Time          `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	LastHeartbeatTime  *time.Time          `protobuf:"bytes,9,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
-	Details            *v14.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	Details            *v15.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
 	Attempt            int32               `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure        *v15.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastFailure        *v16.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory     *v16.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory     *v17.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
@@ -955,7 +973,7 @@ func (m *SyncActivityTaskAttributes) GetLastHeartbeatTime() *time.Time {
 	return nil
 }
 
-func (m *SyncActivityTaskAttributes) GetDetails() *v14.Payloads {
+func (m *SyncActivityTaskAttributes) GetDetails() *v15.Payloads {
 	if m != nil {
 		return m.Details
 	}
@@ -969,7 +987,7 @@ func (m *SyncActivityTaskAttributes) GetAttempt() int32 {
 	return 0
 }
 
-func (m *SyncActivityTaskAttributes) GetLastFailure() *v15.Failure {
+func (m *SyncActivityTaskAttributes) GetLastFailure() *v16.Failure {
 	if m != nil {
 		return m.LastFailure
 	}
@@ -983,7 +1001,7 @@ func (m *SyncActivityTaskAttributes) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
+func (m *SyncActivityTaskAttributes) GetVersionHistory() *v17.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
@@ -996,10 +1014,10 @@ type HistoryTaskV2Attributes struct {
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                    `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	VersionHistoryItems []*v16.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
+	VersionHistoryItems []*v17.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v15.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	NewRunEvents *v15.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
@@ -1062,21 +1080,21 @@ func (m *HistoryTaskV2Attributes) GetRunId() string {
 	return ""
 }
 
-func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v16.VersionHistoryItem {
+func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v17.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetEvents() *v15.DataBlob {
 	if m != nil {
 		return m.Events
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v15.DataBlob {
 	if m != nil {
 		return m.NewRunEvents
 	}
@@ -1090,6 +1108,7 @@ func init() {
 	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
 	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes.CustomSearchAttributeAliasesEntry")
 	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
 	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
@@ -1102,101 +1121,109 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0xf2, 0xcd, 0xe1, 0xd3, 0xa3, 0xab, 0x2b, 0x8a, 0x80, 0x68, 0x89, 0xb0, 0xaf, 0xe5,
-	0x8b, 0x60, 0x69, 0x51, 0x45, 0x62, 0x3b, 0x08, 0x20, 0x39, 0x71, 0x44, 0x01, 0x76, 0x8c, 0xb5,
-	0x60, 0x03, 0x69, 0x36, 0x23, 0xee, 0x90, 0x5c, 0x88, 0xdc, 0x25, 0x66, 0x86, 0x54, 0x98, 0x2a,
-	0x40, 0x8a, 0x34, 0x09, 0xe0, 0xff, 0xe0, 0x20, 0x48, 0x95, 0xdf, 0xe1, 0xd2, 0x4d, 0x00, 0xa7,
-	0x4a, 0x4c, 0x37, 0x29, 0xdd, 0xa5, 0x0d, 0xe6, 0xb1, 0xe4, 0x2e, 0x97, 0xa4, 0x19, 0x07, 0xae,
-	0xd2, 0x71, 0xce, 0xe3, 0x3b, 0x33, 0x67, 0xce, 0x39, 0xdf, 0x2c, 0xc1, 0x0d, 0x86, 0x7b, 0x7d,
-	0x97, 0xa0, 0x6e, 0x8d, 0x62, 0x32, 0xc4, 0xa4, 0x86, 0xfa, 0x76, 0x8d, 0xe0, 0x7e, 0xd7, 0x6e,
-	0x22, 0x66, 0xbb, 0x4e, 0x6d, 0xb8, 0x5f, 0xeb, 0x61, 0x4a, 0x51, 0x1b, 0xeb, 0x7d, 0xe2, 0x32,
-	0x17, 0x56, 0x3d, 0x0f, 0x5d, 0x7a, 0xe8, 0xa8, 0x6f, 0xeb, 0x3e, 0x0f, 0x7d, 0xb8, 0x5f, 0xbe,
-	0xdc, 0x76, 0xdd, 0x76, 0x17, 0xd7, 0x84, 0xc7, 0xd9, 0xa0, 0x55, 0x63, 0x76, 0x0f, 0x53, 0x86,
-	0x7a, 0x7d, 0x09, 0x52, 0xde, 0xb5, 0x70, 0x1f, 0x3b, 0x16, 0x76, 0x9a, 0x36, 0xa6, 0xb5, 0xb6,
-	0xdb, 0x76, 0x85, 0x5c, 0xfc, 0x52, 0x26, 0xfa, 0xbc, 0x9d, 0x61, 0x67, 0xd0, 0xa3, 0x7c, 0x4f,
-	0xfe, 0x80, 0xd2, 0xfe, 0xda, 0x52, 0x7b, 0x86, 0xe8, 0xb9, 0x32, 0x7c, 0x6f, 0x9e, 0x61, 0xc7,
-	0xa6, 0xcc, 0x25, 0xa3, 0xd0, 0x71, 0xcb, 0x57, 0x26, 0xd6, 0xdc, 0xac, 0xe9, 0xf6, 0x7a, 0x73,
-	0x92, 0xe2, 0x0b, 0xce, 0xad, 0x1c, 0xd4, 0xc3, 0xb4, 0x8f, 0x9a, 0x38, 0x6c, 0x78, 0x3d, 0x60,
-	0xb8, 0x2c, 0xd1, 0xe5, 0xab, 0x01, 0xd3, 0x85, 0x1b, 0x0c, 0x9a, 0xb5, 0x90, 0xdd, 0x1d, 0x90,
-	0x70, 0xe0, 0xea, 0x8f, 0x49, 0x50, 0x30, 0xa6, 0xe1, 0x4e, 0x11, 0x3d, 0x87, 0xf7, 0x41, 0x9a,
-	0xe7, 0xc5, 0x64, 0xa3, 0x3e, 0x2e, 0x69, 0x3b, 0xda, 0x5e, 0xbe, 0xbe, 0xaf, 0xcf, 0xbb, 0x5e,
-	0x91, 0x46, 0x7d, 0xb8, 0xaf, 0xcf, 0x20, 0x9c, 0x8e, 0xfa, 0xd8, 0x48, 0x31, 0xf5, 0x0b, 0x5e,
-	0x01, 0x79, 0xea, 0x0e, 0x48, 0x13, 0x9b, 0x02, 0xd6, 0xb6, 0x4a, 0x91, 0x1d, 0x6d, 0x2f, 0x6a,
-	0x64, 0xa5, 0x94, 0x7b, 0x34, 0x2c, 0x38, 0x02, 0x5b, 0x93, 0x04, 0x49, 0x43, 0xc4, 0x18, 0xb1,
-	0xcf, 0x06, 0x0c, 0xd3, 0x52, 0x74, 0x47, 0xdb, 0xcb, 0xd4, 0x6f, 0xeb, 0x6f, 0x2e, 0x32, 0xfd,
-	0xbe, 0x07, 0xc2, 0x71, 0x0f, 0x27, 0x10, 0xc7, 0x6b, 0xc6, 0xa6, 0x33, 0x5f, 0x05, 0x29, 0xd8,
-	0x54, 0x79, 0x0c, 0x05, 0x8e, 0x89, 0xc0, 0x37, 0x57, 0x09, 0x7c, 0x2c, 0x21, 0x42, 0x61, 0x37,
-	0x3a, 0xf3, 0x14, 0xf0, 0x7b, 0x0d, 0xec, 0xd2, 0x91, 0xd3, 0x34, 0x69, 0x07, 0x11, 0xcb, 0xa4,
-	0x0c, 0xb1, 0x01, 0x0d, 0xc5, 0x8f, 0x8b, 0xf8, 0x87, 0xab, 0xc4, 0x7f, 0x38, 0x72, 0x9a, 0x0f,
-	0x39, 0xd6, 0x43, 0x01, 0x15, 0xda, 0xc7, 0x36, 0x5d, 0x66, 0x00, 0xbf, 0xd1, 0x80, 0xb0, 0x30,
-	0x51, 0x93, 0xd9, 0x43, 0x9b, 0x85, 0x73, 0x91, 0x10, 0x7b, 0xf9, 0x68, 0xd5, 0xbd, 0x1c, 0x2a,
-	0x9c, 0xd0, 0x46, 0xca, 0x74, 0xa1, 0x16, 0x7e, 0xa7, 0x81, 0x1d, 0xef, 0x2e, 0x7a, 0x98, 0x21,
-	0x0b, 0x31, 0x14, 0xda, 0x48, 0x72, 0xf5, 0xa4, 0xa8, 0x4b, 0xb9, 0xa7, 0xa0, 0xc2, 0x49, 0xe9,
-	0x2c, 0x33, 0x80, 0x5f, 0x81, 0x72, 0xa0, 0x32, 0x86, 0x75, 0xff, 0x3e, 0x52, 0xab, 0x57, 0xa5,
-	0xaf, 0x38, 0x1e, 0xd5, 0x83, 0x55, 0xd9, 0x99, 0xaf, 0x3a, 0xca, 0x02, 0x30, 0x8d, 0x55, 0x7d,
-	0xaa, 0x81, 0xa2, 0xbf, 0xcd, 0xdc, 0x73, 0xec, 0xc0, 0x2d, 0x90, 0x92, 0xd5, 0x63, 0x5b, 0xa2,
-	0x51, 0xe3, 0x46, 0x52, 0xac, 0x1b, 0x16, 0xbc, 0x09, 0xb6, 0xba, 0x88, 0x32, 0x93, 0x60, 0x46,
-	0x6c, 0x3c, 0xc4, 0x96, 0xa9, 0x1a, 0x7f, 0xda, 0x7f, 0xff, 0xe5, 0x06, 0x86, 0xa7, 0xbf, 0x27,
-	0xd5, 0x3e, 0xd7, 0x3e, 0x71, 0x9b, 0x98, 0xd2, 0xa0, 0x6b, 0x74, 0xea, 0xfa, 0xc0, 0xd3, 0x4f,
-	0x5c, 0xab, 0xa7, 0xa0, 0x30, 0x53, 0x86, 0xf0, 0x10, 0x64, 0xbc, 0xda, 0xb6, 0x7b, 0x72, 0x9e,
-	0x64, 0xea, 0x65, 0x5d, 0x52, 0x81, 0xee, 0x51, 0x81, 0x7e, 0xea, 0x51, 0xc1, 0x51, 0xec, 0xc9,
-	0x6f, 0x97, 0x35, 0x03, 0x48, 0x27, 0x2e, 0xae, 0xfe, 0x1c, 0x01, 0xeb, 0xbe, 0xb3, 0xab, 0x70,
-	0x14, 0x7e, 0x01, 0x2e, 0xf9, 0xd2, 0x2c, 0x6e, 0x88, 0x96, 0xb4, 0x9d, 0xe8, 0x5e, 0xa6, 0x7e,
-	0xb0, 0xca, 0xa5, 0xcc, 0x8c, 0x2d, 0xa3, 0x48, 0x82, 0x02, 0xfa, 0x4f, 0xb2, 0xb8, 0x05, 0x52,
-	0x1d, 0x44, 0xcd, 0x9e, 0x4b, 0xb0, 0x48, 0x5a, 0xca, 0x48, 0x76, 0x10, 0xbd, 0xe7, 0x12, 0x0c,
-	0x4d, 0x70, 0x29, 0xd4, 0xf9, 0x6a, 0xd2, 0x1c, 0xbc, 0x45, 0xa7, 0x1b, 0x85, 0x99, 0xce, 0xae,
-	0xfe, 0x12, 0x4c, 0x98, 0x98, 0xb0, 0x4e, 0xcb, 0x85, 0xbb, 0x20, 0x3b, 0x9d, 0xb1, 0xaa, 0x66,
-	0xd2, 0x46, 0x66, 0x22, 0x6b, 0x58, 0xf0, 0x32, 0xc8, 0x5c, 0xb8, 0xe4, 0xbc, 0xd5, 0x75, 0x2f,
-	0xbc, 0x33, 0xa6, 0x0d, 0xe0, 0x89, 0x1a, 0x16, 0xdc, 0x00, 0x09, 0x32, 0x70, 0xbc, 0x52, 0x48,
-	0x1b, 0x71, 0x32, 0x70, 0x1a, 0x16, 0xbc, 0xe3, 0x27, 0x8d, 0x98, 0x20, 0x8d, 0xff, 0x2d, 0x27,
-	0x8d, 0x39, 0x4c, 0xb1, 0x09, 0x92, 0x1e, 0x45, 0xc4, 0x45, 0x72, 0x13, 0x4c, 0x92, 0x43, 0x09,
-	0x24, 0x87, 0x98, 0x50, 0xdb, 0x75, 0xc4, 0x14, 0x8a, 0x1a, 0xde, 0x92, 0x93, 0x4b, 0xcb, 0x26,
-	0x94, 0x99, 0x78, 0x88, 0x1d, 0xc6, 0x3d, 0x93, 0x92, 0x5c, 0x84, 0xf4, 0x13, 0x2e, 0x6c, 0x58,
-	0xb0, 0x0a, 0x72, 0x0e, 0xfe, 0xd2, 0x67, 0x94, 0x12, 0x46, 0x19, 0x2e, 0xf4, 0x6c, 0x76, 0x41,
-	0x96, 0x36, 0x3b, 0xd8, 0x1a, 0x74, 0xb1, 0x68, 0xa8, 0xb4, 0x34, 0x99, 0xc8, 0x1a, 0x56, 0xf5,
-	0x59, 0x14, 0x6c, 0x2e, 0xe0, 0x17, 0x88, 0xc0, 0xfa, 0x34, 0xb7, 0x6e, 0x1f, 0x13, 0x91, 0x7a,
-	0xc5, 0x9f, 0x37, 0x96, 0xa7, 0x62, 0x82, 0xf9, 0x99, 0xe7, 0x67, 0x40, 0x27, 0x24, 0x83, 0x79,
-	0x10, 0x99, 0x5c, 0x49, 0xc4, 0xb6, 0xe0, 0x87, 0x20, 0x66, 0x3b, 0x2d, 0x57, 0xb1, 0xe3, 0xde,
-	0x34, 0x06, 0x07, 0x9f, 0xf8, 0x07, 0x02, 0xf0, 0x32, 0x30, 0x84, 0x17, 0x3c, 0x02, 0x89, 0xa6,
-	0xeb, 0xb4, 0xec, 0xb6, 0x2a, 0xbd, 0xff, 0xaf, 0xe2, 0x7f, 0x47, 0x78, 0x18, 0xca, 0x13, 0xb6,
-	0x00, 0xf4, 0x77, 0xa0, 0xc2, 0x93, 0xa4, 0xf5, 0x7e, 0x10, 0x6f, 0x11, 0x4d, 0xfb, 0xea, 0x54,
-	0x81, 0xfb, 0x9b, 0x5a, 0x8a, 0xe0, 0x55, 0x90, 0x97, 0xd8, 0x66, 0xb0, 0x0c, 0x72, 0x52, 0xfa,
-	0x48, 0x15, 0xc3, 0x75, 0x50, 0xe4, 0x2f, 0x1d, 0x77, 0x88, 0xc9, 0xc4, 0x50, 0x96, 0x43, 0xc1,
-	0x93, 0x2b, 0xd3, 0xea, 0xd3, 0x28, 0xd8, 0x98, 0xcb, 0xd8, 0xf0, 0x1a, 0x28, 0x30, 0x44, 0xda,
-	0x98, 0x99, 0xcd, 0xee, 0x80, 0x32, 0x4c, 0xe4, 0x4c, 0x49, 0x1b, 0x79, 0x29, 0xbe, 0xa3, 0xa4,
-	0xa1, 0x6e, 0x8a, 0xbc, 0xb1, 0x9b, 0xa2, 0x4b, 0xba, 0x29, 0xe6, 0xef, 0xa6, 0x70, 0x55, 0xc7,
-	0x57, 0xa9, 0xea, 0x44, 0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0c, 0x76, 0xce, 0x2d, 0x90, 0x54, 0xd4,
-	0x23, 0x4a, 0x3d, 0x53, 0xdf, 0x09, 0x5e, 0x98, 0x52, 0xfa, 0xd8, 0xcb, 0xf0, 0x1c, 0xe0, 0x31,
-	0x28, 0x38, 0xf8, 0xc2, 0xe4, 0x5b, 0xf7, 0x30, 0xc0, 0x8a, 0x18, 0x39, 0x07, 0x5f, 0x18, 0x03,
-	0x47, 0x2d, 0x4f, 0x62, 0xa9, 0x54, 0x31, 0x7d, 0x12, 0x4b, 0x65, 0x8a, 0xd9, 0x93, 0x58, 0x2a,
-	0x5b, 0xcc, 0x9d, 0xc4, 0x52, 0xb9, 0x62, 0xfe, 0x24, 0x96, 0xca, 0x17, 0x0b, 0xd5, 0x6f, 0x23,
-	0x60, 0x7b, 0x29, 0x85, 0xff, 0x5b, 0x6e, 0xab, 0xfa, 0x83, 0x06, 0xb6, 0x97, 0xbe, 0xf0, 0x78,
-	0x8f, 0xa8, 0x67, 0xb6, 0xca, 0x84, 0x1a, 0xef, 0x39, 0x29, 0x55, 0x89, 0x08, 0xbc, 0x19, 0x22,
-	0xc1, 0x37, 0xc3, 0x0c, 0x55, 0x47, 0xdf, 0x82, 0xaa, 0x7f, 0x8d, 0x83, 0xf2, 0xe2, 0xc7, 0xdf,
-	0xbb, 0x24, 0x20, 0x5f, 0xea, 0x62, 0xc1, 0x42, 0x9f, 0x1d, 0xec, 0xf1, 0xd0, 0x60, 0x87, 0x9f,
-	0x82, 0xfc, 0xd4, 0x44, 0x1c, 0x3e, 0xb1, 0xe2, 0xe1, 0x73, 0x13, 0x3f, 0xae, 0x81, 0xdb, 0x80,
-	0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x5a, 0x49, 0x04, 0x4b, 0x66, 0x3d, 0xb5, 0x88, 0x92,
-	0x5a, 0x31, 0x4a, 0x46, 0x79, 0x89, 0x18, 0x0f, 0xc0, 0xba, 0x78, 0x94, 0x74, 0x30, 0x22, 0xec,
-	0x0c, 0x23, 0x26, 0xb1, 0xd2, 0x2b, 0x62, 0x5d, 0xe2, 0xce, 0xc7, 0x9e, 0xaf, 0x40, 0xbc, 0x05,
-	0x92, 0x16, 0x66, 0xc8, 0xee, 0xd2, 0xf9, 0x6d, 0x2c, 0xbf, 0x6f, 0x79, 0x17, 0x3f, 0x40, 0xa3,
-	0xae, 0x8b, 0x2c, 0x6a, 0x78, 0x0e, 0x3c, 0xef, 0x88, 0x71, 0x6b, 0x56, 0xca, 0xc8, 0x72, 0x52,
-	0x4b, 0x7e, 0x58, 0xb1, 0x4f, 0xf5, 0xf1, 0x59, 0xca, 0xce, 0x83, 0x56, 0x4a, 0x8e, 0x7d, 0x57,
-	0xfe, 0x34, 0x32, 0xdc, 0x4b, 0x2d, 0xe0, 0x0d, 0xf0, 0x1f, 0x01, 0xc2, 0x0b, 0x00, 0x13, 0xd3,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x36, 0x2a, 0xe5, 0xc4, 0xdd, 0x43, 0xae, 0x7b, 0x2c, 0x54, 0x0d, 0xa5,
-	0x81, 0x8f, 0x41, 0x41, 0xdd, 0xfc, 0x64, 0x36, 0xe5, 0x45, 0x64, 0x7d, 0x2e, 0x09, 0xfb, 0x46,
-	0x94, 0xe2, 0x06, 0x6f, 0x52, 0xe5, 0x87, 0x81, 0x75, 0xf5, 0xcf, 0x08, 0xd8, 0x5c, 0xf0, 0x8e,
-	0xf7, 0xbf, 0x5c, 0xb4, 0xc0, 0xcb, 0xe5, 0x1d, 0x8e, 0x9d, 0x16, 0xd8, 0x98, 0x39, 0xa8, 0x69,
-	0x33, 0xdc, 0xe3, 0x1f, 0x8d, 0xfc, 0x09, 0x5c, 0xff, 0x7b, 0xc7, 0x6d, 0x30, 0xdc, 0x33, 0xd6,
-	0x87, 0x21, 0x19, 0x85, 0x1f, 0x80, 0x84, 0x98, 0x59, 0xde, 0x17, 0xe0, 0xc2, 0xe2, 0xf8, 0x18,
-	0x31, 0x74, 0xd4, 0x75, 0xcf, 0x0c, 0x65, 0x0f, 0xef, 0x82, 0xbc, 0x47, 0x13, 0x0a, 0x21, 0xb9,
-	0x22, 0x42, 0x56, 0xb2, 0x84, 0x98, 0x8b, 0xf4, 0xc8, 0x7e, 0xfe, 0xb2, 0xb2, 0xf6, 0xe2, 0x65,
-	0x65, 0xed, 0xf5, 0xcb, 0x8a, 0xf6, 0xf5, 0xb8, 0xa2, 0xfd, 0x34, 0xae, 0x68, 0xcf, 0xc6, 0x15,
-	0xed, 0xf9, 0xb8, 0xa2, 0xfd, 0x3e, 0xae, 0x68, 0x7f, 0x8c, 0x2b, 0x6b, 0xaf, 0xc7, 0x15, 0xed,
-	0xc9, 0xab, 0xca, 0xda, 0xf3, 0x57, 0x95, 0xb5, 0x17, 0xaf, 0x2a, 0x6b, 0x9f, 0x1f, 0xb4, 0xdd,
-	0x69, 0x1c, 0xdb, 0x5d, 0xfc, 0x57, 0xd6, 0x6d, 0x82, 0xfb, 0x6a, 0x75, 0x96, 0x10, 0x7d, 0x73,
-	0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb4, 0x0f, 0x2d, 0x02, 0x13, 0x00, 0x00,
+	// 1623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6f, 0x1b, 0x47,
+	0x12, 0xd6, 0xf0, 0xcd, 0xe6, 0x53, 0x2d, 0x6b, 0x45, 0x11, 0x2b, 0x4a, 0x22, 0xec, 0xb5, 0xbc,
+	0x58, 0x0c, 0x2d, 0xea, 0xb0, 0x7e, 0x2c, 0x16, 0x90, 0xb4, 0xf6, 0x8a, 0xc2, 0xfa, 0x81, 0x91,
+	0x60, 0x03, 0x0b, 0x04, 0x93, 0xd6, 0x4c, 0x93, 0x1c, 0x88, 0x9c, 0x21, 0xba, 0x9b, 0x54, 0x98,
+	0x53, 0x80, 0x1c, 0x72, 0x49, 0x02, 0xff, 0x07, 0x1b, 0x41, 0x4e, 0xf9, 0x1d, 0x39, 0xfa, 0x12,
+	0xc0, 0x39, 0x25, 0x96, 0x2f, 0x39, 0xfa, 0x96, 0x6b, 0xd0, 0x3d, 0x3d, 0xe4, 0x0c, 0x87, 0xa4,
+	0x09, 0x1b, 0x3e, 0xe5, 0xc6, 0xae, 0xaa, 0xef, 0xab, 0xee, 0xea, 0xea, 0xaa, 0x1a, 0x82, 0x9b,
+	0x0c, 0x77, 0x7b, 0x0e, 0x41, 0x9d, 0x1a, 0xc5, 0x64, 0x80, 0x49, 0x0d, 0xf5, 0xac, 0x1a, 0xc1,
+	0xbd, 0x8e, 0x65, 0x20, 0x66, 0x39, 0x76, 0x6d, 0xb0, 0x5b, 0xeb, 0x62, 0x4a, 0x51, 0x0b, 0xab,
+	0x3d, 0xe2, 0x30, 0x07, 0x56, 0x3d, 0x84, 0xea, 0x22, 0x54, 0xd4, 0xb3, 0x54, 0x1f, 0x42, 0x1d,
+	0xec, 0x96, 0x37, 0x5b, 0x8e, 0xd3, 0xea, 0xe0, 0x9a, 0x40, 0x9c, 0xf5, 0x9b, 0x35, 0x66, 0x75,
+	0x31, 0x65, 0xa8, 0xdb, 0x73, 0x49, 0xca, 0xdb, 0x26, 0xee, 0x61, 0xdb, 0xc4, 0xb6, 0x61, 0x61,
+	0x5a, 0x6b, 0x39, 0x2d, 0x47, 0xc8, 0xc5, 0x2f, 0x69, 0xa2, 0x4e, 0xdb, 0x19, 0xb6, 0xfb, 0x5d,
+	0xca, 0xf7, 0xe4, 0x77, 0xe8, 0xda, 0x5f, 0x9f, 0x6b, 0xcf, 0x10, 0x3d, 0x97, 0x86, 0xff, 0x98,
+	0x66, 0xd8, 0xb6, 0x28, 0x73, 0xc8, 0x30, 0x74, 0xdc, 0xf2, 0xde, 0x34, 0xeb, 0x1e, 0x26, 0xd4,
+	0xa2, 0x0c, 0xdb, 0x06, 0xe6, 0x08, 0x1b, 0x75, 0x31, 0xed, 0x21, 0x03, 0x53, 0x09, 0xba, 0x3a,
+	0x02, 0x71, 0x6b, 0xc3, 0xe9, 0x76, 0xa7, 0x44, 0xb2, 0x7c, 0x3d, 0x60, 0x35, 0x22, 0x09, 0x1b,
+	0xde, 0x08, 0x18, 0xce, 0xbb, 0x9d, 0xf2, 0xb5, 0x80, 0xe9, 0xcc, 0x53, 0x05, 0xcd, 0x9a, 0xc8,
+	0xea, 0xf4, 0x49, 0xd8, 0x71, 0xf5, 0xbb, 0x24, 0x28, 0x68, 0x63, 0x77, 0xa7, 0x88, 0x9e, 0xc3,
+	0x87, 0x20, 0xcd, 0x83, 0xa9, 0xb3, 0x61, 0x0f, 0x97, 0x94, 0x2d, 0x65, 0x27, 0x5f, 0xdf, 0x55,
+	0xa7, 0xe5, 0x84, 0x88, 0xbd, 0x3a, 0xd8, 0x55, 0x27, 0x18, 0x4e, 0x87, 0x3d, 0xac, 0xa5, 0x98,
+	0xfc, 0x05, 0xaf, 0x82, 0x3c, 0x75, 0xfa, 0xc4, 0xc0, 0xba, 0xa0, 0xb5, 0xcc, 0x52, 0x64, 0x4b,
+	0xd9, 0x89, 0x6a, 0x59, 0x57, 0xca, 0x11, 0x0d, 0x13, 0x0e, 0xc1, 0xfa, 0x28, 0x40, 0xae, 0x21,
+	0x62, 0x8c, 0x58, 0x67, 0x7d, 0x86, 0x69, 0x29, 0xba, 0xa5, 0xec, 0x64, 0xea, 0x77, 0xd5, 0x77,
+	0x67, 0xa6, 0xfa, 0xd0, 0x23, 0xe1, 0xbc, 0xfb, 0x23, 0x8a, 0xa3, 0x25, 0x6d, 0xcd, 0x9e, 0xae,
+	0x82, 0x14, 0xac, 0xc9, 0x38, 0x86, 0x1c, 0xc7, 0x84, 0xe3, 0xdb, 0x8b, 0x38, 0x3e, 0x72, 0x29,
+	0x42, 0x6e, 0x57, 0xdb, 0xd3, 0x14, 0xf0, 0x1b, 0x05, 0x6c, 0xd3, 0xa1, 0x6d, 0xe8, 0xb4, 0x8d,
+	0x88, 0xa9, 0x53, 0x86, 0x58, 0x9f, 0x86, 0xfc, 0xc7, 0x85, 0xff, 0xfd, 0x45, 0xfc, 0x9f, 0x0c,
+	0x6d, 0xe3, 0x84, 0x73, 0x9d, 0x08, 0xaa, 0xd0, 0x3e, 0x36, 0xe8, 0x3c, 0x03, 0xf8, 0xa5, 0x02,
+	0x84, 0x85, 0x8e, 0x0c, 0x66, 0x0d, 0x2c, 0x16, 0x8e, 0x45, 0x42, 0xec, 0xe5, 0xdf, 0x8b, 0xee,
+	0x65, 0x5f, 0xf2, 0x84, 0x36, 0x52, 0xa6, 0x33, 0xb5, 0xf0, 0x6b, 0x05, 0x6c, 0x79, 0x77, 0xd1,
+	0xc5, 0x0c, 0x99, 0x88, 0xa1, 0xd0, 0x46, 0x92, 0x8b, 0x07, 0x45, 0x5e, 0xca, 0x03, 0x49, 0x15,
+	0x0e, 0x4a, 0x7b, 0x9e, 0x01, 0xfc, 0x1c, 0x94, 0x03, 0x99, 0x31, 0xa8, 0xfb, 0xf7, 0x91, 0x5a,
+	0x3c, 0x2b, 0x7d, 0xc9, 0xf1, 0xa4, 0x1e, 0xcc, 0xca, 0xf6, 0x74, 0xd5, 0x41, 0x16, 0x80, 0xb1,
+	0xaf, 0xea, 0x73, 0x05, 0x14, 0xfd, 0xcf, 0xcc, 0x39, 0xc7, 0x36, 0x5c, 0x07, 0x29, 0x37, 0x7b,
+	0x2c, 0x53, 0x3c, 0xd4, 0xb8, 0x96, 0x14, 0xeb, 0x86, 0x09, 0x6f, 0x83, 0xf5, 0x0e, 0xa2, 0x4c,
+	0x27, 0x98, 0x11, 0x0b, 0x0f, 0xb0, 0xa9, 0xcb, 0x87, 0x3f, 0x7e, 0x7f, 0x7f, 0xe1, 0x06, 0x9a,
+	0xa7, 0x7f, 0xe0, 0xaa, 0x7d, 0xd0, 0x1e, 0x71, 0x0c, 0x4c, 0x69, 0x10, 0x1a, 0x1d, 0x43, 0x1f,
+	0x7b, 0xfa, 0x11, 0xb4, 0x7a, 0x0a, 0x0a, 0x13, 0x69, 0x08, 0xf7, 0x41, 0xc6, 0xcb, 0x6d, 0xab,
+	0xeb, 0xd6, 0x93, 0x4c, 0xbd, 0xac, 0xba, 0xfd, 0x43, 0xf5, 0xfa, 0x87, 0x7a, 0xea, 0xf5, 0x8f,
+	0x83, 0xd8, 0xb3, 0x5f, 0x36, 0x15, 0x0d, 0xb8, 0x20, 0x2e, 0xae, 0xfe, 0x10, 0x01, 0x2b, 0xbe,
+	0xb3, 0x4b, 0x77, 0x14, 0x7e, 0x0a, 0x96, 0x7d, 0x61, 0x16, 0x37, 0x44, 0x4b, 0xca, 0x56, 0x74,
+	0x27, 0x53, 0xdf, 0x5b, 0xe4, 0x52, 0x26, 0xca, 0x96, 0x56, 0x24, 0x41, 0x01, 0xfd, 0x90, 0x28,
+	0xae, 0x83, 0x54, 0x1b, 0x51, 0xbd, 0xeb, 0x10, 0x2c, 0x82, 0x96, 0xd2, 0x92, 0x6d, 0x44, 0x1f,
+	0x38, 0x04, 0x43, 0x1d, 0x2c, 0x87, 0x5e, 0xbe, 0xac, 0x34, 0x7b, 0xef, 0xf1, 0xd2, 0xb5, 0xc2,
+	0xc4, 0xcb, 0xae, 0xfe, 0x14, 0x0c, 0x98, 0xa8, 0xb0, 0x76, 0xd3, 0x81, 0xdb, 0x20, 0x3b, 0xae,
+	0xb1, 0x32, 0x67, 0xd2, 0x5a, 0x66, 0x24, 0x6b, 0x98, 0x70, 0x13, 0x64, 0x2e, 0x1c, 0x72, 0xde,
+	0xec, 0x38, 0x17, 0xde, 0x19, 0xd3, 0x1a, 0xf0, 0x44, 0x0d, 0x13, 0xae, 0x82, 0x04, 0xe9, 0xdb,
+	0x5e, 0x2a, 0xa4, 0xb5, 0x38, 0xe9, 0xdb, 0x0d, 0x13, 0x1e, 0xfa, 0x9b, 0x46, 0x4c, 0x34, 0x8d,
+	0xbf, 0xcd, 0x6f, 0x1a, 0x53, 0x3a, 0xc5, 0x1a, 0x48, 0x7a, 0x2d, 0x22, 0x2e, 0x82, 0x9b, 0x60,
+	0x6e, 0x73, 0x28, 0x81, 0xe4, 0x00, 0x13, 0x6a, 0x39, 0xb6, 0xa8, 0x42, 0x51, 0xcd, 0x5b, 0xf2,
+	0xe6, 0xd2, 0xb4, 0x08, 0x65, 0x3a, 0x1e, 0x60, 0x9b, 0x71, 0x64, 0xd2, 0x6d, 0x2e, 0x42, 0x7a,
+	0x8f, 0x0b, 0x1b, 0x26, 0xac, 0x82, 0x9c, 0x8d, 0x3f, 0xf3, 0x19, 0xa5, 0x84, 0x51, 0x86, 0x0b,
+	0x3d, 0x9b, 0x6d, 0x90, 0xa5, 0x46, 0x1b, 0x9b, 0xfd, 0x0e, 0x16, 0x0f, 0x2a, 0xed, 0x9a, 0x8c,
+	0x64, 0x0d, 0xb3, 0xfa, 0x6d, 0x02, 0xac, 0xcd, 0xe8, 0x2f, 0x10, 0x81, 0x95, 0x71, 0x6c, 0x9d,
+	0x1e, 0x26, 0x22, 0xf4, 0xb2, 0x7f, 0xde, 0x9c, 0x1f, 0x8a, 0x11, 0xe7, 0x23, 0x0f, 0xa7, 0x41,
+	0x3b, 0x24, 0x83, 0x79, 0x10, 0x19, 0x5d, 0x49, 0xc4, 0x32, 0xe1, 0xbf, 0x40, 0xcc, 0xb2, 0x9b,
+	0x8e, 0xec, 0x8e, 0x3b, 0x63, 0x1f, 0x9c, 0x7c, 0x84, 0x0f, 0x38, 0xe0, 0x69, 0xa0, 0x09, 0x14,
+	0x3c, 0x00, 0x09, 0xc3, 0xb1, 0x9b, 0x56, 0x4b, 0xa6, 0xde, 0xdf, 0x17, 0xc1, 0x1f, 0x0a, 0x84,
+	0x26, 0x91, 0xb0, 0x09, 0xa0, 0xff, 0x05, 0x4a, 0x3e, 0xb7, 0x69, 0xfd, 0x33, 0xc8, 0x37, 0xab,
+	0x4d, 0xfb, 0xf2, 0x54, 0x92, 0x2f, 0x93, 0x49, 0x11, 0xbc, 0x06, 0xf2, 0x2e, 0xb7, 0x1e, 0x4c,
+	0x83, 0x9c, 0x2b, 0x7d, 0x22, 0x93, 0xe1, 0x06, 0x28, 0xf2, 0x49, 0xc7, 0x19, 0x60, 0x32, 0x32,
+	0x74, 0xd3, 0xa1, 0xe0, 0xc9, 0x3d, 0xd3, 0xff, 0x81, 0x24, 0xea, 0x58, 0x88, 0x8a, 0x32, 0xce,
+	0x2b, 0x46, 0x7d, 0xea, 0x15, 0xf9, 0xe6, 0xc0, 0xc0, 0xae, 0xf7, 0x39, 0x56, 0xf3, 0x28, 0xe0,
+	0x0b, 0x05, 0x6c, 0x1a, 0x7d, 0xca, 0x9c, 0xae, 0x4e, 0x31, 0x22, 0x46, 0x7b, 0xdc, 0x26, 0x74,
+	0xcf, 0x4d, 0x5a, 0xb8, 0xf9, 0xe4, 0x03, 0x66, 0x18, 0xf5, 0x50, 0xb8, 0x38, 0x11, 0x1e, 0x46,
+	0xe2, 0x7d, 0x97, 0xff, 0x9e, 0xcd, 0xc8, 0x50, 0xfb, 0xab, 0x31, 0xc7, 0xa4, 0xfc, 0x08, 0x6c,
+	0xbf, 0x93, 0x02, 0x16, 0x41, 0xf4, 0x1c, 0x0f, 0x65, 0x6d, 0xe0, 0x3f, 0xe1, 0x15, 0x10, 0x1f,
+	0xa0, 0x4e, 0x1f, 0xcb, 0xd4, 0x73, 0x17, 0x77, 0x22, 0xb7, 0x94, 0xea, 0xf3, 0x28, 0x58, 0x9d,
+	0x3a, 0xf7, 0xc0, 0xeb, 0xa0, 0xc0, 0x10, 0x69, 0x61, 0xa6, 0x1b, 0x9d, 0x3e, 0x65, 0x98, 0xb8,
+	0x95, 0x39, 0xad, 0xe5, 0x5d, 0xf1, 0xa1, 0x94, 0x86, 0x6a, 0x52, 0xe4, 0x9d, 0x35, 0x29, 0x3a,
+	0xa7, 0x26, 0xc5, 0xfc, 0x35, 0x29, 0x5c, 0x1b, 0xe2, 0x8b, 0xd4, 0x86, 0x44, 0xb8, 0x36, 0xf8,
+	0xea, 0x4f, 0x32, 0x58, 0x7f, 0xee, 0x80, 0xa4, 0x6c, 0xe0, 0xa2, 0x60, 0x64, 0xea, 0x5b, 0xc1,
+	0xb4, 0x97, 0x4a, 0xdf, 0x0c, 0xa0, 0x79, 0x00, 0x78, 0x04, 0x0a, 0x36, 0xbe, 0xd0, 0xf9, 0xd6,
+	0x3d, 0x0e, 0xb0, 0x20, 0x47, 0xce, 0xc6, 0x17, 0x5a, 0xdf, 0x96, 0xcb, 0xe3, 0x58, 0x2a, 0x55,
+	0x4c, 0x1f, 0xc7, 0x52, 0x99, 0x62, 0xf6, 0x38, 0x96, 0xca, 0x16, 0x73, 0xc7, 0xb1, 0x54, 0xae,
+	0x98, 0x3f, 0x8e, 0xa5, 0xf2, 0xc5, 0x42, 0xf5, 0xab, 0x08, 0xd8, 0x98, 0x3b, 0x08, 0xfd, 0x59,
+	0x6e, 0xab, 0xfa, 0x42, 0x01, 0x1b, 0x73, 0xe7, 0x64, 0x5e, 0x69, 0xe4, 0xc7, 0x8a, 0x8c, 0x84,
+	0x7c, 0x08, 0x39, 0x57, 0x2a, 0x03, 0x11, 0x98, 0xbc, 0x22, 0xc1, 0xc9, 0x6b, 0x62, 0xe0, 0x89,
+	0xbe, 0xc7, 0xc0, 0xf3, 0x73, 0x1c, 0x94, 0x67, 0x8f, 0xd0, 0x1f, 0xb3, 0x8d, 0xfb, 0x42, 0x17,
+	0x0b, 0x26, 0xfa, 0x64, 0x7b, 0x8c, 0x87, 0xda, 0x23, 0xfc, 0x2f, 0xc8, 0x8f, 0x4d, 0xc4, 0xe1,
+	0x13, 0x0b, 0x1e, 0x3e, 0x37, 0xc2, 0x71, 0x0d, 0xdc, 0x00, 0x3c, 0x1a, 0x84, 0xb9, 0x9e, 0xdc,
+	0x3b, 0x4c, 0x4b, 0x89, 0x98, 0x35, 0xb2, 0x9e, 0x5a, 0x78, 0x49, 0x2d, 0xe8, 0x25, 0x23, 0x51,
+	0xc2, 0xc7, 0x63, 0xb0, 0x22, 0x46, 0xbb, 0x36, 0x46, 0x84, 0x9d, 0x61, 0xc4, 0x5c, 0xae, 0xf4,
+	0x82, 0x5c, 0xcb, 0x1c, 0x7c, 0xe4, 0x61, 0x05, 0xe3, 0x1d, 0x90, 0x34, 0x31, 0x43, 0x56, 0x87,
+	0x4e, 0x7f, 0xc6, 0xee, 0xbf, 0x04, 0xfc, 0x15, 0x3f, 0x46, 0xc3, 0x8e, 0x83, 0x4c, 0xaa, 0x79,
+	0x00, 0x1e, 0x77, 0xc4, 0xb8, 0x35, 0x2b, 0x65, 0xdc, 0x74, 0x92, 0x4b, 0x7e, 0x58, 0xb1, 0x4f,
+	0xf9, 0x09, 0x5f, 0xca, 0x4e, 0xa3, 0x96, 0x4a, 0xce, 0x7d, 0xdf, 0xfd, 0xa9, 0x65, 0x38, 0x4a,
+	0x2e, 0xe0, 0x4d, 0x70, 0x45, 0x90, 0xf0, 0x04, 0xc0, 0x44, 0xb7, 0x4c, 0x6c, 0x33, 0x8b, 0x0d,
+	0x4b, 0x39, 0x71, 0xf7, 0x90, 0xeb, 0x9e, 0x0a, 0x55, 0x43, 0x6a, 0xe0, 0x53, 0x50, 0x90, 0x37,
+	0x3f, 0xaa, 0x4d, 0x79, 0xe1, 0x59, 0x9d, 0xda, 0xc0, 0x7c, 0x25, 0x4a, 0x76, 0x58, 0xaf, 0x52,
+	0xe5, 0x07, 0x81, 0x75, 0xf5, 0xf7, 0x08, 0x58, 0x9b, 0xf1, 0x35, 0xe4, 0x9f, 0xff, 0x94, 0xc0,
+	0xfc, 0xf7, 0x11, 0xcb, 0x4e, 0x13, 0xac, 0x4e, 0x1c, 0x54, 0xb7, 0x18, 0xee, 0xf2, 0x4f, 0xef,
+	0xd9, 0x63, 0xc1, 0xcc, 0xe3, 0x36, 0x18, 0xee, 0x6a, 0x2b, 0x83, 0x90, 0x8c, 0xc2, 0x5b, 0x20,
+	0x21, 0x6a, 0x96, 0xf7, 0x1d, 0x3d, 0x33, 0x39, 0xfe, 0x83, 0x18, 0x3a, 0xe8, 0x38, 0x67, 0x9a,
+	0xb4, 0x87, 0xf7, 0x41, 0xde, 0x6b, 0x13, 0x92, 0x21, 0xb9, 0x20, 0x43, 0xd6, 0xed, 0x12, 0xa2,
+	0x2e, 0xd2, 0x03, 0xeb, 0xe5, 0xeb, 0xca, 0xd2, 0xab, 0xd7, 0x95, 0xa5, 0xb7, 0xaf, 0x2b, 0xca,
+	0x17, 0x97, 0x15, 0xe5, 0xfb, 0xcb, 0x8a, 0xf2, 0xe3, 0x65, 0x45, 0x79, 0x79, 0x59, 0x51, 0x7e,
+	0xbd, 0xac, 0x28, 0xbf, 0x5d, 0x56, 0x96, 0xde, 0x5e, 0x56, 0x94, 0x67, 0x6f, 0x2a, 0x4b, 0x2f,
+	0xdf, 0x54, 0x96, 0x5e, 0xbd, 0xa9, 0x2c, 0xfd, 0x7f, 0xaf, 0xe5, 0x8c, 0xfd, 0x58, 0xce, 0xec,
+	0x7f, 0x11, 0xef, 0x12, 0xdc, 0x93, 0xab, 0xb3, 0x84, 0x78, 0x37, 0x7b, 0x7f, 0x0c, 0x00, 0xc6,
+	0x57, 0x71, 0x63, 0x7d, 0x14, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1563,6 +1590,22 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 	if this.FailoverVersion != that1.FailoverVersion {
 		return false
 	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if !this.Aliases[i].Equal(that1.Aliases[i]) {
+			return false
+		}
+	}
+	if len(this.CustomSearchAttributeAliases) != len(that1.CustomSearchAttributeAliases) {
+		return false
+	}
+	for i := range this.CustomSearchAttributeAliases {
+		if this.CustomSearchAttributeAliases[i] != that1.CustomSearchAttributeAliases[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1944,7 +1987,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -1959,6 +2002,22 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	}
 	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	if this.Aliases != nil {
+		s = append(s, "Aliases: "+fmt.Sprintf("%#v", this.Aliases)+",\n")
+	}
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
+	if this.CustomSearchAttributeAliases != nil {
+		s = append(s, "CustomSearchAttributeAliases: "+mapStringForCustomSearchAttributeAliases+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2468,6 +2527,39 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k := range m.CustomSearchAttributeAliases {
+			v := m.CustomSearchAttributeAliases[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.FailoverVersion != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
 		i--
@@ -3185,6 +3277,20 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 	if m.FailoverVersion != 0 {
 		n += 1 + sovMessage(uint64(m.FailoverVersion))
 	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k, v := range m.CustomSearchAttributeAliases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3527,6 +3633,21 @@ func (this *NamespaceTaskAttributes) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAliases := "[]*NamespaceAlias{"
+	for _, f := range this.Aliases {
+		repeatedStringForAliases += strings.Replace(fmt.Sprintf("%v", f), "NamespaceAlias", "v13.NamespaceAlias", 1) + ","
+	}
+	repeatedStringForAliases += "}"
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
 	s := strings.Join([]string{`&NamespaceTaskAttributes{`,
 		`NamespaceOperation:` + fmt.Sprintf("%v", this.NamespaceOperation) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
@@ -3535,6 +3656,8 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`Aliases:` + repeatedStringForAliases + `,`,
+		`CustomSearchAttributeAliases:` + mapStringForCustomSearchAttributeAliases + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3551,8 +3674,8 @@ func (this *HistoryTaskAttributes) String() string {
 		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v13.History", 1) + `,`,
-		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v13.History", 1) + `,`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v14.History", 1) + `,`,
+		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v14.History", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3599,11 +3722,11 @@ func (this *SyncActivityTaskAttributes) String() string {
 		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v15.Payloads", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v15.Failure", 1) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v16.Failure", 1) + `,`,
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v17.VersionHistory", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3614,7 +3737,7 @@ func (this *HistoryTaskV2Attributes) String() string {
 	}
 	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
 	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v16.VersionHistoryItem", 1) + ","
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v17.VersionHistoryItem", 1) + ","
 	}
 	repeatedStringForVersionHistoryItems += "}"
 	s := strings.Join([]string{`&HistoryTaskV2Attributes{`,
@@ -3623,8 +3746,8 @@ func (this *HistoryTaskV2Attributes) String() string {
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
 		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v15.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v15.DataBlob", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4788,6 +4911,167 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, &v13.NamespaceAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributeAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributeAliases == nil {
+				m.CustomSearchAttributeAliases = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributeAliases[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -5056,7 +5340,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.History == nil {
-				m.History = &v13.History{}
+				m.History = &v14.History{}
 			}
 			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5092,7 +5376,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunHistory == nil {
-				m.NewRunHistory = &v13.History{}
+				m.NewRunHistory = &v14.History{}
 			}
 			if err := m.NewRunHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5820,7 +6104,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+				m.Details = &v15.Payloads{}
 			}
 			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5875,7 +6159,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastFailure == nil {
-				m.LastFailure = &v15.Failure{}
+				m.LastFailure = &v16.Failure{}
 			}
 			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5943,7 +6227,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistory == nil {
-				m.VersionHistory = &v16.VersionHistory{}
+				m.VersionHistory = &v17.VersionHistory{}
 			}
 			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6146,7 +6430,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v16.VersionHistoryItem{})
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
 			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6181,7 +6465,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
+				m.Events = &v15.DataBlob{}
 			}
 			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6217,7 +6501,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+				m.NewRunEvents = &v15.DataBlob{}
 			}
 			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
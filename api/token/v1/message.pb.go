@@ -35,8 +35,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/server/api/history/v1"
 )
 
@@ -44,6 +48,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -407,11 +412,73 @@ func (m *QueryTask) GetTaskId() string {
 	return ""
 }
 
+type ShardQueuesContinuation struct {
+	// Index of the queue the next page starts with.
+	QueueIndex int32 `protobuf:"varint,1,opt,name=queue_index,json=queueIndex,proto3" json:"queue_index,omitempty"`
+	// Key of the last outstanding task of the queue returned by the previous page.
+	TaskId         int64      `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *time.Time `protobuf:"bytes,3,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+}
+
+func (m *ShardQueuesContinuation) Reset()      { *m = ShardQueuesContinuation{} }
+func (*ShardQueuesContinuation) ProtoMessage() {}
+func (*ShardQueuesContinuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_020fff7d28118bec, []int{4}
+}
+func (m *ShardQueuesContinuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardQueuesContinuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardQueuesContinuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardQueuesContinuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardQueuesContinuation.Merge(m, src)
+}
+func (m *ShardQueuesContinuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardQueuesContinuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardQueuesContinuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardQueuesContinuation proto.InternalMessageInfo
+
+func (m *ShardQueuesContinuation) GetQueueIndex() int32 {
+	if m != nil {
+		return m.QueueIndex
+	}
+	return 0
+}
+
+func (m *ShardQueuesContinuation) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ShardQueuesContinuation) GetVisibilityTime() *time.Time {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HistoryContinuation)(nil), "temporal.server.api.token.v1.HistoryContinuation")
 	proto.RegisterType((*RawHistoryContinuation)(nil), "temporal.server.api.token.v1.RawHistoryContinuation")
 	proto.RegisterType((*Task)(nil), "temporal.server.api.token.v1.Task")
 	proto.RegisterType((*QueryTask)(nil), "temporal.server.api.token.v1.QueryTask")
+	proto.RegisterType((*ShardQueuesContinuation)(nil), "temporal.server.api.token.v1.ShardQueuesContinuation")
 }
 
 func init() {
@@ -419,49 +486,56 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x9b, 0x75, 0xeb, 0x9a, 0x7f, 0xbb, 0x77, 0x6d, 0xa6, 0xbd, 0xab, 0xd0, 0xc8, 0x4a,
-	0xe1, 0x50, 0x06, 0x4a, 0x19, 0x9c, 0x10, 0x27, 0x40, 0x48, 0x0b, 0xb7, 0x45, 0x15, 0x48, 0x48,
-	0x10, 0x79, 0x8d, 0xbb, 0x59, 0xdd, 0x9c, 0xcc, 0x76, 0x32, 0x7a, 0xe3, 0x23, 0x70, 0xe4, 0xc4,
-	0x99, 0x8f, 0xc2, 0x71, 0xc7, 0x1d, 0x59, 0x76, 0xe1, 0xc6, 0x3e, 0x02, 0xb2, 0x13, 0x27, 0xd5,
-	0x28, 0x02, 0x71, 0x6b, 0x9f, 0xff, 0x93, 0xc7, 0xf6, 0xf3, 0x93, 0x0d, 0xdb, 0x02, 0x1f, 0x47,
-	0x21, 0x43, 0x47, 0x03, 0x8e, 0x59, 0x82, 0xd9, 0x00, 0x45, 0x64, 0x20, 0xc2, 0x09, 0xa6, 0x83,
-	0x64, 0x67, 0x70, 0x8c, 0x39, 0x47, 0x07, 0xd8, 0x89, 0x58, 0x28, 0x42, 0x6b, 0x53, 0x7b, 0x9d,
-	0xcc, 0xeb, 0xa0, 0x88, 0x38, 0xca, 0xeb, 0x24, 0x3b, 0x37, 0xee, 0xcf, 0x4b, 0x3a, 0x24, 0x5c,
-	0x84, 0x6c, 0xfa, 0x4b, 0x56, 0xef, 0xc7, 0x02, 0xac, 0xed, 0x66, 0xc3, 0xe7, 0x21, 0x15, 0x84,
-	0xc6, 0x48, 0x90, 0x90, 0x5a, 0xeb, 0x50, 0x63, 0x31, 0xf5, 0x49, 0xd0, 0x31, 0xba, 0x46, 0xdf,
-	0xf4, 0x96, 0x58, 0x4c, 0xdd, 0xc0, 0xba, 0x03, 0xff, 0x8d, 0x09, 0xe3, 0xc2, 0xc7, 0x09, 0xa6,
-	0x42, 0x8e, 0x17, 0xba, 0x46, 0xbf, 0xea, 0x35, 0x95, 0xfa, 0x42, 0x8a, 0x6e, 0x60, 0xf5, 0x60,
-	0x85, 0xe2, 0xf7, 0x33, 0xa6, 0xaa, 0x32, 0x35, 0xa4, 0xa8, 0x3d, 0x0e, 0xac, 0x11, 0xee, 0x9f,
-	0x86, 0x6c, 0x32, 0x3e, 0x0a, 0x4f, 0x7d, 0x16, 0x53, 0x4a, 0xe8, 0x41, 0x67, 0xa9, 0x6b, 0xf4,
-	0xeb, 0x5e, 0x9b, 0xf0, 0xd7, 0xf9, 0xc4, 0xcb, 0x06, 0xd6, 0x3d, 0x68, 0x47, 0x98, 0x71, 0xc2,
-	0x05, 0xa6, 0x23, 0xec, 0xab, 0xe3, 0x76, 0x6a, 0x5d, 0xa3, 0xdf, 0xf4, 0x5a, 0x33, 0x83, 0xa1,
-	0xd4, 0xad, 0x13, 0xd8, 0x10, 0x0c, 0x51, 0x4e, 0xe4, 0xfa, 0xc5, 0x1a, 0x02, 0xf1, 0x49, 0x67,
-	0xb9, 0x6b, 0xf4, 0x1b, 0x0f, 0x1f, 0x3b, 0xf3, 0x3a, 0xcc, 0x5b, 0x72, 0x92, 0x1d, 0x67, 0xa8,
-	0x3f, 0xd7, 0xfb, 0x18, 0x22, 0x3e, 0x71, 0xe9, 0x38, 0xf4, 0xd6, 0xc5, 0xbc, 0x91, 0x75, 0x0b,
-	0x9a, 0xfb, 0x0c, 0xd1, 0xd1, 0x61, 0xbe, 0xb5, 0xba, 0xda, 0x5a, 0x23, 0xd3, 0xd4, 0xae, 0x5e,
-	0x2e, 0xd6, 0xcd, 0x16, 0xf4, 0x3e, 0x57, 0xe1, 0x7f, 0x0f, 0x9d, 0xce, 0x2b, 0x7d, 0x13, 0x4c,
-	0x8a, 0x8e, 0x31, 0x8f, 0xd0, 0x08, 0xe7, 0xbd, 0x97, 0x82, 0xb5, 0x05, 0x8d, 0xe2, 0x28, 0x79,
-	0xf1, 0xa6, 0x07, 0x5a, 0x72, 0x83, 0x19, 0x66, 0xd5, 0x6b, 0xcc, 0xb8, 0x40, 0x6c, 0x06, 0xc7,
-	0x62, 0xc6, 0x4c, 0xa9, 0x33, 0x3c, 0x66, 0x5d, 0x89, 0xac, 0x34, 0xa4, 0x8a, 0x47, 0xd5, 0x6b,
-	0x97, 0xd6, 0x57, 0xd9, 0xc0, 0xea, 0x42, 0x13, 0xd3, 0xa0, 0xcc, 0xac, 0x29, 0x23, 0x60, 0x1a,
-	0xe8, 0xc4, 0x6d, 0x68, 0x97, 0x0e, 0x9d, 0xb7, 0xac, 0x6c, 0xab, 0xda, 0xa6, 0xd3, 0xe6, 0xd2,
-	0xad, 0xff, 0x86, 0xee, 0x5b, 0x68, 0xe7, 0x71, 0x7e, 0x46, 0x8c, 0x60, 0xde, 0x31, 0x15, 0xd7,
-	0x07, 0x7f, 0xe2, 0x9a, 0x2f, 0xb8, 0xab, 0xbf, 0xf3, 0x5a, 0xc9, 0x35, 0xa5, 0xf7, 0x69, 0x01,
-	0x16, 0x35, 0xd2, 0xa2, 0xfd, 0xf2, 0x26, 0x34, 0x0a, 0xcd, 0x0d, 0xfe, 0x99, 0xc9, 0x16, 0x34,
-	0xf8, 0xe8, 0x10, 0x07, 0xf1, 0x11, 0x2e, 0x81, 0x80, 0x96, 0xdc, 0xc0, 0xba, 0x0b, 0xad, 0xc2,
-	0x80, 0x84, 0x3c, 0x94, 0x50, 0x2c, 0x96, 0xbc, 0x55, 0xad, 0x3f, 0xcd, 0x64, 0x99, 0x85, 0x46,
-	0x82, 0x24, 0x44, 0x4c, 0x35, 0x08, 0xd3, 0x03, 0x2d, 0xb9, 0x81, 0x75, 0x1b, 0x56, 0xca, 0x3b,
-	0x30, 0x8d, 0xb0, 0x82, 0x60, 0x7a, 0x4d, 0x2d, 0x0e, 0xa7, 0x11, 0x96, 0xa6, 0x22, 0x45, 0x99,
-	0xea, 0x99, 0x49, 0x8b, 0xd2, 0xd4, 0x1b, 0x83, 0xb9, 0x17, 0x63, 0x36, 0xfd, 0xdb, 0x7a, 0x6e,
-	0x02, 0xc8, 0x4b, 0xe7, 0x9f, 0xc4, 0x38, 0xc6, 0x79, 0x3b, 0xa6, 0x54, 0xf6, 0xa4, 0x60, 0x6d,
-	0xc0, 0xb2, 0x1a, 0x17, 0xed, 0xd4, 0xe4, 0x5f, 0x37, 0x78, 0xf6, 0xee, 0xec, 0xc2, 0xae, 0x9c,
-	0x5f, 0xd8, 0x95, 0xab, 0x0b, 0xdb, 0xf8, 0x90, 0xda, 0xc6, 0x97, 0xd4, 0x36, 0xbe, 0xa6, 0xb6,
-	0x71, 0x96, 0xda, 0xc6, 0xb7, 0xd4, 0x36, 0xbe, 0xa7, 0x76, 0xe5, 0x2a, 0xb5, 0x8d, 0x8f, 0x97,
-	0x76, 0xe5, 0xec, 0xd2, 0xae, 0x9c, 0x5f, 0xda, 0x95, 0x37, 0xfd, 0x83, 0xb0, 0xc4, 0x4f, 0xc2,
-	0x79, 0x2f, 0xe9, 0x13, 0xf5, 0x63, 0xbf, 0xa6, 0x1e, 0xbf, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x82, 0x84, 0xbc, 0x27, 0x76, 0x05, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x71, 0xe2, 0x78, 0x9f, 0xdd, 0x26, 0xde, 0xaa, 0xc4, 0x8a, 0xca, 0xda, 0x35,
+	0x1c, 0x4c, 0x41, 0xbb, 0xa4, 0x9c, 0x10, 0x27, 0x8a, 0x90, 0xba, 0xdc, 0xba, 0x58, 0x20, 0x21,
+	0xc1, 0x6a, 0xe2, 0x7d, 0x76, 0x46, 0xb1, 0x67, 0xb6, 0x33, 0xb3, 0x9b, 0xfa, 0xc6, 0x47, 0xe8,
+	0x91, 0x53, 0xcf, 0x7c, 0x14, 0x8e, 0x39, 0xf6, 0x06, 0x71, 0x2e, 0xdc, 0xe8, 0x47, 0x40, 0x33,
+	0xbb, 0xe3, 0x35, 0xc5, 0x08, 0xc4, 0x6d, 0xf3, 0x7b, 0xff, 0x79, 0xf3, 0xe6, 0xff, 0xcf, 0x8c,
+	0xe1, 0x91, 0xc2, 0x65, 0xc6, 0x05, 0x59, 0x84, 0x12, 0x45, 0x81, 0x22, 0x24, 0x19, 0x0d, 0x15,
+	0xbf, 0x44, 0x16, 0x16, 0x67, 0xe1, 0x12, 0xa5, 0x24, 0x73, 0x0c, 0x32, 0xc1, 0x15, 0xf7, 0x1e,
+	0x58, 0x6d, 0x50, 0x6a, 0x03, 0x92, 0xd1, 0xc0, 0x68, 0x83, 0xe2, 0xec, 0x74, 0x30, 0xe7, 0x7c,
+	0xbe, 0xc0, 0xd0, 0x68, 0xcf, 0xf3, 0x59, 0xa8, 0xe8, 0x12, 0xa5, 0x22, 0xcb, 0xac, 0x5c, 0x7e,
+	0xfa, 0x30, 0xc5, 0x0c, 0x59, 0x8a, 0x6c, 0x4a, 0x51, 0x86, 0x73, 0x3e, 0xe7, 0x86, 0x9b, 0xaf,
+	0x4a, 0xf2, 0xd1, 0xae, 0x69, 0x2e, 0xa8, 0x54, 0x5c, 0xac, 0xfe, 0x36, 0xcf, 0xe8, 0x8f, 0x3d,
+	0xb8, 0xf7, 0xb4, 0x2c, 0x7e, 0xc1, 0x99, 0xa2, 0x2c, 0x27, 0x8a, 0x72, 0xe6, 0xdd, 0x87, 0x96,
+	0xc8, 0x59, 0x42, 0xd3, 0xbe, 0x33, 0x74, 0xc6, 0x6e, 0x7c, 0x20, 0x72, 0x16, 0xa5, 0xde, 0xfb,
+	0x70, 0x77, 0x46, 0x85, 0x54, 0x09, 0x16, 0xc8, 0x94, 0x2e, 0xef, 0x0d, 0x9d, 0x71, 0x33, 0xee,
+	0x1a, 0xfa, 0xa5, 0x86, 0x51, 0xea, 0x8d, 0xe0, 0x0e, 0xc3, 0x17, 0x5b, 0xa2, 0xa6, 0x11, 0x75,
+	0x34, 0xb4, 0x9a, 0x00, 0xee, 0x51, 0x99, 0x5c, 0x71, 0x71, 0x39, 0x5b, 0xf0, 0xab, 0x44, 0xe4,
+	0x8c, 0x51, 0x36, 0xef, 0x1f, 0x0c, 0x9d, 0x71, 0x3b, 0xee, 0x51, 0xf9, 0x6d, 0x55, 0x89, 0xcb,
+	0x82, 0xf7, 0x21, 0xf4, 0x32, 0x14, 0x92, 0x4a, 0x85, 0x6c, 0x8a, 0x89, 0xb1, 0xac, 0xdf, 0x1a,
+	0x3a, 0xe3, 0x6e, 0x7c, 0xbc, 0x55, 0x98, 0x68, 0xee, 0x3d, 0x87, 0x13, 0x25, 0x08, 0x93, 0x54,
+	0xef, 0xbf, 0xd9, 0x43, 0x11, 0x79, 0xd9, 0x3f, 0x1c, 0x3a, 0xe3, 0xce, 0xe3, 0x4f, 0x83, 0x5d,
+	0x39, 0x54, 0x2e, 0x05, 0xc5, 0x59, 0x30, 0xb1, 0xcb, 0xed, 0x1c, 0x13, 0x22, 0x2f, 0x23, 0x36,
+	0xe3, 0xf1, 0x7d, 0xb5, 0xab, 0xe4, 0x3d, 0x84, 0xee, 0xb9, 0x20, 0x6c, 0x7a, 0x51, 0x8d, 0xd6,
+	0x36, 0xa3, 0x75, 0x4a, 0x66, 0xa6, 0xfa, 0x6a, 0xbf, 0xed, 0x1e, 0xc3, 0xe8, 0x55, 0x13, 0xde,
+	0x89, 0xc9, 0xd5, 0x2e, 0xd3, 0x1f, 0x80, 0xcb, 0xc8, 0x12, 0x65, 0x46, 0xa6, 0x58, 0xf9, 0x5e,
+	0x03, 0x6f, 0x00, 0x9d, 0xcd, 0x51, 0x2a, 0xe3, 0xdd, 0x18, 0x2c, 0x8a, 0xd2, 0xad, 0xcc, 0x9a,
+	0x6f, 0x65, 0x26, 0x15, 0x11, 0x5b, 0x71, 0xec, 0x97, 0x99, 0x19, 0xba, 0x95, 0xc7, 0xb6, 0xaa,
+	0xd0, 0x96, 0x72, 0x66, 0xf2, 0x68, 0xc6, 0xbd, 0x5a, 0xfa, 0x4d, 0x59, 0xf0, 0x86, 0xd0, 0x45,
+	0x96, 0xd6, 0x3d, 0x5b, 0x46, 0x08, 0xc8, 0x52, 0xdb, 0xf1, 0x11, 0xf4, 0x6a, 0x85, 0xed, 0x77,
+	0x68, 0x64, 0x47, 0x56, 0x66, 0xbb, 0xed, 0x4c, 0xb7, 0xfd, 0x0f, 0xe9, 0x7e, 0x0f, 0xbd, 0xaa,
+	0x5d, 0x52, 0x26, 0x46, 0x51, 0xf6, 0x5d, 0x93, 0xeb, 0xc7, 0xff, 0x96, 0x6b, 0xb5, 0xe1, 0x53,
+	0xbb, 0x2e, 0x3e, 0x2e, 0xde, 0x22, 0xa3, 0x9f, 0xf6, 0x60, 0xdf, 0x46, 0xba, 0x71, 0xbf, 0xbe,
+	0x09, 0x9d, 0x0d, 0x8b, 0xd2, 0xff, 0x9d, 0xc9, 0x00, 0x3a, 0x72, 0x7a, 0x81, 0x69, 0xbe, 0xc0,
+	0x3a, 0x10, 0xb0, 0x28, 0x4a, 0xbd, 0x0f, 0xe0, 0x78, 0x23, 0x20, 0x4a, 0x1f, 0x4a, 0x99, 0x2c,
+	0x0e, 0xe2, 0x23, 0xcb, 0x3f, 0x2f, 0xb1, 0xee, 0x45, 0xa6, 0x8a, 0x16, 0x54, 0xad, 0x6c, 0x10,
+	0x6e, 0x0c, 0x16, 0x45, 0xa9, 0xf7, 0x1e, 0xdc, 0xa9, 0xef, 0xc0, 0x2a, 0x43, 0x13, 0x82, 0x1b,
+	0x77, 0x2d, 0x9c, 0xac, 0x32, 0xd4, 0xa2, 0x4d, 0x17, 0x23, 0x6a, 0x97, 0x22, 0x0b, 0xb5, 0x68,
+	0x34, 0x03, 0xf7, 0x59, 0x8e, 0x62, 0xf5, 0x5f, 0xed, 0x79, 0x17, 0x40, 0x5f, 0xba, 0xe4, 0x79,
+	0x8e, 0x39, 0x56, 0xee, 0xb8, 0x9a, 0x3c, 0xd3, 0xc0, 0x3b, 0x81, 0x43, 0x53, 0xde, 0xb8, 0xd3,
+	0xd2, 0x7f, 0x46, 0xe9, 0xe8, 0x95, 0x03, 0x27, 0x5f, 0x5f, 0x10, 0x91, 0x1a, 0x9d, 0xfc, 0xcb,
+	0x25, 0x19, 0x40, 0xc7, 0xb4, 0x4b, 0x28, 0x4b, 0xf1, 0x85, 0xd9, 0xf5, 0x20, 0x06, 0x83, 0x22,
+	0x4d, 0xb6, 0xbb, 0x96, 0x8f, 0x53, 0xd5, 0xd5, 0x8b, 0xe0, 0xa8, 0xa0, 0x92, 0x9e, 0xd3, 0x85,
+	0x39, 0x24, 0x5d, 0xa2, 0xd9, 0xb6, 0xf3, 0xf8, 0x34, 0x28, 0xdf, 0xdd, 0xc0, 0xbe, 0xbb, 0xc1,
+	0xc4, 0xbe, 0xbb, 0x4f, 0xf6, 0x5f, 0xfe, 0x3a, 0x70, 0xe2, 0xbb, 0xf5, 0x42, 0x5d, 0x7a, 0xf2,
+	0xc3, 0xf5, 0x8d, 0xdf, 0x78, 0x7d, 0xe3, 0x37, 0xde, 0xdc, 0xf8, 0xce, 0x8f, 0x6b, 0xdf, 0xf9,
+	0x79, 0xed, 0x3b, 0xbf, 0xac, 0x7d, 0xe7, 0x7a, 0xed, 0x3b, 0xbf, 0xad, 0x7d, 0xe7, 0xf7, 0xb5,
+	0xdf, 0x78, 0xb3, 0xf6, 0x9d, 0x97, 0xb7, 0x7e, 0xe3, 0xfa, 0xd6, 0x6f, 0xbc, 0xbe, 0xf5, 0x1b,
+	0xdf, 0x8d, 0xe7, 0xbc, 0xfe, 0xff, 0xa4, 0x7c, 0xd7, 0xcf, 0xc5, 0x67, 0xe6, 0xe3, 0xbc, 0x65,
+	0x26, 0xf9, 0xe4, 0xcf, 0x01, 0x00, 0xc8, 0x1f, 0xe8, 0x2c, 0x5b, 0x06, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -629,6 +703,40 @@ func (this *QueryTask) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardQueuesContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardQueuesContinuation)
+	if !ok {
+		that2, ok := that.(ShardQueuesContinuation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.QueueIndex != that1.QueueIndex {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
+		return false
+	}
+	return true
+}
 func (this *HistoryContinuation) GoString() string {
 	if this == nil {
 		return "nil"
@@ -696,6 +804,18 @@ func (this *QueryTask) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ShardQueuesContinuation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&token.ShardQueuesContinuation{")
+	s = append(s, "QueueIndex: "+fmt.Sprintf("%#v", this.QueueIndex)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -982,6 +1102,49 @@ func (m *QueryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShardQueuesContinuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardQueuesContinuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardQueuesContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VisibilityTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueueIndex != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.QueueIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -1128,6 +1291,25 @@ func (m *QueryTask) Size() (n int) {
 	return n
 }
 
+func (m *ShardQueuesContinuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueueIndex != 0 {
+		n += 1 + sovMessage(uint64(m.QueueIndex))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovMessage(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1197,6 +1379,18 @@ func (this *QueryTask) String() string {
 	}, "")
 	return s
 }
+func (this *ShardQueuesContinuation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardQueuesContinuation{`,
+		`QueueIndex:` + fmt.Sprintf("%v", this.QueueIndex) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2179,6 +2373,133 @@ func (m *QueryTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShardQueuesContinuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardQueuesContinuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardQueuesContinuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueIndex", wireType)
+			}
+			m.QueueIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -316,6 +316,132 @@ func (c *clientImpl) ResendReplicationTasks(
 	return client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *adminservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardQueuesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeShardQueues(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDynamicConfigHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RenameNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReclaimSearchAttributeFields(
+	ctx context.Context,
+	request *adminservice.ReclaimSearchAttributeFieldsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReclaimSearchAttributeFieldsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReclaimSearchAttributeFields(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -362,3 +362,156 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) DescribeShardQueues(
+	ctx context.Context,
+	request *adminservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardQueuesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeShardQueuesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeShardQueuesScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeShardQueues(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeShardQueuesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.SetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfigHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.RenameNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ReclaimSearchAttributeFields(
+	ctx context.Context,
+	request *adminservice.ReclaimSearchAttributeFieldsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReclaimSearchAttributeFieldsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReclaimSearchAttributeFieldsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReclaimSearchAttributeFieldsScope, metrics.ClientLatency)
+	resp, err := c.client.ReclaimSearchAttributeFields(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReclaimSearchAttributeFieldsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -333,3 +333,147 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) DescribeShardQueues(
+	ctx context.Context,
+	request *adminservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardQueuesResponse, error) {
+
+	var resp *adminservice.DescribeShardQueuesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+
+	var resp *adminservice.SetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+
+	var resp *adminservice.DeleteDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfigHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	var resp *adminservice.DeleteNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	var resp *adminservice.RenameNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RenameNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReclaimSearchAttributeFields(
+	ctx context.Context,
+	request *adminservice.ReclaimSearchAttributeFieldsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReclaimSearchAttributeFieldsResponse, error) {
+
+	var resp *adminservice.ReclaimSearchAttributeFieldsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReclaimSearchAttributeFields(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -997,6 +997,54 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return response, nil
 }
 
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DeleteWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *historyservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeShardQueuesResponse, error) {
+	client, err := c.getClientForShardID(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DescribeShardQueuesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -626,3 +626,37 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeShardQueues(
+	ctx context.Context,
+	request *historyservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeShardQueuesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardQueuesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardQueuesScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeShardQueues(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardQueuesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -626,3 +626,37 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardQueues(
+	ctx context.Context,
+	request *historyservice.DescribeShardQueuesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeShardQueuesResponse, error) {
+
+	var resp *historyservice.DescribeShardQueuesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
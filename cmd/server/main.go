@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"     // needed to load sqlite plugin
 	"go.temporal.io/server/temporal"
 )
 
@@ -129,7 +130,7 @@ func buildCLI() *cli.App {
 					return cli.Exit(fmt.Sprintf("Unable to load configuration: %v.", err), 1)
 				}
 
-				logger := tlog.NewZapLogger(tlog.BuildZapLogger(cfg.Log))
+				logger, logLevels := tlog.NewLeveledZapLogger(cfg.Log)
 
 				dynamicConfigClient, err := dynamicconfig.NewFileBasedClient(&cfg.DynamicConfigClient, logger, temporal.InterruptCh())
 				if err != nil {
@@ -139,7 +140,11 @@ func buildCLI() *cli.App {
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer: %v.", err), 1)
+				}
 
 				claimMapper, err := authorization.GetClaimMapperFromConfig(&cfg.Global.Authorization, logger)
 				if err != nil {
@@ -150,6 +155,7 @@ func buildCLI() *cli.App {
 					temporal.WithConfig(cfg),
 					temporal.WithDynamicConfigClient(dynamicConfigClient),
 					temporal.WithLogger(logger),
+					temporal.WithLogLevels(logLevels),
 					temporal.InterruptOn(temporal.InterruptCh()),
 					temporal.WithAuthorizer(authorizer),
 					temporal.WithClaimMapper(func(cfg *config.Config) authorization.ClaimMapper {
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Filestore Payload Store keeps payloads offloaded from workflow history on local disk.
+
+// Each Put() request results in a file being created at the key, relative to the directory
+// specified in the URI. Intermediate directories are created as needed, so that all payloads
+// of an execution can be removed by DeletePrefix() with a single directory removal.
+
+package filestore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	payloadStore struct {
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+// NewPayloadStore creates a new archiver.PayloadStore based on filestore
+func NewPayloadStore(
+	config *config.FilestoreArchiver,
+) (archiver.PayloadStore, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	return &payloadStore{
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}, nil
+}
+
+func (p *payloadStore) Put(
+	_ context.Context,
+	URI archiver.URI,
+	key string,
+	data []byte,
+) error {
+	filePath, err := p.filePath(URI, key)
+	if err != nil {
+		return err
+	}
+	if err := mkdirAll(filepath.Dir(filePath), p.dirMode); err != nil {
+		return err
+	}
+	return writeFile(filePath, data, p.fileMode)
+}
+
+func (p *payloadStore) Get(
+	_ context.Context,
+	URI archiver.URI,
+	key string,
+) ([]byte, error) {
+	filePath, err := p.filePath(URI, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := readFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, serviceerror.NewNotFound(err.Error())
+	}
+	return data, err
+}
+
+func (p *payloadStore) DeletePrefix(
+	_ context.Context,
+	URI archiver.URI,
+	prefix string,
+) error {
+	if !strings.HasSuffix(prefix, "/") {
+		return serviceerror.NewInvalidArgument("payload key prefix must end with /")
+	}
+	dirPath, err := p.filePath(URI, prefix)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dirPath)
+}
+
+func (p *payloadStore) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
+
+func (p *payloadStore) filePath(URI archiver.URI, key string) (string, error) {
+	if err := p.ValidateURI(URI); err != nil {
+		return "", err
+	}
+	root := filepath.Clean(URI.Path())
+	filePath := filepath.Join(root, filepath.FromSlash(key))
+	if filePath == root || !strings.HasPrefix(filePath, root+string(filepath.Separator)) {
+		return "", serviceerror.NewInvalidArgument("payload key escapes the payload store directory")
+	}
+	return filePath, nil
+}
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type payloadStoreSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	storeDir string
+	store    archiver.PayloadStore
+	uri      archiver.URI
+}
+
+func TestPayloadStoreSuite(t *testing.T) {
+	suite.Run(t, new(payloadStoreSuite))
+}
+
+func (s *payloadStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.storeDir, err = ioutil.TempDir("", "TestPayloadStoreSuite")
+	s.NoError(err)
+	s.store, err = NewPayloadStore(&config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+	})
+	s.NoError(err)
+	s.uri, err = archiver.NewURI("file://" + s.storeDir)
+	s.NoError(err)
+}
+
+func (s *payloadStoreSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.storeDir))
+}
+
+func (s *payloadStoreSuite) TestNewPayloadStore_InvalidMode() {
+	_, err := NewPayloadStore(&config.FilestoreArchiver{
+		FileMode: "invalid",
+		DirMode:  testDirModeStr,
+	})
+	s.Equal(errInvalidFileMode, err)
+}
+
+func (s *payloadStoreSuite) TestPutGetDeletePrefix() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.uri, "namespace/workflow/run/payload-1", []byte("data-1")))
+	s.NoError(s.store.Put(ctx, s.uri, "namespace/workflow/other-run/payload-2", []byte("data-2")))
+
+	data, err := s.store.Get(ctx, s.uri, "namespace/workflow/run/payload-1")
+	s.NoError(err)
+	s.Equal([]byte("data-1"), data)
+
+	s.Error(s.store.DeletePrefix(ctx, s.uri, "namespace/workflow/run"))
+	s.NoError(s.store.DeletePrefix(ctx, s.uri, "namespace/workflow/run/"))
+	_, err = s.store.Get(ctx, s.uri, "namespace/workflow/run/payload-1")
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	data, err = s.store.Get(ctx, s.uri, "namespace/workflow/other-run/payload-2")
+	s.NoError(err)
+	s.Equal([]byte("data-2"), data)
+}
+
+func (s *payloadStoreSuite) TestInvalidKey() {
+	ctx := context.Background()
+	s.Error(s.store.Put(ctx, s.uri, "../escaped", []byte("data")))
+	_, err := s.store.Get(ctx, s.uri, "../escaped")
+	s.Error(err)
+	s.Error(s.store.DeletePrefix(ctx, s.uri, "/"))
+}
+
+func (s *payloadStoreSuite) TestValidateURI() {
+	uri, err := archiver.NewURI("s3://bucket/path")
+	s.NoError(err)
+	s.Equal(archiver.ErrURISchemeMismatch, s.store.ValidateURI(uri))
+	s.NoError(s.store.ValidateURI(s.uri))
+}
@@ -86,7 +86,11 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	sel := stmt.(*sqlparser.Select)
+	if len(sel.OrderBy) != 0 {
+		return nil, errors.New("order by is not supported")
+	}
+	whereExpr := sel.Where.Expr
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: time.Time{},
 		latestCloseTime:   time.Now().UTC(),
@@ -199,7 +199,7 @@ func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) strin
 }
 
 func constructVisibilityFilename(closeTimestamp *time.Time, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID))
+	return fmt.Sprintf("%v_%s%s", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID), visibilityFileSuffix)
 }
 
 func hash(s string) string {
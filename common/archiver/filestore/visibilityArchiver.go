@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -48,6 +49,10 @@ import (
 
 const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteManifest          = "failed to write visibility manifest"
+
+	tmpFileSuffix        = ".tmp"
+	visibilityFileSuffix = ".visibility"
 )
 
 type (
@@ -56,6 +61,14 @@ type (
 		fileMode    os.FileMode
 		dirMode     os.FileMode
 		queryParser QueryParser
+		manifest    *archiver.VisibilityManifest
+	}
+
+	// visibilityManifestStore keeps visibility manifests in files under the archival directory.
+	visibilityManifestStore struct {
+		dirPath  string
+		fileMode os.FileMode
+		dirMode  os.FileMode
 	}
 
 	queryVisibilityToken struct {
@@ -89,6 +102,7 @@ func NewVisibilityArchiver(
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
 		queryParser: NewQueryParser(),
+		manifest:    archiver.NewVisibilityManifest(),
 	}, nil
 }
 
@@ -137,6 +151,11 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	if err := v.manifest.Add(ctx, URI, v.manifestStore(URI), request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -156,7 +175,13 @@ func (v *visibilityArchiver) Query(
 
 	parsedQuery, err := v.queryParser.Parse(request.Query)
 	if err != nil {
-		return nil, serviceerror.NewInvalidArgument(err.Error())
+		// Queries which can't be answered from visibility filenames, i.e. with OR, StartTime,
+		// search attributes or order by, are answered from visibility manifests.
+		visibilityQuery, err := archiver.ParseVisibilityQuery(request.Query, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return v.queryManifest(ctx, URI, request, visibilityQuery, saTypeMap)
 	}
 
 	if parsedQuery.emptyResult {
@@ -256,6 +281,99 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) queryManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	visibilityQuery *archiver.VisibilityQuery,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.manifest.Backfill(ctx, URI, v.manifestStore(URI), request.NamespaceID, func(ctx context.Context, visit func(*archiverspb.VisibilityRecord) error) error {
+		return v.forEachRecord(URI, request.NamespaceID, visit)
+	}); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	records, nextPageToken, err := archiver.QueryVisibilityManifest(
+		ctx,
+		v.manifestStore(URI),
+		request.NamespaceID,
+		visibilityQuery,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		if err == archiver.ErrNextPageTokenCorrupted {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	dirPath := path.Join(URI.Path(), request.NamespaceID)
+	for _, manifestRecord := range records {
+		// Manifest doesn't have memo, therefore the archived record is read.
+		encodedRecord, err := readFile(path.Join(dirPath, constructVisibilityFilename(manifestRecord.CloseTime, manifestRecord.GetRunId())))
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	return response, nil
+}
+
+// forEachRecord calls visit for every visibility record archived in the namespace.
+func (v *visibilityArchiver) forEachRecord(
+	URI archiver.URI,
+	namespaceID string,
+	visit func(*archiverspb.VisibilityRecord) error,
+) error {
+	dirPath := path.Join(URI.Path(), namespaceID)
+	exists, err := directoryExists(dirPath)
+	if err != nil || !exists {
+		return err
+	}
+	files, err := listFiles(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file, visibilityFileSuffix) {
+			continue
+		}
+		encodedRecord, err := readFile(path.Join(dirPath, file))
+		if err != nil {
+			return err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return err
+		}
+		if err := visit(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) manifestStore(URI archiver.URI) *visibilityManifestStore {
+	return &visibilityManifestStore{
+		dirPath:  URI.Path(),
+		fileMode: v.fileMode,
+		dirMode:  v.dirMode,
+	}
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -364,3 +482,45 @@ func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap sear
 		SearchAttributes: searchAttributes,
 	}, nil
 }
+
+func (s *visibilityManifestStore) Put(_ context.Context, key string, data []byte) error {
+	filePath := path.Join(s.dirPath, key)
+	if err := mkdirAll(path.Dir(filePath), s.dirMode); err != nil {
+		return err
+	}
+	// Manifest is written to a temporary file first, so readers never see partially written manifest.
+	tmpFilePath := filePath + tmpFileSuffix
+	if err := writeFile(tmpFilePath, data, s.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilePath, filePath)
+}
+
+func (s *visibilityManifestStore) Get(_ context.Context, key string) ([]byte, error) {
+	return readFile(path.Join(s.dirPath, key))
+}
+
+func (s *visibilityManifestStore) List(_ context.Context, prefix string) ([]string, error) {
+	root := path.Join(s.dirPath, prefix)
+	exists, err := directoryExists(root)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var keys []string
+	err = filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(filePath, tmpFileSuffix) {
+			return nil
+		}
+		key, err := filepath.Rel(s.dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(key))
+		return nil
+	})
+	return keys, err
+}
@@ -511,6 +511,82 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Manifest() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQueryManifest")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, record)
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "(ExecutionStatus = 'ContinuedAsNew' OR HistoryLength = 101) AND StartTime < 10 order by StartTime asc",
+	}
+	executions := []*workflowpb.WorkflowExecutionInfo{}
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.NotNil(response)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, executions[0])
+	ei, err = convertToExecutionInfo(s.visibilityRecords[2], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_ManifestBackfill() {
+	dir, err := ioutil.TempDir("", "TestQueryManifestBackfill")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// Records archived before manifests were introduced.
+	dirPath := path.Join(dir, testNamespaceID)
+	s.NoError(os.MkdirAll(dirPath, testDirMode))
+	for _, record := range s.visibilityRecords {
+		if record.GetNamespaceId() != testNamespaceID {
+			continue
+		}
+		data, err := encode(record)
+		s.NoError(err)
+		s.NoError(writeFile(path.Join(dirPath, constructVisibilityFilename(record.CloseTime, record.GetRunId())), data, testFileMode))
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "HistoryLength = 456 order by StartTime asc",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+	s.assertFileExists(path.Join(dir, "manifest", testNamespaceID, "backfill", "done"))
+
+	// Backfill runs once per namespace, records written without manifest later are not backfilled.
+	record := *s.visibilityRecords[2]
+	record.RunId = "legacy run ID"
+	data, err := encode(&record)
+	s.NoError(err)
+	s.NoError(writeFile(path.Join(dirPath, constructVisibilityFilename(record.CloseTime, record.GetRunId())), data, testFileMode))
+	response, err = s.newTestVisibilityArchiver().Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
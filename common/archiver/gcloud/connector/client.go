@@ -65,6 +65,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		DeleteWithPrefix(ctx context.Context, URI archiver.URI, fileNamePrefix string) error
 	}
 
 	storageWrapper struct {
@@ -204,6 +205,27 @@ func (s *storageWrapper) QueryWithFilters(ctx context.Context, URI archiver.URI,
 
 }
 
+// DeleteWithPrefix removes all files whose name starts with the given prefix
+func (s *storageWrapper) DeleteWithPrefix(ctx context.Context, URI archiver.URI, fileNamePrefix string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: formatSinkPath(URI.Path()) + "/" + fileNamePrefix,
+	})
+
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
 func isPageCompleted(pageSize, currentPosition int) bool {
 	return pageSize != 0 && currentPosition > 0 && pageSize <= currentPosition
 }
@@ -66,6 +66,7 @@ type (
 		NewWriter(ctx context.Context) WriterWrapper
 		NewReader(ctx context.Context) (ReaderWrapper, error)
 		Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+		Delete(ctx context.Context) error
 	}
 
 	objectDelegate struct {
@@ -214,6 +215,11 @@ func (o *objectDelegate) Attrs(ctx context.Context) (attrs *storage.ObjectAttrs,
 	return o.object.Attrs(ctx)
 }
 
+// Delete deletes the single specified object.
+func (o *objectDelegate) Delete(ctx context.Context) error {
+	return o.object.Delete(ctx)
+}
+
 // Close completes the write operation and flushes any buffered data.
 // If Close doesn't return an error, metadata about the written object
 // can be retrieved by calling Attrs.
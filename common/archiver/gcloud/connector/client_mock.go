@@ -59,6 +59,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteWithPrefix mocks base method.
+func (m *MockClient) DeleteWithPrefix(ctx context.Context, URI archiver.URI, fileNamePrefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithPrefix", ctx, URI, fileNamePrefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithPrefix indicates an expected call of DeleteWithPrefix.
+func (mr *MockClientMockRecorder) DeleteWithPrefix(ctx, URI, fileNamePrefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithPrefix", reflect.TypeOf((*MockClient)(nil).DeleteWithPrefix), ctx, URI, fileNamePrefix)
+}
+
 // Exist mocks base method.
 func (m *MockClient) Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error) {
 	m.ctrl.T.Helper()
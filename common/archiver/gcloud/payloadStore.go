@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/config"
+)
+
+type payloadStore struct {
+	gcloudStorage connector.Client
+}
+
+// NewPayloadStore creates a new gcloud storage PayloadStore
+func NewPayloadStore(
+	config *config.GstorageArchiver,
+) (archiver.PayloadStore, error) {
+	client, err := connector.NewClient(context.Background(), config)
+	if err == nil {
+		return newPayloadStore(client), nil
+	}
+	return nil, err
+}
+
+func newPayloadStore(client connector.Client) archiver.PayloadStore {
+	return &payloadStore{
+		gcloudStorage: client,
+	}
+}
+
+func (p *payloadStore) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	if err := p.validateURI(URI); err != nil {
+		return serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	return p.gcloudStorage.Upload(ctx, URI, constructPayloadFilename(key), data)
+}
+
+func (p *payloadStore) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	if err := p.validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	data, err := p.gcloudStorage.Get(ctx, URI, constructPayloadFilename(key))
+	if err == storage.ErrObjectNotExist {
+		return nil, serviceerror.NewNotFound(err.Error())
+	}
+	return data, err
+}
+
+func (p *payloadStore) DeletePrefix(ctx context.Context, URI archiver.URI, prefix string) error {
+	if err := p.validateURI(URI); err != nil {
+		return serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		return serviceerror.NewInvalidArgument("payload key prefix must end with /")
+	}
+	return p.gcloudStorage.DeleteWithPrefix(ctx, URI, constructPayloadFilename(prefix))
+}
+
+func (p *payloadStore) ValidateURI(URI archiver.URI) (err error) {
+	if err = p.validateURI(URI); err == nil {
+		_, err = p.gcloudStorage.Exist(context.Background(), URI, "")
+	}
+
+	return
+}
+
+func (p *payloadStore) validateURI(URI archiver.URI) (err error) {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	if URI.Path() == "" || URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+
+	return
+}
+
+func constructPayloadFilename(key string) string {
+	return "payloads/" + key
+}
@@ -89,7 +89,11 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	sel := stmt.(*sqlparser.Select)
+	if len(sel.OrderBy) != 0 {
+		return nil, errors.New("order by is not supported")
+	}
+	whereExpr := sel.Where.Expr
 	parsedQuery := &parsedQuery{}
 	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
 		return nil, err
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -45,6 +46,7 @@ import (
 
 const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteManifest          = "failed to write visibility manifest"
 	indexKeyStartTimeout      = "startTimeout"
 	indexKeyCloseTimeout      = "closeTimeout"
 	timeoutInSeconds          = 5
@@ -59,6 +61,13 @@ type (
 		container     *archiver.VisibilityBootstrapContainer
 		gcloudStorage connector.Client
 		queryParser   QueryParser
+		manifest      *archiver.VisibilityManifest
+	}
+
+	// visibilityManifestStore keeps visibility manifests in the archival bucket.
+	visibilityManifestStore struct {
+		gcloudStorage connector.Client
+		URI           archiver.URI
 	}
 
 	queryVisibilityToken struct {
@@ -78,6 +87,7 @@ func newVisibilityArchiver(container *archiver.VisibilityBootstrapContainer, sto
 		container:     container,
 		gcloudStorage: storage,
 		queryParser:   NewQueryParser(),
+		manifest:      archiver.NewVisibilityManifest(),
 	}
 }
 
@@ -146,6 +156,11 @@ func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, requ
 		return errRetryable
 	}
 
+	if err := v.manifest.Add(ctx, URI, v.manifestStore(URI), request); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return errRetryable
+	}
+
 	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
 	return nil
 }
@@ -172,7 +187,13 @@ func (v *visibilityArchiver) Query(
 
 	parsedQuery, err := v.queryParser.Parse(request.Query)
 	if err != nil {
-		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+		// Queries which can't be answered from visibility filenames, i.e. with OR, ranges,
+		// search attributes or order by, are answered from visibility manifests.
+		visibilityQuery, err := archiver.ParseVisibilityQuery(request.Query, saTypeMap)
+		if err != nil {
+			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+		}
+		return v.queryManifest(ctx, URI, request, visibilityQuery, saTypeMap)
 	}
 
 	if parsedQuery.emptyResult {
@@ -267,6 +288,92 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) queryManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	visibilityQuery *archiver.VisibilityQuery,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.manifest.Backfill(ctx, URI, v.manifestStore(URI), request.NamespaceID, func(ctx context.Context, visit func(*archiverspb.VisibilityRecord) error) error {
+		return v.forEachRecord(ctx, URI, request.NamespaceID, visit)
+	}); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	records, nextPageToken, err := archiver.QueryVisibilityManifest(
+		ctx,
+		v.manifestStore(URI),
+		request.NamespaceID,
+		visibilityQuery,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		if err == archiver.ErrNextPageTokenCorrupted {
+			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+		}
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, manifestRecord := range records {
+		// Manifest doesn't have memo, therefore the archived record is downloaded.
+		filename := constructVisibilityFilename(request.NamespaceID, manifestRecord.WorkflowTypeName, manifestRecord.GetWorkflowId(), manifestRecord.GetRunId(), indexKeyCloseTimeout, timestamp.TimeValue(manifestRecord.CloseTime))
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, filename)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	return response, nil
+}
+
+// forEachRecord calls visit for every visibility record archived in the namespace.
+// Every record is found by its close time index.
+func (v *visibilityArchiver) forEachRecord(
+	ctx context.Context,
+	URI archiver.URI,
+	namespaceID string,
+	visit func(*archiverspb.VisibilityRecord) error,
+) error {
+	filenames, err := v.gcloudStorage.Query(ctx, URI, constructVisibilityFilenamePrefix(namespaceID, indexKeyCloseTimeout))
+	if err != nil {
+		return err
+	}
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", namespaceID, filepath.Base(file)))
+		if err != nil {
+			return err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return err
+		}
+		if err := visit(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) manifestStore(URI archiver.URI) *visibilityManifestStore {
+	return &visibilityManifestStore{
+		gcloudStorage: v.gcloudStorage,
+		URI:           URI,
+	}
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
@@ -290,3 +397,24 @@ func (v *visibilityArchiver) validateURI(URI archiver.URI) (err error) {
 
 	return
 }
+
+func (s *visibilityManifestStore) Put(ctx context.Context, key string, data []byte) error {
+	return s.gcloudStorage.Upload(ctx, s.URI, key, data)
+}
+
+func (s *visibilityManifestStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s.gcloudStorage.Get(ctx, s.URI, key)
+}
+
+func (s *visibilityManifestStore) List(ctx context.Context, prefix string) ([]string, error) {
+	objectNames, err := s.gcloudStorage.Query(ctx, s.URI, prefix+"/")
+	if err != nil {
+		return nil, err
+	}
+	// Query returns object names which include the archival path.
+	keys := make([]string, len(objectNames))
+	for i, objectName := range objectNames {
+		keys[i] = strings.TrimPrefix(objectName, strings.TrimPrefix(s.URI.Path(), "/")+"/")
+	}
+	return keys, nil
+}
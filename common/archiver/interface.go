@@ -139,4 +139,17 @@ type (
 		// ValidateURI is used to define what a valid URI for an implementation is.
 		ValidateURI(uri URI) error
 	}
+
+	// PayloadStore is used to store payloads offloaded from workflow history.
+	// Keys are slash separated paths relative to the resource identified by the URI.
+	PayloadStore interface {
+		// Put writes the data under the given key, an existing blob under the same key is overwritten.
+		Put(ctx context.Context, uri URI, key string, data []byte) error
+		// Get reads the data written under the given key, a NotFound service error is returned if the key doesn't exist.
+		Get(ctx context.Context, uri URI, key string) ([]byte, error)
+		// DeletePrefix removes all data whose key starts with the given slash terminated prefix.
+		DeletePrefix(ctx context.Context, uri URI, prefix string) error
+		// ValidateURI is used to define what a valid URI for an implementation is.
+		ValidateURI(uri URI) error
+	}
 )
@@ -178,3 +178,83 @@ func (mr *MockVisibilityArchiverMockRecorder) ValidateURI(uri interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockVisibilityArchiver)(nil).ValidateURI), uri)
 }
+
+// MockPayloadStore is a mock of PayloadStore interface.
+type MockPayloadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayloadStoreMockRecorder
+}
+
+// MockPayloadStoreMockRecorder is the mock recorder for MockPayloadStore.
+type MockPayloadStoreMockRecorder struct {
+	mock *MockPayloadStore
+}
+
+// NewMockPayloadStore creates a new mock instance.
+func NewMockPayloadStore(ctrl *gomock.Controller) *MockPayloadStore {
+	mock := &MockPayloadStore{ctrl: ctrl}
+	mock.recorder = &MockPayloadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayloadStore) EXPECT() *MockPayloadStoreMockRecorder {
+	return m.recorder
+}
+
+// DeletePrefix mocks base method.
+func (m *MockPayloadStore) DeletePrefix(ctx context.Context, uri URI, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrefix", ctx, uri, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrefix indicates an expected call of DeletePrefix.
+func (mr *MockPayloadStoreMockRecorder) DeletePrefix(ctx, uri, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrefix", reflect.TypeOf((*MockPayloadStore)(nil).DeletePrefix), ctx, uri, prefix)
+}
+
+// Get mocks base method.
+func (m *MockPayloadStore) Get(ctx context.Context, uri URI, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uri, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPayloadStoreMockRecorder) Get(ctx, uri, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPayloadStore)(nil).Get), ctx, uri, key)
+}
+
+// Put mocks base method.
+func (m *MockPayloadStore) Put(ctx context.Context, uri URI, key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, uri, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockPayloadStoreMockRecorder) Put(ctx, uri, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPayloadStore)(nil).Put), ctx, uri, key, data)
+}
+
+// ValidateURI mocks base method.
+func (m *MockPayloadStore) ValidateURI(uri URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateURI", uri)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateURI indicates an expected call of ValidateURI.
+func (mr *MockPayloadStoreMockRecorder) ValidateURI(uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockPayloadStore)(nil).ValidateURI), uri)
+}
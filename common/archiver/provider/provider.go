@@ -228,3 +228,31 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
 	return scheme + ":" + serviceName
 }
+
+// NewPayloadStore returns a new payload store for the given scheme.
+// Payload stores reuse the connector configs of the history archivers.
+func NewPayloadStore(scheme string, configs *config.HistoryArchiverProvider) (archiver.PayloadStore, error) {
+	if configs == nil {
+		return nil, ErrArchiverConfigNotFound
+	}
+
+	switch scheme {
+	case filestore.URIScheme:
+		if configs.Filestore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return filestore.NewPayloadStore(configs.Filestore)
+	case gcloud.URIScheme:
+		if configs.Gstorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return gcloud.NewPayloadStore(configs.Gstorage)
+	case s3store.URIScheme:
+		if configs.S3store == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return s3store.NewPayloadStore(configs.S3store)
+	default:
+		return nil, ErrUnknownScheme
+	}
+}
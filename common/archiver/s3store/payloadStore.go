@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// S3 Payload Store keeps payloads offloaded from workflow history in amazon s3
+
+package s3store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	payloadStore struct {
+		s3cli s3iface.S3API
+	}
+)
+
+// NewPayloadStore creates a new archiver.PayloadStore based on s3
+func NewPayloadStore(
+	config *config.S3Archiver,
+) (archiver.PayloadStore, error) {
+	if len(config.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	s3Config := &aws.Config{
+		Endpoint:         config.Endpoint,
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payloadStore{
+		s3cli: s3.New(sess),
+	}, nil
+}
+
+func (p *payloadStore) Put(
+	ctx context.Context,
+	URI archiver.URI,
+	key string,
+	data []byte,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	return upload(ctx, p.s3cli, URI, constructPayloadKey(URI.Path(), key), data)
+}
+
+func (p *payloadStore) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	key string,
+) ([]byte, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	return download(ctx, p.s3cli, URI, constructPayloadKey(URI.Path(), key))
+}
+
+func (p *payloadStore) DeletePrefix(
+	ctx context.Context,
+	URI archiver.URI,
+	prefix string,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		return serviceerror.NewInvalidArgument("payload key prefix must end with /")
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	listRequest := &s3.ListObjectsV2Input{
+		Bucket: aws.String(URI.Hostname()),
+		Prefix: aws.String(constructPayloadKey(URI.Path(), prefix)),
+	}
+	for {
+		results, err := p.s3cli.ListObjectsV2WithContext(ctx, listRequest)
+		if err != nil {
+			return err
+		}
+		if len(results.Contents) > 0 {
+			objects := make([]*s3.ObjectIdentifier, 0, len(results.Contents))
+			for _, object := range results.Contents {
+				objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+			}
+			if _, err := p.s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(URI.Hostname()),
+				Delete: &s3.Delete{
+					Objects: objects,
+					Quiet:   aws.Bool(true),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			return nil
+		}
+		listRequest.ContinuationToken = results.NextContinuationToken
+	}
+}
+
+func (p *payloadStore) ValidateURI(URI archiver.URI) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultBlobstoreTimeout)
+	defer cancel()
+
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+	return bucketExists(ctx, p.s3cli, URI)
+}
+
+func constructPayloadKey(path, key string) string {
+	return strings.TrimLeft(strings.Join([]string{path, "payloads", key}, "/"), "/")
+}
@@ -26,6 +26,7 @@ package s3store
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -48,6 +49,13 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		s3cli       s3iface.S3API
 		queryParser QueryParser
+		manifest    *archiver.VisibilityManifest
+	}
+
+	// visibilityManifestStore keeps visibility manifests in the archival bucket.
+	visibilityManifestStore struct {
+		s3cli s3iface.S3API
+		URI   archiver.URI
 	}
 
 	queryVisibilityRequest struct {
@@ -67,6 +75,7 @@ type (
 
 const (
 	errEncodeVisibilityRecord       = "failed to encode visibility record"
+	errWriteManifest                = "failed to write visibility manifest"
 	secondaryIndexKeyStartTimeout   = "startTimeout"
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
 	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
@@ -97,6 +106,7 @@ func newVisibilityArchiver(
 		container:   container,
 		s3cli:       s3.New(sess),
 		queryParser: NewQueryParser(),
+		manifest:    archiver.NewVisibilityManifest(),
 	}, nil
 }
 
@@ -151,6 +161,10 @@ func (v *visibilityArchiver) Archive(
 			return err
 		}
 	}
+	if err := v.manifest.Add(ctx, URI, v.manifestStore(URI), request); err != nil {
+		archiveFailReason = errWriteManifest
+		return err
+	}
 	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
 	return nil
 }
@@ -180,7 +194,13 @@ func (v *visibilityArchiver) Query(
 
 	parsedQuery, err := v.queryParser.Parse(request.Query)
 	if err != nil {
-		return nil, serviceerror.NewInvalidArgument(err.Error())
+		// Queries which can't be answered from the visibility indexes, i.e. with OR, ranges,
+		// search attributes or order by, are answered from visibility manifests.
+		visibilityQuery, err := archiver.ParseVisibilityQuery(request.Query, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return v.queryManifest(ctx, URI, request, visibilityQuery, saTypeMap)
 	}
 
 	return v.query(
@@ -261,6 +281,108 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) queryManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	visibilityQuery *archiver.VisibilityQuery,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.manifest.Backfill(ctx, URI, v.manifestStore(URI), request.NamespaceID, func(ctx context.Context, visit func(*archiverspb.VisibilityRecord) error) error {
+		return v.forEachRecord(ctx, URI, request.NamespaceID, visit)
+	}); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	records, nextPageToken, err := archiver.QueryVisibilityManifest(
+		ctx,
+		v.manifestStore(URI),
+		request.NamespaceID,
+		visibilityQuery,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		if err == archiver.ErrNextPageTokenCorrupted {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, manifestRecord := range records {
+		// Manifest doesn't have memo, therefore the archived record is downloaded.
+		key := constructTimestampIndex(URI.Path(), request.NamespaceID, primaryIndexKeyWorkflowID, manifestRecord.GetWorkflowId(), secondaryIndexKeyCloseTimeout, timestamp.TimeValue(manifestRecord.CloseTime), manifestRecord.GetRunId())
+		encodedRecord, err := download(ctx, v.s3cli, URI, key)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	return response, nil
+}
+
+// forEachRecord calls visit for every visibility record archived in the namespace.
+// Every record is found by its close time index by workflow ID.
+func (v *visibilityArchiver) forEachRecord(
+	ctx context.Context,
+	URI archiver.URI,
+	namespaceID string,
+	visit func(*archiverspb.VisibilityRecord) error,
+) error {
+	prefix := strings.TrimLeft(strings.Join([]string{URI.Path(), namespaceID, "visibility", primaryIndexKeyWorkflowID}, "/"), "/") + "/"
+	var continuationToken *string
+	for {
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range results.Contents {
+			// Key has the format: prefix/workflowID/closeTimeout/closeTime/runID
+			pieces := strings.Split(*item.Key, "/")
+			if len(pieces) < 3 || pieces[len(pieces)-3] != secondaryIndexKeyCloseTimeout {
+				continue
+			}
+			encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+			if err != nil {
+				return err
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return err
+			}
+			if err := visit(record); err != nil {
+				return err
+			}
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			return nil
+		}
+		continuationToken = results.NextContinuationToken
+	}
+}
+
+func (v *visibilityArchiver) manifestStore(URI archiver.URI) *visibilityManifestStore {
+	return &visibilityManifestStore{
+		s3cli: v.s3cli,
+		URI:   URI,
+	}
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
@@ -268,3 +390,41 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	}
 	return bucketExists(context.TODO(), v.s3cli, URI)
 }
+
+func (s *visibilityManifestStore) Put(ctx context.Context, key string, data []byte) error {
+	return upload(ctx, s.s3cli, s.URI, s.objectKey(key), data)
+}
+
+func (s *visibilityManifestStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return download(ctx, s.s3cli, s.URI, s.objectKey(key))
+}
+
+func (s *visibilityManifestStore) List(ctx context.Context, prefix string) ([]string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	var keys []string
+	var continuationToken *string
+	for {
+		results, err := s.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(s.URI.Hostname()),
+			Prefix:            aws.String(s.objectKey(prefix) + "/"),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range results.Contents {
+			keys = append(keys, strings.TrimPrefix(*item.Key, s.objectKey("")))
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			return keys, nil
+		}
+		continuationToken = results.NextContinuationToken
+	}
+}
+
+// objectKey returns key of the manifest object in the bucket.
+func (s *visibilityManifestStore) objectKey(key string) string {
+	return strings.TrimLeft(strings.Join([]string{s.URI.Path(), key}, "/"), "/")
+}
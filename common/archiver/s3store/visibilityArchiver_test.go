@@ -122,6 +122,7 @@ func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchive
 		container:   s.container,
 		s3cli:       s.s3cli,
 		queryParser: NewQueryParser(),
+		manifest:    archiver.NewVisibilityManifest(),
 	}
 	return archiver
 }
@@ -0,0 +1,550 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// VisibilityManifestStore is the storage visibility manifests are kept in.
+	// Keys are slash separated paths relative to the archival URI.
+	VisibilityManifestStore interface {
+		Put(ctx context.Context, key string, data []byte) error
+		Get(ctx context.Context, key string) ([]byte, error)
+		// List returns keys of all objects under the prefix.
+		List(ctx context.Context, prefix string) ([]string, error)
+	}
+
+	// VisibilityManifest maintains per-day manifests of archived visibility records, which allow
+	// visibility archivers to answer queries without listing every archived record.
+	//
+	// A manifest of a day lists all records closed on that day (UTC) as newline delimited JSON encoded
+	// VisibilityRecords without memo. Manifest is split into segments and every segment is written once.
+	// Records added to a day are buffered into the open segment of the day, which is written when it reaches
+	// visibilityManifestSegmentSize bytes or visibilityManifestFlushInterval after its first record was added,
+	// whichever comes first. Add returns once the segment with the record is written, so records are batched
+	// across concurrent archivers without losing the guarantee that archived records are listed in the manifest.
+	// Segment keys are unique per VisibilityManifest instance, so concurrent archivers never overwrite
+	// each other's entries.
+	//
+	// Records archived before manifests were introduced are added to the manifests by Backfill, which visibility
+	// archivers run before the first query of the namespace. Backfill runs once per namespace, it writes
+	// a marker once all archived records are added. Records archived by hosts not yet upgraded after
+	// the marker is written are not listed in any manifest.
+	VisibilityManifest struct {
+		instanceID    string
+		sequence      int64
+		segmentSize   int
+		flushInterval time.Duration
+
+		sync.Mutex
+		days map[string]*visibilityManifestDay
+		// backfills are the backfills of the namespaces queried by this instance, keyed by URI and namespace ID.
+		backfills map[string]*visibilityManifestBackfill
+	}
+
+	// ArchivedVisibilityRecords calls visit for every visibility record archived in the namespace.
+	ArchivedVisibilityRecords func(ctx context.Context, visit func(record *archiverspb.VisibilityRecord) error) error
+
+	visibilityManifestBackfill struct {
+		// done is closed when the backfill completes, err is the result of the backfill.
+		done chan struct{}
+		err  error
+	}
+
+	visibilityManifestDay struct {
+		id     string
+		prefix string
+		store  VisibilityManifestStore
+		// open is the segment records are added to, it is nil if there are no buffered records.
+		// Protected by VisibilityManifest lock.
+		open *visibilityManifestSegment
+	}
+
+	visibilityManifestSegment struct {
+		entries [][]byte
+		size    int
+		timer   *time.Timer
+		// done is closed when the segment is written, err is the result of the write.
+		done chan struct{}
+		err  error
+	}
+
+	visibilityManifestToken struct {
+		// Day is the close day of the last record of the page for queries ordered by CloseTime.
+		Day      string
+		SortTime time.Time
+		RunID    string
+	}
+)
+
+const (
+	visibilityManifestDir       = "manifest"
+	visibilityManifestDayLayout = "2006-01-02"
+	// visibilityManifestSegmentSize is the size in bytes segment is written at without waiting for more records.
+	visibilityManifestSegmentSize = 4 * 1024 * 1024
+	// visibilityManifestFlushInterval is the longest time records are buffered before the segment is written.
+	visibilityManifestFlushInterval = 200 * time.Millisecond
+	// visibilityManifestWriteTimeout is the timeout of writing a segment.
+	visibilityManifestWriteTimeout = 10 * time.Second
+	// maxVisibilityManifestDaysToList is the longest close time range which manifests are listed day by day,
+	// manifests of longer ranges are found by listing all manifests of the namespace.
+	maxVisibilityManifestDaysToList = 31
+	// visibilityManifestBackfillDir is the directory of the namespace manifests the backfill marker is written to.
+	visibilityManifestBackfillDir = "backfill"
+	// visibilityManifestBackfillTimeout is the timeout of adding records archived before manifests were
+	// introduced to the manifests of a namespace.
+	visibilityManifestBackfillTimeout = time.Hour
+	// visibilityManifestBackfillBufferSize is the size in bytes of entries backfill buffers before writing them.
+	visibilityManifestBackfillBufferSize = 16 * visibilityManifestSegmentSize
+)
+
+// NewVisibilityManifest creates a new VisibilityManifest
+func NewVisibilityManifest() *VisibilityManifest {
+	return &VisibilityManifest{
+		instanceID:    uuid.New(),
+		segmentSize:   visibilityManifestSegmentSize,
+		flushInterval: visibilityManifestFlushInterval,
+		days:          make(map[string]*visibilityManifestDay),
+		backfills:     make(map[string]*visibilityManifestBackfill),
+	}
+}
+
+// Add adds the record to the manifest of the day the record was closed on and waits until the segment
+// with the record is written. Adding the same record more than once is allowed, duplicates are removed
+// by QueryVisibilityManifest.
+func (m *VisibilityManifest) Add(
+	ctx context.Context,
+	URI URI,
+	store VisibilityManifestStore,
+	record *archiverspb.VisibilityRecord,
+) error {
+	entry, err := encodeVisibilityManifestEntry(record)
+	if err != nil {
+		return err
+	}
+
+	dayPrefix := visibilityManifestDayPrefix(record.GetNamespaceId(), timestamp.TimeValue(record.GetCloseTime()))
+	dayID := URI.String() + "/" + dayPrefix
+
+	m.Lock()
+	day, ok := m.days[dayID]
+	if !ok {
+		day = &visibilityManifestDay{id: dayID, prefix: dayPrefix, store: store}
+		m.days[dayID] = day
+	}
+	segment := day.open
+	if segment == nil {
+		segment = &visibilityManifestSegment{done: make(chan struct{})}
+		segment.timer = time.AfterFunc(m.flushInterval, func() {
+			m.flush(day, segment)
+		})
+		day.open = segment
+	}
+	segment.entries = append(segment.entries, entry)
+	segment.size += len(entry) + 1
+	full := segment.size >= m.segmentSize
+	m.Unlock()
+
+	if full && segment.timer.Stop() {
+		m.flush(day, segment)
+	}
+
+	select {
+	case <-segment.done:
+		return segment.err
+	case <-ctx.Done():
+		// Segment is still written, but caller can't wait for it.
+		return ctx.Err()
+	}
+}
+
+// flush closes the segment for new records and writes it.
+func (m *VisibilityManifest) flush(
+	day *visibilityManifestDay,
+	segment *visibilityManifestSegment,
+) {
+	m.Lock()
+	if day.open == segment {
+		day.open = nil
+		delete(m.days, day.id)
+	}
+	key := fmt.Sprintf("%s/%s_%d", day.prefix, m.instanceID, atomic.AddInt64(&m.sequence, 1))
+	m.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), visibilityManifestWriteTimeout)
+	defer cancel()
+	segment.err = day.store.Put(ctx, key, bytes.Join(segment.entries, []byte("\n")))
+	close(segment.done)
+}
+
+// Backfill adds records archived before manifests were introduced to the manifests of the namespace
+// unless it was already done, and waits until it completes. Backfill keeps running in background
+// when ctx expires, the next call waits for the same backfill. Failed backfill is started over by the next call.
+func (m *VisibilityManifest) Backfill(
+	ctx context.Context,
+	URI URI,
+	store VisibilityManifestStore,
+	namespaceID string,
+	records ArchivedVisibilityRecords,
+) error {
+	backfillID := URI.String() + "/" + namespaceID
+
+	m.Lock()
+	backfill, ok := m.backfills[backfillID]
+	if !ok || (backfill.completed() && backfill.err != nil) {
+		backfill = &visibilityManifestBackfill{done: make(chan struct{})}
+		m.backfills[backfillID] = backfill
+		go func() {
+			backfillCtx, cancel := context.WithTimeout(context.Background(), visibilityManifestBackfillTimeout)
+			defer cancel()
+			backfill.err = m.backfill(backfillCtx, store, namespaceID, records)
+			close(backfill.done)
+		}()
+	}
+	m.Unlock()
+
+	select {
+	case <-backfill.done:
+		return backfill.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *VisibilityManifest) backfill(
+	ctx context.Context,
+	store VisibilityManifestStore,
+	namespaceID string,
+	records ArchivedVisibilityRecords,
+) error {
+	markerPrefix := strings.Join([]string{visibilityManifestNamespacePrefix(namespaceID), visibilityManifestBackfillDir}, "/")
+	markers, err := store.List(ctx, markerPrefix)
+	if err != nil {
+		return err
+	}
+	if len(markers) != 0 {
+		return nil
+	}
+
+	// Entries are buffered per day and written in segments, same as records added by Add. All days are
+	// written once visibilityManifestBackfillBufferSize bytes are buffered. Records written by a backfill
+	// which failed later are written again by the next one, duplicates are removed by QueryVisibilityManifest.
+	days := make(map[string][][]byte)
+	sizes := make(map[string]int)
+	buffered := 0
+	write := func(dayPrefix string) error {
+		key := fmt.Sprintf("%s/%s_%s_%d", dayPrefix, visibilityManifestBackfillDir, m.instanceID, atomic.AddInt64(&m.sequence, 1))
+		if err := store.Put(ctx, key, bytes.Join(days[dayPrefix], []byte("\n"))); err != nil {
+			return err
+		}
+		buffered -= sizes[dayPrefix]
+		delete(days, dayPrefix)
+		delete(sizes, dayPrefix)
+		return nil
+	}
+	writeAll := func() error {
+		for dayPrefix := range days {
+			if err := write(dayPrefix); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := records(ctx, func(record *archiverspb.VisibilityRecord) error {
+		entry, err := encodeVisibilityManifestEntry(record)
+		if err != nil {
+			return err
+		}
+		dayPrefix := visibilityManifestDayPrefix(namespaceID, timestamp.TimeValue(record.GetCloseTime()))
+		days[dayPrefix] = append(days[dayPrefix], entry)
+		sizes[dayPrefix] += len(entry) + 1
+		buffered += len(entry) + 1
+		if sizes[dayPrefix] >= m.segmentSize {
+			return write(dayPrefix)
+		}
+		if buffered >= visibilityManifestBackfillBufferSize {
+			return writeAll()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := writeAll(); err != nil {
+		return err
+	}
+	return store.Put(ctx, markerPrefix+"/done", []byte(time.Now().UTC().Format(time.RFC3339)))
+}
+
+func (b *visibilityManifestBackfill) completed() bool {
+	select {
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// QueryVisibilityManifest returns the next page of records matching the query from the manifests of
+// the days within the close time range of the query. Returned records don't have memo.
+//
+// Queries ordered by CloseTime read manifests one day at a time in the order of the query and stop as soon
+// as the page is full, next page token points to the day and the last record of the page. Queries ordered
+// by StartTime can't be answered without reading manifests of all days within the close time range,
+// they read them on every page.
+func QueryVisibilityManifest(
+	ctx context.Context,
+	store VisibilityManifestStore,
+	namespaceID string,
+	query *VisibilityQuery,
+	pageSize int,
+	nextPageToken []byte,
+) ([]*archiverspb.VisibilityRecord, []byte, error) {
+	var token *visibilityManifestToken
+	if len(nextPageToken) != 0 {
+		token = &visibilityManifestToken{}
+		if err := json.Unmarshal(nextPageToken, token); err != nil {
+			return nil, nil, ErrNextPageTokenCorrupted
+		}
+	}
+
+	days, listDay, err := listVisibilityManifestDays(ctx, store, namespaceID, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	groups := make([][]string, 0, len(days))
+	if query.OrderBy == searchattribute.CloseTime {
+		if !query.Ascending {
+			for i, j := 0, len(days)-1; i < j; i, j = i+1, j-1 {
+				days[i], days[j] = days[j], days[i]
+			}
+		}
+		for _, day := range days {
+			groups = append(groups, []string{day})
+		}
+	} else if len(days) != 0 {
+		groups = append(groups, days)
+	}
+
+	var result []*archiverspb.VisibilityRecord
+	for i, group := range groups {
+		groupID := ""
+		if query.OrderBy == searchattribute.CloseTime {
+			groupID = group[0]
+		}
+		if token != nil && token.Day != "" && (query.Ascending && groupID < token.Day || !query.Ascending && groupID > token.Day) {
+			// Day was returned by the previous pages.
+			continue
+		}
+		// Tokens without day are issued by the older version, which didn't read manifests by day.
+		groupToken := token
+		if token != nil && token.Day != "" && groupID != token.Day {
+			groupToken = nil
+		}
+
+		var keys []string
+		for _, day := range group {
+			dayKeys, err := listDay(ctx, day)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, dayKeys...)
+		}
+		records, err := readVisibilityManifests(ctx, store, keys, query, groupToken)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, record := range records {
+			if len(result) == pageSize {
+				return encodeVisibilityManifestToken(query, groupID, result)
+			}
+			result = append(result, record)
+		}
+		if len(result) == pageSize && i < len(groups)-1 {
+			return encodeVisibilityManifestToken(query, groupID, result)
+		}
+	}
+	return result, nil, nil
+}
+
+// readVisibilityManifests returns records matching the query from the manifest segments ordered by the query.
+// Only records after the token are returned if the token is not nil.
+func readVisibilityManifests(
+	ctx context.Context,
+	store VisibilityManifestStore,
+	keys []string,
+	query *VisibilityQuery,
+	token *visibilityManifestToken,
+) ([]*archiverspb.VisibilityRecord, error) {
+	records := make(map[string]*archiverspb.VisibilityRecord)
+	for _, key := range keys {
+		data, err := store.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range bytes.Split(data, []byte("\n")) {
+			if len(entry) == 0 {
+				continue
+			}
+			record, err := decodeVisibilityManifestEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			if !query.Match(record) {
+				continue
+			}
+			if token != nil && !query.before(token.SortTime, token.RunID, query.SortTime(record), record.GetRunId()) {
+				continue
+			}
+			// Same record can be archived more than once, the one closed last wins.
+			if existing, ok := records[record.GetRunId()]; ok && !timestamp.TimeValue(existing.GetCloseTime()).Before(timestamp.TimeValue(record.GetCloseTime())) {
+				continue
+			}
+			records[record.GetRunId()] = record
+		}
+	}
+
+	result := make([]*archiverspb.VisibilityRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, record)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return query.Less(result[i], result[j])
+	})
+	return result, nil
+}
+
+func encodeVisibilityManifestToken(
+	query *VisibilityQuery,
+	day string,
+	result []*archiverspb.VisibilityRecord,
+) ([]*archiverspb.VisibilityRecord, []byte, error) {
+	last := result[len(result)-1]
+	token, err := json.Marshal(&visibilityManifestToken{
+		Day:      day,
+		SortTime: query.SortTime(last),
+		RunID:    last.GetRunId(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, token, nil
+}
+
+// listVisibilityManifestDays returns days within the close time range of the query in ascending order
+// and the function which lists manifest segments of a day.
+func listVisibilityManifestDays(
+	ctx context.Context,
+	store VisibilityManifestStore,
+	namespaceID string,
+	query *VisibilityQuery,
+) ([]string, func(ctx context.Context, day string) ([]string, error), error) {
+	earliest := query.EarliestCloseTime.UTC().Truncate(24 * time.Hour)
+	latest := query.LatestCloseTime.UTC().Truncate(24 * time.Hour)
+	if query.EarliestCloseTime.IsZero() || query.LatestCloseTime.IsZero() ||
+		latest.Sub(earliest) > maxVisibilityManifestDaysToList*24*time.Hour {
+		keys, err := store.List(ctx, visibilityManifestNamespacePrefix(namespaceID))
+		if err != nil {
+			return nil, nil, err
+		}
+		dayKeys := make(map[string][]string)
+		var days []string
+		for _, key := range keys {
+			dayID := visibilityManifestKeyDay(key)
+			day, err := time.Parse(visibilityManifestDayLayout, dayID)
+			if err != nil {
+				continue
+			}
+			if (!query.EarliestCloseTime.IsZero() && day.Before(earliest)) ||
+				(!query.LatestCloseTime.IsZero() && day.After(latest)) {
+				continue
+			}
+			if _, ok := dayKeys[dayID]; !ok {
+				days = append(days, dayID)
+			}
+			dayKeys[dayID] = append(dayKeys[dayID], key)
+		}
+		sort.Strings(days)
+		return days, func(_ context.Context, day string) ([]string, error) {
+			return dayKeys[day], nil
+		}, nil
+	}
+
+	var days []string
+	for day := earliest; !day.After(latest); day = day.Add(24 * time.Hour) {
+		days = append(days, day.Format(visibilityManifestDayLayout))
+	}
+	return days, func(ctx context.Context, day string) ([]string, error) {
+		return store.List(ctx, strings.Join([]string{visibilityManifestNamespacePrefix(namespaceID), day}, "/"))
+	}, nil
+}
+
+func visibilityManifestNamespacePrefix(namespaceID string) string {
+	return strings.Join([]string{visibilityManifestDir, namespaceID}, "/")
+}
+
+func visibilityManifestDayPrefix(namespaceID string, closeTime time.Time) string {
+	return strings.Join([]string{visibilityManifestNamespacePrefix(namespaceID), closeTime.UTC().Format(visibilityManifestDayLayout)}, "/")
+}
+
+// visibilityManifestKeyDay returns day part of manifest segment key.
+func visibilityManifestKeyDay(key string) string {
+	pieces := strings.Split(key, "/")
+	if len(pieces) < 2 {
+		return ""
+	}
+	return pieces[len(pieces)-2]
+}
+
+func encodeVisibilityManifestEntry(record *archiverspb.VisibilityRecord) ([]byte, error) {
+	entry := *record
+	entry.Memo = nil
+	return codec.NewJSONPBEncoder().Encode(&entry)
+}
+
+func decodeVisibilityManifestEntry(data []byte) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	if err := codec.NewJSONPBEncoder().Decode(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,395 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	visibilityManifestSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		URI   URI
+		store *testVisibilityManifestStore
+	}
+
+	testVisibilityManifestStore struct {
+		sync.Mutex
+		objects map[string][]byte
+		gets    []string
+	}
+
+	failingVisibilityManifestStore struct {
+		*testVisibilityManifestStore
+		failures int
+	}
+)
+
+func TestVisibilityManifestSuite(t *testing.T) {
+	suite.Run(t, new(visibilityManifestSuite))
+}
+
+func (s *visibilityManifestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	var err error
+	s.URI, err = NewURI("test:///archival")
+	s.NoError(err)
+	s.store = &testVisibilityManifestStore{objects: make(map[string][]byte)}
+}
+
+func (s *visibilityManifestSuite) TestAddAndQuery() {
+	manifest := s.newManifest(time.Second)
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	records := make([]*archiverspb.VisibilityRecord, 0, 7)
+	for i := 0; i < 6; i++ {
+		records = append(records, s.newRecord(i, day.Add(time.Duration(i)*12*time.Hour)))
+	}
+	// Records archived more than once are returned once.
+	records = append(records, s.newRecord(5, day.Add(5*12*time.Hour)))
+	s.addConcurrently(manifest, records)
+
+	// Records added together are batched into one segment per day.
+	s.Len(s.store.objects, 3)
+	for key, data := range s.store.objects {
+		s.True(strings.HasPrefix(key, "manifest/test-namespace-id/2021-06-0"), key)
+		s.NotContains(string(data), "memo")
+	}
+
+	query, err := ParseVisibilityQuery("CustomIntField >= 1 order by StartTime asc", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	var runIDs []string
+	var token []byte
+	for {
+		records, nextPageToken, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 2, token)
+		s.NoError(err)
+		for _, record := range records {
+			s.Nil(record.Memo)
+			runIDs = append(runIDs, record.GetRunId())
+		}
+		if nextPageToken == nil {
+			break
+		}
+		token = nextPageToken
+	}
+	s.Equal([]string{"run-1", "run-2", "run-3", "run-4", "run-5"}, runIDs)
+
+	query, err = ParseVisibilityQuery("CloseTime >= '2021-06-02T00:00:00Z' AND CloseTime < '2021-06-03T00:00:00Z'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	records, nextPageToken, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 10, nil)
+	s.NoError(err)
+	s.Nil(nextPageToken)
+	s.Len(records, 2)
+	s.Equal("run-3", records[0].GetRunId())
+	s.Equal("run-2", records[1].GetRunId())
+
+	records, _, err = QueryVisibilityManifest(context.Background(), s.store, "other-namespace-id", query, 10, nil)
+	s.NoError(err)
+	s.Empty(records)
+
+	_, _, err = QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 10, []byte("invalid"))
+	s.Equal(ErrNextPageTokenCorrupted, err)
+}
+
+func (s *visibilityManifestSuite) TestAdd_SegmentPerInstance() {
+	closeTime := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	s.NoError(s.newManifest(time.Millisecond).Add(context.Background(), s.URI, s.store, s.newRecord(1, closeTime)))
+	s.NoError(s.newManifest(time.Millisecond).Add(context.Background(), s.URI, s.store, s.newRecord(2, closeTime)))
+	s.Len(s.store.objects, 2)
+
+	query, err := ParseVisibilityQuery("order by CloseTime", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	records, _, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 10, nil)
+	s.NoError(err)
+	s.Len(records, 2)
+}
+
+func (s *visibilityManifestSuite) TestAdd_Concurrent() {
+	manifest := s.newManifest(time.Second)
+	closeTime := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	var records []*archiverspb.VisibilityRecord
+	for i := 0; i < 50; i++ {
+		records = append(records, s.newRecord(i, closeTime))
+	}
+	s.addConcurrently(manifest, records)
+
+	query, err := ParseVisibilityQuery("order by CloseTime", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	result, _, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 100, nil)
+	s.NoError(err)
+	s.Len(result, 50)
+	s.Len(s.store.objects, 1)
+	for _, data := range s.store.objects {
+		s.Len(strings.Split(string(data), "\n"), 50)
+	}
+}
+
+func (s *visibilityManifestSuite) TestAdd_SegmentSize() {
+	// Segments are written as soon as they are full without waiting for the flush interval.
+	manifest := s.newManifest(time.Hour)
+	manifest.segmentSize = 1
+	closeTime := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		s.NoError(manifest.Add(context.Background(), s.URI, s.store, s.newRecord(i, closeTime)))
+	}
+	s.Len(s.store.objects, 3)
+}
+
+func (s *visibilityManifestSuite) TestAdd_FailedSegment() {
+	manifest := s.newManifest(time.Millisecond)
+	closeTime := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	store := &failingVisibilityManifestStore{testVisibilityManifestStore: s.store, failures: 1}
+	s.Error(manifest.Add(context.Background(), s.URI, store, s.newRecord(1, closeTime)))
+	// Archiver retries archival of the record after failure.
+	s.NoError(manifest.Add(context.Background(), s.URI, store, s.newRecord(1, closeTime)))
+	s.NoError(manifest.Add(context.Background(), s.URI, store, s.newRecord(2, closeTime)))
+
+	s.Len(s.store.objects, 2)
+	query, err := ParseVisibilityQuery("order by CloseTime", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	records, _, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 10, nil)
+	s.NoError(err)
+	s.Len(records, 2)
+}
+
+func (s *visibilityManifestSuite) TestAdd_ContextCanceled() {
+	manifest := s.newManifest(time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := manifest.Add(ctx, s.URI, s.store, s.newRecord(1, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)))
+	s.Equal(context.Canceled, err)
+}
+
+func (s *visibilityManifestSuite) TestQuery_CloseTimePages() {
+	manifest := s.newManifest(time.Millisecond)
+	day := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 6; i++ {
+		s.NoError(manifest.Add(context.Background(), s.URI, s.store, s.newRecord(i, day.Add(time.Duration(i/2)*24*time.Hour+time.Duration(i)*time.Hour))))
+	}
+
+	query, err := ParseVisibilityQuery("CloseTime >= '2021-06-01T00:00:00Z' AND CloseTime < '2021-06-10T00:00:00Z'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	var runIDs []string
+	var token []byte
+	for {
+		s.store.gets = nil
+		records, nextPageToken, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 3, token)
+		s.NoError(err)
+		// Only manifests of the days of the page are read.
+		s.LessOrEqual(len(s.store.gets), 4)
+		for _, record := range records {
+			runIDs = append(runIDs, record.GetRunId())
+		}
+		if nextPageToken == nil {
+			break
+		}
+		token = nextPageToken
+	}
+	s.Equal([]string{"run-5", "run-4", "run-3", "run-2", "run-1", "run-0"}, runIDs)
+
+	query, err = ParseVisibilityQuery("order by CloseTime asc", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.store.gets = nil
+	records, nextPageToken, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 1, nil)
+	s.NoError(err)
+	// Both segments of the first day.
+	s.Len(s.store.gets, 2)
+	s.Equal("run-0", records[0].GetRunId())
+	records, _, err = QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 2, nextPageToken)
+	s.NoError(err)
+	s.Equal("run-1", records[0].GetRunId())
+	s.Equal("run-2", records[1].GetRunId())
+}
+
+func (s *visibilityManifestSuite) TestBackfill() {
+	manifest := s.newManifest(time.Millisecond)
+	s.NoError(manifest.Add(context.Background(), s.URI, s.store, s.newRecord(0, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC))))
+
+	legacyRecords := []*archiverspb.VisibilityRecord{
+		s.newRecord(1, time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)),
+		s.newRecord(2, time.Date(2021, 5, 2, 10, 0, 0, 0, time.UTC)),
+		s.newRecord(3, time.Date(2021, 5, 2, 11, 0, 0, 0, time.UTC)),
+	}
+	var calls int32
+	records := func(ctx context.Context, visit func(record *archiverspb.VisibilityRecord) error) error {
+		atomic.AddInt32(&calls, 1)
+		for _, record := range legacyRecords {
+			if err := visit(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.NoError(manifest.Backfill(context.Background(), s.URI, s.store, "test-namespace-id", records))
+		}()
+	}
+	wg.Wait()
+	// Backfill is not repeated by other instances either.
+	s.NoError(s.newManifest(time.Millisecond).Backfill(context.Background(), s.URI, s.store, "test-namespace-id", records))
+	s.Equal(int32(1), atomic.LoadInt32(&calls))
+	s.Contains(s.store.objects, "manifest/test-namespace-id/backfill/done")
+
+	query, err := ParseVisibilityQuery("order by CloseTime desc", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	result, _, err := QueryVisibilityManifest(context.Background(), s.store, "test-namespace-id", query, 10, nil)
+	s.NoError(err)
+	var runIDs []string
+	for _, record := range result {
+		runIDs = append(runIDs, record.GetRunId())
+	}
+	s.Equal([]string{"run-0", "run-3", "run-2", "run-1"}, runIDs)
+}
+
+func (s *visibilityManifestSuite) TestBackfill_Failed() {
+	manifest := s.newManifest(time.Millisecond)
+	records := func(ctx context.Context, visit func(record *archiverspb.VisibilityRecord) error) error {
+		return visit(s.newRecord(1, time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)))
+	}
+	store := &failingVisibilityManifestStore{testVisibilityManifestStore: s.store, failures: 1}
+	s.Error(manifest.Backfill(context.Background(), s.URI, store, "test-namespace-id", records))
+	s.Empty(s.store.objects)
+	// Failed backfill is started over.
+	s.NoError(manifest.Backfill(context.Background(), s.URI, store, "test-namespace-id", records))
+	s.Len(s.store.objects, 2)
+}
+
+func (s *visibilityManifestSuite) TestBackfill_ContextCanceled() {
+	manifest := s.newManifest(time.Millisecond)
+	release := make(chan struct{})
+	records := func(ctx context.Context, visit func(record *archiverspb.VisibilityRecord) error) error {
+		<-release
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Equal(context.Canceled, manifest.Backfill(ctx, s.URI, s.store, "test-namespace-id", records))
+	// Backfill keeps running and the next call waits for it.
+	close(release)
+	s.NoError(manifest.Backfill(context.Background(), s.URI, s.store, "test-namespace-id", records))
+	s.Len(s.store.objects, 1)
+}
+
+func (s *visibilityManifestSuite) newManifest(flushInterval time.Duration) *VisibilityManifest {
+	manifest := NewVisibilityManifest()
+	manifest.flushInterval = flushInterval
+	return manifest
+}
+
+func (s *visibilityManifestSuite) addConcurrently(manifest *VisibilityManifest, records []*archiverspb.VisibilityRecord) {
+	var wg sync.WaitGroup
+	for _, record := range records {
+		wg.Add(1)
+		go func(record *archiverspb.VisibilityRecord) {
+			defer wg.Done()
+			s.NoError(manifest.Add(context.Background(), s.URI, s.store, record))
+		}(record)
+	}
+	wg.Wait()
+}
+
+func (s *visibilityManifestSuite) newRecord(i int, closeTime time.Time) *archiverspb.VisibilityRecord {
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:      "test-namespace-id",
+		Namespace:        "test-namespace",
+		WorkflowId:       fmt.Sprintf("workflow-%d", i),
+		RunId:            fmt.Sprintf("run-%d", i),
+		WorkflowTypeName: "test-workflow-type",
+		StartTime:        timestamp.TimePtr(closeTime.Add(-time.Hour)),
+		CloseTime:        timestamp.TimePtr(closeTime),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{
+				"testFields": payload.EncodeBytes([]byte{1, 2, 3}),
+			},
+		},
+		SearchAttributes: map[string]string{
+			"CustomIntField": fmt.Sprintf("%d", i),
+		},
+	}
+}
+
+func (s *testVisibilityManifestStore) Put(_ context.Context, key string, data []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.objects[key]; ok {
+		return errors.New("manifest segment is written more than once")
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *testVisibilityManifestStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.gets = append(s.gets, key)
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return data, nil
+}
+
+func (s *testVisibilityManifestStore) List(_ context.Context, prefix string) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	var keys []string
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *failingVisibilityManifestStore) Put(ctx context.Context, key string, data []byte) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("put failed")
+	}
+	return s.testVisibilityManifestStore.Put(ctx, key, data)
+}
@@ -0,0 +1,669 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// VisibilityQuery is an archived visibility query parsed by ParseVisibilityQuery.
+	// It supports AND/OR combinations of comparisons on workflow fields and on search attributes
+	// persisted in the VisibilityRecord, and ordering by StartTime or CloseTime.
+	VisibilityQuery struct {
+		// EarliestCloseTime and LatestCloseTime bound the close time of every record matching the query.
+		// Zero value means the bound is open.
+		EarliestCloseTime time.Time
+		LatestCloseTime   time.Time
+		// OrderBy is the name of the time field records are ordered by.
+		OrderBy string
+		// Ascending is true if records are ordered from the earliest to the latest.
+		Ascending bool
+
+		filter visibilityFilter
+	}
+
+	visibilityFilter func(record *archiverspb.VisibilityRecord) bool
+
+	visibilityQueryField struct {
+		name   string
+		saType enumspb.IndexedValueType
+		// value returns value of the field in the record, which is a slice for search attributes holding lists.
+		value func(record *archiverspb.VisibilityRecord) (interface{}, bool)
+	}
+)
+
+const (
+	// workflowTypeNameField is an alias of WorkflowType used by queries written for the s3store archiver.
+	workflowTypeNameField = "WorkflowTypeName"
+
+	visibilityQueryTemplate        = "select * from dummy where %s"
+	visibilityOrderByQueryTemplate = "select * from dummy %s"
+)
+
+var (
+	errInvalidVisibilityQuery = errors.New("invalid visibility query")
+
+	// visibilityRecordFields are system search attributes which are stored as VisibilityRecord fields.
+	visibilityRecordFields = map[string]func(record *archiverspb.VisibilityRecord) (interface{}, bool){
+		searchattribute.WorkflowID: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return record.GetWorkflowId(), true
+		},
+		searchattribute.RunID: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return record.GetRunId(), true
+		},
+		searchattribute.WorkflowType: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return record.GetWorkflowTypeName(), true
+		},
+		searchattribute.StartTime: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return timeValue(record.GetStartTime())
+		},
+		searchattribute.ExecutionTime: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return timeValue(record.GetExecutionTime())
+		},
+		searchattribute.CloseTime: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return timeValue(record.GetCloseTime())
+		},
+		searchattribute.ExecutionStatus: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return int64(record.GetStatus()), true
+		},
+		searchattribute.HistoryLength: func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+			return record.GetHistoryLength(), true
+		},
+	}
+)
+
+// ParseVisibilityQuery parses where clause of archived visibility query optionally followed by order by clause.
+// Records are ordered by CloseTime (latest first) unless order by clause is specified.
+func ParseVisibilityQuery(query string, saTypeMap searchattribute.NameTypeMap) (*VisibilityQuery, error) {
+	sqlQuery := fmt.Sprintf(visibilityQueryTemplate, query)
+	if common.IsJustOrderByClause(query) {
+		sqlQuery = fmt.Sprintf(visibilityOrderByQueryTemplate, query)
+	}
+
+	// IMPORTANT: This query is never executed, it is just used to parse the visibility query.
+	stmt, err := sqlparser.Parse(sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, errInvalidVisibilityQuery
+	}
+
+	visibilityQuery := &VisibilityQuery{
+		OrderBy: searchattribute.CloseTime,
+		filter: func(*archiverspb.VisibilityRecord) bool {
+			return true
+		},
+	}
+	if sel.Where != nil {
+		parser := &visibilityQueryParser{saTypeMap: saTypeMap}
+		if visibilityQuery.filter, err = parser.convertWhereExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+		if err := visibilityQuery.setCloseTimeRange(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+	if err := visibilityQuery.setOrderBy(sel.OrderBy); err != nil {
+		return nil, err
+	}
+	return visibilityQuery, nil
+}
+
+// Match returns true if the record matches the query.
+func (q *VisibilityQuery) Match(record *archiverspb.VisibilityRecord) bool {
+	closeTime := timestamp.TimeValue(record.GetCloseTime())
+	if !q.EarliestCloseTime.IsZero() && closeTime.Before(q.EarliestCloseTime) {
+		return false
+	}
+	if !q.LatestCloseTime.IsZero() && closeTime.After(q.LatestCloseTime) {
+		return false
+	}
+	return q.filter(record)
+}
+
+// SortTime returns time the record is ordered by.
+func (q *VisibilityQuery) SortTime(record *archiverspb.VisibilityRecord) time.Time {
+	if q.OrderBy == searchattribute.StartTime {
+		return timestamp.TimeValue(record.GetStartTime())
+	}
+	return timestamp.TimeValue(record.GetCloseTime())
+}
+
+// Less returns true if record a goes before record b in the query order. Run ID is used as tie-breaker.
+func (q *VisibilityQuery) Less(a *archiverspb.VisibilityRecord, b *archiverspb.VisibilityRecord) bool {
+	return q.before(q.SortTime(a), a.GetRunId(), q.SortTime(b), b.GetRunId())
+}
+
+func (q *VisibilityQuery) before(aTime time.Time, aRunID string, bTime time.Time, bRunID string) bool {
+	if aTime.Equal(bTime) {
+		return aRunID < bRunID
+	}
+	if q.Ascending {
+		return aTime.Before(bTime)
+	}
+	return aTime.After(bTime)
+}
+
+func (q *VisibilityQuery) setOrderBy(orderBy sqlparser.OrderBy) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if len(orderBy) > 1 {
+		return errors.New("only one order by field is supported")
+	}
+	colName, ok := orderBy[0].Expr.(*sqlparser.ColName)
+	if !ok {
+		return errInvalidVisibilityQuery
+	}
+	name := colName.Name.String()
+	if name != searchattribute.StartTime && name != searchattribute.CloseTime {
+		return fmt.Errorf("order by %s is not supported, only %s and %s are supported", name, searchattribute.StartTime, searchattribute.CloseTime)
+	}
+	q.OrderBy = name
+	q.Ascending = orderBy[0].Direction == sqlparser.AscScr
+	return nil
+}
+
+// setCloseTimeRange narrows close time range using CloseTime comparisons which all matching records must satisfy,
+// i.e. comparisons which are not under OR expression.
+func (q *VisibilityQuery) setCloseTimeRange(expr sqlparser.Expr) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := q.setCloseTimeRange(expr.Left); err != nil {
+			return err
+		}
+		return q.setCloseTimeRange(expr.Right)
+	case *sqlparser.ParenExpr:
+		return q.setCloseTimeRange(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		colName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || colName.Name.String() != searchattribute.CloseTime {
+			return nil
+		}
+		value, err := convertQueryValue(expr.Right, enumspb.INDEXED_VALUE_TYPE_DATETIME)
+		if err != nil {
+			return err
+		}
+		closeTime := value.(time.Time)
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			q.EarliestCloseTime = common.MaxTime(q.EarliestCloseTime, closeTime)
+			q.LatestCloseTime = minTime(q.LatestCloseTime, closeTime)
+		case sqlparser.LessThanStr:
+			q.LatestCloseTime = minTime(q.LatestCloseTime, closeTime.Add(-time.Nanosecond))
+		case sqlparser.LessEqualStr:
+			q.LatestCloseTime = minTime(q.LatestCloseTime, closeTime)
+		case sqlparser.GreaterThanStr:
+			q.EarliestCloseTime = common.MaxTime(q.EarliestCloseTime, closeTime.Add(time.Nanosecond))
+		case sqlparser.GreaterEqualStr:
+			q.EarliestCloseTime = common.MaxTime(q.EarliestCloseTime, closeTime)
+		}
+	case *sqlparser.RangeCond:
+		colName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || colName.Name.String() != searchattribute.CloseTime || expr.Operator != sqlparser.BetweenStr {
+			return nil
+		}
+		from, err := convertQueryValue(expr.From, enumspb.INDEXED_VALUE_TYPE_DATETIME)
+		if err != nil {
+			return err
+		}
+		to, err := convertQueryValue(expr.To, enumspb.INDEXED_VALUE_TYPE_DATETIME)
+		if err != nil {
+			return err
+		}
+		q.EarliestCloseTime = common.MaxTime(q.EarliestCloseTime, from.(time.Time))
+		q.LatestCloseTime = minTime(q.LatestCloseTime, to.(time.Time))
+	}
+	return nil
+}
+
+type visibilityQueryParser struct {
+	saTypeMap searchattribute.NameTypeMap
+}
+
+func (p *visibilityQueryParser) convertWhereExpr(expr sqlparser.Expr) (visibilityFilter, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := p.convertLogicalExpr(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return left(record) && right(record)
+		}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := p.convertLogicalExpr(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return left(record) || right(record)
+		}, nil
+	case *sqlparser.NotExpr:
+		filter, err := p.convertWhereExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return !filter(record)
+		}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertWhereExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(expr)
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidVisibilityQuery, sqlparser.String(expr))
+	}
+}
+
+func (p *visibilityQueryParser) convertLogicalExpr(
+	left sqlparser.Expr,
+	right sqlparser.Expr,
+) (visibilityFilter, visibilityFilter, error) {
+	leftFilter, err := p.convertWhereExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightFilter, err := p.convertWhereExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftFilter, rightFilter, nil
+}
+
+func (p *visibilityQueryParser) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (visibilityFilter, error) {
+	field, err := p.convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		value, err := field.convertValue(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return field.filter(func(fieldValue interface{}) bool {
+			cmp, ok := compareQueryValues(fieldValue, value)
+			return ok && cmp == 0
+		}, expr.Operator == sqlparser.NotEqualStr), nil
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		if field.name == searchattribute.ExecutionStatus || field.saType == enumspb.INDEXED_VALUE_TYPE_BOOL {
+			return nil, fmt.Errorf("operator %s is not supported for %s", expr.Operator, field.name)
+		}
+		value, err := field.convertValue(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		operator := expr.Operator
+		return field.filter(func(fieldValue interface{}) bool {
+			cmp, ok := compareQueryValues(fieldValue, value)
+			if !ok {
+				return false
+			}
+			switch operator {
+			case sqlparser.LessThanStr:
+				return cmp < 0
+			case sqlparser.LessEqualStr:
+				return cmp <= 0
+			case sqlparser.GreaterThanStr:
+				return cmp > 0
+			default:
+				return cmp >= 0
+			}
+		}, false), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok || len(tuple) == 0 {
+			return nil, fmt.Errorf("invalid values for %s operator", expr.Operator)
+		}
+		values := make([]interface{}, len(tuple))
+		for i, valueExpr := range tuple {
+			value, err := field.convertValue(valueExpr)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return field.filter(func(fieldValue interface{}) bool {
+			for _, value := range values {
+				if cmp, ok := compareQueryValues(fieldValue, value); ok && cmp == 0 {
+					return true
+				}
+			}
+			return false
+		}, expr.Operator == sqlparser.NotInStr), nil
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+}
+
+func (p *visibilityQueryParser) convertRangeCond(expr *sqlparser.RangeCond) (visibilityFilter, error) {
+	field, err := p.convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Operator != sqlparser.BetweenStr && expr.Operator != sqlparser.NotBetweenStr {
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+	from, err := field.convertValue(expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := field.convertValue(expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return field.filter(func(fieldValue interface{}) bool {
+		fromCmp, fromOk := compareQueryValues(fieldValue, from)
+		toCmp, toOk := compareQueryValues(fieldValue, to)
+		return fromOk && toOk && fromCmp >= 0 && toCmp <= 0
+	}, expr.Operator == sqlparser.NotBetweenStr), nil
+}
+
+func (p *visibilityQueryParser) convertField(expr sqlparser.Expr) (*visibilityQueryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if name == workflowTypeNameField {
+		name = searchattribute.WorkflowType
+	}
+	saType, err := p.saTypeMap.GetType(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown filter name: %s", name)
+	}
+
+	field := &visibilityQueryField{
+		name:   name,
+		saType: saType,
+	}
+	if value, isRecordField := visibilityRecordFields[name]; isRecordField {
+		field.value = value
+		return field, nil
+	}
+	if _, isSystem := p.saTypeMap.System()[name]; isSystem && !isPredefined(name) {
+		return nil, fmt.Errorf("filter %s is not supported for archived workflows", name)
+	}
+	field.value = func(record *archiverspb.VisibilityRecord) (interface{}, bool) {
+		valueStr, ok := record.GetSearchAttributes()[name]
+		if !ok {
+			return nil, false
+		}
+		value, err := decodeSearchAttributeValue(name, valueStr, p.saTypeMap)
+		return value, err == nil
+	}
+	return field, nil
+}
+
+func (f *visibilityQueryField) convertValue(expr sqlparser.Expr) (interface{}, error) {
+	var value interface{}
+	var err error
+	if f.name == searchattribute.ExecutionStatus {
+		value, err = convertExecutionStatus(expr)
+	} else {
+		value, err = convertQueryValue(expr, f.saType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %s for %s: %v", sqlparser.String(expr), f.name, err)
+	}
+	return value, nil
+}
+
+// filter builds a filter which applies predicate to the field value, and negates the result if negate is true.
+// Search attributes holding lists match predicate if any of list values matches it.
+// Records without the field never match, same as NULL values in SQL.
+func (f *visibilityQueryField) filter(predicate func(fieldValue interface{}) bool, negate bool) visibilityFilter {
+	return func(record *archiverspb.VisibilityRecord) bool {
+		fieldValue, ok := f.value(record)
+		if !ok {
+			return false
+		}
+		matched := false
+		if list := reflect.ValueOf(fieldValue); list.Kind() == reflect.Slice {
+			for i := 0; i < list.Len() && !matched; i++ {
+				matched = predicate(list.Index(i).Interface())
+			}
+		} else {
+			matched = predicate(fieldValue)
+		}
+		return matched != negate
+	}
+}
+
+func isPredefined(name string) bool {
+	switch name {
+	case searchattribute.TemporalChangeVersion, searchattribute.BinaryChecksums, searchattribute.BatcherNamespace, searchattribute.BatcherUser:
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeSearchAttributeValue(name string, valueStr string, saTypeMap searchattribute.NameTypeMap) (interface{}, error) {
+	searchAttributes, err := searchattribute.Parse(map[string]string{name: valueStr}, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+	saType, err := saTypeMap.GetType(name)
+	if err != nil {
+		return nil, err
+	}
+	return searchattribute.DecodeValue(searchAttributes.GetIndexedFields()[name], saType)
+}
+
+// convertExecutionStatus supports statuses passed as names (i.e. "Completed" or "continued_as_new") and as integers.
+func convertExecutionStatus(expr sqlparser.Expr) (interface{}, error) {
+	value, err := parseQueryValue(expr)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case string:
+		if status, err := strconv.ParseInt(v, 10, 32); err == nil {
+			return status, nil
+		}
+		name := strings.ReplaceAll(v, "_", "")
+		for statusName, status := range enumspb.WorkflowExecutionStatus_value {
+			if strings.EqualFold(statusName, name) {
+				return int64(status), nil
+			}
+		}
+	}
+	return nil, errors.New("unknown execution status")
+}
+
+// convertQueryValue converts value parsed from query to the type of the field.
+// Datetime values can be passed as RFC3339 strings and as integer nanoseconds since epoch.
+func convertQueryValue(expr sqlparser.Expr, saType enumspb.IndexedValueType) (interface{}, error) {
+	value, err := parseQueryValue(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case int64:
+			return timestamp.UnixOrZeroTime(v), nil
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, err
+			}
+			return t.UTC(), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unexpected value type %T", searchattribute.ErrInvalidType, value)
+}
+
+func parseQueryValue(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	}
+	return nil, errors.New("unsupported value type")
+}
+
+// compareQueryValues compares record value with query value of the same type.
+// It returns false if values are not comparable.
+func compareQueryValues(recordValue interface{}, queryValue interface{}) (int, bool) {
+	switch q := queryValue.(type) {
+	case string:
+		r, ok := recordValue.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(r, q), true
+	case int64:
+		r, ok := recordValue.(int64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case r < q:
+			return -1, true
+		case r > q:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case float64:
+		switch r := recordValue.(type) {
+		case float64:
+			return compareFloats(r, q), true
+		case int64:
+			return compareFloats(float64(r), q), true
+		}
+	case bool:
+		r, ok := recordValue.(bool)
+		if !ok || r != q {
+			return 1, ok
+		}
+		return 0, true
+	case time.Time:
+		r, ok := recordValue.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case r.Before(q):
+			return -1, true
+		case r.After(q):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func timeValue(t *time.Time) (interface{}, bool) {
+	if t == nil || t.IsZero() {
+		return nil, false
+	}
+	return t.UTC(), true
+}
+
+// minTime returns the earlier of two times treating zero time as an open bound.
+func minTime(a time.Time, b time.Time) time.Time {
+	if a.IsZero() {
+		return b
+	}
+	return common.MinTime(a, b)
+}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+
+		record *archiverspb.VisibilityRecord
+	}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &archiverspb.VisibilityRecord{
+		NamespaceId:      "test-namespace-id",
+		WorkflowId:       "test-workflow-id",
+		RunId:            "test-run-id",
+		WorkflowTypeName: "test-workflow-type",
+		StartTime:        timestamp.TimePtr(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)),
+		CloseTime:        timestamp.TimePtr(time.Date(2021, 6, 2, 10, 0, 0, 0, time.UTC)),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+		HistoryLength:    42,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `["audit","billing"]`,
+			"CustomIntField":     "7",
+			"CustomBoolField":    "true",
+		},
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	testCases := []struct {
+		query   string
+		matches bool
+	}{
+		{query: "WorkflowId = 'test-workflow-id'", matches: true},
+		{query: "WorkflowId != 'test-workflow-id'", matches: false},
+		{query: "WorkflowTypeName = 'test-workflow-type' AND RunId = 'test-run-id'", matches: true},
+		{query: "WorkflowType = 'other' OR HistoryLength > 40", matches: true},
+		{query: "WorkflowType = 'other' OR (HistoryLength > 40 AND ExecutionStatus = 'Completed')", matches: false},
+		{query: "ExecutionStatus = 'continued_as_new'", matches: true},
+		{query: "ExecutionStatus in ('Failed', 6)", matches: true},
+		{query: "StartTime >= '2021-06-01T00:00:00Z' AND StartTime < '2021-06-01T12:00:00Z'", matches: true},
+		{query: "StartTime between '2021-06-02T00:00:00Z' and '2021-06-03T00:00:00Z'", matches: false},
+		{query: "ExecutionTime > '2021-06-01T00:00:00Z'", matches: false},
+		{query: "CustomKeywordField = 'billing'", matches: true},
+		{query: "CustomKeywordField not in ('audit')", matches: false},
+		{query: "CustomIntField >= 7 AND CustomBoolField = true", matches: true},
+		{query: "CustomDoubleField < 1", matches: false},
+		{query: "not (CustomIntField = 7)", matches: false},
+		{query: "order by StartTime asc", matches: true},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		s.Equal(tc.matches, query.Match(s.record), tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParse_Invalid() {
+	testCases := []string{
+		"some invalid query",
+		"UnknownField = 'value'",
+		"TaskQueue = 'test-task-queue'",
+		"ExecutionStatus > 'Completed'",
+		"CustomIntField = 'abc'",
+		"WorkflowId = 'id' order by WorkflowId",
+		"WorkflowId = 'id' order by StartTime, CloseTime",
+		"WorkflowId = 'id' limit 10",
+	}
+
+	for _, query := range testCases {
+		_, err := ParseVisibilityQuery(query, searchattribute.TestNameTypeMap)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParse_CloseTimeRange() {
+	query, err := ParseVisibilityQuery(
+		"CloseTime >= '2021-06-01T00:00:00Z' AND (CloseTime < '2021-06-03T00:00:00Z' AND WorkflowId = 'id') AND (CloseTime > '2021-05-01T00:00:00Z' OR RunId = 'id')",
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.Equal(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), query.EarliestCloseTime)
+	s.Equal(time.Date(2021, 6, 3, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), query.LatestCloseTime)
+
+	query, err = ParseVisibilityQuery("CloseTime > '2021-06-01T00:00:00Z' OR CloseTime < '2021-05-01T00:00:00Z'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.True(query.EarliestCloseTime.IsZero())
+	s.True(query.LatestCloseTime.IsZero())
+}
+
+func (s *visibilityQuerySuite) TestParse_OrderBy() {
+	query, err := ParseVisibilityQuery("WorkflowId = 'id'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(searchattribute.CloseTime, query.OrderBy)
+	s.False(query.Ascending)
+
+	query, err = ParseVisibilityQuery("WorkflowId = 'id' order by StartTime asc", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(searchattribute.StartTime, query.OrderBy)
+	s.True(query.Ascending)
+
+	query, err = ParseVisibilityQuery("order by CloseTime desc", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(searchattribute.CloseTime, query.OrderBy)
+	s.False(query.Ascending)
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -80,13 +81,15 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+func GetAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(&config.Policy, logger)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
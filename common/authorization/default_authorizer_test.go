@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 var (
@@ -168,6 +169,9 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigNoop() {
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigDefault() {
 	s.testGetAuthorizerFromConfig("default", true, reflect.TypeOf(&defaultAuthorizer{}))
 }
+func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigPolicy() {
+	s.testGetAuthorizerFromConfig("policy", true, reflect.TypeOf(&policyAuthorizer{}))
+}
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 	s.testGetAuthorizerFromConfig("foo", false, nil)
 }
@@ -175,7 +179,7 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
-	auth, err := GetAuthorizerFromConfig(&cfg)
+	auth, err := GetAuthorizerFromConfig(&cfg, log.NewNoopLogger())
 	if valid {
 		s.NoError(err)
 		s.NotNil(auth)
@@ -0,0 +1,396 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"gopkg.in/yaml.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+
+	apiGroupReadOnlyNamespace = "@readOnlyNamespace"
+	apiGroupReadOnlyGlobal    = "@readOnlyGlobal"
+
+	minPolicyPollInterval = time.Second * 5
+)
+
+type (
+	policyAuthorizer struct {
+		config          config.AuthorizationPolicy
+		defaultDecision Decision
+		configRules     []*policyRule
+		rules           atomic.Value // []*policyRule
+		lastUpdatedTime time.Time
+		logger          log.Logger
+		ticker          *time.Ticker
+		stop            chan struct{}
+	}
+
+	policyRule struct {
+		name          string
+		decision      Decision
+		subjects      []string
+		roles         Role
+		namespaces    []string
+		apis          []string
+		workflowTypes []string
+		taskQueues    []string
+	}
+
+	// policyFile is the format of file with additional rules
+	policyFile struct {
+		Rules []config.AuthorizationRule `yaml:"rules"`
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer which evaluates allow and deny rules from config
+// and logs every decision to audit log.
+func NewPolicyAuthorizer(cfg *config.AuthorizationPolicy, logger log.Logger) (*policyAuthorizer, error) {
+	defaultDecision, err := parsePolicyEffect(cfg.DefaultDecision, DecisionDeny)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default decision: %w", err)
+	}
+	configRules, err := newPolicyRules(cfg.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &policyAuthorizer{
+		config:          *cfg,
+		defaultDecision: defaultDecision,
+		configRules:     configRules,
+		logger:          log.With(logger, tag.ComponentAuthorizationAudit),
+	}
+	a.rules.Store(configRules)
+
+	if cfg.Filepath == "" {
+		return a, nil
+	}
+	if cfg.PollInterval < minPolicyPollInterval {
+		return nil, fmt.Errorf("policy poll interval should be at least %v", minPolicyPollInterval)
+	}
+	if err := a.update(); err != nil {
+		return nil, err
+	}
+	a.stop = make(chan struct{})
+	a.ticker = time.NewTicker(cfg.PollInterval)
+	go a.timerCallback()
+	return a, nil
+}
+
+// Stop stops reloading of rules from file
+func (a *policyAuthorizer) Stop() {
+	if a.ticker == nil {
+		return
+	}
+	a.ticker.Stop()
+	close(a.stop)
+}
+
+func (a *policyAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	api := ApiName(target.APIName)
+	workflowType, taskQueue := getWorkflowTypeAndTaskQueue(target.Request)
+
+	decision := a.defaultDecision
+	ruleName := ""
+	allowed := false
+	for _, rule := range a.getRules() {
+		if !rule.matches(claims, target.Namespace, api, workflowType, taskQueue) {
+			continue
+		}
+		if rule.decision == DecisionDeny {
+			decision = DecisionDeny
+			ruleName = rule.name
+			break
+		}
+		if !allowed {
+			allowed = true
+			decision = DecisionAllow
+			ruleName = rule.name
+		}
+	}
+
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	a.logger.Info("Authorization decision",
+		tag.NewStringTag("subject", subject),
+		tag.WorkflowNamespace(target.Namespace),
+		tag.NewStringTag("api", api),
+		tag.WorkflowType(workflowType),
+		tag.WorkflowTaskQueueName(taskQueue),
+		tag.NewStringTag("decision", decisionToString(decision)),
+		tag.NewStringTag("rule", ruleName),
+	)
+	return Result{Decision: decision}, nil
+}
+
+func (a *policyAuthorizer) getRules() []*policyRule {
+	return a.rules.Load().([]*policyRule)
+}
+
+func (a *policyAuthorizer) timerCallback() {
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-a.ticker.C:
+		}
+		if err := a.update(); err != nil {
+			a.logger.Error("Failed to update authorization policy", tag.Error(err))
+		}
+	}
+}
+
+// update reloads rules from file if file was modified since last update.
+// If file can't be loaded, previous rules are kept.
+func (a *policyAuthorizer) update() error {
+	info, err := os.Stat(a.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to get status of authorization policy file: %v", err)
+	}
+	if !info.ModTime().After(a.lastUpdatedTime) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(a.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read authorization policy file %v: %v", a.config.Filepath, err)
+	}
+	var file policyFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("failed to decode authorization policy file %v: %v", a.config.Filepath, err)
+	}
+	fileRules, err := newPolicyRules(file.Rules)
+	if err != nil {
+		return err
+	}
+
+	rules := make([]*policyRule, 0, len(a.configRules)+len(fileRules))
+	rules = append(rules, a.configRules...)
+	rules = append(rules, fileRules...)
+	a.rules.Store(rules)
+	a.lastUpdatedTime = info.ModTime()
+	a.logger.Info("Updated authorization policy", tag.NewInt("rules", len(rules)))
+	return nil
+}
+
+func newPolicyRules(rules []config.AuthorizationRule) ([]*policyRule, error) {
+	result := make([]*policyRule, 0, len(rules))
+	for i, rule := range rules {
+		r, err := newPolicyRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authorization rule %d %q: %w", i, rule.Name, err)
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func newPolicyRule(rule config.AuthorizationRule) (*policyRule, error) {
+	if rule.Effect == "" {
+		return nil, errors.New("effect is required")
+	}
+	decision, err := parsePolicyEffect(rule.Effect, DecisionDeny)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := RoleUndefined
+	for _, r := range rule.Roles {
+		role, err := parseRole(r)
+		if err != nil {
+			return nil, err
+		}
+		roles |= role
+	}
+
+	for _, patterns := range [][]string{rule.Subjects, rule.Namespaces, rule.APIs, rule.WorkflowTypes, rule.TaskQueues} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+
+	return &policyRule{
+		name:          rule.Name,
+		decision:      decision,
+		subjects:      rule.Subjects,
+		roles:         roles,
+		namespaces:    rule.Namespaces,
+		apis:          rule.APIs,
+		workflowTypes: rule.WorkflowTypes,
+		taskQueues:    rule.TaskQueues,
+	}, nil
+}
+
+func (r *policyRule) matches(claims *Claims, namespace string, api string, workflowType string, taskQueue string) bool {
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	if !matchesAny(r.subjects, subject) || !matchesAny(r.namespaces, namespace) {
+		return false
+	}
+	if r.roles != RoleUndefined {
+		if claims == nil {
+			return false
+		}
+		role := claims.System | claims.Namespaces[strings.ToLower(namespace)]
+		if withImpliedRoles(role)&r.roles == 0 {
+			return false
+		}
+	}
+	if !r.matchesAPI(api) {
+		return false
+	}
+	if len(r.workflowTypes) > 0 && (workflowType == "" || !matchesAny(r.workflowTypes, workflowType)) {
+		return false
+	}
+	if len(r.taskQueues) > 0 && (taskQueue == "" || !matchesAny(r.taskQueues, taskQueue)) {
+		return false
+	}
+	return true
+}
+
+func (r *policyRule) matchesAPI(api string) bool {
+	if len(r.apis) == 0 {
+		return true
+	}
+	for _, pattern := range r.apis {
+		switch pattern {
+		case apiGroupReadOnlyNamespace:
+			if IsReadOnlyNamespaceAPI(api) {
+				return true
+			}
+		case apiGroupReadOnlyGlobal:
+			if IsReadOnlyGlobalAPI(api) {
+				return true
+			}
+		default:
+			if matched, _ := path.Match(pattern, api); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func getWorkflowTypeAndTaskQueue(request interface{}) (string, string) {
+	var workflowType string
+	var taskQueue string
+	if r, ok := request.(hasWorkflowType); ok {
+		workflowType = r.GetWorkflowType().GetName()
+	}
+	if r, ok := request.(hasTaskQueue); ok {
+		taskQueue = r.GetTaskQueue().GetName()
+	}
+	return workflowType, taskQueue
+}
+
+func parsePolicyEffect(effect string, defaultDecision Decision) (Decision, error) {
+	switch strings.ToLower(effect) {
+	case "":
+		return defaultDecision, nil
+	case policyEffectAllow:
+		return DecisionAllow, nil
+	case policyEffectDeny:
+		return DecisionDeny, nil
+	}
+	return 0, fmt.Errorf("unknown effect: %s", effect)
+}
+
+func parseRole(role string) (Role, error) {
+	switch strings.ToLower(role) {
+	case "worker":
+		return RoleWorker, nil
+	case "reader":
+		return RoleReader, nil
+	case "writer":
+		return RoleWriter, nil
+	case "admin":
+		return RoleAdmin, nil
+	}
+	return RoleUndefined, fmt.Errorf("unknown role: %s", role)
+}
+
+// withImpliedRoles adds all roles implied by the given roles. Roles are ordered the same way as in
+// defaultAuthorizer, i.e. admin is also a writer, writer is also a reader and reader is also a worker.
+func withImpliedRoles(role Role) Role {
+	for r := RoleAdmin; r > RoleWorker; r >>= 1 {
+		if role&r != 0 {
+			role |= r >> 1
+		}
+	}
+	return role
+}
+
+func decisionToString(decision Decision) string {
+	if decision == DecisionAllow {
+		return policyEffectAllow
+	}
+	return policyEffectDeny
+}
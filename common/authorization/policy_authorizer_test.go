@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package authorization
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	startWorkflowExecutionAPI = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	describeNamespaceAPI      = "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"
+	terminateWorkflowAPI      = "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"
+)
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	s := new(policyAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *policyAuthorizerSuite) TestDefaultDecision() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{})
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemAdmin, describeNamespaceAPI, "bar", nil)
+
+	authorizer = s.newAuthorizer(config.AuthorizationPolicy{DefaultDecision: "allow"})
+	s.assertDecision(authorizer, DecisionAllow, nil, describeNamespaceAPI, "bar", nil)
+}
+
+func (s *policyAuthorizerSuite) TestInvalidConfig() {
+	for _, cfg := range []config.AuthorizationPolicy{
+		{DefaultDecision: "maybe"},
+		{Rules: []config.AuthorizationRule{{Name: "no effect"}}},
+		{Rules: []config.AuthorizationRule{{Effect: "allow", Roles: []string{"owner"}}}},
+		{Rules: []config.AuthorizationRule{{Effect: "allow", Namespaces: []string{"["}}}},
+		{Filepath: "/does/not/exist", PollInterval: time.Minute},
+		{Filepath: "/does/not/exist", PollInterval: time.Second},
+	} {
+		_, err := NewPolicyAuthorizer(&cfg, log.NewNoopLogger())
+		s.Error(err)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestSubjectAndNamespace() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "team", Effect: "allow", Subjects: []string{"team-*"}, Namespaces: []string{"team-ns-*"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &Claims{Subject: "team-a"}, describeNamespaceAPI, "team-ns-1", nil)
+	s.assertDecision(authorizer, DecisionDeny, &Claims{Subject: "team-a"}, describeNamespaceAPI, "other-ns", nil)
+	s.assertDecision(authorizer, DecisionDeny, &Claims{Subject: "other"}, describeNamespaceAPI, "team-ns-1", nil)
+	s.assertDecision(authorizer, DecisionDeny, nil, describeNamespaceAPI, "team-ns-1", nil)
+}
+
+func (s *policyAuthorizerSuite) TestRolesAndAPIs() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "readers", Effect: "allow", Roles: []string{"reader"}, APIs: []string{apiGroupReadOnlyNamespace, apiGroupReadOnlyGlobal}},
+			{Name: "writers", Effect: "allow", Roles: []string{"writer"}, APIs: []string{"*"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemUndefinedNamespaceReader, describeNamespaceAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemUndefinedNamespaceReader, describeNamespaceAPI, "foo", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemUndefinedNamespaceReader, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemWriter, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, nil, describeNamespaceAPI, "bar", nil)
+}
+
+func (s *policyAuthorizerSuite) TestRolesImplyLowerRoles() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "workers", Effect: "allow", Roles: []string{"worker"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &Claims{System: RoleWorker}, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &Claims{System: RoleWorker | RoleReader}, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemReader, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &Claims{Namespaces: map[string]Role{"bar": RoleAdmin}}, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &Claims{System: RoleUndefined}, startWorkflowExecutionAPI, "bar", nil)
+
+	authorizer = s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "admins", Effect: "allow", Roles: []string{"admin"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemWriter, startWorkflowExecutionAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &Claims{System: RoleWorker | RoleReader | RoleWriter}, startWorkflowExecutionAPI, "bar", nil)
+}
+
+func (s *policyAuthorizerSuite) TestAnyOfRoles() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "readers and admins", Effect: "allow", Roles: []string{"admin", "reader"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemReader, describeNamespaceAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, describeNamespaceAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &Claims{System: RoleWorker}, describeNamespaceAPI, "bar", nil)
+}
+
+func (s *policyAuthorizerSuite) TestDenyOverridesAllow() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "all", Effect: "allow"},
+			{Name: "no terminate", Effect: "deny", Namespaces: []string{"prod-*"}, APIs: []string{"Terminate*"}},
+		},
+	})
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, terminateWorkflowAPI, "dev", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemAdmin, terminateWorkflowAPI, "prod-1", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemAdmin, describeNamespaceAPI, "prod-1", nil)
+}
+
+func (s *policyAuthorizerSuite) TestWorkflowTypeAndTaskQueue() {
+	authorizer := s.newAuthorizer(config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "billing", Effect: "allow", WorkflowTypes: []string{"Billing*"}, TaskQueues: []string{"billing"}},
+		},
+	})
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:    "bar",
+		WorkflowType: &commonpb.WorkflowType{Name: "BillingWorkflow"},
+		TaskQueue:    &taskqueuepb.TaskQueue{Name: "billing"},
+	}
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemWriter, startWorkflowExecutionAPI, "bar", request)
+
+	request.TaskQueue.Name = "other"
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemWriter, startWorkflowExecutionAPI, "bar", request)
+
+	request.TaskQueue.Name = "billing"
+	request.WorkflowType.Name = "OtherWorkflow"
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemWriter, startWorkflowExecutionAPI, "bar", request)
+
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemWriter, describeNamespaceAPI, "bar", &workflowservice.DescribeNamespaceRequest{Namespace: "bar"})
+}
+
+func (s *policyAuthorizerSuite) TestReloadFromFile() {
+	file, err := ioutil.TempFile("", "authorization_policy_*.yaml")
+	s.NoError(err)
+	defer func() { _ = os.Remove(file.Name()) }()
+	s.writePolicyFile(file.Name(), `
+rules:
+  - name: readers
+    effect: allow
+    apis: ["Describe*"]
+`, time.Now().Add(-time.Minute))
+
+	authorizer, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{
+		Rules: []config.AuthorizationRule{
+			{Name: "no bar", Effect: "deny", Namespaces: []string{"bar"}},
+		},
+		Filepath:     file.Name(),
+		PollInterval: time.Minute,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	defer authorizer.Stop()
+
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemReader, describeNamespaceAPI, "foo", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemReader, describeNamespaceAPI, "bar", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemReader, startWorkflowExecutionAPI, "foo", nil)
+
+	s.writePolicyFile(file.Name(), `
+rules:
+  - name: writers
+    effect: allow
+    apis: ["Start*"]
+`, time.Now())
+	s.NoError(authorizer.update())
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemReader, describeNamespaceAPI, "foo", nil)
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemReader, startWorkflowExecutionAPI, "foo", nil)
+	s.assertDecision(authorizer, DecisionDeny, &claimsSystemReader, startWorkflowExecutionAPI, "bar", nil)
+
+	// invalid file keeps previous rules
+	s.writePolicyFile(file.Name(), `
+rules:
+  - name: invalid
+    effect: maybe
+`, time.Now().Add(time.Minute))
+	s.Error(authorizer.update())
+	s.assertDecision(authorizer, DecisionAllow, &claimsSystemReader, startWorkflowExecutionAPI, "foo", nil)
+}
+
+func (s *policyAuthorizerSuite) newAuthorizer(cfg config.AuthorizationPolicy) Authorizer {
+	authorizer, err := NewPolicyAuthorizer(&cfg, log.NewNoopLogger())
+	s.NoError(err)
+	return authorizer
+}
+
+func (s *policyAuthorizerSuite) writePolicyFile(name string, content string, modTime time.Time) {
+	s.NoError(ioutil.WriteFile(name, []byte(content), 0644))
+	s.NoError(os.Chtimes(name, modTime, modTime))
+}
+
+func (s *policyAuthorizerSuite) assertDecision(
+	authorizer Authorizer,
+	expected Decision,
+	claims *Claims,
+	api string,
+	namespace string,
+	request interface{},
+) {
+	result, err := authorizer.Authorize(nil, claims, &CallTarget{
+		APIName:   api,
+		Namespace: namespace,
+		Request:   request,
+	})
+	s.NoError(err)
+	s.Equal(expected, result.Decision, "api: %s, namespace: %s", api, namespace)
+}
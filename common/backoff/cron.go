@@ -25,7 +25,12 @@
 package backoff
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+	// Embed tz database so time zones in cron schedules are resolved the same way on every host.
+	_ "time/tzdata"
 
 	"github.com/robfig/cron"
 	"go.temporal.io/api/serviceerror"
@@ -33,20 +38,48 @@ import (
 	"go.temporal.io/server/common/convert"
 )
 
-// NoBackoff is used to represent backoff when no cron backoff is needed
-const NoBackoff = time.Duration(-1)
+const (
+	// NoBackoff is used to represent backoff when no cron backoff is needed
+	NoBackoff = time.Duration(-1)
+
+	cronTimeZonePrefix = "CRON_TZ="
+	timeZonePrefix     = "TZ="
+)
+
+type (
+	// zonedSchedule evaluates cron spec against wall clock of location instead of UTC.
+	zonedSchedule struct {
+		schedule cron.Schedule
+		location *time.Location
+	}
+)
+
+var _ cron.Schedule = (*zonedSchedule)(nil)
 
 // ValidateSchedule validates a cron schedule spec
 func ValidateSchedule(cronSchedule string) error {
 	if cronSchedule == "" {
 		return nil
 	}
-	if _, err := cron.ParseStandard(cronSchedule); err != nil {
-		return serviceerror.NewInvalidArgument("Invalid CronSchedule.")
+	if _, err := parseSchedule(cronSchedule); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid CronSchedule: %v.", err))
 	}
 	return nil
 }
 
+// GetScheduleTimeZone returns name of the time zone cron schedule is evaluated in.
+// Empty string is returned if cron schedule is empty or invalid.
+func GetScheduleTimeZone(cronSchedule string) string {
+	if cronSchedule == "" {
+		return ""
+	}
+	location, _, err := parseTimeZone(cronSchedule)
+	if err != nil {
+		return ""
+	}
+	return location.String()
+}
+
 // GetBackoffForNextSchedule calculates the backoff time for the next run given
 // a cronSchedule, current scheduled time, and now.
 func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now time.Time) time.Duration {
@@ -54,7 +87,7 @@ func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now
 		return NoBackoff
 	}
 
-	schedule, err := cron.ParseStandard(cronSchedule)
+	schedule, err := parseSchedule(cronSchedule)
 	if err != nil {
 		return NoBackoff
 	}
@@ -68,9 +101,12 @@ func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now
 	} else {
 		nextScheduleTime = schedule.Next(scheduledUTCTime)
 		// Calculate the next schedule start time which is nearest to now (right after now).
-		for nextScheduleTime.Before(nowUTC) {
+		for !nextScheduleTime.IsZero() && nextScheduleTime.Before(nowUTC) {
 			nextScheduleTime = schedule.Next(nextScheduleTime)
 		}
+		if nextScheduleTime.IsZero() {
+			return NoBackoff
+		}
 	}
 
 	backoffInterval := nextScheduleTime.Sub(nowUTC)
@@ -86,3 +122,105 @@ func GetBackoffForNextScheduleNonNegative(cronSchedule string, scheduledTime tim
 	}
 	return &backoffDuration
 }
+
+// parseSchedule parses cron spec with optional CRON_TZ= or TZ= prefix.
+// Schedules without prefix are evaluated in UTC.
+func parseSchedule(cronSchedule string) (cron.Schedule, error) {
+	location, spec, err := parseTimeZone(cronSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	if location == time.UTC {
+		return schedule, nil
+	}
+	if _, ok := schedule.(*cron.SpecSchedule); !ok {
+		// @every schedules are fixed intervals and don't depend on wall clock.
+		return schedule, nil
+	}
+	return &zonedSchedule{
+		schedule: schedule,
+		location: location,
+	}, nil
+}
+
+func parseTimeZone(cronSchedule string) (*time.Location, string, error) {
+	spec := strings.TrimSpace(cronSchedule)
+	var prefix string
+	switch {
+	case strings.HasPrefix(spec, cronTimeZonePrefix):
+		prefix = cronTimeZonePrefix
+	case strings.HasPrefix(spec, timeZonePrefix):
+		prefix = timeZonePrefix
+	default:
+		return time.UTC, spec, nil
+	}
+
+	fields := strings.SplitN(spec[len(prefix):], " ", 2)
+	name := fields[0]
+	if len(fields) < 2 || strings.TrimSpace(fields[1]) == "" {
+		return nil, "", errors.New("missing cron spec after time zone")
+	}
+	// time.LoadLocation treats empty name as UTC and "Local" as host time zone,
+	// which would make schedule depend on host configuration.
+	if name == "" || name == "Local" {
+		return nil, "", fmt.Errorf("invalid time zone %q", name)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid time zone %q", name)
+	}
+	return location, strings.TrimSpace(fields[1]), nil
+}
+
+// Next returns next activation time later than t.
+// If wall clock time occurs twice (clock is set back), schedule fires only at first occurrence.
+// If wall clock time doesn't exist (clock is set forward), schedule fires shifted forward by the gap.
+func (s *zonedSchedule) Next(t time.Time) time.Time {
+	wallClock := toWallClock(t.In(s.location))
+	for {
+		wallClock = s.schedule.Next(wallClock)
+		if wallClock.IsZero() {
+			return time.Time{}
+		}
+		if next := fromWallClock(wallClock, s.location); next.After(t) {
+			return next
+		}
+	}
+}
+
+// toWallClock returns wall clock reading of t as UTC time.
+func toWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallClock returns the earliest time in location which has wallClock reading.
+// If there is no such time, the time wallClock would be if clock wasn't set forward is returned.
+func fromWallClock(wallClock time.Time, location *time.Location) time.Time {
+	var result time.Time
+	var shifted time.Time
+	// Offset can't be more than a day, so every offset which can apply to wallClock is in effect within this window.
+	for _, probe := range []time.Time{wallClock.Add(-24 * time.Hour), wallClock, wallClock.Add(24 * time.Hour)} {
+		_, offset := probe.In(location).Zone()
+		candidate := wallClock.Add(-time.Duration(offset) * time.Second)
+		candidateWallClock := toWallClock(candidate.In(location))
+		switch {
+		case candidateWallClock.Equal(wallClock):
+			if result.IsZero() || candidate.Before(result) {
+				result = candidate
+			}
+		case candidateWallClock.After(wallClock):
+			if shifted.IsZero() || candidateWallClock.Before(toWallClock(shifted.In(location))) {
+				shifted = candidate
+			}
+		}
+	}
+	if result.IsZero() {
+		return shifted
+	}
+	return result
+}
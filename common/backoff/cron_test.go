@@ -54,6 +54,19 @@ var crontests = []struct {
 	{"@every 30s", "2020-07-17T09:00:02-01:00", "2020-07-17T09:00:02-01:00", time.Second * 30},
 	{"@every 30s", "2020-07-17T09:00:02-01:00", "2020-09-17T03:00:53-01:00", time.Second * 9},
 	{"@every 30m", "2020-07-17T09:00:00-01:00", "2020-07-17T08:45:00-01:00", time.Minute * 15},
+	{"CRON_TZ=Europe/Oslo 0 9 * * *", "2021-03-27T09:00:00+01:00", "", time.Hour * 23},
+	{"TZ=Europe/Oslo 0 9 * * *", "2021-10-30T09:00:00+02:00", "", time.Hour * 25},
+	{"TZ=America/New_York 0 9 * * *", "2021-03-13T09:00:00-05:00", "", time.Hour * 23},
+	{"CRON_TZ=Europe/Oslo 30 2 * * *", "2021-03-27T02:30:00+01:00", "", time.Hour * 24},
+	{"CRON_TZ=Europe/Oslo 30 2 * * *", "2021-10-30T02:30:00+02:00", "", time.Hour * 24},
+	{"CRON_TZ=Europe/Oslo 30 2 * * *", "2021-10-31T02:30:00+02:00", "", time.Hour * 25},
+	{"CRON_TZ=Europe/Oslo */30 * * * *", "2021-10-31T02:30:00+02:00", "", time.Minute * 90},
+	{"CRON_TZ=Europe/Oslo 0 9 * * *", "2021-03-27T09:00:00+01:00", "2021-03-28T06:00:00+00:00", time.Hour},
+	{"TZ=Europe/Oslo @every 5h", "2018-12-17T08:00:00+00:00", "2018-12-17T09:00:00+00:00", time.Hour * 4},
+	{"TZ=Mars/Base 0 9 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"TZ=Local 0 9 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"CRON_TZ= 0 9 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"CRON_TZ=Europe/Oslo", "2018-12-17T08:00:00+00:00", "", NoBackoff},
 }
 
 func TestCron(t *testing.T) {
@@ -75,3 +88,22 @@ func TestCron(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSchedule(t *testing.T) {
+	assert.NoError(t, ValidateSchedule(""))
+	assert.NoError(t, ValidateSchedule("0 9 * * *"))
+	assert.NoError(t, ValidateSchedule("CRON_TZ=Europe/Oslo 0 9 * * *"))
+	assert.NoError(t, ValidateSchedule("TZ=Asia/Kolkata @daily"))
+	assert.Error(t, ValidateSchedule("invalid-cron-spec"))
+	assert.Error(t, ValidateSchedule("TZ=Mars/Base 0 9 * * *"))
+	assert.Error(t, ValidateSchedule("TZ=Local 0 9 * * *"))
+	assert.Error(t, ValidateSchedule("CRON_TZ=Europe/Oslo"))
+}
+
+func TestGetScheduleTimeZone(t *testing.T) {
+	assert.Equal(t, "", GetScheduleTimeZone(""))
+	assert.Equal(t, "", GetScheduleTimeZone("TZ=Mars/Base 0 9 * * *"))
+	assert.Equal(t, "UTC", GetScheduleTimeZone("0 9 * * *"))
+	assert.Equal(t, "Europe/Oslo", GetScheduleTimeZone("CRON_TZ=Europe/Oslo 0 9 * * *"))
+	assert.Equal(t, "America/New_York", GetScheduleTimeZone("TZ=America/New_York 0 9 * * *"))
+}
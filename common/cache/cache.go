@@ -53,6 +53,18 @@ type Cache interface {
 
 	// Size returns the number of entries currently stored in the Cache
 	Size() int
+
+	// Bytes returns the total size of entries currently stored in the Cache
+	// as reported by SizeFunc, or 0 if the Cache has no SizeFunc
+	Bytes() int64
+
+	// SetMaxSize changes the maximum number of entries of the Cache,
+	// unpinned entries over the new limit are evicted
+	SetMaxSize(maxSize int)
+
+	// SetMaxBytes changes the limit on the total size of entries of the Cache,
+	// see Options.MaxBytes. It has no effect if the Cache has no SizeFunc
+	SetMaxBytes(maxBytes int64)
 }
 
 // Options control the behavior of the cache
@@ -70,6 +82,22 @@ type Options struct {
 	// RemovedFunc is an optional function called when an element
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
+
+	// SizeFunc is an optional function which returns the size of an element in bytes.
+	// Size of pinned elements is re-evaluated when they are released. It is called
+	// while the cache is locked, therefore it should return a size tracked by the element
+	// instead of calculating it.
+	SizeFunc SizeFunc
+
+	// MaxBytes limits the total size of elements as reported by SizeFunc.
+	// Zero means the cache is limited only by the number of elements.
+	// Pinned elements are never evicted and can exceed the limit until released.
+	MaxBytes int64
+
+	// EvictedFunc is an optional function called when an element is evicted
+	// to keep the cache within its limits. It is called synchronously and
+	// must not access the cache.
+	EvictedFunc EvictedFunc
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -88,6 +116,13 @@ type SimpleOptions struct {
 // deletion, Cache calls go f(i)
 type RemovedFunc func(interface{})
 
+// SizeFunc is a type for calculating the size of an item in bytes.
+type SizeFunc func(interface{}) int64
+
+// EvictedFunc is a type for notifying applications when an item is
+// evicted from the Cache.
+type EvictedFunc func(interface{})
+
 // Iterator represents the interface for cache iterators
 type Iterator interface {
 	// Close closes the iterator
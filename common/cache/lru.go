@@ -46,6 +46,11 @@ type (
 		ttl      time.Duration
 		pin      bool
 		rmFunc   RemovedFunc
+
+		sizeFunc    SizeFunc
+		maxBytes    int64
+		bytes       int64
+		evictedFunc EvictedFunc
 	}
 
 	iteratorImpl struct {
@@ -59,6 +64,7 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		size       int64
 	}
 )
 
@@ -142,6 +148,10 @@ func New(maxSize int, opts *Options) Cache {
 		maxSize:  maxSize,
 		pin:      opts.Pin,
 		rmFunc:   opts.RemovedFunc,
+
+		sizeFunc:    opts.SizeFunc,
+		maxBytes:    opts.MaxBytes,
+		evictedFunc: opts.EvictedFunc,
 	}
 }
 
@@ -230,6 +240,11 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+	if entry.refCount == 0 && c.sizeFunc != nil {
+		// value could have changed while it was pinned
+		c.updateSize(entry)
+		c.evictOverBudget()
+	}
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -240,6 +255,39 @@ func (c *lru) Size() int {
 	return len(c.byKey)
 }
 
+// Bytes returns the total size of entries currently in the lru
+func (c *lru) Bytes() int64 {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.bytes
+}
+
+// SetMaxSize changes the maximum number of entries, unpinned entries over the new limit are evicted
+func (c *lru) SetMaxSize(maxSize int) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.maxSize = maxSize
+	element := c.byAccess.Back()
+	for element != nil && len(c.byKey) > c.maxSize {
+		prev := element.Prev()
+		if element.Value.(*entryImpl).refCount == 0 {
+			c.evictInternal(element)
+		}
+		element = prev
+	}
+}
+
+// SetMaxBytes changes the limit on the total size of entries, unpinned entries over the new limit are evicted
+func (c *lru) SetMaxBytes(maxBytes int64) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.maxBytes = maxBytes
+	c.evictOverBudget()
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists
 func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool) (interface{}, error) {
@@ -259,12 +307,14 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now().UTC()
 				}
+				c.updateSize(entry)
 			}
 
 			c.byAccess.MoveToFront(elt)
 			if c.pin {
 				entry.refCount++
 			}
+			c.evictOverBudget()
 			return existing, nil
 		}
 	}
@@ -282,7 +332,19 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		entry.createTime = time.Now().UTC()
 	}
 
+	if c.sizeFunc != nil {
+		entry.size = c.sizeFunc(value)
+		if c.maxBytes > 0 && entry.size > c.maxBytes && !c.pin {
+			// entry doesn't fit into the cache, evicting other entries won't help
+			if c.evictedFunc != nil {
+				c.evictedFunc(value)
+			}
+			return nil, nil
+		}
+	}
+
 	c.byKey[key] = c.byAccess.PushFront(entry)
+	c.bytes += entry.size
 	if len(c.byKey) > c.maxSize {
 		oldest := c.byAccess.Back().Value.(*entryImpl)
 
@@ -293,17 +355,53 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			return nil, ErrCacheFull
 		}
 
-		c.deleteInternal(c.byAccess.Back())
+		c.evictInternal(c.byAccess.Back())
 	}
+	c.evictOverBudget()
 
 	return nil, nil
 }
 
+// evictOverBudget evicts least recently used entries which are not pinned
+// until total size of entries is within maxBytes.
+func (c *lru) evictOverBudget() {
+	if c.maxBytes <= 0 {
+		return
+	}
+
+	element := c.byAccess.Back()
+	for element != nil && c.bytes > c.maxBytes {
+		prev := element.Prev()
+		if element.Value.(*entryImpl).refCount == 0 {
+			c.evictInternal(element)
+		}
+		element = prev
+	}
+}
+
+func (c *lru) updateSize(entry *entryImpl) {
+	if c.sizeFunc == nil {
+		return
+	}
+	size := c.sizeFunc(entry.value)
+	c.bytes += size - entry.size
+	entry.size = size
+}
+
+func (c *lru) evictInternal(element *list.Element) {
+	entry := element.Value.(*entryImpl)
+	c.deleteInternal(element)
+	if c.evictedFunc != nil {
+		c.evictedFunc(entry.value)
+	}
+}
+
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	if c.rmFunc != nil {
 		go c.rmFunc(entry.value)
 	}
+	c.bytes -= entry.size
 	delete(c.byKey, entry.key)
 }
 
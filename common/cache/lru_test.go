@@ -25,6 +25,7 @@
 package cache
 
 import (
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -271,6 +272,83 @@ func TestRemovedFuncMaxSize_Pin(t *testing.T) {
 	cache.Release("B")
 }
 
+func TestLRUMaxBytes(t *testing.T) {
+	var evicted []interface{}
+	cache := New(10, &Options{
+		SizeFunc: func(i interface{}) int64 {
+			return int64(len(i.(string)))
+		},
+		MaxBytes: 10,
+		EvictedFunc: func(i interface{}) {
+			evicted = append(evicted, i)
+		},
+	})
+
+	cache.Put("A", "aaaa")
+	cache.Put("B", "bbbb")
+	assert.Equal(t, int64(8), cache.Bytes())
+
+	// Access A, B is now LRU
+	assert.Equal(t, "aaaa", cache.Get("A"))
+	cache.Put("C", "cccc")
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, int64(8), cache.Bytes())
+	assert.Equal(t, []interface{}{"bbbb"}, evicted)
+
+	// Update of existing entry changes its size
+	cache.Put("C", "cc")
+	assert.Equal(t, int64(6), cache.Bytes())
+
+	// Entry which doesn't fit into the cache is not cached
+	cache.Put("D", "ddddddddddd")
+	assert.Nil(t, cache.Get("D"))
+	assert.Equal(t, int64(6), cache.Bytes())
+	assert.Equal(t, []interface{}{"bbbb", "ddddddddddd"}, evicted)
+
+	cache.Delete("A")
+	assert.Equal(t, int64(2), cache.Bytes())
+	assert.Equal(t, 1, cache.Size())
+}
+
+func TestLRUMaxBytes_Pin(t *testing.T) {
+	type value struct {
+		size int64
+	}
+	cache := New(10, &Options{
+		Pin: true,
+		SizeFunc: func(i interface{}) int64 {
+			return i.(*value).size
+		},
+		MaxBytes: 10,
+	})
+
+	valueA := &value{size: 1}
+	_, err := cache.PutIfNotExist("A", valueA)
+	assert.NoError(t, err)
+	valueB := &value{size: 1}
+	_, err = cache.PutIfNotExist("B", valueB)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cache.Bytes())
+
+	// Pinned entries grow over the limit and are not evicted
+	valueA.size = 8
+	valueB.size = 8
+	cache.Release("A")
+	assert.Equal(t, int64(9), cache.Bytes())
+	assert.Equal(t, valueB, cache.Get("B"))
+	cache.Release("B")
+	assert.Equal(t, valueB, cache.Get("B"))
+
+	// Size is re-evaluated on release, LRU entry A is evicted
+	cache.Release("B")
+	cache.Release("B")
+	assert.Equal(t, int64(8), cache.Bytes())
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, valueB, cache.Get("B"))
+	cache.Release("B")
+}
+
 func TestIterator(t *testing.T) {
 	expected := map[string]string{
 		"A": "Alpha",
@@ -303,3 +381,45 @@ func TestIterator(t *testing.T) {
 	it.Close()
 	assert.Equal(t, expected, actual)
 }
+
+func TestLRUResize(t *testing.T) {
+	var evicted []interface{}
+	cache := New(3, &Options{
+		Pin: true,
+		SizeFunc: func(i interface{}) int64 {
+			return int64(len(i.(string)))
+		},
+		EvictedFunc: func(i interface{}) {
+			evicted = append(evicted, i)
+		},
+	})
+
+	for _, key := range []string{"A", "B", "C"} {
+		_, err := cache.PutIfNotExist(key, strings.Repeat(strings.ToLower(key), 4))
+		assert.NoError(t, err)
+	}
+	cache.Release("A")
+	cache.Release("C")
+
+	// Pinned entry B is not evicted
+	cache.SetMaxSize(1)
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, "bbbb", cache.Get("B"))
+	assert.Equal(t, []interface{}{"aaaa", "cccc"}, evicted)
+	cache.Release("B")
+	cache.Release("B")
+
+	cache.SetMaxSize(2)
+	_, err := cache.PutIfNotExist("D", "dd")
+	assert.NoError(t, err)
+	cache.Release("D")
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, int64(6), cache.Bytes())
+
+	// LRU entry B is evicted to fit into the new byte limit
+	cache.SetMaxBytes(5)
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, "dd", cache.Get("D"))
+	cache.Release("D")
+	assert.Equal(t, []interface{}{"aaaa", "cccc", "bbbb"}, evicted)
+}
@@ -486,7 +486,7 @@ UpdateLoop:
 		if err != nil {
 			return err
 		}
-		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, nextEntry.info.Id)
+		c.updateNameToIDCache(newCacheNameToID, nextEntry.info, now)
 
 		if prevEntry != nil {
 			prevEntries = append(prevEntries, prevEntry)
@@ -535,11 +535,20 @@ func (c *namespaceCache) checkAndContinue(
 
 func (c *namespaceCache) updateNameToIDCache(
 	cacheNameToID Cache,
-	name string,
-	id string,
+	info *persistencespb.NamespaceInfo,
+	now time.Time,
 ) {
 
-	cacheNameToID.Put(name, id)
+	for _, alias := range info.Aliases {
+		if !timestamp.TimeValue(alias.ExpirationTime).After(now) {
+			continue
+		}
+		// name of a registered namespace always takes precedence over an alias
+		if _, err := cacheNameToID.PutIfNotExist(alias.Name, info.Id); err != nil {
+			c.logger.Warn("Unable to add namespace alias to cache.", tag.WorkflowNamespace(alias.Name), tag.Error(err))
+		}
+	}
+	cacheNameToID.Put(info.Name, info.Id)
 }
 
 func (c *namespaceCache) updateIDToNamespaceCache(
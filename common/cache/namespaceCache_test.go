@@ -202,6 +202,70 @@ func (s *namespaceCacheSuite) TestListNamespace() {
 	}, allNamespaces)
 }
 
+func (s *namespaceCacheSuite) TestListNamespace_Alias() {
+	now := time.Now().UTC()
+	namespaceNotificationVersion := int64(0)
+	newRecord := func(name string, aliases ...*persistencespb.NamespaceAlias) *persistence.GetNamespaceResponse {
+		record := &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: uuid.New(), Name: name, Data: make(map[string]string), Aliases: aliases},
+				Config: &persistencespb.NamespaceConfig{
+					Retention: timestamp.DurationFromDays(1),
+					BadBinaries: &namespacepb.BadBinaries{
+						Binaries: map[string]*namespacepb.BadBinaryInfo{},
+					}},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          []string{cluster.TestCurrentClusterName},
+				},
+			},
+			NotificationVersion: namespaceNotificationVersion,
+		}
+		namespaceNotificationVersion++
+		return record
+	}
+
+	namespaceRecord1 := newRecord(
+		"renamed namespace name",
+		&persistencespb.NamespaceAlias{Name: "previous namespace name", ExpirationTime: timestamp.TimePtr(now.Add(time.Hour))},
+		&persistencespb.NamespaceAlias{Name: "expired namespace name", ExpirationTime: timestamp.TimePtr(now.Add(-time.Hour))},
+		&persistencespb.NamespaceAlias{Name: "registered namespace name", ExpirationTime: timestamp.TimePtr(now.Add(time.Hour))},
+	)
+	entry1 := s.buildEntryFromRecord(namespaceRecord1)
+	namespaceRecord2 := newRecord("registered namespace name")
+	entry2 := s.buildEntryFromRecord(namespaceRecord2)
+
+	s.metadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: namespaceNotificationVersion}, nil)
+	s.clusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.metadataMgr.EXPECT().ListNamespaces(&persistence.ListNamespacesRequest{
+		PageSize:      namespaceCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecord1, namespaceRecord2},
+		NextPageToken: nil,
+	}, nil)
+
+	// load namespaces
+	s.namespaceCache.Start()
+	defer s.namespaceCache.Stop()
+
+	entryByName, err := s.namespaceCache.GetNamespace("renamed namespace name")
+	s.Nil(err)
+	s.Equal(entry1, entryByName)
+
+	entryByAlias, err := s.namespaceCache.GetNamespace("previous namespace name")
+	s.Nil(err)
+	s.Equal(entry1, entryByAlias)
+
+	_, err = s.namespaceCache.GetNamespace("expired namespace name")
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	// registered namespace takes precedence over an alias with the same name
+	entryByName, err = s.namespaceCache.GetNamespace("registered namespace name")
+	s.Nil(err)
+	s.Equal(entry2, entryByName)
+}
+
 func (s *namespaceCacheSuite) TestRegisterCallback_CatchUp() {
 	namespaceNotificationVersion := int64(0)
 	namespaceRecord1 := &persistence.GetNamespaceResponse{
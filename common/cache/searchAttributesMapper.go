@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"fmt"
+
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// searchAttributesMapper maps custom search attributes registered in a namespace
+	// using aliases stored in the namespace config.
+	searchAttributesMapper struct {
+		namespaceCache NamespaceCache
+	}
+)
+
+var _ searchattribute.Mapper = (*searchAttributesMapper)(nil)
+
+// NewSearchAttributesMapper create searchattribute.Mapper backed by namespace cache
+func NewSearchAttributesMapper(namespaceCache NamespaceCache) searchattribute.Mapper {
+	return &searchAttributesMapper{
+		namespaceCache: namespaceCache,
+	}
+}
+
+// GetFieldName returns name of the field which stores search attribute alias registered in the namespace.
+func (m *searchAttributesMapper) GetFieldName(alias string, namespace string) (string, error) {
+	entry, err := m.namespaceCache.GetNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	for fieldName, fieldAlias := range entry.GetConfig().GetCustomSearchAttributeAliases() {
+		if fieldAlias != "" && fieldAlias == alias {
+			return fieldName, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", searchattribute.ErrInvalidName, alias)
+}
+
+// GetAlias returns search attribute alias registered in the namespace for the field.
+func (m *searchAttributesMapper) GetAlias(fieldName string, namespace string) (string, error) {
+	entry, err := m.namespaceCache.GetNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	if alias, ok := entry.GetConfig().GetCustomSearchAttributeAliases()[fieldName]; ok && alias != "" {
+		return alias, nil
+	}
+	return "", fmt.Errorf("%w: %s", searchattribute.ErrInvalidName, fieldName)
+}
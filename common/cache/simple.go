@@ -166,6 +166,17 @@ func (c *simple) Size() int {
 	return len(c.accessMap)
 }
 
+// Bytes returns 0 as simple cache doesn't track the size of entries
+func (c *simple) Bytes() int64 {
+	return 0
+}
+
+// SetMaxSize does nothing as simple cache is not bounded
+func (c *simple) SetMaxSize(_ int) {}
+
+// SetMaxBytes does nothing as simple cache is not bounded
+func (c *simple) SetMaxBytes(_ int64) {}
+
 func (c *simple) Iterator() Iterator {
 	c.RLock()
 	iterator := &simpleItr{
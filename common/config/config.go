@@ -35,6 +35,14 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/masker"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tracing"
+)
+
+const (
+	// MembershipProviderRingpop discovers cluster members through the ringpop gossip protocol
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderDatabase discovers cluster members through heartbeats persisted in the cluster_membership table
+	MembershipProviderDatabase = "database"
 )
 
 type (
@@ -54,6 +62,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// PayloadOffload is the config for offloading large payloads out of workflow history
+		PayloadOffload PayloadOffload `yaml:"payloadOffload"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
@@ -101,6 +111,8 @@ type (
 		TLS RootTLS `yaml:"tls"`
 		// Metrics is the metrics subsystem configuration
 		Metrics *metrics.Config `yaml:"metrics"`
+		// Tracing is the configuration for the tracing subsystem, tracing is disabled if not set
+		Tracing *tracing.Config `yaml:"tracing"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
 	}
@@ -207,6 +219,10 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Provider selects the membership implementation, either "ringpop" (default) or "database".
+		// The database provider relies only on the heartbeats persisted in the cluster_membership
+		// table, so no membership port needs to be reachable between the nodes.
+		Provider string `yaml:"provider"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -222,10 +238,19 @@ type (
 		NumHistoryShards int32 `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// Compression is the codec used to compress the history events and mutable state blobs
+		// written to the default store, one of "zstd" or "snappy". Compression is disabled if empty.
+		// The blobs are only compressed once enabled by EnableCompression.
+		// The history size limits apply to the uncompressed size of the events
+		Compression string `yaml:"compression"`
 		// VisibilityConfig is config for visibility sampling
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// EnableCompression enables the compression of the written blobs with the Compression codec
+		EnableCompression dynamicconfig.BoolPropertyFn `yaml:"-" json:"-"`
+		// FaultInjection subscribes to the faults injected into the persistence operations
+		FaultInjection dynamicconfig.MapPropertySubscriptionFn `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -399,6 +424,14 @@ type (
 		S3store   *S3Archiver        `yaml:"s3store"`
 	}
 
+	// PayloadOffload contains the config for offloading large payloads to blob storage
+	PayloadOffload struct {
+		// URI is the location offloaded payloads are written to, offloading is disabled if it is empty
+		URI string `yaml:"URI"`
+		// Provider contains the connector configs, the one matching the URI scheme is used
+		Provider *HistoryArchiverProvider `yaml:"provider"`
+	}
+
 	// VisibilityArchival contains the config for visibility archival
 	VisibilityArchival struct {
 		// State is the state of visibility archival either: enabled, disabled, or paused
@@ -474,10 +507,51 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Policy contains rules for policyAuthorizer
+		Policy AuthorizationPolicy `yaml:"policy"`
+	}
+
+	// AuthorizationPolicy contains allow and deny rules for policy based authorizer.
+	// Deny rules take precedence over allow rules. If no rule matches, DefaultDecision is used.
+	AuthorizationPolicy struct {
+		// DefaultDecision is "allow" or "deny". Default is "deny".
+		DefaultDecision string `yaml:"defaultDecision"`
+		// Rules defined in the server config
+		Rules []AuthorizationRule `yaml:"rules"`
+		// Filepath is an optional path to file with additional rules. File is reloaded when it changes.
+		Filepath string `yaml:"filepath"`
+		// PollInterval is how often the file with rules is checked for changes
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// AuthorizationRule matches API calls and either allows or denies them.
+	// All patterns support glob syntax (e.g. "*", "Describe*"). Empty list matches everything.
+	AuthorizationRule struct {
+		// Name of the rule for audit log
+		Name string `yaml:"name"`
+		// Effect is "allow" or "deny"
+		Effect string `yaml:"effect"`
+		// Subjects are patterns for subject from claims
+		Subjects []string `yaml:"subjects"`
+		// Roles are roles ("worker", "reader", "writer", "admin") subject needs to have in target namespace or system.
+		// Rule matches if subject has any of the roles, roles include lower ones (i.e. admin is also a writer,
+		// writer is also a reader and reader is also a worker).
+		Roles []string `yaml:"roles"`
+		// Namespaces are patterns for target namespace
+		Namespaces []string `yaml:"namespaces"`
+		// APIs are patterns for API name without service prefix (e.g. "StartWorkflowExecution")
+		// or one of the API groups: "@readOnlyNamespace", "@readOnlyGlobal".
+		APIs []string `yaml:"apis"`
+		// WorkflowTypes are patterns for workflow type from request.
+		// Rule doesn't match requests without workflow type.
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		// TaskQueues are patterns for task queue from request.
+		// Rule doesn't match requests without task queue.
+		TaskQueues []string `yaml:"taskQueues"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -499,6 +573,12 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.Global.Tracing != nil {
+		if err := c.Global.Tracing.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
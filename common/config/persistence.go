@@ -31,6 +31,8 @@ import (
 	"strings"
 
 	"github.com/gocql/gocql"
+
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 const (
@@ -68,6 +70,10 @@ func (c *Persistence) Validate() error {
 		return err
 	}
 
+	if _, err := serialization.ParseCompression(c.Compression); err != nil {
+		return fmt.Errorf("persistence config: %s", err.Error())
+	}
+
 	return nil
 }
 
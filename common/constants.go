@@ -107,6 +107,12 @@ const (
 	MaxWorkflowTaskStartToCloseTimeout = 120 * time.Second
 )
 
+const (
+	// TaskPriorityHeaderName is the name of the activity header field carrying the
+	// dispatch priority of the activity task, 1 being the highest
+	TaskPriorityHeaderName = "temporal-task-priority"
+)
+
 const (
 	// DefaultTransactionSizeLimit is the largest allowed transaction size to persistence
 	DefaultTransactionSizeLimit = 4 * 1024 * 1024
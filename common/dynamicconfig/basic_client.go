@@ -28,13 +28,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
-var _ Client = (*basicClient)(nil)
+var _ NotifyingClient = (*basicClient)(nil)
 
 type configValueMap map[string][]*constrainedValue
 
@@ -45,14 +46,52 @@ type constrainedValue struct {
 
 type basicClient struct {
 	values atomic.Value // configValueMap
+
+	listenersLock  sync.Mutex
+	nextListenerID int64
+	listeners      map[int64]func()
 }
 
 func newBasicClient() *basicClient {
-	bc := &basicClient{}
+	bc := &basicClient{
+		listeners: make(map[int64]func()),
+	}
 	bc.values.Store(configValueMap{})
 	return bc
 }
 
+func (bc *basicClient) OnChange(callback func()) (cancel func()) {
+	bc.listenersLock.Lock()
+	defer bc.listenersLock.Unlock()
+
+	id := bc.nextListenerID
+	bc.nextListenerID++
+	bc.listeners[id] = callback
+	return func() {
+		bc.listenersLock.Lock()
+		defer bc.listenersLock.Unlock()
+		delete(bc.listeners, id)
+	}
+}
+
+// storeValues replaces all values of the client and notifies the listeners
+func (bc *basicClient) storeValues(values configValueMap) {
+	bc.values.Store(values)
+	bc.notifyListeners()
+}
+
+func (bc *basicClient) notifyListeners() {
+	bc.listenersLock.Lock()
+	listeners := make([]func(), 0, len(bc.listeners))
+	for _, listener := range bc.listeners {
+		listeners = append(listeners, listener)
+	}
+	bc.listenersLock.Unlock()
+	for _, listener := range listeners {
+		listener()
+	}
+}
+
 func (bc *basicClient) GetValue(
 	name Key,
 	defaultValue interface{},
@@ -177,8 +216,22 @@ func (bc *basicClient) getValueWithFilters(
 	filters map[Filter]interface{},
 	defaultValue interface{},
 ) (interface{}, error) {
+	value, found := bc.findValue(key, filters)
+	if !found {
+		return defaultValue, errors.New("unable to find key")
+	}
+	return value, nil
+}
+
+// findValue returns the value matching the filters exactly,
+// or the value without any constraints if there is no such value
+func (bc *basicClient) findValue(
+	key Key,
+	filters map[Filter]interface{},
+) (interface{}, bool) {
 	keyName := strings.ToLower(Keys[key])
 	values := bc.values.Load().(configValueMap)
+	var defaultValue interface{}
 	found := false
 	for _, constrainedValue := range values[keyName] {
 		if len(constrainedValue.Constraints) == 0 {
@@ -188,13 +241,10 @@ func (bc *basicClient) getValueWithFilters(
 			continue
 		}
 		if match(constrainedValue, filters) {
-			return constrainedValue.Value, nil
+			return constrainedValue.Value, true
 		}
 	}
-	if !found {
-		return defaultValue, errors.New("unable to find key")
-	}
-	return defaultValue, nil
+	return defaultValue, found
 }
 
 // match will return true if the constraints matches the filters exactly
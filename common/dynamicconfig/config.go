@@ -43,10 +43,11 @@ const (
 // NewCollection creates a new collection
 func NewCollection(client Client, logger log.Logger) *Collection {
 	return &Collection{
-		client:   client,
-		logger:   logger,
-		keys:     &sync.Map{},
-		errCount: -1,
+		client:        client,
+		logger:        logger,
+		keys:          &sync.Map{},
+		errCount:      -1,
+		subscriptions: newSubscriptions(client),
 	}
 }
 
@@ -54,10 +55,11 @@ func NewCollection(client Client, logger log.Logger) *Collection {
 // can be directly accessed by calling the function without propagating the client everywhere in
 // code
 type Collection struct {
-	client   Client
-	logger   log.Logger
-	keys     *sync.Map // map of config Key to strongly typed value
-	errCount int64
+	client        Client
+	logger        log.Logger
+	keys          *sync.Map // map of config Key to strongly typed value
+	errCount      int64
+	subscriptions *subscriptions
 }
 
 func (c *Collection) logError(key Key, err error) {
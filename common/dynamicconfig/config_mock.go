@@ -91,3 +91,27 @@ func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) m
 func GetMapPropertyFnWithNamespaceFilter(value map[string]interface{}) func(namespace string) map[string]interface{} {
 	return func(namespace string) map[string]interface{} { return value }
 }
+
+// GetIntPropertySubscriptionFn returns value as IntPropertySubscriptionFn, the value never changes
+func GetIntPropertySubscriptionFn(value int) IntPropertySubscriptionFn {
+	return func(callback func(int), _ ...FilterOption) func() {
+		callback(value)
+		return func() {}
+	}
+}
+
+// GetIntPropertySubscriptionFnFilteredByTaskQueueInfo returns value as IntPropertySubscriptionFnWithTaskQueueInfoFilters, the value never changes
+func GetIntPropertySubscriptionFnFilteredByTaskQueueInfo(value int) IntPropertySubscriptionFnWithTaskQueueInfoFilters {
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, callback func(int)) func() {
+		callback(value)
+		return func() {}
+	}
+}
+
+// GetFloatPropertySubscriptionFnFilteredByNamespace returns value as FloatPropertySubscriptionFnWithNamespaceFilter, the value never changes
+func GetFloatPropertySubscriptionFnFilteredByNamespace(value float64) FloatPropertySubscriptionFnWithNamespaceFilter {
+	return func(namespace string, callback func(float64)) func() {
+		callback(value)
+		return func() {}
+	}
+}
@@ -40,6 +40,7 @@ func (k Key) String() string {
 }
 
 // Keys represents a mapping from Key to keyName, where keyName are used dynamic config source.
+// Every key also needs the type of its values in keyTypes.
 var Keys = map[Key]string{
 	unknownKey: "unknownKey",
 
@@ -84,6 +85,12 @@ var Keys = map[Key]string{
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
 	EnableCrossNamespaceCommands:           "system.enableCrossNamespaceCommands",
+	LogLevel:                               "system.logLevel",
+	LogServiceLevels:                       "system.logServiceLevels",
+	LogComponentLevels:                     "system.logComponentLevels",
+	PersistenceFaultInjection:              "system.persistenceFaultInjection",
+	PayloadOffloadThreshold:                "system.payloadOffloadThreshold",
+	EnablePersistenceCompression:           "system.enablePersistenceCompression",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -153,6 +160,9 @@ var Keys = map[Key]string{
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
 	ResilientSyncMatch:                      "matching.resilientSyncMatch",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
+	MatchingNumTaskqueuePriorityLevels:      "matching.numTaskqueuePriorityLevels",
+	MatchingDefaultTaskPriority:             "matching.defaultTaskPriority",
+	MatchingPriorityStarvationInterval:      "matching.priorityStarvationInterval",
 
 	// history settings
 	HistoryRPS:                                           "history.rps",
@@ -164,10 +174,12 @@ var Keys = map[Key]string{
 	HistoryCacheInitialSize:                              "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                            "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                  "history.cacheMaxSize",
+	HistoryCacheMaxSizeBytes:                             "history.cacheMaxSizeBytes",
 	HistoryCacheTTL:                                      "history.cacheTTL",
 	HistoryShutdownDrainDuration:                         "history.shutdownDrainDuration",
 	EventsCacheInitialSize:                               "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                   "history.eventsCacheMaxSize",
+	EventsCacheMaxSizeBytes:                              "history.eventsCacheMaxSizeBytes",
 	EventsCacheTTL:                                       "history.eventsCacheTTL",
 	AcquireShardInterval:                                 "history.acquireShardInterval",
 	AcquireShardConcurrency:                              "history.acquireShardConcurrency",
@@ -179,6 +191,8 @@ var Keys = map[Key]string{
 	TaskSchedulerWorkerCount:                             "history.taskSchedulerWorkerCount",
 	TaskSchedulerQueueSize:                               "history.taskSchedulerQueueSize",
 	TaskSchedulerRoundRobinWeights:                       "history.taskSchedulerRoundRobinWeight",
+	TaskSchedulerNamespaceWeight:                         "history.taskSchedulerNamespaceWeight",
+	TaskSchedulerNamespaceMaxInFlight:                    "history.taskSchedulerNamespaceMaxInFlight",
 	TimerTaskBatchSize:                                   "history.timerTaskBatchSize",
 	TimerTaskWorkerCount:                                 "history.timerTaskWorkerCount",
 	TimerTaskMaxRetryCount:                               "history.timerTaskMaxRetryCount",
@@ -288,6 +302,7 @@ var Keys = map[Key]string{
 	StandbyTaskReReplicationContextTimeout:                 "history.standbyTaskReReplicationContextTimeout",
 	EnableDropStuckTaskByNamespaceID:                       "history.DropStuckTaskByNamespace",
 	SkipReapplicationByNamespaceID:                         "history.SkipReapplicationByNamespaceID",
+	TaskDLQMaxAttempts:                                     "history.taskDLQMaxAttempts",
 	DefaultActivityRetryPolicy:                             "history.defaultActivityRetryPolicy",
 	DefaultWorkflowRetryPolicy:                             "history.defaultWorkflowRetryPolicy",
 
@@ -330,6 +345,10 @@ var Keys = map[Key]string{
 	TaskQueueScannerEnabled:             "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:               "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:            "worker.executionsScannerEnabled",
+	DeleteNamespaceActivityRPS:          "worker.deleteNamespaceActivityRPS",
+	DeleteNamespacePageSize:             "worker.deleteNamespacePageSize",
+	RebuildVisibilityActivityRPS:        "worker.rebuildVisibilityActivityRPS",
+	RebuildVisibilityPageSize:           "worker.rebuildVisibilityPageSize",
 }
 
 const (
@@ -392,6 +411,22 @@ const (
 	EnableAuthorization
 	// EnableCrossNamespaceCommands is the key to enable commands for external namespaces
 	EnableCrossNamespaceCommands
+	// LogLevel is the key for the log level of the server, overrides the level of the static log config
+	LogLevel
+	// LogServiceLevels is the key for the log levels per service, a map from service name to level
+	LogServiceLevels
+	// LogComponentLevels is the key for the log levels per component tag, a map from component to level
+	LogComponentLevels
+	// PersistenceFaultInjection is the key for the faults injected into the persistence operations, a map
+	// from operation name (or "*" for all operations) to a map with the errorType, rate and latency
+	PersistenceFaultInjection
+	// PayloadOffloadThreshold is the size in bytes above which event payloads are offloaded to the configured
+	// payload store, 0 disables offloading. The blob size limits apply to the payloads once offloaded
+	PayloadOffloadThreshold
+	// EnablePersistenceCompression is the key to compress the blobs written with the codec of the static
+	// persistence config. Compressed blobs can only be read by servers which support compression, so it must
+	// only be enabled once every host of the cluster has been upgraded
+	EnablePersistenceCompression
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -537,6 +572,12 @@ const (
 	ResilientSyncMatch
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration
+	// MatchingNumTaskqueuePriorityLevels is the number of dispatch priority levels of a task queue
+	MatchingNumTaskqueuePriorityLevels
+	// MatchingDefaultTaskPriority is the dispatch priority of tasks which do not specify one, 1 being the highest
+	MatchingDefaultTaskPriority
+	// MatchingPriorityStarvationInterval is the number of polls after which a poll prefers the lower priority levels of a task queue
+	MatchingPriorityStarvationInterval
 
 	// key for history
 
@@ -556,6 +597,8 @@ const (
 	HistoryCacheInitialSize
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize
+	// HistoryCacheMaxSizeBytes is max size of history cache in bytes, 0 means no limit
+	HistoryCacheMaxSizeBytes
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
@@ -564,6 +607,8 @@ const (
 	EventsCacheInitialSize
 	// EventsCacheMaxSize is max size of events cache
 	EventsCacheMaxSize
+	// EventsCacheMaxSizeBytes is max size of events cache in bytes, 0 means no limit
+	EventsCacheMaxSizeBytes
 	// EventsCacheTTL is TTL of events cache
 	EventsCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
@@ -588,6 +633,11 @@ const (
 	TaskSchedulerQueueSize
 	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler
 	TaskSchedulerRoundRobinWeights
+	// TaskSchedulerNamespaceWeight is the number of tasks a namespace can dispatch in its turn in namespace fair task scheduler
+	TaskSchedulerNamespaceWeight
+	// TaskSchedulerNamespaceMaxInFlight is the max number of tasks of a namespace being processed at the same time
+	// in namespace fair task scheduler, 0 means no limit
+	TaskSchedulerNamespaceMaxInFlight
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize
 	// TimerTaskWorkerCount is number of task workers for timer processor
@@ -771,6 +821,9 @@ const (
 	EnableDropStuckTaskByNamespaceID
 	// SkipReapplicationByNamespaceID is whether skipping a event re-application for a namespace
 	SkipReapplicationByNamespaceID
+	// TaskDLQMaxAttempts is the number of attempts after which a failing transfer or timer task is moved
+	// to the history task DLQ of its shard, 0 disables the DLQ
+	TaskDLQMaxAttempts
 
 	// key for worker
 
@@ -843,6 +896,14 @@ const (
 	WorkerParentCloseMaxConcurrentWorkflowTaskPollers
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// DeleteNamespaceActivityRPS is the rate limit of the executions and task queues deleted per second by the delete namespace workflow
+	DeleteNamespaceActivityRPS
+	// DeleteNamespacePageSize is the page size used by the delete namespace workflow to list executions and task queues
+	DeleteNamespacePageSize
+	// RebuildVisibilityActivityRPS is the rate limit of the executions processed per second by the rebuild visibility workflow
+	RebuildVisibilityActivityRPS
+	// RebuildVisibilityPageSize is the page size used by the rebuild visibility workflow to list executions of a shard
+	RebuildVisibilityPageSize
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
 	EnableStickyQuery
 
@@ -118,10 +118,10 @@ func (fc *fileBasedClient) update() error {
 		return fmt.Errorf("failed to decode dynamic config %v", err)
 	}
 
-	return fc.storeValues(newValues)
+	return fc.formatAndStoreValues(newValues)
 }
 
-func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue) error {
+func (fc *fileBasedClient) formatAndStoreValues(newValues map[string][]*constrainedValue) error {
 	formattedNewValues := make(configValueMap, len(newValues))
 
 	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
@@ -139,7 +139,7 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 		formattedNewValues[strings.ToLower(key)] = valuesSlice
 	}
 
-	fc.values.Store(formattedNewValues)
+	fc.storeValues(formattedNewValues)
 	fc.logger.Info("Updated dynamic config")
 	return nil
 }
@@ -30,8 +30,8 @@ import (
 	"time"
 )
 
-// Client allows fetching values from a dynamic configuration system NOTE: This does not have async
-// options right now. In the interest of keeping it minimal, we can add when requirement arises.
+// Client allows fetching values from a dynamic configuration system. NOTE: This does not have async
+// options, components which need to react to changes subscribe to the properties through Collection.
 type Client interface {
 	GetValue(name Key, defaultValue interface{}) (interface{}, error)
 	GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error)
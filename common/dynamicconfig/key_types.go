@@ -0,0 +1,368 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// valueType is the type of the values of a key, it matches the Collection method the key is read with
+type valueType int
+
+const (
+	valueTypeAny valueType = iota
+	valueTypeBool
+	valueTypeInt
+	valueTypeFloat
+	valueTypeString
+	valueTypeDuration
+	valueTypeMap
+)
+
+// keyTypes are the types of values of the keys, values of keys without a type are not validated
+var keyTypes = map[Key]valueType{
+	testCaseInsensitivePropertyKey:                          valueTypeBool,
+	testGetIntPropertyKey:                                   valueTypeInt,
+	testGetFloat64PropertyKey:                               valueTypeFloat,
+	testGetDurationPropertyKey:                              valueTypeDuration,
+	testGetBoolPropertyKey:                                  valueTypeBool,
+	testGetStringPropertyKey:                                valueTypeString,
+	testGetMapPropertyKey:                                   valueTypeMap,
+	testGetIntPropertyFilteredByNamespaceKey:                valueTypeInt,
+	testGetDurationPropertyFilteredByNamespaceKey:           valueTypeDuration,
+	testGetIntPropertyFilteredByTaskQueueInfoKey:            valueTypeInt,
+	testGetDurationPropertyFilteredByTaskQueueInfoKey:       valueTypeDuration,
+	testGetBoolPropertyFilteredByNamespaceIDKey:             valueTypeBool,
+	testGetBoolPropertyFilteredByTaskQueueInfoKey:           valueTypeBool,
+	AdminMatchingNamespaceToPartitionDispatchRate:           valueTypeFloat,
+	AdminMatchingNamespaceTaskqueueToPartitionDispatchRate:  valueTypeFloat,
+	EnableDBRecordVersion:                                   valueTypeBool,
+	EnableVisibilitySampling:                                valueTypeBool,
+	AdvancedVisibilityWritingMode:                           valueTypeString,
+	EnableReadVisibilityFromES:                              valueTypeBool,
+	HistoryArchivalState:                                    valueTypeString,
+	EnableReadFromHistoryArchival:                           valueTypeBool,
+	VisibilityArchivalState:                                 valueTypeString,
+	EnableReadFromVisibilityArchival:                        valueTypeBool,
+	EnableNamespaceNotActiveAutoForwarding:                  valueTypeBool,
+	TransactionSizeLimit:                                    valueTypeInt,
+	DisallowQuery:                                           valueTypeBool,
+	EnableBatcher:                                           valueTypeBool,
+	EnableParentClosePolicyWorker:                           valueTypeBool,
+	EnableStickyQuery:                                       valueTypeBool,
+	EnablePriorityTaskProcessor:                             valueTypeBool,
+	EnableAuthorization:                                     valueTypeBool,
+	EnableCrossNamespaceCommands:                            valueTypeBool,
+	LogLevel:                                                valueTypeString,
+	LogServiceLevels:                                        valueTypeMap,
+	LogComponentLevels:                                      valueTypeMap,
+	PersistenceFaultInjection:                               valueTypeMap,
+	PayloadOffloadThreshold:                                 valueTypeInt,
+	EnablePersistenceCompression:                            valueTypeBool,
+	BlobSizeLimitError:                                      valueTypeInt,
+	BlobSizeLimitWarn:                                       valueTypeInt,
+	MemoSizeLimitError:                                      valueTypeInt,
+	MemoSizeLimitWarn:                                       valueTypeInt,
+	HistorySizeLimitError:                                   valueTypeInt,
+	HistorySizeLimitWarn:                                    valueTypeInt,
+	HistoryCountLimitError:                                  valueTypeInt,
+	HistoryCountLimitWarn:                                   valueTypeInt,
+	MaxIDLengthLimit:                                        valueTypeInt,
+	FrontendPersistenceMaxQPS:                               valueTypeInt,
+	FrontendPersistenceGlobalMaxQPS:                         valueTypeInt,
+	FrontendVisibilityMaxPageSize:                           valueTypeInt,
+	FrontendVisibilityListMaxQPS:                            valueTypeInt,
+	FrontendESVisibilityListMaxQPS:                          valueTypeInt,
+	FrontendMaxBadBinaries:                                  valueTypeInt,
+	FrontendESIndexMaxResultWindow:                          valueTypeInt,
+	FrontendHistoryMaxPageSize:                              valueTypeInt,
+	FrontendRPS:                                             valueTypeInt,
+	FrontendMaxNamespaceRPSPerInstance:                      valueTypeInt,
+	FrontendMaxNamespaceCountPerInstance:                    valueTypeInt,
+	FrontendGlobalNamespaceRPS:                              valueTypeInt,
+	FrontendShutdownDrainDuration:                           valueTypeDuration,
+	DisableListVisibilityByFilter:                           valueTypeBool,
+	FrontendThrottledLogRPS:                                 valueTypeInt,
+	EnableClientVersionCheck:                                valueTypeBool,
+	SendRawWorkflowHistory:                                  valueTypeBool,
+	SearchAttributesNumberOfKeysLimit:                       valueTypeInt,
+	SearchAttributesSizeOfValueLimit:                        valueTypeInt,
+	SearchAttributesTotalSizeLimit:                          valueTypeInt,
+	VisibilityArchivalQueryMaxPageSize:                      valueTypeInt,
+	VisibilityArchivalQueryMaxRangeInDays:                   valueTypeInt,
+	VisibilityArchivalQueryMaxQPS:                           valueTypeInt,
+	EnableServerVersionCheck:                                valueTypeBool,
+	EnableTokenNamespaceEnforcement:                         valueTypeBool,
+	KeepAliveMinTime:                                        valueTypeDuration,
+	KeepAlivePermitWithoutStream:                            valueTypeBool,
+	KeepAliveMaxConnectionIdle:                              valueTypeDuration,
+	KeepAliveMaxConnectionAge:                               valueTypeDuration,
+	KeepAliveMaxConnectionAgeGrace:                          valueTypeDuration,
+	KeepAliveTime:                                           valueTypeDuration,
+	KeepAliveTimeout:                                        valueTypeDuration,
+	MatchingRPS:                                             valueTypeInt,
+	MatchingPersistenceMaxQPS:                               valueTypeInt,
+	MatchingPersistenceGlobalMaxQPS:                         valueTypeInt,
+	MatchingMinTaskThrottlingBurstSize:                      valueTypeInt,
+	MatchingGetTasksBatchSize:                               valueTypeInt,
+	MatchingLongPollExpirationInterval:                      valueTypeDuration,
+	MatchingSyncMatchWaitDuration:                           valueTypeDuration,
+	MatchingUpdateAckInterval:                               valueTypeDuration,
+	MatchingIdleTaskqueueCheckInterval:                      valueTypeDuration,
+	MaxTaskqueueIdleTime:                                    valueTypeDuration,
+	MatchingOutstandingTaskAppendsThreshold:                 valueTypeInt,
+	MatchingMaxTaskBatchSize:                                valueTypeInt,
+	MatchingMaxTaskDeleteBatchSize:                          valueTypeInt,
+	MatchingThrottledLogRPS:                                 valueTypeInt,
+	MatchingNumTaskqueueWritePartitions:                     valueTypeInt,
+	MatchingNumTaskqueueReadPartitions:                      valueTypeInt,
+	MatchingForwarderMaxOutstandingPolls:                    valueTypeInt,
+	MatchingForwarderMaxOutstandingTasks:                    valueTypeInt,
+	MatchingForwarderMaxRatePerSecond:                       valueTypeInt,
+	MatchingForwarderMaxChildrenPerNode:                     valueTypeInt,
+	ResilientSyncMatch:                                      valueTypeBool,
+	MatchingShutdownDrainDuration:                           valueTypeDuration,
+	MatchingNumTaskqueuePriorityLevels:                      valueTypeInt,
+	MatchingDefaultTaskPriority:                             valueTypeInt,
+	MatchingPriorityStarvationInterval:                      valueTypeInt,
+	HistoryRPS:                                              valueTypeInt,
+	HistoryPersistenceMaxQPS:                                valueTypeInt,
+	HistoryPersistenceGlobalMaxQPS:                          valueTypeInt,
+	HistoryVisibilityOpenMaxQPS:                             valueTypeInt,
+	HistoryVisibilityClosedMaxQPS:                           valueTypeInt,
+	HistoryLongPollExpirationInterval:                       valueTypeDuration,
+	HistoryCacheInitialSize:                                 valueTypeInt,
+	HistoryMaxAutoResetPoints:                               valueTypeInt,
+	HistoryCacheMaxSize:                                     valueTypeInt,
+	HistoryCacheMaxSizeBytes:                                valueTypeInt,
+	HistoryCacheTTL:                                         valueTypeDuration,
+	HistoryShutdownDrainDuration:                            valueTypeDuration,
+	EventsCacheInitialSize:                                  valueTypeInt,
+	EventsCacheMaxSize:                                      valueTypeInt,
+	EventsCacheMaxSizeBytes:                                 valueTypeInt,
+	EventsCacheTTL:                                          valueTypeDuration,
+	AcquireShardInterval:                                    valueTypeDuration,
+	AcquireShardConcurrency:                                 valueTypeInt,
+	StandbyClusterDelay:                                     valueTypeDuration,
+	StandbyTaskMissingEventsResendDelay:                     valueTypeDuration,
+	StandbyTaskMissingEventsDiscardDelay:                    valueTypeDuration,
+	TaskProcessRPS:                                          valueTypeInt,
+	TaskSchedulerType:                                       valueTypeInt,
+	TaskSchedulerWorkerCount:                                valueTypeInt,
+	TaskSchedulerQueueSize:                                  valueTypeInt,
+	TaskSchedulerRoundRobinWeights:                          valueTypeMap,
+	TaskSchedulerNamespaceWeight:                            valueTypeInt,
+	TaskSchedulerNamespaceMaxInFlight:                       valueTypeInt,
+	TimerTaskBatchSize:                                      valueTypeInt,
+	TimerTaskWorkerCount:                                    valueTypeInt,
+	TimerTaskMaxRetryCount:                                  valueTypeInt,
+	TimerProcessorGetFailureRetryCount:                      valueTypeInt,
+	TimerProcessorCompleteTimerFailureRetryCount:            valueTypeInt,
+	TimerProcessorUpdateShardTaskCount:                      valueTypeInt,
+	TimerProcessorUpdateAckInterval:                         valueTypeDuration,
+	TimerProcessorUpdateAckIntervalJitterCoefficient:        valueTypeFloat,
+	TimerProcessorCompleteTimerInterval:                     valueTypeDuration,
+	TimerProcessorFailoverMaxPollRPS:                        valueTypeInt,
+	TimerProcessorMaxPollRPS:                                valueTypeInt,
+	TimerProcessorMaxPollInterval:                           valueTypeDuration,
+	TimerProcessorMaxPollIntervalJitterCoefficient:          valueTypeFloat,
+	TimerProcessorRedispatchInterval:                        valueTypeDuration,
+	TimerProcessorRedispatchIntervalJitterCoefficient:       valueTypeFloat,
+	TimerProcessorMaxRedispatchQueueSize:                    valueTypeInt,
+	TimerProcessorEnablePriorityTaskProcessor:               valueTypeBool,
+	TimerProcessorMaxTimeShift:                              valueTypeDuration,
+	TimerProcessorHistoryArchivalSizeLimit:                  valueTypeInt,
+	TimerProcessorArchivalTimeLimit:                         valueTypeDuration,
+	TransferTaskBatchSize:                                   valueTypeInt,
+	TransferProcessorFailoverMaxPollRPS:                     valueTypeInt,
+	TransferProcessorMaxPollRPS:                             valueTypeInt,
+	TransferTaskWorkerCount:                                 valueTypeInt,
+	TransferTaskMaxRetryCount:                               valueTypeInt,
+	TransferProcessorCompleteTransferFailureRetryCount:      valueTypeInt,
+	TransferProcessorUpdateShardTaskCount:                   valueTypeInt,
+	TransferProcessorMaxPollInterval:                        valueTypeDuration,
+	TransferProcessorMaxPollIntervalJitterCoefficient:       valueTypeFloat,
+	TransferProcessorUpdateAckInterval:                      valueTypeDuration,
+	TransferProcessorUpdateAckIntervalJitterCoefficient:     valueTypeFloat,
+	TransferProcessorCompleteTransferInterval:               valueTypeDuration,
+	TransferProcessorRedispatchInterval:                     valueTypeDuration,
+	TransferProcessorRedispatchIntervalJitterCoefficient:    valueTypeFloat,
+	TransferProcessorMaxRedispatchQueueSize:                 valueTypeInt,
+	TransferProcessorEnablePriorityTaskProcessor:            valueTypeBool,
+	TransferProcessorVisibilityArchivalTimeLimit:            valueTypeDuration,
+	VisibilityTaskBatchSize:                                 valueTypeInt,
+	VisibilityProcessorFailoverMaxPollRPS:                   valueTypeInt,
+	VisibilityProcessorMaxPollRPS:                           valueTypeInt,
+	VisibilityTaskWorkerCount:                               valueTypeInt,
+	VisibilityTaskMaxRetryCount:                             valueTypeInt,
+	VisibilityProcessorCompleteTaskFailureRetryCount:        valueTypeInt,
+	VisibilityProcessorUpdateShardTaskCount:                 valueTypeInt,
+	VisibilityProcessorMaxPollInterval:                      valueTypeDuration,
+	VisibilityProcessorMaxPollIntervalJitterCoefficient:     valueTypeFloat,
+	VisibilityProcessorUpdateAckInterval:                    valueTypeDuration,
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient:   valueTypeFloat,
+	VisibilityProcessorCompleteTaskInterval:                 valueTypeDuration,
+	VisibilityProcessorRedispatchInterval:                   valueTypeDuration,
+	VisibilityProcessorRedispatchIntervalJitterCoefficient:  valueTypeFloat,
+	VisibilityProcessorMaxRedispatchQueueSize:               valueTypeInt,
+	VisibilityProcessorEnablePriorityTaskProcessor:          valueTypeBool,
+	VisibilityProcessorVisibilityArchivalTimeLimit:          valueTypeDuration,
+	ReplicatorTaskBatchSize:                                 valueTypeInt,
+	ReplicatorTaskWorkerCount:                               valueTypeInt,
+	ReplicatorTaskMaxRetryCount:                             valueTypeInt,
+	ReplicatorProcessorMaxPollRPS:                           valueTypeInt,
+	ReplicatorProcessorUpdateShardTaskCount:                 valueTypeInt,
+	ReplicatorProcessorMaxPollInterval:                      valueTypeDuration,
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:     valueTypeFloat,
+	ReplicatorProcessorUpdateAckInterval:                    valueTypeDuration,
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient:   valueTypeFloat,
+	ReplicatorProcessorRedispatchInterval:                   valueTypeDuration,
+	ReplicatorProcessorRedispatchIntervalJitterCoefficient:  valueTypeFloat,
+	ReplicatorProcessorMaxRedispatchQueueSize:               valueTypeInt,
+	ReplicatorProcessorEnablePriorityTaskProcessor:          valueTypeBool,
+	MaximumBufferedEventsBatch:                              valueTypeInt,
+	MaximumSignalsPerExecution:                              valueTypeInt,
+	ShardUpdateMinInterval:                                  valueTypeDuration,
+	ShardSyncMinInterval:                                    valueTypeDuration,
+	ShardSyncTimerJitterCoefficient:                         valueTypeFloat,
+	DefaultEventEncoding:                                    valueTypeString,
+	EnableParentClosePolicy:                                 valueTypeBool,
+	NumArchiveSystemWorkflows:                               valueTypeInt,
+	ArchiveRequestRPS:                                       valueTypeInt,
+	EmitShardDiffLog:                                        valueTypeBool,
+	HistoryThrottledLogRPS:                                  valueTypeInt,
+	StickyTTL:                                               valueTypeDuration,
+	WorkflowTaskHeartbeatTimeout:                            valueTypeDuration,
+	DefaultWorkflowTaskTimeout:                              valueTypeDuration,
+	ParentClosePolicyThreshold:                              valueTypeInt,
+	NumParentClosePolicySystemWorkflows:                     valueTypeInt,
+	ReplicationTaskFetcherParallelism:                       valueTypeInt,
+	ReplicationTaskFetcherAggregationInterval:               valueTypeDuration,
+	ReplicationTaskFetcherTimerJitterCoefficient:            valueTypeFloat,
+	ReplicationTaskFetcherErrorRetryWait:                    valueTypeDuration,
+	ReplicationTaskProcessorErrorRetryWait:                  valueTypeDuration,
+	ReplicationTaskProcessorErrorRetryBackoffCoefficient:    valueTypeFloat,
+	ReplicationTaskProcessorErrorRetryMaxInterval:           valueTypeDuration,
+	ReplicationTaskProcessorErrorRetryMaxAttempts:           valueTypeInt,
+	ReplicationTaskProcessorErrorRetryExpiration:            valueTypeDuration,
+	ReplicationTaskProcessorNoTaskInitialWait:               valueTypeDuration,
+	ReplicationTaskProcessorCleanupInterval:                 valueTypeDuration,
+	ReplicationTaskProcessorCleanupJitterCoefficient:        valueTypeFloat,
+	ReplicationTaskProcessorStartWait:                       valueTypeDuration,
+	ReplicationTaskProcessorStartWaitJitterCoefficient:      valueTypeFloat,
+	ReplicationTaskProcessorHostQPS:                         valueTypeFloat,
+	ReplicationTaskProcessorShardQPS:                        valueTypeFloat,
+	MaxBufferedQueryCount:                                   valueTypeInt,
+	MutableStateChecksumGenProbability:                      valueTypeInt,
+	MutableStateChecksumVerifyProbability:                   valueTypeInt,
+	MutableStateChecksumInvalidateBefore:                    valueTypeFloat,
+	ReplicationEventsFromCurrentCluster:                     valueTypeBool,
+	StandbyTaskReReplicationContextTimeout:                  valueTypeDuration,
+	EnableDropStuckTaskByNamespaceID:                        valueTypeBool,
+	SkipReapplicationByNamespaceID:                          valueTypeBool,
+	TaskDLQMaxAttempts:                                      valueTypeInt,
+	DefaultActivityRetryPolicy:                              valueTypeMap,
+	DefaultWorkflowRetryPolicy:                              valueTypeMap,
+	WorkerPersistenceMaxQPS:                                 valueTypeInt,
+	WorkerPersistenceGlobalMaxQPS:                           valueTypeInt,
+	WorkerIndexerConcurrency:                                valueTypeInt,
+	WorkerESProcessorNumOfWorkers:                           valueTypeInt,
+	WorkerESProcessorBulkActions:                            valueTypeInt,
+	WorkerESProcessorBulkSize:                               valueTypeInt,
+	WorkerESProcessorFlushInterval:                          valueTypeDuration,
+	WorkerESProcessorAckTimeout:                             valueTypeDuration,
+	WorkerArchiverMaxConcurrentActivityExecutionSize:        valueTypeInt,
+	WorkerArchiverMaxConcurrentWorkflowTaskExecutionSize:    valueTypeInt,
+	WorkerArchiverMaxConcurrentActivityTaskPollers:          valueTypeInt,
+	WorkerArchiverMaxConcurrentWorkflowTaskPollers:          valueTypeInt,
+	WorkerArchiverConcurrency:                               valueTypeInt,
+	WorkerArchivalsPerIteration:                             valueTypeInt,
+	WorkerScannerMaxConcurrentActivityExecutionSize:         valueTypeInt,
+	WorkerScannerMaxConcurrentWorkflowTaskExecutionSize:     valueTypeInt,
+	WorkerScannerMaxConcurrentActivityTaskPollers:           valueTypeInt,
+	WorkerScannerMaxConcurrentWorkflowTaskPollers:           valueTypeInt,
+	WorkerBatcherMaxConcurrentActivityExecutionSize:         valueTypeInt,
+	WorkerBatcherMaxConcurrentWorkflowTaskExecutionSize:     valueTypeInt,
+	WorkerBatcherMaxConcurrentActivityTaskPollers:           valueTypeInt,
+	WorkerBatcherMaxConcurrentWorkflowTaskPollers:           valueTypeInt,
+	WorkerParentCloseMaxConcurrentActivityExecutionSize:     valueTypeInt,
+	WorkerParentCloseMaxConcurrentWorkflowTaskExecutionSize: valueTypeInt,
+	WorkerParentCloseMaxConcurrentActivityTaskPollers:       valueTypeInt,
+	WorkerParentCloseMaxConcurrentWorkflowTaskPollers:       valueTypeInt,
+	WorkerTimeLimitPerArchivalIteration:                     valueTypeDuration,
+	WorkerThrottledLogRPS:                                   valueTypeInt,
+	ScannerPersistenceMaxQPS:                                valueTypeInt,
+	TaskQueueScannerEnabled:                                 valueTypeBool,
+	HistoryScannerEnabled:                                   valueTypeBool,
+	ExecutionsScannerEnabled:                                valueTypeBool,
+	DeleteNamespaceActivityRPS:                              valueTypeInt,
+	DeleteNamespacePageSize:                                 valueTypeInt,
+	RebuildVisibilityActivityRPS:                            valueTypeInt,
+	RebuildVisibilityPageSize:                               valueTypeInt,
+}
+
+// validateValueType validates that the parsed value can be read as the type of the key
+// the same way as basicClient converts values
+func validateValueType(key Key, value interface{}) error {
+	switch keyTypes[key] {
+	case valueTypeBool:
+		if _, ok := value.(bool); !ok {
+			return errors.New("value type is not bool")
+		}
+	case valueTypeInt:
+		if _, ok := value.(int); !ok {
+			return errors.New("value type is not int")
+		}
+	case valueTypeFloat:
+		switch value.(type) {
+		case float64, int:
+		default:
+			return errors.New("value type is not float64")
+		}
+	case valueTypeString:
+		if _, ok := value.(string); !ok {
+			return errors.New("value type is not string")
+		}
+	case valueTypeDuration:
+		switch v := value.(type) {
+		case time.Duration:
+		case string:
+			if _, err := timestamp.ParseDurationDefaultDays(v); err != nil {
+				return fmt.Errorf("failed to parse duration: %v", err)
+			}
+		default:
+			return errors.New("value not convertible to Duration")
+		}
+	case valueTypeMap:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return errors.New("value type is not map")
+		}
+	}
+	return nil
+}
@@ -119,7 +119,7 @@ func (c *MutableEphemeralClient) Update(mutations ...Mutation) {
 	for _, mutate := range mutations {
 		mutate(newvals)
 	}
-	c.values.Store(newvals)
+	c.storeValues(newvals)
 }
 
 // Set assigns a single configuration value, overwriting any existing values.
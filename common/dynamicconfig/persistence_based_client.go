@@ -0,0 +1,434 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"gopkg.in/yaml.v2"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ NotifyingClient = (*persistenceBasedClient)(nil)
+
+const (
+	persistencePollInterval = time.Second * 5
+	persistenceReadPageSize = 1000
+
+	// emptyChangeID is the change ID before the first change of the change log
+	emptyChangeID = int64(-1)
+)
+
+type (
+	// ChangeLogReader reads the persisted dynamic config change log
+	ChangeLogReader interface {
+		ReadChanges(lastChangeID int64, maxCount int) ([]*persistencespb.DynamicConfigChange, error)
+		ReadSnapshot() (*persistencespb.DynamicConfigSnapshot, error)
+	}
+
+	// PersistedConfig is the view of the persisted dynamic config values, built by applying
+	// the changes of the change log after its snapshot in order. It is not safe for concurrent use.
+	PersistedConfig struct {
+		values           map[persistedValueID]*persistencespb.DynamicConfigValue
+		lastChangeID     int64
+		snapshotChangeID int64
+	}
+
+	persistedValueID struct {
+		key           string
+		namespace     string
+		taskQueueName string
+		taskType      enumspb.TaskQueueType
+	}
+
+	persistenceBasedClient struct {
+		*basicClient
+		base   Client
+		reader ChangeLogReader
+		config *PersistedConfig
+		doneCh <-chan interface{}
+		logger log.Logger
+	}
+)
+
+// NewPersistenceBasedClient creates a client serving the dynamic config values persisted through
+// the admin API. Persisted values take precedence over the values of the base client, which serves
+// the keys and filters without a persisted value. The change log is polled every few seconds.
+func NewPersistenceBasedClient(
+	reader ChangeLogReader,
+	base Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (Client, error) {
+	client := &persistenceBasedClient{
+		basicClient: newBasicClient(),
+		base:        base,
+		reader:      reader,
+		config:      NewPersistedConfig(),
+		doneCh:      doneCh,
+		logger:      logger,
+	}
+	if err := client.update(); err != nil {
+		return nil, err
+	}
+	if notifyingBase, ok := base.(NotifyingClient); ok {
+		// values of the base client are served by this client as well
+		notifyingBase.OnChange(client.notifyListeners)
+	}
+	go func() {
+		ticker := time.NewTicker(persistencePollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				err := client.update()
+				if err != nil {
+					client.logger.Error("Failed to update persisted dynamic config", tag.Error(err))
+				}
+			case <-client.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return client, nil
+}
+
+func (pc *persistenceBasedClient) update() error {
+	changed, err := pc.config.Refresh(pc.reader)
+	if !changed {
+		return err
+	}
+	// Changes applied before a page failed to be read are served right away, the next
+	// refresh continues after the last applied change.
+
+	newValues := make(configValueMap)
+	for _, value := range pc.config.Values() {
+		parsedValue, err := ParseValue(value.GetValue())
+		if err != nil {
+			pc.logger.Error("Failed to parse persisted dynamic config value", tag.Key(value.GetKey()), tag.Error(err))
+			continue
+		}
+		keyName := strings.ToLower(value.GetKey())
+		newValues[keyName] = append(newValues[keyName], &constrainedValue{
+			Value:       parsedValue,
+			Constraints: constraintsToMap(value.GetConstraints()),
+		})
+	}
+
+	pc.storeValues(newValues)
+	pc.logger.Info("Updated persisted dynamic config", tag.Counter(len(pc.config.values)))
+	return err
+}
+
+func (pc *persistenceBasedClient) hasValue(
+	name Key,
+	filters map[Filter]interface{},
+) bool {
+	_, found := pc.findValue(name, filters)
+	return found
+}
+
+func (pc *persistenceBasedClient) GetValue(
+	name Key,
+	defaultValue interface{},
+) (interface{}, error) {
+	return pc.GetValueWithFilters(name, nil, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetValueWithFilters(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue interface{},
+) (interface{}, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetValueWithFilters(name, filters, defaultValue)
+	}
+	return pc.base.GetValueWithFilters(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetIntValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue int,
+) (int, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetIntValue(name, filters, defaultValue)
+	}
+	return pc.base.GetIntValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetFloatValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue float64,
+) (float64, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetFloatValue(name, filters, defaultValue)
+	}
+	return pc.base.GetFloatValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetBoolValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue bool,
+) (bool, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetBoolValue(name, filters, defaultValue)
+	}
+	return pc.base.GetBoolValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetStringValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue string,
+) (string, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetStringValue(name, filters, defaultValue)
+	}
+	return pc.base.GetStringValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetMapValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetMapValue(name, filters, defaultValue)
+	}
+	return pc.base.GetMapValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetDurationValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue time.Duration,
+) (time.Duration, error) {
+	if pc.hasValue(name, filters) {
+		return pc.basicClient.GetDurationValue(name, filters, defaultValue)
+	}
+	return pc.base.GetDurationValue(name, filters, defaultValue)
+}
+
+// NewPersistedConfig creates an empty PersistedConfig
+func NewPersistedConfig() *PersistedConfig {
+	return &PersistedConfig{
+		values:           make(map[persistedValueID]*persistencespb.DynamicConfigValue),
+		lastChangeID:     emptyChangeID,
+		snapshotChangeID: emptyChangeID,
+	}
+}
+
+// Refresh applies the changes appended to the change log since the last refresh,
+// and returns whether any change was applied
+func (c *PersistedConfig) Refresh(reader ChangeLogReader) (bool, error) {
+	snapshot, err := reader.ReadSnapshot()
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	if snapshot != nil {
+		// the changes contained by the snapshot may be trimmed from the change log already
+		if snapshot.GetLastChangeId() > c.lastChangeID {
+			c.load(snapshot)
+			changed = true
+		}
+		c.snapshotChangeID = snapshot.GetLastChangeId()
+	}
+	for {
+		changes, err := reader.ReadChanges(c.lastChangeID, persistenceReadPageSize)
+		if err != nil {
+			return changed, err
+		}
+		for _, change := range changes {
+			c.Apply(change)
+			changed = true
+		}
+		if len(changes) < persistenceReadPageSize {
+			return changed, nil
+		}
+	}
+}
+
+// Apply applies a single change of the change log
+func (c *PersistedConfig) Apply(change *persistencespb.DynamicConfigChange) {
+	if change.GetChangeId() > c.lastChangeID {
+		c.lastChangeID = change.GetChangeId()
+	}
+
+	id := newPersistedValueID(change.GetValue().GetKey(), change.GetValue().GetConstraints())
+	if change.GetDeleted() {
+		delete(c.values, id)
+		return
+	}
+	c.values[id] = change.GetValue()
+}
+
+// Contains returns whether there is a value for the key and constraints
+func (c *PersistedConfig) Contains(key string, constraints *persistencespb.DynamicConfigConstraints) bool {
+	_, ok := c.values[newPersistedValueID(key, constraints)]
+	return ok
+}
+
+// Get returns the values of the key, ordered by constraints
+func (c *PersistedConfig) Get(key string) []*persistencespb.DynamicConfigValue {
+	var values []*persistencespb.DynamicConfigValue
+	for _, value := range c.Values() {
+		if strings.EqualFold(value.GetKey(), key) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Values returns all values, ordered by key and constraints
+func (c *PersistedConfig) Values() []*persistencespb.DynamicConfigValue {
+	ids := make([]persistedValueID, 0, len(c.values))
+	for id := range c.values {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].less(ids[j])
+	})
+
+	values := make([]*persistencespb.DynamicConfigValue, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, c.values[id])
+	}
+	return values
+}
+
+// LastChangeID returns the ID of the last applied change
+func (c *PersistedConfig) LastChangeID() int64 {
+	return c.lastChangeID
+}
+
+// SnapshotChangeID returns the ID of the last change contained by the snapshot of the change log
+func (c *PersistedConfig) SnapshotChangeID() int64 {
+	return c.snapshotChangeID
+}
+
+// Snapshot returns a snapshot of the values, which contains all applied changes
+func (c *PersistedConfig) Snapshot() *persistencespb.DynamicConfigSnapshot {
+	return &persistencespb.DynamicConfigSnapshot{
+		LastChangeId: c.lastChangeID,
+		Values:       c.Values(),
+	}
+}
+
+func (c *PersistedConfig) load(snapshot *persistencespb.DynamicConfigSnapshot) {
+	c.values = make(map[persistedValueID]*persistencespb.DynamicConfigValue, len(snapshot.GetValues()))
+	for _, value := range snapshot.GetValues() {
+		c.values[newPersistedValueID(value.GetKey(), value.GetConstraints())] = value
+	}
+	c.lastChangeID = snapshot.GetLastChangeId()
+}
+
+// GetKeyFromKeyName returns the key with the given name, the name is case insensitive
+func GetKeyFromKeyName(keyName string) (Key, bool) {
+	for key, name := range Keys {
+		if key != unknownKey && strings.EqualFold(name, keyName) {
+			return key, true
+		}
+	}
+	return unknownKey, false
+}
+
+// ParseValue decodes a YAML encoded value the same way as the file based client does
+func ParseValue(value string) (interface{}, error) {
+	var parsedValue interface{}
+	if err := yaml.Unmarshal([]byte(value), &parsedValue); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config value: %v", err)
+	}
+	if parsedValue == nil {
+		return nil, errors.New("dynamic config value is empty")
+	}
+	return convertKeyTypeToString(parsedValue)
+}
+
+// ValidateValue validates that the value refers to a known key and can be decoded as the type of the key
+func ValidateValue(value *persistencespb.DynamicConfigValue) error {
+	key, ok := GetKeyFromKeyName(value.GetKey())
+	if !ok {
+		return fmt.Errorf("unknown dynamic config key: %v", value.GetKey())
+	}
+	if _, ok := enumspb.TaskQueueType_name[int32(value.GetConstraints().GetTaskType())]; !ok {
+		return fmt.Errorf("unknown task type: %v", value.GetConstraints().GetTaskType())
+	}
+	parsedValue, err := ParseValue(value.GetValue())
+	if err != nil {
+		return err
+	}
+	if err := validateValueType(key, parsedValue); err != nil {
+		return fmt.Errorf("invalid value of dynamic config key %v: %v", value.GetKey(), err)
+	}
+	return nil
+}
+
+func newPersistedValueID(key string, constraints *persistencespb.DynamicConfigConstraints) persistedValueID {
+	return persistedValueID{
+		key:           strings.ToLower(key),
+		namespace:     constraints.GetNamespace(),
+		taskQueueName: constraints.GetTaskQueueName(),
+		taskType:      constraints.GetTaskType(),
+	}
+}
+
+func (id persistedValueID) less(other persistedValueID) bool {
+	if id.key != other.key {
+		return id.key < other.key
+	}
+	if id.namespace != other.namespace {
+		return id.namespace < other.namespace
+	}
+	if id.taskQueueName != other.taskQueueName {
+		return id.taskQueueName < other.taskQueueName
+	}
+	return id.taskType < other.taskType
+}
+
+func constraintsToMap(constraints *persistencespb.DynamicConfigConstraints) map[string]interface{} {
+	result := make(map[string]interface{})
+	if constraints.GetNamespace() != "" {
+		result[Namespace.String()] = constraints.GetNamespace()
+	}
+	if constraints.GetTaskQueueName() != "" {
+		result[TaskQueueName.String()] = constraints.GetTaskQueueName()
+	}
+	if constraints.GetTaskType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		result[TaskType.String()] = enumspb.TaskQueueType_name[int32(constraints.GetTaskType())]
+	}
+	return result
+}
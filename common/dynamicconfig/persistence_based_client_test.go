@@ -0,0 +1,389 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	persistenceBasedClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		reader *testChangeLogReader
+		base   *MutableEphemeralClient
+		doneCh chan interface{}
+	}
+
+	testChangeLogReader struct {
+		changes      []*persistencespb.DynamicConfigChange
+		snapshot     *persistencespb.DynamicConfigSnapshot
+		nextChangeID int64
+		// failAfterChangeID fails reads of the changes after the change ID if set
+		failAfterChangeID *int64
+	}
+)
+
+func TestPersistenceBasedClientSuite(t *testing.T) {
+	s := new(persistenceBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceBasedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.reader = &testChangeLogReader{}
+	s.base = NewMutableEphemeralClient()
+	s.doneCh = make(chan interface{})
+}
+
+func (s *persistenceBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *persistenceBasedClientSuite) TestGetValue_PersistedValueOverridesBase() {
+	s.base.Set(testGetIntPropertyKey, 10)
+	s.base.Add(testGetIntPropertyKey, 20, ForNamespace("base-namespace"))
+	s.reader.set(testGetIntPropertyKey.String(), "100", nil)
+	s.reader.set(testGetIntPropertyKey.String(), "200", &persistencespb.DynamicConfigConstraints{Namespace: "persisted-namespace"})
+
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(100, v)
+
+	v, err = client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "persisted-namespace"}, 0)
+	s.NoError(err)
+	s.Equal(200, v)
+
+	// persisted default value matches before the base client is consulted
+	v, err = client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "base-namespace"}, 0)
+	s.NoError(err)
+	s.Equal(100, v)
+
+	// keys without persisted values are served by the base client
+	s.base.Set(testGetBoolPropertyKey, true)
+	b, err := client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(b)
+}
+
+func (s *persistenceBasedClientSuite) TestGetValue_Types() {
+	s.reader.set(testGetFloat64PropertyKey.String(), "1.5", nil)
+	s.reader.set(testGetDurationPropertyKey.String(), "1m", nil)
+	s.reader.set(testGetStringPropertyKey.String(), "some-value", nil)
+	s.reader.set(testGetMapPropertyKey.String(), "{key: value, count: 1}", nil)
+	s.reader.set(testGetBoolPropertyFilteredByTaskQueueInfoKey.String(), "true", &persistencespb.DynamicConfigConstraints{
+		Namespace:     "some-namespace",
+		TaskQueueName: "some-task-queue",
+		TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+
+	f, err := client.GetFloatValue(testGetFloat64PropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(1.5, f)
+
+	d, err := client.GetDurationValue(testGetDurationPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(time.Minute, d)
+
+	str, err := client.GetStringValue(testGetStringPropertyKey, nil, "")
+	s.NoError(err)
+	s.Equal("some-value", str)
+
+	m, err := client.GetMapValue(testGetMapPropertyKey, nil, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"key": "value", "count": 1}, m)
+
+	filters := map[Filter]interface{}{}
+	NamespaceFilter("some-namespace")(filters)
+	TaskQueueFilter("some-task-queue")(filters)
+	TaskTypeFilter(enumspb.TASK_QUEUE_TYPE_ACTIVITY)(filters)
+	b, err := client.GetBoolValue(testGetBoolPropertyFilteredByTaskQueueInfoKey, filters, false)
+	s.NoError(err)
+	s.True(b)
+}
+
+func (s *persistenceBasedClientSuite) TestUpdate_SetAndDelete() {
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	pc := client.(*persistenceBasedClient)
+
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.Error(err)
+	s.Equal(1, v)
+
+	s.reader.set(testGetIntPropertyKey.String(), "2", nil)
+	s.NoError(pc.update())
+	v, err = client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(2, v)
+
+	s.reader.set(testGetIntPropertyKey.String(), "3", nil)
+	s.NoError(pc.update())
+	v, err = client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(3, v)
+
+	s.reader.delete(testGetIntPropertyKey.String(), nil)
+	s.NoError(pc.update())
+	v, err = client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.Error(err)
+	s.Equal(1, v)
+}
+
+func (s *persistenceBasedClientSuite) TestUpdate_PageReadFailed() {
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	pc := client.(*persistenceBasedClient)
+
+	for i := 0; i < persistenceReadPageSize; i++ {
+		s.reader.set(testGetIntPropertyKey.String(), strconv.Itoa(i), nil)
+	}
+	s.reader.set(testGetBoolPropertyKey.String(), "true", nil)
+	lastChangeOfFirstPage := int64(persistenceReadPageSize - 1)
+	s.reader.failAfterChangeID = &lastChangeOfFirstPage
+
+	// changes of the first page are served although the second page failed to be read
+	s.Error(pc.update())
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, -1)
+	s.NoError(err)
+	s.Equal(persistenceReadPageSize-1, v)
+	_, err = client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.Error(err)
+
+	s.reader.failAfterChangeID = nil
+	s.NoError(pc.update())
+	b, err := client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(b)
+}
+
+func (s *persistenceBasedClientSuite) TestOnChange() {
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	pc := client.(*persistenceBasedClient)
+	var changes int32
+	cancel := pc.OnChange(func() { atomic.AddInt32(&changes, 1) })
+	defer cancel()
+
+	s.reader.set(testGetIntPropertyKey.String(), "2", nil)
+	s.NoError(pc.update())
+	s.Equal(int32(1), atomic.LoadInt32(&changes))
+
+	// nothing has changed in the change log
+	s.NoError(pc.update())
+	s.Equal(int32(1), atomic.LoadInt32(&changes))
+
+	// changes of the base client are propagated
+	s.base.Set(testGetFloat64PropertyKey, 1.5)
+	s.Equal(int32(2), atomic.LoadInt32(&changes))
+}
+
+func (s *persistenceBasedClientSuite) TestPersistedConfig() {
+	s.reader.set("testGetIntPropertyKey", "1", nil)
+	s.reader.set("TESTGETINTPROPERTYKEY", "2", nil)
+	s.reader.set("testGetIntPropertyKey", "3", &persistencespb.DynamicConfigConstraints{Namespace: "b"})
+	s.reader.set("testGetIntPropertyKey", "4", &persistencespb.DynamicConfigConstraints{Namespace: "a"})
+	s.reader.set("testGetBoolPropertyKey", "true", nil)
+	s.reader.delete("testGetBoolPropertyKey", nil)
+
+	config := NewPersistedConfig()
+	changed, err := config.Refresh(s.reader)
+	s.NoError(err)
+	s.True(changed)
+	s.Equal(int64(5), config.LastChangeID())
+
+	values := config.Values()
+	s.Len(values, 3)
+	s.Equal("2", values[0].GetValue())
+	s.Equal("4", values[1].GetValue())
+	s.Equal("3", values[2].GetValue())
+	s.Len(config.Get("testgetintpropertykey"), 3)
+	s.Empty(config.Get("testGetBoolPropertyKey"))
+	s.True(config.Contains("testGetIntPropertyKey", &persistencespb.DynamicConfigConstraints{Namespace: "a"}))
+	s.False(config.Contains("testGetIntPropertyKey", &persistencespb.DynamicConfigConstraints{Namespace: "c"}))
+
+	changed, err = config.Refresh(s.reader)
+	s.NoError(err)
+	s.False(changed)
+}
+
+func (s *persistenceBasedClientSuite) TestPersistedConfig_Snapshot() {
+	s.reader.set(testGetIntPropertyKey.String(), "1", nil)
+	s.reader.set(testGetBoolPropertyKey.String(), "true", nil)
+	s.reader.set(testGetIntPropertyKey.String(), "2", &persistencespb.DynamicConfigConstraints{Namespace: "a"})
+	s.reader.compact()
+	s.reader.delete(testGetBoolPropertyKey.String(), nil)
+	s.reader.set(testGetIntPropertyKey.String(), "3", nil)
+
+	config := NewPersistedConfig()
+	changed, err := config.Refresh(s.reader)
+	s.NoError(err)
+	s.True(changed)
+	s.Equal(int64(4), config.LastChangeID())
+	s.Equal(int64(2), config.SnapshotChangeID())
+
+	values := config.Values()
+	s.Len(values, 2)
+	s.Equal("3", values[0].GetValue())
+	s.Equal("2", values[1].GetValue())
+
+	snapshot := config.Snapshot()
+	s.Equal(int64(4), snapshot.GetLastChangeId())
+	s.Equal(values, snapshot.GetValues())
+}
+
+func (s *persistenceBasedClientSuite) TestUpdate_ChangesTrimmedBeforeRead() {
+	s.reader.set(testGetIntPropertyKey.String(), "1", nil)
+	client, err := NewPersistenceBasedClient(s.reader, s.base, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	pc := client.(*persistenceBasedClient)
+
+	// the changes after the last refresh are only found in the snapshot
+	s.reader.set(testGetBoolPropertyKey.String(), "true", nil)
+	s.reader.set(testGetIntPropertyKey.String(), "2", nil)
+	s.reader.compact()
+	s.NoError(pc.update())
+
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(2, v)
+	b, err := client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(b)
+}
+
+func (s *persistenceBasedClientSuite) TestValidateValue() {
+	s.NoError(ValidateValue(&persistencespb.DynamicConfigValue{Key: "testgetintpropertykey", Value: "1"}))
+	s.Error(ValidateValue(&persistencespb.DynamicConfigValue{Key: "non-exist-key", Value: "1"}))
+	s.Error(ValidateValue(&persistencespb.DynamicConfigValue{Key: "testGetIntPropertyKey", Value: ""}))
+	s.Error(ValidateValue(&persistencespb.DynamicConfigValue{Key: "testGetIntPropertyKey", Value: "{a: "}))
+
+	// values must have the type of the key
+	for _, tc := range []struct {
+		key   Key
+		valid []string
+		wrong []string
+	}{
+		{key: testGetBoolPropertyKey, valid: []string{"true", "false"}, wrong: []string{"1", "yes please", "1.5"}},
+		{key: testGetIntPropertyKey, valid: []string{"1", "-10"}, wrong: []string{"1.5", "true", "abc", "{a: 1}"}},
+		{key: testGetFloat64PropertyKey, valid: []string{"1.5", "2"}, wrong: []string{"true", "abc"}},
+		{key: testGetStringPropertyKey, valid: []string{"abc"}, wrong: []string{"1", "true", "[a, b]"}},
+		{key: testGetDurationPropertyKey, valid: []string{"10s", "1h30m", "2d"}, wrong: []string{"10", "ten seconds", "true"}},
+		{key: testGetMapPropertyKey, valid: []string{"{a: 1, b: [x]}"}, wrong: []string{"1", "[a, b]", "abc"}},
+	} {
+		for _, value := range tc.valid {
+			s.NoError(ValidateValue(&persistencespb.DynamicConfigValue{Key: tc.key.String(), Value: value}), "%v: %v", tc.key, value)
+		}
+		for _, value := range tc.wrong {
+			s.Error(ValidateValue(&persistencespb.DynamicConfigValue{Key: tc.key.String(), Value: value}), "%v: %v", tc.key, value)
+		}
+	}
+
+	key, ok := GetKeyFromKeyName("TESTGETINTPROPERTYKEY")
+	s.True(ok)
+	s.Equal(testGetIntPropertyKey, key)
+}
+
+func (s *persistenceBasedClientSuite) TestKeyTypes() {
+	// values of every key are validated, only the generic test key can have values of any type
+	for key, name := range Keys {
+		if key == unknownKey || key == testGetPropertyKey {
+			continue
+		}
+		s.NotEqual(valueTypeAny, keyTypes[key], "key %v has no value type", name)
+	}
+}
+
+func (r *testChangeLogReader) ReadChanges(lastChangeID int64, maxCount int) ([]*persistencespb.DynamicConfigChange, error) {
+	if r.failAfterChangeID != nil && lastChangeID >= *r.failAfterChangeID {
+		return nil, errors.New("read failed")
+	}
+	var changes []*persistencespb.DynamicConfigChange
+	for _, change := range r.changes {
+		if change.GetChangeId() > lastChangeID && len(changes) < maxCount {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func (r *testChangeLogReader) ReadSnapshot() (*persistencespb.DynamicConfigSnapshot, error) {
+	return r.snapshot, nil
+}
+
+// compact snapshots all changes and trims them from the change log
+func (r *testChangeLogReader) compact() {
+	config := NewPersistedConfig()
+	if r.snapshot != nil {
+		config.load(r.snapshot)
+	}
+	for _, change := range r.changes {
+		config.Apply(change)
+	}
+	r.snapshot = config.Snapshot()
+	r.changes = nil
+}
+
+func (r *testChangeLogReader) set(key string, value string, constraints *persistencespb.DynamicConfigConstraints) {
+	r.changes = append(r.changes, &persistencespb.DynamicConfigChange{
+		ChangeId: r.nextChangeID,
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         key,
+			Constraints: constraints,
+			Value:       value,
+		},
+	})
+	r.nextChangeID++
+}
+
+func (r *testChangeLogReader) delete(key string, constraints *persistencespb.DynamicConfigConstraints) {
+	r.changes = append(r.changes, &persistencespb.DynamicConfigChange{
+		ChangeId: r.nextChangeID,
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         key,
+			Constraints: constraints,
+		},
+		Deleted: true,
+	})
+	r.nextChangeID++
+}
@@ -0,0 +1,281 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const (
+	// subscriptionRefreshInterval is the interval the subscribed properties are re-evaluated at
+	// if the client doesn't notify about changes
+	subscriptionRefreshInterval = time.Second
+	// subscriptionFallbackRefreshInterval is the interval the subscribed properties are re-evaluated at
+	// if the client notifies about changes
+	subscriptionFallbackRefreshInterval = time.Minute
+)
+
+// NotifyingClient is a Client which notifies about changes of its values. Subscribed properties
+// are re-evaluated as soon as the values of such client change, and are polled only as a fallback.
+type NotifyingClient interface {
+	Client
+	// OnChange registers the callback invoked after the values of the client have changed
+	OnChange(callback func()) (cancel func())
+}
+
+// IntPropertySubscriptionFn subscribes to the changes of an int property from dynamic config
+type IntPropertySubscriptionFn func(callback func(int), opts ...FilterOption) (cancel func())
+
+// IntPropertySubscriptionFnWithNamespaceFilter subscribes to the changes of an int property from dynamic config with namespace as filter
+type IntPropertySubscriptionFnWithNamespaceFilter func(namespace string, callback func(int)) (cancel func())
+
+// IntPropertySubscriptionFnWithTaskQueueInfoFilters subscribes to the changes of an int property from dynamic config with three filters: namespace, taskQueue, taskType
+type IntPropertySubscriptionFnWithTaskQueueInfoFilters func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, callback func(int)) (cancel func())
+
+// FloatPropertySubscriptionFnWithNamespaceFilter subscribes to the changes of a float property from dynamic config with namespace as filter
+type FloatPropertySubscriptionFnWithNamespaceFilter func(namespace string, callback func(float64)) (cancel func())
+
+// FloatPropertySubscriptionFnWithTaskQueueInfoFilters subscribes to the changes of a float property from dynamic config with three filters: namespace, taskQueue, taskType
+type FloatPropertySubscriptionFnWithTaskQueueInfoFilters func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, callback func(float64)) (cancel func())
+
+// StringPropertySubscriptionFn subscribes to the changes of a string property from dynamic config
+type StringPropertySubscriptionFn func(callback func(string), opts ...FilterOption) (cancel func())
+
+// MapPropertySubscriptionFn subscribes to the changes of a map property from dynamic config
+type MapPropertySubscriptionFn func(callback func(map[string]interface{}), opts ...FilterOption) (cancel func())
+
+type (
+	// subscriptions re-evaluates the subscribed properties in the background and invokes
+	// the callbacks of the properties whose effective value has changed. The refresh loop
+	// only runs while there is at least one subscription.
+	subscriptions struct {
+		client Client
+
+		sync.Mutex
+		nextID  int64
+		entries map[int64]*subscription
+		running bool
+
+		// refreshLock serializes the refreshes, so callbacks of a subscription are never invoked concurrently
+		refreshLock sync.Mutex
+	}
+
+	subscription struct {
+		getValue func() interface{}
+		callback func(interface{})
+		// value is guarded by refreshLock once the subscription is registered
+		value interface{}
+	}
+)
+
+func newSubscriptions(client Client) *subscriptions {
+	return &subscriptions{
+		client:  client,
+		entries: make(map[int64]*subscription),
+	}
+}
+
+// subscribe invokes the callback with the current value before it returns,
+// and then every time the value changes until the subscription is cancelled
+func (s *subscriptions) subscribe(
+	getValue func() interface{},
+	callback func(interface{}),
+) (cancel func()) {
+	sub := &subscription{
+		getValue: getValue,
+		callback: callback,
+		value:    getValue(),
+	}
+	callback(sub.value)
+
+	s.Lock()
+	defer s.Unlock()
+
+	id := s.nextID
+	s.nextID++
+	s.entries[id] = sub
+	if !s.running {
+		s.running = true
+		go s.refreshLoop()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.Lock()
+			defer s.Unlock()
+			delete(s.entries, id)
+		})
+	}
+}
+
+func (s *subscriptions) refreshLoop() {
+	refreshInterval := subscriptionRefreshInterval
+	changeCh := make(chan struct{}, 1)
+	if notifyingClient, ok := s.client.(NotifyingClient); ok {
+		cancel := notifyingClient.OnChange(func() {
+			select {
+			case changeCh <- struct{}{}:
+			default:
+			}
+		})
+		defer cancel()
+		refreshInterval = subscriptionFallbackRefreshInterval
+		// values may have changed before the callback was registered
+		changeCh <- struct{}{}
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-changeCh:
+		}
+		if !s.refresh() {
+			return
+		}
+	}
+}
+
+// refresh invokes the callbacks of the changed values, and returns false
+// once there is no subscription left, which stops the refresh loop
+func (s *subscriptions) refresh() bool {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+
+	s.Lock()
+	if len(s.entries) == 0 {
+		s.running = false
+		s.Unlock()
+		return false
+	}
+	ids := make([]int64, 0, len(s.entries))
+	for id := range s.entries {
+		ids = append(ids, id)
+	}
+	s.Unlock()
+
+	for _, id := range ids {
+		s.Lock()
+		sub, ok := s.entries[id]
+		s.Unlock()
+		if !ok {
+			// cancelled in the meantime
+			continue
+		}
+
+		value := sub.getValue()
+		if !reflect.DeepEqual(sub.value, value) {
+			sub.value = value
+			sub.callback(value)
+		}
+	}
+	return true
+}
+
+// SubscribeIntProperty subscribes to the changes of an int property. The callback is invoked with
+// the current value before the subscription returns, and then every time the value changes, until
+// the returned cancel function is called.
+func (c *Collection) SubscribeIntProperty(key Key, defaultValue int) IntPropertySubscriptionFn {
+	getValue := c.GetIntProperty(key, defaultValue)
+	return func(callback func(int), opts ...FilterOption) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(opts...) },
+			func(value interface{}) { callback(value.(int)) },
+		)
+	}
+}
+
+// SubscribeIntPropertyFilteredByNamespace subscribes to the changes of an int property with namespace filter
+func (c *Collection) SubscribeIntPropertyFilteredByNamespace(key Key, defaultValue int) IntPropertySubscriptionFnWithNamespaceFilter {
+	getValue := c.GetIntPropertyFilteredByNamespace(key, defaultValue)
+	return func(namespace string, callback func(int)) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(namespace) },
+			func(value interface{}) { callback(value.(int)) },
+		)
+	}
+}
+
+// SubscribeIntPropertyFilteredByTaskQueueInfo subscribes to the changes of an int property with taskQueueInfo as filters
+func (c *Collection) SubscribeIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue int) IntPropertySubscriptionFnWithTaskQueueInfoFilters {
+	getValue := c.GetIntPropertyFilteredByTaskQueueInfo(key, defaultValue)
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, callback func(int)) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(namespace, taskQueue, taskType) },
+			func(value interface{}) { callback(value.(int)) },
+		)
+	}
+}
+
+// SubscribeFloatPropertyFilteredByNamespace subscribes to the changes of a float property with namespace filter
+func (c *Collection) SubscribeFloatPropertyFilteredByNamespace(key Key, defaultValue float64) FloatPropertySubscriptionFnWithNamespaceFilter {
+	getValue := c.GetFloatPropertyFilteredByNamespace(key, defaultValue)
+	return func(namespace string, callback func(float64)) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(namespace) },
+			func(value interface{}) { callback(value.(float64)) },
+		)
+	}
+}
+
+// SubscribeFloatPropertyFilteredByTaskQueueInfo subscribes to the changes of a float property with taskQueueInfo as filters
+func (c *Collection) SubscribeFloatPropertyFilteredByTaskQueueInfo(key Key, defaultValue float64) FloatPropertySubscriptionFnWithTaskQueueInfoFilters {
+	getValue := c.GetFloatPropertyFilteredByTaskQueueInfo(key, defaultValue)
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, callback func(float64)) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(namespace, taskQueue, taskType) },
+			func(value interface{}) { callback(value.(float64)) },
+		)
+	}
+}
+
+// SubscribeStringProperty subscribes to the changes of a string property
+func (c *Collection) SubscribeStringProperty(key Key, defaultValue string) StringPropertySubscriptionFn {
+	getValue := c.GetStringProperty(key, defaultValue)
+	return func(callback func(string), opts ...FilterOption) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(opts...) },
+			func(value interface{}) { callback(value.(string)) },
+		)
+	}
+}
+
+// SubscribeMapProperty subscribes to the changes of a map property
+func (c *Collection) SubscribeMapProperty(key Key, defaultValue map[string]interface{}) MapPropertySubscriptionFn {
+	getValue := c.GetMapProperty(key, defaultValue)
+	return func(callback func(map[string]interface{}), opts ...FilterOption) func() {
+		return c.subscriptions.subscribe(
+			func() interface{} { return getValue(opts...) },
+			func(value interface{}) { callback(value.(map[string]interface{})) },
+		)
+	}
+}
@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	subscriptionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		client *MutableEphemeralClient
+		cln    *Collection
+	}
+
+	// valueRecorder records the values passed to a subscription callback, which may
+	// also be invoked by the background refresh loop
+	valueRecorder struct {
+		sync.Mutex
+		values []interface{}
+	}
+)
+
+func (r *valueRecorder) record(value interface{}) {
+	r.Lock()
+	defer r.Unlock()
+	r.values = append(r.values, value)
+}
+
+func (r *valueRecorder) get() []interface{} {
+	r.Lock()
+	defer r.Unlock()
+	return append([]interface{}(nil), r.values...)
+}
+
+func (r *valueRecorder) last() interface{} {
+	values := r.get()
+	return values[len(values)-1]
+}
+
+func TestSubscriptionSuite(t *testing.T) {
+	s := new(subscriptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *subscriptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.client = NewMutableEphemeralClient()
+	s.cln = NewCollection(s.client, log.NewNoopLogger())
+}
+
+func (s *subscriptionSuite) TestSubscribeIntProperty() {
+	recorder := &valueRecorder{}
+	cancel := s.cln.SubscribeIntProperty(testGetIntPropertyKey, 10)(func(value int) {
+		recorder.record(value)
+	})
+	defer cancel()
+
+	// callback is invoked with the current value on subscription
+	s.Equal([]interface{}{10}, recorder.get())
+
+	// unchanged value does not invoke the callback
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal([]interface{}{10}, recorder.get())
+
+	s.client.Set(testGetIntPropertyKey, 50)
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal([]interface{}{10, 50}, recorder.get())
+
+	s.client.Set(testGetIntPropertyKey, 50)
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal([]interface{}{10, 50}, recorder.get())
+}
+
+func (s *subscriptionSuite) TestSubscribeFilteredProperty() {
+	namespaceRecorder := &valueRecorder{}
+	cancel1 := s.cln.SubscribeFloatPropertyFilteredByNamespace(testGetFloat64PropertyKey, 1)("namespace", func(value float64) {
+		namespaceRecorder.record(value)
+	})
+	defer cancel1()
+	otherNamespaceRecorder := &valueRecorder{}
+	cancel2 := s.cln.SubscribeFloatPropertyFilteredByNamespace(testGetFloat64PropertyKey, 1)("other-namespace", func(value float64) {
+		otherNamespaceRecorder.record(value)
+	})
+	defer cancel2()
+
+	s.client.Set(testGetFloat64PropertyKey, 5.5, ForNamespace("namespace"))
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal(5.5, namespaceRecorder.last())
+	s.Equal([]interface{}{float64(1)}, otherNamespaceRecorder.get())
+
+	taskQueueRecorder := &valueRecorder{}
+	cancel3 := s.cln.SubscribeIntPropertyFilteredByTaskQueueInfo(testGetIntPropertyFilteredByTaskQueueInfoKey, 1)(
+		"namespace", "task-queue", enumspb.TASK_QUEUE_TYPE_ACTIVITY, func(value int) {
+			taskQueueRecorder.record(value)
+		},
+	)
+	defer cancel3()
+	s.Equal(1, taskQueueRecorder.last())
+
+	s.client.Set(testGetIntPropertyFilteredByTaskQueueInfoKey, 7, ForNamespace("namespace"), ForTaskQueueName("task-queue"))
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal(7, taskQueueRecorder.last())
+}
+
+func (s *subscriptionSuite) TestSubscribeMapProperty() {
+	recorder := &valueRecorder{}
+	cancel := s.cln.SubscribeMapProperty(testGetMapPropertyKey, map[string]interface{}{})(func(value map[string]interface{}) {
+		recorder.record(value)
+	})
+	defer cancel()
+	s.Equal([]interface{}{map[string]interface{}{}}, recorder.get())
+
+	s.client.Set(testGetMapPropertyKey, map[string]interface{}{"matching": "debug"})
+	s.True(s.cln.subscriptions.refresh())
+	s.Equal(map[string]interface{}{"matching": "debug"}, recorder.last())
+
+	// an equal map is not a change
+	s.client.Set(testGetMapPropertyKey, map[string]interface{}{"matching": "debug"})
+	s.True(s.cln.subscriptions.refresh())
+	s.Len(recorder.get(), 2)
+}
+
+func (s *subscriptionSuite) TestCancel() {
+	recorder := &valueRecorder{}
+	cancel := s.cln.SubscribeIntProperty(testGetIntPropertyKey, 10)(func(value int) {
+		recorder.record(value)
+	})
+	cancel()
+	// cancel is idempotent
+	cancel()
+
+	s.client.Set(testGetIntPropertyKey, 50)
+	// no subscription left, the refresh loop stops
+	s.False(s.cln.subscriptions.refresh())
+	s.Equal([]interface{}{10}, recorder.get())
+}
+
+func (s *subscriptionSuite) TestRefreshLoop_OnChange() {
+	recorder := &valueRecorder{}
+	cancel := s.cln.SubscribeIntProperty(testGetIntPropertyKey, 10)(func(value int) {
+		recorder.record(value)
+	})
+	defer cancel()
+
+	// change is picked up long before the fallback refresh
+	s.client.Set(testGetIntPropertyKey, 50)
+	s.Eventually(func() bool {
+		return recorder.last() == 50
+	}, subscriptionRefreshInterval, 10*time.Millisecond)
+}
+
+func (s *subscriptionSuite) TestRefreshLoop_Poll() {
+	// client which doesn't notify about changes
+	cln := NewCollection(struct{ Client }{s.client}, log.NewNoopLogger())
+	recorder := &valueRecorder{}
+	cancel := cln.SubscribeIntProperty(testGetIntPropertyKey, 10)(func(value int) {
+		recorder.record(value)
+	})
+	defer cancel()
+
+	s.client.Set(testGetIntPropertyKey, 50)
+	s.Eventually(func() bool {
+		return recorder.last() == 50
+	}, 5*subscriptionRefreshInterval, 10*time.Millisecond)
+}
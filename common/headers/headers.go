@@ -34,6 +34,13 @@ const (
 	ClientNameHeaderName              = "client-name"
 	ClientVersionHeaderName           = "client-version"
 	SupportedServerVersionsHeaderName = "supported-server-versions"
+
+	// CronScheduleHeaderName is the response header DescribeWorkflowExecution returns the cron schedule
+	// of the execution in, because the public API response has no field for it.
+	CronScheduleHeaderName = "cron-schedule"
+	// CronTimeZoneHeaderName is the response header DescribeWorkflowExecution returns the name of the time zone
+	// the cron schedule is evaluated in.
+	CronTimeZoneHeaderName = "cron-time-zone"
 )
 
 var (
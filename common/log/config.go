@@ -24,6 +24,10 @@
 
 package log
 
+import (
+	"time"
+)
+
 type (
 	// Config contains the config items for logger
 	Config struct {
@@ -33,5 +37,27 @@ type (
 		Level string `yaml:"level"`
 		// OutputFile is the path to the log output file
 		OutputFile string `yaml:"outputFile"`
+		// Format is the log encoding, either "json" (default) or the human-readable "console"
+		Format string `yaml:"format"`
+		// Rotation is the rotation policy of the log output file
+		Rotation RotationConfig `yaml:"rotation"`
+		// ServiceLevels overrides Level for the services, keyed by service name
+		ServiceLevels map[string]string `yaml:"serviceLevels"`
+		// ComponentLevels overrides Level and ServiceLevels for the loggers tagged
+		// with a component, keyed by component name (e.g. shard)
+		ComponentLevels map[string]string `yaml:"componentLevels"`
+	}
+
+	// RotationConfig contains the config items for log file rotation. The output
+	// file is not rotated if neither MaxSize nor Interval is set
+	RotationConfig struct {
+		// MaxSize is the size in megabytes at which the output file is rotated
+		MaxSize int `yaml:"maxSize"`
+		// Interval is the time after which the output file is rotated
+		Interval time.Duration `yaml:"interval"`
+		// MaxBackups is the number of rotated files to retain, 0 retains all of them
+		MaxBackups int `yaml:"maxBackups"`
+		// MaxAge is the duration for which rotated files are retained, 0 retains them forever
+		MaxAge time.Duration `yaml:"maxAge"`
 	}
 )
@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package log
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	// Levels are the log levels of the loggers created by NewLeveledZapLogger. The global
+	// level can be overridden per service and per component, the loggers pick up the
+	// service and component from their service and component tags. Levels can be changed
+	// at runtime and apply to the existing loggers immediately
+	Levels struct {
+		// updateLock serializes the updates, the levels are read without locking
+		updateLock sync.Mutex
+		value      atomic.Value // *levelOverrides
+	}
+
+	levelOverrides struct {
+		level      zapcore.Level
+		services   map[string]zapcore.Level
+		components map[string]zapcore.Level
+	}
+)
+
+// NewLevels returns the log levels of the logging configuration
+func NewLevels(cfg Config) *Levels {
+	levels := &Levels{}
+	levels.value.Store(&levelOverrides{
+		level:      parseZapLevel(cfg.Level),
+		services:   parseZapLevels(cfg.ServiceLevels),
+		components: parseZapLevels(cfg.ComponentLevels),
+	})
+	return levels
+}
+
+// SetLevel sets the global log level
+func (l *Levels) SetLevel(level string) {
+	l.update(func(overrides *levelOverrides) {
+		overrides.level = parseZapLevel(level)
+	})
+}
+
+// SetServiceLevels replaces the log levels of the services, keyed by service name
+func (l *Levels) SetServiceLevels(levels map[string]string) {
+	l.update(func(overrides *levelOverrides) {
+		overrides.services = parseZapLevels(levels)
+	})
+}
+
+// SetComponentLevels replaces the log levels of the components, keyed by component name
+func (l *Levels) SetComponentLevels(levels map[string]string) {
+	l.update(func(overrides *levelOverrides) {
+		overrides.components = parseZapLevels(levels)
+	})
+}
+
+func (l *Levels) update(fn func(overrides *levelOverrides)) {
+	l.updateLock.Lock()
+	defer l.updateLock.Unlock()
+
+	overrides := *l.value.Load().(*levelOverrides)
+	fn(&overrides)
+	l.value.Store(&overrides)
+}
+
+// enabled returns whether the level is enabled for a logger of the service and component,
+// the component level takes precedence over the service level
+func (l *Levels) enabled(service string, component string, level zapcore.Level) bool {
+	overrides := l.value.Load().(*levelOverrides)
+	if component != "" {
+		if componentLevel, ok := overrides.components[component]; ok {
+			return componentLevel.Enabled(level)
+		}
+	}
+	if service != "" {
+		if serviceLevel, ok := overrides.services[service]; ok {
+			return serviceLevel.Enabled(level)
+		}
+	}
+	return overrides.level.Enabled(level)
+}
+
+func parseZapLevels(levels map[string]string) map[string]zapcore.Level {
+	zapLevels := make(map[string]zapcore.Level, len(levels))
+	for name, level := range levels {
+		zapLevels[name] = parseZapLevel(level)
+	}
+	return zapLevels
+}
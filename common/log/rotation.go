@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package log
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// rotationTimeFormat is the timestamp of the rotated file names, it sorts chronologically
+	rotationTimeFormat = "2006-01-02T15-04-05.000"
+
+	megabyte = 1024 * 1024
+)
+
+type (
+	// rotatingWriter writes to a file that is rotated once it grows over the max size
+	// or gets older than the rotation interval. Rotated files are renamed by appending
+	// the rotation time to the file name, and removed according to the retention policy
+	rotatingWriter struct {
+		sync.Mutex
+		filename string
+		config   RotationConfig
+		now      func() time.Time
+
+		file     *os.File
+		size     int64
+		openTime time.Time
+	}
+)
+
+var _ zapcore.WriteSyncer = (*rotatingWriter)(nil)
+
+func newRotatingWriter(filename string, config RotationConfig) *rotatingWriter {
+	return &rotatingWriter{
+		filename: filename,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	if w.shouldRotate(len(p)) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *rotatingWriter) Sync() error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Sync()
+}
+
+func (w *rotatingWriter) shouldRotate(writeSize int) bool {
+	if w.size == 0 {
+		return false
+	}
+	if w.config.MaxSize > 0 && w.size+int64(writeSize) > int64(w.config.MaxSize)*megabyte {
+		return true
+	}
+	return w.config.Interval > 0 && w.now().Sub(w.openTime) >= w.config.Interval
+}
+
+func (w *rotatingWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.filename), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(w.filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	w.file = file
+	w.size = info.Size()
+	w.openTime = w.now()
+	return nil
+}
+
+func (w *rotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+	if err := os.Rename(w.filename, w.backupName(w.now())); err != nil {
+		return err
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	w.removeExpiredBackups()
+	return nil
+}
+
+// backupName returns the name of the file rotated at the given time, e.g.
+// temporal-2021-05-04T10-30-00.000.log for temporal.log
+func (w *rotatingWriter) backupName(rotationTime time.Time) string {
+	ext := filepath.Ext(w.filename)
+	prefix := strings.TrimSuffix(w.filename, ext)
+	return fmt.Sprintf("%s-%s%s", prefix, rotationTime.UTC().Format(rotationTimeFormat), ext)
+}
+
+// backups returns the rotated files along with their rotation time, oldest first
+func (w *rotatingWriter) backups() ([]string, []time.Time) {
+	ext := filepath.Ext(w.filename)
+	prefix := strings.TrimSuffix(w.filename, ext) + "-"
+	matches, err := filepath.Glob(prefix + "*" + ext)
+	if err != nil {
+		return nil, nil
+	}
+	// the rotation time sorts chronologically
+	sort.Strings(matches)
+
+	var backups []string
+	var rotationTimes []time.Time
+	for _, match := range matches {
+		rotationTime, err := time.Parse(rotationTimeFormat, strings.TrimSuffix(strings.TrimPrefix(match, prefix), ext))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, match)
+		rotationTimes = append(rotationTimes, rotationTime)
+	}
+	return backups, rotationTimes
+}
+
+func (w *rotatingWriter) removeExpiredBackups() {
+	backups, rotationTimes := w.backups()
+	for i, backup := range backups {
+		tooMany := w.config.MaxBackups > 0 && len(backups)-i > w.config.MaxBackups
+		tooOld := w.config.MaxAge > 0 && w.now().Sub(rotationTimes[i]) > w.config.MaxAge
+		if tooMany || tooOld {
+			_ = os.Remove(backup)
+		}
+	}
+}
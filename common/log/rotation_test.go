@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type rotationSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	dir      string
+	filename string
+	now      time.Time
+}
+
+func TestRotationSuite(t *testing.T) {
+	suite.Run(t, new(rotationSuite))
+}
+
+func (s *rotationSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	dir, err := ioutil.TempDir("", "rotationSuite")
+	s.NoError(err)
+	s.dir = dir
+	s.filename = filepath.Join(dir, "temporal.log")
+	s.now = time.Date(2021, 5, 4, 10, 30, 0, 0, time.UTC)
+}
+
+func (s *rotationSuite) TearDownTest() {
+	_ = os.RemoveAll(s.dir)
+}
+
+func (s *rotationSuite) newWriter(config RotationConfig) *rotatingWriter {
+	w := newRotatingWriter(s.filename, config)
+	w.now = func() time.Time { return s.now }
+	return w
+}
+
+func (s *rotationSuite) write(w *rotatingWriter, size int) {
+	_, err := w.Write([]byte(strings.Repeat("x", size)))
+	s.NoError(err)
+	s.now = s.now.Add(time.Second)
+}
+
+func (s *rotationSuite) files() []string {
+	infos, err := ioutil.ReadDir(s.dir)
+	s.NoError(err)
+	var files []string
+	for _, info := range infos {
+		files = append(files, info.Name())
+	}
+	return files
+}
+
+func (s *rotationSuite) TestRotateBySize() {
+	w := s.newWriter(RotationConfig{MaxSize: 1})
+
+	s.write(w, megabyte/2)
+	s.write(w, megabyte/2)
+	s.Equal([]string{"temporal.log"}, s.files())
+
+	s.write(w, 1)
+	s.Equal([]string{"temporal-2021-05-04T10-30-02.000.log", "temporal.log"}, s.files())
+
+	content, err := ioutil.ReadFile(s.filename)
+	s.NoError(err)
+	s.Len(content, 1)
+}
+
+func (s *rotationSuite) TestRotateByInterval() {
+	w := s.newWriter(RotationConfig{Interval: time.Minute})
+
+	s.write(w, 10)
+	s.write(w, 10)
+	s.Equal([]string{"temporal.log"}, s.files())
+
+	s.now = s.now.Add(time.Minute)
+	s.write(w, 10)
+	s.Equal([]string{"temporal-2021-05-04T10-31-02.000.log", "temporal.log"}, s.files())
+}
+
+func (s *rotationSuite) TestRetention() {
+	w := s.newWriter(RotationConfig{Interval: time.Minute, MaxBackups: 2})
+	for i := 0; i < 4; i++ {
+		s.write(w, 10)
+		s.now = s.now.Add(time.Minute)
+	}
+	s.Equal([]string{
+		"temporal-2021-05-04T10-32-02.000.log",
+		"temporal-2021-05-04T10-33-03.000.log",
+		"temporal.log",
+	}, s.files())
+
+	w.config = RotationConfig{Interval: time.Minute, MaxAge: 90 * time.Second}
+	s.write(w, 10)
+	s.Equal([]string{
+		"temporal-2021-05-04T10-33-03.000.log",
+		"temporal-2021-05-04T10-34-04.000.log",
+		"temporal.log",
+	}, s.files())
+}
+
+func (s *rotationSuite) TestSync() {
+	w := s.newWriter(RotationConfig{MaxSize: 1})
+	s.NoError(w.Sync())
+	s.write(w, 10)
+	s.NoError(w.Sync())
+}
@@ -128,6 +128,9 @@ var (
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
 	ComponentAddSearchAttributes      = component("add-search-attributes")
+	ComponentDeleteNamespace          = component("delete-namespace")
+	ComponentRebuildVisibility        = component("rebuild-visibility")
+	ComponentAuthorizationAudit       = component("authorization-audit")
 	VersionChecker                    = component("version-checker")
 )
 
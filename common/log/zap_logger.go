@@ -25,6 +25,7 @@
 package log
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -40,6 +41,12 @@ const (
 	skipForZapLogger = 3
 	// we put a default message when it is empty so that the log can be searchable/filterable
 	defaultMsgForEmpty = "none"
+
+	// tag keys used to pick the level of a leveled logger
+	serviceTagKey   = "service"
+	componentTagKey = "component"
+
+	consoleFormat = "console"
 )
 
 type (
@@ -47,6 +54,12 @@ type (
 	zapLogger struct {
 		zl   *zap.Logger
 		skip int
+
+		// levels is nil unless the logger is leveled, in which case the level
+		// is picked by the service and component tags of the logger
+		levels    *Levels
+		service   string
+		component string
 	}
 )
 
@@ -74,9 +87,20 @@ func NewZapLogger(zl *zap.Logger) *zapLogger {
 	}
 }
 
+// NewLeveledZapLogger returns a new zap based logger for this logging configuration, whose
+// levels can be overridden per service and component, and changed at runtime through the
+// returned Levels
+func NewLeveledZapLogger(cfg Config) (*zapLogger, *Levels) {
+	levels := NewLevels(cfg)
+	// the core lets everything through, the level is checked by the logger itself
+	logger := NewZapLogger(buildZapLogger(cfg, true, zap.DebugLevel))
+	logger.levels = levels
+	return logger, levels
+}
+
 // BuildZapLogger builds and returns a new zap.Logger for this logging configuration
 func BuildZapLogger(cfg Config) *zap.Logger {
-	return buildZapLogger(cfg, true)
+	return buildZapLogger(cfg, true, parseZapLevel(cfg.Level))
 }
 
 func caller(skip int) string {
@@ -113,7 +137,7 @@ func setDefaultMsg(msg string) string {
 }
 
 func (l *zapLogger) Debug(msg string, tags ...tag.Tag) {
-	if l.zl.Core().Enabled(zap.DebugLevel) {
+	if l.enabled(zap.DebugLevel) {
 		msg = setDefaultMsg(msg)
 		fields := l.buildFieldsWithCallAt(tags)
 		l.zl.Debug(msg, fields...)
@@ -121,7 +145,7 @@ func (l *zapLogger) Debug(msg string, tags ...tag.Tag) {
 }
 
 func (l *zapLogger) Info(msg string, tags ...tag.Tag) {
-	if l.zl.Core().Enabled(zap.InfoLevel) {
+	if l.enabled(zap.InfoLevel) {
 		msg = setDefaultMsg(msg)
 		fields := l.buildFieldsWithCallAt(tags)
 		l.zl.Info(msg, fields...)
@@ -129,7 +153,7 @@ func (l *zapLogger) Info(msg string, tags ...tag.Tag) {
 }
 
 func (l *zapLogger) Warn(msg string, tags ...tag.Tag) {
-	if l.zl.Core().Enabled(zap.WarnLevel) {
+	if l.enabled(zap.WarnLevel) {
 		msg = setDefaultMsg(msg)
 		fields := l.buildFieldsWithCallAt(tags)
 		l.zl.Warn(msg, fields...)
@@ -137,7 +161,7 @@ func (l *zapLogger) Warn(msg string, tags ...tag.Tag) {
 }
 
 func (l *zapLogger) Error(msg string, tags ...tag.Tag) {
-	if l.zl.Core().Enabled(zap.ErrorLevel) {
+	if l.enabled(zap.ErrorLevel) {
 		msg = setDefaultMsg(msg)
 		fields := l.buildFieldsWithCallAt(tags)
 		l.zl.Error(msg, fields...)
@@ -145,31 +169,58 @@ func (l *zapLogger) Error(msg string, tags ...tag.Tag) {
 }
 
 func (l *zapLogger) Fatal(msg string, tags ...tag.Tag) {
-	if l.zl.Core().Enabled(zap.FatalLevel) {
+	if l.enabled(zap.FatalLevel) {
 		msg = setDefaultMsg(msg)
 		fields := l.buildFieldsWithCallAt(tags)
 		l.zl.Fatal(msg, fields...)
 	}
 }
 
+func (l *zapLogger) enabled(level zapcore.Level) bool {
+	if l.levels != nil {
+		return l.levels.enabled(l.service, l.component, level)
+	}
+	return l.zl.Core().Enabled(level)
+}
+
 func (l *zapLogger) With(tags ...tag.Tag) Logger {
 	fields := make([]zap.Field, len(tags))
 	l.fillFields(tags, fields)
 	zl := l.zl.With(fields...)
-	return &zapLogger{
-		zl:   zl,
-		skip: l.skip,
+	logger := &zapLogger{
+		zl:        zl,
+		skip:      l.skip,
+		levels:    l.levels,
+		service:   l.service,
+		component: l.component,
+	}
+	if logger.levels != nil {
+		for _, field := range fields {
+			if field.Type != zapcore.StringType {
+				continue
+			}
+			switch field.Key {
+			case serviceTagKey:
+				logger.service = field.String
+			case componentTagKey:
+				logger.component = field.String
+			}
+		}
 	}
+	return logger
 }
 
 func (l *zapLogger) Skip(extraSkip int) Logger {
 	return &zapLogger{
-		zl:   l.zl,
-		skip: l.skip + extraSkip,
+		zl:        l.zl,
+		skip:      l.skip + extraSkip,
+		levels:    l.levels,
+		service:   l.service,
+		component: l.component,
 	}
 }
 
-func buildZapLogger(cfg Config, disableCaller bool) *zap.Logger {
+func buildZapLogger(cfg Config, disableCaller bool, level zapcore.Level) *zap.Logger {
 	encodeConfig := zapcore.EncoderConfig{
 		TimeKey:        "ts",
 		LevelKey:       "level",
@@ -189,26 +240,39 @@ func buildZapLogger(cfg Config, disableCaller bool) *zap.Logger {
 		encodeConfig.EncodeCaller = nil
 	}
 
-	outputPath := "stderr"
-	if len(cfg.OutputFile) > 0 {
-		outputPath = cfg.OutputFile
+	var encoder zapcore.Encoder
+	if strings.ToLower(cfg.Format) == consoleFormat {
+		encoder = zapcore.NewConsoleEncoder(encodeConfig)
+	} else {
+		encoder = zapcore.NewJSONEncoder(encodeConfig)
 	}
-	if cfg.Stdout {
-		outputPath = "stdout"
+
+	output := buildZapOutput(cfg)
+	options := []zap.Option{
+		zap.ErrorOutput(output),
+		zap.AddStacktrace(zap.ErrorLevel),
+	}
+	if !disableCaller {
+		options = append(options, zap.AddCaller())
 	}
+	return zap.New(zapcore.NewCore(encoder, output, zap.NewAtomicLevelAt(level)), options...)
+}
 
-	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(parseZapLevel(cfg.Level)),
-		Development:      false,
-		Sampling:         nil,
-		Encoding:         "json",
-		EncoderConfig:    encodeConfig,
-		OutputPaths:      []string{outputPath},
-		ErrorOutputPaths: []string{outputPath},
-		DisableCaller:    disableCaller,
+func buildZapOutput(cfg Config) zapcore.WriteSyncer {
+	if cfg.Stdout {
+		return zapcore.Lock(os.Stdout)
 	}
-	logger, _ := config.Build()
-	return logger
+	if len(cfg.OutputFile) == 0 {
+		return zapcore.Lock(os.Stderr)
+	}
+	if cfg.Rotation.MaxSize > 0 || cfg.Rotation.Interval > 0 {
+		return newRotatingWriter(cfg.OutputFile, cfg.Rotation)
+	}
+	output, _, err := zap.Open(cfg.OutputFile)
+	if err != nil {
+		return zapcore.Lock(os.Stderr)
+	}
+	return output
 }
 
 func parseZapLevel(level string) zapcore.Level {
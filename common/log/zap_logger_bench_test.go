@@ -45,7 +45,7 @@ BenchmarkLoggerWithoutFields-4            162109              7211 ns/op
 */
 
 func BenchmarkZapLoggerWithFields(b *testing.B) {
-	zLogger := buildZapLogger(Config{Level: "info"}, false)
+	zLogger := buildZapLogger(Config{Level: "info"}, false, zap.InfoLevel)
 
 	for i := 0; i < b.N; i++ {
 		zLoggerWith := zLogger.With(zap.Int64("wf-schedule-id", int64(i)), zap.String("cluster-name", "this is a very long value: 1234567890 1234567890 1234567890 1234567890"))
@@ -57,7 +57,7 @@ func BenchmarkZapLoggerWithFields(b *testing.B) {
 }
 
 func BenchmarkLoggerWithFields(b *testing.B) {
-	logger := NewZapLogger(buildZapLogger(Config{Level: "info"}, true))
+	logger := NewZapLogger(buildZapLogger(Config{Level: "info"}, true, zap.InfoLevel))
 
 	for i := 0; i < b.N; i++ {
 		loggerWith := logger.With(tag.WorkflowScheduleID(int64(i)), tag.ClusterName("this is a very long value: 1234567890 1234567890 1234567890 1234567890"))
@@ -69,7 +69,7 @@ func BenchmarkLoggerWithFields(b *testing.B) {
 }
 
 func BenchmarkZapLoggerWithoutFields(b *testing.B) {
-	zLogger := buildZapLogger(Config{Level: "info"}, false)
+	zLogger := buildZapLogger(Config{Level: "info"}, false, zap.InfoLevel)
 
 	for i := 0; i < b.N; i++ {
 		zLogger.Info("msg to print log, 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890",
@@ -82,7 +82,7 @@ func BenchmarkZapLoggerWithoutFields(b *testing.B) {
 }
 
 func BenchmarkLoggerWithoutFields(b *testing.B) {
-	logger := NewZapLogger(buildZapLogger(Config{Level: "info"}, true))
+	logger := NewZapLogger(buildZapLogger(Config{Level: "info"}, true, zap.InfoLevel))
 
 	for i := 0; i < b.N; i++ {
 		logger.Info("msg to print log, 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890 1234567890",
@@ -80,6 +80,55 @@ func (s *LogSuite) TestNewLogger() {
 	_, err = os.Stat(dir + "/test.log")
 	s.Nil(err)
 }
+
+func (s *LogSuite) TestLeveledLogger() {
+	logger, levels := NewLeveledZapLogger(Config{
+		Level:           "info",
+		ServiceLevels:   map[string]string{"matching": "debug", "history": "error"},
+		ComponentLevels: map[string]string{"shard": "debug"},
+	})
+
+	s.False(logger.enabled(zap.DebugLevel))
+	s.True(logger.enabled(zap.InfoLevel))
+
+	matchingLogger := logger.With(tag.Service("matching")).(*zapLogger)
+	s.True(matchingLogger.enabled(zap.DebugLevel))
+	historyLogger := logger.With(tag.Service("history")).(*zapLogger)
+	s.False(historyLogger.enabled(zap.WarnLevel))
+	s.True(historyLogger.enabled(zap.ErrorLevel))
+
+	// component level takes precedence over the service level
+	shardLogger := historyLogger.With(tag.ComponentShard).(*zapLogger)
+	s.True(shardLogger.enabled(zap.DebugLevel))
+	s.True(shardLogger.Skip(1).(*zapLogger).enabled(zap.DebugLevel))
+
+	// level changes apply to the existing loggers
+	levels.SetLevel("debug")
+	s.True(logger.enabled(zap.DebugLevel))
+	levels.SetServiceLevels(nil)
+	s.True(historyLogger.enabled(zap.DebugLevel))
+	levels.SetComponentLevels(map[string]string{"shard": "error"})
+	s.False(shardLogger.enabled(zap.WarnLevel))
+	s.True(matchingLogger.With(tag.ComponentShard).(*zapLogger).enabled(zap.ErrorLevel))
+}
+
+func (s *LogSuite) TestConsoleFormat() {
+	dir, err := ioutil.TempDir("", "config.testConsoleFormat")
+	s.Nil(err)
+	defer os.RemoveAll(dir)
+
+	logger := NewZapLogger(BuildZapLogger(Config{
+		Level:      "info",
+		OutputFile: dir + "/test.log",
+		Format:     "console",
+	}))
+	logger.Info("test info", tag.ComponentShard)
+	s.NoError(logger.zl.Sync())
+
+	out, err := ioutil.ReadFile(dir + "/test.log")
+	s.Nil(err)
+	s.Contains(string(out), "info\ttest info\t{\"component\": \"shard\"")
+}
 func TestDefaultLogger(t *testing.T) {
 	old := os.Stdout // keep backup of the real stdout
 	r, w, _ := os.Pipe()
@@ -0,0 +1,312 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// dbHeartbeatInterval leaves room for a few failed heartbeats before the other members
+	// consider this host unhealthy
+	dbHeartbeatInterval = healthyHostLastHeartbeatCutoff / 4
+	// dbHeartbeatJitterCoefficient spreads the heartbeats of the hosts started together
+	dbHeartbeatJitterCoefficient = 0.2
+	// dbHeartbeatRetryInterval is the initial interval of the retries of a failed heartbeat
+	dbHeartbeatRetryInterval = 500 * time.Millisecond
+)
+
+type dbMonitor struct {
+	status  int32
+	evicted int32
+
+	serviceName               string
+	services                  map[string]int
+	rings                     map[string]*dbServiceResolver
+	logger                    log.Logger
+	metadataManager           persistence.ClusterMetadataManager
+	broadcastHostPortResolver func() (string, error)
+	hostID                    uuid.UUID
+	hostPort                  string
+	heartbeatInterval         time.Duration
+	heartbeatRetryPolicy      backoff.RetryPolicy
+
+	evictCh    chan struct{}
+	shutdownCh chan struct{}
+	shutdownWG sync.WaitGroup
+}
+
+var _ Monitor = (*dbMonitor)(nil)
+
+// NewDBMonitor returns a membership monitor which relies only on the heartbeats persisted
+// in the cluster_membership table. Unlike the ringpop monitor, members heartbeat with their
+// gRPC hostport, so the broadcastHostPortResolver must resolve to the service gRPC listener.
+func NewDBMonitor(
+	serviceName string,
+	services map[string]int,
+	logger log.Logger,
+	metadataManager persistence.ClusterMetadataManager,
+	broadcastHostPortResolver func() (string, error),
+) Monitor {
+
+	monitor := &dbMonitor{
+		status:                    common.DaemonStatusInitialized,
+		serviceName:               serviceName,
+		services:                  services,
+		rings:                     make(map[string]*dbServiceResolver),
+		logger:                    logger,
+		metadataManager:           metadataManager,
+		broadcastHostPortResolver: broadcastHostPortResolver,
+		hostID:                    uuid.NewUUID(),
+		heartbeatInterval:         dbHeartbeatInterval,
+		heartbeatRetryPolicy:      newDBHeartbeatRetryPolicy(),
+		evictCh:                   make(chan struct{}),
+		shutdownCh:                make(chan struct{}),
+	}
+	for service := range services {
+		monitor.rings[service] = newDBServiceResolver(service, metadataManager, monitor.isEvictedSelf, logger)
+	}
+	return monitor
+}
+
+func (m *dbMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	hostPort, err := m.broadcastHostPortResolver()
+	if err != nil {
+		m.logger.Fatal("unable to resolve broadcast address", tag.Error(err))
+	}
+	m.hostPort = hostPort
+
+	request, err := m.buildHeartbeatRequest()
+	if err != nil {
+		m.logger.Fatal("unable to initialize membership heartbeats", tag.Error(err))
+	}
+
+	// Start by cleaning up expired records to avoid growth
+	if err := m.metadataManager.PruneClusterMembership(&persistence.PruneClusterMembershipRequest{MaxRecordsPruned: 10}); err != nil {
+		m.logger.Warn("unable to prune expired membership records", tag.Error(err))
+	}
+
+	// Upsert before loading the rings so that this host is part of its own ring right away
+	if err := m.metadataManager.UpsertClusterMembership(request); err != nil {
+		m.logger.Fatal("unable to initialize membership heartbeats", tag.Error(err))
+	}
+	m.logger.Info("Membership heartbeat upserted successfully",
+		tag.Address(request.RPCAddress.String()),
+		tag.Port(int(request.RPCPort)),
+		tag.HostID(m.hostID.String()))
+
+	m.shutdownWG.Add(1)
+	go m.heartbeatLoop(request)
+
+	for _, ring := range m.rings {
+		ring.Start()
+	}
+}
+
+func (m *dbMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(m.shutdownCh)
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+
+	if success := common.AwaitWaitGroup(&m.shutdownWG, time.Minute); !success {
+		m.logger.Warn("membership monitor timed out on shutdown.")
+	}
+}
+
+func (m *dbMonitor) buildHeartbeatRequest() (*persistence.UpsertClusterMembershipRequest, error) {
+	address, port, err := SplitHostPortTyped(m.hostPort)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := ServiceNameToServiceTypeEnum(m.serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistence.UpsertClusterMembershipRequest{
+		Role:         role,
+		RPCAddress:   address,
+		RPCPort:      port,
+		SessionStart: time.Now().UTC(),
+		RecordExpiry: upsertMembershipRecordExpiryDefault,
+		HostID:       m.hostID,
+	}, nil
+}
+
+// newDBHeartbeatRetryPolicy retries failed heartbeats until one succeeds, at most one
+// heartbeat interval apart
+func newDBHeartbeatRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(dbHeartbeatRetryInterval)
+	policy.SetMaximumInterval(dbHeartbeatInterval)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	return policy
+}
+
+// heartbeatLoop upserts the membership record every heartbeat interval. A failed upsert is
+// retried with a short backoff, so a single failure doesn't make the host look unhealthy.
+func (m *dbMonitor) heartbeatLoop(request *persistence.UpsertClusterMembershipRequest) {
+	defer m.shutdownWG.Done()
+
+	retrier := backoff.NewRetrier(m.heartbeatRetryPolicy, backoff.SystemClock)
+	delay := backoff.JitDuration(m.heartbeatInterval, dbHeartbeatJitterCoefficient)
+	for {
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-m.shutdownCh:
+			timer.Stop()
+			return
+		case <-m.evictCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := m.metadataManager.UpsertClusterMembership(request); err != nil {
+			m.logger.Error("Membership upsert failed.", tag.Error(err))
+			delay = retrier.NextBackOff()
+			continue
+		}
+		retrier.Reset()
+		delay = backoff.JitDuration(m.heartbeatInterval, dbHeartbeatJitterCoefficient)
+		m.logger.Debug("Membership heartbeat upserted successfully",
+			tag.Address(request.RPCAddress.String()),
+			tag.Port(int(request.RPCPort)),
+			tag.HostID(request.HostID.String()))
+	}
+}
+
+// WhoAmI returns the gRPC address (host:port) this host heartbeats with
+func (m *dbMonitor) WhoAmI() (*HostInfo, error) {
+	servicePort, ok := m.services[m.serviceName]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+
+	return NewHostInfo(m.hostPort, map[string]string{
+		RoleKey:  m.serviceName,
+		RolePort: strconv.Itoa(servicePort),
+	}), nil
+}
+
+// EvictSelf stops the heartbeats of this host and drops it from the local rings.
+// Other members stop routing to this host once its last heartbeat
+// is older than the healthy host cutoff.
+func (m *dbMonitor) EvictSelf() error {
+	if !atomic.CompareAndSwapInt32(&m.evicted, 0, 1) {
+		return nil
+	}
+
+	close(m.evictCh)
+	for _, ring := range m.rings {
+		if err := ring.refresh(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *dbMonitor) isEvictedSelf(hostPort string) bool {
+	return atomic.LoadInt32(&m.evicted) == 1 && hostPort == m.hostPort
+}
+
+func (m *dbMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *dbMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *dbMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *dbMonitor) RemoveListener(service string, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (m *dbMonitor) GetReachableMembers() ([]string, error) {
+	var hostPorts []string
+	for _, ring := range m.rings {
+		for _, host := range ring.Members() {
+			hostPorts = append(hostPorts, host.GetAddress())
+		}
+	}
+	return hostPorts, nil
+}
+
+func (m *dbMonitor) GetMemberCount(service string) (int, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"net"
+	"sync"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+// DBMonitorFactory builds the membership monitor backed by the cluster_membership table
+type DBMonitorFactory struct {
+	serviceName      string
+	servicePortMap   map[string]int
+	grpcListener     func() net.Listener
+	broadcastAddress string
+	logger           log.Logger
+	metadataManager  persistence.ClusterMetadataManager
+
+	sync.Mutex
+	membershipMonitor Monitor
+}
+
+// NewDBMonitorFactory builds a database membership monitor factory. The grpcListener is used to
+// resolve the hostport this host heartbeats with, overridden by broadcastAddress if specified.
+func NewDBMonitorFactory(
+	serviceName string,
+	servicePortMap map[string]int,
+	grpcListener func() net.Listener,
+	broadcastAddress string,
+	logger log.Logger,
+	metadataManager persistence.ClusterMetadataManager,
+) *DBMonitorFactory {
+	return &DBMonitorFactory{
+		serviceName:      serviceName,
+		servicePortMap:   servicePortMap,
+		grpcListener:     grpcListener,
+		broadcastAddress: broadcastAddress,
+		logger:           logger,
+		metadataManager:  metadataManager,
+	}
+}
+
+// GetMembershipMonitor return a membership monitor
+func (factory *DBMonitorFactory) GetMembershipMonitor() (Monitor, error) {
+	factory.Lock()
+	defer factory.Unlock()
+
+	if factory.membershipMonitor == nil {
+		factory.membershipMonitor = NewDBMonitor(
+			factory.serviceName,
+			factory.servicePortMap,
+			factory.logger,
+			factory.metadataManager,
+			factory.broadcastAddressResolver,
+		)
+	}
+	return factory.membershipMonitor, nil
+}
+
+func (factory *DBMonitorFactory) broadcastAddressResolver() (string, error) {
+	return BuildBroadcastHostPortFromListener(factory.grpcListener().Addr().String(), factory.broadcastAddress)
+}
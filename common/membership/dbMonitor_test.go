@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+type dbMonitorSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller          *gomock.Controller
+	mockMetadataManager *persistence.MockClusterMetadataManager
+}
+
+func TestDBMonitorSuite(t *testing.T) {
+	suite.Run(t, new(dbMonitorSuite))
+}
+
+func (s *dbMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataManager = persistence.NewMockClusterMetadataManager(s.controller)
+}
+
+func (s *dbMonitorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dbMonitorSuite) TestResolver_JoinAndLeave() {
+	resolver := newDBServiceResolver(primitives.HistoryService, s.mockMetadataManager, nil, log.NewNoopLogger())
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(resolver.AddListener("test-listener", listenCh))
+
+	gomock.InOrder(
+		s.expectMembers("127.0.0.1:7234", "127.0.0.2:7234"),
+		s.expectMembers("127.0.0.2:7234", "127.0.0.3:7234"),
+		s.expectMembers("127.0.0.2:7234", "127.0.0.3:7234"),
+	)
+
+	s.NoError(resolver.refresh())
+	e := <-listenCh
+	s.ElementsMatch([]string{"127.0.0.1:7234", "127.0.0.2:7234"}, hostAddresses(e.HostsAdded))
+	s.Nil(e.HostsRemoved)
+	s.Equal(2, resolver.MemberCount())
+
+	s.NoError(resolver.refresh())
+	e = <-listenCh
+	s.Equal([]string{"127.0.0.3:7234"}, hostAddresses(e.HostsAdded))
+	s.Equal([]string{"127.0.0.1:7234"}, hostAddresses(e.HostsRemoved))
+	s.Nil(e.HostsUpdated)
+
+	// no change, no notification
+	s.NoError(resolver.refresh())
+	s.Len(listenCh, 0)
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		host, err := resolver.Lookup(key)
+		s.NoError(err)
+		s.NotEqual("127.0.0.1:7234", host.GetAddress())
+		s.Equal(primitives.HistoryService, host.labels[RoleKey])
+	}
+}
+
+func (s *dbMonitorSuite) TestResolver_Paging() {
+	resolver := newDBServiceResolver(primitives.MatchingService, s.mockMetadataManager, nil, log.NewNoopLogger())
+
+	s.mockMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).DoAndReturn(
+		func(request *persistence.GetClusterMembersRequest) (*persistence.GetClusterMembersResponse, error) {
+			s.Equal(persistence.Matching, request.RoleEquals)
+			s.Equal(healthyHostLastHeartbeatCutoff, request.LastHeartbeatWithin)
+			if request.NextPageToken == nil {
+				return &persistence.GetClusterMembersResponse{
+					ActiveMembers: []*persistence.ClusterMember{newClusterMember("127.0.0.1:7235")},
+					NextPageToken: []byte("token"),
+				}, nil
+			}
+			return &persistence.GetClusterMembersResponse{
+				ActiveMembers: []*persistence.ClusterMember{newClusterMember("127.0.0.2:7235"), newClusterMember("127.0.0.1:7235")},
+			}, nil
+		}).Times(2)
+
+	s.NoError(resolver.refresh())
+	s.ElementsMatch([]string{"127.0.0.1:7235", "127.0.0.2:7235"}, hostAddresses(resolver.Members()))
+}
+
+func (s *dbMonitorSuite) TestMonitor_EvictSelf() {
+	selfHostPort := "127.0.0.1:7234"
+	s.mockMetadataManager.EXPECT().PruneClusterMembership(gomock.Any()).Return(nil)
+	s.mockMetadataManager.EXPECT().UpsertClusterMembership(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpsertClusterMembershipRequest) error {
+			s.Equal(persistence.History, request.Role)
+			s.Equal("127.0.0.1", request.RPCAddress.String())
+			s.Equal(uint16(7234), request.RPCPort)
+			return nil
+		})
+	s.mockMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(
+		&persistence.GetClusterMembersResponse{
+			ActiveMembers: []*persistence.ClusterMember{newClusterMember(selfHostPort), newClusterMember("127.0.0.2:7234")},
+		}, nil).MinTimes(2)
+
+	monitor := NewDBMonitor(
+		primitives.HistoryService,
+		map[string]int{primitives.HistoryService: 7234},
+		log.NewNoopLogger(),
+		s.mockMetadataManager,
+		func() (string, error) { return selfHostPort, nil },
+	)
+	monitor.Start()
+	defer monitor.Stop()
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal(selfHostPort, self.GetAddress())
+
+	count, err := monitor.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	s.NoError(monitor.EvictSelf())
+	count, err = monitor.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(1, count)
+
+	_, err = monitor.GetResolver(primitives.FrontendService)
+	s.Equal(ErrUnknownService, err)
+}
+
+func (s *dbMonitorSuite) TestMonitor_HeartbeatRetry() {
+	selfHostPort := "127.0.0.1:7234"
+	heartbeatInterval := 200 * time.Millisecond
+	var failedAt time.Time
+	retriedCh := make(chan time.Time, 1)
+	s.mockMetadataManager.EXPECT().PruneClusterMembership(gomock.Any()).Return(nil)
+	s.mockMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(&persistence.GetClusterMembersResponse{}, nil).AnyTimes()
+	gomock.InOrder(
+		s.mockMetadataManager.EXPECT().UpsertClusterMembership(gomock.Any()).Return(nil),
+		s.mockMetadataManager.EXPECT().UpsertClusterMembership(gomock.Any()).DoAndReturn(
+			func(_ *persistence.UpsertClusterMembershipRequest) error {
+				failedAt = time.Now()
+				return errors.New("upsert failed")
+			}),
+		s.mockMetadataManager.EXPECT().UpsertClusterMembership(gomock.Any()).DoAndReturn(
+			func(_ *persistence.UpsertClusterMembershipRequest) error {
+				retriedCh <- time.Now()
+				return nil
+			}),
+		s.mockMetadataManager.EXPECT().UpsertClusterMembership(gomock.Any()).Return(nil).AnyTimes(),
+	)
+
+	monitor := NewDBMonitor(
+		primitives.HistoryService,
+		map[string]int{primitives.HistoryService: 7234},
+		log.NewNoopLogger(),
+		s.mockMetadataManager,
+		func() (string, error) { return selfHostPort, nil },
+	).(*dbMonitor)
+	monitor.heartbeatInterval = heartbeatInterval
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	monitor.heartbeatRetryPolicy = retryPolicy
+	monitor.Start()
+	defer monitor.Stop()
+
+	// the failed heartbeat is retried before the next heartbeat is due
+	retriedAt := <-retriedCh
+	s.Less(int64(retriedAt.Sub(failedAt)), int64(heartbeatInterval/2))
+}
+
+func (s *dbMonitorSuite) expectMembers(hostPorts ...string) *gomock.Call {
+	var members []*persistence.ClusterMember
+	for _, hostPort := range hostPorts {
+		members = append(members, newClusterMember(hostPort))
+	}
+	return s.mockMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(
+		&persistence.GetClusterMembersResponse{ActiveMembers: members}, nil,
+	)
+}
+
+func newClusterMember(hostPort string) *persistence.ClusterMember {
+	address, port, _ := SplitHostPortTyped(hostPort)
+	return &persistence.ClusterMember{
+		Role:       persistence.History,
+		RPCAddress: address,
+		RPCPort:    port,
+	}
+}
+
+func hostAddresses(hosts []*HostInfo) []string {
+	var addresses []string
+	for _, host := range hosts {
+		addresses = append(addresses, host.GetAddress())
+	}
+	return addresses
+}
@@ -0,0 +1,349 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/temporalio/ringpop-go/hashring"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	dbRefreshInterval = time.Second * 5
+	dbMembersPageSize = 1000
+)
+
+type dbServiceResolver struct {
+	status          int32
+	service         string
+	metadataManager persistence.ClusterMetadataManager
+	excludeHost     func(hostPort string) bool
+	refreshChan     chan struct{}
+	shutdownCh      chan struct{}
+	shutdownWG      sync.WaitGroup
+	logger          log.Logger
+
+	ringValue atomic.Value // this stores the current hashring
+
+	refreshLock     sync.Mutex
+	lastRefreshTime time.Time
+	membersMap      map[string]struct{} // for computing change notifications
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*dbServiceResolver)(nil)
+
+func newDBServiceResolver(
+	service string,
+	metadataManager persistence.ClusterMetadataManager,
+	excludeHost func(hostPort string) bool,
+	logger log.Logger,
+) *dbServiceResolver {
+
+	resolver := &dbServiceResolver{
+		status:          common.DaemonStatusInitialized,
+		service:         service,
+		metadataManager: metadataManager,
+		excludeHost:     excludeHost,
+		refreshChan:     make(chan struct{}),
+		shutdownCh:      make(chan struct{}),
+		logger:          log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		membersMap:      make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+// Start starts the resolver
+func (r *dbServiceResolver) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	if err := r.refresh(); err != nil {
+		r.logger.Fatal("unable to start database service resolver", tag.Error(err))
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+}
+
+// Stop stops the resolver
+func (r *dbServiceResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+	close(r.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *dbServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		select {
+		case r.refreshChan <- struct{}{}:
+		default:
+		}
+		return nil, ErrInsufficientHosts
+	}
+
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *dbServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *dbServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if !ok {
+		return nil
+	}
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *dbServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *dbServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+func (r *dbServiceResolver) refresh() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	return r.refreshNoLock()
+}
+
+func (r *dbServiceResolver) refreshWithBackoff() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	if r.lastRefreshTime.After(time.Now().UTC().Add(-minRefreshInternal)) {
+		// refresh too frequently
+		return nil
+	}
+	return r.refreshNoLock()
+}
+
+func (r *dbServiceResolver) refreshNoLock() error {
+	addrs, err := r.getHealthyMembers()
+	if err != nil {
+		return err
+	}
+	r.lastRefreshTime = time.Now().UTC()
+
+	newMembersMap, event := r.compareMembers(addrs)
+	if event == nil {
+		return nil
+	}
+
+	ring := newHashRing()
+	for _, addr := range addrs {
+		host := NewHostInfo(addr, r.getLabelsMap())
+		ring.AddMembers(host)
+	}
+
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+
+	r.emitEvent(event)
+	return nil
+}
+
+// getHealthyMembers returns the hostports of all members of this service which heartbeated
+// within the healthy host cutoff. Members which joined show up on their first heartbeat,
+// while members which left or crashed drop out once their last heartbeat is too old.
+func (r *dbServiceResolver) getHealthyMembers() ([]string, error) {
+	role, err := ServiceNameToServiceTypeEnum(r.service)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{})
+	var nextPageToken []byte
+	for {
+		resp, err := r.metadataManager.GetClusterMembers(&persistence.GetClusterMembersRequest{
+			LastHeartbeatWithin: healthyHostLastHeartbeatCutoff,
+			RoleEquals:          role,
+			PageSize:            dbMembersPageSize,
+			NextPageToken:       nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Dedupe on hostport, a restarted host heartbeats with a new host ID
+		for _, member := range resp.ActiveMembers {
+			hostPort := net.JoinHostPort(member.RPCAddress.String(), convert.Uint16ToString(member.RPCPort))
+			if r.excludeHost != nil && r.excludeHost(hostPort) {
+				continue
+			}
+			set[hostPort] = struct{}{}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	hostPorts := make([]string, 0, len(set))
+	for hostPort := range set {
+		hostPorts = append(hostPorts, hostPort)
+	}
+	sort.Strings(hostPorts)
+	return hostPorts, nil
+}
+
+func (r *dbServiceResolver) emitEvent(
+	event *ChangedEvent,
+) {
+
+	// Notify listeners
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *dbServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(dbRefreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-r.refreshChan:
+			if err := r.refreshWithBackoff(); err != nil {
+				r.logger.Error("error refreshing ring", tag.Error(err))
+			}
+		case <-refreshTicker.C:
+			if err := r.refresh(); err != nil {
+				r.logger.Error("error periodically refreshing ring", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (r *dbServiceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *dbServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
+
+// compareMembers returns the new members map along with the change event,
+// the event is nil if the members did not change
+func (r *dbServiceResolver) compareMembers(addrs []string) (map[string]struct{}, *ChangedEvent) {
+	var event *ChangedEvent
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			if event == nil {
+				event = &ChangedEvent{}
+			}
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			if event == nil {
+				event = &ChangedEvent{}
+			}
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	return newMembersMap, event
+}
@@ -393,8 +393,14 @@ func BuildBroadcastHostPort(listenerPeerInfo tchannel.LocalPeerInfo, broadcastAd
 		return "", ringpop.ErrEphemeralAddress
 	}
 
+	return BuildBroadcastHostPortFromListener(listenerPeerInfo.HostPort, broadcastAddress)
+}
+
+// BuildBroadcastHostPortFromListener return the given listener hostport
+// and overrides the address with broadcastAddress if specified
+func BuildBroadcastHostPortFromListener(listenerHostPort string, broadcastAddress string) (string, error) {
 	// Parse listener hostport
-	listenerIpString, port, err := net.SplitHostPort(listenerPeerInfo.HostPort)
+	listenerIpString, port, err := net.SplitHostPort(listenerHostPort)
 	if err != nil {
 		return "", err
 	}
@@ -420,5 +426,5 @@ func BuildBroadcastHostPort(listenerPeerInfo tchannel.LocalPeerInfo, broadcastAd
 		return "", errors.New("broadcastAddress required when listening on all interfaces (0.0.0.0/[::])")
 	}
 
-	return listenerPeerInfo.HostPort, nil
+	return listenerHostPort, nil
 }
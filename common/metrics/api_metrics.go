@@ -95,3 +95,11 @@ func HistoryAPIMetricsScopes() map[string]int {
 	}
 	return apiNameToScope
 }
+
+// GetScopeOperation returns the operation name of the given scope of the service
+func GetScopeOperation(serviceIdx ServiceIdx, scope int) string {
+	if def, ok := ScopeDefs[serviceIdx][scope]; ok {
+		return def.operation
+	}
+	return ScopeDefs[Common][scope].operation
+}
@@ -200,6 +200,12 @@ const (
 	PersistenceDeleteReplicationTaskFromDLQScope
 	// PersistenceRangeDeleteReplicationTaskFromDLQScope tracks PersistenceRangeDeleteReplicationTaskFromDLQScope calls made by service to persistence layer
 	PersistenceRangeDeleteReplicationTaskFromDLQScope
+	// PersistencePutHistoryTaskToDLQScope tracks PersistencePutHistoryTaskToDLQScope calls made by service to persistence layer
+	PersistencePutHistoryTaskToDLQScope
+	// PersistenceGetHistoryTasksFromDLQScope tracks PersistenceGetHistoryTasksFromDLQScope calls made by service to persistence layer
+	PersistenceGetHistoryTasksFromDLQScope
+	// PersistenceRangeDeleteHistoryTaskFromDLQScope tracks PersistenceRangeDeleteHistoryTaskFromDLQScope calls made by service to persistence layer
+	PersistenceRangeDeleteHistoryTaskFromDLQScope
 	// PersistenceGetTimerTaskScope tracks GetTimerTask calls made by service to persistence layer
 	PersistenceGetTimerTaskScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
@@ -238,6 +244,8 @@ const (
 	PersistenceGetNamespaceScope
 	// PersistenceUpdateNamespaceScope tracks UpdateNamespace calls made by service to persistence layer
 	PersistenceUpdateNamespaceScope
+	// PersistenceRenameNamespaceScope tracks RenameNamespace calls made by service to persistence layer
+	PersistenceRenameNamespaceScope
 	// PersistenceDeleteNamespaceScope tracks DeleteNamespace calls made by service to persistence layer
 	PersistenceDeleteNamespaceScope
 	// PersistenceDeleteNamespaceByNameScope tracks DeleteNamespaceByName calls made by service to persistence layer
@@ -370,6 +378,10 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientDescribeShardQueuesScope tracks RPC calls to history service
+	HistoryClientDescribeShardQueuesScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -504,6 +516,24 @@ const (
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientDescribeShardQueuesScope tracks RPC calls to admin service
+	AdminClientDescribeShardQueuesScope
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientSetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigScope
+	// AdminClientDeleteDynamicConfigScope tracks RPC calls to admin service
+	AdminClientDeleteDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope
+	// AdminClientListDynamicConfigHistoryScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigHistoryScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientRenameNamespaceScope tracks RPC calls to admin service
+	AdminClientRenameNamespaceScope
+	// AdminClientReclaimSearchAttributeFieldsScope tracks RPC calls to admin service
+	AdminClientReclaimSearchAttributeFieldsScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -621,6 +651,10 @@ const (
 	PersistenceGetAllHistoryTreeBranchesScope
 	// PersistenceNamespaceReplicationQueueScope is the metrics scope for namespace replication queue
 	PersistenceNamespaceReplicationQueueScope
+	// PersistenceCompressBlobScope tracks the compression of history and mutable state blobs
+	PersistenceCompressBlobScope
+	// PersistenceDecompressBlobScope tracks the decompression of history and mutable state blobs
+	PersistenceDecompressBlobScope
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
@@ -719,6 +753,24 @@ const (
 	AdminRefreshWorkflowTasksScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminDescribeShardQueuesScope is the metric scope for admin.DescribeShardQueues
+	AdminDescribeShardQueuesScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminSetDynamicConfigScope is the metric scope for admin.SetDynamicConfig
+	AdminSetDynamicConfigScope
+	// AdminDeleteDynamicConfigScope is the metric scope for admin.DeleteDynamicConfig
+	AdminDeleteDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
+	// AdminListDynamicConfigHistoryScope is the metric scope for admin.ListDynamicConfigHistory
+	AdminListDynamicConfigHistoryScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminRenameNamespaceScope is the metric scope for admin.RenameNamespace
+	AdminRenameNamespaceScope
+	// AdminReclaimSearchAttributeFieldsScope is the metric scope for admin.ReclaimSearchAttributeFields
+	AdminReclaimSearchAttributeFieldsScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -890,6 +942,10 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryDeleteWorkflowExecutionScope is the scope used by delete workflow execution API
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryDescribeShardQueuesScope is the scope used by describe shard queues API
+	HistoryDescribeShardQueuesScope
 	// HistoryHistoryRemoveTaskScope is the scope used by remove task API
 	HistoryHistoryRemoveTaskScope
 	// HistoryCloseShard is the scope used by close shard API
@@ -1034,6 +1090,8 @@ const (
 	ReplicationTaskCleanupScope
 	// ReplicationDLQStatsScope is scope used by all metrics emitted related to replication DLQ
 	ReplicationDLQStatsScope
+	// HistoryPayloadOffloadScope is scope used by the offloading of the payloads of appended history events
+	HistoryPayloadOffloadScope
 
 	NumHistoryScopes
 )
@@ -1106,6 +1164,10 @@ const (
 	ParentClosePolicyProcessorScope
 	// AddSearchAttributesWorkflowScope is scope used by all metrics emitted by worker.AddSearchAttributesWorkflowScope module
 	AddSearchAttributesWorkflowScope
+	// DeleteNamespaceWorkflowScope is scope used by all metrics emitted by worker.DeleteNamespaceWorkflow module
+	DeleteNamespaceWorkflowScope
+	// RebuildVisibilityWorkflowScope is scope used by all metrics emitted by worker.RebuildVisibilityWorkflow module
+	RebuildVisibilityWorkflowScope
 
 	NumWorkerScopes
 )
@@ -1144,6 +1206,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetReplicationTasksFromDLQScope:               {operation: "GetReplicationTasksFromDLQ"},
 		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
 		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "RangeDeleteReplicationTaskFromDLQ"},
+		PersistencePutHistoryTaskToDLQScope:                      {operation: "PutHistoryTaskToDLQ"},
+		PersistenceGetHistoryTasksFromDLQScope:                   {operation: "GetHistoryTasksFromDLQ"},
+		PersistenceRangeDeleteHistoryTaskFromDLQScope:            {operation: "RangeDeleteHistoryTaskFromDLQ"},
 		PersistenceGetTimerTaskScope:                             {operation: "GetTimerTask"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
@@ -1163,6 +1228,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCreateNamespaceScope:                          {operation: "CreateNamespace"},
 		PersistenceGetNamespaceScope:                             {operation: "GetNamespace"},
 		PersistenceUpdateNamespaceScope:                          {operation: "UpdateNamespace"},
+		PersistenceRenameNamespaceScope:                          {operation: "RenameNamespace"},
 		PersistenceDeleteNamespaceScope:                          {operation: "DeleteNamespace"},
 		PersistenceDeleteNamespaceByNameScope:                    {operation: "DeleteNamespaceByName"},
 		PersistenceListNamespaceScope:                            {operation: "ListNamespace"},
@@ -1202,6 +1268,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateDLQAckLevelScope:                        {operation: "UpdateDLQAckLevel"},
 		PersistenceGetDLQAckLevelScope:                           {operation: "GetDLQAckLevel"},
 		PersistenceNamespaceReplicationQueueScope:                {operation: "NamespaceReplicationQueue"},
+		PersistenceCompressBlobScope:                             {operation: "CompressBlob"},
+		PersistenceDecompressBlobScope:                           {operation: "DecompressBlob"},
 		PersistenceGetClusterMetadataScope:                       {operation: "GetClusterMetadata"},
 		PersistenceSaveClusterMetadataScope:                      {operation: "SaveClusterMetadata"},
 		PersistencePruneClusterMembershipScope:                   {operation: "PruneClusterMembership"},
@@ -1242,6 +1310,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeShardQueuesScope:                 {operation: "HistoryClientDescribeShardQueues", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1305,6 +1375,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardQueuesScope:                   {operation: "AdminClientDescribeShardQueues", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSetDynamicConfigScope:                      {operation: "AdminClientSetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteDynamicConfigScope:                   {operation: "AdminClientDeleteDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigHistoryScope:              {operation: "AdminClientListDynamicConfigHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRenameNamespaceScope:                       {operation: "AdminClientRenameNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientReclaimSearchAttributeFieldsScope:          {operation: "AdminClientReclaimSearchAttributeFields", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1411,6 +1490,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminDescribeShardQueuesScope:              {operation: "DescribeShardQueues"},
+		AdminGetDynamicConfigScope:                 {operation: "GetDynamicConfig"},
+		AdminSetDynamicConfigScope:                 {operation: "SetDynamicConfig"},
+		AdminDeleteDynamicConfigScope:              {operation: "DeleteDynamicConfig"},
+		AdminListDynamicConfigScope:                {operation: "ListDynamicConfig"},
+		AdminListDynamicConfigHistoryScope:         {operation: "ListDynamicConfigHistory"},
+		AdminDeleteNamespaceScope:                  {operation: "DeleteNamespace"},
+		AdminRenameNamespaceScope:                  {operation: "RenameNamespace"},
+		AdminReclaimSearchAttributeFieldsScope:     {operation: "ReclaimSearchAttributeFields"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollWorkflowTaskQueueScope:              {operation: "PollWorkflowTaskQueue"},
@@ -1492,6 +1580,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                  {operation: "ShardController"},
 		HistoryReapplyEventsScope:                    {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
+		HistoryDeleteWorkflowExecutionScope:          {operation: "DeleteWorkflowExecution"},
+		HistoryDescribeShardQueuesScope:              {operation: "DescribeShardQueues"},
 		HistoryHistoryRemoveTaskScope:                {operation: "RemoveTask"},
 		HistoryCloseShard:                            {operation: "CloseShard"},
 		HistoryReplicateEventsV2:                     {operation: "ReplicateEventsV2"},
@@ -1562,6 +1652,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicationTaskFetcherScope:               {operation: "ReplicationTaskFetcher"},
 		ReplicationTaskCleanupScope:               {operation: "ReplicationTaskCleanup"},
 		ReplicationDLQStatsScope:                  {operation: "ReplicationDLQStats"},
+		HistoryPayloadOffloadScope:                {operation: "PayloadOffload"},
 		SyncShardTaskScope:                        {operation: "SyncShardTask"},
 		SyncActivityTaskScope:                     {operation: "SyncActivityTask"},
 		HistoryMetadataReplicationTaskScope:       {operation: "HistoryMetadataReplicationTask"},
@@ -1603,6 +1694,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		AddSearchAttributesWorkflowScope:       {operation: "AddSearchAttributesWorkflow"},
+		DeleteNamespaceWorkflowScope:           {operation: "DeleteNamespaceWorkflow"},
+		RebuildVisibilityWorkflowScope:         {operation: "RebuildVisibilityWorkflow"},
 	},
 }
 
@@ -1646,6 +1739,9 @@ const (
 	PersistenceErrNamespaceAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceCompressionUncompressedBytes
+	PersistenceCompressionCompressedBytes
+	PersistenceCompressionLatency
 
 	ClientRequests
 	ClientFailures
@@ -1665,6 +1761,7 @@ const (
 	HistoryCount
 	EventBlobSize
 	SearchAttributesSize
+	PayloadOffloadFailures
 
 	LockRequests
 	LockFailures
@@ -1693,6 +1790,9 @@ const (
 	PriorityTaskSubmitRequest
 	PriorityTaskSubmitLatency
 
+	NamespaceTaskQueueLatency
+	NamespaceTaskInFlightThrottled
+
 	HistoryArchiverArchiveNonRetryableErrorCount
 	HistoryArchiverArchiveTransientErrorCount
 	HistoryArchiverArchiveSuccessCount
@@ -1765,6 +1865,7 @@ const (
 	TaskLatency
 	TaskFailures
 	TaskDiscarded
+	TaskMovedToDLQ
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
@@ -1860,6 +1961,8 @@ const (
 	CacheFailures
 	CacheLatency
 	CacheMissCounter
+	CacheEvictionCounter
+	CacheSizeBytes
 	AcquireLockFailedCounter
 	WorkflowContextCleared
 	MutableStateSize
@@ -2033,6 +2136,11 @@ const (
 	ScavengerValidationRequestsCount
 	ScavengerValidationFailuresCount
 	AddSearchAttributesFailuresCount
+	DeleteNamespaceFailuresCount
+	RebuildVisibilityMissingDocumentsCount
+	RebuildVisibilityStaleDocumentsCount
+	RebuildVisibilityFailuresCount
+	RebuildVisibilityOrphanedDocumentsCount
 
 	NumWorkerMetrics
 )
@@ -2077,6 +2185,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrNamespaceAlreadyExistsCounter:         {metricName: "persistence_errors_namespace_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceCompressionUncompressedBytes:             {metricName: "persistence_compression_uncompressed_bytes", metricType: Counter},
+		PersistenceCompressionCompressedBytes:               {metricName: "persistence_compression_compressed_bytes", metricType: Counter},
+		PersistenceCompressionLatency:                       {metricName: "persistence_compression_latency", metricType: Timer},
 		ClientRequests:                                      {metricName: "client_requests", metricType: Counter},
 		ClientFailures:                                      {metricName: "client_errors", metricType: Counter},
 		ClientLatency:                                       {metricName: "client_latency", metricType: Timer},
@@ -2091,6 +2202,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
 		SearchAttributesSize:                                {metricName: "search_attributes_size", metricType: Timer},
+		PayloadOffloadFailures:                              {metricName: "payload_offload_failures", metricType: Counter},
 		LockRequests:                                        {metricName: "lock_requests", metricType: Counter},
 		LockFailures:                                        {metricName: "lock_failures", metricType: Counter},
 		LockLatency:                                         {metricName: "lock_latency", metricType: Timer},
@@ -2112,6 +2224,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParallelTaskTaskProcessingLatency:                   {metricName: "paralleltask_task_processing_latency", metricType: Timer},
 		PriorityTaskSubmitRequest:                           {metricName: "prioritytask_submit_request", metricType: Counter},
 		PriorityTaskSubmitLatency:                           {metricName: "prioritytask_submit_latency", metricType: Timer},
+		NamespaceTaskQueueLatency:                           {metricName: "namespacetask_queue_latency", metricType: Timer},
+		NamespaceTaskInFlightThrottled:                      {metricName: "namespacetask_inflight_throttled", metricType: Counter},
 
 		HistoryArchiverArchiveNonRetryableErrorCount:              {metricName: "history_archiver_archive_non_retryable_error", metricType: Counter},
 		HistoryArchiverArchiveTransientErrorCount:                 {metricName: "history_archiver_archive_transient_error", metricType: Counter},
@@ -2218,6 +2332,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskAttemptTimer:         {metricName: "task_attempt", metricType: Timer},
 		TaskFailures:             {metricName: "task_errors", metricType: Counter},
 		TaskDiscarded:            {metricName: "task_errors_discarded", metricType: Counter},
+		TaskMovedToDLQ:           {metricName: "task_errors_moved_to_dlq", metricType: Counter},
 		TaskStandbyRetryCounter:  {metricName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskNotActiveCounter:     {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter: {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
@@ -2310,6 +2425,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheFailures:                                     {metricName: "cache_errors", metricType: Counter},
 		CacheLatency:                                      {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
+		CacheEvictionCounter:                              {metricName: "cache_evictions", metricType: Counter},
+		CacheSizeBytes:                                    {metricName: "cache_size_bytes", metricType: Timer},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
@@ -2475,6 +2592,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ScavengerValidationRequestsCount:              {metricName: "scavenger_validation_requests", metricType: Counter},
 		ScavengerValidationFailuresCount:              {metricName: "scavenger_validation_failures", metricType: Counter},
 		AddSearchAttributesFailuresCount:              {metricName: "add_search_attributes_failures", metricType: Counter},
+		DeleteNamespaceFailuresCount:                  {metricName: "delete_namespace_failures", metricType: Counter},
+		RebuildVisibilityMissingDocumentsCount:        {metricName: "rebuild_visibility_missing_documents", metricType: Counter},
+		RebuildVisibilityStaleDocumentsCount:          {metricName: "rebuild_visibility_stale_documents", metricType: Counter},
+		RebuildVisibilityFailuresCount:                {metricName: "rebuild_visibility_failures", metricType: Counter},
+		RebuildVisibilityOrphanedDocumentsCount:       {metricName: "rebuild_visibility_orphaned_documents", metricType: Counter},
 	},
 }
 
@@ -38,4 +38,10 @@ const (
 
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
+
+	// DefaultAliasTTL is how long the previous name of a renamed namespace keeps resolving to the namespace
+	// if rename request does not specify it.
+	DefaultAliasTTL = 7 * 24 * time.Hour
+
+	namespaceAliasCheckPageSize = 100
 )
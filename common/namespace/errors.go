@@ -36,4 +36,13 @@ var (
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidArchivalConfig              = serviceerror.NewInvalidArgument("Invalid to enable archival without specifying a uri.")
+	errRenameToSameName                   = serviceerror.NewInvalidArgument("New namespace name is the same as the current one.")
+	errNamespaceNameUsedByAlias           = serviceerror.NewNamespaceAlreadyExists("Namespace name is used as an alias of another namespace.")
+
+	errSearchAttributeIsReservedMessage       = "Search attribute %s is reserved by system."
+	errSearchAttributeDefinedInClusterMessage = "Search attribute %s is already defined in the cluster."
+	errSearchAttributeAlreadyExistsMessage    = "Search attribute %s already exists in the namespace."
+	errSearchAttributeDoesntExistMessage      = "Search attribute %s doesn't exist in the namespace."
+	errNoFieldsAvailableMessage               = "Unable to add search attribute %s: no fields of type %s are available in the namespace."
+	errUnableToGetSearchAttributesMessage     = "Unable to get search attributes: %v."
 )
@@ -29,6 +29,7 @@ package namespace
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -38,6 +39,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -49,6 +51,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 // validateRetentionDuration ensures that retention duration can't be set below a sane minimum.
@@ -87,6 +90,23 @@ type (
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		RenameNamespace(
+			ctx context.Context,
+			renameRequest *adminservice.RenameNamespaceRequest,
+		) (*adminservice.RenameNamespaceResponse, error)
+		AddSearchAttributes(
+			ctx context.Context,
+			addRequest *adminservice.AddSearchAttributesRequest,
+		) (*adminservice.AddSearchAttributesResponse, error)
+		RemoveSearchAttributes(
+			ctx context.Context,
+			removeRequest *adminservice.RemoveSearchAttributesRequest,
+		) (*adminservice.RemoveSearchAttributesResponse, error)
+		ReclaimSearchAttributeFields(
+			ctx context.Context,
+			namespace string,
+			clearField func(fieldName string) error,
+		) error
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
@@ -99,6 +119,7 @@ type (
 		namespaceAttrValidator *AttrValidatorImpl
 		archivalMetadata       archiver.ArchivalMetadata
 		archiverProvider       provider.ArchiverProvider
+		saProvider             searchattribute.Provider
 	}
 )
 
@@ -113,6 +134,7 @@ func NewHandler(
 	namespaceReplicator Replicator,
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
+	saProvider searchattribute.Provider,
 ) *HandlerImpl {
 	return &HandlerImpl{
 		maxBadBinaryCount:      maxBadBinaryCount,
@@ -123,6 +145,7 @@ func NewHandler(
 		namespaceAttrValidator: newAttrValidator(clusterMetadata),
 		archivalMetadata:       archivalMetadata,
 		archiverProvider:       archiverProvider,
+		saProvider:             saProvider,
 	}
 }
 
@@ -644,6 +667,306 @@ func (d *HandlerImpl) DeprecateNamespace(
 	return nil, nil
 }
 
+// RenameNamespace renames a namespace, the previous name is kept as an alias of the namespace until alias TTL expires
+func (d *HandlerImpl) RenameNamespace(
+	_ context.Context,
+	renameRequest *adminservice.RenameNamespaceRequest,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	previousName := renameRequest.GetNamespace()
+	newName := renameRequest.GetNewName()
+	if previousName == newName {
+		return nil, errRenameToSameName
+	}
+	aliasTTL := timestamp.DurationValue(renameRequest.GetAliasTtl())
+	if aliasTTL <= 0 {
+		aliasTTL = DefaultAliasTTL
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: previousName})
+	if err != nil {
+		return nil, err
+	}
+
+	isGlobalNamespace := getResponse.IsGlobalNamespace
+	if isGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return nil, errNotMasterCluster
+	}
+
+	info := getResponse.Namespace.Info
+	now := time.Now().UTC()
+	if err := d.validateNewNamespaceName(info.Id, newName, now); err != nil {
+		return nil, err
+	}
+
+	var aliases []*persistencespb.NamespaceAlias
+	for _, alias := range info.Aliases {
+		// renaming namespace back to one of its aliases drops that alias
+		if alias.Name != newName && timestamp.TimeValue(alias.ExpirationTime).After(now) {
+			aliases = append(aliases, alias)
+		}
+	}
+	info.Aliases = append(aliases, &persistencespb.NamespaceAlias{
+		Name:           previousName,
+		ExpirationTime: timestamp.TimePtr(now.Add(aliasTTL)),
+	})
+	info.Name = newName
+	getResponse.Namespace.ConfigVersion++
+
+	err = d.metadataMgr.RenameNamespace(&persistence.RenameNamespaceRequest{
+		PreviousName:        previousName,
+		Namespace:           getResponse.Namespace,
+		IsGlobalNamespace:   isGlobalNamespace,
+		NotificationVersion: notificationVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isGlobalNamespace {
+		err = d.namespaceReplicator.HandleTransmissionTask(enumsspb.NAMESPACE_OPERATION_RENAME,
+			info,
+			getResponse.Namespace.Config,
+			getResponse.Namespace.ReplicationConfig,
+			getResponse.Namespace.ConfigVersion,
+			getResponse.Namespace.FailoverVersion,
+			isGlobalNamespace,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.logger.Info("Rename namespace succeeded",
+		tag.WorkflowNamespace(newName),
+		tag.WorkflowNamespaceID(info.Id),
+		tag.NewStringTag("previous-namespace", previousName),
+	)
+	return &adminservice.RenameNamespaceResponse{NamespaceId: info.Id}, nil
+}
+
+// AddSearchAttributes registers custom search attributes in the namespace.
+// Every search attribute is assigned to a pre-created generic field of the same type which isn't used
+// in the namespace and isn't released by a removed search attribute.
+func (d *HandlerImpl) AddSearchAttributes(
+	_ context.Context,
+	addRequest *adminservice.AddSearchAttributesRequest,
+) (*adminservice.AddSearchAttributesResponse, error) {
+
+	clusterSearchAttributes, err := d.saProvider.GetSearchAttributes(addRequest.GetIndexName(), false)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+	}
+
+	saNames := make([]string, 0, len(addRequest.GetSearchAttributes()))
+	for saName := range addRequest.GetSearchAttributes() {
+		if saName == "" || searchattribute.IsReserved(saName) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, saName))
+		}
+		if clusterSearchAttributes.IsDefined(saName) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeDefinedInClusterMessage, saName))
+		}
+		saNames = append(saNames, saName)
+	}
+	// sort names to assign fields deterministically
+	sort.Strings(saNames)
+
+	err = d.updateSearchAttributeAliases(addRequest.GetNamespace(), func(aliases map[string]string) error {
+		usedAliases := make(map[string]struct{}, len(aliases))
+		for _, alias := range aliases {
+			usedAliases[alias] = struct{}{}
+		}
+
+		for _, saName := range saNames {
+			if _, ok := usedAliases[saName]; ok {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, saName))
+			}
+			saType := addRequest.GetSearchAttributes()[saName]
+			fieldName := ""
+			for _, genericField := range searchattribute.GenericFields(saType) {
+				// released fields are present with empty alias and are skipped too
+				if _, ok := aliases[genericField]; !ok {
+					fieldName = genericField
+					break
+				}
+			}
+			if fieldName == "" {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(errNoFieldsAvailableMessage, saName, saType))
+			}
+			aliases[fieldName] = saName
+			usedAliases[saName] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.AddSearchAttributesResponse{}, nil
+}
+
+// RemoveSearchAttributes removes custom search attributes from the namespace and releases their fields.
+// Values which are already stored in the released fields are not removed, so released fields keep an empty alias
+// and aren't assigned to another search attribute until they are reclaimed, see ReclaimSearchAttributeFields.
+func (d *HandlerImpl) RemoveSearchAttributes(
+	_ context.Context,
+	removeRequest *adminservice.RemoveSearchAttributesRequest,
+) (*adminservice.RemoveSearchAttributesResponse, error) {
+
+	err := d.updateSearchAttributeAliases(removeRequest.GetNamespace(), func(aliases map[string]string) error {
+		for _, saName := range removeRequest.GetSearchAttributes() {
+			found := false
+			for fieldName, alias := range aliases {
+				if alias != "" && alias == saName {
+					aliases[fieldName] = ""
+					found = true
+					break
+				}
+			}
+			if !found {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeDoesntExistMessage, saName))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RemoveSearchAttributesResponse{}, nil
+}
+
+// ReclaimSearchAttributeFields makes the fields released by removed search attributes of the namespace available
+// to new search attributes again. clearField must remove the values stored in the field from all documents of
+// the namespace, the field is reclaimed only if it succeeds.
+func (d *HandlerImpl) ReclaimSearchAttributeFields(
+	_ context.Context,
+	namespace string,
+	clearField func(fieldName string) error,
+) error {
+
+	return d.updateSearchAttributeAliases(namespace, func(aliases map[string]string) error {
+		for fieldName, alias := range aliases {
+			if alias != "" {
+				continue
+			}
+			if err := clearField(fieldName); err != nil {
+				return err
+			}
+			delete(aliases, fieldName)
+		}
+		return nil
+	})
+}
+
+func (d *HandlerImpl) updateSearchAttributeAliases(
+	namespace string,
+	updateAliases func(aliases map[string]string) error,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	if err != nil {
+		return err
+	}
+
+	isGlobalNamespace := getResponse.IsGlobalNamespace
+	if isGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return errNotMasterCluster
+	}
+
+	config := getResponse.Namespace.Config
+	aliases := make(map[string]string, len(config.CustomSearchAttributeAliases))
+	for fieldName, alias := range config.CustomSearchAttributeAliases {
+		aliases[fieldName] = alias
+	}
+	if err := updateAliases(aliases); err != nil {
+		return err
+	}
+	config.CustomSearchAttributeAliases = aliases
+	getResponse.Namespace.ConfigVersion++
+
+	err = d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           getResponse.Namespace,
+		NotificationVersion: notificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	if isGlobalNamespace {
+		err = d.namespaceReplicator.HandleTransmissionTask(enumsspb.NAMESPACE_OPERATION_UPDATE,
+			getResponse.Namespace.Info,
+			config,
+			getResponse.Namespace.ReplicationConfig,
+			getResponse.Namespace.ConfigVersion,
+			getResponse.Namespace.FailoverVersion,
+			isGlobalNamespace,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("Update namespace search attributes succeeded",
+		tag.WorkflowNamespace(namespace),
+		tag.WorkflowNamespaceID(getResponse.Namespace.Info.Id),
+	)
+	return nil
+}
+
+// validateNewNamespaceName checks that the name is neither used by another namespace nor by an unexpired alias
+// of another namespace
+func (d *HandlerImpl) validateNewNamespaceName(
+	namespaceID string,
+	name string,
+	now time.Time,
+) error {
+
+	_, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	switch err.(type) {
+	case nil:
+		return serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
+	case *serviceerror.NotFound:
+		// name is not used by any namespace, check aliases
+	default:
+		return err
+	}
+
+	request := &persistence.ListNamespacesRequest{PageSize: namespaceAliasCheckPageSize}
+	for {
+		resp, err := d.metadataMgr.ListNamespaces(request)
+		if err != nil {
+			return err
+		}
+		for _, namespace := range resp.Namespaces {
+			if namespace.Namespace.Info.Id == namespaceID {
+				continue
+			}
+			for _, alias := range namespace.Namespace.Info.Aliases {
+				if alias.Name == name && timestamp.TimeValue(alias.ExpirationTime).After(now) {
+					return errNamespaceNameUsedByAlias
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (d *HandlerImpl) createResponse(
 	ctx context.Context,
 	info *persistencespb.NamespaceInfo,
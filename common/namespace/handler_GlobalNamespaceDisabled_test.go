@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -108,6 +109,7 @@ func (s *namespaceHandlerGlobalNamespaceDisabledSuite) SetupTest() {
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		searchattribute.NewTestProvider(),
 	)
 }
 
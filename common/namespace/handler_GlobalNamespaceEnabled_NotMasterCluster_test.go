@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -109,6 +110,7 @@ func (s *namespaceHandlerGlobalNamespaceEnabledNotMasterClusterSuite) SetupTest(
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		searchattribute.NewTestProvider(),
 	)
 }
 
@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	namespaceHandlerSearchAttributesSuite struct {
+		suite.Suite
+
+		controller *gomock.Controller
+
+		mockMetadataMgr     *persistence.MockMetadataManager
+		mockClusterMetadata *cluster.MockMetadata
+
+		handler *HandlerImpl
+	}
+)
+
+func TestNamespaceHandlerSearchAttributesSuite(t *testing.T) {
+	s := new(namespaceHandlerSearchAttributesSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceHandlerSearchAttributesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
+	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.handler = NewHandler(
+		nil,
+		log.NewNoopLogger(),
+		s.mockMetadataMgr,
+		s.mockClusterMetadata,
+		nil,
+		nil,
+		nil,
+		searchattribute.NewTestProvider(),
+	)
+}
+
+func (s *namespaceHandlerSearchAttributesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceHandlerSearchAttributesSuite) TestAddSearchAttributes_InvalidName() {
+	_, err := s.handler.AddSearchAttributes(context.Background(), &adminservice.AddSearchAttributesRequest{
+		Namespace: "test-namespace",
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Search attribute Keyword01 is reserved by system."}, err)
+
+	_, err = s.handler.AddSearchAttributes(context.Background(), &adminservice.AddSearchAttributesRequest{
+		Namespace: "test-namespace",
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Search attribute CustomKeywordField is already defined in the cluster."}, err)
+}
+
+func (s *namespaceHandlerSearchAttributesSuite) TestRemoveAndAddSearchAttributes_ReleasedFieldIsNotReused() {
+	detail := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+		Config: &persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"Keyword01": "ProductId",
+			},
+		},
+	}
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{}, nil).Times(2)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: detail,
+	}, nil).Times(2)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).Return(nil).Times(2)
+
+	_, err := s.handler.RemoveSearchAttributes(context.Background(), &adminservice.RemoveSearchAttributesRequest{
+		Namespace:        "test-namespace",
+		SearchAttributes: []string{"ProductId"},
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"Keyword01": ""}, detail.Config.CustomSearchAttributeAliases)
+
+	_, err = s.handler.AddSearchAttributes(context.Background(), &adminservice.AddSearchAttributesRequest{
+		Namespace: "test-namespace",
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"Keyword01": "", "Keyword02": "OrderId"}, detail.Config.CustomSearchAttributeAliases)
+}
+
+func (s *namespaceHandlerSearchAttributesSuite) TestReclaimSearchAttributeFields() {
+	detail := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+		Config: &persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"Keyword01": "",
+				"Keyword02": "OrderId",
+			},
+		},
+	}
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{}, nil).Times(2)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: detail,
+	}, nil).Times(2)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).Return(nil)
+
+	// field isn't reclaimed if its values can't be cleared
+	err := s.handler.ReclaimSearchAttributeFields(context.Background(), "test-namespace", func(fieldName string) error {
+		return serviceerror.NewInternal("unable to clear field")
+	})
+	s.Error(err)
+	s.Equal(map[string]string{"Keyword01": "", "Keyword02": "OrderId"}, detail.Config.CustomSearchAttributeAliases)
+
+	var clearedFields []string
+	err = s.handler.ReclaimSearchAttributeFields(context.Background(), "test-namespace", func(fieldName string) error {
+		clearedFields = append(clearedFields, fieldName)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]string{"Keyword01"}, clearedFields)
+	s.Equal(map[string]string{"Keyword02": "OrderId"}, detail.Config.CustomSearchAttributeAliases)
+
+	// reclaimed field is assigned to new search attribute
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: detail,
+	}, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).Return(nil)
+	_, err = s.handler.AddSearchAttributes(context.Background(), &adminservice.AddSearchAttributesRequest{
+		Namespace: "test-namespace",
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"ProductId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"Keyword01": "ProductId", "Keyword02": "OrderId"}, detail.Config.CustomSearchAttributeAliases)
+}
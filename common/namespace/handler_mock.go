@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -59,6 +60,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// AddSearchAttributes mocks base method.
+func (m *MockHandler) AddSearchAttributes(ctx context.Context, addRequest *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributes", ctx, addRequest)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSearchAttributes indicates an expected call of AddSearchAttributes.
+func (mr *MockHandlerMockRecorder) AddSearchAttributes(ctx, addRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockHandler)(nil).AddSearchAttributes), ctx, addRequest)
+}
+
 // DeprecateNamespace mocks base method.
 func (m *MockHandler) DeprecateNamespace(ctx context.Context, deprecateRequest *workflowservice.DeprecateNamespaceRequest) (*workflowservice.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +120,20 @@ func (mr *MockHandlerMockRecorder) ListNamespaces(ctx, listRequest interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockHandler)(nil).ListNamespaces), ctx, listRequest)
 }
 
+// ReclaimSearchAttributeFields mocks base method.
+func (m *MockHandler) ReclaimSearchAttributeFields(ctx context.Context, namespace string, clearField func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReclaimSearchAttributeFields", ctx, namespace, clearField)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReclaimSearchAttributeFields indicates an expected call of ReclaimSearchAttributeFields.
+func (mr *MockHandlerMockRecorder) ReclaimSearchAttributeFields(ctx, namespace, clearField interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimSearchAttributeFields", reflect.TypeOf((*MockHandler)(nil).ReclaimSearchAttributeFields), ctx, namespace, clearField)
+}
+
 // RegisterNamespace mocks base method.
 func (m *MockHandler) RegisterNamespace(ctx context.Context, registerRequest *workflowservice.RegisterNamespaceRequest) (*workflowservice.RegisterNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +149,36 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// RemoveSearchAttributes mocks base method.
+func (m *MockHandler) RemoveSearchAttributes(ctx context.Context, removeRequest *adminservice.RemoveSearchAttributesRequest) (*adminservice.RemoveSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSearchAttributes", ctx, removeRequest)
+	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
+func (mr *MockHandlerMockRecorder) RemoveSearchAttributes(ctx, removeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockHandler)(nil).RemoveSearchAttributes), ctx, removeRequest)
+}
+
+// RenameNamespace mocks base method.
+func (m *MockHandler) RenameNamespace(ctx context.Context, renameRequest *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", ctx, renameRequest)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockHandlerMockRecorder) RenameNamespace(ctx, renameRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockHandler)(nil).RenameNamespace), ctx, renameRequest)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
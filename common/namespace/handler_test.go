@@ -37,8 +37,10 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/config"
 
 	"go.temporal.io/server/common"
@@ -50,6 +52,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -111,6 +114,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		searchattribute.NewTestProvider(),
 	)
 }
 
@@ -432,6 +436,64 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidRetentionPeriod
 	}
 }
 
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace() {
+	namespace := s.getRandomNamespace()
+	otherNamespace := s.getRandomNamespace()
+	newName := s.getRandomNamespace()
+	for _, name := range []string{namespace, otherNamespace} {
+		_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        name,
+			WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+			IsGlobalNamespace:                false,
+		})
+		s.NoError(err)
+	}
+
+	_, err := s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   namespace,
+	})
+	s.Equal(errRenameToSameName, err)
+
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   otherNamespace,
+	})
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+
+	renameResp, err := s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   newName,
+	})
+	s.NoError(err)
+
+	getResp, err := s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: newName})
+	s.NoError(err)
+	s.Equal(renameResp.GetNamespaceId(), getResp.Namespace.Info.Id)
+	s.Equal(1, len(getResp.Namespace.Info.Aliases))
+	s.Equal(namespace, getResp.Namespace.Info.Aliases[0].Name)
+	s.True(getResp.Namespace.Info.Aliases[0].ExpirationTime.After(time.Now().Add(DefaultAliasTTL - time.Hour)))
+
+	// name is still in use as an alias of the renamed namespace
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: otherNamespace,
+		NewName:   namespace,
+	})
+	s.Equal(errNamespaceNameUsedByAlias, err)
+
+	// renaming back to the alias drops it
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: newName,
+		NewName:   namespace,
+		AliasTtl:  timestamp.DurationPtr(time.Hour),
+	})
+	s.NoError(err)
+	getResp, err = s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Equal(1, len(getResp.Namespace.Info.Aliases))
+	s.Equal(newName, getResp.Namespace.Info.Aliases[0].Name)
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
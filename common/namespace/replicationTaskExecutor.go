@@ -98,6 +98,8 @@ func (h *namespaceReplicationTaskExecutorImpl) Execute(task *replicationspb.Name
 		return h.handleNamespaceCreationReplicationTask(task)
 	case enumsspb.NAMESPACE_OPERATION_UPDATE:
 		return h.handleNamespaceUpdateReplicationTask(task)
+	case enumsspb.NAMESPACE_OPERATION_RENAME:
+		return h.handleNamespaceRenameReplicationTask(task)
 	default:
 		return ErrInvalidNamespaceOperation
 	}
@@ -113,20 +115,14 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 
 	request := &persistence.CreateNamespaceRequest{
 		Namespace: &persistencespb.NamespaceDetail{
-			Info: &persistencespb.NamespaceInfo{
-				Id:          task.GetId(),
-				Name:        task.Info.GetName(),
-				State:       task.Info.GetState(),
-				Description: task.Info.GetDescription(),
-				Owner:       task.Info.GetOwnerEmail(),
-				Data:        task.Info.Data,
-			},
+			Info: h.convertNamespaceInfoFromTask(task),
 			Config: &persistencespb.NamespaceConfig{
-				Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
-				HistoryArchivalState:    task.Config.GetHistoryArchivalState(),
-				HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
-				VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
-				VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+				Retention:                    task.Config.GetWorkflowExecutionRetentionTtl(),
+				HistoryArchivalState:         task.Config.GetHistoryArchivalState(),
+				HistoryArchivalUri:           task.Config.GetHistoryArchivalUri(),
+				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
+				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
+				CustomSearchAttributeAliases: task.GetCustomSearchAttributeAliases(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -224,20 +220,14 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	if resp.Namespace.ConfigVersion < task.GetConfigVersion() {
 		recordUpdated = true
-		request.Namespace.Info = &persistencespb.NamespaceInfo{
-			Id:          task.GetId(),
-			Name:        task.Info.GetName(),
-			State:       task.Info.GetState(),
-			Description: task.Info.GetDescription(),
-			Owner:       task.Info.GetOwnerEmail(),
-			Data:        task.Info.Data,
-		}
+		request.Namespace.Info = h.convertNamespaceInfoFromTask(task)
 		request.Namespace.Config = &persistencespb.NamespaceConfig{
-			Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
-			HistoryArchivalState:    task.Config.GetHistoryArchivalState(),
-			HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
-			VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
-			VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+			Retention:                    task.Config.GetWorkflowExecutionRetentionTtl(),
+			HistoryArchivalState:         task.Config.GetHistoryArchivalState(),
+			HistoryArchivalUri:           task.Config.GetHistoryArchivalUri(),
+			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
+			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
+			CustomSearchAttributeAliases: task.GetCustomSearchAttributeAliases(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -259,6 +249,50 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 	return h.metadataManagerV2.UpdateNamespace(request)
 }
 
+// handleNamespaceRenameReplicationTask handles the namespace rename replication task
+func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceRenameReplicationTask(task *replicationspb.NamespaceTaskAttributes) error {
+	// task already validated
+	err := h.validateNamespaceStatus(task.Info.State)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := h.metadataManagerV2.GetMetadata()
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+
+	// namespace is looked up by id since the name is exactly what is changed by the task
+	resp, err := h.metadataManagerV2.GetNamespace(&persistence.GetNamespaceRequest{
+		ID: task.GetId(),
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return h.handleNamespaceCreationReplicationTask(task)
+		}
+		return err
+	}
+
+	if resp.Namespace.ConfigVersion >= task.GetConfigVersion() {
+		// this rename or a newer change is already applied
+		return nil
+	}
+	if resp.Namespace.Info.Name == task.Info.GetName() {
+		return h.handleNamespaceUpdateReplicationTask(task)
+	}
+
+	previousName := resp.Namespace.Info.Name
+	resp.Namespace.Info = h.convertNamespaceInfoFromTask(task)
+	resp.Namespace.ConfigVersion = task.GetConfigVersion()
+	return h.metadataManagerV2.RenameNamespace(&persistence.RenameNamespaceRequest{
+		PreviousName:        previousName,
+		Namespace:           resp.Namespace,
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: notificationVersion,
+	})
+}
+
 func (h *namespaceReplicationTaskExecutorImpl) validateNamespaceReplicationTask(task *replicationspb.NamespaceTaskAttributes) error {
 	if task == nil {
 		return ErrEmptyNamespaceReplicationTask
@@ -286,6 +320,19 @@ func (h *namespaceReplicationTaskExecutorImpl) convertClusterReplicationConfigFr
 	return output
 }
 
+func (h *namespaceReplicationTaskExecutorImpl) convertNamespaceInfoFromTask(
+	task *replicationspb.NamespaceTaskAttributes) *persistencespb.NamespaceInfo {
+	return &persistencespb.NamespaceInfo{
+		Id:          task.GetId(),
+		Name:        task.Info.GetName(),
+		State:       task.Info.GetState(),
+		Description: task.Info.GetDescription(),
+		Owner:       task.Info.GetOwnerEmail(),
+		Data:        task.Info.Data,
+		Aliases:     task.GetAliases(),
+	}
+}
+
 func (h *namespaceReplicationTaskExecutorImpl) validateNamespaceStatus(input enumspb.NamespaceState) error {
 	switch input {
 	case enumspb.NAMESPACE_STATE_REGISTERED, enumspb.NAMESPACE_STATE_DEPRECATED:
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
@@ -807,3 +808,64 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	s.Equal(int64(0), resp.Namespace.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_RenameNamespaceTask() {
+	id := uuid.New()
+	name := "some random namespace test name"
+	newName := "some random namespace test new name"
+	retention := 10 * time.Hour * 24
+	clusterActive := "some random active cluster name"
+	configVersion := int64(0)
+	failoverVersion := int64(59)
+	aliasExpirationTime := time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)
+
+	createTask := &replicationspb.NamespaceTaskAttributes{
+		NamespaceOperation: enumsspb.NAMESPACE_OPERATION_CREATE,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:  name,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: &retention,
+		},
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          []*replicationpb.ClusterReplicationConfig{{ClusterName: clusterActive}},
+		},
+		ConfigVersion:   configVersion,
+		FailoverVersion: failoverVersion,
+	}
+
+	err := s.namespaceReplicator.Execute(createTask)
+	s.Nil(err)
+
+	renameTask := &replicationspb.NamespaceTaskAttributes{
+		NamespaceOperation: enumsspb.NAMESPACE_OPERATION_RENAME,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:  newName,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		Config:            createTask.Config,
+		ReplicationConfig: createTask.ReplicationConfig,
+		ConfigVersion:     configVersion + 1,
+		FailoverVersion:   failoverVersion,
+		Aliases: []*persistencespb.NamespaceAlias{
+			{Name: name, ExpirationTime: &aliasExpirationTime},
+		},
+	}
+	err = s.namespaceReplicator.Execute(renameTask)
+	s.Nil(err)
+	// duplicated task is a no-op
+	err = s.namespaceReplicator.Execute(renameTask)
+	s.Nil(err)
+
+	_, err = s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	s.IsType(&serviceerror.NotFound{}, err)
+	resp, err := s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: id})
+	s.Nil(err)
+	s.Equal(newName, resp.Namespace.Info.Name)
+	s.Equal(configVersion+1, resp.Namespace.ConfigVersion)
+	s.Equal(renameTask.Aliases, resp.Namespace.Info.Aliases)
+}
@@ -107,8 +107,10 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          namespaceReplicator.convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
+			ConfigVersion:                configVersion,
+			FailoverVersion:              failoverVersion,
+			Aliases:                      info.Aliases,
+			CustomSearchAttributeAliases: config.CustomSearchAttributeAliases,
 		},
 	}
 
@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package payloadoffload
+
+import (
+	"context"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	rehydrateTimeout = 30 * time.Second
+)
+
+type (
+	rehydratingExecutionManager struct {
+		persistence.ExecutionManager
+		offloader Offloader
+	}
+)
+
+// DeleteHistoryBranch deletes the history branch and, if it is the last branch of its history tree,
+// the payloads offloaded for the tree. Payloads are deleted first so that a failed deletion is retried
+// together with the branch.
+func DeleteHistoryBranch(
+	ctx context.Context,
+	offloader Offloader,
+	executionManager persistence.ExecutionManager,
+	request *persistence.DeleteHistoryBranchRequest,
+) error {
+	if offloader.Enabled() {
+		branch, err := serialization.HistoryBranchFromBlob(request.BranchToken, enumspb.ENCODING_TYPE_PROTO3.String())
+		if err != nil {
+			return err
+		}
+		resp, err := executionManager.GetHistoryTree(&persistence.GetHistoryTreeRequest{
+			TreeID:  branch.GetTreeId(),
+			ShardID: &request.ShardID,
+		})
+		if err != nil {
+			return err
+		}
+		lastBranch := true
+		for _, br := range resp.Branches {
+			if br.GetBranchId() != branch.GetBranchId() {
+				lastBranch = false
+				break
+			}
+		}
+		if lastBranch {
+			if err := offloader.Delete(ctx, branch.GetTreeId()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return executionManager.DeleteHistoryBranch(request)
+}
+
+// NewRehydratingExecutionManager returns an ExecutionManager whose history events contain the offloaded payloads
+// instead of references, e.g. so that archived histories stay readable once the payloads are deleted and
+// replicated histories don't reference the payload store of the active cluster
+func NewRehydratingExecutionManager(
+	executionManager persistence.ExecutionManager,
+	offloader Offloader,
+) persistence.ExecutionManager {
+	if !offloader.Enabled() {
+		return executionManager
+	}
+	return &rehydratingExecutionManager{
+		ExecutionManager: executionManager,
+		offloader:        offloader,
+	}
+}
+
+func (m *rehydratingExecutionManager) ReadHistoryBranch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.rehydrate(&historypb.History{Events: resp.HistoryEvents}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *rehydratingExecutionManager) ReadHistoryBranchByBatch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranchByBatch(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, history := range resp.History {
+		if err := m.rehydrate(history); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (m *rehydratingExecutionManager) ReadRawHistoryBranch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadRawHistoryBranchResponse, error) {
+	resp, err := m.ExecutionManager.ReadRawHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+	serializer := serialization.NewSerializer()
+	for i, blob := range resp.HistoryEventBlobs {
+		events, err := serializer.DeserializeEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		if !hasReference(events) {
+			continue
+		}
+		if err := m.rehydrate(&historypb.History{Events: events}); err != nil {
+			return nil, err
+		}
+		resp.HistoryEventBlobs[i], err = serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (m *rehydratingExecutionManager) rehydrate(
+	history *historypb.History,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rehydrateTimeout)
+	defer cancel()
+	return m.offloader.Rehydrate(ctx, history)
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package payloadoffload
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	historySuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockStore        *archiver.MockPayloadStore
+		executionManager *persistence.MockExecutionManager
+		offloader        Offloader
+		uri              archiver.URI
+	}
+)
+
+func TestHistorySuite(t *testing.T) {
+	suite.Run(t, new(historySuite))
+}
+
+func (s *historySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockStore = archiver.NewMockPayloadStore(s.controller)
+	s.executionManager = persistence.NewMockExecutionManager(s.controller)
+
+	var err error
+	s.uri, err = archiver.NewURI("file:///tmp/payloads")
+	s.NoError(err)
+	s.offloader = NewOffloader(s.mockStore, s.uri)
+}
+
+func (s *historySuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historySuite) TestDeleteHistoryBranch_LastBranch() {
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID(testTreeID, "branch-id")
+	s.NoError(err)
+	request := &persistence.DeleteHistoryBranchRequest{BranchToken: branchToken, ShardID: 1}
+
+	s.executionManager.EXPECT().GetHistoryTree(gomock.Any()).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*persistencespb.HistoryBranch{{TreeId: testTreeID, BranchId: "branch-id"}},
+	}, nil)
+	s.mockStore.EXPECT().DeletePrefix(gomock.Any(), s.uri, testTreeID+"/").Return(nil)
+	s.executionManager.EXPECT().DeleteHistoryBranch(request).Return(nil)
+
+	s.NoError(DeleteHistoryBranch(context.Background(), s.offloader, s.executionManager, request))
+}
+
+func (s *historySuite) TestDeleteHistoryBranch_SharedTree() {
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID(testTreeID, "branch-id")
+	s.NoError(err)
+	request := &persistence.DeleteHistoryBranchRequest{BranchToken: branchToken, ShardID: 1}
+
+	// e.g. a reset run forked from the branch still references the payloads
+	s.executionManager.EXPECT().GetHistoryTree(gomock.Any()).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*persistencespb.HistoryBranch{
+			{TreeId: testTreeID, BranchId: "branch-id"},
+			{TreeId: testTreeID, BranchId: "reset-branch-id"},
+		},
+	}, nil)
+	s.executionManager.EXPECT().DeleteHistoryBranch(request).Return(nil)
+
+	s.NoError(DeleteHistoryBranch(context.Background(), s.offloader, s.executionManager, request))
+}
+
+func (s *historySuite) TestDeleteHistoryBranch_DeletePayloadsFailed() {
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID(testTreeID, "branch-id")
+	s.NoError(err)
+	request := &persistence.DeleteHistoryBranchRequest{BranchToken: branchToken, ShardID: 1}
+
+	s.executionManager.EXPECT().GetHistoryTree(gomock.Any()).Return(&persistence.GetHistoryTreeResponse{}, nil)
+	s.mockStore.EXPECT().DeletePrefix(gomock.Any(), s.uri, testTreeID+"/").Return(errors.New("some random error"))
+
+	s.Error(DeleteHistoryBranch(context.Background(), s.offloader, s.executionManager, request))
+}
+
+func (s *historySuite) TestRehydratingExecutionManager() {
+	input := payloads.EncodeString("input")
+	data, err := input.Payloads[0].Marshal()
+	s.NoError(err)
+
+	s.mockStore.EXPECT().Put(gomock.Any(), s.uri, gomock.Any(), data).Return(nil)
+	events, err := s.offloader.Offload(context.Background(), testTreeID, 1, []*historypb.HistoryEvent{newStartedEvent(input)})
+	s.NoError(err)
+	reference := events[0]
+	s.True(IsReference(reference.GetWorkflowExecutionStartedEventAttributes().GetInput().Payloads[0]))
+
+	request := &persistence.ReadHistoryBranchRequest{}
+	s.executionManager.EXPECT().ReadHistoryBranchByBatch(request).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{{Events: []*historypb.HistoryEvent{reference}}},
+	}, nil)
+	s.mockStore.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(data, nil)
+
+	resp, err := NewRehydratingExecutionManager(s.executionManager, s.offloader).ReadHistoryBranchByBatch(request)
+	s.NoError(err)
+	s.Equal(input, resp.History[0].Events[0].GetWorkflowExecutionStartedEventAttributes().GetInput())
+}
+
+func (s *historySuite) TestRehydratingExecutionManager_Disabled() {
+	s.Same(s.executionManager, NewRehydratingExecutionManager(s.executionManager, NewDisabledOffloader()))
+}
+
+func (s *historySuite) TestRehydratingExecutionManager_RawHistory() {
+	input := payloads.EncodeString("input")
+	data, err := input.Payloads[0].Marshal()
+	s.NoError(err)
+
+	s.mockStore.EXPECT().Put(gomock.Any(), s.uri, gomock.Any(), data).Return(nil)
+	events, err := s.offloader.Offload(context.Background(), testTreeID, 1, []*historypb.HistoryEvent{newStartedEvent(input)})
+	s.NoError(err)
+	serializer := serialization.NewSerializer()
+	referenceBlob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	plainBlob, err := serializer.SerializeEvents([]*historypb.HistoryEvent{newStartedEvent(input)}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	request := &persistence.ReadHistoryBranchRequest{}
+	s.executionManager.EXPECT().ReadRawHistoryBranch(request).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*commonpb.DataBlob{plainBlob, referenceBlob},
+	}, nil)
+	s.mockStore.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(data, nil)
+
+	resp, err := NewRehydratingExecutionManager(s.executionManager, s.offloader).ReadRawHistoryBranch(request)
+	s.NoError(err)
+	s.Same(plainBlob, resp.HistoryEventBlobs[0])
+	rehydrated, err := serializer.DeserializeEvents(resp.HistoryEventBlobs[1])
+	s.NoError(err)
+	s.Equal(input, rehydrated[0].GetWorkflowExecutionStartedEventAttributes().GetInput())
+}
@@ -0,0 +1,397 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package payloadoffload moves large payloads out of workflow history into blob storage.
+//
+// Offloaded payloads are stored under a prefix derived from the history tree of the execution which
+// wrote them. Reset and forked branches share the nodes of the tree, so the payloads are only deleted
+// together with the last branch of the tree, see DeleteHistoryBranch. References written by another
+// tree, e.g. carried over by continue-as-new, retry or cron, are copied into the tree when appended.
+// Archived histories contain the rehydrated payloads, see NewRehydratingExecutionManager.
+//
+// Payloads are offloaded when history is appended. The frontend and history check the requests against
+// the blob size limits using the size the payloads take once offloaded, see Offloader.Size, so payloads
+// above limit.blobSize.error are accepted when they are offloaded. Raw history handed to other clusters
+// contains the rehydrated payloads, the receiving cluster offloads them again to its own payload store.
+package payloadoffload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+const (
+	// MetadataEncoding is the encoding set on payloads which reference an offloaded payload
+	MetadataEncoding = "temporal.io/offloaded-payload"
+	// MetadataURI is the metadata key holding the URI of the payload store the payload was offloaded to
+	MetadataURI = "temporal.io/offloaded-payload-uri"
+	// MetadataKey is the metadata key holding the key of the offloaded payload within the payload store
+	MetadataKey = "temporal.io/offloaded-payload-key"
+
+	metadataEncodingKey = "encoding"
+)
+
+var (
+	// referenceKeyLength is the length of the keys of offloaded payloads, a tree ID and a UUID
+	referenceKeyLength = len(constructTreePrefix(uuid.New())) + len(uuid.New())
+
+	payloadsType = reflect.TypeOf((*commonpb.Payloads)(nil))
+
+	errPayloadFound = errors.New("payload above threshold found")
+)
+
+type (
+	// Offloader moves large payloads of workflow history events to a payload store,
+	// leaving a small reference payload behind, and resolves those references on read.
+	// Only payloads contained in Payloads lists are considered, memo, search attributes and
+	// headers are never offloaded.
+	Offloader interface {
+		// Enabled returns true if the offloader has a payload store configured.
+		Enabled() bool
+		// Offload returns events where every payload larger than threshold bytes is replaced by a reference
+		// and every reference written by another history tree is replaced by a copy written by the given tree.
+		// Events are copied before being changed, the given events are never modified.
+		Offload(
+			ctx context.Context,
+			treeID string,
+			threshold int,
+			events []*historypb.HistoryEvent,
+		) ([]*historypb.HistoryEvent, error)
+		// Size returns the size of the message once every payload larger than threshold bytes is replaced by a reference.
+		Size(message proto.Message, threshold int) int
+		// Rehydrate replaces, in place, every reference payload of the message with the offloaded payload.
+		Rehydrate(ctx context.Context, message proto.Message) error
+		// Delete removes all payloads offloaded for the given history tree.
+		Delete(ctx context.Context, treeID string) error
+	}
+
+	offloaderImpl struct {
+		store archiver.PayloadStore
+		uri   archiver.URI
+	}
+)
+
+var _ Offloader = (*offloaderImpl)(nil)
+
+// NewOffloader creates a new Offloader, offloading is disabled if store is nil
+func NewOffloader(
+	store archiver.PayloadStore,
+	uri archiver.URI,
+) Offloader {
+	return &offloaderImpl{
+		store: store,
+		uri:   uri,
+	}
+}
+
+// NewDisabledOffloader creates a new Offloader which never offloads or rehydrates payloads
+func NewDisabledOffloader() Offloader {
+	return NewOffloader(nil, nil)
+}
+
+// IsReference returns true if the payload references an offloaded payload
+func IsReference(payload *commonpb.Payload) bool {
+	return payload != nil && string(payload.GetMetadata()[metadataEncodingKey]) == MetadataEncoding
+}
+
+func (o *offloaderImpl) Enabled() bool {
+	return o.store != nil
+}
+
+func (o *offloaderImpl) Offload(
+	ctx context.Context,
+	treeID string,
+	threshold int,
+	events []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, error) {
+	if !o.Enabled() {
+		return events, nil
+	}
+
+	prefix := constructTreePrefix(treeID)
+	var result []*historypb.HistoryEvent
+	for i, event := range events {
+		if !needsOffload(event, threshold, prefix) {
+			if result != nil {
+				result = append(result, event)
+			}
+			continue
+		}
+
+		if result == nil {
+			result = make([]*historypb.HistoryEvent, i, len(events))
+			copy(result, events[:i])
+		}
+		event = proto.Clone(event).(*historypb.HistoryEvent)
+		if err := visitPayloads(reflect.ValueOf(event), func(payloads *commonpb.Payloads) error {
+			for j, payload := range payloads.Payloads {
+				var reference *commonpb.Payload
+				var err error
+				switch {
+				case isAbove(payload, threshold):
+					reference, err = o.offloadPayload(ctx, prefix, payload)
+				case isForeignReference(payload, prefix):
+					reference, err = o.copyReference(ctx, prefix, payload)
+				default:
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				payloads.Payloads[j] = reference
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+
+	if result == nil {
+		return events, nil
+	}
+	return result, nil
+}
+
+func (o *offloaderImpl) Size(
+	message proto.Message,
+	threshold int,
+) int {
+	size := proto.Size(message)
+	if !o.Enabled() || threshold <= 0 {
+		return size
+	}
+
+	referenceSize := o.newReference(strings.Repeat("0", referenceKeyLength)).Size()
+	_ = visitPayloads(reflect.ValueOf(message), func(payloads *commonpb.Payloads) error {
+		for _, payload := range payloads.Payloads {
+			if isAbove(payload, threshold) {
+				size -= payload.Size() - referenceSize
+			}
+		}
+		return nil
+	})
+	return size
+}
+
+func (o *offloaderImpl) Rehydrate(
+	ctx context.Context,
+	message proto.Message,
+) error {
+	if !o.Enabled() || message == nil {
+		return nil
+	}
+
+	return visitPayloads(reflect.ValueOf(message), func(payloads *commonpb.Payloads) error {
+		for i, payload := range payloads.Payloads {
+			if !IsReference(payload) {
+				continue
+			}
+			original, err := o.rehydratePayload(ctx, payload)
+			if err != nil {
+				return err
+			}
+			payloads.Payloads[i] = original
+		}
+		return nil
+	})
+}
+
+func (o *offloaderImpl) Delete(
+	ctx context.Context,
+	treeID string,
+) error {
+	if !o.Enabled() {
+		return nil
+	}
+
+	return o.store.DeletePrefix(ctx, o.uri, constructTreePrefix(treeID))
+}
+
+func (o *offloaderImpl) offloadPayload(
+	ctx context.Context,
+	prefix string,
+	payload *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	data, err := payload.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return o.putPayload(ctx, prefix, data)
+}
+
+func (o *offloaderImpl) copyReference(
+	ctx context.Context,
+	prefix string,
+	reference *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	data, err := o.getPayload(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	return o.putPayload(ctx, prefix, data)
+}
+
+func (o *offloaderImpl) putPayload(
+	ctx context.Context,
+	prefix string,
+	data []byte,
+) (*commonpb.Payload, error) {
+	key := prefix + uuid.New()
+	if err := o.store.Put(ctx, o.uri, key, data); err != nil {
+		return nil, err
+	}
+	return o.newReference(key), nil
+}
+
+func (o *offloaderImpl) newReference(
+	key string,
+) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncodingKey: []byte(MetadataEncoding),
+			MetadataURI:         []byte(o.uri.String()),
+			MetadataKey:         []byte(key),
+		},
+	}
+}
+
+func (o *offloaderImpl) rehydratePayload(
+	ctx context.Context,
+	reference *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	data, err := o.getPayload(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	payload := &commonpb.Payload{}
+	if err := payload.Unmarshal(data); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to decode offloaded payload: %v", err))
+	}
+	return payload, nil
+}
+
+func (o *offloaderImpl) getPayload(
+	ctx context.Context,
+	reference *commonpb.Payload,
+) ([]byte, error) {
+	uri, err := archiver.NewURI(string(reference.Metadata[MetadataURI]))
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("invalid offloaded payload URI: %v", err))
+	}
+	if uri.Scheme() != o.uri.Scheme() {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("offloaded payload URI scheme %v doesn't match payload store scheme %v", uri.Scheme(), o.uri.Scheme()))
+	}
+
+	return o.store.Get(ctx, uri, string(reference.Metadata[MetadataKey]))
+}
+
+func needsOffload(event *historypb.HistoryEvent, threshold int, prefix string) bool {
+	return visitPayloads(reflect.ValueOf(event), func(payloads *commonpb.Payloads) error {
+		for _, payload := range payloads.Payloads {
+			if isAbove(payload, threshold) || isForeignReference(payload, prefix) {
+				return errPayloadFound
+			}
+		}
+		return nil
+	}) == errPayloadFound
+}
+
+func hasReference(events []*historypb.HistoryEvent) bool {
+	for _, event := range events {
+		if visitPayloads(reflect.ValueOf(event), func(payloads *commonpb.Payloads) error {
+			for _, payload := range payloads.Payloads {
+				if IsReference(payload) {
+					return errPayloadFound
+				}
+			}
+			return nil
+		}) == errPayloadFound {
+			return true
+		}
+	}
+	return false
+}
+
+func isAbove(payload *commonpb.Payload, threshold int) bool {
+	return threshold > 0 && payload.Size() > threshold && !IsReference(payload)
+}
+
+func isForeignReference(payload *commonpb.Payload, prefix string) bool {
+	return IsReference(payload) && !strings.HasPrefix(string(payload.Metadata[MetadataKey]), prefix)
+}
+
+// visitPayloads walks the exported fields of a generated proto message and calls fn for every Payloads.
+// Maps are not traversed, which keeps memo, search attributes and headers untouched.
+func visitPayloads(value reflect.Value, fn func(payloads *commonpb.Payloads) error) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type() == payloadsType {
+			return fn(value.Interface().(*commonpb.Payloads))
+		}
+		return visitPayloads(value.Elem(), fn)
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return visitPayloads(value.Elem(), fn)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := visitPayloads(value.Field(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := visitPayloads(value.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func constructTreePrefix(treeID string) string {
+	return treeID + "/"
+}
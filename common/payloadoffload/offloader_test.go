@@ -0,0 +1,191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadoffload
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/payloads"
+)
+
+const (
+	testTreeID    = "test-tree-id"
+	testThreshold = 64
+)
+
+type (
+	offloaderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		storeDir   string
+		offloader  Offloader
+		smallInput *commonpb.Payloads
+		largeInput *commonpb.Payloads
+	}
+)
+
+func TestOffloaderSuite(t *testing.T) {
+	suite.Run(t, new(offloaderSuite))
+}
+
+func (s *offloaderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.storeDir, err = ioutil.TempDir("", "TestOffloaderSuite")
+	s.NoError(err)
+
+	store, err := filestore.NewPayloadStore(&config.FilestoreArchiver{
+		FileMode: "0666",
+		DirMode:  "0766",
+	})
+	s.NoError(err)
+	uri, err := archiver.NewURI("file://" + s.storeDir)
+	s.NoError(err)
+	s.offloader = NewOffloader(store, uri)
+
+	s.smallInput = payloads.EncodeString("small")
+	s.largeInput = payloads.EncodeString(strings.Repeat("large", testThreshold))
+}
+
+func (s *offloaderSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.storeDir))
+}
+
+func (s *offloaderSuite) TestOffload_BelowThreshold() {
+	events := []*historypb.HistoryEvent{newStartedEvent(s.smallInput)}
+
+	result, err := s.offloader.Offload(context.Background(), testTreeID, testThreshold, events)
+	s.NoError(err)
+	s.Equal(events, result)
+	s.Same(events[0], result[0])
+}
+
+func (s *offloaderSuite) TestOffload_Disabled() {
+	events := []*historypb.HistoryEvent{newStartedEvent(s.largeInput)}
+
+	result, err := NewDisabledOffloader().Offload(context.Background(), testTreeID, testThreshold, events)
+	s.NoError(err)
+	s.Same(events[0], result[0])
+
+	result, err = s.offloader.Offload(context.Background(), testTreeID, 0, events)
+	s.NoError(err)
+	s.Same(events[0], result[0])
+}
+
+func (s *offloaderSuite) TestOffload_Rehydrate() {
+	original := newStartedEvent(s.largeInput)
+	original.GetWorkflowExecutionStartedEventAttributes().Memo = &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{"memo": s.largeInput.Payloads[0]},
+	}
+	small := newStartedEvent(s.smallInput)
+	events := []*historypb.HistoryEvent{small, original}
+
+	result, err := s.offloader.Offload(context.Background(), testTreeID, testThreshold, events)
+	s.NoError(err)
+	s.Len(result, 2)
+	s.Same(small, result[0])
+	s.NotSame(original, result[1])
+	s.Equal(s.largeInput, original.GetWorkflowExecutionStartedEventAttributes().GetInput())
+
+	attributes := result[1].GetWorkflowExecutionStartedEventAttributes()
+	s.True(IsReference(attributes.GetInput().Payloads[0]))
+	s.False(IsReference(attributes.GetMemo().Fields["memo"]))
+
+	history := &historypb.History{Events: result}
+	s.NoError(s.offloader.Rehydrate(context.Background(), history))
+	s.Equal(original, history.Events[1])
+}
+
+func (s *offloaderSuite) TestOffload_CopyForeignReference() {
+	original := newStartedEvent(s.largeInput)
+	foreign, err := s.offloader.Offload(context.Background(), "other-tree-id", testThreshold, []*historypb.HistoryEvent{original})
+	s.NoError(err)
+
+	// references written by the same tree are kept, references written by another tree are copied
+	result, err := s.offloader.Offload(context.Background(), "other-tree-id", testThreshold, foreign)
+	s.NoError(err)
+	s.Same(foreign[0], result[0])
+	result, err = s.offloader.Offload(context.Background(), testTreeID, 0, foreign)
+	s.NoError(err)
+	s.NotSame(foreign[0], result[0])
+	key := string(result[0].GetWorkflowExecutionStartedEventAttributes().GetInput().Payloads[0].Metadata[MetadataKey])
+	s.True(strings.HasPrefix(key, testTreeID+"/"))
+
+	s.NoError(s.offloader.Delete(context.Background(), "other-tree-id"))
+	s.NoError(s.offloader.Rehydrate(context.Background(), result[0]))
+	s.Equal(original, result[0])
+}
+
+func (s *offloaderSuite) TestSize() {
+	small := newStartedEvent(s.smallInput)
+	s.Equal(small.Size(), s.offloader.Size(small, testThreshold))
+	large := newStartedEvent(s.largeInput)
+	s.Equal(large.Size(), s.offloader.Size(large, 0))
+	s.Equal(large.Size(), NewDisabledOffloader().Size(large, testThreshold))
+
+	result, err := s.offloader.Offload(context.Background(), uuid.New(), testThreshold, []*historypb.HistoryEvent{large})
+	s.NoError(err)
+	s.Equal(result[0].Size(), s.offloader.Size(large, testThreshold))
+	s.Less(s.offloader.Size(large, testThreshold), large.Size())
+}
+
+func (s *offloaderSuite) TestDelete() {
+	events := []*historypb.HistoryEvent{newStartedEvent(s.largeInput)}
+	result, err := s.offloader.Offload(context.Background(), testTreeID, testThreshold, events)
+	s.NoError(err)
+
+	s.NoError(s.offloader.Delete(context.Background(), testTreeID))
+	err = s.offloader.Rehydrate(context.Background(), result[0])
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func newStartedEvent(input *commonpb.Payloads) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: input,
+			},
+		},
+	}
+}
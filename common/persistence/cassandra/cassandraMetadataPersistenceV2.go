@@ -53,6 +53,10 @@ const (
 	templateDeleteNamespaceQuery = `DELETE FROM namespaces_by_id ` +
 		`WHERE id = ?`
 
+	templateUpdateNamespaceNameQuery = `UPDATE namespaces_by_id ` +
+		`SET name = ? ` +
+		`WHERE id = ?`
+
 	templateNamespaceColumns = `id, name, detail, detail_encoding, notification_version, is_global_namespace`
 
 	templateCreateNamespaceByNameQueryWithinBatchV2 = `INSERT INTO namespaces ` +
@@ -210,6 +214,59 @@ func (m *cassandraMetadataPersistenceV2) UpdateNamespace(request *p.InternalUpda
 	return nil
 }
 
+// RenameNamespace moves the namespace record to its new name
+// The namespace record is keyed by name, so the rename is done by inserting the record under the new name and deleting
+// the one under the previous name within a single conditional batch, which fails if the new name is already taken.
+// Name stored in namespaces_by_id table is updated afterwards. If that update fails the rename can be retried, the
+// record already moved to the new name is kept, and GetNamespace by ID repairs namespaces_by_id in the meantime.
+func (m *cassandraMetadataPersistenceV2) RenameNamespace(request *p.InternalRenameNamespaceRequest) error {
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateNamespaceByNameQueryWithinBatchV2,
+		constNamespacePartition,
+		request.Id,
+		request.Name,
+		request.Namespace.Data,
+		request.Namespace.EncodingType.String(),
+		request.NotificationVersion,
+		request.IsGlobal,
+	)
+	batch.Query(templateDeleteNamespaceByNameQueryV2,
+		constNamespacePartition,
+		request.PreviousName,
+	)
+	m.updateMetadataBatch(batch, request.NotificationVersion)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := m.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed. Error: %v", err))
+	}
+	if !applied {
+		var ID []byte
+		query := m.session.Query(templateGetNamespaceByNameQueryV2, constNamespacePartition, request.Name)
+		switch err := query.Scan(&ID, nil, nil, nil, nil, nil); {
+		case err == nil && primitives.UUIDString(ID) == request.Id:
+			// the record was moved by a previous attempt which failed to update namespaces_by_id
+		case err == nil:
+			return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("Namespace %v already exists.", request.Name))
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed because of conditional failure."))
+		}
+	}
+
+	if err := m.session.Query(templateUpdateNamespaceNameQuery, request.Name, request.Id).Exec(); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed. Updating namespaces_by_id table. Error: %v", err))
+	}
+
+	return nil
+}
+
 func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceRequest) (*p.InternalGetNamespaceResponse, error) {
 	var query gocql.Query
 	var err error
@@ -244,9 +301,10 @@ func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceReq
 		}
 	}
 
+	var ID []byte
 	query = m.session.Query(templateGetNamespaceByNameQueryV2, constNamespacePartition, namespace)
 	err = query.Scan(
-		nil,
+		&ID,
 		nil,
 		&detail,
 		&detailEncoding,
@@ -254,6 +312,10 @@ func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceReq
 		&isGlobalNamespace,
 	)
 
+	if len(request.ID) > 0 && (gocql.IsNotFoundError(err) || (err == nil && primitives.UUIDString(ID) != request.ID)) {
+		// namespaces_by_id still holds the previous name of a namespace whose rename failed half way
+		return m.repairNamespaceName(request.ID)
+	}
 	if err != nil {
 		return nil, handleError(request.Name, request.ID, err)
 	}
@@ -265,6 +327,44 @@ func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceReq
 	}, nil
 }
 
+// repairNamespaceName looks the namespace up among all the namespace records and updates
+// the name stored in namespaces_by_id table to the name of the record
+func (m *cassandraMetadataPersistenceV2) repairNamespaceName(namespaceID string) (*p.InternalGetNamespaceResponse, error) {
+	iter := m.session.Query(templateListNamespaceQueryV2, constNamespacePartition).Iter()
+	var ID []byte
+	var name string
+	var detail []byte
+	var detailEncoding string
+	var notificationVersion int64
+	var isGlobal bool
+	found := false
+	for !found && iter.Scan(
+		&ID,
+		&name,
+		&detail,
+		&detailEncoding,
+		&notificationVersion,
+		&isGlobal,
+	) {
+		found = name != namespaceMetadataRecordName && primitives.UUIDString(ID) == namespaceID
+	}
+	if err := iter.Close(); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetNamespace operation failed. Error %v", err))
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Namespace %s does not exist.", namespaceID))
+	}
+
+	if err := m.session.Query(templateUpdateNamespaceNameQuery, name, namespaceID).Exec(); err != nil {
+		m.logger.Warn("Unable to repair namespace name in namespaces_by_id table.", tag.WorkflowNamespace(name), tag.Error(err))
+	}
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           p.NewDataBlob(detail, detailEncoding),
+		IsGlobal:            isGlobal,
+		NotificationVersion: notificationVersion,
+	}, nil
+}
+
 func (m *cassandraMetadataPersistenceV2) ListNamespaces(request *p.ListNamespacesRequest) (*p.InternalListNamespacesResponse, error) {
 	query := m.session.Query(templateListNamespaceQueryV2, constNamespacePartition)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
@@ -49,7 +49,7 @@ import (
 // Where x is any hexadecimal value, E represents the entity type valid values are:
 // E = {NamespaceID = 1, WorkflowID = 2, RunID = 3}
 // R represents row type in executions table, valid values are:
-// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5}
+// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5, Visibility = 6, HistoryTaskDLQ = 7}
 const (
 	// Special Namespaces related constants
 	emptyNamespaceID = "10000000-0000-f000-f000-000000000000"
@@ -79,6 +79,10 @@ const (
 	// Row Constants for Replication Task DLQ Row. Source cluster name will be used as WorkflowID.
 	rowTypeDLQNamespaceID = "10000000-6000-f000-f000-000000000000"
 	rowTypeDLQRunID       = "30000000-6000-f000-f000-000000000000"
+	// Row Constants for History Task DLQ Row
+	rowTypeHistoryTaskDLQNamespaceID = "10000000-7000-f000-f000-000000000000"
+	rowTypeHistoryTaskDLQWorkflowID  = "20000000-7000-f000-f000-000000000000"
+	rowTypeHistoryTaskDLQRunID       = "30000000-7000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID = int64(-10)
 	rowTypeShardTaskID     = int64(-11)
@@ -94,6 +98,7 @@ const (
 	rowTypeReplicationTask
 	rowTypeDLQ
 	rowTypeVisibilityTask
+	rowTypeHistoryTaskDLQ
 )
 
 const (
@@ -178,6 +183,10 @@ const (
 		`shard_id, type, namespace_id, workflow_id, run_id, timer, timer_encoding, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
+	templateCreateHistoryTaskDLQQuery = `INSERT INTO executions (` +
+		`shard_id, type, namespace_id, workflow_id, run_id, history_task_dlq, history_task_dlq_encoding, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
 	templateUpdateLeaseQuery = `UPDATE executions ` +
 		`SET range_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -216,6 +225,9 @@ const (
 		`WHERE shard_id = ? ` +
 		`and type = ?`
 
+	templateListNamespaceWorkflowExecutionQuery = templateListWorkflowExecutionQuery + ` ` +
+		`and namespace_id = ?`
+
 	// TODO deprecate templateUpdateWorkflowExecutionQueryDeprecated in favor of templateUpdateWorkflowExecutionQuery
 	// Deprecated.
 	templateUpdateWorkflowExecutionQueryDeprecated = `UPDATE executions ` +
@@ -578,6 +590,19 @@ const (
 
 	templateRangeCompleteReplicationTaskQuery = templateRangeCompleteTransferTaskQuery
 
+	templateGetHistoryTasksFromDLQQuery = `SELECT history_task_dlq, history_task_dlq_encoding ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and namespace_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
+	templateRangeDeleteHistoryTaskFromDLQQuery = templateRangeCompleteTransferTaskQuery
+
 	templateGetTimerTaskQuery = `SELECT timer, timer_encoding ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -658,6 +683,16 @@ const (
 		`and type = ? ` +
 		`and task_id = ?`
 
+	// task queues are rows of the tasks table, listing them scans the whole table
+	templateListTaskQueueQuery = `SELECT ` +
+		`range_id, ` +
+		`task_queue, ` +
+		`task_queue_encoding ` +
+		`FROM tasks ` +
+		`WHERE type = ? ` +
+		`and task_id = ? ` +
+		`ALLOW FILTERING`
+
 	templateInsertTaskQueueQuery = `INSERT INTO tasks (` +
 		`namespace_id, ` +
 		`task_queue_name, ` +
@@ -1475,6 +1510,14 @@ func (d *cassandraPersistence) ListConcreteExecutions(
 		request.ShardID,
 		rowTypeExecution,
 	)
+	if request.NamespaceID != "" {
+		query = d.session.Query(
+			templateListNamespaceWorkflowExecutionQuery,
+			request.ShardID,
+			rowTypeExecution,
+			request.NamespaceID,
+		)
+	}
 	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
 
 	response := &p.InternalListConcreteExecutionsResponse{}
@@ -2063,9 +2106,36 @@ func (d *cassandraPersistence) UpdateTaskQueue(
 }
 
 func (d *cassandraPersistence) ListTaskQueue(
-	_ *p.ListTaskQueueRequest,
+	request *p.ListTaskQueueRequest,
 ) (*p.InternalListTaskQueueResponse, error) {
-	return nil, serviceerror.NewInternal(fmt.Sprintf("unsupported operation"))
+	query := d.session.Query(templateListTaskQueueQuery,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+	)
+	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
+
+	response := &p.InternalListTaskQueueResponse{}
+	for {
+		var rangeID int64
+		var tlBytes []byte
+		var tlEncoding string
+		if !iter.Scan(&rangeID, &tlBytes, &tlEncoding) {
+			break
+		}
+		response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+			TaskQueue: p.NewDataBlob(tlBytes, tlEncoding),
+			RangeID:   rangeID,
+		})
+	}
+	if nextPageToken := iter.PageState(); len(nextPageToken) > 0 {
+		response.NextPageToken = make([]byte, len(nextPageToken))
+		copy(response.NextPageToken, nextPageToken)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListTaskQueue", err)
+	}
+	return response, nil
 }
 
 func (d *cassandraPersistence) DeleteTaskQueue(
@@ -2421,6 +2491,91 @@ func (d *cassandraPersistence) RangeDeleteReplicationTaskFromDLQ(
 	return gocql.ConvertError("RangeDeleteReplicationTaskFromDLQ", err)
 }
 
+func (d *cassandraPersistence) PutHistoryTaskToDLQ(
+	request *p.PutHistoryTaskToDLQRequest,
+) error {
+	task := request.TaskInfo
+	datablob, err := serialization.HistoryDLQTaskInfoToBlob(task)
+	if err != nil {
+		return gocql.ConvertError("PutHistoryTaskToDLQ", err)
+	}
+
+	query := d.session.Query(templateCreateHistoryTaskDLQQuery,
+		request.ShardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQNamespaceID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		datablob.Data,
+		datablob.EncodingType.String(),
+		defaultVisibilityTimestamp,
+		p.GetHistoryDLQTaskID(task))
+
+	err = query.Exec()
+	if err != nil {
+		return gocql.ConvertError("PutHistoryTaskToDLQ", err)
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetHistoryTasksFromDLQ(
+	request *p.GetHistoryTasksFromDLQRequest,
+) (*p.GetHistoryTasksFromDLQResponse, error) {
+	query := d.session.Query(templateGetHistoryTasksFromDLQQuery,
+		request.ShardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQNamespaceID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		defaultVisibilityTimestamp,
+		request.MinTaskID,
+		request.MaxTaskID,
+	)
+	iter := query.PageSize(request.BatchSize).PageState(request.NextPageToken).Iter()
+
+	response := &p.GetHistoryTasksFromDLQResponse{}
+	var data []byte
+	var encoding string
+
+	for iter.Scan(&data, &encoding) {
+		t, err := serialization.HistoryDLQTaskInfoFromBlob(data, encoding)
+		if err != nil {
+			return nil, gocql.ConvertError("GetHistoryTasksFromDLQ", err)
+		}
+
+		response.Tasks = append(response.Tasks, t)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("GetHistoryTasksFromDLQ", err)
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) RangeDeleteHistoryTaskFromDLQ(
+	request *p.RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+
+	query := d.session.Query(templateRangeDeleteHistoryTaskFromDLQQuery,
+		request.ShardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQNamespaceID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	return gocql.ConvertError("RangeDeleteHistoryTaskFromDLQ", err)
+}
+
 func mutableStateFromRow(
 	result map[string]interface{},
 ) (*p.InternalWorkflowMutableState, error) {
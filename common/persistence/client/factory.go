@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resolver"
@@ -57,6 +58,8 @@ type (
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
+		// NewDynamicConfigManager returns a new manager for the persisted dynamic config
+		NewDynamicConfigManager() (p.DynamicConfigManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -94,6 +97,7 @@ type (
 		logger                   log.Logger
 		datastores               map[storeType]Datastore
 		clusterName              string
+		faultInjector            *p.FaultInjector
 	}
 
 	storeType int
@@ -142,6 +146,9 @@ func NewFactory(
 		logger:                   logger,
 		clusterName:              clusterName,
 	}
+	if cfg.FaultInjection != nil {
+		factory.faultInjector = p.NewFaultInjector(cfg.FaultInjection, logger)
+	}
 	limiters := buildRateLimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters, r)
 	return factory
@@ -155,6 +162,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 		return nil, err
 	}
 	result := p.NewTaskManager(taskStore)
+	if f.faultInjector != nil {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -172,6 +182,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.faultInjector != nil {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -192,6 +205,9 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger, f.clusterName)
+	if f.faultInjector != nil {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -229,7 +245,13 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManager(store, f.logger, f.config.TransactionSizeLimit)
+	// config is validated on startup, unknown compressions can't make it here
+	compression, _ := serialization.ParseCompression(f.config.Compression)
+	serializer := serialization.NewCompressingSerializer(compression, f.config.EnableCompression, f.metricsClient)
+	result := p.NewExecutionManager(store, serializer, f.logger, f.config.TransactionSizeLimit)
+	if f.faultInjector != nil {
+		result = p.NewExecutionPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -255,11 +277,30 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	return p.NewNamespaceReplicationQueue(result, f.clusterName, f.metricsClient, f.logger)
 }
 
+func (f *factoryImpl) NewDynamicConfigManager() (p.DynamicConfigManager, error) {
+	ds := f.datastores[storeTypeQueue]
+	result, err := ds.factory.NewQueue(p.DynamicConfigQueueType)
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+
+	return p.NewDynamicConfigManager(result)
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	for _, ds := range f.datastores {
 		ds.factory.Close()
 	}
+	if f.faultInjector != nil {
+		f.faultInjector.Close()
+	}
 }
 
 func (f *factoryImpl) isCassandra() bool {
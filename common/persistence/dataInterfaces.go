@@ -55,6 +55,7 @@ type QueueType int32
 
 const (
 	NamespaceReplicationQueueType QueueType = iota + 1
+	DynamicConfigQueueType
 )
 
 // Create Workflow Execution Mode
@@ -462,9 +463,12 @@ type (
 
 	// ListConcreteExecutionsRequest is request to ListConcreteExecutions
 	ListConcreteExecutionsRequest struct {
-		ShardID   int32
-		PageSize  int
-		PageToken []byte
+		ShardID int32
+		// NamespaceID is optional, if set only the executions of the namespace are listed.
+		// SQL stores only list the executions of one namespace.
+		NamespaceID string
+		PageSize    int
+		PageToken   []byte
 	}
 
 	// ListConcreteExecutionsResponse is response to ListConcreteExecutions
@@ -763,6 +767,34 @@ type (
 	// GetReplicationTasksFromDLQResponse is the response for GetReplicationTasksFromDLQ
 	GetReplicationTasksFromDLQResponse = GetReplicationTasksResponse
 
+	// PutHistoryTaskToDLQRequest is used to put a transfer or timer task to the history task dlq
+	PutHistoryTaskToDLQRequest struct {
+		ShardID  int32
+		TaskInfo *persistencespb.HistoryDLQTaskInfo
+	}
+
+	// GetHistoryTasksFromDLQRequest is used to get transfer and timer tasks from the history task dlq
+	GetHistoryTasksFromDLQRequest struct {
+		ShardID       int32
+		MinTaskID     int64
+		MaxTaskID     int64
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetHistoryTasksFromDLQResponse is the response for GetHistoryTasksFromDLQ
+	GetHistoryTasksFromDLQResponse struct {
+		Tasks         []*persistencespb.HistoryDLQTaskInfo
+		NextPageToken []byte
+	}
+
+	// RangeDeleteHistoryTaskFromDLQRequest is used to delete tasks from the history task dlq
+	RangeDeleteHistoryTaskFromDLQRequest struct {
+		ShardID              int32
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
 		ShardID                 int32
@@ -922,6 +954,14 @@ type (
 		NotificationVersion int64
 	}
 
+	// RenameNamespaceRequest is used to rename namespace, Namespace carries the new name
+	RenameNamespaceRequest struct {
+		PreviousName        string
+		Namespace           *persistencespb.NamespaceDetail
+		IsGlobalNamespace   bool
+		NotificationVersion int64
+	}
+
 	// DeleteNamespaceRequest is used to delete namespace entry from namespaces table
 	DeleteNamespaceRequest struct {
 		ID string
@@ -1288,6 +1328,12 @@ type (
 		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
 		RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error
 
+		// history task dlq
+
+		PutHistoryTaskToDLQ(request *PutHistoryTaskToDLQRequest) error
+		GetHistoryTasksFromDLQ(request *GetHistoryTasksFromDLQRequest) (*GetHistoryTasksFromDLQResponse, error)
+		RangeDeleteHistoryTaskFromDLQ(request *RangeDeleteHistoryTaskFromDLQRequest) error
+
 		// visibility tasks
 
 		GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error)
@@ -1351,6 +1397,7 @@ type (
 		CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error)
 		UpdateNamespace(request *UpdateNamespaceRequest) error
+		RenameNamespace(request *RenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error)
@@ -2194,6 +2241,16 @@ func NewGetReplicationTasksFromDLQRequest(
 	}
 }
 
+// GetHistoryDLQTaskID returns the ID of the transfer or timer task wrapped by the history dlq task
+func GetHistoryDLQTaskID(
+	task *persistencespb.HistoryDLQTaskInfo,
+) int64 {
+	if transferTask := task.GetTransferTaskInfo(); transferTask != nil {
+		return transferTask.GetTaskId()
+	}
+	return task.GetTimerTaskInfo().GetTaskId()
+}
+
 type ServiceType int
 
 const (
@@ -528,6 +528,21 @@ func (mr *MockExecutionManagerMockRecorder) GetCurrentExecution(request interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentExecution", reflect.TypeOf((*MockExecutionManager)(nil).GetCurrentExecution), request)
 }
 
+// GetHistoryTasksFromDLQ mocks base method.
+func (m *MockExecutionManager) GetHistoryTasksFromDLQ(request *GetHistoryTasksFromDLQRequest) (*GetHistoryTasksFromDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTasksFromDLQ", request)
+	ret0, _ := ret[0].(*GetHistoryTasksFromDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTasksFromDLQ indicates an expected call of GetHistoryTasksFromDLQ.
+func (mr *MockExecutionManagerMockRecorder) GetHistoryTasksFromDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTasksFromDLQ", reflect.TypeOf((*MockExecutionManager)(nil).GetHistoryTasksFromDLQ), request)
+}
+
 // GetHistoryTree mocks base method.
 func (m *MockExecutionManager) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	m.ctrl.T.Helper()
@@ -722,6 +737,20 @@ func (mr *MockExecutionManagerMockRecorder) ListConcreteExecutions(request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConcreteExecutions", reflect.TypeOf((*MockExecutionManager)(nil).ListConcreteExecutions), request)
 }
 
+// PutHistoryTaskToDLQ mocks base method.
+func (m *MockExecutionManager) PutHistoryTaskToDLQ(request *PutHistoryTaskToDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutHistoryTaskToDLQ", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutHistoryTaskToDLQ indicates an expected call of PutHistoryTaskToDLQ.
+func (mr *MockExecutionManagerMockRecorder) PutHistoryTaskToDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutHistoryTaskToDLQ", reflect.TypeOf((*MockExecutionManager)(nil).PutHistoryTaskToDLQ), request)
+}
+
 // PutReplicationTaskToDLQ mocks base method.
 func (m *MockExecutionManager) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
 	m.ctrl.T.Helper()
@@ -792,6 +821,20 @@ func (mr *MockExecutionManagerMockRecorder) RangeCompleteVisibilityTask(request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteVisibilityTask", reflect.TypeOf((*MockExecutionManager)(nil).RangeCompleteVisibilityTask), request)
 }
 
+// RangeDeleteHistoryTaskFromDLQ mocks base method.
+func (m *MockExecutionManager) RangeDeleteHistoryTaskFromDLQ(request *RangeDeleteHistoryTaskFromDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteHistoryTaskFromDLQ", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteHistoryTaskFromDLQ indicates an expected call of RangeDeleteHistoryTaskFromDLQ.
+func (mr *MockExecutionManagerMockRecorder) RangeDeleteHistoryTaskFromDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteHistoryTaskFromDLQ", reflect.TypeOf((*MockExecutionManager)(nil).RangeDeleteHistoryTaskFromDLQ), request)
+}
+
 // RangeDeleteReplicationTaskFromDLQ mocks base method.
 func (m *MockExecutionManager) RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error {
 	m.ctrl.T.Helper()
@@ -1199,6 +1242,20 @@ func (mr *MockMetadataManagerMockRecorder) ListNamespaces(request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockMetadataManager)(nil).ListNamespaces), request)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockMetadataManager) RenameNamespace(request *RenameNamespaceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockMetadataManagerMockRecorder) RenameNamespace(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockMetadataManager)(nil).RenameNamespace), request)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockMetadataManager) UpdateNamespace(request *UpdateNamespaceRequest) error {
 	m.ctrl.T.Helper()
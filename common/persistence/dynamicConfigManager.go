@@ -0,0 +1,174 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination dynamicConfigManager_mock.go
+
+package persistence
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// DynamicConfigManager persists the dynamic config change log. The current dynamic config
+	// values are derived by applying the changes after the snapshot in order, the log itself
+	// is the audit history.
+	DynamicConfigManager interface {
+		Closeable
+		// AppendChange appends a change to the end of the change log
+		AppendChange(change *persistencespb.DynamicConfigChange) error
+		// ReadChanges returns up to maxCount changes after lastChangeID, ordered by change ID
+		ReadChanges(lastChangeID int64, maxCount int) ([]*persistencespb.DynamicConfigChange, error)
+		// ReadSnapshot returns the snapshot of the compacted change log, nil if the change log was never compacted
+		ReadSnapshot() (*persistencespb.DynamicConfigSnapshot, error)
+		// UpdateSnapshot replaces the snapshot if it contains more changes than the current one, and trims
+		// the changes contained by the replaced snapshot from the change log
+		UpdateSnapshot(snapshot *persistencespb.DynamicConfigSnapshot) error
+	}
+
+	dynamicConfigManagerImpl struct {
+		queue      Queue
+		serializer serialization.Serializer
+	}
+)
+
+var _ DynamicConfigManager = (*dynamicConfigManagerImpl)(nil)
+
+// NewDynamicConfigManager creates a new DynamicConfigManager backed by the given queue
+func NewDynamicConfigManager(
+	queue Queue,
+) (DynamicConfigManager, error) {
+	serializer := serialization.NewSerializer()
+
+	// the change log has no consumers to track, the metadata is only initialized
+	// for the queue implementations which require it
+	blob, err := serializer.QueueMetadataToBlob(
+		&persistencespb.QueueMetadata{
+			ClusterAckLevels: make(map[string]int64),
+		}, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	if err := queue.Init(blob); err != nil {
+		return nil, err
+	}
+
+	return &dynamicConfigManagerImpl{
+		queue:      queue,
+		serializer: serializer,
+	}, nil
+}
+
+func (m *dynamicConfigManagerImpl) AppendChange(
+	change *persistencespb.DynamicConfigChange,
+) error {
+
+	blob, err := serialization.DynamicConfigChangeToBlob(change)
+	if err != nil {
+		return fmt.Errorf("failed to encode dynamic config change: %v", err)
+	}
+	return m.queue.EnqueueMessage(blob)
+}
+
+func (m *dynamicConfigManagerImpl) ReadChanges(
+	lastChangeID int64,
+	maxCount int,
+) ([]*persistencespb.DynamicConfigChange, error) {
+
+	messages, err := m.queue.ReadMessages(lastChangeID, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*persistencespb.DynamicConfigChange, 0, len(messages))
+	for _, message := range messages {
+		change, err := serialization.DynamicConfigChangeFromBlob(message.Data, message.Encoding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode dynamic config change: %v", err)
+		}
+
+		// change ID is assigned by the queue on enqueue
+		change.ChangeId = message.ID
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (m *dynamicConfigManagerImpl) ReadSnapshot() (*persistencespb.DynamicConfigSnapshot, error) {
+	metadata, err := m.queue.GetAckLevels()
+	if err != nil {
+		return nil, err
+	}
+	queueMetadata, err := m.serializer.QueueMetadataFromBlob(metadata.Blob)
+	if err != nil {
+		return nil, err
+	}
+	return queueMetadata.GetDynamicConfigSnapshot(), nil
+}
+
+func (m *dynamicConfigManagerImpl) UpdateSnapshot(
+	snapshot *persistencespb.DynamicConfigSnapshot,
+) error {
+
+	metadata, err := m.queue.GetAckLevels()
+	if err != nil {
+		return err
+	}
+	queueMetadata, err := m.serializer.QueueMetadataFromBlob(metadata.Blob)
+	if err != nil {
+		return err
+	}
+	previousSnapshot := queueMetadata.GetDynamicConfigSnapshot()
+	if previousSnapshot != nil && previousSnapshot.GetLastChangeId() >= snapshot.GetLastChangeId() {
+		return nil
+	}
+
+	queueMetadata.DynamicConfigSnapshot = snapshot
+	blob, err := m.serializer.QueueMetadataToBlob(queueMetadata, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return err
+	}
+	// conditional on the version read above, concurrent updates of the snapshot fail
+	metadata.Blob = blob
+	if err := m.queue.UpdateAckLevel(metadata); err != nil {
+		return err
+	}
+
+	if previousSnapshot == nil {
+		return nil
+	}
+	// Only the changes of the replaced snapshot are trimmed, readers which loaded it still find
+	// all changes after it. This also keeps the last change in the queue, which assigns the ID
+	// of the next change from the last one left.
+	return m.queue.DeleteMessagesBefore(previousSnapshot.GetLastChangeId() + 1)
+}
+
+func (m *dynamicConfigManagerImpl) Close() {
+	m.queue.Close()
+}
@@ -0,0 +1,195 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	dynamicConfigManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		queue   *dynamicConfigQueue
+		manager DynamicConfigManager
+	}
+
+	// dynamicConfigQueue keeps the messages and the metadata of a single queue in memory
+	dynamicConfigQueue struct {
+		Queue
+
+		messages []*QueueMessage
+		metadata *InternalQueueMetadata
+		// concurrentUpdate updates the metadata after every read of it if set
+		concurrentUpdate bool
+	}
+)
+
+func TestDynamicConfigManagerSuite(t *testing.T) {
+	s := new(dynamicConfigManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *dynamicConfigManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.queue = &dynamicConfigQueue{}
+
+	var err error
+	s.manager, err = NewDynamicConfigManager(s.queue)
+	s.NoError(err)
+}
+
+func (s *dynamicConfigManagerSuite) TestReadSnapshot_NeverCompacted() {
+	snapshot, err := s.manager.ReadSnapshot()
+	s.NoError(err)
+	s.Nil(snapshot)
+}
+
+func (s *dynamicConfigManagerSuite) TestUpdateSnapshot() {
+	s.appendChanges(3)
+	first := &persistencespb.DynamicConfigSnapshot{
+		LastChangeId: 1,
+		Values:       []*persistencespb.DynamicConfigValue{{Key: "frontend.rps", Value: "100"}},
+	}
+	s.NoError(s.manager.UpdateSnapshot(first))
+
+	snapshot, err := s.manager.ReadSnapshot()
+	s.NoError(err)
+	s.Equal(first, snapshot)
+	// nothing is trimmed until the first snapshot is replaced
+	s.Equal([]int64{0, 1, 2}, s.changeIDs())
+
+	// a snapshot which doesn't contain more changes is ignored
+	s.NoError(s.manager.UpdateSnapshot(&persistencespb.DynamicConfigSnapshot{LastChangeId: 1}))
+	snapshot, err = s.manager.ReadSnapshot()
+	s.NoError(err)
+	s.Equal(first, snapshot)
+
+	s.appendChanges(2)
+	second := &persistencespb.DynamicConfigSnapshot{
+		LastChangeId: 4,
+		Values:       []*persistencespb.DynamicConfigValue{{Key: "frontend.rps", Value: "200"}},
+	}
+	s.NoError(s.manager.UpdateSnapshot(second))
+
+	snapshot, err = s.manager.ReadSnapshot()
+	s.NoError(err)
+	s.Equal(second, snapshot)
+	// the changes of the replaced snapshot are trimmed
+	s.Equal([]int64{2, 3, 4}, s.changeIDs())
+}
+
+func (s *dynamicConfigManagerSuite) TestUpdateSnapshot_ConcurrentUpdate() {
+	s.appendChanges(1)
+	s.queue.concurrentUpdate = true
+
+	err := s.manager.UpdateSnapshot(&persistencespb.DynamicConfigSnapshot{LastChangeId: 0})
+	s.IsType(&ConditionFailedError{}, err)
+	snapshot, err := s.manager.ReadSnapshot()
+	s.NoError(err)
+	s.Nil(snapshot)
+}
+
+func (s *dynamicConfigManagerSuite) appendChanges(count int) {
+	for i := 0; i < count; i++ {
+		s.NoError(s.manager.AppendChange(&persistencespb.DynamicConfigChange{
+			Value: &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: "100"},
+		}))
+	}
+}
+
+func (s *dynamicConfigManagerSuite) changeIDs() []int64 {
+	changes, err := s.manager.ReadChanges(EmptyQueueMessageID, 100)
+	s.NoError(err)
+	var result []int64
+	for _, change := range changes {
+		result = append(result, change.GetChangeId())
+	}
+	return result
+}
+
+func (q *dynamicConfigQueue) Init(blob *commonpb.DataBlob) error {
+	if q.metadata == nil {
+		q.metadata = &InternalQueueMetadata{Blob: blob}
+	}
+	return nil
+}
+
+func (q *dynamicConfigQueue) EnqueueMessage(blob commonpb.DataBlob) error {
+	messageID := int64(EmptyQueueMessageID + 1)
+	if len(q.messages) > 0 {
+		messageID = q.messages[len(q.messages)-1].ID + 1
+	}
+	q.messages = append(q.messages, &QueueMessage{
+		ID:       messageID,
+		Data:     blob.Data,
+		Encoding: blob.EncodingType.String(),
+	})
+	return nil
+}
+
+func (q *dynamicConfigQueue) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	var result []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *dynamicConfigQueue) DeleteMessagesBefore(messageID int64) error {
+	var result []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			result = append(result, message)
+		}
+	}
+	q.messages = result
+	return nil
+}
+
+func (q *dynamicConfigQueue) UpdateAckLevel(metadata *InternalQueueMetadata) error {
+	if metadata.Version != q.metadata.Version {
+		return &ConditionFailedError{Msg: "UpdateAckLevel operation encounter concurrent write."}
+	}
+	q.metadata = &InternalQueueMetadata{Blob: metadata.Blob, Version: metadata.Version + 1}
+	return nil
+}
+
+func (q *dynamicConfigQueue) GetAckLevels() (*InternalQueueMetadata, error) {
+	metadata := &InternalQueueMetadata{Blob: q.metadata.Blob, Version: q.metadata.Version}
+	if q.concurrentUpdate {
+		q.metadata = &InternalQueueMetadata{Blob: q.metadata.Blob, Version: q.metadata.Version + 1}
+	}
+	return metadata, nil
+}
@@ -57,12 +57,13 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 // NewExecutionManager returns new ExecutionManager
 func NewExecutionManager(
 	persistence ExecutionStore,
+	serializer serialization.Serializer,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:            serialization.NewSerializer(),
+		serializer:            serializer,
 		persistence:           persistence,
 		logger:                logger,
 		statsComputer:         statsComputer{},
@@ -591,6 +592,24 @@ func (m *executionManagerImpl) RangeDeleteReplicationTaskFromDLQ(
 	return m.persistence.RangeDeleteReplicationTaskFromDLQ(request)
 }
 
+func (m *executionManagerImpl) PutHistoryTaskToDLQ(
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	return m.persistence.PutHistoryTaskToDLQ(request)
+}
+
+func (m *executionManagerImpl) GetHistoryTasksFromDLQ(
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	return m.persistence.GetHistoryTasksFromDLQ(request)
+}
+
+func (m *executionManagerImpl) RangeDeleteHistoryTaskFromDLQ(
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	return m.persistence.RangeDeleteHistoryTaskFromDLQ(request)
+}
+
 // Timer related methods.
 func (m *executionManagerImpl) GetTimerTask(
 	request *GetTimerTaskRequest,
@@ -0,0 +1,209 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// FaultInjectionAllOperations configures the fault injected into every operation
+	// which has no fault configured of its own
+	FaultInjectionAllOperations = "*"
+
+	// FaultTypeTimeout injects a TimeoutError
+	FaultTypeTimeout = "Timeout"
+	// FaultTypeConditionFailed injects a ConditionFailedError
+	FaultTypeConditionFailed = "ConditionFailed"
+	// FaultTypeShardOwnershipLost injects a ShardOwnershipLostError
+	FaultTypeShardOwnershipLost = "ShardOwnershipLost"
+	// FaultTypeResourceExhausted injects a ResourceExhausted service error
+	FaultTypeResourceExhausted = "ResourceExhausted"
+	// FaultTypeUnavailable injects an Unavailable service error
+	FaultTypeUnavailable = "Unavailable"
+
+	faultConfigErrorType = "errorType"
+	faultConfigRate      = "rate"
+	faultConfigLatency   = "latency"
+
+	injectedFaultMessage = "injected fault"
+)
+
+type (
+	// FaultInjector injects errors and latency into persistence operations. The faults are
+	// configured per operation through dynamic config, for example
+	//
+	//   system.persistenceFaultInjection:
+	//     - value:
+	//         UpdateWorkflowExecution:
+	//           errorType: ShardOwnershipLost
+	//           rate: 0.01
+	//         "*":
+	//           latency: 50ms
+	//
+	// injects a ShardOwnershipLostError into 1% of the UpdateWorkflowExecution calls
+	// and delays every other operation by 50ms
+	FaultInjector struct {
+		logger log.Logger
+
+		faults atomic.Value // map[string]fault
+		cancel func()
+	}
+
+	fault struct {
+		errorType string
+		rate      float64
+		latency   time.Duration
+	}
+)
+
+// NewFaultInjector creates a FaultInjector which follows the changes of the faults in dynamic config
+func NewFaultInjector(
+	faults dynamicconfig.MapPropertySubscriptionFn,
+	logger log.Logger,
+) *FaultInjector {
+	injector := &FaultInjector{
+		logger: logger,
+	}
+	injector.faults.Store(map[string]fault{})
+	injector.cancel = faults(injector.update)
+	return injector
+}
+
+// Inject sleeps for the latency configured for the operation, then returns the error
+// to be injected into the operation, if any
+func (f *FaultInjector) Inject(operation string) error {
+	faults := f.faults.Load().(map[string]fault)
+	if len(faults) == 0 {
+		return nil
+	}
+
+	operationFault, ok := faults[operation]
+	if !ok {
+		if operationFault, ok = faults[FaultInjectionAllOperations]; !ok {
+			return nil
+		}
+	}
+
+	if operationFault.latency > 0 {
+		time.Sleep(operationFault.latency)
+	}
+	if operationFault.errorType == "" || rand.Float64() >= operationFault.rate {
+		return nil
+	}
+	return newInjectedError(operationFault.errorType)
+}
+
+// Close stops following the changes of the faults in dynamic config
+func (f *FaultInjector) Close() {
+	f.cancel()
+}
+
+func (f *FaultInjector) update(config map[string]interface{}) {
+	faults := make(map[string]fault, len(config))
+	for operation, value := range config {
+		operationFault, err := parseFault(value)
+		if err != nil {
+			f.logger.Error("Invalid persistence fault injection config, the operation is skipped.",
+				tag.Operation(operation), tag.Error(err))
+			continue
+		}
+		faults[operation] = operationFault
+	}
+	f.faults.Store(faults)
+	if len(faults) > 0 {
+		f.logger.Warn("Persistence fault injection is enabled.", tag.Value(config))
+	}
+}
+
+func parseFault(value interface{}) (fault, error) {
+	config, ok := value.(map[string]interface{})
+	if !ok {
+		return fault{}, fmt.Errorf("fault is not a map: %v", value)
+	}
+
+	var result fault
+	if errorType, ok := config[faultConfigErrorType]; ok {
+		result.errorType, ok = errorType.(string)
+		if !ok {
+			return fault{}, fmt.Errorf("%s is not a string: %v", faultConfigErrorType, errorType)
+		}
+		if newInjectedError(result.errorType) == nil {
+			return fault{}, fmt.Errorf("unknown %s: %v", faultConfigErrorType, result.errorType)
+		}
+		// the error is always injected unless a rate is configured
+		result.rate = 1
+	}
+
+	if rate, ok := config[faultConfigRate]; ok {
+		switch rate := rate.(type) {
+		case float64:
+			result.rate = rate
+		case int:
+			result.rate = float64(rate)
+		default:
+			return fault{}, fmt.Errorf("%s is not a number: %v", faultConfigRate, rate)
+		}
+	}
+
+	if latency, ok := config[faultConfigLatency]; ok {
+		latencyString, ok := latency.(string)
+		if !ok {
+			return fault{}, fmt.Errorf("%s is not a duration: %v", faultConfigLatency, latency)
+		}
+		var err error
+		if result.latency, err = time.ParseDuration(latencyString); err != nil {
+			return fault{}, fmt.Errorf("%s is not a duration: %w", faultConfigLatency, err)
+		}
+	}
+
+	return result, nil
+}
+
+func newInjectedError(errorType string) error {
+	switch errorType {
+	case FaultTypeTimeout:
+		return &TimeoutError{Msg: injectedFaultMessage}
+	case FaultTypeConditionFailed:
+		return &ConditionFailedError{Msg: injectedFaultMessage}
+	case FaultTypeShardOwnershipLost:
+		return &ShardOwnershipLostError{Msg: injectedFaultMessage}
+	case FaultTypeResourceExhausted:
+		return serviceerror.NewResourceExhausted(injectedFaultMessage)
+	case FaultTypeUnavailable:
+		return serviceerror.NewUnavailable(injectedFaultMessage)
+	default:
+		return nil
+	}
+}
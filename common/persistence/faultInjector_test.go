@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	faultInjectorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		callback func(map[string]interface{})
+		injector *FaultInjector
+	}
+)
+
+func TestFaultInjectorSuite(t *testing.T) {
+	s := new(faultInjectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var faults dynamicconfig.MapPropertySubscriptionFn = func(callback func(map[string]interface{}), _ ...dynamicconfig.FilterOption) func() {
+		s.callback = callback
+		callback(nil)
+		return func() {}
+	}
+	s.injector = NewFaultInjector(faults, log.NewNoopLogger())
+}
+
+func (s *faultInjectorSuite) TearDownTest() {
+	s.injector.Close()
+}
+
+func (s *faultInjectorSuite) TestNoFault() {
+	s.NoError(s.injector.Inject("UpdateWorkflowExecution"))
+}
+
+func (s *faultInjectorSuite) TestOperationFault() {
+	s.callback(map[string]interface{}{
+		"UpdateWorkflowExecution": map[string]interface{}{
+			"errorType": FaultTypeShardOwnershipLost,
+		},
+		"GetTasks": map[string]interface{}{
+			"errorType": FaultTypeResourceExhausted,
+			"rate":      1,
+		},
+	})
+
+	err := s.injector.Inject("UpdateWorkflowExecution")
+	s.IsType(&ShardOwnershipLostError{}, err)
+	err = s.injector.Inject("GetTasks")
+	s.IsType(&serviceerror.ResourceExhausted{}, err)
+	s.NoError(s.injector.Inject("GetWorkflowExecution"))
+}
+
+func (s *faultInjectorSuite) TestAllOperationsFault() {
+	s.callback(map[string]interface{}{
+		FaultInjectionAllOperations: map[string]interface{}{
+			"errorType": FaultTypeTimeout,
+		},
+		"UpdateWorkflowExecution": map[string]interface{}{
+			"errorType": FaultTypeConditionFailed,
+		},
+	})
+
+	s.IsType(&TimeoutError{}, s.injector.Inject("GetWorkflowExecution"))
+	s.IsType(&ConditionFailedError{}, s.injector.Inject("UpdateWorkflowExecution"))
+}
+
+func (s *faultInjectorSuite) TestRate() {
+	s.callback(map[string]interface{}{
+		"UpdateWorkflowExecution": map[string]interface{}{
+			"errorType": FaultTypeUnavailable,
+			"rate":      0.0,
+		},
+	})
+
+	for i := 0; i < 100; i++ {
+		s.NoError(s.injector.Inject("UpdateWorkflowExecution"))
+	}
+}
+
+func (s *faultInjectorSuite) TestLatency() {
+	s.callback(map[string]interface{}{
+		"UpdateWorkflowExecution": map[string]interface{}{
+			"latency": "20ms",
+		},
+	})
+
+	startTime := time.Now()
+	s.NoError(s.injector.Inject("UpdateWorkflowExecution"))
+	s.True(time.Since(startTime) >= 20*time.Millisecond)
+}
+
+func (s *faultInjectorSuite) TestInvalidFault() {
+	s.callback(map[string]interface{}{
+		"UpdateWorkflowExecution": map[string]interface{}{
+			"errorType": "Unknown",
+		},
+		"GetWorkflowExecution": map[string]interface{}{
+			"latency": 10,
+		},
+	})
+
+	s.NoError(s.injector.Inject("UpdateWorkflowExecution"))
+	s.NoError(s.injector.Inject("GetWorkflowExecution"))
+}
@@ -171,6 +171,8 @@ func (m *executionManagerImpl) DeleteHistoryBranch(
 			// skip the target branch
 			continue
 		}
+		// a branch which is still part of the tree uses all of its own nodes, new branches may be forked from them
+		usedBranches[br.BranchId] = common.LastEventID
 		for _, ancestor := range br.Ancestors {
 			if curr, ok := usedBranches[ancestor.GetBranchId()]; !ok || curr < ancestor.GetEndNodeId() {
 				usedBranches[ancestor.GetBranchId()] = ancestor.GetEndNodeId()
@@ -375,7 +377,12 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 	if err != nil {
 		return nil, err
 	}
-	size := len(blob.Data)
+	// the size is the uncompressed size of the events, so that the history size limits and the
+	// transaction size limit do not depend on the compression of the blobs
+	size, err := serialization.DecompressedBlobSize(blob.Data)
+	if err != nil {
+		return nil, err
+	}
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
 		return nil, &TransactionSizeLimitError{
@@ -458,6 +465,19 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 		return nil, err
 	}
 
+	// raw history is returned to the SDK clients and handed over to other clusters, neither of them
+	// understands the compressed blobs
+	for index, blob := range dataBlobs {
+		data, err := serialization.DecompressBlob(blob.Data)
+		if err != nil {
+			return nil, serialization.NewDeserializationError(err.Error())
+		}
+		dataBlobs[index] = &commonpb.DataBlob{
+			Data:         data,
+			EncodingType: blob.EncodingType,
+		}
+	}
+
 	nextPageToken, err := m.serializeToken(token)
 	if err != nil {
 		return nil, err
@@ -625,7 +645,11 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
 			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			size, err := serialization.DecompressedBlobSize(node.Events.Data)
+			if err != nil {
+				return nil, nil, 0, serialization.NewDeserializationError(err.Error())
+			}
+			dataSize += size
 		}
 		lastNode := nodes[len(nodes)-1]
 		token.LastNodeID = lastNode.NodeID
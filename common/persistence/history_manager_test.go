@@ -0,0 +1,284 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	historyManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store      *historyTreeStore
+		serializer serialization.Serializer
+		manager    ExecutionManager
+	}
+
+	// historyTreeStore keeps history tree of a single tree in memory and records deleted ranges
+	historyTreeStore struct {
+		ExecutionStore
+
+		branches      []*persistencespb.HistoryBranch
+		deleteRequest *InternalDeleteHistoryBranchRequest
+		appendRequest *InternalAppendHistoryNodesRequest
+		nodes         []InternalHistoryNode
+	}
+)
+
+func TestHistoryManagerSuite(t *testing.T) {
+	s := new(historyManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.store = &historyTreeStore{}
+	s.serializer = serialization.NewSerializer()
+	s.manager = NewExecutionManager(s.store, s.serializer, log.NewNoopLogger(), nil)
+}
+
+func (s *historyManagerSuite) TestDeleteHistoryBranch_KeepsNodesOfLiveAncestorBranches() {
+	treeID := uuid.New()
+	// root branch with nodes [1, ...)
+	root := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: uuid.New(),
+	}
+	// forked from root at node 5
+	forked := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: uuid.New(),
+		Ancestors: []*persistencespb.HistoryBranchRange{
+			{BranchId: root.BranchId, BeginNodeId: common.FirstEventID, EndNodeId: 5},
+		},
+	}
+	// forked from forked branch at node 8
+	target := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: uuid.New(),
+		Ancestors: []*persistencespb.HistoryBranchRange{
+			{BranchId: root.BranchId, BeginNodeId: common.FirstEventID, EndNodeId: 5},
+			{BranchId: forked.BranchId, BeginNodeId: 5, EndNodeId: 8},
+		},
+	}
+	s.store.branches = []*persistencespb.HistoryBranch{root, forked, target}
+
+	err := s.manager.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+		ShardID:     1,
+		BranchToken: s.branchToken(target),
+	})
+	s.NoError(err)
+
+	// only the nodes owned by the target branch are deleted, forked branch is still alive
+	// and none of its nodes can be deleted
+	s.Equal(target.BranchId, s.store.deleteRequest.BranchId)
+	s.Equal([]InternalDeleteHistoryBranchRange{
+		{BranchId: target.BranchId, BeginNodeId: 8},
+		{BranchId: forked.BranchId, BeginNodeId: common.LastEventID},
+	}, s.store.deleteRequest.BranchRanges)
+}
+
+func (s *historyManagerSuite) TestDeleteHistoryBranch_DeletesUnreferencedNodesOfRootBranch() {
+	treeID := uuid.New()
+	root := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: uuid.New(),
+	}
+	forked := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: uuid.New(),
+		Ancestors: []*persistencespb.HistoryBranchRange{
+			{BranchId: root.BranchId, BeginNodeId: common.FirstEventID, EndNodeId: 5},
+		},
+	}
+	s.store.branches = []*persistencespb.HistoryBranch{root, forked}
+
+	err := s.manager.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+		ShardID:     1,
+		BranchToken: s.branchToken(root),
+	})
+	s.NoError(err)
+
+	s.Equal([]InternalDeleteHistoryBranchRange{
+		{BranchId: root.BranchId, BeginNodeId: 5},
+	}, s.store.deleteRequest.BranchRanges)
+}
+
+func (s *historyManagerSuite) TestAppendHistoryNodes_SizeIsUncompressed() {
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   common.FirstEventID,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					Input: payloads.EncodeString(strings.Repeat("input", 100)),
+				},
+			},
+		},
+	}
+	uncompressedBlob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	uncompressedSize := len(uncompressedBlob.Data)
+
+	// the transaction size limit applies to the uncompressed size as well
+	manager := NewExecutionManager(
+		s.store,
+		serialization.NewCompressingSerializer(serialization.CompressionZstd, dynamicconfig.GetBoolPropertyFn(true), nil),
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(uncompressedSize),
+	)
+	branch := &persistencespb.HistoryBranch{
+		TreeId:   uuid.New(),
+		BranchId: uuid.New(),
+	}
+	resp, err := manager.AppendHistoryNodes(&AppendHistoryNodesRequest{
+		ShardID:       1,
+		IsNewBranch:   true,
+		BranchToken:   s.branchToken(branch),
+		Events:        events,
+		TransactionID: 1,
+	})
+	s.NoError(err)
+	s.Equal(uncompressedSize, resp.Size)
+
+	storedData := s.store.appendRequest.Node.Events.Data
+	s.True(serialization.IsCompressedBlob(storedData))
+	s.Less(len(storedData), uncompressedSize)
+
+	manager = NewExecutionManager(
+		s.store,
+		serialization.NewCompressingSerializer(serialization.CompressionZstd, dynamicconfig.GetBoolPropertyFn(true), nil),
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(uncompressedSize-1),
+	)
+	_, err = manager.AppendHistoryNodes(&AppendHistoryNodesRequest{
+		ShardID:       1,
+		IsNewBranch:   true,
+		BranchToken:   s.branchToken(branch),
+		Events:        events,
+		TransactionID: 1,
+	})
+	s.IsType(&TransactionSizeLimitError{}, err)
+}
+
+func (s *historyManagerSuite) TestReadRawHistoryBranch_Decompressed() {
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   common.FirstEventID,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		},
+	}
+	uncompressedBlob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	compressedData, err := serialization.CompressBlob(uncompressedBlob.Data, serialization.CompressionZstd)
+	s.NoError(err)
+	s.store.nodes = []InternalHistoryNode{
+		{
+			NodeID:        common.FirstEventID,
+			TransactionID: 1,
+			Events: &commonpb.DataBlob{
+				EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+				Data:         compressedData,
+			},
+		},
+	}
+
+	// raw history leaves the server, it is never returned compressed
+	resp, err := s.manager.ReadRawHistoryBranch(&ReadHistoryBranchRequest{
+		ShardID: 1,
+		BranchToken: s.branchToken(&persistencespb.HistoryBranch{
+			TreeId:   uuid.New(),
+			BranchId: uuid.New(),
+		}),
+		MinEventID: common.FirstEventID,
+		MaxEventID: common.FirstEventID + 1,
+		PageSize:   10,
+	})
+	s.NoError(err)
+	s.Equal([]*commonpb.DataBlob{uncompressedBlob}, resp.HistoryEventBlobs)
+	s.Equal(len(uncompressedBlob.Data), resp.Size)
+}
+
+func (s *historyManagerSuite) branchToken(branch *persistencespb.HistoryBranch) []byte {
+	blob, err := s.serializer.HistoryBranchToBlob(branch, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	return blob.Data
+}
+
+func (s *historyTreeStore) GetHistoryTree(
+	request *GetHistoryTreeRequest,
+) (*InternalGetHistoryTreeResponse, error) {
+	serializer := serialization.NewSerializer()
+	var treeInfos []*commonpb.DataBlob
+	for _, branch := range s.branches {
+		blob, err := serializer.HistoryTreeInfoToBlob(&persistencespb.HistoryTreeInfo{
+			BranchInfo: branch,
+		}, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, err
+		}
+		treeInfos = append(treeInfos, blob)
+	}
+	return &InternalGetHistoryTreeResponse{TreeInfos: treeInfos}, nil
+}
+
+func (s *historyTreeStore) DeleteHistoryBranch(
+	request *InternalDeleteHistoryBranchRequest,
+) error {
+	s.deleteRequest = request
+	return nil
+}
+
+func (s *historyTreeStore) AppendHistoryNodes(
+	request *InternalAppendHistoryNodesRequest,
+) error {
+	s.appendRequest = request
+	return nil
+}
+
+func (s *historyTreeStore) ReadHistoryBranch(
+	request *InternalReadHistoryBranchRequest,
+) (*InternalReadHistoryBranchResponse, error) {
+	return &InternalReadHistoryBranchResponse{Nodes: s.nodes}, nil
+}
@@ -99,6 +99,22 @@ func (m *metadataManagerImpl) UpdateNamespace(request *UpdateNamespaceRequest) e
 	})
 }
 
+func (m *metadataManagerImpl) RenameNamespace(request *RenameNamespaceRequest) error {
+	datablob, err := m.serializer.NamespaceDetailToBlob(request.Namespace, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.RenameNamespace(&InternalRenameNamespaceRequest{
+		Id:                  request.Namespace.Info.Id,
+		PreviousName:        request.PreviousName,
+		Name:                request.Namespace.Info.Name,
+		Namespace:           datablob,
+		IsGlobal:            request.IsGlobalNamespace,
+		NotificationVersion: request.NotificationVersion,
+	})
+}
+
 func (m *metadataManagerImpl) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	return m.persistence.DeleteNamespace(request)
 }
@@ -474,6 +474,20 @@ func (mr *MockMetadataStoreMockRecorder) ListNamespaces(request interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockMetadataStore)(nil).ListNamespaces), request)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockMetadataStore) RenameNamespace(request *persistence.InternalRenameNamespaceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockMetadataStoreMockRecorder) RenameNamespace(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockMetadataStore)(nil).RenameNamespace), request)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockMetadataStore) UpdateNamespace(request *persistence.InternalUpdateNamespaceRequest) error {
 	m.ctrl.T.Helper()
@@ -872,6 +886,21 @@ func (mr *MockExecutionStoreMockRecorder) GetCurrentExecution(request interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentExecution", reflect.TypeOf((*MockExecutionStore)(nil).GetCurrentExecution), request)
 }
 
+// GetHistoryTasksFromDLQ mocks base method.
+func (m *MockExecutionStore) GetHistoryTasksFromDLQ(request *persistence.GetHistoryTasksFromDLQRequest) (*persistence.GetHistoryTasksFromDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTasksFromDLQ", request)
+	ret0, _ := ret[0].(*persistence.GetHistoryTasksFromDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTasksFromDLQ indicates an expected call of GetHistoryTasksFromDLQ.
+func (mr *MockExecutionStoreMockRecorder) GetHistoryTasksFromDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTasksFromDLQ", reflect.TypeOf((*MockExecutionStore)(nil).GetHistoryTasksFromDLQ), request)
+}
+
 // GetHistoryTree mocks base method.
 func (m *MockExecutionStore) GetHistoryTree(request *persistence.GetHistoryTreeRequest) (*persistence.InternalGetHistoryTreeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1066,6 +1095,20 @@ func (mr *MockExecutionStoreMockRecorder) ListConcreteExecutions(request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConcreteExecutions", reflect.TypeOf((*MockExecutionStore)(nil).ListConcreteExecutions), request)
 }
 
+// PutHistoryTaskToDLQ mocks base method.
+func (m *MockExecutionStore) PutHistoryTaskToDLQ(request *persistence.PutHistoryTaskToDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutHistoryTaskToDLQ", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutHistoryTaskToDLQ indicates an expected call of PutHistoryTaskToDLQ.
+func (mr *MockExecutionStoreMockRecorder) PutHistoryTaskToDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutHistoryTaskToDLQ", reflect.TypeOf((*MockExecutionStore)(nil).PutHistoryTaskToDLQ), request)
+}
+
 // PutReplicationTaskToDLQ mocks base method.
 func (m *MockExecutionStore) PutReplicationTaskToDLQ(request *persistence.PutReplicationTaskToDLQRequest) error {
 	m.ctrl.T.Helper()
@@ -1136,6 +1179,20 @@ func (mr *MockExecutionStoreMockRecorder) RangeCompleteVisibilityTask(request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteVisibilityTask", reflect.TypeOf((*MockExecutionStore)(nil).RangeCompleteVisibilityTask), request)
 }
 
+// RangeDeleteHistoryTaskFromDLQ mocks base method.
+func (m *MockExecutionStore) RangeDeleteHistoryTaskFromDLQ(request *persistence.RangeDeleteHistoryTaskFromDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteHistoryTaskFromDLQ", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteHistoryTaskFromDLQ indicates an expected call of RangeDeleteHistoryTaskFromDLQ.
+func (mr *MockExecutionStoreMockRecorder) RangeDeleteHistoryTaskFromDLQ(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteHistoryTaskFromDLQ", reflect.TypeOf((*MockExecutionStore)(nil).RangeDeleteHistoryTaskFromDLQ), request)
+}
+
 // RangeDeleteReplicationTaskFromDLQ mocks base method.
 func (m *MockExecutionStore) RangeDeleteReplicationTaskFromDLQ(request *persistence.RangeDeleteReplicationTaskFromDLQRequest) error {
 	m.ctrl.T.Helper()
@@ -3187,6 +3187,62 @@ func (s *ExecutionManagerSuite) TestReplicationDLQ() {
 	s.Len(resp.Tasks, 0)
 }
 
+// TestHistoryTaskDLQ test
+func (s *ExecutionManagerSuite) TestHistoryTaskDLQ() {
+	transferTask := &persistencespb.HistoryDLQTaskInfo{
+		TaskInfo: &persistencespb.HistoryDLQTaskInfo_TransferTaskInfo{
+			TransferTaskInfo: &persistencespb.TransferTaskInfo{
+				NamespaceId: uuid.New(),
+				WorkflowId:  uuid.New(),
+				RunId:       uuid.New(),
+				TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+				TaskId:      1,
+			},
+		},
+		Attempt:   10,
+		LastError: "transfer task error",
+	}
+	timerTask := &persistencespb.HistoryDLQTaskInfo{
+		TaskInfo: &persistencespb.HistoryDLQTaskInfo_TimerTaskInfo{
+			TimerTaskInfo: &persistencespb.TimerTaskInfo{
+				NamespaceId: uuid.New(),
+				WorkflowId:  uuid.New(),
+				RunId:       uuid.New(),
+				TaskType:    enumsspb.TASK_TYPE_USER_TIMER,
+				TaskId:      2,
+			},
+		},
+		Attempt:   10,
+		LastError: "timer task error",
+	}
+	s.NoError(s.PutHistoryTaskToDLQ(transferTask))
+	s.NoError(s.PutHistoryTaskToDLQ(timerTask))
+
+	resp, err := s.GetHistoryTasksFromDLQ(0, 2, 1, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(transferTask, resp.Tasks[0])
+	s.NotEmpty(resp.NextPageToken)
+
+	resp, err = s.GetHistoryTasksFromDLQ(0, 2, 1, resp.NextPageToken)
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(timerTask, resp.Tasks[0])
+
+	err = s.RangeDeleteHistoryTaskFromDLQ(0, 1)
+	s.NoError(err)
+	resp, err = s.GetHistoryTasksFromDLQ(0, 2, 2, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(timerTask, resp.Tasks[0])
+
+	err = s.RangeDeleteHistoryTaskFromDLQ(0, 2)
+	s.NoError(err)
+	resp, err = s.GetHistoryTasksFromDLQ(0, 2, 2, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 0)
+}
+
 func copyWorkflowExecutionInfo(sourceInfo *persistencespb.WorkflowExecutionInfo) *persistencespb.WorkflowExecutionInfo {
 	return &persistencespb.WorkflowExecutionInfo{
 		NamespaceId:                sourceInfo.NamespaceId,
@@ -410,9 +410,6 @@ func (s *MatchingPersistenceSuite) deleteAllTaskQueue() {
 
 // TestListWithOneTaskQueue test
 func (s *MatchingPersistenceSuite) TestListWithOneTaskQueue() {
-	if s.TaskMgr.GetName() == "cassandra" {
-		s.T().Skip("ListTaskQueue API is currently not supported in cassandra")
-	}
 	s.deleteAllTaskQueue()
 	resp, err := s.TaskMgr.ListTaskQueue(&p.ListTaskQueueRequest{PageSize: 10})
 	s.Equal(0, len(resp.Items))
@@ -470,9 +467,6 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskQueue() {
 
 // TestListWithMultipleTaskQueue test
 func (s *MatchingPersistenceSuite) TestListWithMultipleTaskQueue() {
-	if s.TaskMgr.GetName() == "cassandra" {
-		s.T().Skip("ListTaskQueue API is currently not supported in cassandra")
-	}
 	s.deleteAllTaskQueue()
 	namespaceID := uuid.New()
 	tlNames := make(map[string]struct{})
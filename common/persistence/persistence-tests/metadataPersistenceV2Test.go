@@ -774,6 +774,92 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateNamespace() {
 	m.EqualTimes(time.Unix(0, 0).UTC(), *resp6.Namespace.FailoverEndTime)
 }
 
+// TestRenameNamespace test
+func (m *MetadataPersistenceSuiteV2) TestRenameNamespace() {
+	id := uuid.New()
+	name := "rename-namespace-test-name"
+	newName := "rename-namespace-test-new-name"
+	otherName := "rename-namespace-test-other-name"
+	clusterActive := "some random active cluster name"
+	configVersion := int64(10)
+	failoverVersion := int64(59)
+
+	_, err := m.CreateNamespace(
+		&persistencespb.NamespaceInfo{
+			Id:    uuid.New(),
+			Name:  otherName,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{ActiveClusterName: clusterActive, Clusters: []string{clusterActive}},
+		false,
+		configVersion,
+		failoverVersion,
+	)
+	m.NoError(err)
+
+	resp1, err := m.CreateNamespace(
+		&persistencespb.NamespaceInfo{
+			Id:    id,
+			Name:  name,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{ActiveClusterName: clusterActive, Clusters: []string{clusterActive}},
+		false,
+		configVersion,
+		failoverVersion,
+	)
+	m.NoError(err)
+	m.EqualValues(id, resp1.ID)
+
+	resp2, err := m.GetNamespace(id, "")
+	m.NoError(err)
+
+	// Renaming to the name of another namespace must fail and leave the namespace intact.
+	metadata, err := m.MetadataManager.GetMetadata()
+	m.NoError(err)
+	resp2.Namespace.Info.Name = otherName
+	err = m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName:        name,
+		Namespace:           resp2.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	m.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+	_, err = m.GetNamespace("", name)
+	m.NoError(err)
+
+	metadata, err = m.MetadataManager.GetMetadata()
+	m.NoError(err)
+	aliasExpirationTime := time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)
+	resp2.Namespace.Info.Name = newName
+	resp2.Namespace.Info.Aliases = []*persistencespb.NamespaceAlias{{Name: name, ExpirationTime: &aliasExpirationTime}}
+	resp2.Namespace.ConfigVersion = configVersion + 1
+	err = m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName:        name,
+		Namespace:           resp2.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	m.NoError(err)
+
+	_, err = m.GetNamespace("", name)
+	m.IsType(&serviceerror.NotFound{}, err)
+
+	resp3, err := m.GetNamespace("", newName)
+	m.NoError(err)
+	m.Equal(id, resp3.Namespace.Info.Id)
+	m.Equal(newName, resp3.Namespace.Info.Name)
+	m.Equal(configVersion+1, resp3.Namespace.ConfigVersion)
+	m.Equal(1, len(resp3.Namespace.Info.Aliases))
+	m.Equal(name, resp3.Namespace.Info.Aliases[0].Name)
+	m.Equal(aliasExpirationTime, *resp3.Namespace.Info.Aliases[0].ExpirationTime)
+	m.Equal(metadata.NotificationVersion, resp3.NotificationVersion)
+
+	resp4, err := m.GetNamespace(id, "")
+	m.NoError(err)
+	m.Equal(newName, resp4.Namespace.Info.Name)
+}
+
 // TestDeleteNamespace test
 func (m *MetadataPersistenceSuiteV2) TestDeleteNamespace() {
 	id := uuid.New()
@@ -61,6 +61,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/searchattribute"
@@ -148,6 +149,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBPort = environment.GetMySQLPort()
 		case postgresql.PluginName:
 			options.DBPort = environment.GetPostgreSQLPort()
+		case sqlite.PluginName:
+			// noop, sqlite databases are local files
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", options.SQLDBPluginName))
 		}
@@ -158,6 +161,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBHost = environment.GetMySQLAddress()
 		case postgresql.PluginName:
 			options.DBHost = environment.GetPostgreSQLAddress()
+		case sqlite.PluginName:
+			options.DBHost = environment.Localhost
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", options.SQLDBPluginName))
 		}
@@ -238,13 +243,14 @@ func (s *TestBase) Setup(clusterMetadataConfig *config.ClusterMetadata) {
 
 	s.Factory = factory
 
-	// SQL currently doesn't have support for visibility manager
 	vCfg := s.VisibilityTestCluster.Config()
 	s.VisibilityMgr, err = visibilityclient.NewVisibilityManager(
 		vCfg,
 		nil,
 		nil,
 		resolver.NewNoopResolver(),
+		searchattribute.NewTestProvider(),
+		"",
 		s.logger,
 	)
 	if err != nil {
@@ -1095,6 +1101,47 @@ func (s *TestBase) RangeDeleteReplicationTaskFromDLQ(
 	})
 }
 
+// PutHistoryTaskToDLQ is a utility method to insert a history task into the history task DLQ
+func (s *TestBase) PutHistoryTaskToDLQ(
+	taskInfo *persistencespb.HistoryDLQTaskInfo,
+) error {
+
+	return s.ExecutionManager.PutHistoryTaskToDLQ(&persistence.PutHistoryTaskToDLQRequest{
+		ShardID:  s.ShardInfo.GetShardId(),
+		TaskInfo: taskInfo,
+	})
+}
+
+// GetHistoryTasksFromDLQ is a utility method to read history tasks from the history task DLQ
+func (s *TestBase) GetHistoryTasksFromDLQ(
+	readLevel int64,
+	maxReadLevel int64,
+	pageSize int,
+	pageToken []byte,
+) (*persistence.GetHistoryTasksFromDLQResponse, error) {
+
+	return s.ExecutionManager.GetHistoryTasksFromDLQ(&persistence.GetHistoryTasksFromDLQRequest{
+		ShardID:       s.ShardInfo.GetShardId(),
+		MinTaskID:     readLevel,
+		MaxTaskID:     maxReadLevel,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	})
+}
+
+// RangeDeleteHistoryTaskFromDLQ is a utility method to delete history tasks from the history task DLQ
+func (s *TestBase) RangeDeleteHistoryTaskFromDLQ(
+	beginTaskID int64,
+	endTaskID int64,
+) error {
+
+	return s.ExecutionManager.RangeDeleteHistoryTaskFromDLQ(&persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ShardID:              s.ShardInfo.GetShardId(),
+		ExclusiveBeginTaskID: beginTaskID,
+		InclusiveEndTaskID:   endTaskID,
+	})
+}
+
 // CompleteTransferTask is a utility method to complete a transfer task
 func (s *TestBase) CompleteTransferTask(taskID int64) error {
 
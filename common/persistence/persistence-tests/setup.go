@@ -25,9 +25,13 @@
 package persistencetests
 
 import (
+	"os"
+	"path/filepath"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/environment"
 )
 
@@ -39,6 +43,8 @@ const (
 	testPostgreSQLUser      = "temporal"
 	testPostgreSQLPassword  = "temporal"
 	testPostgreSQLSchemaDir = "schema/postgresql/v96"
+
+	testSQLiteSchemaDir = "schema/sqlite/v3"
 )
 
 // GetMySQLTestClusterOption return test options
@@ -66,3 +72,13 @@ func GetPostgreSQLTestClusterOption() *TestBaseOptions {
 		StoreType:       config.StoreTypeSQL,
 	}
 }
+
+// GetSQLiteTestClusterOption return test options
+func GetSQLiteTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: sqlite.PluginName,
+		DBName:          filepath.Join(os.TempDir(), "test_"+GenerateRandomDBName(3)+".db"),
+		SchemaDir:       testSQLiteSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
@@ -25,12 +25,14 @@
 package persistencetests
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -700,6 +702,122 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	}
 }
 
+// TestAdvancedVisibilityQuery test
+func (s *VisibilityPersistenceSuite) TestAdvancedVisibilityQuery() {
+	if _, ok := s.VisibilityTestCluster.(*cassandra.TestCluster); ok {
+		// Cassandra visibility store doesn't support List, Scan and Count by query.
+		return
+	}
+
+	testNamespaceUUID := uuid.New()
+	startTime := time.Now().UTC().Add(time.Second * -5)
+	newSearchAttributes := func(keyword string, number int64) *commonpb.SearchAttributes {
+		searchAttributes, err := searchattribute.Encode(map[string]interface{}{
+			"CustomKeywordField": keyword,
+			"CustomIntField":     number,
+		}, &searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		return searchAttributes
+	}
+
+	startReqs := make([]*visibility.RecordWorkflowExecutionStartedRequest, 3)
+	for i := range startReqs {
+		startReqs[i] = &visibility.RecordWorkflowExecutionStartedRequest{
+			VisibilityRequestBase: &visibility.VisibilityRequestBase{
+				NamespaceID: testNamespaceUUID,
+				Execution: commonpb.WorkflowExecution{
+					WorkflowId: fmt.Sprintf("visibility-advanced-query-test-%d", i),
+					RunId:      uuid.New(),
+				},
+				WorkflowTypeName: "visibility-workflow",
+				StartTime:        startTime.Add(time.Duration(i) * time.Millisecond),
+				TaskQueue:        "visibility-task-queue",
+				SearchAttributes: newSearchAttributes("keyword", int64(i)),
+			},
+		}
+		s.NoError(s.VisibilityMgr.RecordWorkflowExecutionStarted(startReqs[i]))
+	}
+
+	upsertReq := &visibility.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: &visibility.VisibilityRequestBase{
+			NamespaceID:      testNamespaceUUID,
+			Execution:        startReqs[1].Execution,
+			WorkflowTypeName: startReqs[1].WorkflowTypeName,
+			StartTime:        startReqs[1].StartTime,
+			TaskQueue:        startReqs[1].TaskQueue,
+			SearchAttributes: newSearchAttributes("upserted", 10),
+		},
+	}
+	s.NoError(s.VisibilityMgr.UpsertWorkflowExecution(upsertReq))
+
+	closeReq := &visibility.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: &visibility.VisibilityRequestBase{
+			NamespaceID:      testNamespaceUUID,
+			Execution:        startReqs[2].Execution,
+			WorkflowTypeName: startReqs[2].WorkflowTypeName,
+			StartTime:        startReqs[2].StartTime,
+			TaskQueue:        startReqs[2].TaskQueue,
+			SearchAttributes: startReqs[2].SearchAttributes,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		CloseTime:     time.Now(),
+		HistoryLength: 5,
+	}
+	s.NoError(s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq))
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(&visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    10,
+		Query:       "CustomKeywordField = 'keyword' AND ExecutionStatus = 'Running'",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.assertOpenExecutionEquals(startReqs[0], resp.Executions[0])
+	s.Equal("visibility-task-queue", resp.Executions[0].GetTaskQueue())
+	s.Equal(startReqs[0].SearchAttributes.GetIndexedFields()["CustomIntField"].GetData(),
+		resp.Executions[0].GetSearchAttributes().GetIndexedFields()["CustomIntField"].GetData())
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(&visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    10,
+		Query:       "CustomIntField >= 2",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 2)
+	s.assertClosedExecutionEquals(closeReq, resp.Executions[0])
+
+	resp, err = s.VisibilityMgr.ScanWorkflowExecutions(&visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    2,
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 2)
+	s.NotNil(resp.NextPageToken)
+	resp, err = s.VisibilityMgr.ScanWorkflowExecutions(&visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceUUID,
+		PageSize:      2,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Nil(resp.NextPageToken)
+	s.assertOpenExecutionEquals(startReqs[0], resp.Executions[0])
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(&visibility.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "CustomKeywordField = 'upserted' OR CloseTime != missing",
+	})
+	s.NoError(err)
+	s.Equal(int64(2), countResp.Count)
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(&visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    10,
+		Query:       "CustomIntField = 1 ORDER BY StartTime",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 // TestUpsertWorkflowExecution test
 func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	tests := []struct {
@@ -742,9 +860,8 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 					Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 				},
 			},
-			// To avoid blocking the task queue processors on non-ElasticSearch visibility stores
-			// we simply treat any attempts to perform Upserts as "no-ops"
-			// Attempts to Scan, Count or List will still fail for non-ES stores.
+			// To avoid blocking the task queue processors, upserts for workflow executions
+			// which are not recorded as open are treated as "no-ops".
 			expected: nil,
 		},
 	}
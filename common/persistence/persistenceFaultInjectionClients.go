@@ -0,0 +1,682 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	shardFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   ShardManager
+		logger        log.Logger
+	}
+
+	executionFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   ExecutionManager
+		logger        log.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   TaskManager
+		logger        log.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   MetadataManager
+		logger        log.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*executionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ MetadataManager = (*metadataFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a client to manage shards which injects faults
+func NewShardPersistenceFaultInjectionClient(persistence ShardManager, faultInjector *FaultInjector, logger log.Logger) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewExecutionPersistenceFaultInjectionClient creates a client to manage executions and history which injects faults
+func NewExecutionPersistenceFaultInjectionClient(persistence ExecutionManager, faultInjector *FaultInjector, logger log.Logger) ExecutionManager {
+	return &executionFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a client to manage tasks which injects faults
+func NewTaskPersistenceFaultInjectionClient(persistence TaskManager, faultInjector *FaultInjector, logger log.Logger) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewMetadataPersistenceFaultInjectionClient creates a MetadataManager client to manage metadata which injects faults
+func NewMetadataPersistenceFaultInjectionClient(persistence MetadataManager, faultInjector *FaultInjector, logger log.Logger) MetadataManager {
+	return &metadataFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	if err := p.faultInjector.Inject("CreateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CreateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	if err := p.faultInjector.Inject("GetShard"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetShard(request)
+	return response, err
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	if err := p.faultInjector.Inject("UpdateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionFaultInjectionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("GetWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecution(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("UpdateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	return resp, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("ConflictResolveWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.ConflictResolveWorkflowExecution(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecution(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteCurrentWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if err := p.faultInjector.Inject("GetCurrentExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetCurrentExecution(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListConcreteExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListConcreteExecutions(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) AddTasks(request *AddTasksRequest) error {
+	if err := p.faultInjector.Inject("AddTasks"); err != nil {
+		return err
+	}
+
+	err := p.persistence.AddTasks(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetTransferTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTask(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTransferTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTasks(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetVisibilityTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetVisibilityTask(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetVisibilityTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetVisibilityTasks(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTask(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasks(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTransferTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTransferTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteVisibilityTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteVisibilityTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteVisibilityTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteVisibilityTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteReplicationTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteReplicationTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteReplicationTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteReplicationTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) PutReplicationTaskToDLQ(
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("PutReplicationTaskToDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.PutReplicationTaskToDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTasksFromDLQ"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.GetReplicationTasksFromDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("DeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.DeleteReplicationTaskFromDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("RangeDeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) PutHistoryTaskToDLQ(
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("PutHistoryTaskToDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.PutHistoryTaskToDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetHistoryTasksFromDLQ(
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	if err := p.faultInjector.Inject("GetHistoryTasksFromDLQ"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.GetHistoryTasksFromDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("RangeDeleteHistoryTaskFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.RangeDeleteHistoryTaskFromDLQ(request)
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetTimerTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTimerTask(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTimerIndexTasks"); err != nil {
+		return nil, err
+	}
+
+	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	return resonse, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTimerTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTimerTask(request)
+	return err
+}
+
+func (p *executionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if err := p.faultInjector.Inject("CreateTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTask(request)
+	return err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	if err := p.faultInjector.Inject("CompleteTasksLessThan"); err != nil {
+		return 0, err
+	}
+	return p.persistence.CompleteTasksLessThan(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("LeaseTaskQueue"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.LeaseTaskQueue(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("UpdateTaskQueue"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateTaskQueue(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("ListTaskQueue"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListTaskQueue(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	if err := p.faultInjector.Inject("DeleteTaskQueue"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteTaskQueue(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	if err := p.faultInjector.Inject("CreateNamespace"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateNamespace(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	if err := p.faultInjector.Inject("GetNamespace"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetNamespace(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	if err := p.faultInjector.Inject("UpdateNamespace"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateNamespace(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	if err := p.faultInjector.Inject("RenameNamespace"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RenameNamespace(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	if err := p.faultInjector.Inject("DeleteNamespace"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteNamespace(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	if err := p.faultInjector.Inject("DeleteNamespaceByName"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteNamespaceByName(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	if err := p.faultInjector.Inject("ListNamespaces"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListNamespaces(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	if err := p.faultInjector.Inject("GetMetadata"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetMetadata()
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add a node to history node table
+
+func (p *executionFaultInjectionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if err := p.faultInjector.Inject("AppendHistoryNodes"); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryNodes(request)
+}
+
+// ReadHistoryBranch returns history node data for a branch
+
+func (p *executionFaultInjectionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ReadHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranch(request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+
+func (p *executionFaultInjectionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if err := p.faultInjector.Inject("ReadHistoryBranchByBatch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+
+func (p *executionFaultInjectionPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ReadRawHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+
+func (p *executionFaultInjectionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ForkHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ForkHistoryBranch(request)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+
+func (p *executionFaultInjectionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	if err := p.faultInjector.Inject("DeleteHistoryBranch"); err != nil {
+		return err
+	}
+	err := p.persistence.DeleteHistoryBranch(request)
+	return err
+}
+
+// TrimHistoryBranch trims a branch
+
+func (p *executionFaultInjectionPersistenceClient) TrimHistoryBranch(request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("TrimHistoryBranch"); err != nil {
+		return nil, err
+	}
+	resp, err := p.persistence.TrimHistoryBranch(request)
+	return resp, err
+}
+
+// GetHistoryTree returns all branch information of a tree
+
+func (p *executionFaultInjectionPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if err := p.faultInjector.Inject("GetHistoryTree"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetHistoryTree(request)
+	return response, err
+}
+
+func (p *executionFaultInjectionPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	if err := p.faultInjector.Inject("GetAllHistoryTreeBranches"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	return response, err
+}
+
+func (c *metadataFaultInjectionPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
+	if err := c.faultInjector.Inject("InitializeSystemNamespaces"); err != nil {
+		return err
+	}
+	return c.persistence.InitializeSystemNamespaces(currentClusterName)
+}
@@ -80,6 +80,7 @@ type (
 		CreateNamespace(request *InternalCreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*InternalGetNamespaceResponse, error)
 		UpdateNamespace(request *InternalUpdateNamespaceRequest) error
+		RenameNamespace(request *InternalRenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*InternalListNamespacesResponse, error)
@@ -142,6 +143,11 @@ type (
 		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
 		RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error
 
+		// history task dlq
+		PutHistoryTaskToDLQ(request *PutHistoryTaskToDLQRequest) error
+		GetHistoryTasksFromDLQ(request *GetHistoryTasksFromDLQRequest) (*GetHistoryTasksFromDLQResponse, error)
+		RangeDeleteHistoryTaskFromDLQ(request *RangeDeleteHistoryTaskFromDLQRequest) error
+
 		// visibility tasks
 		GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error)
 		GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error)
@@ -603,6 +609,16 @@ type (
 		NotificationVersion int64
 	}
 
+	// InternalRenameNamespaceRequest is used to rename namespace
+	InternalRenameNamespaceRequest struct {
+		Id                  string
+		PreviousName        string
+		Name                string
+		Namespace           *commonpb.DataBlob
+		IsGlobal            bool
+		NotificationVersion int64
+	}
+
 	// InternalListNamespacesResponse is the response for GetNamespace
 	InternalListNamespacesResponse struct {
 		Namespaces    []*InternalGetNamespaceResponse
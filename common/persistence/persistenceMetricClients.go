@@ -25,12 +25,16 @@
 package persistence
 
 import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tracing"
 )
 
 type (
@@ -44,6 +48,8 @@ type (
 		metricClient metrics.Client
 		persistence  ExecutionManager
 		logger       log.Logger
+		// ctx carries the span the persistence operations are traced under
+		ctx context.Context
 	}
 
 	taskPersistenceClient struct {
@@ -93,7 +99,20 @@ func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricCl
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		ctx:          context.Background(),
+	}
+}
+
+// ExecutionManagerWithContext returns the execution manager with its operations traced as
+// children of the span carried by ctx. Managers not wrapped by the metrics client are returned as is
+func ExecutionManagerWithContext(ctx context.Context, manager ExecutionManager) ExecutionManager {
+	client, ok := manager.(*executionPersistenceClient)
+	if !ok {
+		return manager
 	}
+	clientWithContext := *client
+	clientWithContext.ctx = ctx
+	return &clientWithContext
 }
 
 // NewTaskPersistenceMetricsClient creates a client to manage tasks
@@ -208,8 +227,10 @@ func (p *executionPersistenceClient) CreateWorkflowExecution(request *CreateWork
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceCreateWorkflowExecutionScope)
 	response, err := p.persistence.CreateWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateWorkflowExecutionScope, err)
@@ -222,8 +243,10 @@ func (p *executionPersistenceClient) GetWorkflowExecution(request *GetWorkflowEx
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetWorkflowExecutionScope)
 	response, err := p.persistence.GetWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
@@ -236,8 +259,10 @@ func (p *executionPersistenceClient) UpdateWorkflowExecution(request *UpdateWork
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceUpdateWorkflowExecutionScope)
 	resp, err := p.persistence.UpdateWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
@@ -250,8 +275,10 @@ func (p *executionPersistenceClient) ConflictResolveWorkflowExecution(request *C
 	p.metricClient.IncCounter(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceConflictResolveWorkflowExecutionScope)
 	err := p.persistence.ConflictResolveWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceConflictResolveWorkflowExecutionScope, err)
@@ -264,8 +291,10 @@ func (p *executionPersistenceClient) DeleteWorkflowExecution(request *DeleteWork
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceDeleteWorkflowExecutionScope)
 	err := p.persistence.DeleteWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionScope, err)
@@ -278,8 +307,10 @@ func (p *executionPersistenceClient) DeleteCurrentWorkflowExecution(request *Del
 	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceDeleteCurrentWorkflowExecutionScope)
 	err := p.persistence.DeleteCurrentWorkflowExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
@@ -292,8 +323,10 @@ func (p *executionPersistenceClient) GetCurrentExecution(request *GetCurrentExec
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetCurrentExecutionScope)
 	response, err := p.persistence.GetCurrentExecution(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetCurrentExecutionScope, err)
@@ -306,8 +339,10 @@ func (p *executionPersistenceClient) ListConcreteExecutions(request *ListConcret
 	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceListConcreteExecutionsScope)
 	response, err := p.persistence.ListConcreteExecutions(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListConcreteExecutionsScope, err)
@@ -320,8 +355,10 @@ func (p *executionPersistenceClient) AddTasks(request *AddTasksRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceAddTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceAddTasksScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceAddTasksScope)
 	err := p.persistence.AddTasks(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTransferTaskScope, err)
@@ -334,8 +371,10 @@ func (p *executionPersistenceClient) GetTransferTask(request *GetTransferTaskReq
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetTransferTaskScope)
 	response, err := p.persistence.GetTransferTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTransferTaskScope, err)
@@ -348,8 +387,10 @@ func (p *executionPersistenceClient) GetTransferTasks(request *GetTransferTasksR
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetTransferTasksScope)
 	response, err := p.persistence.GetTransferTasks(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTransferTasksScope, err)
@@ -362,8 +403,10 @@ func (p *executionPersistenceClient) GetVisibilityTask(request *GetVisibilityTas
 	p.metricClient.IncCounter(metrics.PersistenceGetVisibilityTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetVisibilityTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetVisibilityTaskScope)
 	response, err := p.persistence.GetVisibilityTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetVisibilityTaskScope, err)
@@ -376,8 +419,10 @@ func (p *executionPersistenceClient) GetVisibilityTasks(request *GetVisibilityTa
 	p.metricClient.IncCounter(metrics.PersistenceGetVisibilityTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetVisibilityTasksScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetVisibilityTasksScope)
 	response, err := p.persistence.GetVisibilityTasks(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetVisibilityTasksScope, err)
@@ -390,8 +435,10 @@ func (p *executionPersistenceClient) GetReplicationTask(request *GetReplicationT
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetReplicationTaskScope)
 	response, err := p.persistence.GetReplicationTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetReplicationTaskScope, err)
@@ -404,8 +451,10 @@ func (p *executionPersistenceClient) GetReplicationTasks(request *GetReplication
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetReplicationTasksScope)
 	response, err := p.persistence.GetReplicationTasks(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksScope, err)
@@ -418,8 +467,10 @@ func (p *executionPersistenceClient) CompleteTransferTask(request *CompleteTrans
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceCompleteTransferTaskScope)
 	err := p.persistence.CompleteTransferTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTransferTaskScope, err)
@@ -432,8 +483,10 @@ func (p *executionPersistenceClient) RangeCompleteTransferTask(request *RangeCom
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeCompleteTransferTaskScope)
 	err := p.persistence.RangeCompleteTransferTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRangeCompleteTransferTaskScope, err)
@@ -446,8 +499,10 @@ func (p *executionPersistenceClient) CompleteVisibilityTask(request *CompleteVis
 	p.metricClient.IncCounter(metrics.PersistenceCompleteVisibilityTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteVisibilityTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceCompleteVisibilityTaskScope)
 	err := p.persistence.CompleteVisibilityTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteVisibilityTaskScope, err)
@@ -460,8 +515,10 @@ func (p *executionPersistenceClient) RangeCompleteVisibilityTask(request *RangeC
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteVisibilityTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteVisibilityTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeCompleteVisibilityTaskScope)
 	err := p.persistence.RangeCompleteVisibilityTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRangeCompleteVisibilityTaskScope, err)
@@ -474,8 +531,10 @@ func (p *executionPersistenceClient) CompleteReplicationTask(request *CompleteRe
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceCompleteReplicationTaskScope)
 	err := p.persistence.CompleteReplicationTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteReplicationTaskScope, err)
@@ -488,8 +547,10 @@ func (p *executionPersistenceClient) RangeCompleteReplicationTask(request *Range
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteReplicationTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeCompleteReplicationTaskScope)
 	err := p.persistence.RangeCompleteReplicationTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRangeCompleteReplicationTaskScope, err)
@@ -504,8 +565,10 @@ func (p *executionPersistenceClient) PutReplicationTaskToDLQ(
 	p.metricClient.IncCounter(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistencePutReplicationTaskToDLQScope)
 	err := p.persistence.PutReplicationTaskToDLQ(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistencePutReplicationTaskToDLQScope, err)
@@ -520,8 +583,10 @@ func (p *executionPersistenceClient) GetReplicationTasksFromDLQ(
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetReplicationTasksFromDLQScope)
 	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksFromDLQScope, err)
@@ -536,8 +601,10 @@ func (p *executionPersistenceClient) DeleteReplicationTaskFromDLQ(
 	p.metricClient.IncCounter(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceDeleteReplicationTaskFromDLQScope)
 	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteReplicationTaskFromDLQScope, err)
@@ -552,8 +619,10 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope)
 	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, err)
@@ -562,12 +631,68 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 	return nil
 }
 
+func (p *executionPersistenceClient) PutHistoryTaskToDLQ(
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistencePutHistoryTaskToDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutHistoryTaskToDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistencePutHistoryTaskToDLQScope)
+	err := p.persistence.PutHistoryTaskToDLQ(request)
+	sw.Stop()
+	tracing.EndSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutHistoryTaskToDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *executionPersistenceClient) GetHistoryTasksFromDLQ(
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTasksFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTasksFromDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetHistoryTasksFromDLQScope)
+	response, err := p.persistence.GetHistoryTasksFromDLQ(request)
+	sw.Stop()
+	tracing.EndSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetHistoryTasksFromDLQScope, err)
+	}
+
+	return response, err
+}
+
+func (p *executionPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope)
+	err := p.persistence.RangeDeleteHistoryTaskFromDLQ(request)
+	sw.Stop()
+	tracing.EndSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, err)
+	}
+
+	return nil
+}
+
 func (p *executionPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetTimerTaskScope)
 	response, err := p.persistence.GetTimerTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTimerTaskScope, err)
@@ -580,8 +705,10 @@ func (p *executionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTa
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetTimerIndexTasksScope)
 	response, err := p.persistence.GetTimerIndexTasks(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTimerIndexTasksScope, err)
@@ -594,8 +721,10 @@ func (p *executionPersistenceClient) CompleteTimerTask(request *CompleteTimerTas
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceCompleteTimerTaskScope)
 	err := p.persistence.CompleteTimerTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTimerTaskScope, err)
@@ -608,8 +737,10 @@ func (p *executionPersistenceClient) RangeCompleteTimerTask(request *RangeComple
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceRangeCompleteTimerTaskScope)
 	err := p.persistence.RangeCompleteTimerTask(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRangeCompleteTimerTaskScope, err)
@@ -821,6 +952,20 @@ func (p *metadataPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequ
 	return err
 }
 
+func (p *metadataPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceLatency)
+	err := p.persistence.RenameNamespace(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRenameNamespaceScope, err)
+	}
+
+	return err
+}
+
 func (p *metadataPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteNamespaceScope, metrics.PersistenceRequests)
 
@@ -903,8 +1048,10 @@ func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *executionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceAppendHistoryNodesScope)
 	resp, err := p.persistence.AppendHistoryNodes(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
 	}
@@ -915,8 +1062,10 @@ func (p *executionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNo
 func (p *executionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceReadHistoryBranchScope)
 	response, err := p.persistence.ReadHistoryBranch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
 	}
@@ -927,8 +1076,10 @@ func (p *executionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranc
 func (p *executionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceReadHistoryBranchScope)
 	response, err := p.persistence.ReadHistoryBranchByBatch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
 	}
@@ -939,8 +1090,10 @@ func (p *executionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHisto
 func (p *executionPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceReadHistoryBranchScope)
 	response, err := p.persistence.ReadRawHistoryBranch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
 	}
@@ -951,8 +1104,10 @@ func (p *executionPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBr
 func (p *executionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceForkHistoryBranchScope)
 	response, err := p.persistence.ForkHistoryBranch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceForkHistoryBranchScope, err)
 	}
@@ -963,8 +1118,10 @@ func (p *executionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranc
 func (p *executionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceDeleteHistoryBranchScope)
 	err := p.persistence.DeleteHistoryBranch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteHistoryBranchScope, err)
 	}
@@ -975,8 +1132,10 @@ func (p *executionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryB
 func (p *executionPersistenceClient) TrimHistoryBranch(request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceTrimHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceTrimHistoryBranchScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceTrimHistoryBranchScope)
 	resp, err := p.persistence.TrimHistoryBranch(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceTrimHistoryBranchScope, err)
 	}
@@ -986,8 +1145,10 @@ func (p *executionPersistenceClient) TrimHistoryBranch(request *TrimHistoryBranc
 func (p *executionPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetAllHistoryTreeBranchesScope)
 	response, err := p.persistence.GetAllHistoryTreeBranches(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetAllHistoryTreeBranchesScope, err)
 	}
@@ -998,8 +1159,10 @@ func (p *executionPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHi
 func (p *executionPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceLatency)
+	span := startPersistenceSpan(p.ctx, metrics.PersistenceGetHistoryTreeScope)
 	response, err := p.persistence.GetHistoryTree(request)
 	sw.Stop()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetHistoryTreeScope, err)
 	}
@@ -1259,3 +1422,17 @@ func (c *metadataPersistenceClient) InitializeSystemNamespaces(currentClusterNam
 
 	return err
 }
+
+// startPersistenceSpan starts the span of a persistence operation as a child of the span carried
+// by ctx, operations without a parent span are not traced so they don't show up as root traces
+func startPersistenceSpan(ctx context.Context, scope int) trace.Span {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return trace.SpanFromContext(context.Background())
+	}
+	_, span := tracing.StartSpan(
+		ctx,
+		"persistence/"+metrics.GetScopeOperation(metrics.Common, scope),
+		trace.SpanKindClient,
+	)
+	return span
+}
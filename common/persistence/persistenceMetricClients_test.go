@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tracing"
+)
+
+type (
+	persistenceMetricClientsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		recorder         *oteltest.StandardSpanRecorder
+		executionManager *MockExecutionManager
+		client           ExecutionManager
+	}
+)
+
+func TestPersistenceMetricClientsSuite(t *testing.T) {
+	s := new(persistenceMetricClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceMetricClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.recorder = new(oteltest.StandardSpanRecorder)
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(s.recorder)))
+
+	s.executionManager = NewMockExecutionManager(s.controller)
+	s.client = NewExecutionPersistenceMetricsClient(s.executionManager, metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+}
+
+func (s *persistenceMetricClientsSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *persistenceMetricClientsSuite) TestSpanParentedToRPC() {
+	request := &GetWorkflowExecutionRequest{ShardID: 1}
+	s.executionManager.EXPECT().GetWorkflowExecution(request).Return(&GetWorkflowExecutionResponse{}, nil)
+
+	const method = "/temporal.server.api.historyservice.v1.HistoryService/GetMutableState"
+	_, err := tracing.NewServerInterceptor("history")(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return ExecutionManagerWithContext(ctx, s.client).GetWorkflowExecution(request)
+		},
+	)
+	s.NoError(err)
+
+	spans := s.recorder.Completed()
+	s.Len(spans, 2)
+	persistenceSpan, rpcSpan := spans[0], spans[1]
+	s.Equal(method, rpcSpan.Name())
+	s.Equal("persistence/GetWorkflowExecution", persistenceSpan.Name())
+	s.Equal(trace.SpanKindClient, persistenceSpan.SpanKind())
+	s.Equal(rpcSpan.SpanContext().TraceID, persistenceSpan.SpanContext().TraceID)
+	s.Equal(rpcSpan.SpanContext().SpanID, persistenceSpan.ParentSpanID())
+}
+
+func (s *persistenceMetricClientsSuite) TestNoSpanWithoutParent() {
+	request := &GetWorkflowExecutionRequest{ShardID: 1}
+	s.executionManager.EXPECT().GetWorkflowExecution(request).Return(&GetWorkflowExecutionResponse{}, nil).Times(2)
+
+	_, err := s.client.GetWorkflowExecution(request)
+	s.NoError(err)
+	_, err = ExecutionManagerWithContext(context.Background(), s.client).GetWorkflowExecution(request)
+	s.NoError(err)
+
+	s.Empty(s.recorder.Completed())
+}
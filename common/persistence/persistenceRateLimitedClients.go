@@ -405,6 +405,36 @@ func (p *executionRateLimitedPersistenceClient) RangeDeleteReplicationTaskFromDL
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
 }
 
+func (p *executionRateLimitedPersistenceClient) PutHistoryTaskToDLQ(
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.PutHistoryTaskToDLQ(request)
+}
+
+func (p *executionRateLimitedPersistenceClient) GetHistoryTasksFromDLQ(
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.GetHistoryTasksFromDLQ(request)
+}
+
+func (p *executionRateLimitedPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.RangeDeleteHistoryTaskFromDLQ(request)
+}
+
 func (p *executionRateLimitedPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -550,6 +580,15 @@ func (p *metadataRateLimitedPersistenceClient) UpdateNamespace(request *UpdateNa
 	return err
 }
 
+func (p *metadataRateLimitedPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RenameNamespace(request)
+	return err
+}
+
 func (p *metadataRateLimitedPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
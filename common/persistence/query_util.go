@@ -47,14 +47,23 @@ func LoadAndSplitQuery(
 		if err != nil {
 			return nil, fmt.Errorf("error reading contents from file %v, err: %v", path, err)
 		}
-		for _, stmt := range strings.Split(string(content), queryDelimiter) {
-			stmt = strings.TrimSpace(stmt)
-			if stmt == "" {
-				continue
-			}
-			result = append(result, stmt)
-		}
-
+		result = append(result, SplitQuery(string(content))...)
 	}
 	return result, nil
 }
+
+// SplitQuery splits cql / sql query content into one statement per string
+func SplitQuery(
+	content string,
+) []string {
+
+	result := make([]string, 0, querySliceDefaultSize)
+	for _, stmt := range strings.Split(content, queryDelimiter) {
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		result = append(result, stmt)
+	}
+	return result
+}
@@ -94,6 +94,15 @@ func VisibilityTaskInfoFromBlob(blob []byte, encoding string) (*persistencespb.V
 	return result, proto3Decode(blob, encoding, result)
 }
 
+func HistoryDLQTaskInfoToBlob(info *persistencespb.HistoryDLQTaskInfo) (commonpb.DataBlob, error) {
+	return proto3Encode(info)
+}
+
+func HistoryDLQTaskInfoFromBlob(blob []byte, encoding string) (*persistencespb.HistoryDLQTaskInfo, error) {
+	result := &persistencespb.HistoryDLQTaskInfo{}
+	return result, proto3Decode(blob, encoding, result)
+}
+
 func QueueMetadataToBlob(metadata *persistencespb.QueueMetadata) (commonpb.DataBlob, error) {
 	// TODO change ENCODING_TYPE_JSON to ENCODING_TYPE_PROTO3
 	return encode(metadata, enumspb.ENCODING_TYPE_JSON)
@@ -104,6 +113,15 @@ func QueueMetadataFromBlob(blob []byte, encoding string) (*persistencespb.QueueM
 	return result, decode(blob, encoding, result)
 }
 
+func DynamicConfigChangeToBlob(change *persistencespb.DynamicConfigChange) (commonpb.DataBlob, error) {
+	return proto3Encode(change)
+}
+
+func DynamicConfigChangeFromBlob(blob []byte, encoding string) (*persistencespb.DynamicConfigChange, error) {
+	result := &persistencespb.DynamicConfigChange{}
+	return result, proto3Decode(blob, encoding, result)
+}
+
 func encode(
 	object proto.Message,
 	encoding enumspb.EncodingType,
@@ -166,6 +184,10 @@ func proto3Decode(blob []byte, encoding string, result proto.Message) error {
 		return fmt.Errorf("encoding %s doesn't match expected encoding %v", encoding, enumspb.ENCODING_TYPE_PROTO3)
 	}
 
+	blob, err := DecompressBlob(blob)
+	if err != nil {
+		return fmt.Errorf("error decompressing blob: %w", err)
+	}
 	if err := proto.Unmarshal(blob, result); err != nil {
 		return fmt.Errorf("error deserializing blob using %v encoding: %w", enumspb.ENCODING_TYPE_PROTO3, err)
 	}
@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// Compression is the codec used to compress the history events and mutable state blobs
+	Compression string
+
+	blobCompressor struct {
+		compression   Compression
+		enabled       dynamicconfig.BoolPropertyFn
+		metricsClient metrics.Client
+	}
+)
+
+const (
+	// CompressionNone disables the compression of blobs
+	CompressionNone Compression = ""
+	// CompressionZstd compresses blobs with zstd
+	CompressionZstd Compression = "zstd"
+	// CompressionSnappy compresses blobs with snappy
+	CompressionSnappy Compression = "snappy"
+)
+
+// A compressed blob starts with a two bytes header, the marker followed by the codec of the
+// payload. The marker is never the first byte of a proto3 encoded blob since zero is not a
+// valid field number, so compressed and uncompressed blobs can coexist in the same table.
+const (
+	compressedBlobMarker byte = 0x00
+
+	compressedBlobCodecZstd   byte = 0x01
+	compressedBlobCodecSnappy byte = 0x02
+
+	compressedBlobHeaderSize = 2
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompression converts the string to a Compression
+func ParseCompression(compression string) (Compression, error) {
+	switch c := Compression(compression); c {
+	case CompressionNone, CompressionZstd, CompressionSnappy:
+		return c, nil
+	default:
+		return CompressionNone, fmt.Errorf("unknown compression %q", compression)
+	}
+}
+
+// IsCompressedBlob returns true if the blob data was compressed by a compressing Serializer
+func IsCompressedBlob(data []byte) bool {
+	return len(data) >= compressedBlobHeaderSize && data[0] == compressedBlobMarker
+}
+
+// CompressBlob compresses the blob data and prepends the header recording the codec
+func CompressBlob(data []byte, compression Compression) ([]byte, error) {
+	header := []byte{compressedBlobMarker, 0}
+	switch compression {
+	case CompressionNone:
+		return data, nil
+	case CompressionZstd:
+		header[1] = compressedBlobCodecZstd
+		return zstdEncoder.EncodeAll(data, header), nil
+	case CompressionSnappy:
+		header[1] = compressedBlobCodecSnappy
+		return append(header, snappy.Encode(nil, data)...), nil
+	default:
+		return nil, fmt.Errorf("unknown compression %q", compression)
+	}
+}
+
+// DecompressBlob decompresses the blob data according to its header. Data which is not
+// compressed is returned as is
+func DecompressBlob(data []byte) ([]byte, error) {
+	if !IsCompressedBlob(data) {
+		return data, nil
+	}
+
+	payload := data[compressedBlobHeaderSize:]
+	switch codec := data[1]; codec {
+	case compressedBlobCodecZstd:
+		return zstdDecoder.DecodeAll(payload, nil)
+	case compressedBlobCodecSnappy:
+		return snappy.Decode(nil, payload)
+	default:
+		return nil, fmt.Errorf("unknown compressed blob codec %v", codec)
+	}
+}
+
+// DecompressedBlobSize returns the size of the blob data once decompressed, which is the size
+// of the data which is not compressed. The size limits on history apply to this size so they
+// keep their meaning whether the blobs are compressed or not
+func DecompressedBlobSize(data []byte) (int, error) {
+	if !IsCompressedBlob(data) {
+		return len(data), nil
+	}
+
+	payload := data[compressedBlobHeaderSize:]
+	switch codec := data[1]; codec {
+	case compressedBlobCodecZstd:
+		var header zstd.Header
+		if err := header.Decode(payload); err == nil && header.HasFCS {
+			return int(header.FrameContentSize), nil
+		}
+		decompressed, err := DecompressBlob(data)
+		return len(decompressed), err
+	case compressedBlobCodecSnappy:
+		return snappy.DecodedLen(payload)
+	default:
+		return 0, fmt.Errorf("unknown compressed blob codec %v", codec)
+	}
+}
+
+func newBlobCompressor(
+	compression Compression,
+	enabled dynamicconfig.BoolPropertyFn,
+	metricsClient metrics.Client,
+) blobCompressor {
+	return blobCompressor{
+		compression:   compression,
+		enabled:       enabled,
+		metricsClient: metricsClient,
+	}
+}
+
+func (c blobCompressor) compress(data []byte) ([]byte, error) {
+	// compressed blobs are unreadable by servers without compression support, writing them has to
+	// wait until it is explicitly enabled for the whole cluster
+	if c.compression == CompressionNone || c.enabled == nil || !c.enabled() || len(data) == 0 {
+		return data, nil
+	}
+
+	startTime := time.Now()
+	compressed, err := CompressBlob(data, c.compression)
+	if err != nil {
+		return nil, err
+	}
+	c.recordMetrics(metrics.PersistenceCompressBlobScope, len(data), len(compressed), time.Since(startTime))
+	return compressed, nil
+}
+
+func (c blobCompressor) decompress(data []byte) ([]byte, error) {
+	if !IsCompressedBlob(data) {
+		return data, nil
+	}
+
+	startTime := time.Now()
+	decompressed, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+	c.recordMetrics(metrics.PersistenceDecompressBlobScope, len(decompressed), len(data), time.Since(startTime))
+	return decompressed, nil
+}
+
+func (c blobCompressor) recordMetrics(
+	scope int,
+	uncompressedSize int,
+	compressedSize int,
+	latency time.Duration,
+) {
+	if c.metricsClient == nil {
+		return
+	}
+	c.metricsClient.AddCounter(scope, metrics.PersistenceCompressionUncompressedBytes, int64(uncompressedSize))
+	c.metricsClient.AddCounter(scope, metrics.PersistenceCompressionCompressedBytes, int64(compressedSize))
+	c.metricsClient.RecordTimer(scope, metrics.PersistenceCompressionLatency, latency)
+}
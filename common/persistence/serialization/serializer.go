@@ -39,6 +39,8 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
 )
 
 type (
@@ -145,7 +147,9 @@ type (
 		encodingType enumspb.EncodingType
 	}
 
-	serializerImpl struct{}
+	serializerImpl struct {
+		compressor blobCompressor
+	}
 )
 
 // NewSerializer returns a PayloadSerializer
@@ -153,8 +157,21 @@ func NewSerializer() Serializer {
 	return &serializerImpl{}
 }
 
+// NewCompressingSerializer returns a PayloadSerializer which compresses the history events and
+// mutable state blobs while enabled. The blobs compressed by it can be deserialized by any Serializer
+// of a server which supports compression, whether compression is enabled or not
+func NewCompressingSerializer(
+	compression Compression,
+	enabled dynamicconfig.BoolPropertyFn,
+	metricsClient metrics.Client,
+) Serializer {
+	return &serializerImpl{
+		compressor: newBlobCompressor(compression, enabled, metricsClient),
+	}
+}
+
 func (t *serializerImpl) SerializeEvents(events []*historypb.HistoryEvent, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return t.serialize(&historypb.History{Events: events}, encodingType)
+	return t.compress(t.serialize(&historypb.History{Events: events}, encodingType))
 }
 
 func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historypb.HistoryEvent, error) {
@@ -165,8 +182,12 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 		return nil, nil
 	}
 
+	data, err := t.decompress(data)
+	if err != nil {
+		return nil, err
+	}
+
 	events := &historypb.History{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
@@ -467,66 +488,66 @@ func (t *serializerImpl) HistoryBranchFromBlob(data *commonpb.DataBlob) (*persis
 }
 
 func (t *serializerImpl) WorkflowExecutionInfoToBlob(info *persistencespb.WorkflowExecutionInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) WorkflowExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionInfo, error) {
 	result := &persistencespb.WorkflowExecutionInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) WorkflowExecutionStateToBlob(info *persistencespb.WorkflowExecutionState, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) WorkflowExecutionStateFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionState, error) {
 	result := &persistencespb.WorkflowExecutionState{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) ActivityInfoToBlob(info *persistencespb.ActivityInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) ActivityInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ActivityInfo, error) {
 	result := &persistencespb.ActivityInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) ChildExecutionInfoToBlob(info *persistencespb.ChildExecutionInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) ChildExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ChildExecutionInfo, error) {
 	result := &persistencespb.ChildExecutionInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) SignalInfoToBlob(info *persistencespb.SignalInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) SignalInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.SignalInfo, error) {
 	result := &persistencespb.SignalInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) RequestCancelInfoToBlob(info *persistencespb.RequestCancelInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) RequestCancelInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.RequestCancelInfo, error) {
 	result := &persistencespb.RequestCancelInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) TimerInfoToBlob(info *persistencespb.TimerInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	return proto3EncodeBlob(info, encodingType)
+	return t.compress(proto3EncodeBlob(info, encodingType))
 }
 
 func (t *serializerImpl) TimerInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TimerInfo, error) {
 	result := &persistencespb.TimerInfo{}
-	return result, proto3DecodeBlob(data, result)
+	return result, t.proto3DecodeCompressedBlob(data, result)
 }
 
 func (t *serializerImpl) TaskInfoToBlob(info *persistencespb.AllocatedTaskInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
@@ -619,6 +640,44 @@ func (t *serializerImpl) ReplicationTaskFromBlob(data *commonpb.DataBlob) (*repl
 	return result, proto3DecodeBlob(data, result)
 }
 
+func (t *serializerImpl) compress(blob *commonpb.DataBlob, err error) (*commonpb.DataBlob, error) {
+	if err != nil || blob == nil {
+		return blob, err
+	}
+
+	data, err := t.compressor.compress(blob.Data)
+	if err != nil {
+		return nil, NewSerializationError(err.Error())
+	}
+	return &commonpb.DataBlob{
+		Data:         data,
+		EncodingType: blob.EncodingType,
+	}, nil
+}
+
+func (t *serializerImpl) decompress(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || !IsCompressedBlob(blob.Data) {
+		return blob, nil
+	}
+
+	data, err := t.compressor.decompress(blob.Data)
+	if err != nil {
+		return nil, NewDeserializationError(err.Error())
+	}
+	return &commonpb.DataBlob{
+		Data:         data,
+		EncodingType: blob.EncodingType,
+	}, nil
+}
+
+func (t *serializerImpl) proto3DecodeCompressedBlob(data *commonpb.DataBlob, result proto.Message) error {
+	data, err := t.decompress(data)
+	if err != nil {
+		return err
+	}
+	return proto3DecodeBlob(data, result)
+}
+
 func proto3DecodeBlob(data *commonpb.DataBlob, result proto.Message) error {
 	if data == nil {
 		// TODO: should we return nil or error?
@@ -42,6 +42,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -296,3 +297,107 @@ func (s *temporalSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *temporalSerializerSuite) TestCompressingSerializer() {
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   999,
+			EventTime: timestamp.TimePtr(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)),
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result:           payloads.EncodeString("result-1-event-1"),
+					ScheduledEventId: 4,
+					StartedEventId:   5,
+					Identity:         "event-1",
+				},
+			},
+		},
+	}
+	activityInfo := &persistencespb.ActivityInfo{
+		Version:    1,
+		ScheduleId: 4,
+		ActivityId: "activity-1",
+	}
+
+	for _, compression := range []Compression{CompressionZstd, CompressionSnappy} {
+		serializer := NewCompressingSerializer(compression, dynamicconfig.GetBoolPropertyFn(true), nil)
+
+		eventsBlob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		s.Equal(enumspb.ENCODING_TYPE_PROTO3, eventsBlob.EncodingType)
+		s.True(IsCompressedBlob(eventsBlob.Data))
+
+		uncompressedBlob, err := NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		size, err := DecompressedBlobSize(eventsBlob.Data)
+		s.NoError(err)
+		s.Equal(len(uncompressedBlob.Data), size)
+
+		activityInfoBlob, err := serializer.ActivityInfoToBlob(activityInfo, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		s.True(IsCompressedBlob(activityInfoBlob.Data))
+
+		// compressed blobs are readable by any serializer
+		for _, deserializer := range []Serializer{serializer, NewSerializer()} {
+			deserializedEvents, err := deserializer.DeserializeEvents(eventsBlob)
+			s.NoError(err)
+			s.Equal(events, deserializedEvents)
+
+			deserializedActivityInfo, err := deserializer.ActivityInfoFromBlob(activityInfoBlob)
+			s.NoError(err)
+			s.Equal(activityInfo, deserializedActivityInfo)
+		}
+	}
+}
+
+func (s *temporalSerializerSuite) TestCompressingSerializer_UncompressedBlob() {
+	activityInfo := &persistencespb.ActivityInfo{
+		Version:    1,
+		ScheduleId: 4,
+		ActivityId: "activity-1",
+	}
+
+	blob, err := NewSerializer().ActivityInfoToBlob(activityInfo, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	s.False(IsCompressedBlob(blob.Data))
+
+	deserializedActivityInfo, err := NewCompressingSerializer(CompressionZstd, dynamicconfig.GetBoolPropertyFn(true), nil).ActivityInfoFromBlob(blob)
+	s.NoError(err)
+	s.Equal(activityInfo, deserializedActivityInfo)
+}
+
+func (s *temporalSerializerSuite) TestCompressingSerializer_Disabled() {
+	activityInfo := &persistencespb.ActivityInfo{
+		Version:    1,
+		ScheduleId: 4,
+		ActivityId: "activity-1",
+	}
+
+	for _, enabled := range []dynamicconfig.BoolPropertyFn{nil, dynamicconfig.GetBoolPropertyFn(false)} {
+		blob, err := NewCompressingSerializer(CompressionZstd, enabled, nil).ActivityInfoToBlob(activityInfo, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		s.False(IsCompressedBlob(blob.Data))
+	}
+
+	// blobs compressed before compression was disabled stay readable
+	blob, err := NewCompressingSerializer(CompressionZstd, dynamicconfig.GetBoolPropertyFn(true), nil).ActivityInfoToBlob(activityInfo, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	s.True(IsCompressedBlob(blob.Data))
+	deserializedActivityInfo, err := NewCompressingSerializer(CompressionZstd, dynamicconfig.GetBoolPropertyFn(false), nil).ActivityInfoFromBlob(blob)
+	s.NoError(err)
+	s.Equal(activityInfo, deserializedActivityInfo)
+}
+
+func (s *temporalSerializerSuite) TestParseCompression() {
+	compression, err := ParseCompression("snappy")
+	s.NoError(err)
+	s.Equal(CompressionSnappy, compression)
+
+	compression, err = ParseCompression("")
+	s.NoError(err)
+	s.Equal(CompressionNone, compression)
+
+	_, err = ParseCompression("gzip")
+	s.Error(err)
+}
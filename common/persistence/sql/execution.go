@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"go.temporal.io/api/serviceerror"
@@ -651,8 +652,66 @@ func (m *sqlExecutionStore) GetCurrentExecution(
 	}, nil
 }
 
+// ListConcreteExecutions lists the executions of one namespace in the shard, the executions of all the namespaces
+// can't be listed without scanning the whole executions table.
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ *p.ListConcreteExecutionsRequest,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	if request.NamespaceID == "" {
+		return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is only implemented for the executions of one namespace")
+	}
+	namespaceID, err := primitives.ParseUUID(request.NamespaceID)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListConcreteExecutions: invalid namespace ID. Error: %v", err))
+	}
+
+	pageToken := &concreteExecutionsPageToken{RunID: make(primitives.UUID, 16)}
+	if len(request.PageToken) > 0 {
+		if err := pageToken.deserialize(request.PageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error deserializing concreteExecutionsPageToken: %v", err))
+		}
+	}
+
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	rows, err := m.Db.RangeSelectFromExecutions(ctx, sqlplugin.ExecutionsRangeFilter{
+		ShardID:       request.ShardID,
+		NamespaceID:   namespaceID,
+		MinWorkflowID: pageToken.WorkflowID,
+		MinRunID:      pageToken.RunID,
+		PageSize:      request.PageSize,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err))
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	for _, row := range rows {
+		response.States = append(response.States, &p.InternalWorkflowMutableState{
+			ExecutionInfo:  p.NewDataBlob(row.Data, row.DataEncoding),
+			ExecutionState: p.NewDataBlob(row.State, row.StateEncoding),
+			NextEventID:    row.NextEventID,
+		})
+	}
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		pageToken = &concreteExecutionsPageToken{WorkflowID: lastRow.WorkflowID, RunID: lastRow.RunID}
+		if response.NextPageToken, err = pageToken.serialize(); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error serializing concreteExecutionsPageToken: %v", err))
+		}
+	}
+	return response, nil
+}
+
+type concreteExecutionsPageToken struct {
+	WorkflowID string
+	RunID      primitives.UUID
+}
+
+func (t *concreteExecutionsPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *concreteExecutionsPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
 }
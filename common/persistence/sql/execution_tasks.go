@@ -516,6 +516,83 @@ func (m *sqlExecutionStore) RangeDeleteReplicationTaskFromDLQ(
 	return nil
 }
 
+func (m *sqlExecutionStore) PutHistoryTaskToDLQ(
+	request *p.PutHistoryTaskToDLQRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	blob, err := serialization.HistoryDLQTaskInfoToBlob(request.TaskInfo)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Db.InsertIntoHistoryTasksDLQ(ctx, []sqlplugin.HistoryTasksDLQRow{{
+		ShardID:      request.ShardID,
+		TaskID:       p.GetHistoryDLQTaskID(request.TaskInfo),
+		Data:         blob.Data,
+		DataEncoding: blob.EncodingType.String(),
+	}})
+
+	// The same task can be moved to DLQ again if the ack of the original task failed.
+	if err != nil && !m.Db.IsDupEntryError(err) {
+		return serviceerror.NewInternal(fmt.Sprintf("PutHistoryTaskToDLQ operation failed. Error: %v", err))
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) GetHistoryTasksFromDLQ(
+	request *p.GetHistoryTasksFromDLQRequest,
+) (*p.GetHistoryTasksFromDLQResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	readLevel := request.MinTaskID
+	if len(request.NextPageToken) > 0 {
+		var err error
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := m.Db.RangeSelectFromHistoryTasksDLQ(ctx, sqlplugin.HistoryTasksDLQRangeFilter{
+		ShardID:   request.ShardID,
+		MinTaskID: readLevel,
+		MaxTaskID: request.MaxTaskID,
+		PageSize:  request.BatchSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetHistoryTasksFromDLQ operation failed. Select failed: %v", err))
+	}
+
+	response := &p.GetHistoryTasksFromDLQResponse{}
+	for _, row := range rows {
+		info, err := serialization.HistoryDLQTaskInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		response.Tasks = append(response.Tasks, info)
+	}
+	if len(rows) == request.BatchSize {
+		response.NextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionStore) RangeDeleteHistoryTaskFromDLQ(
+	request *p.RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	if _, err := m.Db.RangeDeleteFromHistoryTasksDLQ(ctx, sqlplugin.HistoryTasksDLQRangeFilter{
+		ShardID:   request.ShardID,
+		MinTaskID: request.ExclusiveBeginTaskID,
+		MaxTaskID: request.InclusiveEndTaskID,
+	}); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("RangeDeleteHistoryTaskFromDLQ operation failed. Error: %v", err))
+	}
+	return nil
+}
+
 func (m *sqlExecutionStore) GetVisibilityTask(
 	request *persistence.GetVisibilityTaskRequest,
 ) (*persistence.GetVisibilityTaskResponse, error) {
@@ -189,6 +189,52 @@ func (m *sqlMetadataManagerV2) UpdateNamespace(request *persistence.InternalUpda
 	})
 }
 
+// RenameNamespace updates the name of the namespace row in place, relying on the unique name constraint to
+// reject names which are already taken
+func (m *sqlMetadataManagerV2) RenameNamespace(request *persistence.InternalRenameNamespaceRequest) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	idBytes, err := primitives.ParseUUID(request.Id)
+	if err != nil {
+		return err
+	}
+
+	return m.txExecute(ctx, "RenameNamespace", func(tx sqlplugin.Tx) error {
+		metadata, err := lockMetadata(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if metadata.NotificationVersion != request.NotificationVersion {
+			return fmt.Errorf(
+				"conditional update error: expect: %v, actual: %v",
+				request.NotificationVersion,
+				metadata.NotificationVersion,
+			)
+		}
+		result, err := tx.UpdateNamespace(ctx, &sqlplugin.NamespaceRow{
+			Name:                request.Name,
+			ID:                  idBytes,
+			Data:                request.Namespace.Data,
+			DataEncoding:        request.Namespace.EncodingType.String(),
+			NotificationVersion: request.NotificationVersion,
+		})
+		if err != nil {
+			if m.Db.IsDupEntryError(err) {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
+			return err
+		}
+		noRowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rowsAffected error: %v", err)
+		}
+		if noRowsAffected != 1 {
+			return fmt.Errorf("%v rows updated instead of one", noRowsAffected)
+		}
+		return updateMetadata(ctx, tx, metadata.NotificationVersion)
+	})
+}
+
 func (m *sqlMetadataManagerV2) DeleteNamespace(request *persistence.DeleteNamespaceRequest) error {
 	ctx, cancel := newExecutionContext()
 	defer cancel()
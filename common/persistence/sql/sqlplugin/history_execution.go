@@ -59,6 +59,16 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to filter results through a WHERE clause, rows are listed after MinWorkflowID and MinRunID
+	ExecutionsRangeFilter struct {
+		ShardID       int32
+		NamespaceID   primitives.UUID
+		MinWorkflowID string
+		MinRunID      primitives.UUID
+		PageSize      int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -92,6 +102,9 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns the rows of the namespace in the shard ordered by workflow ID and run ID
+		// Required params - {shardID, namespaceID, minWorkflowID, minRunID, pageSize}
+		RangeSelectFromExecutions(ctx context.Context, filter ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+)
+
+type (
+	// HistoryTasksDLQRow represents a row in history_tasks_dlq table
+	HistoryTasksDLQRow struct {
+		ShardID      int32
+		TaskID       int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// HistoryTasksDLQRangeFilter contains the column names within history_tasks_dlq table that
+	// can be used to filter results through a WHERE clause
+	HistoryTasksDLQRangeFilter struct {
+		ShardID   int32
+		MinTaskID int64
+		MaxTaskID int64
+		PageSize  int
+	}
+
+	// HistoryTaskDLQ is the SQL persistence interface for the DLQ of history transfer and timer tasks
+	HistoryTaskDLQ interface {
+		// InsertIntoHistoryTasksDLQ puts the transfer or timer tasks into DLQ
+		InsertIntoHistoryTasksDLQ(ctx context.Context, rows []HistoryTasksDLQRow) (sql.Result, error)
+		// RangeSelectFromHistoryTasksDLQ returns one or more rows from history_tasks_dlq table
+		RangeSelectFromHistoryTasksDLQ(ctx context.Context, filter HistoryTasksDLQRangeFilter) ([]HistoryTasksDLQRow, error)
+		// RangeDeleteFromHistoryTasksDLQ deletes one or more rows from history_tasks_dlq table
+		//  HistoryTasksDLQRangeFilter - {PageSize} will be ignored
+		RangeDeleteFromHistoryTasksDLQ(ctx context.Context, filter HistoryTasksDLQRangeFilter) (sql.Result, error)
+	}
+)
@@ -44,6 +44,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND namespace_id = ? AND ((workflow_id = ? AND run_id > ?) OR workflow_id > ?)
+ ORDER BY workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -175,6 +179,12 @@ VALUES     (:source_cluster_name,
 		AND shard_id = ? 
 		AND task_id > ?
 		AND task_id <= ?`
+
+	insertHistoryTaskDLQQuery = `INSERT INTO history_tasks_dlq(shard_id, task_id, data, data_encoding) 
+ VALUES(:shard_id, :task_id, :data, :data_encoding)`
+	getHistoryTasksDLQQuery = `SELECT task_id, data, data_encoding FROM history_tasks_dlq WHERE 
+shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+	rangeDeleteHistoryTaskFromDLQQuery = `DELETE FROM history_tasks_dlq WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
 )
 
 // InsertIntoExecutions inserts a row into executions table
@@ -218,6 +228,24 @@ func (mdb *db) SelectFromExecutions(
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads the rows of the namespace in the shard after the given workflow and run IDs
+func (mdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := mdb.conn.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.NamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.MinWorkflowID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -705,6 +733,47 @@ func (mdb *db) RangeDeleteFromReplicationDLQTasks(
 	)
 }
 
+// InsertIntoHistoryTasksDLQ inserts one or more rows into history_tasks_dlq table
+func (mdb *db) InsertIntoHistoryTasksDLQ(
+	ctx context.Context,
+	rows []sqlplugin.HistoryTasksDLQRow,
+) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx,
+		insertHistoryTaskDLQQuery,
+		rows,
+	)
+}
+
+// RangeSelectFromHistoryTasksDLQ reads one or more rows from history_tasks_dlq table
+func (mdb *db) RangeSelectFromHistoryTasksDLQ(
+	ctx context.Context,
+	filter sqlplugin.HistoryTasksDLQRangeFilter,
+) ([]sqlplugin.HistoryTasksDLQRow, error) {
+	var rows []sqlplugin.HistoryTasksDLQRow
+	err := mdb.conn.SelectContext(ctx,
+		&rows, getHistoryTasksDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
+// RangeDeleteFromHistoryTasksDLQ deletes one or more rows from history_tasks_dlq table
+func (mdb *db) RangeDeleteFromHistoryTasksDLQ(
+	ctx context.Context,
+	filter sqlplugin.HistoryTasksDLQRangeFilter,
+) (sql.Result, error) {
+
+	return mdb.conn.ExecContext(ctx,
+		rangeDeleteHistoryTaskFromDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+	)
+}
+
 // InsertIntoVisibilityTasks inserts one or more rows into visibility_tasks table
 func (mdb *db) InsertIntoVisibilityTasks(
 	ctx context.Context,
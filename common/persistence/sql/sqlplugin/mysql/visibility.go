@@ -29,22 +29,28 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE ` +
 		`run_id=VALUES(run_id)`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE workflow_id = VALUES(workflow_id), start_time = VALUES(start_time), execution_time = VALUES(execution_time), workflow_type_name = VALUES(workflow_type_name), ` +
-		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding)`
+		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding), ` +
+		`task_queue = VALUES(task_queue), search_attributes = VALUES(search_attributes)`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility ` +
+		`SET memo = ?, encoding = ?, task_queue = ?, search_attributes = ? ` +
+		`WHERE namespace_id = ? AND run_id = ? AND status = 1`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -61,7 +67,7 @@ const (
 	ORDER BY close_time DESC, run_id
 	LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -81,7 +87,7 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status != 1
 		 AND run_id = ?`
@@ -110,6 +116,8 @@ func (mdb *db) InsertIntoVisibility(
 		row.Status,
 		row.Memo,
 		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes,
 	)
 }
 
@@ -136,12 +144,30 @@ func (mdb *db) ReplaceIntoVisibility(
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskQueue,
+			row.SearchAttributes,
 		)
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpdateVisibility updates memo, task queue and search attributes of an open workflow row in visibility table
+func (mdb *db) UpdateVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes,
+		row.NamespaceID,
+		row.RunID,
+	)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -169,6 +195,12 @@ func (mdb *db) SelectFromVisibility(
 	}
 	// If filter.Status == 0 (UNSPECIFIED) then only closed workflows will be returned (all excluding 1 (RUNNING)).
 	switch {
+	case len(filter.Query) != 0:
+		err = mdb.conn.SelectContext(ctx,
+			&rows,
+			filter.Query,
+			mdb.convertQueryArgs(filter.QueryArgs)...,
+		)
 	case filter.MinTime == nil && filter.RunID != nil && filter.Status != 1:
 		var row sqlplugin.VisibilityRow
 		err = mdb.conn.GetContext(ctx,
@@ -264,3 +296,29 @@ func (mdb *db) SelectFromVisibility(
 	}
 	return rows, nil
 }
+
+// CountFromVisibility returns number of rows in visibility table matching advanced visibility query
+func (mdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	if len(filter.Query) == 0 {
+		return 0, fmt.Errorf("invalid query filter")
+	}
+	var count int64
+	err := mdb.conn.GetContext(ctx,
+		&count,
+		filter.Query,
+		mdb.convertQueryArgs(filter.QueryArgs)...,
+	)
+	return count, err
+}
+
+func (mdb *db) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	return args
+}
@@ -44,6 +44,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND namespace_id = $2 AND ((workflow_id = $3 AND run_id > $4) OR workflow_id > $5)
+ ORDER BY workflow_id, run_id LIMIT $6`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -174,6 +178,12 @@ VALUES     (:source_cluster_name,
 		AND shard_id = $2 
 		AND task_id > $3
 		AND task_id <= $4`
+
+	insertHistoryTaskDLQQuery = `INSERT INTO history_tasks_dlq(shard_id, task_id, data, data_encoding) 
+ VALUES(:shard_id, :task_id, :data, :data_encoding)`
+	getHistoryTasksDLQQuery = `SELECT task_id, data, data_encoding FROM history_tasks_dlq WHERE 
+shard_id = $1 AND task_id > $2 AND task_id <= $3 ORDER BY task_id LIMIT $4`
+	rangeDeleteHistoryTaskFromDLQQuery = `DELETE FROM history_tasks_dlq WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3`
 )
 
 // InsertIntoExecutions inserts a row into executions table
@@ -218,6 +228,24 @@ func (pdb *db) SelectFromExecutions(
 	return &row, nil
 }
 
+// RangeSelectFromExecutions reads the rows of the namespace in the shard after the given workflow and run IDs
+func (pdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := pdb.conn.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.NamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.MinWorkflowID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -706,6 +734,47 @@ func (pdb *db) RangeDeleteFromReplicationDLQTasks(
 	)
 }
 
+// InsertIntoHistoryTasksDLQ inserts one or more rows into history_tasks_dlq table
+func (pdb *db) InsertIntoHistoryTasksDLQ(
+	ctx context.Context,
+	rows []sqlplugin.HistoryTasksDLQRow,
+) (sql.Result, error) {
+	return pdb.conn.NamedExecContext(ctx,
+		insertHistoryTaskDLQQuery,
+		rows,
+	)
+}
+
+// RangeSelectFromHistoryTasksDLQ reads one or more rows from history_tasks_dlq table
+func (pdb *db) RangeSelectFromHistoryTasksDLQ(
+	ctx context.Context,
+	filter sqlplugin.HistoryTasksDLQRangeFilter,
+) ([]sqlplugin.HistoryTasksDLQRow, error) {
+	var rows []sqlplugin.HistoryTasksDLQRow
+	err := pdb.conn.SelectContext(ctx,
+		&rows, getHistoryTasksDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
+// RangeDeleteFromHistoryTasksDLQ deletes one or more rows from history_tasks_dlq table
+func (pdb *db) RangeDeleteFromHistoryTasksDLQ(
+	ctx context.Context,
+	filter sqlplugin.HistoryTasksDLQRangeFilter,
+) (sql.Result, error) {
+
+	return pdb.conn.ExecContext(ctx,
+		rangeDeleteHistoryTaskFromDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+	)
+}
+
 // InsertIntoVisibilityTasks inserts one or more rows into visibility_tasks table
 func (pdb *db) InsertIntoVisibilityTasks(
 	ctx context.Context,
@@ -30,19 +30,20 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
          ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (namespace_id, run_id) DO UPDATE 
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -52,7 +53,13 @@ const (
 			  status = excluded.status,
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
-			  encoding = excluded.encoding`
+			  encoding = excluded.encoding,
+			  task_queue = excluded.task_queue,
+			  search_attributes = excluded.search_attributes`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility
+		 SET memo = $1, encoding = $2, task_queue = $3, search_attributes = $4
+		 WHERE namespace_id = $5 AND run_id = $6 AND status = 1`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND namespace_id = $1
@@ -83,7 +90,7 @@ const (
          ORDER BY close_time DESC, run_id
          LIMIT $8`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -103,7 +110,7 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditionsClosedWorkflow2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = $1 AND status != 1
 		 AND run_id = $2`
@@ -131,6 +138,8 @@ func (pdb *db) InsertIntoVisibility(
 		row.Status,
 		row.Memo,
 		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes,
 	)
 }
 
@@ -156,12 +165,30 @@ func (pdb *db) ReplaceIntoVisibility(
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskQueue,
+			row.SearchAttributes,
 		)
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpdateVisibility updates memo, task queue and search attributes of an open workflow row in visibility table
+func (pdb *db) UpdateVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes,
+		row.NamespaceID,
+		row.RunID,
+	)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -189,6 +216,12 @@ func (pdb *db) SelectFromVisibility(
 	}
 	// If filter.Status == 0 (UNSPECIFIED) then only closed workflows will be returned (all excluding 1 (RUNNING)).
 	switch {
+	case len(filter.Query) != 0:
+		err = pdb.conn.SelectContext(ctx,
+			&rows,
+			filter.Query,
+			pdb.convertQueryArgs(filter.QueryArgs)...,
+		)
 	case filter.MinTime == nil && filter.RunID != nil && filter.Status != 1:
 		var row sqlplugin.VisibilityRow
 		err = pdb.conn.GetContext(ctx,
@@ -283,3 +316,29 @@ func (pdb *db) SelectFromVisibility(
 	}
 	return rows, nil
 }
+
+// CountFromVisibility returns number of rows in visibility table matching advanced visibility query
+func (pdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	if len(filter.Query) == 0 {
+		return 0, fmt.Errorf("invalid query filter")
+	}
+	var count int64
+	err := pdb.conn.GetContext(ctx,
+		&count,
+		filter.Query,
+		pdb.convertQueryArgs(filter.QueryArgs)...,
+	)
+	return count, err
+}
+
+func (pdb *db) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgreSQLDateTime(t)
+		}
+	}
+	return args
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// connPool keeps the connections to in-memory databases alive
+	// as long as at least one logical db is using them
+	connPool struct {
+		sync.Mutex
+
+		pool map[string]*connPoolEntry
+	}
+
+	connPoolEntry struct {
+		db       *sqlx.DB
+		refCount int
+	}
+)
+
+func newConnPool() *connPool {
+	return &connPool{
+		pool: make(map[string]*connPoolEntry),
+	}
+}
+
+// allocate returns the shared connection to the database, creating it if needed
+func (cp *connPool) allocate(
+	cfg *config.SQL,
+	createConn func(cfg *config.SQL) (*sqlx.DB, error),
+) (*sqlx.DB, error) {
+	cp.Lock()
+	defer cp.Unlock()
+
+	dsn := buildDSN(cfg)
+	if entry, ok := cp.pool[dsn]; ok {
+		entry.refCount++
+		return entry.db, nil
+	}
+
+	db, err := createConn(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cp.pool[dsn] = &connPoolEntry{db: db, refCount: 1}
+	return db, nil
+}
+
+// release decrements the reference count of the shared connection
+// and closes it once it is no longer used
+func (cp *connPool) release(cfg *config.SQL) {
+	cp.Lock()
+	defer cp.Unlock()
+
+	dsn := buildDSN(cfg)
+	entry, ok := cp.pool[dsn]
+	if !ok {
+		return
+	}
+	entry.refCount--
+	if entry.refCount > 0 {
+		return
+	}
+	delete(cp.pool, dsn)
+	_ = entry.db.Close()
+}
@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	sqliteschema "go.temporal.io/server/schema/sqlite"
+)
+
+// db represents a logical connection to sqlite database
+type db struct {
+	dbKind   sqlplugin.DbKind
+	dbName   string
+	inMemory bool
+
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqlplugin.Conn
+	converter DataConverter
+	onClose   func() error
+}
+
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.Tx = (*db)(nil)
+
+// IsDupEntryError returns true if the error is a primary key or unique constraint violation,
+// i.e. the row already exists
+func (mdb *db) IsDupEntryError(err error) bool {
+	return isDupEntryError(err)
+}
+
+// newDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+func newDB(
+	dbKind sqlplugin.DbKind,
+	dbName string,
+	inMemory bool,
+	xdb *sqlx.DB,
+	tx *sqlx.Tx,
+	onClose func() error,
+) *db {
+	mdb := &db{
+		dbKind:   dbKind,
+		dbName:   dbName,
+		inMemory: inMemory,
+		db:       xdb,
+		tx:       tx,
+		onClose:  onClose,
+	}
+	mdb.conn = xdb
+	if tx != nil {
+		mdb.conn = tx
+	}
+	mdb.converter = &converter{}
+	return mdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (mdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
+	xtx, err := mdb.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(mdb.dbKind, mdb.dbName, mdb.inMemory, mdb.db, xtx, mdb.onClose), nil
+}
+
+// Commit commits a previously started transaction
+func (mdb *db) Commit() error {
+	return mdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (mdb *db) Rollback() error {
+	return mdb.tx.Rollback()
+}
+
+// Close closes the connection to the sqlite db
+func (mdb *db) Close() error {
+	if mdb.onClose != nil {
+		return mdb.onClose()
+	}
+	return mdb.db.Close()
+}
+
+// PluginName returns the name of the sqlite plugin
+func (mdb *db) PluginName() string {
+	return PluginName
+}
+
+// ExpectedVersion returns expected version.
+func (mdb *db) ExpectedVersion() string {
+	switch mdb.dbKind {
+	case sqlplugin.DbKindMain:
+		return sqliteschema.Version
+	case sqlplugin.DbKindVisibility:
+		return sqliteschema.VisibilityVersion
+	default:
+		panic(fmt.Sprintf("unknown db kind %v", mdb.dbKind))
+	}
+}
+
+// VerifyVersion verify schema version is up to date
+func (mdb *db) VerifyVersion() error {
+	expectedVersion := mdb.ExpectedVersion()
+	return schema.VerifyCompatibleVersion(mdb, mdb.dbName, expectedVersion)
+}
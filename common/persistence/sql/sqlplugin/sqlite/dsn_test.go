@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+)
+
+type dsnSuite struct {
+	suite.Suite
+}
+
+func TestDSNSuite(t *testing.T) {
+	suite.Run(t, new(dsnSuite))
+}
+
+func (s *dsnSuite) TestBuildDSN_File() {
+	cfg := &config.SQL{
+		PluginName:        PluginName,
+		DatabaseName:      "/tmp/temporal.db",
+		ConnectAttributes: map[string]string{"_busy_timeout": "100", "_txlock": "deferred"},
+	}
+	s.False(isInMemory(cfg))
+	s.Equal("file:/tmp/temporal.db?_busy_timeout=100&_journal_mode=WAL&_txlock=immediate", buildDSN(cfg))
+}
+
+func (s *dsnSuite) TestBuildDSN_Memory() {
+	cfg := &config.SQL{
+		PluginName:        PluginName,
+		DatabaseName:      "default",
+		ConnectAttributes: map[string]string{"mode": "memory", "setup": "true"},
+	}
+	s.True(isInMemory(cfg))
+	s.Equal("file:default?_busy_timeout=10000&_txlock=immediate&cache=shared&mode=memory", buildDSN(cfg))
+}
+
+func (s *dsnSuite) TestBuildDSN_Scratch() {
+	cfg := &config.SQL{
+		PluginName: PluginName,
+	}
+	s.False(isInMemory(cfg))
+	s.Equal(inMemoryDatabaseName, buildDSN(cfg))
+
+	cfg.DatabaseName = inMemoryDatabaseName
+	s.True(isInMemory(cfg))
+	s.Equal(inMemoryDatabaseName, buildDSN(cfg))
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The bundled sqlite is built without the JSON1 extension, search attributes stored as JSON
+// in search_attributes column are accessed with the functions below which are registered on
+// every connection. All of them take the search_attributes column and the search attribute name.
+// Functions implemented in Go have a fixed result type and can't return NULL for missing values,
+// therefore values must only be read after SearchAttributeExistsFunc returned true.
+const (
+	// SearchAttributeExistsFunc returns true if search attribute has a value.
+	SearchAttributeExistsFunc = "temporal_search_attribute_exists"
+	// SearchAttributeTextFunc returns value of string, keyword and datetime search attributes.
+	// Lists are returned as JSON arrays.
+	SearchAttributeTextFunc = "temporal_search_attribute_text"
+	// SearchAttributeIntegerFunc returns value of int and bool (as 0 or 1) search attributes.
+	SearchAttributeIntegerFunc = "temporal_search_attribute_integer"
+	// SearchAttributeRealFunc returns value of double search attributes.
+	SearchAttributeRealFunc = "temporal_search_attribute_real"
+	// SearchAttributeContainsFunc takes additional value argument and returns true if keyword search attribute
+	// is equal to the value or, if search attribute holds list of keywords, contains it.
+	SearchAttributeContainsFunc = "temporal_search_attribute_contains"
+)
+
+// searchAttributeValue returns value of search attribute decoded from JSON with numbers kept as json.Number.
+// Arguments are passed as interface{} because search_attributes column is NULL if there are no search attributes.
+func searchAttributeValue(data interface{}, name interface{}) (interface{}, error) {
+	doc, ok := data.(string)
+	if !ok {
+		return nil, nil
+	}
+	var values map[string]interface{}
+	d := json.NewDecoder(strings.NewReader(doc))
+	d.UseNumber()
+	if err := d.Decode(&values); err != nil {
+		return nil, err
+	}
+	return values[fmt.Sprint(name)], nil
+}
+
+func searchAttributeExists(data interface{}, name interface{}) (bool, error) {
+	value, err := searchAttributeValue(data, name)
+	return value != nil, err
+}
+
+func searchAttributeText(data interface{}, name interface{}) (string, error) {
+	value, err := searchAttributeValue(data, name)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		encoded, err := json.Marshal(v)
+		return string(encoded), err
+	}
+}
+
+func searchAttributeInteger(data interface{}, name interface{}) (int64, error) {
+	value, err := searchAttributeValue(data, name)
+	if err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case json.Number:
+		return v.Int64()
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	default:
+		return 0, fmt.Errorf("search attribute %v of type %T is not an integer", name, v)
+	}
+}
+
+func searchAttributeReal(data interface{}, name interface{}) (float64, error) {
+	value, err := searchAttributeValue(data, name)
+	if err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("search attribute %v of type %T is not a number", name, v)
+	}
+}
+
+func searchAttributeContains(data interface{}, name interface{}, expected interface{}) (bool, error) {
+	value, err := searchAttributeValue(data, name)
+	if err != nil {
+		return false, err
+	}
+	expectedString := fmt.Sprint(expected)
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if fmt.Sprint(item) == expectedString {
+				return true, nil
+			}
+		}
+		return false, nil
+	case nil:
+		return false, nil
+	default:
+		return fmt.Sprint(v) == expectedString, nil
+	}
+}
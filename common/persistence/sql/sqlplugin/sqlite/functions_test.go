@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type functionsSuite struct {
+	suite.Suite
+}
+
+const testSearchAttributes = `{"CustomKeywordField":["foo","bar"],"CustomStringField":"baz","CustomIntField":9007199254740993,` +
+	`"CustomDoubleField":1.5,"CustomBoolField":true}`
+
+func TestFunctionsSuite(t *testing.T) {
+	suite.Run(t, new(functionsSuite))
+}
+
+func (s *functionsSuite) TestSearchAttributeExists() {
+	exists, err := searchAttributeExists(testSearchAttributes, "CustomStringField")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = searchAttributeExists(testSearchAttributes, "MissingField")
+	s.NoError(err)
+	s.False(exists)
+
+	exists, err = searchAttributeExists(nil, "CustomStringField")
+	s.NoError(err)
+	s.False(exists)
+
+	_, err = searchAttributeExists("{", "CustomStringField")
+	s.Error(err)
+}
+
+func (s *functionsSuite) TestSearchAttributeValues() {
+	text, err := searchAttributeText(testSearchAttributes, "CustomStringField")
+	s.NoError(err)
+	s.Equal("baz", text)
+
+	text, err = searchAttributeText(testSearchAttributes, "CustomKeywordField")
+	s.NoError(err)
+	s.Equal(`["foo","bar"]`, text)
+
+	integer, err := searchAttributeInteger(testSearchAttributes, "CustomIntField")
+	s.NoError(err)
+	s.Equal(int64(9007199254740993), integer)
+
+	integer, err = searchAttributeInteger(testSearchAttributes, "CustomBoolField")
+	s.NoError(err)
+	s.Equal(int64(1), integer)
+
+	double, err := searchAttributeReal(testSearchAttributes, "CustomDoubleField")
+	s.NoError(err)
+	s.Equal(1.5, double)
+
+	_, err = searchAttributeInteger(testSearchAttributes, "CustomKeywordField")
+	s.Error(err)
+}
+
+func (s *functionsSuite) TestSearchAttributeContains() {
+	contains, err := searchAttributeContains(testSearchAttributes, "CustomKeywordField", "bar")
+	s.NoError(err)
+	s.True(contains)
+
+	contains, err = searchAttributeContains(testSearchAttributes, "CustomKeywordField", "baz")
+	s.NoError(err)
+	s.False(contains)
+
+	contains, err = searchAttributeContains(testSearchAttributes, "CustomStringField", "baz")
+	s.NoError(err)
+	s.True(contains)
+
+	contains, err = searchAttributeContains(testSearchAttributes, "MissingField", "baz")
+	s.NoError(err)
+	s.False(contains)
+}
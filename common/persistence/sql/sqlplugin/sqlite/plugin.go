@@ -0,0 +1,287 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+	sqliteschema "go.temporal.io/server/schema/sqlite"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	// goSQLDriverName is the sqlite3 driver with search attribute functions registered on every connection
+	goSQLDriverName = "sqlite3_temporal"
+
+	// inMemoryDatabaseName opens a database which only lives as long as the process
+	inMemoryDatabaseName = ":memory:"
+
+	// connectAttributeMode selects between file backed (default) and in-memory databases,
+	// set to "memory" to keep the database named by DatabaseName in memory
+	connectAttributeMode = "mode"
+	modeMemory           = "memory"
+	// connectAttributeSetup set to "true" creates the schema when the database is opened
+	connectAttributeSetup = "setup"
+
+	defaultBusyTimeoutMillis = 10000
+)
+
+var (
+	// sqlite keeps the write ahead log & shared memory index next to the database file
+	databaseFileSuffixes = []string{"", "-wal", "-shm"}
+
+	// NOTE: sqlite has no row level locks, all transactions are started with BEGIN IMMEDIATE
+	//  so the write lock on the whole database is held for the duration of a transaction
+	dsnAttrOverrides = map[string]string{
+		"_txlock": "immediate",
+	}
+	dsnAttrDefaults = map[string]string{
+		"_busy_timeout": strconv.Itoa(defaultBusyTimeoutMillis),
+	}
+	fileDSNAttrDefaults = map[string]string{
+		"_journal_mode": "WAL",
+	}
+)
+
+type plugin struct {
+	connPool *connPool
+}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.DB, error) {
+	return p.createDB(dbKind, cfg)
+}
+
+// CreateAdminDB initialize the db object
+func (p *plugin) CreateAdminDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.AdminDB, error) {
+	return p.createDB(dbKind, cfg)
+}
+
+func (p *plugin) createDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+) (*db, error) {
+	if !isInMemory(cfg) {
+		conn, err := p.createDBConnection(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if err := setupSchema(dbKind, cfg, conn); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		return newDB(dbKind, cfg.DatabaseName, false, conn, nil, nil), nil
+	}
+
+	// in-memory databases are destroyed together with their last connection,
+	// connections are shared across all logical dbs pointing to the same database name
+	conn, err := p.connPool.allocate(cfg, p.createDBConnection)
+	if err != nil {
+		return nil, err
+	}
+	if err := setupSchema(dbKind, cfg, conn); err != nil {
+		p.connPool.release(cfg)
+		return nil, err
+	}
+	onClose := func() error {
+		p.connPool.release(cfg)
+		return nil
+	}
+	return newDB(dbKind, cfg.DatabaseName, true, conn, nil, onClose), nil
+}
+
+// CreateDBConnection creates a returns a reference to a logical connection to the
+// underlying SQL database. The returned object is to tied to a single
+// SQL database and the object can be used to perform CRUD operations on
+// the tables in the database
+func (p *plugin) createDBConnection(cfg *config.SQL) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(goSQLDriverName, buildDSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	if isInMemory(cfg) {
+		// NOTE: a shared cache in-memory database uses table level locks which are not subject to
+		//  busy timeouts, all access is serialized through a single connection which is never recycled
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxLifetime(0)
+	} else {
+		if cfg.MaxConns > 0 {
+			db.SetMaxOpenConns(cfg.MaxConns)
+		}
+		if cfg.MaxIdleConns > 0 {
+			db.SetMaxIdleConns(cfg.MaxIdleConns)
+		}
+		if cfg.MaxConnLifetime > 0 {
+			db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+		}
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func isInMemory(cfg *config.SQL) bool {
+	return cfg.DatabaseName == inMemoryDatabaseName ||
+		strings.ToLower(cfg.ConnectAttributes[connectAttributeMode]) == modeMemory
+}
+
+func buildDSN(cfg *config.SQL) string {
+	if cfg.DatabaseName == "" || cfg.DatabaseName == inMemoryDatabaseName {
+		// private in-memory database, also used as scratch connection by admin tools
+		// which connect without a database name to create or drop databases
+		return inMemoryDatabaseName
+	}
+	if isInMemory(cfg) {
+		return buildMemoryDSN(cfg.DatabaseName, cfg.ConnectAttributes)
+	}
+	return buildFileDSN(cfg.DatabaseName, cfg.ConnectAttributes)
+}
+
+func buildFileDSN(
+	dbName string,
+	connectAttributes map[string]string,
+) string {
+	attrs := buildDSNAttrs(connectAttributes)
+	for k, v := range fileDSNAttrDefaults {
+		if _, ok := attrs[k]; !ok {
+			attrs.Set(k, v)
+		}
+	}
+	return "file:" + dbName + "?" + attrs.Encode()
+}
+
+func buildMemoryDSN(
+	dbName string,
+	connectAttributes map[string]string,
+) string {
+	attrs := buildDSNAttrs(connectAttributes)
+	attrs.Set(connectAttributeMode, modeMemory)
+	attrs.Set("cache", "shared")
+	return "file:" + dbName + "?" + attrs.Encode()
+}
+
+func buildDSNAttrs(connectAttributes map[string]string) url.Values {
+	attrs := url.Values{}
+	for k, v := range connectAttributes {
+		if strings.TrimSpace(strings.ToLower(k)) == connectAttributeSetup {
+			continue
+		}
+		attrs.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	for k, v := range dsnAttrDefaults {
+		if _, ok := attrs[k]; !ok {
+			attrs.Set(k, v)
+		}
+	}
+	// these attrs are always overridden
+	for k, v := range dsnAttrOverrides {
+		attrs.Set(k, v)
+	}
+	return attrs
+}
+
+// setupSchema creates the schema of the given db kind if requested through the setup connect attribute,
+// the schema version tables are populated so that the version check at startup passes
+func setupSchema(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	conn *sqlx.DB,
+) error {
+	if setup, _ := strconv.ParseBool(cfg.ConnectAttributes[connectAttributeSetup]); !setup {
+		return nil
+	}
+
+	var schemaContent string
+	var version string
+	switch dbKind {
+	case sqlplugin.DbKindMain:
+		schemaContent = sqliteschema.ExecutionSchema
+		version = sqliteschema.Version
+	case sqlplugin.DbKindVisibility:
+		schemaContent = sqliteschema.VisibilitySchema
+		version = sqliteschema.VisibilityVersion
+	default:
+		return nil
+	}
+
+	adminDB := newDB(dbKind, cfg.DatabaseName, isInMemory(cfg), conn, nil, nil)
+	tables, err := adminDB.ListTables(cfg.DatabaseName)
+	if err != nil {
+		return err
+	}
+	if schemaTablesExist(tables, dbKind) {
+		return nil
+	}
+
+	for _, stmt := range persistence.SplitQuery(schemaContent) {
+		if err := adminDB.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	if err := adminDB.CreateSchemaVersionTables(); err != nil {
+		return err
+	}
+	return adminDB.UpdateSchemaVersion(cfg.DatabaseName, version, version)
+}
+
+func schemaTablesExist(
+	tables []string,
+	dbKind sqlplugin.DbKind,
+) bool {
+	// the first table created by each schema is used as marker
+	marker := "namespaces"
+	if dbKind == sqlplugin.DbKindVisibility {
+		marker = "executions_visibility"
+	}
+	for _, table := range tables {
+		if table == marker {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build cgo
+// +build cgo
+
+package sqlite
+
+import (
+	dbsql "database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+
+	"go.temporal.io/server/common/persistence/sql"
+)
+
+func init() {
+	dbsql.Register(goSQLDriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: registerSearchAttributeFuncs,
+	})
+	sqlx.BindDriver(goSQLDriverName, sqlx.QUESTION)
+	sql.RegisterPlugin(PluginName, &plugin{
+		connPool: newConnPool(),
+	})
+}
+
+func registerSearchAttributeFuncs(conn *sqlite3.SQLiteConn) error {
+	funcs := map[string]interface{}{
+		SearchAttributeExistsFunc:   searchAttributeExists,
+		SearchAttributeTextFunc:     searchAttributeText,
+		SearchAttributeIntegerFunc:  searchAttributeInteger,
+		SearchAttributeRealFunc:     searchAttributeReal,
+		SearchAttributeContainsFunc: searchAttributeContains,
+	}
+	for name, impl := range funcs {
+		if err := conn.RegisterFunc(name, impl, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isDupEntryError(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && (sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+		sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique)
+}
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build !cgo
+// +build !cgo
+
+package sqlite
+
+import (
+	"errors"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+)
+
+// sqlite driver is implemented with cgo, binaries built with CGO_ENABLED=0 register a plugin
+// which fails to create databases so that configs referring to sqlite get a meaningful error
+type unsupportedPlugin struct{}
+
+var _ sqlplugin.Plugin = (*unsupportedPlugin)(nil)
+
+var errCgoDisabled = errors.New("sqlite plugin requires binary built with CGO_ENABLED=1")
+
+func init() {
+	sql.RegisterPlugin(PluginName, &unsupportedPlugin{})
+}
+
+// CreateDB returns error as sqlite is not available without cgo
+func (p *unsupportedPlugin) CreateDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.DB, error) {
+	return nil, errCgoDisabled
+}
+
+// CreateAdminDB returns error as sqlite is not available without cgo
+func (p *unsupportedPlugin) CreateAdminDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.AdminDB, error) {
+	return nil, errCgoDisabled
+}
+
+func isDupEntryError(err error) bool {
+	return false
+}
@@ -145,6 +145,44 @@ func (s *historyExecutionSuite) TestInsertSelect() {
 	s.Equal(&execution, row)
 }
 
+func (s *historyExecutionSuite) TestInsertRangeSelect() {
+	shardID := rand.Int31()
+	namespaceID := primitives.NewUUID()
+	workflowID := shuffle.String(testHistoryExecutionWorkflowID)
+	numExecutions := 5
+	pageSize := 2
+
+	var executions []sqlplugin.ExecutionsRow
+	for i := 0; i < numExecutions; i++ {
+		execution := s.newRandomExecutionRow(shardID, namespaceID, workflowID, primitives.NewUUID(), rand.Int63(), rand.Int63())
+		_, err := s.store.InsertIntoExecutions(newExecutionContext(), &execution)
+		s.NoError(err)
+		executions = append(executions, execution)
+	}
+	otherNamespaceExecution := s.newRandomExecutionRow(shardID, primitives.NewUUID(), workflowID, primitives.NewUUID(), rand.Int63(), rand.Int63())
+	_, err := s.store.InsertIntoExecutions(newExecutionContext(), &otherNamespaceExecution)
+	s.NoError(err)
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		MinRunID:    make(primitives.UUID, 16),
+		PageSize:    pageSize,
+	}
+	var rows []sqlplugin.ExecutionsRow
+	for {
+		page, err := s.store.RangeSelectFromExecutions(newExecutionContext(), filter)
+		s.NoError(err)
+		rows = append(rows, page...)
+		if len(page) < pageSize {
+			break
+		}
+		filter.MinWorkflowID = page[len(page)-1].WorkflowID
+		filter.MinRunID = page[len(page)-1].RunID
+	}
+	s.ElementsMatch(executions, rows)
+}
+
 func (s *historyExecutionSuite) TestInsertUpdate_Success() {
 	shardID := rand.Int31()
 	namespaceID := primitives.NewUUID()
@@ -54,6 +54,7 @@ const (
 	testVisibilityEncoding         = "random encoding"
 	testVisibilityWorkflowTypeName = "random workflow type name"
 	testVisibilityWorkflowID       = "random workflow ID"
+	testVisibilityTaskQueue        = "random task queue"
 )
 
 var (
@@ -293,6 +294,104 @@ func (s *visibilitySuite) TestReplaceSelect_Exists() {
 	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
 }
 
+func (s *visibilitySuite) TestInsertUpdateSelect() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	workflowID := shuffle.String(testVisibilityWorkflowID)
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	closeTime := (*time.Time)(nil)
+	historyLength := (*int64)(nil)
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		workflowTypeName,
+		workflowID,
+		startTime,
+		executionTime,
+		status,
+		closeTime,
+		historyLength,
+	)
+	result, err := s.store.InsertIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	visibility.Memo = shuffle.Bytes(testVisibilityData)
+	visibility.TaskQueue = shuffle.String(testVisibilityTaskQueue)
+	visibility.SearchAttributes = convert.StringPtr(`{"CustomKeywordField": "random keyword"}`)
+	result, err = s.store.UpdateVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	selectFilter := sqlplugin.VisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		RunID:       convert.StringPtr(""),
+		MinTime:     timestamp.TimePtr(startTime),
+		MaxTime:     timestamp.TimePtr(startTime),
+		Status:      int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		PageSize:    convert.IntPtr(1),
+	}
+	rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].NamespaceID = namespaceID.String()
+	}
+	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
+}
+
+func (s *visibilitySuite) TestUpdateSelect_Closed() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	workflowID := shuffle.String(testVisibilityWorkflowID)
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	closeTime := timestamp.TimePtr(startTime.Add(time.Minute))
+	historyLength := convert.Int64Ptr(rand.Int63())
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		workflowTypeName,
+		workflowID,
+		startTime,
+		executionTime,
+		status,
+		closeTime,
+		historyLength,
+	)
+	_, err := s.store.ReplaceIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+
+	update := visibility
+	update.TaskQueue = shuffle.String(testVisibilityTaskQueue)
+	result, err := s.store.UpdateVisibility(newVisibilityContext(), &update)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	selectFilter := sqlplugin.VisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		RunID:       convert.StringPtr(runID.String()),
+	}
+	rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].NamespaceID = namespaceID.String()
+	}
+	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
+}
+
 func (s *visibilitySuite) TestDeleteSelect() {
 	namespaceID := primitives.NewUUID()
 	runID := primitives.NewUUID()
@@ -1376,5 +1475,6 @@ func (s *visibilitySuite) newRandomVisibilityRow(
 		HistoryLength:    historyLength,
 		Memo:             shuffle.Bytes(testVisibilityData),
 		Encoding:         testVisibilityEncoding,
+		TaskQueue:        shuffle.String(testVisibilityTaskQueue),
 	}
 }
@@ -44,6 +44,9 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskQueue        string
+		// SearchAttributes is JSON encoded map of search attribute values, nil if workflow has none
+		SearchAttributes *string
 	}
 
 	// VisibilitySelectFilter contains the column names within executions_visibility table that
@@ -57,6 +60,10 @@ type (
 		MinTime          *time.Time
 		MaxTime          *time.Time
 		PageSize         *int
+		// Query and QueryArgs are used by advanced visibility: Query is complete SELECT statement
+		// built by visibility store for particular database and is executed as is
+		Query     string
+		QueryArgs []interface{}
 	}
 
 	VisibilityDeleteFilter struct {
@@ -70,6 +77,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibility updates memo, task queue and search attributes of open workflow row.
+		// Closed workflow rows are left as such
+		UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {namespaceID, runID, closed=true}
@@ -78,7 +88,10 @@ type (
 		//     - namespaceID, minStartTime, maxStartTime, runID and pageSize where some or all of these may come from previous page token
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, status (along with closed=true)
+		// - advanced visibility query - {query, queryArgs}
 		SelectFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityRow, error)
+		// CountFromVisibility returns number of rows matching advanced visibility query - {query, queryArgs}
+		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 	}
 )
@@ -40,13 +40,15 @@ import (
 type (
 	VisibilityQueryValidator struct {
 		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
 	}
 )
 
 // NewQueryValidator create VisibilityQueryValidator
-func NewQueryValidator(searchAttributesProvider searchattribute.Provider) *VisibilityQueryValidator {
+func NewQueryValidator(searchAttributesProvider searchattribute.Provider, searchAttributesMapper searchattribute.Mapper) *VisibilityQueryValidator {
 	return &VisibilityQueryValidator{
 		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
 	}
 }
 
@@ -54,7 +56,7 @@ func NewQueryValidator(searchAttributesProvider searchattribute.Provider) *Visib
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *workflowservice.ListWorkflowExecutionsRequest, indexName string) error {
 	whereClause := listRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName)
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName, listRequest.GetNamespace())
 	if err != nil {
 		return err
 	}
@@ -64,7 +66,7 @@ func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *wor
 
 func (qv *VisibilityQueryValidator) ValidateScanRequestForQuery(listRequest *workflowservice.ScanWorkflowExecutionsRequest, indexName string) error {
 	whereClause := listRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName)
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName, listRequest.GetNamespace())
 	if err != nil {
 		return err
 	}
@@ -76,7 +78,7 @@ func (qv *VisibilityQueryValidator) ValidateScanRequestForQuery(listRequest *wor
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *workflowservice.CountWorkflowExecutionsRequest, indexName string) error {
 	whereClause := countRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName)
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, indexName, countRequest.GetNamespace())
 	if err != nil {
 		return err
 	}
@@ -85,8 +87,8 @@ func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *w
 }
 
 // validateListOrCountRequestForQuery valid sql for visibility API
-// it also adds attr prefix for customized fields
-func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string, indexName string) (string, error) {
+// it also replaces custom search attributes registered in the namespace with names of the fields which store them
+func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string, indexName string, namespace string) (string, error) {
 	if len(whereClause) != 0 {
 		// Build a placeholder query that allows us to easily parse the contents of the where clause.
 		// IMPORTANT: This query is never executed, it is just used to parse and validate whereClause
@@ -111,14 +113,14 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 		buf := sqlparser.NewTrackedBuffer(nil)
 		// validate where expr
 		if sel.Where != nil {
-			err = qv.validateWhereExpr(sel.Where.Expr, indexName)
+			err = qv.validateWhereExpr(sel.Where.Expr, indexName, namespace)
 			if err != nil {
 				return "", serviceerror.NewInvalidArgument(err.Error())
 			}
 			sel.Where.Expr.Format(buf)
 		}
 		// validate order by
-		err = qv.validateOrderByExpr(sel.OrderBy, indexName)
+		err = qv.validateOrderByExpr(sel.OrderBy, indexName, namespace)
 		if err != nil {
 			return "", serviceerror.NewInvalidArgument(err.Error())
 		}
@@ -129,27 +131,27 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 	return whereClause, nil
 }
 
-func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr, indexName string) error {
+func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr, indexName string, namespace string) error {
 	if expr == nil {
 		return nil
 	}
 
 	switch expr := expr.(type) {
 	case *sqlparser.AndExpr, *sqlparser.OrExpr:
-		return qv.validateAndOrExpr(expr, indexName)
+		return qv.validateAndOrExpr(expr, indexName, namespace)
 	case *sqlparser.ComparisonExpr:
-		return qv.validateComparisonExpr(expr, indexName)
+		return qv.validateComparisonExpr(expr, indexName, namespace)
 	case *sqlparser.RangeCond:
-		return qv.validateRangeExpr(expr, indexName)
+		return qv.validateRangeExpr(expr, indexName, namespace)
 	case *sqlparser.ParenExpr:
-		return qv.validateWhereExpr(expr.Expr, indexName)
+		return qv.validateWhereExpr(expr.Expr, indexName, namespace)
 	default:
 		return errors.New("invalid where clause")
 	}
 
 }
 
-func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr, indexName string) error {
+func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr, indexName string, namespace string) error {
 	var leftExpr sqlparser.Expr
 	var rightExpr sqlparser.Expr
 
@@ -162,13 +164,13 @@ func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr, index
 		rightExpr = expr.Right
 	}
 
-	if err := qv.validateWhereExpr(leftExpr, indexName); err != nil {
+	if err := qv.validateWhereExpr(leftExpr, indexName, namespace); err != nil {
 		return err
 	}
-	return qv.validateWhereExpr(rightExpr, indexName)
+	return qv.validateWhereExpr(rightExpr, indexName, namespace)
 }
 
-func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr, indexName string) error {
+func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr, indexName string, namespace string) error {
 	comparisonExpr := expr.(*sqlparser.ComparisonExpr)
 	colName, ok := comparisonExpr.Left.(*sqlparser.ColName)
 	if !ok {
@@ -179,13 +181,13 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr,
 	if err != nil {
 		return err
 	}
-	if !searchAttributes.IsDefined(colNameStr) {
+	if !qv.resolveColName(colName, searchAttributes, namespace) {
 		return fmt.Errorf("invalid search attribute: %s", colNameStr)
 	}
 	return nil
 }
 
-func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, indexName string) error {
+func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, indexName string, namespace string) error {
 	rangeCond := expr.(*sqlparser.RangeCond)
 	colName, ok := rangeCond.Left.(*sqlparser.ColName)
 	if !ok {
@@ -196,13 +198,13 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, index
 	if err != nil {
 		return err
 	}
-	if !searchAttributes.IsDefined(colNameStr) {
+	if !qv.resolveColName(colName, searchAttributes, namespace) {
 		return fmt.Errorf("invalid search attribute: %s", colNameStr)
 	}
 	return nil
 }
 
-func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy, indexName string) error {
+func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy, indexName string, namespace string) error {
 	searchAttributes, err := qv.searchAttributesProvider.GetSearchAttributes(indexName, false)
 	for _, orderByExpr := range orderBy {
 		colName, ok := orderByExpr.Expr.(*sqlparser.ColName)
@@ -213,9 +215,27 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 		if err != nil {
 			return err
 		}
-		if !searchAttributes.IsDefined(colNameStr) {
+		if !qv.resolveColName(colName, searchAttributes, namespace) {
 			return fmt.Errorf("invalid order by attribute: %s", colNameStr)
 		}
 	}
 	return nil
 }
+
+// resolveColName checks that column is a valid search attribute. Custom search attribute registered
+// in the namespace is replaced with the name of the field which stores it.
+func (qv *VisibilityQueryValidator) resolveColName(colName *sqlparser.ColName, searchAttributes searchattribute.NameTypeMap, namespace string) bool {
+	colNameStr := colName.Name.String()
+	if searchAttributes.IsDefined(colNameStr) {
+		return true
+	}
+	if qv.searchAttributesMapper == nil {
+		return false
+	}
+	fieldName, err := qv.searchAttributesMapper.GetFieldName(colNameStr, namespace)
+	if err != nil {
+		return false
+	}
+	colName.Name = sqlparser.NewColIdent(fieldName)
+	return true
+}
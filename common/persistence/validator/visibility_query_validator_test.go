@@ -61,7 +61,7 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 		Return(searchattribute.TestNameTypeMap, nil).
 		AnyTimes()
 
-	qv := NewQueryValidator(searchAttributesProvider)
+	qv := NewQueryValidator(searchAttributesProvider, nil)
 
 	listRequest := &workflowservice.ListWorkflowExecutionsRequest{}
 	s.Nil(qv.ValidateListRequestForQuery(listRequest, "index-name"))
@@ -157,7 +157,7 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery_NoCustomSearchAttr
 		Return(searchattribute.NameTypeMap{}, nil).
 		AnyTimes()
 
-	qv := NewQueryValidator(searchAttributesProvider)
+	qv := NewQueryValidator(searchAttributesProvider, nil)
 
 	// system search attributes should pass through.
 	listRequest := &workflowservice.ListWorkflowExecutionsRequest{}
@@ -171,3 +171,29 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery_NoCustomSearchAttr
 	listRequest.Query = query
 	s.Error(qv.ValidateListRequestForQuery(listRequest, "index-name"))
 }
+
+func (s *queryValidatorSuite) TestValidateListRequestForQuery_NamespaceSearchAttributes() {
+	searchAttributesProvider := searchattribute.NewMockProvider(s.controller)
+	searchAttributesProvider.EXPECT().GetSearchAttributes("index-name", false).
+		Return(searchattribute.TestNameTypeMap, nil).
+		AnyTimes()
+	searchAttributesMapper := searchattribute.NewMockMapper(s.controller)
+	searchAttributesMapper.EXPECT().GetFieldName("AliasKeywordField", "test-namespace").
+		Return("Keyword01", nil).
+		AnyTimes()
+	searchAttributesMapper.EXPECT().GetFieldName("UnknownField", "test-namespace").
+		Return("", searchattribute.ErrInvalidName).
+		AnyTimes()
+
+	qv := NewQueryValidator(searchAttributesProvider, searchAttributesMapper)
+
+	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: "test-namespace",
+		Query:     "AliasKeywordField = 'value' and CustomIntField > 1 order by AliasKeywordField desc",
+	}
+	s.NoError(qv.ValidateListRequestForQuery(listRequest, "index-name"))
+	s.Equal("Keyword01 = 'value' and CustomIntField > 1 order by Keyword01 desc", listRequest.GetQuery())
+
+	listRequest.Query = "UnknownField = 'value'"
+	s.Error(qv.ValidateListRequestForQuery(listRequest, "index-name"))
+}
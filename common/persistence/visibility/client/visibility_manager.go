@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/cassandra"
 	"go.temporal.io/server/common/persistence/visibility/sql"
@@ -43,6 +44,8 @@ func NewVisibilityManager(
 	persistenceMaxQPS dynamicconfig.IntPropertyFn,
 	metricsClient metrics.Client,
 	r resolver.ServiceResolver,
+	searchAttributesProvider searchattribute.Provider,
+	visibilityIndexName string,
 	logger log.Logger,
 ) (visibility.VisibilityManager, error) {
 	if cfg.VisibilityConfig == nil {
@@ -64,7 +67,7 @@ func NewVisibilityManager(
 	case visibilityStoreCfg.Cassandra != nil:
 		store, err = cassandra.NewVisibilityStore(*visibilityStoreCfg.Cassandra, r, logger)
 	case visibilityStoreCfg.SQL != nil:
-		store, err = sql.NewSQLVisibilityStore(*visibilityStoreCfg.SQL, r, logger)
+		store, err = sql.NewSQLVisibilityStore(*visibilityStoreCfg.SQL, r, visibilityIndexName, searchAttributesProvider, logger)
 	}
 
 	if err != nil {
@@ -76,8 +79,13 @@ func NewVisibilityManager(
 		return nil, nil
 	}
 
-	// This visibility manager is used by DB visibility only and doesn't care about search attributes.
-	result := visibility.NewVisibilityManagerImpl(store, searchattribute.NewSystemProvider(), "", logger)
+	// SQL visibility store persists search attributes using the same search attributes provider and index name as Elasticsearch.
+	result := visibility.NewVisibilityManagerImpl(store, searchAttributesProvider, nil, visibilityIndexName, logger)
+
+	if cfg.FaultInjection != nil {
+		faultInjector := persistence.NewFaultInjector(cfg.FaultInjection, logger)
+		result = visibility.NewVisibilityPersistenceFaultInjectionClient(result, faultInjector, logger)
+	}
 
 	if persistenceMaxQPS != nil && persistenceMaxQPS() > 0 {
 		rateLimiter := quotas.NewDefaultOutgoingDynamicRateLimiter(
@@ -63,5 +63,8 @@ type (
 		ID          string
 		Version     int64
 		Doc         map[string]interface{}
+		// FailOnVersionConflict makes visibility processor fail the request if the document
+		// already has the same or newer version instead of ignoring the conflict.
+		FailOnVersionConflict bool
 	}
 )
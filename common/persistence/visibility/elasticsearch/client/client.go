@@ -36,6 +36,8 @@ import (
 const (
 	docTypeV6           = "_doc"
 	versionTypeExternal = "external"
+
+	removeFieldScript = "ctx._source.remove(params.field)"
 )
 
 type (
@@ -52,6 +54,8 @@ type (
 		PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error)
 		WaitForYellowStatus(ctx context.Context, index string) (string, error)
 		GetMapping(ctx context.Context, index string) (map[string]string, error)
+		// RemoveField removes the field from the documents matching the query and returns the number of updated documents.
+		RemoveField(ctx context.Context, index string, query elastic.Query, field string) (int64, error)
 	}
 
 	// Combine ClientV7 with Client interface after ES v6 support removal.
@@ -62,6 +66,7 @@ type (
 	}
 
 	// Deprecated. Remove after ES v6 support removal.
+	// Also used by OpenSearch 1.x which doesn't support point in time API.
 	ClientV6 interface {
 		// Deprecated. Remove after ES v6 support removal.
 		Scroll(ctx context.Context, scrollID string) (*elastic.SearchResult, ScrollService, error)
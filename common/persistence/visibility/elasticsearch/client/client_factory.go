@@ -38,6 +38,12 @@ func NewClient(config *config.Elasticsearch, httpClient *http.Client, logger log
 		return newClientV6(config, httpClient, logger)
 	case "v7", "":
 		return newClientV7(config, httpClient, logger)
+	case "v8":
+		return newClientV8(config, httpClient, logger)
+	case "opensearch1":
+		return newClientOpenSearchV1(config, httpClient, logger)
+	case "opensearch2":
+		return newClientOpenSearchV2(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", config.Version)
 	}
@@ -49,6 +55,12 @@ func NewCLIClient(url string, version string) (CLIClient, error) {
 		return newSimpleClientV6(url)
 	case "v7", "":
 		return newSimpleClientV7(url)
+	case "v8":
+		return newSimpleClientV8(url)
+	case "opensearch1":
+		return newSimpleClientOpenSearchV1(url)
+	case "opensearch2":
+		return newSimpleClientOpenSearchV2(url)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", version)
 	}
@@ -60,6 +72,12 @@ func NewIntegrationTestsClient(url string, version string) (IntegrationTestsClie
 		return newSimpleClientV6(url)
 	case "v7":
 		return newSimpleClientV7(url)
+	case "v8":
+		return newSimpleClientV8(url)
+	case "opensearch1":
+		return newSimpleClientOpenSearchV1(url)
+	case "opensearch2":
+		return newSimpleClientOpenSearchV2(url)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", version)
 	}
@@ -105,6 +105,21 @@ func (mr *MockClientMockRecorder) PutMapping(ctx, index, mapping interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// RemoveField mocks base method.
+func (m *MockClient) RemoveField(ctx context.Context, index string, query v7.Query, field string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveField", ctx, index, query, field)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveField indicates an expected call of RemoveField.
+func (mr *MockClientMockRecorder) RemoveField(ctx, index, query, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveField", reflect.TypeOf((*MockClient)(nil).RemoveField), ctx, index, query, field)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +414,21 @@ func (mr *MockCLIClientMockRecorder) PutMapping(ctx, index, mapping interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockCLIClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// RemoveField mocks base method.
+func (m *MockCLIClient) RemoveField(ctx context.Context, index string, query v7.Query, field string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveField", ctx, index, query, field)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveField indicates an expected call of RemoveField.
+func (mr *MockCLIClientMockRecorder) RemoveField(ctx, index, query, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveField", reflect.TypeOf((*MockCLIClient)(nil).RemoveField), ctx, index, query, field)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockCLIClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
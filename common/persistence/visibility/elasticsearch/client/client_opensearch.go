@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/olivere/elastic/v7"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientOpenSearchV2 implements Client for OpenSearch 2.x.
+	// OpenSearch REST API is compatible with Elasticsearch 7 except point in time API.
+	clientOpenSearchV2 struct {
+		*clientV7
+	}
+
+	// clientOpenSearchV1 implements Client for OpenSearch 1.x.
+	// OpenSearch 1.x doesn't support point in time API and uses scroll to scan over all workflows.
+	clientOpenSearchV1 struct {
+		CLIClient
+		IntegrationTestsClient
+		esClient *elastic.Client
+	}
+
+	openPointInTimeResponseOpenSearch struct {
+		PitId string `json:"pit_id"`
+	}
+
+	closePointInTimeResponseOpenSearch struct {
+		Pits []struct {
+			PitId      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+)
+
+var _ Client = (*clientOpenSearchV2)(nil)
+var _ ClientV7 = (*clientOpenSearchV2)(nil)
+var _ CLIClient = (*clientOpenSearchV2)(nil)
+var _ IntegrationTestsClient = (*clientOpenSearchV2)(nil)
+
+var _ Client = (*clientOpenSearchV1)(nil)
+var _ ClientV6 = (*clientOpenSearchV1)(nil)
+var _ CLIClient = (*clientOpenSearchV1)(nil)
+var _ IntegrationTestsClient = (*clientOpenSearchV1)(nil)
+
+// newClientOpenSearchV2 create an OpenSearch 2.x client
+func newClientOpenSearchV2(config *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*clientOpenSearchV2, error) {
+	client, err := newClientV7(config, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientOpenSearchV2{clientV7: client}, nil
+}
+
+func newSimpleClientOpenSearchV2(url string) (*clientOpenSearchV2, error) {
+	client, err := newSimpleClientV7(url)
+	if err != nil {
+		return nil, err
+	}
+	return &clientOpenSearchV2{clientV7: client}, nil
+}
+
+// newClientOpenSearchV1 create an OpenSearch 1.x client
+func newClientOpenSearchV1(config *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*clientOpenSearchV1, error) {
+	client, err := newClientV7(config, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return newClientOpenSearchV1FromV7(client), nil
+}
+
+func newSimpleClientOpenSearchV1(url string) (*clientOpenSearchV1, error) {
+	client, err := newSimpleClientV7(url)
+	if err != nil {
+		return nil, err
+	}
+	return newClientOpenSearchV1FromV7(client), nil
+}
+
+func newClientOpenSearchV1FromV7(client *clientV7) *clientOpenSearchV1 {
+	return &clientOpenSearchV1{
+		CLIClient:              client,
+		IntegrationTestsClient: client,
+		esClient:               client.esClient,
+	}
+}
+
+// OpenPointInTime uses OpenSearch point in time API which is different from Elasticsearch one.
+// https://opensearch.org/docs/latest/search-plugins/point-in-time-api/
+func (c *clientOpenSearchV2) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/%s/_search/point_in_time", url.PathEscape(index)),
+		Params: url.Values{"keep_alive": []string{keepAliveInterval}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var pitResp openPointInTimeResponseOpenSearch
+	if err := json.Unmarshal(resp.Body, &pitResp); err != nil {
+		return "", err
+	}
+	return pitResp.PitId, nil
+}
+
+func (c *clientOpenSearchV2) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodDelete,
+		Path:   "/_search/point_in_time",
+		Body:   map[string]interface{}{"pit_id": []string{id}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var pitResp closePointInTimeResponseOpenSearch
+	if err := json.Unmarshal(resp.Body, &pitResp); err != nil {
+		return false, err
+	}
+	for _, pit := range pitResp.Pits {
+		if pit.PitId == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *clientOpenSearchV1) Scroll(ctx context.Context, scrollID string) (*elastic.SearchResult, ScrollService, error) {
+	scrollService := c.esClient.Scroll()
+	result, err := scrollService.ScrollId(scrollID).Do(ctx)
+	return result, scrollService, err
+}
+
+func (c *clientOpenSearchV1) ScrollFirstPage(ctx context.Context, index, query string) (*elastic.SearchResult, ScrollService, error) {
+	scrollService := c.esClient.Scroll(index)
+	result, err := scrollService.Body(query).Do(ctx)
+	return result, scrollService, err
+}
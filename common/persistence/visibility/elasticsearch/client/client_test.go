@@ -25,7 +25,11 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/olivere/elastic/v7"
@@ -94,3 +98,82 @@ func TestIsResponseRetryable(t *testing.T) {
 		require.True(t, IsRetryableStatus(code))
 	}
 }
+
+func Test_ToCompatibilityMediaType(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("application/vnd.elasticsearch+json;compatible-with=7", toCompatibilityMediaType("application/json"))
+	assert.Equal("application/vnd.elasticsearch+json;compatible-with=7", toCompatibilityMediaType("application/json; charset=UTF-8"))
+	assert.Equal("application/vnd.elasticsearch+x-ndjson;compatible-with=7", toCompatibilityMediaType("application/x-ndjson"))
+	assert.Equal("application/vnd.elasticsearch+json;compatible-with=7", toCompatibilityMediaType(""))
+	assert.Equal("application/vnd.elasticsearch+json;compatible-with=7", toCompatibilityMediaType("application/vnd.elasticsearch+json;compatible-with=7"))
+}
+
+func Test_ClientV8_CompatibilityHeaders(t *testing.T) {
+	var contentType, accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		accept = r.Header.Get("Accept")
+		_, _ = w.Write([]byte(`{"count":42}`))
+	}))
+	defer server.Close()
+
+	client, err := newSimpleClientV8(server.URL)
+	require.NoError(t, err)
+
+	count, err := client.Count(context.Background(), "test-index", `{"query":{"match_all":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), count)
+	require.Equal(t, "application/vnd.elasticsearch+json;compatible-with=7", contentType)
+	require.Equal(t, "application/vnd.elasticsearch+json;compatible-with=7", accept)
+}
+
+func Test_ClientOpenSearchV2_PointInTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/":
+			// Health check.
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search/point_in_time":
+			require.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			_, _ = w.Write([]byte(`{"pit_id":"test-pit-id","creation_time":1658146050064}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"pit_id":["test-pit-id"]}`, string(body))
+			_, _ = w.Write([]byte(`{"pits":[{"pit_id":"test-pit-id","successful":true}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := newSimpleClientOpenSearchV2(server.URL)
+	require.NoError(t, err)
+
+	pitID, err := client.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "test-pit-id", pitID)
+
+	succeeded, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	require.True(t, succeeded)
+}
+
+func Test_NewCLIClient_Versions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	for _, version := range []string{"v7", "v8", "opensearch1", "opensearch2"} {
+		client, err := NewCLIClient(server.URL, version)
+		require.NoError(t, err, version)
+		require.NotNil(t, client, version)
+	}
+
+	_, isV7 := interface{}(&clientOpenSearchV1{}).(ClientV7)
+	require.False(t, isV7, "OpenSearch 1.x doesn't support point in time API")
+
+	_, err := NewCLIClient(server.URL, "v5")
+	require.Error(t, err)
+}
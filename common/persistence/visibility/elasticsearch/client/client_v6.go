@@ -184,6 +184,19 @@ func (c *clientV6) GetMapping(ctx context.Context, index string) (map[string]str
 	return convertMappingBody(resp, index), nil
 }
 
+func (c *clientV6) RemoveField(ctx context.Context, index string, query elastic.Query, field string) (int64, error) {
+	resp, err := c.esClient.UpdateByQuery(index).
+		Query(query).
+		Script(elastic6.NewScript(removeFieldScript).Param("field", field)).
+		ProceedOnVersionConflict().
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return 0, convertV6ErrorToV7(err)
+	}
+	return resp.Updated, nil
+}
+
 func (c *clientV6) GetDateFieldType() string {
 	return "date"
 }
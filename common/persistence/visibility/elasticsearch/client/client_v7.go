@@ -86,14 +86,22 @@ func newClientV7(config *config.Elasticsearch, httpClient *http.Client, logger l
 }
 
 func newSimpleClientV7(url string) (*clientV7, error) {
+	return newSimpleClientV7WithHttpClient(url, nil)
+}
+
+func newSimpleClientV7WithHttpClient(url string, httpClient *http.Client) (*clientV7, error) {
 	retrier := elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))
-	var client *elastic.Client
-	var err error
-	if client, err = elastic.NewClient(
+	options := []elastic.ClientOptionFunc{
 		elastic.SetURL(url),
 		elastic.SetSniff(false),
 		elastic.SetRetrier(retrier),
-	); err != nil {
+	}
+	if httpClient != nil {
+		options = append(options, elastic.SetHttpClient(httpClient))
+	}
+
+	client, err := elastic.NewClient(options...)
+	if err != nil {
 		return nil, err
 	}
 
@@ -187,6 +195,19 @@ func (c *clientV7) GetMapping(ctx context.Context, index string) (map[string]str
 	return convertMappingBody(resp, index), err
 }
 
+func (c *clientV7) RemoveField(ctx context.Context, index string, query elastic.Query, field string) (int64, error) {
+	resp, err := c.esClient.UpdateByQuery(index).
+		Query(query).
+		Script(elastic.NewScript(removeFieldScript).Param("field", field)).
+		ProceedOnVersionConflict().
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Updated, nil
+}
+
 func (c *clientV7) GetDateFieldType() string {
 	return "date_nanos"
 }
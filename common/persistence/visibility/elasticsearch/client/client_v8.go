@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	// Elasticsearch 8 accepts requests from v7 clients when they are sent with REST API compatibility media types.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/8.0/rest-api-compatibility.html
+	compatibilityMediaTypePrefix = "application/vnd.elasticsearch+"
+	compatibilityMediaTypeSuffix = ";compatible-with=7"
+)
+
+type (
+	// clientV8 implements Client
+	clientV8 struct {
+		*clientV7
+	}
+
+	// compatibilityTransport rewrites Content-Type and Accept headers to REST API compatibility media types.
+	compatibilityTransport struct {
+		next http.RoundTripper
+	}
+)
+
+var _ Client = (*clientV8)(nil)
+var _ ClientV7 = (*clientV8)(nil)
+var _ CLIClient = (*clientV8)(nil)
+var _ IntegrationTestsClient = (*clientV8)(nil)
+
+// newClientV8 create a ES client
+func newClientV8(config *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*clientV8, error) {
+	client, err := newClientV7(config, newCompatibilityHttpClient(httpClient), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientV8{clientV7: client}, nil
+}
+
+func newSimpleClientV8(url string) (*clientV8, error) {
+	client, err := newSimpleClientV7WithHttpClient(url, newCompatibilityHttpClient(nil))
+	if err != nil {
+		return nil, err
+	}
+	return &clientV8{clientV7: client}, nil
+}
+
+func newCompatibilityHttpClient(httpClient *http.Client) *http.Client {
+	var compatibilityHttpClient http.Client
+	if httpClient != nil {
+		compatibilityHttpClient = *httpClient
+	}
+	next := compatibilityHttpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	compatibilityHttpClient.Transport = &compatibilityTransport{next: next}
+	return &compatibilityHttpClient
+}
+
+func (t *compatibilityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper must not modify original request.
+	req = req.Clone(req.Context())
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		req.Header.Set("Content-Type", toCompatibilityMediaType(contentType))
+	}
+	req.Header.Set("Accept", toCompatibilityMediaType(req.Header.Get("Accept")))
+	return t.next.RoundTrip(req)
+}
+
+// toCompatibilityMediaType converts "application/json" to "application/vnd.elasticsearch+json;compatible-with=7"
+// and "application/x-ndjson" to "application/vnd.elasticsearch+x-ndjson;compatible-with=7".
+func toCompatibilityMediaType(mediaType string) string {
+	if strings.HasPrefix(mediaType, compatibilityMediaTypePrefix) {
+		return mediaType
+	}
+	subtype := "json"
+	if strings.Contains(mediaType, "ndjson") {
+		subtype = "x-ndjson"
+	}
+	return compatibilityMediaTypePrefix + subtype + compatibilityMediaTypeSuffix
+}
@@ -76,9 +76,10 @@ type (
 	}
 
 	ackChan struct { // value of processorImpl.mapToAckChan
-		ackChInternal chan bool
-		addedAt       time.Time // Time when request was added to bulk processor (used to report metrics).
-		startedAt     time.Time // Time when request was sent to Elasticsearch by bulk processor (used to report metrics).
+		ackChInternal         chan bool
+		addedAt               time.Time // Time when request was added to bulk processor (used to report metrics).
+		startedAt             time.Time // Time when request was sent to Elasticsearch by bulk processor (used to report metrics).
+		failOnVersionConflict bool      // Nack request on version conflict instead of ignoring it.
 	}
 )
 
@@ -166,6 +167,7 @@ func (p *processorImpl) hashFn(key interface{}) uint32 {
 // Add request to the bulk and return ack channel which will receive ack signal when request is processed.
 func (p *processorImpl) Add(request *esclient.BulkableRequest, visibilityTaskKey string) <-chan bool {
 	ackCh := newAckChan()
+	ackCh.failOnVersionConflict = request.FailOnVersionConflict
 	retCh := ackCh.ackChInternal
 	_, isDup, _ := p.mapToAckChan.PutOrDo(visibilityTaskKey, ackCh, func(key interface{}, value interface{}) error {
 		ackChExisting, ok := value.(*ackChan)
@@ -183,6 +185,7 @@ func (p *processorImpl) Add(request *esclient.BulkableRequest, visibilityTaskKey
 		ackChExisting.addedAt = ackCh.addedAt
 		ackChExisting.startedAt = ackCh.startedAt
 		ackChExisting.ackChInternal = ackCh.ackChInternal
+		ackChExisting.failOnVersionConflict = ackCh.failOnVersionConflict
 		return nil
 	})
 	if !isDup {
@@ -256,6 +259,8 @@ func (p *processorImpl) bulkAfterAction(_ int64, requests []elastic.BulkableRequ
 		}
 
 		switch {
+		case responseItem.Status == 409:
+			p.sendVersionConflictToAckChan(visibilityTaskKey)
 		case isSuccess(responseItem):
 			p.sendToAckChan(visibilityTaskKey, true)
 		case !esclient.IsRetryableStatus(responseItem.Status):
@@ -308,6 +313,19 @@ func (p *processorImpl) sendToAckChan(visibilityTaskKey string, ack bool) {
 	})
 }
 
+// sendVersionConflictToAckChan acks the request unless it was added to fail on version conflict.
+func (p *processorImpl) sendVersionConflictToAckChan(visibilityTaskKey string) {
+	_ = p.mapToAckChan.RemoveIf(visibilityTaskKey, func(key interface{}, value interface{}) bool {
+		ackCh, ok := value.(*ackChan)
+		if !ok {
+			p.logger.Fatal(fmt.Sprintf("mapToAckChan has item of a wrong type %T (%T expected).", value, &ackChan{}), tag.ESKey(visibilityTaskKey))
+		}
+
+		ackCh.done(!ackCh.failOnVersionConflict, p.metricsClient)
+		return true
+	})
+}
+
 func (p *processorImpl) extractVisibilityTaskKey(request elastic.BulkableRequest) string {
 	req, err := request.Source()
 	if err != nil {
@@ -305,6 +305,45 @@ func (s *processorSuite) TestBulkAfterAction_Nack() {
 	}
 }
 
+func (s *processorSuite) TestBulkAfterAction_VersionConflict() {
+	version := int64(3)
+	testKey := "testKey"
+	request := elastic.NewBulkIndexRequest().
+		Index(testIndex).
+		Id(testID).
+		Version(version).
+		Doc(map[string]interface{}{searchattribute.VisibilityTaskKey: testKey})
+	requests := []elastic.BulkableRequest{request}
+
+	mConflict := map[string]*elastic.BulkResponseItem{
+		"index": {
+			Index:   testIndex,
+			Id:      testID,
+			Version: version,
+			Status:  409,
+		},
+	}
+	response := &elastic.BulkResponse{
+		Took:   3,
+		Errors: true,
+		Items:  []map[string]*elastic.BulkResponseItem{mConflict},
+	}
+
+	for _, failOnVersionConflict := range []bool{false, true} {
+		s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorRequestLatency, gomock.Any())
+		mapVal := newAckChan()
+		mapVal.failOnVersionConflict = failOnVersionConflict
+		s.esProcessor.mapToAckChan.Put(testKey, mapVal)
+		s.esProcessor.bulkAfterAction(0, requests, response, nil)
+		select {
+		case ack := <-mapVal.ackChInternal:
+			s.Equal(!failOnVersionConflict, ack)
+		default:
+			s.Fail("request should be acknowledged")
+		}
+	}
+}
+
 func (s *processorSuite) TestBulkAfterAction_Error() {
 	version := int64(3)
 	doc := map[string]interface{}{
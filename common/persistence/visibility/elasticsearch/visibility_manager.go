@@ -42,13 +42,14 @@ func NewVisibilityManager(
 	esClient esclient.Client,
 	cfg *config.VisibilityConfig,
 	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
 	processor Processor,
 	metricsClient metrics.Client,
 	log log.Logger,
 ) visibility.VisibilityManager {
 
 	visStore := NewVisibilityStore(esClient, indexName, searchAttributesProvider, processor, cfg, log, metricsClient)
-	visManager := visibility.NewVisibilityManagerImpl(visStore, searchAttributesProvider, indexName, log)
+	visManager := visibility.NewVisibilityManagerImpl(visStore, searchAttributesProvider, searchAttributesMapper, indexName, log)
 
 	if cfg != nil {
 		// wrap with rate limiter
@@ -152,7 +152,7 @@ func (s *visibilityStore) UpsertWorkflowExecution(request *visibility.InternalUp
 }
 
 func (s *visibilityStore) DeleteWorkflowExecution(request *visibility.VisibilityDeleteWorkflowExecutionRequest) error {
-	docID := getDocID(request.WorkflowID, request.RunID)
+	docID := GetDocID(request.WorkflowID, request.RunID)
 
 	bulkDeleteRequest := &esclient.BulkableRequest{
 		Index:       s.index,
@@ -164,7 +164,8 @@ func (s *visibilityStore) DeleteWorkflowExecution(request *visibility.Visibility
 	return s.addBulkRequestAndWait(bulkDeleteRequest, docID)
 }
 
-func getDocID(workflowID string, runID string) string {
+// GetDocID returns the ID of the Elasticsearch document of the workflow execution.
+func GetDocID(workflowID string, runID string) string {
 	return fmt.Sprintf("%s%s%s", workflowID, delimiter, runID)
 }
 
@@ -179,10 +180,12 @@ func (s *visibilityStore) addBulkIndexRequestAndWait(
 ) error {
 	bulkIndexRequest := &esclient.BulkableRequest{
 		Index:       s.index,
-		ID:          getDocID(request.WorkflowID, request.RunID),
+		ID:          GetDocID(request.WorkflowID, request.RunID),
 		Version:     request.TaskID,
 		RequestType: esclient.BulkableRequestTypeIndex,
 		Doc:         esDoc,
+
+		FailOnVersionConflict: request.FailOnVersionConflict,
 	}
 
 	return s.addBulkRequestAndWait(bulkIndexRequest, visibilityTaskKey)
@@ -676,7 +679,10 @@ func (s *visibilityStore) getFieldType(fieldName string) enumspb.IndexedValueTyp
 	if err != nil {
 		s.logger.Error("Unable to read search attribute types.", tag.Error(err))
 	}
-	fieldType, _ := searchAttributes.GetType(fieldName)
+	fieldType, err := searchAttributes.GetType(fieldName)
+	if errors.Is(err, searchattribute.ErrInvalidName) {
+		fieldType, _ = searchAttributes.GetGenericFieldType(fieldName)
+	}
 	return fieldType
 }
 
@@ -961,6 +967,10 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 		}
 
 		fieldType, err := saTypeMap.GetType(fieldName)
+		if errors.Is(err, searchattribute.ErrInvalidName) {
+			// Generic fields store custom search attributes registered per namespace.
+			fieldType, err = saTypeMap.GetGenericFieldType(fieldName)
+		}
 		if err != nil {
 			// Silently ignore ErrInvalidName because it indicates unknown field in Elasticsearch document.
 			if !errors.Is(err, searchattribute.ErrInvalidName) {
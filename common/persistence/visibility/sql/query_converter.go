@@ -0,0 +1,567 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// pluginQueryConverter builds database specific parts of advanced visibility queries.
+	pluginQueryConverter interface {
+		// bindVar returns placeholder for n-th (starting from 1) query argument.
+		bindVar(n int) string
+		// jsonPath returns path of search attribute value inside search_attributes column.
+		jsonPath(name string) string
+		// jsonValueExpr returns expression which extracts search attribute value from search_attributes column.
+		jsonValueExpr(pathVar string, saType enumspb.IndexedValueType) string
+		// jsonExistsExpr returns condition which is true if search attribute has a value.
+		jsonExistsExpr(pathVar string) string
+		// jsonContainsExpr returns condition which is true if keyword search attribute is equal to the value
+		// or, if search attribute holds list of keywords, contains it.
+		jsonContainsExpr(pathVar string, valueVar string) string
+		// executionDurationExpr returns expression which calculates execution duration in nanoseconds.
+		executionDurationExpr() string
+	}
+
+	// queryConverter converts visibility query (where clause of SQL-like query accepted by Elasticsearch visibility)
+	// to SQL query for executions_visibility table. Values are never inlined and passed as query arguments.
+	queryConverter struct {
+		pluginQueryConverter
+		typeMap searchattribute.NameTypeMap
+		args    []interface{}
+	}
+
+	queryField struct {
+		name   string
+		saType enumspb.IndexedValueType
+		// column is empty for custom and predefined search attributes which are stored in search_attributes column.
+		column string
+	}
+)
+
+const (
+	// searchAttributeDatetimeLayout is used to store datetime search attributes in search_attributes column.
+	// Values are compared as strings, therefore layout must be fixed width and all values must be in UTC.
+	searchAttributeDatetimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+	selectFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, ` +
+		`close_time, history_length, task_queue, search_attributes`
+
+	// missingValue is used in queries like `CloseTime = missing` to find executions without value.
+	missingValue = "missing"
+)
+
+var (
+	// errOrderByNotSupported is returned for queries with ORDER BY clause. Pages are read with keyset pagination
+	// on start time and run ID, therefore executions are always ordered by start time (latest first).
+	errOrderByNotSupported = errors.New("order by is not supported by SQL visibility store, executions are always ordered by StartTime descending")
+	errInvalidWhereClause  = errors.New("invalid where clause")
+
+	// systemColumns maps system search attributes to executions_visibility columns.
+	systemColumns = map[string]string{
+		searchattribute.WorkflowID:      "workflow_id",
+		searchattribute.RunID:           "run_id",
+		searchattribute.WorkflowType:    "workflow_type_name",
+		searchattribute.StartTime:       "start_time",
+		searchattribute.ExecutionTime:   "execution_time",
+		searchattribute.CloseTime:       "close_time",
+		searchattribute.ExecutionStatus: "status",
+		searchattribute.TaskQueue:       "task_queue",
+		searchattribute.HistoryLength:   "history_length",
+	}
+)
+
+func newPluginQueryConverter(pluginName string) pluginQueryConverter {
+	switch pluginName {
+	case mysql.PluginName:
+		return &mysqlQueryConverter{}
+	case postgresql.PluginName:
+		return &postgresqlQueryConverter{}
+	case sqlite.PluginName:
+		return &sqliteQueryConverter{}
+	default:
+		return nil
+	}
+}
+
+func newQueryConverter(
+	pluginConverter pluginQueryConverter,
+	typeMap searchattribute.NameTypeMap,
+) *queryConverter {
+	return &queryConverter{
+		pluginQueryConverter: pluginConverter,
+		typeMap:              typeMap,
+	}
+}
+
+// buildSelectQuery builds query which returns next page of executions matching whereClause.
+// Executions are ordered by start time (latest first) and run ID which is used as tie-breaker.
+func (c *queryConverter) buildSelectQuery(
+	namespaceID string,
+	whereClause string,
+	token *visibilityPageToken,
+	pageSize int,
+) (string, []interface{}, error) {
+	conditions, err := c.buildConditions(namespaceID, whereClause)
+	if err != nil {
+		return "", nil, err
+	}
+	if token != nil {
+		conditions = append(conditions, fmt.Sprintf("(start_time < %s OR (start_time = %s AND run_id > %s))",
+			c.addArg(token.Time),
+			c.addArg(token.Time),
+			c.addArg(token.RunID),
+		))
+	}
+	query := fmt.Sprintf("SELECT %s FROM executions_visibility WHERE %s ORDER BY start_time DESC, run_id LIMIT %s",
+		selectFieldNames,
+		strings.Join(conditions, " AND "),
+		c.addArg(pageSize),
+	)
+	return query, c.args, nil
+}
+
+// buildCountQuery builds query which returns number of executions matching whereClause.
+func (c *queryConverter) buildCountQuery(
+	namespaceID string,
+	whereClause string,
+) (string, []interface{}, error) {
+	conditions, err := c.buildConditions(namespaceID, whereClause)
+	if err != nil {
+		return "", nil, err
+	}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM executions_visibility WHERE %s", strings.Join(conditions, " AND "))
+	return query, c.args, nil
+}
+
+func (c *queryConverter) buildConditions(
+	namespaceID string,
+	whereClause string,
+) ([]string, error) {
+	conditions := []string{fmt.Sprintf("namespace_id = %s", c.addArg(namespaceID))}
+
+	whereClause = strings.TrimSpace(whereClause)
+	if whereClause == "" {
+		return conditions, nil
+	}
+	if common.IsJustOrderByClause(whereClause) {
+		return nil, errOrderByNotSupported
+	}
+
+	// IMPORTANT: This query is never executed, it is just used to parse whereClause.
+	stmt, err := sqlparser.Parse(fmt.Sprintf("SELECT * FROM dummy WHERE %s", whereClause))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return nil, errInvalidWhereClause
+	}
+	if len(sel.OrderBy) != 0 {
+		return nil, errOrderByNotSupported
+	}
+
+	condition, err := c.convertWhereExpr(sel.Where.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return append(conditions, condition), nil
+}
+
+func (c *queryConverter) convertWhereExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertLogicalExpr(expr.Left, expr.Right, "AND")
+	case *sqlparser.OrExpr:
+		return c.convertLogicalExpr(expr.Left, expr.Right, "OR")
+	case *sqlparser.ParenExpr:
+		return c.convertWhereExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return "", errInvalidWhereClause
+	}
+}
+
+func (c *queryConverter) convertLogicalExpr(
+	left sqlparser.Expr,
+	right sqlparser.Expr,
+	operator string,
+) (string, error) {
+	leftStr, err := c.convertWhereExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertWhereExpr(right)
+	if err != nil {
+		return "", err
+	}
+	// Every logical expression is wrapped with parentheses to preserve precedence of the original query.
+	return fmt.Sprintf("(%s %s %s)", leftStr, operator, rightStr), nil
+}
+
+func (c *queryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	field, err := c.convertField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if isMissingValue(expr.Right) {
+			if expr.Operator == sqlparser.EqualStr {
+				return fmt.Sprintf("%s IS NULL", c.fieldExpr(field)), nil
+			}
+			return fmt.Sprintf("%s IS NOT NULL", c.fieldExpr(field)), nil
+		}
+		value, err := c.convertValue(field, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		if expr.Operator == sqlparser.EqualStr {
+			return c.equalCondition(field, value), nil
+		}
+		if field.column == "" {
+			// Like with Elasticsearch, executions without the search attribute match the negated condition.
+			existsCondition := c.jsonExistsCondition(field)
+			return fmt.Sprintf("NOT (%s AND %s)", existsCondition, c.equalCondition(field, value)), nil
+		}
+		return fmt.Sprintf("%s != %s", c.fieldExpr(field), c.addArg(value)), nil
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		value, err := c.convertValue(field, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %s %s", c.fieldExpr(field), expr.Operator, c.addArg(value)), nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if field.saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && field.saType != enumspb.INDEXED_VALUE_TYPE_STRING {
+			return "", fmt.Errorf("operator %s is not supported for search attribute %s of type %s", expr.Operator, field.name, field.saType)
+		}
+		value, err := c.convertValue(field, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %s %s", c.fieldExpr(field), strings.ToUpper(expr.Operator), c.addArg(value)), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return c.convertInExpr(field, expr)
+	default:
+		return "", fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+}
+
+func (c *queryConverter) convertInExpr(field *queryField, expr *sqlparser.ComparisonExpr) (string, error) {
+	tuple, ok := expr.Right.(sqlparser.ValTuple)
+	if !ok || len(tuple) == 0 {
+		return "", fmt.Errorf("invalid values for %s operator", expr.Operator)
+	}
+	values := make([]interface{}, len(tuple))
+	for i, valueExpr := range tuple {
+		value, err := c.convertValue(field, valueExpr)
+		if err != nil {
+			return "", err
+		}
+		values[i] = value
+	}
+
+	// Like with Elasticsearch, executions without the search attribute match the negated condition.
+	// Existence condition goes first, because MySQL arguments are bound by their position in the query.
+	var existsCondition string
+	if expr.Operator == sqlparser.NotInStr && field.column == "" {
+		existsCondition = c.jsonExistsCondition(field)
+	}
+
+	var condition string
+	if field.isJSONKeyword() {
+		conditions := make([]string, len(values))
+		for i, value := range values {
+			conditions[i] = c.jsonContainsCondition(field, value)
+		}
+		condition = fmt.Sprintf("(%s)", strings.Join(conditions, " OR "))
+	} else {
+		fieldExpr := c.fieldExpr(field)
+		bindVars := make([]string, len(values))
+		for i, value := range values {
+			bindVars[i] = c.addArg(value)
+		}
+		condition = fmt.Sprintf("%s IN (%s)", fieldExpr, strings.Join(bindVars, ", "))
+	}
+
+	if existsCondition != "" {
+		return fmt.Sprintf("NOT (%s AND %s)", existsCondition, condition), nil
+	}
+	if expr.Operator == sqlparser.NotInStr {
+		return fmt.Sprintf("NOT %s", condition), nil
+	}
+	return condition, nil
+}
+
+func (c *queryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	field, err := c.convertField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	from, err := c.convertValue(field, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(field, expr.To)
+	if err != nil {
+		return "", err
+	}
+
+	switch expr.Operator {
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		return fmt.Sprintf("%s %s %s AND %s",
+			c.fieldExpr(field),
+			strings.ToUpper(expr.Operator),
+			c.addArg(from),
+			c.addArg(to),
+		), nil
+	default:
+		return "", fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+}
+
+func (c *queryConverter) convertField(expr sqlparser.Expr) (*queryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, errors.New("invalid comparison expression")
+	}
+	name := colName.Name.String()
+	saType, err := c.typeMap.GetType(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search attribute: %s", name)
+	}
+
+	field := &queryField{
+		name:   name,
+		saType: saType,
+	}
+	if column, isSystem := systemColumns[name]; isSystem {
+		field.column = column
+		return field, nil
+	}
+	switch name {
+	case searchattribute.ExecutionDuration:
+		field.column = c.executionDurationExpr()
+	case searchattribute.StateTransitionCount:
+		return nil, fmt.Errorf("search attribute %s is not supported by SQL visibility store", name)
+	}
+	return field, nil
+}
+
+// fieldExpr returns expression for the field. For search attributes stored in search_attributes column
+// it also adds JSON path argument, therefore it must be called exactly where expression is used.
+func (c *queryConverter) fieldExpr(field *queryField) string {
+	if field.column != "" {
+		return field.column
+	}
+	return c.jsonValueExpr(c.addArg(c.jsonPath(field.name)), field.saType)
+}
+
+// equalCondition returns condition which is true if the field is equal to the value
+// or, if keyword search attribute holds list of keywords, contains it.
+func (c *queryConverter) equalCondition(field *queryField, value interface{}) string {
+	if field.isJSONKeyword() {
+		return c.jsonContainsCondition(field, value)
+	}
+	return fmt.Sprintf("%s = %s", c.fieldExpr(field), c.addArg(value))
+}
+
+func (c *queryConverter) jsonExistsCondition(field *queryField) string {
+	return c.jsonExistsExpr(c.addArg(c.jsonPath(field.name)))
+}
+
+func (c *queryConverter) jsonContainsCondition(field *queryField, value interface{}) string {
+	return c.jsonContainsExpr(c.addArg(c.jsonPath(field.name)), c.addArg(value))
+}
+
+func (c *queryConverter) addArg(arg interface{}) string {
+	c.args = append(c.args, arg)
+	return c.bindVar(len(c.args))
+}
+
+func (c *queryConverter) convertValue(field *queryField, expr sqlparser.Expr) (interface{}, error) {
+	var value interface{}
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		var err error
+		switch expr.Type {
+		case sqlparser.StrVal:
+			value = string(expr.Val)
+		case sqlparser.IntVal:
+			value, err = strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			value, err = strconv.ParseFloat(string(expr.Val), 64)
+		default:
+			err = errors.New("unsupported value type")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s for search attribute %s: %v", sqlparser.String(expr), field.name, err)
+		}
+	case sqlparser.BoolVal:
+		value = bool(expr)
+	default:
+		return nil, fmt.Errorf("invalid value %s for search attribute %s", sqlparser.String(expr), field.name)
+	}
+
+	var converted interface{}
+	var err error
+	switch field.name {
+	case searchattribute.ExecutionStatus:
+		converted, err = convertExecutionStatus(value)
+	case searchattribute.ExecutionDuration:
+		converted, err = convertExecutionDuration(value)
+	default:
+		converted, err = convertSearchAttributeValue(value, field.saType)
+		// Datetime search attributes from search_attributes column are compared as strings.
+		if t, isTime := converted.(time.Time); isTime && field.column == "" {
+			converted = t.Format(searchAttributeDatetimeLayout)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %v for search attribute %s of type %s: %v", value, field.name, field.saType, err)
+	}
+	return converted, nil
+}
+
+func (f *queryField) isJSONKeyword() bool {
+	return f.column == "" &&
+		(f.saType == enumspb.INDEXED_VALUE_TYPE_KEYWORD || f.saType == enumspb.INDEXED_VALUE_TYPE_STRING)
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Name.EqualString(missingValue)
+}
+
+// convertExecutionStatus supports statuses passed as names (i.e. "Running") and as integers.
+func convertExecutionStatus(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if status, ok := enumspb.WorkflowExecutionStatus_value[v]; ok {
+			return status, nil
+		}
+		status, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, errors.New("unknown execution status")
+		}
+		return int32(status), nil
+	case int64:
+		return int32(v), nil
+	default:
+		return nil, errors.New("unknown execution status")
+	}
+}
+
+// convertExecutionDuration supports durations passed as integer nanoseconds
+// and as golang durations such as "300ms", "-1.5h" or "2h45m".
+func convertExecutionDuration(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case string:
+		if nanos, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return nanos, nil
+		}
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		return duration.Nanoseconds(), nil
+	default:
+		return nil, errors.New("unexpected duration value")
+	}
+}
+
+// convertSearchAttributeValue converts value parsed from query to the type of search attribute.
+// Datetime values can be passed as RFC3339 strings and as integer nanoseconds since epoch.
+func convertSearchAttributeValue(value interface{}, saType enumspb.IndexedValueType) (interface{}, error) {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case int64:
+			return time.Unix(0, v).UTC(), nil
+		case string:
+			if nanos, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return time.Unix(0, nanos).UTC(), nil
+			}
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, err
+			}
+			return t.UTC(), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unexpected value type %T", searchattribute.ErrInvalidType, value)
+}
@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	mysqlQueryConverter struct{}
+)
+
+var _ pluginQueryConverter = (*mysqlQueryConverter)(nil)
+
+func (c *mysqlQueryConverter) bindVar(_ int) string {
+	return "?"
+}
+
+func (c *mysqlQueryConverter) jsonPath(name string) string {
+	return "$." + strconv.Quote(name)
+}
+
+func (c *mysqlQueryConverter) jsonValueExpr(pathVar string, saType enumspb.IndexedValueType) string {
+	// NOTE: MySQL doesn't support BETWEEN and IN for JSON values,
+	//  therefore numbers are casted to native types.
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return fmt.Sprintf("CAST(JSON_EXTRACT(search_attributes, %s) AS SIGNED)", pathVar)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return fmt.Sprintf("CAST(JSON_EXTRACT(search_attributes, %s) AS DECIMAL(65, 30))", pathVar)
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return fmt.Sprintf("(JSON_EXTRACT(search_attributes, %s) = CAST('true' AS JSON))", pathVar)
+	default:
+		return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(search_attributes, %s))", pathVar)
+	}
+}
+
+func (c *mysqlQueryConverter) jsonExistsExpr(pathVar string) string {
+	return fmt.Sprintf("JSON_CONTAINS_PATH(search_attributes, 'one', %s)", pathVar)
+}
+
+func (c *mysqlQueryConverter) jsonContainsExpr(pathVar string, valueVar string) string {
+	return fmt.Sprintf("JSON_CONTAINS(JSON_EXTRACT(search_attributes, %s), JSON_QUOTE(%s))", pathVar, valueVar)
+}
+
+func (c *mysqlQueryConverter) executionDurationExpr() string {
+	return "(TIMESTAMPDIFF(MICROSECOND, execution_time, close_time) * 1000)"
+}
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	postgresqlQueryConverter struct{}
+)
+
+var _ pluginQueryConverter = (*postgresqlQueryConverter)(nil)
+
+func (c *postgresqlQueryConverter) bindVar(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+func (c *postgresqlQueryConverter) jsonPath(name string) string {
+	return name
+}
+
+func (c *postgresqlQueryConverter) jsonValueExpr(pathVar string, saType enumspb.IndexedValueType) string {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return fmt.Sprintf("(search_attributes->>%s::text)::bigint", pathVar)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return fmt.Sprintf("(search_attributes->>%s::text)::double precision", pathVar)
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return fmt.Sprintf("(search_attributes->>%s::text)::boolean", pathVar)
+	default:
+		return fmt.Sprintf("(search_attributes->>%s::text)", pathVar)
+	}
+}
+
+func (c *postgresqlQueryConverter) jsonExistsExpr(pathVar string) string {
+	return fmt.Sprintf("jsonb_exists(search_attributes, %s::text)", pathVar)
+}
+
+func (c *postgresqlQueryConverter) jsonContainsExpr(pathVar string, valueVar string) string {
+	return fmt.Sprintf("(search_attributes->%s::text) @> to_jsonb(%s::text)", pathVar, valueVar)
+}
+
+func (c *postgresqlQueryConverter) executionDurationExpr() string {
+	return "(EXTRACT(EPOCH FROM close_time - execution_time) * 1000000000)"
+}
@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+)
+
+type (
+	sqliteQueryConverter struct{}
+)
+
+var _ pluginQueryConverter = (*sqliteQueryConverter)(nil)
+
+func (c *sqliteQueryConverter) bindVar(n int) string {
+	// NOTE: numbered parameters can be referenced more than once.
+	return "?" + strconv.Itoa(n)
+}
+
+func (c *sqliteQueryConverter) jsonPath(name string) string {
+	// NOTE: search attribute functions registered by sqlite plugin take search attribute name as path.
+	return name
+}
+
+func (c *sqliteQueryConverter) jsonValueExpr(pathVar string, saType enumspb.IndexedValueType) string {
+	valueFunc := sqlite.SearchAttributeTextFunc
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_BOOL:
+		// NOTE: booleans are returned as 0 and 1, which can be compared with query arguments as is.
+		valueFunc = sqlite.SearchAttributeIntegerFunc
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		valueFunc = sqlite.SearchAttributeRealFunc
+	}
+	// NOTE: missing search attributes must evaluate to NULL like with other SQL databases.
+	return fmt.Sprintf("CASE WHEN %s(search_attributes, %s) THEN %s(search_attributes, %s) END",
+		sqlite.SearchAttributeExistsFunc, pathVar, valueFunc, pathVar)
+}
+
+func (c *sqliteQueryConverter) jsonExistsExpr(pathVar string) string {
+	return fmt.Sprintf("%s(search_attributes, %s)", sqlite.SearchAttributeExistsFunc, pathVar)
+}
+
+func (c *sqliteQueryConverter) jsonContainsExpr(pathVar string, valueVar string) string {
+	return fmt.Sprintf("%s(search_attributes, %s, %s)", sqlite.SearchAttributeContainsFunc, pathVar, valueVar)
+}
+
+func (c *sqliteQueryConverter) executionDurationExpr() string {
+	return "CAST((julianday(close_time) - julianday(execution_time)) * 86400000000000 AS INTEGER)"
+}
@@ -0,0 +1,292 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	queryConverterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		*require.Assertions
+	}
+
+	converterTestCase struct {
+		query         string
+		expectedWhere string
+		expectedArgs  []interface{}
+		expectedErr   string
+	}
+)
+
+const (
+	testNamespaceID = "bfd5c907-f899-4baf-a7b2-2ab85e623ebd"
+)
+
+func TestQueryConverterSuite(t *testing.T) {
+	suite.Run(t, new(queryConverterSuite))
+}
+
+func (s *queryConverterSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *queryConverterSuite) TestNewPluginQueryConverter() {
+	s.IsType(&mysqlQueryConverter{}, newPluginQueryConverter(mysql.PluginName))
+	s.IsType(&postgresqlQueryConverter{}, newPluginQueryConverter(postgresql.PluginName))
+	s.IsType(&sqliteQueryConverter{}, newPluginQueryConverter(sqlite.PluginName))
+	s.Nil(newPluginQueryConverter("cassandra"))
+}
+
+func (s *queryConverterSuite) TestConvertWhereClause_MySQL() {
+	testCases := []converterTestCase{
+		{
+			query:         "",
+			expectedWhere: "namespace_id = ?",
+			expectedArgs:  []interface{}{testNamespaceID},
+		},
+		{
+			query:         "WorkflowId = 'wid' and ExecutionStatus = 'Running'",
+			expectedWhere: "namespace_id = ? AND (workflow_id = ? AND status = ?)",
+			expectedArgs:  []interface{}{testNamespaceID, "wid", int32(1)},
+		},
+		{
+			query:         "ExecutionStatus != 2 and (CloseTime = missing or TaskQueue = 'tq')",
+			expectedWhere: "namespace_id = ? AND (status != ? AND (close_time IS NULL OR task_queue = ?))",
+			expectedArgs:  []interface{}{testNamespaceID, int32(2), "tq"},
+		},
+		{
+			query:         "StartTime >= '2021-06-01T10:00:00+02:00' and HistoryLength < 10",
+			expectedWhere: "namespace_id = ? AND (start_time >= ? AND history_length < ?)",
+			expectedArgs:  []interface{}{testNamespaceID, time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), int64(10)},
+		},
+		{
+			query:         "WorkflowType in ('type1', 'type2')",
+			expectedWhere: "namespace_id = ? AND workflow_type_name IN (?, ?)",
+			expectedArgs:  []interface{}{testNamespaceID, "type1", "type2"},
+		},
+		{
+			query:         "CustomKeywordField = 'foo' or CustomIntField > 5",
+			expectedWhere: "namespace_id = ? AND (JSON_CONTAINS(JSON_EXTRACT(search_attributes, ?), JSON_QUOTE(?)) OR CAST(JSON_EXTRACT(search_attributes, ?) AS SIGNED) > ?)",
+			expectedArgs:  []interface{}{testNamespaceID, `$."CustomKeywordField"`, "foo", `$."CustomIntField"`, int64(5)},
+		},
+		{
+			query: "CustomKeywordField not in ('foo', 'bar')",
+			expectedWhere: "namespace_id = ? AND NOT (JSON_CONTAINS_PATH(search_attributes, 'one', ?) AND " +
+				"(JSON_CONTAINS(JSON_EXTRACT(search_attributes, ?), JSON_QUOTE(?)) OR JSON_CONTAINS(JSON_EXTRACT(search_attributes, ?), JSON_QUOTE(?))))",
+			expectedArgs: []interface{}{testNamespaceID, `$."CustomKeywordField"`, `$."CustomKeywordField"`, "foo", `$."CustomKeywordField"`, "bar"},
+		},
+		{
+			query: "CustomKeywordField != 'foo'",
+			expectedWhere: "namespace_id = ? AND NOT (JSON_CONTAINS_PATH(search_attributes, 'one', ?) AND " +
+				"JSON_CONTAINS(JSON_EXTRACT(search_attributes, ?), JSON_QUOTE(?)))",
+			expectedArgs: []interface{}{testNamespaceID, `$."CustomKeywordField"`, `$."CustomKeywordField"`, "foo"},
+		},
+		{
+			query:         "CustomDoubleField between 1 and 2.5",
+			expectedWhere: "namespace_id = ? AND CAST(JSON_EXTRACT(search_attributes, ?) AS DECIMAL(65, 30)) BETWEEN ? AND ?",
+			expectedArgs:  []interface{}{testNamespaceID, `$."CustomDoubleField"`, float64(1), 2.5},
+		},
+		{
+			query:         "CustomDatetimeField < '2021-06-01T10:00:00+02:00'",
+			expectedWhere: "namespace_id = ? AND JSON_UNQUOTE(JSON_EXTRACT(search_attributes, ?)) < ?",
+			expectedArgs:  []interface{}{testNamespaceID, `$."CustomDatetimeField"`, "2021-06-01T08:00:00.000000000Z"},
+		},
+		{
+			query:         "CustomStringField like '%foo%' and CustomBoolField = true",
+			expectedWhere: "namespace_id = ? AND (JSON_UNQUOTE(JSON_EXTRACT(search_attributes, ?)) LIKE ? AND (JSON_EXTRACT(search_attributes, ?) = CAST('true' AS JSON)) = ?)",
+			expectedArgs:  []interface{}{testNamespaceID, `$."CustomStringField"`, "%foo%", `$."CustomBoolField"`, true},
+		},
+		{
+			query:         "CustomIntField = missing",
+			expectedWhere: "namespace_id = ? AND CAST(JSON_EXTRACT(search_attributes, ?) AS SIGNED) IS NULL",
+			expectedArgs:  []interface{}{testNamespaceID, `$."CustomIntField"`},
+		},
+		{
+			query:         "ExecutionDuration > '1m'",
+			expectedWhere: "namespace_id = ? AND (TIMESTAMPDIFF(MICROSECOND, execution_time, close_time) * 1000) > ?",
+			expectedArgs:  []interface{}{testNamespaceID, int64(time.Minute)},
+		},
+	}
+	s.testConvertWhereClause(&mysqlQueryConverter{}, testCases)
+}
+
+func (s *queryConverterSuite) TestConvertWhereClause_PostgreSQL() {
+	testCases := []converterTestCase{
+		{
+			query:         "WorkflowId = 'wid' and ExecutionStatus = 'Running'",
+			expectedWhere: "namespace_id = $1 AND (workflow_id = $2 AND status = $3)",
+			expectedArgs:  []interface{}{testNamespaceID, "wid", int32(1)},
+		},
+		{
+			query:         "CustomKeywordField = 'foo' or CustomIntField > 5",
+			expectedWhere: "namespace_id = $1 AND ((search_attributes->$2::text) @> to_jsonb($3::text) OR (search_attributes->>$4::text)::bigint > $5)",
+			expectedArgs:  []interface{}{testNamespaceID, "CustomKeywordField", "foo", "CustomIntField", int64(5)},
+		},
+		{
+			query:         "CustomIntField in (1, 2)",
+			expectedWhere: "namespace_id = $1 AND (search_attributes->>$2::text)::bigint IN ($3, $4)",
+			expectedArgs:  []interface{}{testNamespaceID, "CustomIntField", int64(1), int64(2)},
+		},
+		{
+			query:         "CustomBoolField != false",
+			expectedWhere: "namespace_id = $1 AND NOT (jsonb_exists(search_attributes, $2::text) AND (search_attributes->>$3::text)::boolean = $4)",
+			expectedArgs:  []interface{}{testNamespaceID, "CustomBoolField", "CustomBoolField", false},
+		},
+		{
+			query:         "ExecutionDuration >= 1000",
+			expectedWhere: "namespace_id = $1 AND (EXTRACT(EPOCH FROM close_time - execution_time) * 1000000000) >= $2",
+			expectedArgs:  []interface{}{testNamespaceID, int64(1000)},
+		},
+	}
+	s.testConvertWhereClause(&postgresqlQueryConverter{}, testCases)
+}
+
+func (s *queryConverterSuite) TestConvertWhereClause_SQLite() {
+	testCases := []converterTestCase{
+		{
+			query: "CustomKeywordField != 'foo' and CustomDoubleField <= 1.5",
+			expectedWhere: "namespace_id = ?1 AND (NOT (temporal_search_attribute_exists(search_attributes, ?2) AND temporal_search_attribute_contains(search_attributes, ?3, ?4)) AND " +
+				"CASE WHEN temporal_search_attribute_exists(search_attributes, ?5) THEN temporal_search_attribute_real(search_attributes, ?5) END <= ?6)",
+			expectedArgs: []interface{}{testNamespaceID, "CustomKeywordField", "CustomKeywordField", "foo", "CustomDoubleField", 1.5},
+		},
+		{
+			query: "CustomIntField in (1, 2)",
+			expectedWhere: "namespace_id = ?1 AND " +
+				"CASE WHEN temporal_search_attribute_exists(search_attributes, ?2) THEN temporal_search_attribute_integer(search_attributes, ?2) END IN (?3, ?4)",
+			expectedArgs: []interface{}{testNamespaceID, "CustomIntField", int64(1), int64(2)},
+		},
+		{
+			query:         "CloseTime != missing",
+			expectedWhere: "namespace_id = ?1 AND close_time IS NOT NULL",
+			expectedArgs:  []interface{}{testNamespaceID},
+		},
+	}
+	s.testConvertWhereClause(&sqliteQueryConverter{}, testCases)
+}
+
+func (s *queryConverterSuite) TestConvertWhereClause_Errors() {
+	testCases := []converterTestCase{
+		{
+			query:       "order by StartTime",
+			expectedErr: errOrderByNotSupported.Error(),
+		},
+		{
+			query:       "CustomIntField = 1 order by StartTime",
+			expectedErr: errOrderByNotSupported.Error(),
+		},
+		{
+			query:       "CustomIntField",
+			expectedErr: errInvalidWhereClause.Error(),
+		},
+		{
+			query:       "UnknownField = 1",
+			expectedErr: "invalid search attribute: UnknownField",
+		},
+		{
+			query:       "StateTransitionCount > 1",
+			expectedErr: "search attribute StateTransitionCount is not supported by SQL visibility store",
+		},
+		{
+			query:       "CustomIntField like '1%'",
+			expectedErr: "operator like is not supported for search attribute CustomIntField of type Int",
+		},
+		{
+			query:       "CustomIntField = 'foo'",
+			expectedErr: "invalid value foo for search attribute CustomIntField of type Int",
+		},
+		{
+			query:       "ExecutionStatus = 'Unknown'",
+			expectedErr: "invalid value Unknown for search attribute ExecutionStatus of type Keyword: unknown execution status",
+		},
+		{
+			query:       "1 = CustomIntField",
+			expectedErr: "invalid comparison expression",
+		},
+	}
+	s.testConvertWhereClause(&mysqlQueryConverter{}, testCases)
+}
+
+func (s *queryConverterSuite) TestBuildSelectQuery() {
+	token := &visibilityPageToken{
+		Time:  time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC),
+		RunID: "1601da05-4db9-4eeb-89e4-da99481bdfc9",
+	}
+
+	converter := newQueryConverter(&postgresqlQueryConverter{}, searchattribute.TestNameTypeMap)
+	query, args, err := converter.buildSelectQuery(testNamespaceID, "WorkflowType = 'type1'", token, 10)
+	s.NoError(err)
+	s.Equal("SELECT "+selectFieldNames+" FROM executions_visibility "+
+		"WHERE namespace_id = $1 AND workflow_type_name = $2 AND (start_time < $3 OR (start_time = $4 AND run_id > $5)) "+
+		"ORDER BY start_time DESC, run_id LIMIT $6", query)
+	s.Equal([]interface{}{testNamespaceID, "type1", token.Time, token.Time, token.RunID, 10}, args)
+
+	converter = newQueryConverter(&mysqlQueryConverter{}, searchattribute.TestNameTypeMap)
+	query, args, err = converter.buildSelectQuery(testNamespaceID, "", nil, 10)
+	s.NoError(err)
+	s.Equal("SELECT "+selectFieldNames+" FROM executions_visibility WHERE namespace_id = ? ORDER BY start_time DESC, run_id LIMIT ?", query)
+	s.Equal([]interface{}{testNamespaceID, 10}, args)
+}
+
+func (s *queryConverterSuite) TestBuildCountQuery() {
+	converter := newQueryConverter(&mysqlQueryConverter{}, searchattribute.TestNameTypeMap)
+	query, args, err := converter.buildCountQuery(testNamespaceID, "ExecutionStatus = 'Running'")
+	s.NoError(err)
+	s.Equal("SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = ? AND status = ?", query)
+	s.Equal([]interface{}{testNamespaceID, int32(1)}, args)
+
+	converter = newQueryConverter(&mysqlQueryConverter{}, searchattribute.TestNameTypeMap)
+	_, _, err = converter.buildCountQuery(testNamespaceID, "ExecutionStatus = 'Running' order by StartTime")
+	s.True(errors.Is(err, errOrderByNotSupported))
+}
+
+func (s *queryConverterSuite) testConvertWhereClause(pluginConverter pluginQueryConverter, testCases []converterTestCase) {
+	for _, tc := range testCases {
+		converter := newQueryConverter(pluginConverter, searchattribute.TestNameTypeMap)
+		conditions, err := converter.buildConditions(testNamespaceID, tc.query)
+		if tc.expectedErr != "" {
+			s.Error(err, tc.query)
+			s.Contains(err.Error(), tc.expectedErr, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.expectedWhere, strings.Join(conditions, " AND "), tc.query)
+		s.Equal(tc.expectedArgs, converter.args, tc.query)
+	}
+}
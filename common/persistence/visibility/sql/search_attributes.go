@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/searchattribute"
+)
+
+var (
+	errUnexpectedJSONFieldType = errors.New("unexpected JSON field type")
+	errUnexpectedPayloadType   = errors.New("unexpected payload type")
+)
+
+// decodeSearchAttributePayloads decodes search attribute payloads to values of their types.
+// Unlike searchattribute.Decode it fails on the first value which can't be decoded and
+// accepts binary payloads for string and keyword search attributes.
+func decodeSearchAttributePayloads(
+	searchAttributes *commonpb.SearchAttributes,
+	typeMap searchattribute.NameTypeMap,
+) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(searchAttributes.GetIndexedFields()))
+	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		saType, err := typeMap.GetType(saName)
+		if err != nil {
+			// Namespace search attributes are stored under the generic fields which back them.
+			if saType, err = typeMap.GetGenericFieldType(saName); err != nil {
+				return nil, err
+			}
+		}
+		value, err := decodeSearchAttributePayload(saPayload, saType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode search attribute %s: %w", saName, err)
+		}
+		result[saName] = value
+	}
+	return result, nil
+}
+
+func decodeSearchAttributePayload(
+	saPayload *commonpb.Payload,
+	t enumspb.IndexedValueType,
+) (interface{}, error) {
+	if ivt, ok := enumspb.IndexedValueType_value[string(saPayload.GetMetadata()[searchattribute.MetadataType])]; ok {
+		t = enumspb.IndexedValueType(ivt)
+	}
+	// Binary payloads can only be decoded to []byte, which is a valid value for string types only.
+	if string(saPayload.GetMetadata()[converter.MetadataEncoding]) == converter.MetadataEncodingBinary {
+		switch t {
+		case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+			return string(saPayload.GetData()), nil
+		default:
+			return nil, fmt.Errorf("%w: binary payload for %v search attribute", errUnexpectedPayloadType, t)
+		}
+	}
+	return searchattribute.DecodeValue(saPayload, t)
+}
+
+// encodeSearchAttributes encodes search attribute values to JSON which is stored in search_attributes column.
+// Datetime values are stored as fixed width UTC strings, so they can be compared as strings.
+func encodeSearchAttributes(searchAttributes map[string]interface{}) (*string, error) {
+	values := make(map[string]interface{}, len(searchAttributes))
+	for saName, saValue := range searchAttributes {
+		switch v := saValue.(type) {
+		case nil:
+			// Search attributes without value are skipped.
+			continue
+		case time.Time:
+			values[saName] = v.UTC().Format(searchAttributeDatetimeLayout)
+		case []time.Time:
+			listValue := make([]string, len(v))
+			for i, t := range v {
+				listValue[i] = t.UTC().Format(searchAttributeDatetimeLayout)
+			}
+			values[saName] = listValue
+		default:
+			values[saName] = v
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	encoded := string(data)
+	return &encoded, nil
+}
+
+// decodeSearchAttributes decodes search_attributes column to the map of search attribute values.
+// Search attributes which are not in typeMap (i.e. were removed) are skipped.
+// In case of error, it will continue to next search attribute and return last error.
+func decodeSearchAttributes(data *string, typeMap searchattribute.NameTypeMap) (map[string]interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var values map[string]interface{}
+	d := json.NewDecoder(strings.NewReader(*data))
+	// Numbers are decoded as json.Number to not lose precision of int values.
+	d.UseNumber()
+	if err := d.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	var lastErr error
+	for saName, saValue := range values {
+		saType, err := typeMap.GetType(saName)
+		if err != nil {
+			continue
+		}
+		value, err := parseJSONValue(saValue, saType)
+		if err != nil {
+			lastErr = fmt.Errorf("unable to parse search attribute %s: %w", saName, err)
+			continue
+		}
+		if result == nil {
+			result = make(map[string]interface{}, len(values))
+		}
+		result[saName] = value
+	}
+	return result, lastErr
+}
+
+// parseJSONValue converts value returned by json.Decode (with UseNumber) to the type of search attribute.
+func parseJSONValue(val interface{}, t enumspb.IndexedValueType) (interface{}, error) {
+	// Search attributes support array of particular type.
+	if arrayValue, isArray := val.([]interface{}); isArray {
+		retArray := make([]interface{}, len(arrayValue))
+		var lastErr error
+		for i := 0; i < len(retArray); i++ {
+			retArray[i], lastErr = parseJSONValue(arrayValue[i], t)
+		}
+		return retArray, lastErr
+	}
+
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		stringVal, isString := val.(string)
+		if !isString {
+			return nil, fmt.Errorf("%w: expected string got %T", errUnexpectedJSONFieldType, val)
+		}
+		if t == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return time.Parse(time.RFC3339Nano, stringVal)
+		}
+		return stringVal, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		numberVal, isNumber := val.(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("%w: expected json.Number got %T", errUnexpectedJSONFieldType, val)
+		}
+		if t == enumspb.INDEXED_VALUE_TYPE_INT {
+			return numberVal.Int64()
+		}
+		return numberVal.Float64()
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		boolVal, isBool := val.(bool)
+		if !isBool {
+			return nil, fmt.Errorf("%w: expected bool got %T", errUnexpectedJSONFieldType, val)
+		}
+		return boolVal, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", searchattribute.ErrInvalidType, t)
+	}
+}
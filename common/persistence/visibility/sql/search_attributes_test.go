@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func Test_EncodeSearchAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	encoded, err := encodeSearchAttributes(map[string]interface{}{
+		"CustomKeywordField":  []string{"foo", "bar"},
+		"CustomIntField":      int64(42),
+		"CustomDatetimeField": time.Date(2021, 6, 1, 10, 0, 0, 100, time.FixedZone("UTC+2", 2*60*60)),
+		"CustomBoolField":     nil,
+	})
+	assert.NoError(err)
+	assert.NotNil(encoded)
+	assert.JSONEq(`{"CustomKeywordField":["foo","bar"],"CustomIntField":42,"CustomDatetimeField":"2021-06-01T08:00:00.000000100Z"}`, *encoded)
+
+	encoded, err = encodeSearchAttributes(map[string]interface{}{"CustomBoolField": nil})
+	assert.NoError(err)
+	assert.Nil(encoded)
+
+	encoded, err = encodeSearchAttributes(nil)
+	assert.NoError(err)
+	assert.Nil(encoded)
+}
+
+func Test_DecodeSearchAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	data := `{"CustomKeywordField":["foo","bar"],"CustomIntField":9007199254740993,"CustomDoubleField":1.5,` +
+		`"CustomBoolField":true,"CustomDatetimeField":"2021-06-01T08:00:00.000000100Z","RemovedField":"foo"}`
+	searchAttributes, err := decodeSearchAttributes(&data, searchattribute.TestNameTypeMap)
+	assert.NoError(err)
+	assert.Equal(map[string]interface{}{
+		"CustomKeywordField":  []interface{}{"foo", "bar"},
+		"CustomIntField":      int64(9007199254740993),
+		"CustomDoubleField":   1.5,
+		"CustomBoolField":     true,
+		"CustomDatetimeField": time.Date(2021, 6, 1, 8, 0, 0, 100, time.UTC),
+	}, searchAttributes)
+
+	searchAttributes, err = decodeSearchAttributes(nil, searchattribute.TestNameTypeMap)
+	assert.NoError(err)
+	assert.Nil(searchAttributes)
+
+	data = `{"CustomKeywordField":"foo","CustomIntField":"42"}`
+	searchAttributes, err = decodeSearchAttributes(&data, searchattribute.TestNameTypeMap)
+	assert.True(errors.Is(err, errUnexpectedJSONFieldType))
+	assert.Equal(map[string]interface{}{"CustomKeywordField": "foo"}, searchAttributes)
+}
+
+func Test_DecodeSearchAttributePayloads(t *testing.T) {
+	assert := assert.New(t)
+
+	intPayload, err := payload.Encode(42)
+	assert.NoError(err)
+	searchAttributes, err := decodeSearchAttributePayloads(&commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomKeywordField":                  payload.EncodeString("foo"),
+			"CustomIntField":                      intPayload,
+			searchattribute.TemporalChangeVersion: payload.EncodeBytes([]byte("bar")),
+		},
+	}, searchattribute.TestNameTypeMap)
+	assert.NoError(err)
+	assert.Equal(map[string]interface{}{
+		"CustomKeywordField":                  "foo",
+		"CustomIntField":                      int64(42),
+		searchattribute.TemporalChangeVersion: "bar",
+	}, searchAttributes)
+
+	_, err = decodeSearchAttributePayloads(&commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomIntField": payload.EncodeBytes([]byte("42")),
+		},
+	}, searchattribute.TestNameTypeMap)
+	assert.True(errors.Is(err, errUnexpectedPayloadType))
+
+	_, err = decodeSearchAttributePayloads(&commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"UnknownField": payload.EncodeString("foo"),
+		},
+	}, searchattribute.TestNameTypeMap)
+	assert.True(errors.Is(err, searchattribute.ErrInvalidName))
+}
@@ -30,15 +30,18 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 	persistencesql "go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 const (
@@ -47,7 +50,10 @@ const (
 
 type (
 	visibilityStore struct {
-		sqlStore persistencesql.SqlStore
+		sqlStore                 persistencesql.SqlStore
+		indexName                string
+		searchAttributesProvider searchattribute.Provider
+		logger                   log.Logger
 	}
 
 	visibilityPageToken struct {
@@ -68,6 +74,8 @@ func newVisibilityContext() (context.Context, context.CancelFunc) {
 func NewSQLVisibilityStore(
 	cfg config.SQL,
 	r resolver.ServiceResolver,
+	indexName string,
+	searchAttributesProvider searchattribute.Provider,
 	logger log.Logger,
 ) (*visibilityStore, error) {
 	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
@@ -76,7 +84,10 @@ func NewSQLVisibilityStore(
 		return nil, err
 	}
 	return &visibilityStore{
-		sqlStore: persistencesql.NewSqlStore(db, logger),
+		sqlStore:                 persistencesql.NewSqlStore(db, logger),
+		indexName:                indexName,
+		searchAttributesProvider: searchAttributesProvider,
+		logger:                   logger,
 	}, nil
 }
 
@@ -91,9 +102,14 @@ func (s *visibilityStore) GetName() string {
 func (s *visibilityStore) RecordWorkflowExecutionStarted(
 	request *visibility.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
+	searchAttributes, err := s.encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := newVisibilityContext()
 	defer cancel()
-	_, err := s.sqlStore.Db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	_, err = s.sqlStore.Db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		NamespaceID:      request.NamespaceID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -103,12 +119,19 @@ func (s *visibilityStore) RecordWorkflowExecutionStarted(
 		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), // Underlying value (1) is hardcoded in SQL queries.
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.EncodingType.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
 }
 
 func (s *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.InternalRecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := s.encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := newVisibilityContext()
 	defer cancel()
 	result, err := s.sqlStore.Db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
@@ -123,6 +146,8 @@ func (s *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.EncodingType.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return err
@@ -138,9 +163,28 @@ func (s *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 }
 
 func (s *visibilityStore) UpsertWorkflowExecution(
-	_ *visibility.InternalUpsertWorkflowExecutionRequest,
+	request *visibility.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	// Not OperationNotSupportedErr!
+	searchAttributes, err := s.encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	// Only open workflow executions are updated. Upsert for closed (or not yet recorded) workflow execution
+	// doesn't update any row and is not an error.
+	_, err = s.sqlStore.Db.UpdateVisibility(ctx, &sqlplugin.VisibilityRow{
+		NamespaceID:      request.NamespaceID,
+		RunID:            request.RunID,
+		Memo:             request.Memo.GetData(),
+		Encoding:         request.Memo.GetEncodingType().String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpsertWorkflowExecution operation failed. Update failed: %v", err))
+	}
 	return nil
 }
 
@@ -316,25 +360,134 @@ func (s *visibilityStore) DeleteWorkflowExecution(
 }
 
 func (s *visibilityStore) ListWorkflowExecutions(
-	_ *visibility.ListWorkflowExecutionsRequestV2,
+	request *visibility.ListWorkflowExecutionsRequestV2,
 ) (*visibility.InternalListWorkflowExecutionsResponse, error) {
-	return nil, visibility.OperationNotSupportedErr
+	return s.listWorkflowExecutionsByQuery("ListWorkflowExecutions", request)
 }
 
 func (s *visibilityStore) ScanWorkflowExecutions(
-	_ *visibility.ListWorkflowExecutionsRequestV2,
+	request *visibility.ListWorkflowExecutionsRequestV2,
 ) (*visibility.InternalListWorkflowExecutionsResponse, error) {
-	return nil, visibility.OperationNotSupportedErr
+	// SQL doesn't have scroll API, therefore Scan is the same as List with the same query.
+	return s.listWorkflowExecutionsByQuery("ScanWorkflowExecutions", request)
 }
 
 func (s *visibilityStore) CountWorkflowExecutions(
-	_ *visibility.CountWorkflowExecutionsRequest,
+	request *visibility.CountWorkflowExecutionsRequest,
 ) (*visibility.CountWorkflowExecutionsResponse, error) {
-	return nil, visibility.OperationNotSupportedErr
+	converter, err := s.newQueryConverter()
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, err := converter.buildCountQuery(request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	count, err := s.sqlStore.Db.CountFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+		Query:     query,
+		QueryArgs: queryArgs,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return &visibility.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *visibilityStore) listWorkflowExecutionsByQuery(
+	opName string,
+	request *visibility.ListWorkflowExecutionsRequestV2,
+) (*visibility.InternalListWorkflowExecutionsResponse, error) {
+	var token *visibilityPageToken
+	if len(request.NextPageToken) > 0 {
+		var err error
+		token, err = s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v operation failed. Unable to deserialize page token: %v", opName, err))
+		}
+	}
+
+	converter, err := s.newQueryConverter()
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, err := converter.buildSelectQuery(request.NamespaceID, request.Query, token, request.PageSize)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+		Query:     query,
+		QueryArgs: queryArgs,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+
+	infos := make([]*visibility.VisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row, converter.typeMap)
+	}
+
+	var nextPageToken []byte
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			Time:  lastRow.StartTime,
+			RunID: lastRow.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &visibility.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *visibilityStore) newQueryConverter() (*queryConverter, error) {
+	pluginConverter := newPluginQueryConverter(s.sqlStore.GetName())
+	if pluginConverter == nil {
+		return nil, visibility.OperationNotSupportedErr
+	}
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	return newQueryConverter(pluginConverter, typeMap), nil
+}
+
+func (s *visibilityStore) encodeSearchAttributes(
+	searchAttributesPayloads *commonpb.SearchAttributes,
+) (*string, error) {
+	if len(searchAttributesPayloads.GetIndexedFields()) == 0 {
+		return nil, nil
+	}
+
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+
+	searchAttributes, err := decodeSearchAttributePayloads(searchAttributesPayloads, typeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode search attributes: %v", err))
+	}
+	encoded, err := encodeSearchAttributes(searchAttributes)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes: %v", err))
+	}
+	return encoded, nil
 }
 
 func (s *visibilityStore) rowToInfo(
 	row *sqlplugin.VisibilityRow,
+	typeMap searchattribute.NameTypeMap,
 ) *visibility.VisibilityWorkflowExecutionInfo {
 	if row.ExecutionTime.UnixNano() == 0 {
 		row.ExecutionTime = row.StartTime
@@ -347,7 +500,16 @@ func (s *visibilityStore) rowToInfo(
 		ExecutionTime: row.ExecutionTime,
 		Memo:          persistence.NewDataBlob(row.Memo, row.Encoding),
 		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+		TaskQueue:     row.TaskQueue,
 	}
+	searchAttributes, err := decodeSearchAttributes(row.SearchAttributes, typeMap)
+	if err != nil {
+		s.logger.Error("Unable to decode search attributes.",
+			tag.WorkflowID(row.WorkflowID),
+			tag.WorkflowRunID(row.RunID),
+			tag.Error(err))
+	}
+	info.SearchAttributes = searchAttributes
 	if row.CloseTime != nil {
 		info.CloseTime = *row.CloseTime
 		info.HistoryLength = *row.HistoryLength
@@ -384,9 +546,14 @@ func (s *visibilityStore) listWorkflowExecutions(
 		return &visibility.InternalListWorkflowExecutionsResponse{}, nil
 	}
 
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		s.logger.Error("Unable to read search attribute types.", tag.Error(err))
+	}
+
 	var infos = make([]*visibility.VisibilityWorkflowExecutionInfo, len(rows))
 	for i, row := range rows {
-		infos[i] = s.rowToInfo(&row)
+		infos[i] = s.rowToInfo(&row, typeMap)
 	}
 	var nextPageToken []byte
 	lastRow := rows[len(rows)-1]
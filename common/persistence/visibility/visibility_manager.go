@@ -51,6 +51,9 @@ type (
 		Memo                 *commonpb.Memo
 		TaskQueue            string
 		SearchAttributes     *commonpb.SearchAttributes
+		// FailOnVersionConflict is used by ES only, the write fails if the record already has the same or newer version
+		// instead of being ignored.
+		FailOnVersionConflict bool
 	}
 
 	// RecordWorkflowExecutionStartedRequest is used to add a record of a newly started execution
@@ -107,7 +107,7 @@ func (v *visibilityManagerWrapper) RecordWorkflowExecutionClosed(request *Record
 func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
 	switch v.advancedVisWritingMode() {
 	case common.AdvancedVisibilityWritingModeOff:
-		// no op on SQL/Cassandra persistence.
+		// no op on Cassandra persistence.
 		return v.visibilityManager.UpsertWorkflowExecution(request)
 	case common.AdvancedVisibilityWritingModeOn:
 		return v.esVisibilityManager.UpsertWorkflowExecution(request)
@@ -115,7 +115,7 @@ func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkfl
 		if err := v.esVisibilityManager.UpsertWorkflowExecution(request); err != nil {
 			return err
 		}
-		// no op on SQL/Cassandra persistence.
+		// no op on Cassandra persistence.
 		return v.visibilityManager.UpsertWorkflowExecution(request)
 	default:
 		return serviceerror.NewInternal(fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()))
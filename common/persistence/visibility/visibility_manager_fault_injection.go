@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	visibilityFaultInjectionPersistenceClient struct {
+		faultInjector *persistence.FaultInjector
+		persistence   VisibilityManager
+		logger        log.Logger
+	}
+)
+
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+
+// NewVisibilityPersistenceFaultInjectionClient creates a client to manage visibility which injects faults,
+// the client takes over the fault injector and closes it on Close
+func NewVisibilityPersistenceFaultInjectionClient(persistence VisibilityManager, faultInjector *persistence.FaultInjector, logger log.Logger) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionStarted"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionClosed"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("UpsertWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpsertWorkflowExecution(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByStatus"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ScanWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ScanWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("CountWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.CountWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+	p.faultInjector.Close()
+}
@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestVisibilityFaultInjectionClient(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	subscribed := false
+	var faults dynamicconfig.MapPropertySubscriptionFn = func(callback func(map[string]interface{}), _ ...dynamicconfig.FilterOption) func() {
+		subscribed = true
+		callback(map[string]interface{}{
+			"UpsertWorkflowExecution": map[string]interface{}{
+				"errorType": persistence.FaultTypeResourceExhausted,
+			},
+		})
+		return func() { subscribed = false }
+	}
+
+	manager := NewMockVisibilityManager(controller)
+	client := NewVisibilityPersistenceFaultInjectionClient(manager, persistence.NewFaultInjector(faults, log.NewNoopLogger()), log.NewNoopLogger())
+	require.True(t, subscribed)
+
+	err := client.UpsertWorkflowExecution(&UpsertWorkflowExecutionRequest{})
+	require.IsType(t, &serviceerror.ResourceExhausted{}, err)
+
+	// closing the client stops following the faults in dynamic config
+	manager.EXPECT().Close()
+	client.Close()
+	require.False(t, subscribed)
+}
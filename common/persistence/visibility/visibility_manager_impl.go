@@ -25,6 +25,7 @@
 package visibility
 
 import (
+	"errors"
 	"time"
 
 	"go.temporal.io/server/common/persistence/serialization"
@@ -43,6 +44,7 @@ type (
 		serializer                 serialization.Serializer
 		store                      VisibilityStore
 		searchAttributesProvider   searchattribute.Provider
+		searchAttributesMapper     searchattribute.Mapper
 		defaultVisibilityIndexName string
 		logger                     log.Logger
 	}
@@ -54,11 +56,13 @@ const MemoEncoding = enumspb.ENCODING_TYPE_PROTO3
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
 // NewVisibilityManagerImpl returns new VisibilityManager
-func NewVisibilityManagerImpl(store VisibilityStore, searchAttributesProvider searchattribute.Provider, defaultVisibilityIndexName string, logger log.Logger) VisibilityManager {
+// searchAttributesMapper can be nil if store doesn't support custom search attributes registered per namespace.
+func NewVisibilityManagerImpl(store VisibilityStore, searchAttributesProvider searchattribute.Provider, searchAttributesMapper searchattribute.Mapper, defaultVisibilityIndexName string, logger log.Logger) VisibilityManager {
 	return &visibilityManagerImpl{
 		serializer:                 serialization.NewSerializer(),
 		store:                      store,
 		searchAttributesProvider:   searchAttributesProvider,
+		searchAttributesMapper:     searchAttributesMapper,
 		defaultVisibilityIndexName: defaultVisibilityIndexName,
 		logger:                     logger,
 	}
@@ -109,7 +113,9 @@ func (v *visibilityManagerImpl) newInternalVisibilityRequestBase(request *Visibi
 		ShardID:          request.ShardID,
 		TaskQueue:        request.TaskQueue,
 		Memo:             v.serializeMemo(request.Memo, request.NamespaceID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
-		SearchAttributes: request.SearchAttributes,
+		SearchAttributes: v.aliasesToFieldNames(request.SearchAttributes, request.Namespace),
+
+		FailOnVersionConflict: request.FailOnVersionConflict,
 	}
 }
 
@@ -118,7 +124,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutions(request *ListWorkflow
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -126,7 +132,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutions(request *ListWorkfl
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -134,7 +140,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(request *ListWo
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -142,7 +148,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(request *List
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -150,7 +156,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(request *
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -158,7 +164,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(request
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -166,7 +172,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(request *Li
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
@@ -178,7 +184,7 @@ func (v *visibilityManagerImpl) ListWorkflowExecutions(request *ListWorkflowExec
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
@@ -186,14 +192,14 @@ func (v *visibilityManagerImpl) ScanWorkflowExecutions(request *ListWorkflowExec
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp, request.Namespace), nil
 }
 
 func (v *visibilityManagerImpl) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	return v.store.CountWorkflowExecutions(request)
 }
 
-func (v *visibilityManagerImpl) convertInternalListResponse(internalResp *InternalListWorkflowExecutionsResponse) *ListWorkflowExecutionsResponse {
+func (v *visibilityManagerImpl) convertInternalListResponse(internalResp *InternalListWorkflowExecutionsResponse, namespace string) *ListWorkflowExecutionsResponse {
 	if internalResp == nil {
 		return nil
 	}
@@ -205,14 +211,14 @@ func (v *visibilityManagerImpl) convertInternalListResponse(internalResp *Intern
 		v.logger.Error("Unable to read valid search attributes.", tag.Error(err))
 	}
 	for i, execution := range internalResp.Executions {
-		resp.Executions[i] = v.convertVisibilityWorkflowExecutionInfo(execution, saTypeMap)
+		resp.Executions[i] = v.convertVisibilityWorkflowExecutionInfo(execution, saTypeMap, namespace)
 	}
 
 	resp.NextPageToken = internalResp.NextPageToken
 	return resp
 }
 
-func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution *VisibilityWorkflowExecutionInfo, saTypeMap searchattribute.NameTypeMap) *workflowpb.WorkflowExecutionInfo {
+func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution *VisibilityWorkflowExecutionInfo, saTypeMap searchattribute.NameTypeMap, namespace string) *workflowpb.WorkflowExecutionInfo {
 	memo, err := v.serializer.DeserializeVisibilityMemo(execution.Memo)
 	if err != nil {
 		v.logger.Error("failed to deserialize memo",
@@ -239,7 +245,7 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 		StartTime:            &execution.StartTime,
 		ExecutionTime:        &execution.ExecutionTime,
 		Memo:                 memo,
-		SearchAttributes:     searchAttributes,
+		SearchAttributes:     v.fieldNamesToAliases(searchAttributes, saTypeMap, namespace),
 		TaskQueue:            execution.TaskQueue,
 		Status:               execution.Status,
 		StateTransitionCount: execution.StateTransitionCount,
@@ -262,6 +268,54 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 	return convertedExecution
 }
 
+// aliasesToFieldNames replaces names of custom search attributes registered in the namespace
+// with names of the generic fields which store them.
+func (v *visibilityManagerImpl) aliasesToFieldNames(searchAttributes *commonpb.SearchAttributes, namespace string) *commonpb.SearchAttributes {
+	if v.searchAttributesMapper == nil || len(searchAttributes.GetIndexedFields()) == 0 {
+		return searchAttributes
+	}
+
+	saTypeMap, err := v.searchAttributesProvider.GetSearchAttributes(v.defaultVisibilityIndexName, false)
+	if err != nil {
+		v.logger.Error("Unable to read valid search attributes.", tag.Error(err))
+	}
+
+	indexedFields := make(map[string]*commonpb.Payload, len(searchAttributes.GetIndexedFields()))
+	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		if !saTypeMap.IsDefined(saName) {
+			fieldName, err := v.searchAttributesMapper.GetFieldName(saName, namespace)
+			if err == nil {
+				saName = fieldName
+			} else if !errors.Is(err, searchattribute.ErrInvalidName) {
+				v.logger.Error("Unable to map search attribute to field name.", tag.Name(saName), tag.WorkflowNamespace(namespace), tag.Error(err))
+			}
+		}
+		indexedFields[saName] = saPayload
+	}
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
+}
+
+// fieldNamesToAliases replaces names of the generic fields with names of custom search attributes registered in the namespace.
+// Generic fields which are not assigned in the namespace are removed.
+func (v *visibilityManagerImpl) fieldNamesToAliases(searchAttributes *commonpb.SearchAttributes, saTypeMap searchattribute.NameTypeMap, namespace string) *commonpb.SearchAttributes {
+	if v.searchAttributesMapper == nil || len(searchAttributes.GetIndexedFields()) == 0 {
+		return searchAttributes
+	}
+
+	indexedFields := make(map[string]*commonpb.Payload, len(searchAttributes.GetIndexedFields()))
+	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		if _, err := saTypeMap.GetGenericFieldType(saName); err == nil {
+			alias, err := v.searchAttributesMapper.GetAlias(saName, namespace)
+			if err != nil {
+				continue
+			}
+			saName = alias
+		}
+		indexedFields[saName] = saPayload
+	}
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
+}
+
 func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *commonpb.Memo, namespaceID, wID, rID string) *commonpb.DataBlob {
 	memo, err := v.serializer.SerializeVisibilityMemo(visibilityMemo, MemoEncoding)
 	if err != nil {
@@ -37,6 +37,8 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
+	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 	"go.temporal.io/server/common/resolver"
@@ -66,14 +68,17 @@ type (
 		ESClient                     esclient.Client
 		ESConfig                     *config.Elasticsearch
 		DynamicConfigClient          dynamicconfig.Client
-		DCRedirectionPolicy          config.DCRedirectionPolicy
-		SdkClient                    sdkclient.Client
-		ArchivalMetadata             archiver.ArchivalMetadata
-		ArchiverProvider             provider.ArchiverProvider
-		Authorizer                   authorization.Authorizer
-		ClaimMapper                  authorization.ClaimMapper
-		PersistenceServiceResolver   resolver.ServiceResolver
-		AudienceGetter               authorization.JWTAudienceMapper
+		// DynamicConfigManager is nil if persisted dynamic config is not available
+		DynamicConfigManager       persistence.DynamicConfigManager
+		DCRedirectionPolicy        config.DCRedirectionPolicy
+		SdkClient                  sdkclient.Client
+		ArchivalMetadata           archiver.ArchivalMetadata
+		ArchiverProvider           provider.ArchiverProvider
+		PayloadOffloader           payloadoffload.Offloader
+		Authorizer                 authorization.Authorizer
+		ClaimMapper                authorization.ClaimMapper
+		PersistenceServiceResolver resolver.ServiceResolver
+		AudienceGetter             authorization.JWTAudienceMapper
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
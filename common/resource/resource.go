@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 )
@@ -65,6 +66,7 @@ type (
 		GetClusterMetadata() cluster.Metadata
 		GetSearchAttributesProvider() searchattribute.Provider
 		GetSearchAttributesManager() searchattribute.Manager
+		GetSearchAttributesMapper() searchattribute.Mapper
 
 		// other common resources
 
@@ -73,6 +75,7 @@ type (
 		GetPayloadSerializer() serialization.Serializer
 		GetMetricsClient() metrics.Client
 		GetArchiverProvider() provider.ArchiverProvider
+		GetPayloadOffloader() payloadoffload.Offloader
 
 		// membership infos
 
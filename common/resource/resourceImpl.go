@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 )
@@ -69,6 +70,7 @@ type (
 	VisibilityManagerInitializer func(
 		persistenceBean persistenceClient.Bean,
 		searchAttributesProvider searchattribute.Provider,
+		searchAttributesMapper searchattribute.Mapper,
 		logger log.Logger,
 	) (visibility.VisibilityManager, error)
 
@@ -86,6 +88,7 @@ type (
 		clusterMetadata cluster.Metadata
 		saProvider      searchattribute.Provider
 		saManager       searchattribute.Manager
+		saMapper        searchattribute.Mapper
 
 		// other common resources
 
@@ -95,6 +98,7 @@ type (
 		metricsClient     metrics.Client
 		archivalMetadata  archiver.ArchivalMetadata
 		archiverProvider  provider.ArchiverProvider
+		payloadOffloader  payloadoffload.Offloader
 
 		// membership infos
 
@@ -250,22 +254,25 @@ func New(
 
 	saManager := persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), persistenceBean.GetClusterMetadataManager())
 
+	namespaceCache := cache.NewNamespaceCache(
+		persistenceBean.GetMetadataManager(),
+		clusterMetadata,
+		params.MetricsClient,
+		logger,
+	)
+
+	saMapper := cache.NewSearchAttributesMapper(namespaceCache)
+
 	visibilityMgr, err := visibilityManagerInitializer(
 		persistenceBean,
 		saProvider,
+		saMapper,
 		logger,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	namespaceCache := cache.NewNamespaceCache(
-		persistenceBean.GetMetadataManager(),
-		clusterMetadata,
-		params.MetricsClient,
-		logger,
-	)
-
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := frontend.NewRetryableClient(
 		frontendRawClient,
@@ -290,8 +297,14 @@ func New(
 		common.IsWhitelistServiceTransientError,
 	)
 
+	payloadOffloader := params.PayloadOffloader
+	if payloadOffloader == nil {
+		payloadOffloader = payloadoffload.NewDisabledOffloader()
+	}
+
+	// archived histories contain the offloaded payloads, which are deleted together with the history
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
-		ExecutionManager: persistenceBean.GetExecutionManager(),
+		ExecutionManager: payloadoffload.NewRehydratingExecutionManager(persistenceBean.GetExecutionManager(), payloadOffloader),
 		Logger:           logger,
 		MetricsClient:    params.MetricsClient,
 		ClusterMetadata:  clusterMetadata,
@@ -323,6 +336,7 @@ func New(
 		clusterMetadata: clusterMetadata,
 		saProvider:      saProvider,
 		saManager:       saManager,
+		saMapper:        saMapper,
 
 		// other common resources
 
@@ -332,6 +346,7 @@ func New(
 		metricsClient:     params.MetricsClient,
 		archivalMetadata:  params.ArchivalMetadata,
 		archiverProvider:  params.ArchiverProvider,
+		payloadOffloader:  payloadOffloader,
 
 		// membership infos
 
@@ -482,6 +497,11 @@ func (h *Impl) GetArchiverProvider() provider.ArchiverProvider {
 	return h.archiverProvider
 }
 
+// GetPayloadOffloader return payload offloader
+func (h *Impl) GetPayloadOffloader() payloadoffload.Offloader {
+	return h.payloadOffloader
+}
+
 // membership infos
 
 // GetMembershipMonitor return the membership monitor
@@ -633,3 +653,7 @@ func (h *Impl) GetSearchAttributesProvider() searchattribute.Provider {
 func (h *Impl) GetSearchAttributesManager() searchattribute.Manager {
 	return h.saManager
 }
+
+func (h *Impl) GetSearchAttributesMapper() searchattribute.Mapper {
+	return h.saMapper
+}
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -64,6 +65,7 @@ type (
 		ClusterMetadata          *cluster.MockMetadata
 		SearchAttributesProvider *searchattribute.MockProvider
 		SearchAttributesManager  *searchattribute.MockManager
+		SearchAttributesMapper   *searchattribute.MockMapper
 
 		// other common resources
 
@@ -73,6 +75,7 @@ type (
 		MetricsClient     metrics.Client
 		ArchivalMetadata  *archiver.MockArchivalMetadata
 		ArchiverProvider  *provider.MockArchiverProvider
+		PayloadOffloader  payloadoffload.Offloader
 
 		// membership infos
 
@@ -172,6 +175,7 @@ func NewTest(
 		ClusterMetadata:          cluster.NewMockMetadata(controller),
 		SearchAttributesProvider: searchattribute.NewMockProvider(controller),
 		SearchAttributesManager:  searchattribute.NewMockManager(controller),
+		SearchAttributesMapper:   searchattribute.NewMockMapper(controller),
 
 		// other common resources
 
@@ -181,6 +185,7 @@ func NewTest(
 		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
 		ArchivalMetadata:  archiver.NewMockArchivalMetadata(controller),
 		ArchiverProvider:  provider.NewMockArchiverProvider(controller),
+		PayloadOffloader:  payloadoffload.NewDisabledOffloader(),
 
 		// membership infos
 
@@ -287,6 +292,11 @@ func (s *Test) GetArchiverProvider() provider.ArchiverProvider {
 	return s.ArchiverProvider
 }
 
+// GetPayloadOffloader for testing
+func (s *Test) GetPayloadOffloader() payloadoffload.Offloader {
+	return s.PayloadOffloader
+}
+
 // membership infos
 
 // GetMembershipMonitor for testing
@@ -434,3 +444,7 @@ func (h *Test) GetSearchAttributesProvider() searchattribute.Provider {
 func (h *Test) GetSearchAttributesManager() searchattribute.Manager {
 	return h.SearchAttributesManager
 }
+
+func (h *Test) GetSearchAttributesMapper() searchattribute.Mapper {
+	return h.SearchAttributesMapper
+}
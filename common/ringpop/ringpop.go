@@ -75,6 +75,11 @@ func ValidateRingpopConfig(rpConfig *config.Membership) error {
 	if rpConfig.BroadcastAddress != "" && net.ParseIP(rpConfig.BroadcastAddress) == nil {
 		return fmt.Errorf("ringpop config malformed `broadcastAddress` param")
 	}
+	switch rpConfig.Provider {
+	case "", config.MembershipProviderRingpop, config.MembershipProviderDatabase:
+	default:
+		return fmt.Errorf("membership config malformed `provider` param: %v", rpConfig.Provider)
+	}
 	return nil
 }
 
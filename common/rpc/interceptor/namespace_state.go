@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cache"
+)
+
+const (
+	workflowServiceName = "temporal.api.workflowservice.v1.WorkflowService"
+)
+
+var (
+	ErrNamespaceDeleted = serviceerror.NewInvalidArgument("Namespace is being deleted.")
+
+	// read only APIs which are still allowed on a namespace which is being deleted,
+	// so that the progress of the deletion can be observed
+	deletedNamespaceAllowedAPIs = map[string]struct{}{
+		"DescribeNamespace":              {},
+		"DescribeWorkflowExecution":      {},
+		"GetWorkflowExecutionHistory":    {},
+		"ListOpenWorkflowExecutions":     {},
+		"ListClosedWorkflowExecutions":   {},
+		"ListWorkflowExecutions":         {},
+		"ListArchivedWorkflowExecutions": {},
+		"ScanWorkflowExecutions":         {},
+		"CountWorkflowExecutions":        {},
+		"GetSearchAttributes":            {},
+		"GetClusterInfo":                 {},
+		"ListNamespaces":                 {},
+	}
+)
+
+type (
+	NamespaceStateInterceptor struct {
+		namespaceCache cache.NamespaceCache
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*NamespaceStateInterceptor)(nil).Intercept
+
+func NewNamespaceStateInterceptor(
+	namespaceCache cache.NamespaceCache,
+) *NamespaceStateInterceptor {
+	return &NamespaceStateInterceptor{
+		namespaceCache: namespaceCache,
+	}
+}
+
+func (ni *NamespaceStateInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	serviceName, methodName := splitMethodName(info.FullMethod)
+	if serviceName != workflowServiceName {
+		return handler(ctx, req)
+	}
+	if _, ok := deletedNamespaceAllowedAPIs[methodName]; ok {
+		return handler(ctx, req)
+	}
+
+	namespace := GetNamespace(ni.namespaceCache, req)
+	if namespace == "" {
+		return handler(ctx, req)
+	}
+	namespaceEntry, err := ni.namespaceCache.GetNamespace(namespace)
+	if err != nil {
+		// let the handler deal with unknown namespaces
+		return handler(ctx, req)
+	}
+	if namespaceEntry.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED {
+		return nil, ErrNamespaceDeleted
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+)
+
+type (
+	namespaceStateSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockNamespaceCache *cache.MockNamespaceCache
+
+		interceptor *NamespaceStateInterceptor
+	}
+)
+
+func TestNamespaceStateSuite(t *testing.T) {
+	s := new(namespaceStateSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceStateSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+
+	s.interceptor = NewNamespaceStateInterceptor(s.mockNamespaceCache)
+}
+
+func (s *namespaceStateSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceStateSuite) TestIntercept_Registered() {
+	s.mockNamespaceCache.EXPECT().GetNamespace("test-namespace").Return(s.namespaceEntry(enumspb.NAMESPACE_STATE_REGISTERED), nil)
+
+	_, err := s.intercept("StartWorkflowExecution", &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+}
+
+func (s *namespaceStateSuite) TestIntercept_Deleted() {
+	s.mockNamespaceCache.EXPECT().GetNamespace("test-namespace").Return(s.namespaceEntry(enumspb.NAMESPACE_STATE_DELETED), nil)
+
+	_, err := s.intercept("StartWorkflowExecution", &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"})
+	s.Equal(ErrNamespaceDeleted, err)
+}
+
+func (s *namespaceStateSuite) TestIntercept_Deleted_AllowedAPI() {
+	_, err := s.intercept("DescribeNamespace", &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+}
+
+func (s *namespaceStateSuite) TestIntercept_OtherService() {
+	info := &grpc.UnaryServerInfo{FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"}
+	_, err := s.interceptor.Intercept(context.Background(), nil, info, s.handler)
+	s.NoError(err)
+}
+
+func (s *namespaceStateSuite) intercept(methodName string, req interface{}) (interface{}, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + workflowServiceName + "/" + methodName}
+	return s.interceptor.Intercept(context.Background(), req, info, s.handler)
+}
+
+func (s *namespaceStateSuite) handler(_ context.Context, _ interface{}) (interface{}, error) {
+	return struct{}{}, nil
+}
+
+func (s *namespaceStateSuite) namespaceEntry(state enumspb.NamespaceState) *cache.NamespaceCacheEntry {
+	return cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Name: "test-namespace", State: state},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+}
@@ -25,6 +25,8 @@
 package searchattribute
 
 import (
+	"fmt"
+
 	enumspb "go.temporal.io/api/enums/v1"
 )
 
@@ -76,6 +78,20 @@ var (
 		BatcherUser:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
+	// genericFields are pre-created Elasticsearch fields which back custom search attributes registered per namespace.
+	// Fields of every type are listed in the order they are assigned.
+	genericFields = map[enumspb.IndexedValueType][]string{
+		enumspb.INDEXED_VALUE_TYPE_STRING:   genericFieldNames("Text", 3),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD:  genericFieldNames("Keyword", 10),
+		enumspb.INDEXED_VALUE_TYPE_INT:      genericFieldNames("Int", 3),
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE:   genericFieldNames("Double", 3),
+		enumspb.INDEXED_VALUE_TYPE_BOOL:     genericFieldNames("Bool", 3),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME: genericFieldNames("Datetime", 3),
+	}
+
+	// generic is a type map of all genericFields.
+	generic = buildGenericTypeMap()
+
 	// reserved are internal field names that can't be used as search attribute names.
 	reserved = map[string]struct{}{
 		NamespaceID:       {},
@@ -96,5 +112,37 @@ func IsReserved(fieldName string) bool {
 	if _, ok := reserved[fieldName]; ok {
 		return true
 	}
+	if _, ok := generic[fieldName]; ok {
+		return true
+	}
 	return false
 }
+
+// IsSystem returns true if search attribute is system and is stored as separate field rather than in SearchAttributes object.
+func IsSystem(name string) bool {
+	_, ok := system[name]
+	return ok
+}
+
+// GenericFields returns names of pre-created fields of the given type which can back namespace search attributes.
+func GenericFields(saType enumspb.IndexedValueType) []string {
+	return genericFields[saType]
+}
+
+func genericFieldNames(prefix string, count int) []string {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s%02d", prefix, i+1)
+	}
+	return names
+}
+
+func buildGenericTypeMap() map[string]enumspb.IndexedValueType {
+	typeMap := make(map[string]enumspb.IndexedValueType)
+	for saType, fieldNames := range genericFields {
+		for _, fieldName := range fieldNames {
+			typeMap[fieldName] = saType
+		}
+	}
+	return typeMap
+}
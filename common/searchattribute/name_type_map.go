@@ -44,6 +44,7 @@ const (
 	systemCategory category = 1 << iota
 	predefinedCategory
 	customCategory
+	genericCategory
 )
 
 func BuildIndexNameTypeMap(indexSearchAttributes map[string]*persistencespb.IndexSearchAttributes) map[string]NameTypeMap {
@@ -107,9 +108,19 @@ func (m NameTypeMap) getType(name string, cat category) (enumspb.IndexedValueTyp
 			return t, nil
 		}
 	}
+	if cat|genericCategory == cat {
+		if t, isGeneric := generic[name]; isGeneric {
+			return t, nil
+		}
+	}
 	return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("%w: %s", ErrInvalidName, name)
 }
 
+// GetGenericFieldType returns type of pre-created field which backs namespace search attribute.
+func (m NameTypeMap) GetGenericFieldType(fieldName string) (enumspb.IndexedValueType, error) {
+	return m.getType(fieldName, genericCategory)
+}
+
 func (m NameTypeMap) IsDefined(name string) bool {
 	if _, err := m.GetType(name); err == nil {
 		return true
@@ -47,6 +47,13 @@ type (
 		Provider
 		SaveSearchAttributes(indexName string, newCustomSearchAttributes map[string]enumspb.IndexedValueType) error
 	}
+
+	// Mapper maps custom search attributes registered in a namespace (aliases)
+	// to the pre-created generic fields which store them and back.
+	Mapper interface {
+		GetFieldName(alias string, namespace string) (string, error)
+		GetAlias(fieldName string, namespace string) (string, error)
+	}
 )
 
 var (
@@ -124,3 +124,56 @@ func (mr *MockManagerMockRecorder) SaveSearchAttributes(indexName, newCustomSear
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSearchAttributes", reflect.TypeOf((*MockManager)(nil).SaveSearchAttributes), indexName, newCustomSearchAttributes)
 }
+
+// MockMapper is a mock of Mapper interface.
+type MockMapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockMapperMockRecorder
+}
+
+// MockMapperMockRecorder is the mock recorder for MockMapper.
+type MockMapperMockRecorder struct {
+	mock *MockMapper
+}
+
+// NewMockMapper creates a new mock instance.
+func NewMockMapper(ctrl *gomock.Controller) *MockMapper {
+	mock := &MockMapper{ctrl: ctrl}
+	mock.recorder = &MockMapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMapper) EXPECT() *MockMapperMockRecorder {
+	return m.recorder
+}
+
+// GetAlias mocks base method.
+func (m *MockMapper) GetAlias(fieldName, namespace string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlias", fieldName, namespace)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlias indicates an expected call of GetAlias.
+func (mr *MockMapperMockRecorder) GetAlias(fieldName, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlias", reflect.TypeOf((*MockMapper)(nil).GetAlias), fieldName, namespace)
+}
+
+// GetFieldName mocks base method.
+func (m *MockMapper) GetFieldName(alias, namespace string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFieldName", alias, namespace)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFieldName indicates an expected call of GetFieldName.
+func (mr *MockMapperMockRecorder) GetFieldName(alias, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFieldName", reflect.TypeOf((*MockMapper)(nil).GetFieldName), alias, namespace)
+}
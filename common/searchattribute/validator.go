@@ -29,6 +29,7 @@ import (
 	"fmt"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/dynamicconfig"
@@ -43,6 +44,7 @@ type (
 		logger log.Logger
 
 		searchAttributesProvider          Provider
+		searchAttributesMapper            Mapper
 		searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
 		searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
 		searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -57,6 +59,7 @@ var (
 func NewValidator(
 	logger log.Logger,
 	searchAttributesProvider Provider,
+	searchAttributesMapper Mapper,
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
@@ -64,6 +67,7 @@ func NewValidator(
 	return &Validator{
 		logger:                            logger,
 		searchAttributesProvider:          searchAttributesProvider,
+		searchAttributesMapper:            searchAttributesMapper,
 		searchAttributesNumberOfKeysLimit: searchAttributesNumberOfKeysLimit,
 		searchAttributesSizeOfValueLimit:  searchAttributesSizeOfValueLimit,
 		searchAttributesTotalSizeLimit:    searchAttributesTotalSizeLimit,
@@ -101,6 +105,9 @@ func (v *Validator) Validate(searchAttributes *commonpb.SearchAttributes, namesp
 		}
 
 		saType, err := typeMap.getType(saName, customCategory|predefinedCategory)
+		if errors.Is(err, ErrInvalidName) {
+			saType, err = v.getNamespaceSearchAttributeType(saName, namespace, typeMap)
+		}
 		if err != nil {
 			if errors.Is(err, ErrInvalidName) {
 				return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is not a valid search attribute name", saName))
@@ -120,6 +127,18 @@ func (v *Validator) Validate(searchAttributes *commonpb.SearchAttributes, namesp
 	return nil
 }
 
+// getNamespaceSearchAttributeType returns type of custom search attribute registered in the namespace.
+func (v *Validator) getNamespaceSearchAttributeType(alias string, namespace string, typeMap NameTypeMap) (enumspb.IndexedValueType, error) {
+	if v.searchAttributesMapper == nil {
+		return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("%w: %s", ErrInvalidName, alias)
+	}
+	fieldName, err := v.searchAttributesMapper.GetFieldName(alias, namespace)
+	if err != nil {
+		return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, err
+	}
+	return typeMap.GetGenericFieldType(fieldName)
+}
+
 // ValidateSize validate search attributes are valid for writing and not exceed limits
 func (v *Validator) ValidateSize(searchAttributes *commonpb.SearchAttributes, namespace string) error {
 	if searchAttributes == nil {
@@ -25,8 +25,10 @@
 package searchattribute
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 
@@ -51,6 +53,7 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate() {
 
 	saValidator := NewValidator(log.NewNoopLogger(),
 		NewTestProvider(),
+		nil,
 		dynamicconfig.GetIntPropertyFilteredByNamespace(numOfKeysLimit),
 		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfTotalLimit))
@@ -117,6 +120,56 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate() {
 	s.Equal("StartTime attribute can't be set in SearchAttributes", err.Error())
 }
 
+func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate_NamespaceAlias() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+
+	namespace := "namespace"
+	mapper := NewMockMapper(controller)
+	mapper.EXPECT().GetFieldName("AliasIntField", namespace).Return("Int01", nil).AnyTimes()
+	mapper.EXPECT().GetFieldName("InvalidKey", namespace).Return("", fmt.Errorf("%w: %s", ErrInvalidName, "InvalidKey")).AnyTimes()
+
+	saValidator := NewValidator(log.NewNoopLogger(),
+		NewTestProvider(),
+		mapper,
+		dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(100),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(1000))
+
+	intPayload, err := payload.Encode(1)
+	s.NoError(err)
+	attr := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomIntField": intPayload,
+			"AliasIntField":  intPayload,
+		},
+	}
+	err = saValidator.Validate(attr, namespace, "")
+	s.NoError(err)
+
+	attr.IndexedFields = map[string]*commonpb.Payload{
+		"AliasIntField": payload.EncodeString("one"),
+	}
+	err = saValidator.Validate(attr, namespace, "")
+	s.Error(err)
+	s.Equal("one is not a valid value for search attribute AliasIntField of type Int", err.Error())
+
+	attr.IndexedFields = map[string]*commonpb.Payload{
+		"InvalidKey": intPayload,
+	}
+	err = saValidator.Validate(attr, namespace, "")
+	s.Error(err)
+	s.Equal("InvalidKey is not a valid search attribute name", err.Error())
+
+	attr.IndexedFields = map[string]*commonpb.Payload{
+		"Int01": intPayload,
+	}
+	mapper.EXPECT().GetFieldName("Int01", namespace).Return("", fmt.Errorf("%w: %s", ErrInvalidName, "Int01"))
+	err = saValidator.Validate(attr, namespace, "")
+	s.Error(err)
+	s.Equal("Int01 is not a valid search attribute name", err.Error())
+}
+
 func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize() {
 	numOfKeysLimit := 2
 	sizeOfValueLimit := 5
@@ -124,6 +177,7 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize() {
 
 	saValidator := NewValidator(log.NewNoopLogger(),
 		NewTestProvider(),
+		nil,
 		dynamicconfig.GetIntPropertyFilteredByNamespace(numOfKeysLimit),
 		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfTotalLimit))
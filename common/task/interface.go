@@ -102,6 +102,8 @@ const (
 	SchedulerTypeFIFO SchedulerType = iota + 1
 	// SchedulerTypeWRR is the scheduler type for weighted round robin scheduler implementation
 	SchedulerTypeWRR
+	// SchedulerTypeNamespaceFair is the scheduler type for namespace fair scheduler implementation
+	SchedulerTypeNamespaceFair
 )
 
 const (
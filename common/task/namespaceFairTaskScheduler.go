@@ -0,0 +1,418 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// NamespaceFairTaskSchedulerOptions configs namespace fair task scheduler
+	NamespaceFairTaskSchedulerOptions struct {
+		// Weights is the weight of each task priority, same as WeightedRoundRobinTaskSchedulerOptions
+		Weights dynamicconfig.MapPropertyFn
+		// NamespaceFn returns the namespace a task should be accounted to
+		NamespaceFn func(task PriorityTask) string
+		// NamespaceWeight is the number of tasks a namespace can dispatch in its turn
+		NamespaceWeight dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// NamespaceMaxInFlight is the max number of tasks of a namespace being processed, 0 means no limit
+		NamespaceMaxInFlight dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// QueueSize is the max number of pending tasks of a namespace for each priority
+		QueueSize   int
+		WorkerCount int
+		RetryPolicy backoff.RetryPolicy
+	}
+
+	namespaceFairTaskSchedulerImpl struct {
+		sync.Mutex
+
+		status       int32
+		weights      atomic.Value // store the currently used weights
+		queues       map[int]*namespaceTaskQueues
+		inFlight     map[string]int
+		queueCond    *sync.Cond // signaled when a full namespace queue has room again
+		shutdownCh   chan struct{}
+		notifyCh     chan struct{}
+		dispatcherWG sync.WaitGroup
+		logger       log.Logger
+		metricsScope metrics.Scope
+		options      *NamespaceFairTaskSchedulerOptions
+
+		processor Processor
+	}
+
+	// namespaceTaskQueues holds the pending tasks of one priority, the namespaces
+	// with pending tasks take turns in round robin order
+	namespaceTaskQueues struct {
+		namespaces []string
+		current    int
+		credit     int // remaining number of tasks the current namespace can dispatch in its turn
+		tasks      map[string][]*namespaceFairTask
+	}
+
+	namespaceFairTask struct {
+		PriorityTask
+
+		namespace   string
+		enqueueTime time.Time
+		releaseOnce sync.Once
+		release     func(namespace string)
+	}
+)
+
+var _ Scheduler = (*namespaceFairTaskSchedulerImpl)(nil)
+
+// NewNamespaceFairTaskScheduler creates a new namespace fair task scheduler
+func NewNamespaceFairTaskScheduler(
+	logger log.Logger,
+	metricsClient metrics.Client,
+	options *NamespaceFairTaskSchedulerOptions,
+) (Scheduler, error) {
+	weights, err := convertWeightsFromDynamicConfig(options.Weights())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights) == 0 {
+		return nil, errors.New("weight is not specified in the scheduler option")
+	}
+
+	scheduler := &namespaceFairTaskSchedulerImpl{
+		status:       common.DaemonStatusInitialized,
+		queues:       make(map[int]*namespaceTaskQueues),
+		inFlight:     make(map[string]int),
+		shutdownCh:   make(chan struct{}),
+		notifyCh:     make(chan struct{}, 1),
+		logger:       logger,
+		metricsScope: metricsClient.Scope(metrics.TaskSchedulerScope),
+		options:      options,
+		processor: NewParallelTaskProcessor(
+			logger,
+			metricsClient,
+			&ParallelTaskProcessorOptions{
+				QueueSize:   wRRTaskProcessorQueueSize,
+				WorkerCount: options.WorkerCount,
+				RetryPolicy: options.RetryPolicy,
+			},
+		),
+	}
+	scheduler.queueCond = sync.NewCond(&scheduler.Mutex)
+	scheduler.weights.Store(weights)
+
+	return scheduler, nil
+}
+
+func (s *namespaceFairTaskSchedulerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	s.processor.Start()
+
+	s.dispatcherWG.Add(1)
+	go s.dispatcher()
+	go s.updateWeights()
+
+	s.logger.Info("Namespace fair task scheduler started.")
+}
+
+func (s *namespaceFairTaskSchedulerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(s.shutdownCh)
+
+	// wake up all blocking submits
+	s.Lock()
+	s.queueCond.Broadcast()
+	s.Unlock()
+
+	s.processor.Stop()
+
+	if success := common.AwaitWaitGroup(&s.dispatcherWG, time.Minute); !success {
+		s.logger.Warn("Namespace fair task scheduler timedout on shutdown.")
+	}
+
+	s.logger.Info("Namespace fair task scheduler shutdown.")
+}
+
+func (s *namespaceFairTaskSchedulerImpl) Submit(task PriorityTask) error {
+	s.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	sw := s.metricsScope.StartTimer(metrics.PriorityTaskSubmitLatency)
+	defer sw.Stop()
+
+	_, err := s.submit(task, true)
+	return err
+}
+
+func (s *namespaceFairTaskSchedulerImpl) TrySubmit(
+	task PriorityTask,
+) (bool, error) {
+	submitted, err := s.submit(task, false)
+	if submitted {
+		s.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	}
+	return submitted, err
+}
+
+func (s *namespaceFairTaskSchedulerImpl) submit(
+	task PriorityTask,
+	blocking bool,
+) (bool, error) {
+	priority := task.Priority()
+	if _, ok := s.getWeights()[priority]; !ok {
+		return false, fmt.Errorf("unknown task priority: %v", priority)
+	}
+	namespace := s.options.NamespaceFn(task)
+
+	s.Lock()
+	queues, ok := s.queues[priority]
+	if !ok {
+		queues = &namespaceTaskQueues{
+			tasks: make(map[string][]*namespaceFairTask),
+		}
+		s.queues[priority] = queues
+	}
+	for len(queues.tasks[namespace]) >= s.options.QueueSize && !s.isStopped() {
+		if !blocking {
+			s.Unlock()
+			return false, nil
+		}
+		s.queueCond.Wait()
+	}
+	if s.isStopped() {
+		s.Unlock()
+		return false, ErrTaskSchedulerClosed
+	}
+	queues.add(&namespaceFairTask{
+		PriorityTask: task,
+		namespace:    namespace,
+		enqueueTime:  time.Now(),
+		release:      s.release,
+	})
+	s.Unlock()
+
+	s.notifyDispatcher()
+	return true, nil
+}
+
+func (s *namespaceFairTaskSchedulerImpl) dispatcher() {
+	defer s.dispatcherWG.Done()
+
+	outstandingTasks := false
+
+	for {
+		if !outstandingTasks {
+			// if no task is dispatched in the last round,
+			// wait for a notification
+			select {
+			case <-s.notifyCh:
+				// block until there's a new task or a task is completed
+			case <-s.shutdownCh:
+				return
+			}
+		}
+
+		outstandingTasks = false
+		weights := s.getWeights()
+		for priority := range weights {
+			for i := 0; i < weights[priority]; i++ {
+				task := s.poll(priority)
+				if task == nil {
+					// no task can be dispatched, skip to next priority
+					break
+				}
+
+				// dispatched at least one task in this round
+				outstandingTasks = true
+				s.metricsScope.Tagged(metrics.NamespaceTag(task.namespace)).
+					RecordTimer(metrics.NamespaceTaskQueueLatency, time.Since(task.enqueueTime))
+
+				if err := s.processor.Submit(task); err != nil {
+					s.logger.Error("fail to submit task to processor", tag.Error(err))
+					task.Nack()
+				}
+			}
+
+			select {
+			case <-s.shutdownCh:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// poll removes the next task to dispatch for the given priority, it returns nil if all
+// namespaces with pending tasks have reached their in-flight limit
+func (s *namespaceFairTaskSchedulerImpl) poll(
+	priority int,
+) *namespaceFairTask {
+	s.Lock()
+	defer s.Unlock()
+
+	queues, ok := s.queues[priority]
+	if !ok {
+		return nil
+	}
+
+	for attempts := len(queues.namespaces); attempts > 0; attempts-- {
+		namespace := queues.namespaces[queues.current]
+		if len(queues.tasks[namespace]) == 0 {
+			queues.removeCurrent()
+			continue
+		}
+
+		if maxInFlight := s.options.NamespaceMaxInFlight(namespace); maxInFlight > 0 && s.inFlight[namespace] >= maxInFlight {
+			s.metricsScope.Tagged(metrics.NamespaceTag(namespace)).IncCounter(metrics.NamespaceTaskInFlightThrottled)
+			queues.next()
+			continue
+		}
+
+		if queues.credit <= 0 {
+			// start of the namespace's turn
+			queues.credit = common.MaxInt(1, s.options.NamespaceWeight(namespace))
+		}
+		queues.credit--
+
+		if len(queues.tasks[namespace]) >= s.options.QueueSize {
+			s.queueCond.Broadcast()
+		}
+		task := queues.poll(namespace)
+		if queues.credit == 0 {
+			queues.next()
+		}
+		s.inFlight[namespace]++
+		return task
+	}
+	return nil
+}
+
+func (s *namespaceFairTaskSchedulerImpl) release(
+	namespace string,
+) {
+	s.Lock()
+	s.inFlight[namespace]--
+	if s.inFlight[namespace] <= 0 {
+		delete(s.inFlight, namespace)
+	}
+	s.Unlock()
+
+	// tasks of the namespace may be waiting for its in-flight limit
+	s.notifyDispatcher()
+}
+
+func (s *namespaceFairTaskSchedulerImpl) notifyDispatcher() {
+	select {
+	case s.notifyCh <- struct{}{}:
+		// sent a notification to the dispatcher
+	default:
+		// do not block if there's already a notification
+	}
+}
+
+func (s *namespaceFairTaskSchedulerImpl) getWeights() map[int]int {
+	return s.weights.Load().(map[int]int)
+}
+
+func (s *namespaceFairTaskSchedulerImpl) updateWeights() {
+	ticker := time.NewTicker(defaultUpdateWeightsInterval)
+	for {
+		select {
+		case <-ticker.C:
+			weights, err := convertWeightsFromDynamicConfig(s.options.Weights())
+			if err != nil {
+				s.logger.Error("failed to update weight for namespace fair task scheduler", tag.Error(err))
+			} else {
+				s.weights.Store(weights)
+			}
+		case <-s.shutdownCh:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (s *namespaceFairTaskSchedulerImpl) isStopped() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStopped
+}
+
+func (q *namespaceTaskQueues) add(
+	task *namespaceFairTask,
+) {
+	if _, ok := q.tasks[task.namespace]; !ok {
+		q.namespaces = append(q.namespaces, task.namespace)
+	}
+	q.tasks[task.namespace] = append(q.tasks[task.namespace], task)
+}
+
+func (q *namespaceTaskQueues) poll(
+	namespace string,
+) *namespaceFairTask {
+	tasks := q.tasks[namespace]
+	task := tasks[0]
+	tasks[0] = nil
+	q.tasks[namespace] = tasks[1:]
+	return task
+}
+
+// next ends the turn of the current namespace
+func (q *namespaceTaskQueues) next() {
+	q.credit = 0
+	q.current = (q.current + 1) % len(q.namespaces)
+}
+
+// removeCurrent drops the current namespace from the round robin order,
+// the namespace after it becomes the current one
+func (q *namespaceTaskQueues) removeCurrent() {
+	delete(q.tasks, q.namespaces[q.current])
+	q.namespaces = append(q.namespaces[:q.current], q.namespaces[q.current+1:]...)
+	q.credit = 0
+	if q.current >= len(q.namespaces) {
+		q.current = 0
+	}
+}
+
+func (t *namespaceFairTask) Ack() {
+	t.PriorityTask.Ack()
+	t.releaseOnce.Do(func() { t.release(t.namespace) })
+}
+
+func (t *namespaceFairTask) Nack() {
+	t.PriorityTask.Nack()
+	t.releaseOnce.Do(func() { t.release(t.namespace) })
+}
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	namespaceFairTaskSchedulerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller    *gomock.Controller
+		mockProcessor *MockProcessor
+
+		queueSize       int
+		namespaceWeight map[string]int
+		maxInFlight     map[string]int
+		taskNamespace   map[PriorityTask]string
+
+		scheduler *namespaceFairTaskSchedulerImpl
+	}
+)
+
+func TestNamespaceFairTaskSchedulerSuite(t *testing.T) {
+	s := new(namespaceFairTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockProcessor = NewMockProcessor(s.controller)
+
+	s.queueSize = 10
+	s.namespaceWeight = make(map[string]int)
+	s.maxInFlight = make(map[string]int)
+	s.taskNamespace = make(map[PriorityTask]string)
+	s.scheduler = s.newTestNamespaceFairTaskScheduler(s.queueSize)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestSubmit_Success() {
+	taskPriority := 1
+	mockTask := s.newMockTask(taskPriority, "some random namespace")
+
+	err := s.scheduler.Submit(mockTask)
+	s.NoError(err)
+
+	task := s.scheduler.poll(taskPriority)
+	s.Equal(mockTask, task.PriorityTask)
+	s.Equal("some random namespace", task.namespace)
+	s.Nil(s.scheduler.poll(taskPriority))
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestSubmit_Fail_SchedulerShutDown() {
+	scheduler := s.newTestNamespaceFairTaskScheduler(0)
+
+	mockTask := s.newMockTask(1, "some random namespace")
+	scheduler.Start()
+	scheduler.Stop()
+	err := scheduler.Submit(mockTask)
+	s.Equal(ErrTaskSchedulerClosed, err)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestSubmit_Fail_UnknownPriority() {
+	taskPriority := 5 // make sure the number is not in testSchedulerWeights
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(taskPriority)
+	err := s.scheduler.Submit(mockTask)
+	s.Error(err)
+	s.NotEqual(ErrTaskSchedulerClosed, err)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestTrySubmit() {
+	taskPriority := 1
+	for i := 0; i != s.queueSize; i++ {
+		submitted, err := s.scheduler.TrySubmit(s.newMockTask(taskPriority, "busy namespace"))
+		s.NoError(err)
+		s.True(submitted)
+	}
+
+	// now the queue of the namespace is full, submit one more task, should be non-blocking
+	submitted, err := s.scheduler.TrySubmit(s.newMockTask(taskPriority, "busy namespace"))
+	s.NoError(err)
+	s.False(submitted)
+
+	// other namespaces are not affected
+	submitted, err = s.scheduler.TrySubmit(s.newMockTask(taskPriority, "idle namespace"))
+	s.NoError(err)
+	s.True(submitted)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestPoll_NamespaceWeight() {
+	taskPriority := 0
+	s.namespaceWeight["namespace A"] = 3
+	for i := 0; i != 5; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(taskPriority, "namespace A")))
+	}
+	for i := 0; i != 3; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(taskPriority, "namespace B")))
+	}
+
+	var namespaces []string
+	for task := s.scheduler.poll(taskPriority); task != nil; task = s.scheduler.poll(taskPriority) {
+		namespaces = append(namespaces, task.namespace)
+	}
+	s.Equal([]string{
+		"namespace A", "namespace A", "namespace A",
+		"namespace B",
+		"namespace A", "namespace A",
+		"namespace B", "namespace B",
+	}, namespaces)
+	s.Empty(s.scheduler.queues[taskPriority].namespaces)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestPoll_NamespaceMaxInFlight() {
+	taskPriority := 0
+	s.maxInFlight["namespace A"] = 1
+	for i := 0; i != 2; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(taskPriority, "namespace A")))
+	}
+	s.NoError(s.scheduler.Submit(s.newMockTask(taskPriority, "namespace B")))
+
+	taskA := s.scheduler.poll(taskPriority)
+	s.Equal("namespace A", taskA.namespace)
+	taskB := s.scheduler.poll(taskPriority)
+	s.Equal("namespace B", taskB.namespace)
+	s.Nil(s.scheduler.poll(taskPriority))
+
+	taskA.PriorityTask.(*MockPriorityTask).EXPECT().Ack()
+	taskA.Ack()
+	task := s.scheduler.poll(taskPriority)
+	s.Equal("namespace A", task.namespace)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) TestDispatcher_FailToSubmit() {
+	mockTask := s.newMockTask(0, "some random namespace")
+	mockTask.EXPECT().Nack()
+
+	var taskWG sync.WaitGroup
+	s.NoError(s.scheduler.Submit(mockTask))
+	taskWG.Add(1)
+
+	mockFn := func(_ Task) error {
+		taskWG.Done()
+		return errors.New("some random error")
+	}
+	s.mockProcessor.EXPECT().Submit(gomock.Any()).DoAndReturn(mockFn)
+	s.scheduler.processor = s.mockProcessor
+
+	doneCh := make(chan struct{})
+	s.scheduler.dispatcherWG.Add(1)
+	go func() {
+		s.scheduler.dispatcher()
+		close(doneCh)
+	}()
+
+	taskWG.Wait()
+	close(s.scheduler.shutdownCh)
+
+	<-doneCh
+	s.Empty(s.scheduler.inFlight)
+}
+
+func (s *namespaceFairTaskSchedulerSuite) newMockTask(
+	priority int,
+	namespace string,
+) *MockPriorityTask {
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(priority).AnyTimes()
+	s.taskNamespace[mockTask] = namespace
+	return mockTask
+}
+
+func (s *namespaceFairTaskSchedulerSuite) newTestNamespaceFairTaskScheduler(
+	queueSize int,
+) *namespaceFairTaskSchedulerImpl {
+	scheduler, err := NewNamespaceFairTaskScheduler(
+		log.NewTestLogger(),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&NamespaceFairTaskSchedulerOptions{
+			Weights: testSchedulerWeights,
+			NamespaceFn: func(task PriorityTask) string {
+				return s.taskNamespace[task]
+			},
+			NamespaceWeight: func(namespace string) int {
+				return s.namespaceWeight[namespace]
+			},
+			NamespaceMaxInFlight: func(namespace string) int {
+				return s.maxInFlight[namespace]
+			},
+			QueueSize:   queueSize,
+			WorkerCount: 1,
+			RetryPolicy: backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	s.NoError(err)
+	return scheduler.(*namespaceFairTaskSchedulerImpl)
+}
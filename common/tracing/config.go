@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"fmt"
+)
+
+const (
+	// ExporterOTLP exports the spans to an OpenTelemetry collector
+	ExporterOTLP = "otlp"
+	// ExporterStdout writes the spans to the standard output
+	ExporterStdout = "stdout"
+	// ExporterFile writes the spans to a local file
+	ExporterFile = "file"
+)
+
+type (
+	// Config contains the config items for tracing. The spans cover the gRPC calls, the history
+	// queue tasks and the workflow execution persistence operations made on their behalf
+	Config struct {
+		// Exporter is the span exporter, one of otlp, stdout or file
+		Exporter string `yaml:"exporter"`
+		// Endpoint is the address of the OpenTelemetry collector for the otlp exporter
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables client transport security for the otlp exporter
+		Insecure bool `yaml:"insecure"`
+		// OutputFile is the path of the file the file exporter writes to
+		OutputFile string `yaml:"outputFile"`
+		// SampleRatio is the ratio of the traces started by the server that are sampled,
+		// traces started by the callers follow the sampling decision of the caller.
+		// Defaults to 1, i.e. every trace is sampled
+		SampleRatio *float64 `yaml:"sampleRatio"`
+	}
+)
+
+// Validate validates the tracing config
+func (c *Config) Validate() error {
+	switch c.Exporter {
+	case ExporterOTLP:
+		if c.Endpoint == "" {
+			return fmt.Errorf("tracing: endpoint is required for the %v exporter", ExporterOTLP)
+		}
+	case ExporterStdout:
+	case ExporterFile:
+		if c.OutputFile == "" {
+			return fmt.Errorf("tracing: outputFile is required for the %v exporter", ExporterFile)
+		}
+	default:
+		return fmt.Errorf("tracing: unknown exporter %q", c.Exporter)
+	}
+	if c.SampleRatio != nil && (*c.SampleRatio < 0 || *c.SampleRatio > 1) {
+		return fmt.Errorf("tracing: sampleRatio must be between 0 and 1")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// serviceKey is the temporal service handling the request
+	serviceKey = label.Key("temporal.service")
+)
+
+type (
+	// metadataCarrier adapts gRPC metadata to carry the trace context
+	metadataCarrier metadata.MD
+)
+
+// NewServerInterceptor returns a gRPC server interceptor that continues the trace of the caller,
+// if any, and wraps the handling of the request of the service in a span
+func NewServerInterceptor(service string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md.Copy()))
+
+		ctx, span := StartSpan(ctx, info.FullMethod, trace.SpanKindServer, rpcAttributes(info.FullMethod, service)...)
+		resp, err := handler(ctx, req)
+		EndSpan(span, err)
+		return resp, err
+	}
+}
+
+// NewClientInterceptor returns a gRPC client interceptor that wraps the call in a span
+// and propagates the trace context to the callee
+func NewClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx, span := StartSpan(ctx, method, trace.SpanKindClient, rpcAttributes(method, "")...)
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		EndSpan(span, err)
+		return err
+	}
+}
+
+func rpcAttributes(fullMethod string, service string) []label.KeyValue {
+	// full method is formatted as /package.service/method
+	rpcService, rpcMethod := "", strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(rpcMethod, "/"); i >= 0 {
+		rpcService, rpcMethod = rpcMethod[:i], rpcMethod[i+1:]
+	}
+
+	attributes := []label.KeyValue{
+		semconv.RPCSystemGRPC,
+		semconv.RPCServiceKey.String(rpcService),
+		semconv.RPCMethodKey.String(rpcMethod),
+	}
+	if service != "" {
+		attributes = append(attributes, serviceKey.String(service))
+	}
+	return attributes
+}
+
+// Get returns the value of the key
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set sets the value of the key
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	grpcSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		recorder *oteltest.StandardSpanRecorder
+	}
+)
+
+func TestGRPCSuite(t *testing.T) {
+	suite.Run(t, new(grpcSuite))
+}
+
+func (s *grpcSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.recorder = new(oteltest.StandardSpanRecorder)
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(s.recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+func (s *grpcSuite) TestPropagation() {
+	const method = "/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution"
+	handlerErr := errors.New("handler error")
+
+	var serverSpanContext trace.SpanContext
+	server := NewServerInterceptor("history")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		s.True(ok)
+		_, err := server(
+			metadata.NewIncomingContext(context.Background(), md),
+			req,
+			&grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverSpanContext = trace.SpanContextFromContext(ctx)
+				return nil, handlerErr
+			},
+		)
+		return err
+	}
+
+	err := NewClientInterceptor()(context.Background(), method, nil, nil, nil, invoker)
+	s.Equal(handlerErr, err)
+
+	spans := s.recorder.Completed()
+	s.Len(spans, 2)
+	serverSpan, clientSpan := spans[0], spans[1]
+	s.Equal(trace.SpanKindServer, serverSpan.SpanKind())
+	s.Equal(trace.SpanKindClient, clientSpan.SpanKind())
+	s.Equal(method, serverSpan.Name())
+
+	// the server span continues the trace of the client span
+	s.Equal(serverSpanContext, serverSpan.SpanContext())
+	s.Equal(clientSpan.SpanContext().TraceID, serverSpan.SpanContext().TraceID)
+	s.Equal(clientSpan.SpanContext().SpanID, serverSpan.ParentSpanID())
+
+	s.Equal(codes.Error, serverSpan.StatusCode())
+	s.Equal(codes.Error, clientSpan.StatusCode())
+	s.Equal("StartWorkflowExecution", serverSpan.Attributes()["rpc.method"].AsString())
+	s.Equal("temporal.server.api.historyservice.v1.HistoryService", serverSpan.Attributes()["rpc.service"].AsString())
+	s.Equal("history", serverSpan.Attributes()[serviceKey].AsString())
+}
+
+func (s *grpcSuite) TestStartSpan() {
+	ctx, parent := StartSpan(context.Background(), "parent", trace.SpanKindInternal)
+	_, child := StartSpan(ctx, "child", trace.SpanKindClient)
+	EndSpan(child, nil)
+	EndSpan(parent, nil)
+
+	spans := s.recorder.Completed()
+	s.Len(spans, 2)
+	s.Equal("child", spans[0].Name())
+	s.Equal(parent.SpanContext().SpanID, spans[0].ParentSpanID())
+	s.Equal(codes.Unset, spans[0].StatusCode())
+}
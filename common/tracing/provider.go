@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagation"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	serviceName = "temporal"
+)
+
+// InitTracerProvider registers the global tracer provider and propagator for the tracing
+// config. The returned function flushes the pending spans and shuts the provider down
+func InitTracerProvider(cfg *Config, logger log.Logger) (func(), error) {
+	exporter, closer, err := newExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleRatio := 1.0
+	if cfg.SampleRatio != nil {
+		sampleRatio = *cfg.SampleRatio
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{
+			DefaultSampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio)),
+		}),
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			logger.Error("Unable to shutdown tracer provider.", tag.Error(err))
+		}
+		if closer != nil {
+			_ = closer.Close()
+		}
+	}, nil
+}
+
+func newExporter(cfg *Config) (exporttrace.SpanExporter, io.Closer, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		opts := []otlp.ExporterOption{otlp.WithAddress(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlp.WithInsecure())
+		}
+		exporter, err := otlp.NewExporter(context.Background(), opts...)
+		return exporter, nil, err
+	case ExporterStdout:
+		exporter, err := stdout.NewExporter(stdout.WithWriter(os.Stdout), stdout.WithoutMetricExport())
+		return exporter, nil, err
+	case ExporterFile:
+		file, err := os.OpenFile(cfg.OutputFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdout.NewExporter(stdout.WithWriter(file), stdout.WithoutMetricExport())
+		if err != nil {
+			_ = file.Close()
+			return nil, nil, err
+		}
+		return exporter, file, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
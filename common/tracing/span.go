@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "go.temporal.io/server"
+)
+
+// Attribute keys of the spans started by the server
+const (
+	NamespaceIDKey = label.Key("temporal.namespace_id")
+	WorkflowIDKey  = label.Key("temporal.workflow_id")
+	RunIDKey       = label.Key("temporal.run_id")
+	TaskIDKey      = label.Key("temporal.task_id")
+	TaskTypeKey    = label.Key("temporal.task_type")
+)
+
+// StartSpan starts a span as a child of the span of the context, if any. The returned
+// context carries the new span. Without a registered tracer provider the span is a no-op
+func StartSpan(
+	ctx context.Context,
+	name string,
+	kind trace.SpanKind,
+	attributes ...label.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(
+		ctx,
+		name,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// EndSpan records the error of the operation, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
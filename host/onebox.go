@@ -107,6 +107,7 @@ type (
 		archiverMetadata                 carchiver.ArchivalMetadata
 		archiverProvider                 provider.ArchiverProvider
 		historyConfig                    *HistoryConfig
+		faultInjection                   map[string]map[string]interface{}
 		esConfig                         *config.Elasticsearch
 		esClient                         esclient.Client
 		workerConfig                     *WorkerConfig
@@ -139,6 +140,7 @@ type (
 		ArchiverProvider                 provider.ArchiverProvider
 		EnableReadHistoryFromArchival    bool
 		HistoryConfig                    *HistoryConfig
+		FaultInjection                   map[string]map[string]interface{}
 		ESConfig                         *config.Elasticsearch
 		ESClient                         esclient.Client
 		WorkerConfig                     *WorkerConfig
@@ -172,6 +174,7 @@ func NewTemporal(params *TemporalParams) Temporal {
 		archiverMetadata:                 params.ArchiverMetadata,
 		archiverProvider:                 params.ArchiverProvider,
 		historyConfig:                    params.HistoryConfig,
+		faultInjection:                   params.FaultInjection,
 		workerConfig:                     params.WorkerConfig,
 		mockAdminClient:                  params.MockAdminClient,
 		namespaceReplicationTaskExecutor: params.NamespaceReplicationTaskExecutor,
@@ -379,7 +382,8 @@ func (c *temporalImpl) startFrontend(hosts map[string][]string, startWG *sync.Wa
 	}
 	params.ClusterMetadataConfig = c.clusterMetadataConfig
 	params.MetricsClient = metrics.NewClient(params.MetricsScope, metrics.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfigClient = newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	integrationClient := newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	params.DynamicConfigClient = integrationClient
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 	params.ESConfig = c.esConfig
@@ -391,6 +395,7 @@ func (c *temporalImpl) startFrontend(hosts map[string][]string, startWG *sync.Wa
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for frontend", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = c.subscribeFaultInjection(integrationClient)
 	params.PersistenceServiceResolver = resolver.NewNoopResolver()
 
 	if c.esConfig != nil {
@@ -469,6 +474,7 @@ func (c *temporalImpl) startHistory(
 		if err != nil {
 			c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
 		}
+		params.PersistenceConfig.FaultInjection = c.subscribeFaultInjection(integrationClient)
 		params.PersistenceServiceResolver = resolver.NewNoopResolver()
 
 		if c.esConfig != nil {
@@ -526,7 +532,8 @@ func (c *temporalImpl) startMatching(hosts map[string][]string, startWG *sync.Wa
 	}
 	params.ClusterMetadataConfig = c.clusterMetadataConfig
 	params.MetricsClient = metrics.NewClient(params.MetricsScope, metrics.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfigClient = newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	integrationClient := newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	params.DynamicConfigClient = integrationClient
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 
@@ -535,6 +542,7 @@ func (c *temporalImpl) startMatching(hosts map[string][]string, startWG *sync.Wa
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for matching", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = c.subscribeFaultInjection(integrationClient)
 	params.PersistenceServiceResolver = resolver.NewNoopResolver()
 
 	matchingService, err := matching.NewService(params)
@@ -569,7 +577,8 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 	}
 	params.ClusterMetadataConfig = c.clusterMetadataConfig
 	params.MetricsClient = metrics.NewClient(params.MetricsScope, metrics.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfigClient = newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	integrationClient := newIntegrationConfigClient(dynamicconfig.NewNoopClient())
+	params.DynamicConfigClient = integrationClient
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 
@@ -578,6 +587,7 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for worker", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = c.subscribeFaultInjection(integrationClient)
 	params.PersistenceServiceResolver = resolver.NewNoopResolver()
 
 	params.SdkClient, err = sdkclient.NewClient(sdkclient.Options{
@@ -601,6 +611,7 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 		func(
 			persistenceBean persistenceClient.Bean,
 			searchAttributesProvider searchattribute.Provider,
+			searchAttributesMapper searchattribute.Mapper,
 			logger log.Logger,
 		) (visibility.VisibilityManager, error) {
 			visibilityFromDB, err := visibilityclient.NewVisibilityManager(
@@ -608,6 +619,8 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 				dynamicconfig.GetIntPropertyFn(5000),
 				params.MetricsClient,
 				params.PersistenceServiceResolver,
+				searchAttributesProvider,
+				c.esConfig.GetVisibilityIndex(),
 				params.Logger,
 			)
 			if err != nil {
@@ -737,6 +750,18 @@ func copyPersistenceConfig(pConfig config.Persistence) (config.Persistence, erro
 	return pConfig, nil
 }
 
+// subscribeFaultInjection applies the persistence faults of the test cluster to the dynamic config client
+func (c *temporalImpl) subscribeFaultInjection(client *dynamicClient) dynamicconfig.MapPropertySubscriptionFn {
+	if len(c.faultInjection) > 0 {
+		faults := make(map[string]interface{}, len(c.faultInjection))
+		for operation, fault := range c.faultInjection {
+			faults[operation] = fault
+		}
+		client.OverrideValue(dynamicconfig.PersistenceFaultInjection, faults)
+	}
+	return dynamicconfig.NewCollection(client, c.logger).SubscribeMapProperty(dynamicconfig.PersistenceFaultInjection, nil)
+}
+
 func newMembershipFactory(serviceName string, hosts map[string][]string) resource.MembershipMonitorFactory {
 	return &membershipFactoryImpl{
 		serviceName: serviceName,
@@ -48,6 +48,7 @@ import (
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
@@ -82,6 +83,7 @@ type (
 		ClusterMetadata config.ClusterMetadata
 		Persistence     persistencetests.TestBaseOptions
 		HistoryConfig   *HistoryConfig
+		FaultInjection  map[string]map[string]interface{}
 		ESConfig        *config.Elasticsearch
 		WorkerConfig    *WorkerConfig
 		MockAdminClient map[string]adminservice.AdminServiceClient
@@ -126,10 +128,13 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			ops = persistencetests.GetMySQLTestClusterOption()
 		case postgresql.PluginName:
 			ops = persistencetests.GetPostgreSQLTestClusterOption()
+		case sqlite.PluginName:
+			ops = persistencetests.GetSQLiteTestClusterOption()
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", TestFlags.PersistenceDriver))
 		}
 		options.Persistence.SQLDBPluginName = TestFlags.PersistenceDriver
+		options.Persistence.DBName = ops.DBName
 		options.Persistence.DBUsername = ops.DBUsername
 		options.Persistence.DBPassword = ops.DBPassword
 		options.Persistence.DBHost = ops.DBHost
@@ -174,7 +179,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		esVisibilityStore := elasticsearch.NewVisibilityStore(
 			esClient, indexName, searchattribute.NewTestProvider(), esProcessor, visConfig, logger, &metrics.NoopMetricsClient{},
 		)
-		esVisibilityMgr = visibility.NewVisibilityManagerImpl(esVisibilityStore, searchattribute.NewTestProvider(), indexName, logger)
+		esVisibilityMgr = visibility.NewVisibilityManagerImpl(esVisibilityStore, searchattribute.NewTestProvider(), nil, indexName, logger)
 	}
 	visibilityMgr := visibility.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(options.WorkerConfig.EnableIndexer), advancedVisibilityWritingMode)
@@ -219,6 +224,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		ArchiverMetadata:                 archiverBase.metadata,
 		ArchiverProvider:                 archiverBase.provider,
 		HistoryConfig:                    options.HistoryConfig,
+		FaultInjection:                   options.FaultInjection,
 		WorkerConfig:                     options.WorkerConfig,
 		MockAdminClient:                  options.MockAdminClient,
 		NamespaceReplicationTaskExecutor: namespace.NewReplicationTaskExecutor(testBase.MetadataManager, logger),
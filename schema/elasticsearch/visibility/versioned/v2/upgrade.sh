@@ -0,0 +1,60 @@
+#!/bin/bash
+
+set -eu -o pipefail
+
+# Prerequisites:
+#   - jq
+#   - curl
+
+# Input parameters.
+ES_SCHEME="${ES_SCHEME:-http}"
+ES_SERVER="${ES_SERVER:-127.0.0.1}"
+ES_PORT="${ES_PORT:-9200}"
+ES_USER="${ES_USER:-}"
+ES_PWD="${ES_PWD:-}"
+ES_VERSION="${ES_VERSION:-v7}"
+ES_VIS_INDEX_V1="${ES_VIS_INDEX_V1:-temporal_visibility_v1_dev}"
+ES_VIS_TEMPLATE="${ES_VIS_TEMPLATE:-temporal_visibility_v1_template}"
+AUTO_CONFIRM="${AUTO_CONFIRM:-}"
+
+ES_ENDPOINT="${ES_SCHEME}://${ES_SERVER}:${ES_PORT}"
+DIR_NAME="$(dirname "$(realpath "${BASH_SOURCE[0]}")")"
+
+echo "=== Step 0. Sanity check if Elasticsearch index is accessible. ==="
+if ! curl --silent --fail --user "${ES_USER}":"${ES_PWD}" "${ES_ENDPOINT}/${ES_VIS_INDEX_V1}/_stats/docs" --write-out "\n"; then
+    echo "Elasticsearch index ${ES_VIS_INDEX_V1} is not accessible at ${ES_ENDPOINT}."
+    exit 1
+fi
+
+echo "=== Step 1. Update index template. ==="
+jq . "${DIR_NAME}/index_template_${ES_VERSION}.json"
+if [ -z "${AUTO_CONFIRM}" ]; then
+    read -p "Apply index template above to ${ES_VIS_TEMPLATE}? (N/y)" -n 1 -r
+    echo
+else
+    REPLY="y"
+fi
+if [ "${REPLY}" = "y" ]; then
+    curl --silent --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/_template/${ES_VIS_TEMPLATE}" -H "Content-Type: application/json" --data-binary "@${DIR_NAME}/index_template_${ES_VERSION}.json" | jq
+fi
+
+echo "=== Step 2. Add namespace search attribute fields to the existing index. ==="
+DOC_TYPE=""
+if [ "${ES_VERSION}" != "v7" ]; then
+    DOC_TYPE="/_doc"
+fi
+jq . "${DIR_NAME}/index_mapping_${ES_VERSION}.json"
+if [ -z "${AUTO_CONFIRM}" ]; then
+    read -p "Add fields above to the index ${ES_VIS_INDEX_V1}? (N/y)" -n 1 -r
+    echo
+else
+    REPLY="y"
+fi
+if [ "${REPLY}" = "y" ]; then
+    curl --silent --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/${ES_VIS_INDEX_V1}${DOC_TYPE}/_mapping" -H "Content-Type: application/json" --data-binary "@${DIR_NAME}/index_mapping_${ES_VERSION}.json" | jq
+    # Wait for mapping changes to go through.
+    until curl --silent --user "${ES_USER}":"${ES_PWD}" "${ES_ENDPOINT}/_cluster/health/${ES_VIS_INDEX_V1}" | jq --exit-status '.status=="green" | .'; do
+        echo "Waiting for Elasticsearch index ${ES_VIS_INDEX_V1} become green."
+        sleep 1
+    done
+fi
@@ -127,6 +127,15 @@ CREATE TABLE replication_tasks_dlq (
   PRIMARY KEY (source_cluster_name, shard_id, task_id)
 );
 
+CREATE TABLE history_tasks_dlq (
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
 CREATE TABLE visibility_tasks(
   shard_id INT NOT NULL,
   task_id BIGINT NOT NULL,
@@ -28,8 +28,8 @@ package postgresql
 
 // Version is the Postgres database release version
 // Temporal supports both MySQL and Postgres officially, so upgrade should be perform for both MySQL and Postgres
-const Version = "1.6"
+const Version = "1.7"
 
 // VisibilityVersion is the Postgres visibility database release version
 // Temporal supports both MySQL and Postgres officially, so upgrade should be perform for both MySQL and Postgres
-const VisibilityVersion = "1.1"
+const VisibilityVersion = "1.2"
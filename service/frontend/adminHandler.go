@@ -26,10 +26,14 @@ package frontend
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/olivere/elastic/v7"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -41,30 +45,39 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
+	defaultDynamicConfigHistoryPageSize     = 100
+	// dynamicConfigCompactionThreshold is the number of changes after the snapshot which trigger a new snapshot
+	dynamicConfigCompactionThreshold = 1000
+	defaultShardQueuesPageSize       = 1000
 )
 
 type (
@@ -78,7 +91,9 @@ type (
 		ESClient              esclient.Client
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
+		namespaceHandler      namespace.Handler
 		eventSerializer       serialization.Serializer
+		dynamicConfigManager  persistence.DynamicConfigManager
 	}
 )
 
@@ -110,9 +125,20 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
-		eventSerializer: serialization.NewSerializer(),
-		ESConfig:        params.ESConfig,
-		ESClient:        params.ESClient,
+		namespaceHandler: namespace.NewHandler(
+			config.MaxBadBinaries,
+			resource.GetLogger(),
+			resource.GetMetadataManager(),
+			resource.GetClusterMetadata(),
+			namespace.NewNamespaceReplicator(resource.GetNamespaceReplicationQueue(), resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+			resource.GetSearchAttributesProvider(),
+		),
+		eventSerializer:      serialization.NewSerializer(),
+		ESConfig:             params.ESConfig,
+		dynamicConfigManager: params.DynamicConfigManager,
+		ESClient:             params.ESClient,
 	}
 }
 
@@ -145,7 +171,7 @@ func (adh *AdminHandler) Stop() {
 	adh.Resource.GetNamespaceReplicationQueue().Stop()
 }
 
-// AddSearchAttributes add search attribute to the cluster.
+// AddSearchAttributes add search attribute to the cluster or to the namespace if namespace is set.
 func (adh *AdminHandler) AddSearchAttributes(ctx context.Context, request *adminservice.AddSearchAttributesRequest) (_ *adminservice.AddSearchAttributesResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
@@ -183,6 +209,16 @@ func (adh *AdminHandler) AddSearchAttributes(ctx context.Context, request *admin
 		}
 	}
 
+	if request.GetNamespace() != "" {
+		// Namespace search attributes are stored in pre-created fields and don't require schema update.
+		request.IndexName = indexName
+		resp, err := adh.namespaceHandler.AddSearchAttributes(ctx, request)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return resp, nil
+	}
+
 	// Execute workflow.
 	wfParams := addsearchattributes.WorkflowParams{
 		CustomAttributesToAdd: request.GetSearchAttributes(),
@@ -214,7 +250,7 @@ func (adh *AdminHandler) AddSearchAttributes(ctx context.Context, request *admin
 	return &adminservice.AddSearchAttributesResponse{}, nil
 }
 
-// RemoveSearchAttributes remove search attribute from the cluster.
+// RemoveSearchAttributes remove search attribute from the cluster or from the namespace if namespace is set.
 func (adh *AdminHandler) RemoveSearchAttributes(ctx context.Context, request *adminservice.RemoveSearchAttributesRequest) (_ *adminservice.RemoveSearchAttributesResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
@@ -230,6 +266,14 @@ func (adh *AdminHandler) RemoveSearchAttributes(ctx context.Context, request *ad
 		return nil, adh.error(errSearchAttributesNotSet, scope)
 	}
 
+	if request.GetNamespace() != "" {
+		resp, err := adh.namespaceHandler.RemoveSearchAttributes(ctx, request)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return resp, nil
+	}
+
 	indexName := request.GetIndexName()
 	if indexName == "" {
 		indexName = adh.ESConfig.GetVisibilityIndex()
@@ -263,6 +307,27 @@ func (adh *AdminHandler) RemoveSearchAttributes(ctx context.Context, request *ad
 	return &adminservice.RemoveSearchAttributesResponse{}, nil
 }
 
+// ReclaimSearchAttributeFields makes the fields released by removed search attributes of the namespace available to new search attributes.
+func (adh *AdminHandler) ReclaimSearchAttributeFields(ctx context.Context, request *adminservice.ReclaimSearchAttributeFieldsRequest) (_ *adminservice.ReclaimSearchAttributeFieldsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminReclaimSearchAttributeFieldsScope)
+	defer sw.Stop()
+
+	// validate request
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := adh.reclaimSearchAttributeFields(ctx, request.GetNamespace(), request.GetIndexName()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ReclaimSearchAttributeFieldsResponse{}, nil
+}
+
 func (adh *AdminHandler) GetSearchAttributes(ctx context.Context, request *adminservice.GetSearchAttributesRequest) (_ *adminservice.GetSearchAttributesResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
@@ -278,6 +343,14 @@ func (adh *AdminHandler) GetSearchAttributes(ctx context.Context, request *admin
 		indexName = adh.ESConfig.GetVisibilityIndex()
 	}
 
+	if request.GetNamespace() != "" {
+		resp, err := adh.getNamespaceSearchAttributes(indexName, request.GetNamespace())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return resp, nil
+	}
+
 	resp, err := adh.getSearchAttributes(ctx, indexName, "")
 	if err != nil {
 		return nil, adh.error(err, scope)
@@ -286,6 +359,67 @@ func (adh *AdminHandler) GetSearchAttributes(ctx context.Context, request *admin
 	return resp, nil
 }
 
+// reclaimSearchAttributeFields clears the fields released by the removed search attributes of the namespace
+// from the documents of the namespace in the index and then releases the fields to new search attributes.
+func (adh *AdminHandler) reclaimSearchAttributeFields(ctx context.Context, namespace string, indexName string) error {
+	if adh.ESClient == nil {
+		return errCannotReclaimFieldsWithoutElasticsearch
+	}
+	if indexName == "" {
+		indexName = adh.ESConfig.GetVisibilityIndex()
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	// the documents of the namespace in other clusters would keep the values
+	if namespaceEntry.IsGlobalNamespace() {
+		return errCannotReclaimGlobalNamespaceFields
+	}
+
+	return adh.namespaceHandler.ReclaimSearchAttributeFields(ctx, namespace, func(fieldName string) error {
+		query := elastic.NewBoolQuery().
+			Filter(elastic.NewTermQuery(searchattribute.NamespaceID, namespaceEntry.GetInfo().Id)).
+			Filter(elastic.NewExistsQuery(fieldName))
+		if _, err := adh.ESClient.RemoveField(ctx, indexName, query, fieldName); err != nil {
+			return serviceerror.NewInternal(fmt.Sprintf(errUnableToClearSearchAttributeFieldMessage, fieldName, err))
+		}
+		return nil
+	})
+}
+
+// getNamespaceSearchAttributes returns custom search attributes registered in the namespace.
+func (adh *AdminHandler) getNamespaceSearchAttributes(indexName string, namespace string) (*adminservice.GetSearchAttributesResponse, error) {
+	getResponse, err := adh.GetMetadataManager().GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	searchAttributes, err := adh.Resource.GetSearchAttributesProvider().GetSearchAttributes(indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+	}
+
+	aliases := getResponse.Namespace.GetConfig().GetCustomSearchAttributeAliases()
+	customAttributes := make(map[string]enumspb.IndexedValueType, len(aliases))
+	for fieldName, alias := range aliases {
+		if alias == "" {
+			// field released by removed search attribute
+			continue
+		}
+		saType, err := searchAttributes.GetGenericFieldType(fieldName)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+		}
+		customAttributes[alias] = saType
+	}
+
+	return &adminservice.GetSearchAttributesResponse{
+		CustomAttributes: customAttributes,
+		SystemAttributes: searchAttributes.System(),
+	}, nil
+}
+
 func (adh *AdminHandler) getSearchAttributes(ctx context.Context, indexName string, runID string) (*adminservice.GetSearchAttributesResponse, error) {
 	var lastErr error
 	descResp, err := adh.GetSDKClient().DescribeWorkflowExecution(ctx, addsearchattributes.WorkflowName, runID)
@@ -534,7 +668,9 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 		execution.GetWorkflowId(),
 		adh.numberOfHistoryShards,
 	)
-	rawHistoryResponse, err := adh.GetExecutionManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+	// the history is replicated to another cluster, which can't read the payloads offloaded by this one
+	executionManager := payloadoffload.NewRehydratingExecutionManager(adh.GetExecutionManager(), adh.GetPayloadOffloader())
+	rawHistoryResponse, err := executionManager.ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
 		BranchToken: targetVersionHistory.GetBranchToken(),
 		// GetWorkflowExecutionRawHistoryV2 is exclusive exclusive.
 		// ReadRawHistoryBranch is inclusive exclusive.
@@ -796,7 +932,7 @@ func (adh *AdminHandler) GetDLQMessages(
 	var token []byte
 	var op func() error
 	switch request.GetType() {
-	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
 		resp, err := adh.GetHistoryClient().GetDLQMessages(ctx, &historyservice.GetDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -813,6 +949,7 @@ func (adh *AdminHandler) GetDLQMessages(
 		return &adminservice.GetDLQMessagesResponse{
 			Type:             resp.GetType(),
 			ReplicationTasks: resp.GetReplicationTasks(),
+			HistoryTasks:     resp.GetHistoryTasks(),
 			NextPageToken:    resp.GetNextPageToken(),
 		}, err
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
@@ -863,7 +1000,7 @@ func (adh *AdminHandler) PurgeDLQMessages(
 
 	var op func() error
 	switch request.GetType() {
-	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
 		resp, err := adh.GetHistoryClient().PurgeDLQMessages(ctx, &historyservice.PurgeDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -917,7 +1054,7 @@ func (adh *AdminHandler) MergeDLQMessages(
 	var token []byte
 	var op func() error
 	switch request.GetType() {
-	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
 		resp, err := adh.GetHistoryClient().MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -931,7 +1068,7 @@ func (adh *AdminHandler) MergeDLQMessages(
 		}
 
 		return &adminservice.MergeDLQMessagesResponse{
-			NextPageToken: request.GetNextPageToken(),
+			NextPageToken: resp.GetNextPageToken(),
 		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 
@@ -1030,6 +1167,337 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	return &adminservice.ResendReplicationTasksResponse{}, nil
 }
 
+// DescribeShardQueues returns the in-memory state of the task queue processors of a history shard
+func (adh *AdminHandler) DescribeShardQueues(
+	ctx context.Context,
+	request *adminservice.DescribeShardQueuesRequest,
+) (_ *adminservice.DescribeShardQueuesResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeShardQueuesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetShardId() <= 0 || request.GetShardId() > adh.numberOfHistoryShards {
+		return nil, adh.error(errInvalidShardID, scope)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultShardQueuesPageSize
+	}
+	resp, err := adh.GetHistoryClient().DescribeShardQueues(ctx, &historyservice.DescribeShardQueuesRequest{
+		ShardId:       request.GetShardId(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeShardQueuesResponse{
+		ShardId:       resp.GetShardId(),
+		Queues:        resp.GetQueues(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// GetDynamicConfig returns the persisted dynamic config values of a key
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+	if _, ok := dynamicconfig.GetKeyFromKeyName(request.GetKey()); !ok {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownDynamicConfigKeyMessage, request.GetKey())), scope)
+	}
+
+	persistedConfig, err := adh.loadPersistedDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetDynamicConfigResponse{
+		Values: persistedConfig.Get(request.GetKey()),
+	}, nil
+}
+
+// SetDynamicConfig persists a dynamic config value, the value is picked up by all hosts within seconds
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetValue() == nil {
+		return nil, adh.error(errDynamicConfigValueNotSet, scope)
+	}
+	if err := dynamicconfig.ValidateValue(request.GetValue()); err != nil {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidDynamicConfigValueMessage, err)), scope)
+	}
+
+	if adh.dynamicConfigManager == nil {
+		return nil, adh.error(errPersistedDynamicConfigNotAvailable, scope)
+	}
+
+	key, _ := dynamicconfig.GetKeyFromKeyName(request.GetValue().GetKey())
+	err = adh.dynamicConfigManager.AppendChange(&persistencespb.DynamicConfigChange{
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         key.String(),
+			Constraints: request.GetValue().GetConstraints(),
+			Value:       request.GetValue().GetValue(),
+		},
+		Identity:   request.GetIdentity(),
+		Reason:     request.GetReason(),
+		ChangeTime: timestamp.TimePtr(time.Now().UTC()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.compactDynamicConfigChangeLog()
+	return &adminservice.SetDynamicConfigResponse{}, nil
+}
+
+// DeleteDynamicConfig deletes a persisted dynamic config value, the key falls back to the static config
+func (adh *AdminHandler) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+) (_ *adminservice.DeleteDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+	key, ok := dynamicconfig.GetKeyFromKeyName(request.GetKey())
+	if !ok {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownDynamicConfigKeyMessage, request.GetKey())), scope)
+	}
+
+	persistedConfig, err := adh.loadPersistedDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !persistedConfig.Contains(key.String(), request.GetConstraints()) {
+		return nil, adh.error(serviceerror.NewNotFound(fmt.Sprintf(errDynamicConfigValueDoesntExistMessage, key.String())), scope)
+	}
+
+	err = adh.dynamicConfigManager.AppendChange(&persistencespb.DynamicConfigChange{
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         key.String(),
+			Constraints: request.GetConstraints(),
+		},
+		Deleted:    true,
+		Identity:   request.GetIdentity(),
+		Reason:     request.GetReason(),
+		ChangeTime: timestamp.TimePtr(time.Now().UTC()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.compactDynamicConfigChangeLog()
+	return &adminservice.DeleteDynamicConfigResponse{}, nil
+}
+
+// ListDynamicConfig returns all persisted dynamic config values
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	persistedConfig, err := adh.loadPersistedDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		Values: persistedConfig.Values(),
+	}, nil
+}
+
+// ListDynamicConfigHistory returns the audit history of the persisted dynamic config, oldest change first.
+// The history starts with the changes after the previous snapshot, older changes are trimmed.
+func (adh *AdminHandler) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+) (_ *adminservice.ListDynamicConfigHistoryResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+
+	pageSize := int(request.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultDynamicConfigHistoryPageSize
+	}
+	lastChangeID := int64(defaultLastMessageID)
+	if len(request.GetNextPageToken()) > 0 {
+		if len(request.GetNextPageToken()) != 8 {
+			return nil, adh.error(errInvalidNextPageToken, scope)
+		}
+		lastChangeID = int64(binary.BigEndian.Uint64(request.GetNextPageToken()))
+	}
+
+	if adh.dynamicConfigManager == nil {
+		return nil, adh.error(errPersistedDynamicConfigNotAvailable, scope)
+	}
+	changes, err := adh.dynamicConfigManager.ReadChanges(lastChangeID, pageSize)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the page is filtered after it is read, so a page may contain less changes than the page size
+	var result []*persistencespb.DynamicConfigChange
+	for _, change := range changes {
+		if request.GetKey() == "" || strings.EqualFold(change.GetValue().GetKey(), request.GetKey()) {
+			result = append(result, change)
+		}
+	}
+	var nextPageToken []byte
+	if len(changes) == pageSize {
+		nextPageToken = make([]byte, 8)
+		binary.BigEndian.PutUint64(nextPageToken, uint64(changes[len(changes)-1].GetChangeId()))
+	}
+	return &adminservice.ListDynamicConfigHistoryResponse{
+		Changes:       result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// DeleteNamespace marks the namespace as deleted and starts the workflow which deletes its data in the background
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errCannotDeleteSystemNamespace, scope)
+	}
+
+	getResponse, err := adh.GetMetadataManager().GetNamespace(&persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// the deletion is not replicated, so deleting a global namespace would leave it behind in the other clusters
+	if getResponse.IsGlobalNamespace {
+		return nil, adh.error(errCannotDeleteGlobalNamespace, scope)
+	}
+	namespaceID := getResponse.Namespace.Info.Id
+
+	adh.GetLogger().Info("Deleting namespace.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.NewStringTag("identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()),
+	)
+
+	// Execute workflow.
+	wfParams := deletenamespace.WorkflowParams{
+		Namespace:   request.GetNamespace(),
+		NamespaceID: namespaceID,
+	}
+
+	run, err := adh.GetSDKClient().ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			TaskQueue:             deletenamespace.TaskQueueName,
+			ID:                    deletenamespace.WorkflowIDPrefix + namespaceID,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+		},
+		deletenamespace.WorkflowName,
+		wfParams,
+	)
+	if err != nil {
+		return nil, adh.error(serviceerror.NewInternal(fmt.Sprintf(errUnableToStartWorkflowMessage, deletenamespace.WorkflowName, err)), scope)
+	}
+
+	return &adminservice.DeleteNamespaceResponse{
+		NamespaceId: namespaceID,
+		WorkflowId:  run.GetID(),
+		RunId:       run.GetRunID(),
+	}, nil
+}
+
+// RenameNamespace renames the namespace, the previous name keeps resolving to the namespace until its alias expires
+func (adh *AdminHandler) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+) (_ *adminservice.RenameNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRenameNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNewName() == "" {
+		return nil, adh.error(errNewNamespaceNameNotSet, scope)
+	}
+	if len(request.GetNewName()) > adh.config.MaxIDLengthLimit() {
+		return nil, adh.error(errNamespaceTooLong, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errCannotRenameSystemNamespace, scope)
+	}
+
+	adh.GetLogger().Info("Renaming namespace.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewStringTag("new-namespace", request.GetNewName()),
+		tag.NewStringTag("identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()),
+	)
+
+	resp, err := adh.namespaceHandler.RenameNamespace(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1069,6 +1537,34 @@ func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	return nil
 }
 
+func (adh *AdminHandler) loadPersistedDynamicConfig() (*dynamicconfig.PersistedConfig, error) {
+	if adh.dynamicConfigManager == nil {
+		return nil, errPersistedDynamicConfigNotAvailable
+	}
+	persistedConfig := dynamicconfig.NewPersistedConfig()
+	if _, err := persistedConfig.Refresh(adh.dynamicConfigManager); err != nil {
+		return nil, err
+	}
+	return persistedConfig, nil
+}
+
+// compactDynamicConfigChangeLog snapshots the persisted dynamic config once enough changes are appended
+// after the snapshot, so hosts don't replay the whole change log. The change is persisted already,
+// so a failed compaction is only logged and retried with the next change.
+func (adh *AdminHandler) compactDynamicConfigChangeLog() {
+	persistedConfig, err := adh.loadPersistedDynamicConfig()
+	if err != nil {
+		adh.GetLogger().Warn("Failed to load persisted dynamic config for compaction", tag.Error(err))
+		return
+	}
+	if persistedConfig.LastChangeID()-persistedConfig.SnapshotChangeID() < dynamicConfigCompactionThreshold {
+		return
+	}
+	if err := adh.dynamicConfigManager.UpdateSnapshot(persistedConfig.Snapshot()); err != nil {
+		adh.GetLogger().Warn("Failed to compact persisted dynamic config change log", tag.Error(err))
+	}
+}
+
 func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 	versionHistories *historyspb.VersionHistories,
@@ -28,9 +28,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -41,13 +43,18 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	sdkmocks "go.temporal.io/sdk/mocks"
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/resource"
@@ -64,7 +71,8 @@ type (
 		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
 		mockNamespaceCache *cache.MockNamespaceCache
 
-		mockExecutionMgr *persistence.MockExecutionManager
+		mockExecutionMgr     *persistence.MockExecutionManager
+		mockDynamicConfigMgr *persistence.MockDynamicConfigManager
 
 		namespace   string
 		namespaceID string
@@ -89,12 +97,14 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockNamespaceCache = s.mockResource.NamespaceCache
 	s.mockHistoryClient = s.mockResource.HistoryClient
 	s.mockExecutionMgr = s.mockResource.ExecutionMgr
+	s.mockDynamicConfigMgr = persistence.NewMockDynamicConfigManager(s.controller)
 
 	params := &resource.BootstrapParams{
 		PersistenceConfig: config.Persistence{
 			NumHistoryShards: 1,
 		},
-		ESClient: s.mockResource.ESClient,
+		ESClient:             s.mockResource.ESClient,
+		DynamicConfigManager: s.mockDynamicConfigMgr,
 	}
 	config := &Config{}
 	s.handler = NewAdminHandler(s.mockResource, params, config)
@@ -682,3 +692,409 @@ func (s *adminHandlerSuite) Test_RemoveSearchAttributes() {
 	s.NoError(err)
 	s.NotNil(resp)
 }
+
+func (s *adminHandlerSuite) Test_NamespaceSearchAttributes() {
+	ctx := context.Background()
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = mockNamespaceHandler
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", gomock.Any()).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+
+	_, err := s.handler.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		Namespace: s.namespace,
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomIntField": enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Search attribute CustomIntField already exists."}, err)
+
+	_, err = s.handler.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		Namespace: s.namespace,
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Search attribute Keyword01 is reserved by system."}, err)
+
+	addRequest := &adminservice.AddSearchAttributesRequest{
+		Namespace: s.namespace,
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"ProductId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	}
+	mockNamespaceHandler.EXPECT().AddSearchAttributes(gomock.Any(), addRequest).Return(&adminservice.AddSearchAttributesResponse{}, nil)
+	_, err = s.handler.AddSearchAttributes(ctx, addRequest)
+	s.NoError(err)
+
+	removeRequest := &adminservice.RemoveSearchAttributesRequest{
+		Namespace:        s.namespace,
+		SearchAttributes: []string{"ProductId"},
+	}
+	mockNamespaceHandler.EXPECT().RemoveSearchAttributes(gomock.Any(), removeRequest).Return(&adminservice.RemoveSearchAttributesResponse{}, nil)
+	_, err = s.handler.RemoveSearchAttributes(ctx, removeRequest)
+	s.NoError(err)
+
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+			Config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{
+					"Keyword01": "ProductId",
+					"Keyword02": "",
+					"Int01":     "Quantity",
+				},
+			},
+		},
+	}, nil)
+	resp, err := s.handler.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{Namespace: s.namespace})
+	s.NoError(err)
+	s.Equal(map[string]enumspb.IndexedValueType{
+		"ProductId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"Quantity":  enumspb.INDEXED_VALUE_TYPE_INT,
+	}, resp.GetCustomAttributes())
+}
+
+func (s *adminHandlerSuite) Test_ReclaimSearchAttributeFields() {
+	ctx := context.Background()
+
+	_, err := s.handler.ReclaimSearchAttributeFields(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = s.handler.ReclaimSearchAttributeFields(ctx, &adminservice.ReclaimSearchAttributeFieldsRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = mockNamespaceHandler
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	// values are cleared from the documents of the namespace before the released field is reclaimed
+	mockNamespaceHandler.EXPECT().ReclaimSearchAttributeFields(gomock.Any(), s.namespace, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, clearField func(fieldName string) error) error {
+			return clearField("Keyword01")
+		})
+	s.mockResource.ESClient.EXPECT().RemoveField(gomock.Any(), "", gomock.Any(), "Keyword01").DoAndReturn(
+		func(_ context.Context, _ string, query elastic.Query, _ string) (int64, error) {
+			source, err := query.Source()
+			s.NoError(err)
+			s.Equal(map[string]interface{}{
+				"bool": map[string]interface{}{
+					"filter": []interface{}{
+						map[string]interface{}{"term": map[string]interface{}{searchattribute.NamespaceID: s.namespaceID}},
+						map[string]interface{}{"exists": map[string]interface{}{"field": "Keyword01"}},
+					},
+				},
+			}, source)
+			return 1, nil
+		})
+	_, err = s.handler.ReclaimSearchAttributeFields(ctx, &adminservice.ReclaimSearchAttributeFieldsRequest{Namespace: s.namespace})
+	s.NoError(err)
+
+	globalNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1234,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(globalNamespaceEntry, nil)
+	_, err = s.handler.ReclaimSearchAttributeFields(ctx, &adminservice.ReclaimSearchAttributeFieldsRequest{Namespace: s.namespace})
+	s.Equal(errCannotReclaimGlobalNamespaceFields, err)
+}
+
+func (s *adminHandlerSuite) Test_DescribeShardQueues() {
+	ctx := context.Background()
+
+	_, err := s.handler.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{ShardId: 0})
+	s.Equal(errInvalidShardID, err)
+	_, err = s.handler.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{ShardId: 2})
+	s.Equal(errInvalidShardID, err)
+
+	queues := []*historyspb.QueueState{
+		{
+			Category:    enumsspb.TASK_CATEGORY_TRANSFER,
+			ClusterName: "active",
+			AckLevel:    10,
+			ReadLevel:   20,
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeShardQueues(gomock.Any(), &historyservice.DescribeShardQueuesRequest{
+		ShardId:  1,
+		PageSize: defaultShardQueuesPageSize,
+	}).Return(&historyservice.DescribeShardQueuesResponse{ShardId: 1, Queues: queues, NextPageToken: []byte{1}}, nil)
+
+	resp, err := s.handler.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{ShardId: 1})
+	s.NoError(err)
+	s.Equal(int32(1), resp.GetShardId())
+	s.Equal(queues, resp.GetQueues())
+	s.Equal([]byte{1}, resp.GetNextPageToken())
+
+	s.mockHistoryClient.EXPECT().DescribeShardQueues(gomock.Any(), &historyservice.DescribeShardQueuesRequest{
+		ShardId:       1,
+		PageSize:      10,
+		NextPageToken: []byte{1},
+	}).Return(&historyservice.DescribeShardQueuesResponse{ShardId: 1}, nil)
+
+	resp, err = s.handler.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{ShardId: 1, PageSize: 10, NextPageToken: []byte{1}})
+	s.NoError(err)
+	s.Empty(resp.GetQueues())
+	s.Empty(resp.GetNextPageToken())
+
+	_, err = s.handler.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{ShardId: 1, PageSize: -1})
+	s.Equal(errInvalidPageSize, err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{})
+	s.Equal(errDynamicConfigValueNotSet, err)
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{Key: "unknown.key", Value: "1"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: ""},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: s.namespace}
+	s.mockDynamicConfigMgr.EXPECT().AppendChange(gomock.Any()).DoAndReturn(
+		func(change *persistencespb.DynamicConfigChange) error {
+			// key name is canonicalized
+			s.Equal("frontend.rps", change.GetValue().GetKey())
+			s.Equal(constraints, change.GetValue().GetConstraints())
+			s.Equal("100", change.GetValue().GetValue())
+			s.False(change.GetDeleted())
+			s.Equal("test-identity", change.GetIdentity())
+			s.Equal("test-reason", change.GetReason())
+			s.NotNil(change.GetChangeTime())
+			return nil
+		})
+	// the change log is not compacted until enough changes are appended after the snapshot
+	s.mockDynamicConfigMgr.EXPECT().ReadSnapshot().Return(nil, nil)
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(int64(-1), gomock.Any()).Return([]*persistencespb.DynamicConfigChange{
+		{
+			ChangeId: 0,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Constraints: constraints, Value: "100"},
+		},
+	}, nil)
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         "Frontend.RPS",
+			Constraints: constraints,
+			Value:       "100",
+		},
+		Identity: "test-identity",
+		Reason:   "test-reason",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig_CompactChangeLog() {
+	ctx := context.Background()
+
+	snapshot := &persistencespb.DynamicConfigSnapshot{
+		LastChangeId: 9,
+		Values: []*persistencespb.DynamicConfigValue{
+			{Key: "frontend.rps", Value: "100"},
+		},
+	}
+	var changes []*persistencespb.DynamicConfigChange
+	for i := 0; i < dynamicConfigCompactionThreshold; i++ {
+		changes = append(changes, &persistencespb.DynamicConfigChange{
+			ChangeId: snapshot.GetLastChangeId() + int64(i) + 1,
+			Value:    &persistencespb.DynamicConfigValue{Key: "matching.numTaskqueueWritePartitions", Value: strconv.Itoa(i)},
+		})
+	}
+	s.mockDynamicConfigMgr.EXPECT().AppendChange(gomock.Any()).Return(nil)
+	s.mockDynamicConfigMgr.EXPECT().ReadSnapshot().Return(snapshot, nil)
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(snapshot.GetLastChangeId(), gomock.Any()).Return(changes, nil)
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(changes[len(changes)-1].GetChangeId(), gomock.Any()).Return(nil, nil)
+	s.mockDynamicConfigMgr.EXPECT().UpdateSnapshot(gomock.Any()).DoAndReturn(
+		func(newSnapshot *persistencespb.DynamicConfigSnapshot) error {
+			s.Equal(changes[len(changes)-1].GetChangeId(), newSnapshot.GetLastChangeId())
+			s.Len(newSnapshot.GetValues(), 2)
+			s.Equal("100", newSnapshot.GetValues()[0].GetValue())
+			s.Equal(strconv.Itoa(dynamicConfigCompactionThreshold-1), newSnapshot.GetValues()[1].GetValue())
+			return nil
+		})
+
+	_, err := s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{
+			Key:   "matching.numTaskqueueWritePartitions",
+			Value: strconv.Itoa(dynamicConfigCompactionThreshold - 1),
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteDynamicConfig() {
+	ctx := context.Background()
+
+	_, err := s.handler.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{})
+	s.Equal(errDynamicConfigKeyNotSet, err)
+
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: s.namespace}
+	s.mockDynamicConfigMgr.EXPECT().ReadSnapshot().Return(nil, nil).Times(3)
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(int64(-1), gomock.Any()).Return([]*persistencespb.DynamicConfigChange{
+		{
+			ChangeId: 0,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Constraints: constraints, Value: "100"},
+		},
+	}, nil).Times(3)
+
+	_, err = s.handler.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{
+		Key: "frontend.rps",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.mockDynamicConfigMgr.EXPECT().AppendChange(gomock.Any()).DoAndReturn(
+		func(change *persistencespb.DynamicConfigChange) error {
+			s.Equal("frontend.rps", change.GetValue().GetKey())
+			s.Equal(constraints, change.GetValue().GetConstraints())
+			s.True(change.GetDeleted())
+			return nil
+		})
+	_, err = s.handler.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{
+		Key:         "frontend.rps",
+		Constraints: constraints,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_GetAndListDynamicConfig() {
+	ctx := context.Background()
+
+	changes := []*persistencespb.DynamicConfigChange{
+		{
+			ChangeId: 0,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: "100"},
+		},
+		{
+			ChangeId: 1,
+			Value:    &persistencespb.DynamicConfigValue{Key: "matching.numTaskqueueWritePartitions", Value: "4"},
+		},
+		{
+			ChangeId: 2,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: "200"},
+		},
+	}
+	s.mockDynamicConfigMgr.EXPECT().ReadSnapshot().Return(nil, nil).Times(2)
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(int64(-1), gomock.Any()).Return(changes, nil).Times(2)
+
+	getResp, err := s.handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Key: "frontend.rps"})
+	s.NoError(err)
+	s.Len(getResp.GetValues(), 1)
+	s.Equal("200", getResp.GetValues()[0].GetValue())
+
+	listResp, err := s.handler.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	s.NoError(err)
+	s.Len(listResp.GetValues(), 2)
+	s.Equal("frontend.rps", listResp.GetValues()[0].GetKey())
+	s.Equal("matching.numTaskqueueWritePartitions", listResp.GetValues()[1].GetKey())
+}
+
+func (s *adminHandlerSuite) Test_ListDynamicConfigHistory() {
+	ctx := context.Background()
+
+	_, err := s.handler.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{NextPageToken: []byte{1}})
+	s.Equal(errInvalidNextPageToken, err)
+
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(int64(-1), 2).Return([]*persistencespb.DynamicConfigChange{
+		{
+			ChangeId: 0,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: "100"},
+		},
+		{
+			ChangeId: 1,
+			Value:    &persistencespb.DynamicConfigValue{Key: "matching.numTaskqueueWritePartitions", Value: "4"},
+		},
+	}, nil)
+	resp, err := s.handler.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{
+		Key:      "frontend.rps",
+		PageSize: 2,
+	})
+	s.NoError(err)
+	s.Len(resp.GetChanges(), 1)
+	s.Equal(int64(0), resp.GetChanges()[0].GetChangeId())
+	s.NotEmpty(resp.GetNextPageToken())
+
+	s.mockDynamicConfigMgr.EXPECT().ReadChanges(int64(1), 2).Return([]*persistencespb.DynamicConfigChange{
+		{
+			ChangeId: 2,
+			Value:    &persistencespb.DynamicConfigValue{Key: "frontend.rps", Value: "200", Constraints: &persistencespb.DynamicConfigConstraints{}},
+			Deleted:  true,
+		},
+	}, nil)
+	resp, err = s.handler.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{
+		Key:           "frontend.rps",
+		PageSize:      2,
+		NextPageToken: resp.GetNextPageToken(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetChanges(), 1)
+	s.True(resp.GetChanges()[0].GetDeleted())
+	s.Empty(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	ctx := context.Background()
+
+	_, err := s.handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{})
+	s.Equal(errNamespaceNotSet, err)
+	_, err = s.handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: common.SystemLocalNamespace})
+	s.Equal(errCannotDeleteSystemNamespace, err)
+
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		},
+		IsGlobalNamespace: true,
+	}, nil).Times(1)
+	_, err = s.handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: s.namespace})
+	s.Equal(errCannotDeleteGlobalNamespace, err)
+
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		},
+	}, nil).Times(1)
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("GetID").Return("temporal-sys-delete-namespace-" + s.namespaceID)
+	mockRun.On("GetRunID").Return("random-run-id")
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, "temporal-sys-delete-namespace-workflow", mock.Anything).Return(mockRun, nil).Once()
+	resp, err := s.handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: s.namespace})
+	s.NoError(err)
+	s.Equal(s.namespaceID, resp.GetNamespaceId())
+	s.Equal("temporal-sys-delete-namespace-"+s.namespaceID, resp.GetWorkflowId())
+	s.Equal("random-run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace() {
+	ctx := context.Background()
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = mockNamespaceHandler
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+
+	_, err := s.handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{NewName: "new-name"})
+	s.Equal(errNamespaceNotSet, err)
+	_, err = s.handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{Namespace: s.namespace})
+	s.Equal(errNewNamespaceNameNotSet, err)
+	_, err = s.handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{Namespace: common.SystemLocalNamespace, NewName: "new-name"})
+	s.Equal(errCannotRenameSystemNamespace, err)
+
+	request := &adminservice.RenameNamespaceRequest{Namespace: s.namespace, NewName: "new-name"}
+	mockNamespaceHandler.EXPECT().RenameNamespace(gomock.Any(), request).Return(&adminservice.RenameNamespaceResponse{
+		NamespaceId: s.namespaceID,
+	}, nil)
+	resp, err := s.handler.RenameNamespace(ctx, request)
+	s.NoError(err)
+	s.Equal(s.namespaceID, resp.GetNamespaceId())
+}
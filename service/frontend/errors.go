@@ -69,11 +69,21 @@ var (
 	errInvalidEndEventCombination                         = serviceerror.NewInvalidArgument("Invalid EndEventId and EndEventVersion combination.")
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("Invalid ShardId.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errTokenNamespaceMismatch                             = serviceerror.NewInvalidArgument("Operation requested with a token from a different namespace.")
+	errDynamicConfigKeyNotSet                             = serviceerror.NewInvalidArgument("Dynamic config key is not set on request.")
+	errDynamicConfigValueNotSet                           = serviceerror.NewInvalidArgument("Dynamic config value is not set on request.")
+	errCannotDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("System namespace cannot be deleted.")
+	errCannotDeleteGlobalNamespace                        = serviceerror.NewInvalidArgument("Global namespace cannot be deleted.")
+	errNewNamespaceNameNotSet                             = serviceerror.NewInvalidArgument("New namespace name not set on request.")
+	errCannotRenameSystemNamespace                        = serviceerror.NewInvalidArgument("System namespace cannot be renamed.")
+	errCannotReclaimGlobalNamespaceFields                 = serviceerror.NewInvalidArgument("Search attribute fields of global namespace cannot be reclaimed.")
+	errCannotReclaimFieldsWithoutElasticsearch            = serviceerror.NewInvalidArgument("Search attribute fields can be reclaimed only if Elasticsearch visibility is configured.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
+	errPersistedDynamicConfigNotAvailable                 = serviceerror.NewUnavailable("Persisted dynamic config is not available.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
@@ -84,8 +94,13 @@ var (
 	errUnableToGetSearchAttributesMessage             = "Unable to get search attributes: %v."
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
 	errUnableToSaveSearchAttributesMessage            = "Unable to save search attributes: %v."
+	errUnableToClearSearchAttributeFieldMessage       = "Unable to clear search attribute field %s: %v."
 	errUnableToStartWorkflowMessage                   = "Unable to start %s workflow: %v."
 	errWorkflowReturnedErrorMessage                   = "Workflow %s returned an error: %v."
 
+	errUnknownDynamicConfigKeyMessage       = "Unknown dynamic config key: %v."
+	errInvalidDynamicConfigValueMessage     = "Invalid dynamic config value: %v."
+	errDynamicConfigValueDoesntExistMessage = "Dynamic config value for key %v with the given constraints doesn't exist."
+
 	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.", "")
 )
@@ -51,6 +51,7 @@ import (
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend/configs"
 	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -89,6 +90,9 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// PayloadOffloadThreshold is the payload size above which payloads are offloaded to blob storage
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Namespace specific config
@@ -156,6 +160,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		PayloadOffloadThreshold:                dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadOffloadThreshold, 0),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
@@ -216,6 +221,7 @@ func NewService(
 	visibilityManagerInitializer := func(
 		persistenceBean persistenceClient.Bean,
 		searchAttributesProvider searchattribute.Provider,
+		searchAttributesMapper searchattribute.Mapper,
 		logger log.Logger,
 	) (visibility.VisibilityManager, error) {
 		visibilityFromDB, err := visibilityclient.NewVisibilityManager(
@@ -223,6 +229,8 @@ func NewService(
 			serviceConfig.PersistenceMaxQPS,
 			params.MetricsClient,
 			params.PersistenceServiceResolver,
+			searchAttributesProvider,
+			params.ESConfig.GetVisibilityIndex(),
 			params.Logger,
 		)
 		if err != nil {
@@ -237,7 +245,7 @@ func NewService(
 				VisibilityListMaxQPS: serviceConfig.ESVisibilityListMaxQPS,
 			}
 			visibilityFromES = elasticsearch.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
-				searchAttributesProvider, nil, params.MetricsClient, logger)
+				searchAttributesProvider, searchAttributesMapper, nil, params.MetricsClient, logger)
 		}
 		return visibility.NewVisibilityManagerWrapper(
 			visibilityFromDB,
@@ -296,6 +304,10 @@ func NewService(
 		configs.ExecutionAPICountLimitOverride,
 	)
 
+	namespaceStateInterceptor := interceptor.NewNamespaceStateInterceptor(
+		serviceResource.GetNamespaceCache(),
+	)
+
 	namespaceLogger := params.NamespaceLogger
 	namespaceLogInterceptor := interceptor.NewNamespaceLogInterceptor(
 		serviceResource.GetNamespaceCache(),
@@ -322,12 +334,14 @@ func NewService(
 		grpc.KeepaliveParams(kp),
 		grpc.KeepaliveEnforcementPolicy(kep),
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerInterceptor(common.FrontendServiceName),
 			namespaceLogInterceptor.Intercept,
 			rpc.ServiceErrorInterceptor,
 			metricsInterceptor.Intercept,
 			rateLimiterInterceptor.Intercept,
 			namespaceRateLimiterInterceptor.Intercept,
 			namespaceCountLimiterInterceptor.Intercept,
+			namespaceStateInterceptor.Intercept,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			authorization.NewAuthorizationInterceptor(
 				params.ClaimMapper,
@@ -30,6 +30,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -38,7 +39,9 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -56,6 +59,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/validator"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -128,8 +132,9 @@ func NewWorkflowHandler(
 			namespace.NewNamespaceReplicator(namespaceReplicationQueue, resource.GetLogger()),
 			resource.GetArchivalMetadata(),
 			resource.GetArchiverProvider(),
+			resource.GetSearchAttributesProvider(),
 		),
-		visibilityQueryValidator:        validator.NewQueryValidator(resource.GetSearchAttributesProvider()),
+		visibilityQueryValidator:        validator.NewQueryValidator(resource.GetSearchAttributesProvider(), resource.GetSearchAttributesMapper()),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
 	}
 
@@ -616,6 +621,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				historyBlob = historyBlob[len(historyBlob)-1 : len(historyBlob)]
 			} else {
 				history, _, err = wh.getHistory(
+					ctx,
 					wh.metricsScope(ctx),
 					namespaceID,
 					*execution,
@@ -662,6 +668,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				)
 			} else {
 				history, continuationToken.PersistenceToken, err = wh.getHistory(
+					ctx,
 					wh.metricsScope(ctx),
 					namespaceID,
 					*execution,
@@ -934,7 +941,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.offloadedSize(request.GetFailure(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceId,
@@ -1052,7 +1059,7 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 
 		return nil, err
 	}
-	return &workflowservice.PollActivityTaskQueueResponse{
+	response := &workflowservice.PollActivityTaskQueueResponse{
 		TaskToken:                   matchingResponse.TaskToken,
 		WorkflowExecution:           matchingResponse.WorkflowExecution,
 		ActivityId:                  matchingResponse.ActivityId,
@@ -1069,7 +1076,15 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		WorkflowType:                matchingResponse.WorkflowType,
 		WorkflowNamespace:           matchingResponse.WorkflowNamespace,
 		Header:                      matchingResponse.Header,
-	}, nil
+	}
+	// activity input is loaded from history on events cache miss and can reference an offloaded payload
+	if err := wh.GetPayloadOffloader().Rehydrate(ctx, response); err != nil {
+		wh.GetLogger().Error("Unable to rehydrate offloaded payloads of activity task.",
+			tag.WorkflowTaskQueueName(request.GetTaskQueue().GetName()),
+			tag.Error(err))
+		return nil, err
+	}
+	return response, nil
 }
 
 // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
@@ -1308,7 +1323,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.offloadedSize(request.GetResult(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceId,
@@ -1408,7 +1423,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.offloadedSize(request.GetResult(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1509,7 +1524,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.offloadedSize(request.GetFailure(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1597,7 +1612,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.offloadedSize(request.GetFailure(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1681,7 +1696,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		wh.offloadedSize(request.GetDetails(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1780,7 +1795,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		wh.offloadedSize(request.GetDetails(), namespaceEntry.GetInfo().Name),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1914,7 +1929,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		wh.offloadedSize(request.GetInput(), request.GetNamespace()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -2850,6 +2865,10 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 	}
 	searchattribute.ApplyTypeMap(response.GetWorkflowExecutionInfo().GetSearchAttributes(), searchAttributes)
 
+	if err := setCronScheduleHeaders(ctx, response.GetCronSchedule()); err != nil {
+		wh.GetLogger().Warn("Unable to set cron schedule response headers.", tag.WorkflowID(request.Execution.GetWorkflowId()), tag.Error(err))
+	}
+
 	return &workflowservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig:       response.GetExecutionConfig(),
 		WorkflowExecutionInfo: response.GetWorkflowExecutionInfo(),
@@ -2976,7 +2995,9 @@ func (wh *WorkflowHandler) getRawHistory(
 	var rawHistory []*commonpb.DataBlob
 	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), wh.config.NumHistoryShards)
 
-	resp, err := wh.GetExecutionManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+	// the clients can't read the payloads offloaded to the payload store
+	executionManager := payloadoffload.NewRehydratingExecutionManager(wh.GetExecutionManager(), wh.GetPayloadOffloader())
+	resp, err := executionManager.ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
 		MinEventID:    firstEventID,
 		MaxEventID:    nextEventID,
@@ -2988,6 +3009,7 @@ func (wh *WorkflowHandler) getRawHistory(
 		return nil, nil, err
 	}
 
+	// the execution manager decompresses the raw history blobs, the clients never see the compressed ones
 	for _, data := range resp.HistoryEventBlobs {
 		rawHistory = append(rawHistory, &commonpb.DataBlob{
 			EncodingType: data.EncodingType,
@@ -3028,6 +3050,7 @@ func (wh *WorkflowHandler) getRawHistory(
 }
 
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -3102,6 +3125,14 @@ func (wh *WorkflowHandler) getHistory(
 	executionHistory := &historypb.History{
 		Events: historyEvents,
 	}
+	if err := wh.GetPayloadOffloader().Rehydrate(ctx, executionHistory); err != nil {
+		wh.GetLogger().Error("getHistory: unable to rehydrate offloaded payloads",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return nil, nil, err
+	}
 	return executionHistory, nextPageToken, nil
 }
 
@@ -3209,6 +3240,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 			return nil, dErr
 		}
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			wh.metricsScope(ctx),
 			namespaceID,
 			*matchingResp.GetWorkflowExecution(),
@@ -3501,3 +3533,21 @@ func (wh *WorkflowHandler) checkNamespaceMatch(requestNamespace string, tokenNam
 func (wh *WorkflowHandler) metricsScope(ctx context.Context) metrics.Scope {
 	return interceptor.MetricsScope(ctx, wh.GetLogger())
 }
+
+// offloadedSize returns the size the message takes in history, once its large payloads are offloaded
+func (wh *WorkflowHandler) offloadedSize(message proto.Message, namespace string) int {
+	return wh.GetPayloadOffloader().Size(message, wh.config.PayloadOffloadThreshold(namespace))
+}
+
+// setCronScheduleHeaders returns cron schedule and the time zone it is evaluated in as response headers
+// because they are not part of the public DescribeWorkflowExecution response.
+func setCronScheduleHeaders(ctx context.Context, cronSchedule string) error {
+	if cronSchedule == "" {
+		return nil
+	}
+	md := metadata.Pairs(headers.CronScheduleHeaderName, cronSchedule)
+	if timeZone := backoff.GetScheduleTimeZone(cronSchedule); timeZone != "" {
+		md.Set(headers.CronTimeZoneHeaderName, timeZone)
+	}
+	return grpc.SetHeader(ctx, md)
+}
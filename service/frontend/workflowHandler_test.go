@@ -42,11 +42,17 @@ import (
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloadoffload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -57,9 +63,11 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	dc "go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
@@ -1050,6 +1058,63 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 	s.True(resp.GetArchived())
 }
 
+func (s *workflowHandlerSuite) TestGetRawHistory_Rehydrated() {
+	namespaceID := uuid.New()
+	branchToken := []byte{1}
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wid",
+		RunId:      "rid",
+	}
+	input := payloads.EncodeString("input")
+	data, err := input.Payloads[0].Marshal()
+	s.NoError(err)
+	startedEvent := &historypb.HistoryEvent{
+		EventId:   common.FirstEventID,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: input,
+			},
+		},
+	}
+
+	uri, err := archiver.NewURI("file:///tmp/payloads")
+	s.NoError(err)
+	mockPayloadStore := archiver.NewMockPayloadStore(s.controller)
+	s.mockResource.PayloadOffloader = payloadoffload.NewOffloader(mockPayloadStore, uri)
+	mockPayloadStore.EXPECT().Put(gomock.Any(), uri, gomock.Any(), data).Return(nil)
+	events, err := s.mockResource.PayloadOffloader.Offload(context.Background(), uuid.New(), 1, []*historypb.HistoryEvent{startedEvent})
+	s.NoError(err)
+	serializer := serialization.NewSerializer()
+	referenceBlob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any()).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*commonpb.DataBlob{referenceBlob},
+		NextPageToken:     []byte{},
+	}, nil)
+	mockPayloadStore.EXPECT().Get(gomock.Any(), uri, gomock.Any()).Return(data, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	rawHistory, _, err := wh.getRawHistory(
+		metrics.NoopScope(metrics.Frontend),
+		namespaceID,
+		we,
+		common.FirstEventID,
+		common.FirstEventID+1,
+		1,
+		[]byte{},
+		nil,
+		branchToken,
+	)
+	s.NoError(err)
+	s.Len(rawHistory, 1)
+	rehydrated, err := serializer.DeserializeEvents(rawHistory[0])
+	s.NoError(err)
+	s.Equal([]*historypb.HistoryEvent{startedEvent}, rehydrated)
+}
+
 func (s *workflowHandlerSuite) TestGetHistory() {
 	namespaceID := uuid.New()
 	firstEventID := int64(100)
@@ -1097,6 +1162,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
 	history, token, err := wh.getHistory(
+		context.Background(),
 		metrics.NoopScope(metrics.Frontend),
 		namespaceID,
 		we,
@@ -1213,12 +1279,65 @@ func (s *workflowHandlerSuite) TestGetSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_CronSchedule() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: uuid.New()}
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+		"memoKey": payload.EncodeString("memoValue"),
+	}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: execution,
+			Memo:      memo,
+		},
+		CronSchedule: "CRON_TZ=Europe/Oslo 0 9 * * *",
+	}, nil)
+
+	stream := &testServerTransportStream{}
+	resp, err := wh.DescribeWorkflowExecution(grpc.NewContextWithServerTransportStream(context.Background(), stream), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Execution: execution,
+	})
+	s.NoError(err)
+
+	// memo is returned as the workflow set it
+	s.Equal(memo, resp.GetWorkflowExecutionInfo().GetMemo())
+	s.Equal([]string{"CRON_TZ=Europe/Oslo 0 9 * * *"}, stream.header.Get(headers.CronScheduleHeaderName))
+	s.Equal([]string{"Europe/Oslo"}, stream.header.Get(headers.CronTimeZoneHeaderName))
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_NoCronSchedule() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: execution,
+		},
+	}, nil)
+
+	stream := &testServerTransportStream{}
+	resp, err := wh.DescribeWorkflowExecution(grpc.NewContextWithServerTransportStream(context.Background(), stream), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Nil(resp.GetWorkflowExecutionInfo().GetMemo())
+	s.Nil(stream.header)
+}
+
 func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
 
 	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
 	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.SearchAttributesMapper.EXPECT().GetFieldName("InvalidKey", s.testNamespace).Return("", searchattribute.ErrInvalidName).Times(2)
 	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any()).Return(&visibility.ListWorkflowExecutionsResponse{}, nil)
 
 	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
@@ -1249,6 +1368,7 @@ func (s *workflowHandlerSuite) TestScanWorkflowExecutions() {
 
 	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
 	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.SearchAttributesMapper.EXPECT().GetFieldName("InvalidKey", s.testNamespace).Return("", searchattribute.ErrInvalidName).Times(2)
 	s.mockVisibilityMgr.EXPECT().ScanWorkflowExecutions(gomock.Any()).Return(&visibility.ListWorkflowExecutionsResponse{}, nil)
 
 	scanRequest := &workflowservice.ScanWorkflowExecutionsRequest{
@@ -1282,6 +1402,7 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 
 	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
 	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.SearchAttributesMapper.EXPECT().GetFieldName("InvalidKey", s.testNamespace).Return("", searchattribute.ErrInvalidName)
 	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any()).Return(&visibility.CountWorkflowExecutionsResponse{}, nil)
 
 	countRequest := &workflowservice.CountWorkflowExecutionsRequest{
@@ -1588,3 +1709,24 @@ func listArchivedWorkflowExecutionsTestRequest() *workflowservice.ListArchivedWo
 		Query:     "some random query string",
 	}
 }
+
+type testServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *testServerTransportStream) Method() string {
+	return ""
+}
+
+func (s *testServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *testServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *testServerTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
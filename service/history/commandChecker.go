@@ -29,6 +29,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
@@ -45,6 +46,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
@@ -75,6 +77,9 @@ type (
 		historyCountLimitWarn  int
 		historyCountLimitError int
 
+		payloadOffloader        payloadoffload.Offloader
+		payloadOffloadThreshold int
+
 		completedID               int64
 		mutableState              workflow.MutableState
 		searchAttributesValidator *searchattribute.Validator
@@ -113,6 +118,8 @@ func newWorkflowSizeChecker(
 	historySizeLimitError int,
 	historyCountLimitWarn int,
 	historyCountLimitError int,
+	payloadOffloader payloadoffload.Offloader,
+	payloadOffloadThreshold int,
 	completedID int64,
 	mutableState workflow.MutableState,
 	searchAttributesValidator *searchattribute.Validator,
@@ -129,6 +136,8 @@ func newWorkflowSizeChecker(
 		historySizeLimitError:     historySizeLimitError,
 		historyCountLimitWarn:     historyCountLimitWarn,
 		historyCountLimitError:    historyCountLimitError,
+		payloadOffloader:          payloadOffloader,
+		payloadOffloadThreshold:   payloadOffloadThreshold,
 		completedID:               completedID,
 		mutableState:              mutableState,
 		searchAttributesValidator: searchAttributesValidator,
@@ -138,6 +147,11 @@ func newWorkflowSizeChecker(
 	}
 }
 
+// offloadedSize returns the size the message takes in history, once its large payloads are offloaded
+func (c *workflowSizeChecker) offloadedSize(message proto.Message) int {
+	return c.payloadOffloader.Size(message, c.payloadOffloadThreshold)
+}
+
 func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
 	commandTypeTag metrics.Tag,
 	payloadSize int,
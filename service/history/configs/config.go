@@ -42,7 +42,7 @@ type Config struct {
 	// TODO remove this dynamic flag in 1.14.x
 	EnableDBRecordVersion dynamicconfig.BoolPropertyFn
 
-	RPS                           dynamicconfig.IntPropertyFn
+	RPS                           dynamicconfig.IntPropertySubscriptionFn
 	MaxIDLengthLimit              dynamicconfig.IntPropertyFn
 	PersistenceMaxQPS             dynamicconfig.IntPropertyFn
 	PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
@@ -57,16 +57,18 @@ type Config struct {
 	ShutdownDrainDuration         dynamicconfig.DurationPropertyFn
 
 	// HistoryCache settings
-	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	// Change of these configs require shard restart, except for the max sizes which apply immediately
+	HistoryCacheInitialSize  dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize      dynamicconfig.IntPropertySubscriptionFn
+	HistoryCacheMaxSizeBytes dynamicconfig.IntPropertySubscriptionFn
+	HistoryCacheTTL          dynamicconfig.DurationPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
-	EventsCacheInitialSize dynamicconfig.IntPropertyFn
-	EventsCacheMaxSize     dynamicconfig.IntPropertyFn
-	EventsCacheTTL         dynamicconfig.DurationPropertyFn
+	EventsCacheInitialSize  dynamicconfig.IntPropertyFn
+	EventsCacheMaxSize      dynamicconfig.IntPropertyFn
+	EventsCacheMaxSizeBytes dynamicconfig.IntPropertyFn
+	EventsCacheTTL          dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
 	RangeSizeBits           uint
@@ -85,6 +87,9 @@ type Config struct {
 	TaskSchedulerWorkerCount       dynamicconfig.IntPropertyFn
 	TaskSchedulerQueueSize         dynamicconfig.IntPropertyFn
 	TaskSchedulerRoundRobinWeights dynamicconfig.MapPropertyFn
+	// Namespace fair task scheduler settings
+	TaskSchedulerNamespaceWeight      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespaceMaxInFlight dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                                dynamicconfig.IntPropertyFn
@@ -169,6 +174,9 @@ type Config struct {
 	NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
 	ArchiveRequestRPS         dynamicconfig.IntPropertyFn
 
+	// PayloadOffloadThreshold is the payload size above which payloads are offloaded to blob storage
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// Size limit related settings
 	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -227,6 +235,7 @@ type Config struct {
 
 	EnableDropStuckTaskByNamespaceID dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
 	SkipReapplicationByNamespaceID   dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+	TaskDLQMaxAttempts               dynamicconfig.IntPropertyFn
 
 	// ===== Visibility related =====
 	// VisibilityQueueProcessor settings
@@ -274,7 +283,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		// TODO remove this dynamic flag in 1.14.x
 		EnableDBRecordVersion: dc.GetBoolProperty(dynamicconfig.EnableDBRecordVersion, true),
 
-		RPS:                                  dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
+		RPS:                                  dc.SubscribeIntProperty(dynamicconfig.HistoryRPS, 3000),
 		MaxIDLengthLimit:                     dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		PersistenceMaxQPS:                    dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
 		PersistenceGlobalMaxQPS:              dc.GetIntProperty(dynamicconfig.HistoryPersistenceGlobalMaxQPS, 0),
@@ -287,10 +296,12 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
-		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
+		HistoryCacheMaxSize:                  dc.SubscribeIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
+		HistoryCacheMaxSizeBytes:             dc.SubscribeIntProperty(dynamicconfig.HistoryCacheMaxSizeBytes, 0),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
+		EventsCacheMaxSizeBytes:              dc.GetIntProperty(dynamicconfig.EventsCacheMaxSizeBytes, 0),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
@@ -307,6 +318,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		TaskSchedulerQueueSize:         dc.GetIntProperty(dynamicconfig.TaskSchedulerQueueSize, 2000),
 		TaskSchedulerRoundRobinWeights: dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights, ConvertWeightsToDynamicConfigValue(DefaultTaskPriorityWeight)),
 
+		TaskSchedulerNamespaceWeight:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceWeight, 1),
+		TaskSchedulerNamespaceMaxInFlight: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceMaxInFlight, 0),
+
 		TimerTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
 		TimerTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.TimerTaskMaxRetryCount, 100),
@@ -377,6 +391,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 		ArchiveRequestRPS:         dc.GetIntProperty(dynamicconfig.ArchiveRequestRPS, 300), // should be much smaller than frontend RPS
 
+		PayloadOffloadThreshold: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadOffloadThreshold, 0),
+
 		BlobSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 512*1024),
 		MemoSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MemoSizeLimitError, 2*1024*1024),
@@ -418,6 +434,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 
 		EnableDropStuckTaskByNamespaceID: dc.GetBoolPropertyFnWithNamespaceIDFilter(dynamicconfig.EnableDropStuckTaskByNamespaceID, false),
 		SkipReapplicationByNamespaceID:   dc.GetBoolPropertyFnWithNamespaceIDFilter(dynamicconfig.SkipReapplicationByNamespaceID, false),
+		TaskDLQMaxAttempts:               dc.GetIntProperty(dynamicconfig.TaskDLQMaxAttempts, 0),
 
 		// ===== Visibility related =====
 		VisibilityTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.VisibilityTaskBatchSize, 100),
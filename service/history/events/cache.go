@@ -91,22 +91,32 @@ func NewEventsCache(
 	shardID int32,
 	initialCount int,
 	maxCount int,
+	maxSizeInBytes int,
 	ttl time.Duration,
 	eventsMgr persistence.ExecutionManager,
 	disabled bool,
 	logger log.Logger,
-	metrics metrics.Client,
+	metricsClient metrics.Client,
 ) *CacheImpl {
 	opts := &cache.Options{}
 	opts.InitialCapacity = initialCount
 	opts.TTL = ttl
+	opts.EvictedFunc = func(_ interface{}) {
+		metricsClient.IncCounter(metrics.EventsCachePutEventScope, metrics.CacheEvictionCounter)
+	}
+	if maxSizeInBytes > 0 {
+		opts.MaxBytes = int64(maxSizeInBytes)
+		opts.SizeFunc = func(value interface{}) int64 {
+			return int64(value.(*historypb.HistoryEvent).Size())
+		}
+	}
 
 	return &CacheImpl{
 		Cache:         cache.New(maxCount, opts),
 		eventsMgr:     eventsMgr,
 		disabled:      disabled,
 		logger:        log.With(logger, tag.ComponentEventsCache),
-		metricsClient: metrics,
+		metricsClient: metricsClient,
 		shardID:       shardID,
 	}
 }
@@ -159,6 +169,9 @@ func (e *CacheImpl) PutEvent(namespaceID, workflowID, runID string, eventID int6
 
 	key := newEventKey(namespaceID, workflowID, runID, eventID)
 	e.Put(key, event)
+	if bytes := e.Bytes(); bytes > 0 {
+		e.metricsClient.RecordDistribution(metrics.EventsCachePutEventScope, metrics.CacheSizeBytes, int(bytes))
+	}
 }
 
 func (e *CacheImpl) DeleteEvent(namespaceID, workflowID, runID string, eventID int64) {
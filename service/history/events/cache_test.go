@@ -84,11 +84,16 @@ func (s *eventsCacheSuite) TearDownTest() {
 }
 
 func (s *eventsCacheSuite) newTestEventsCache() *CacheImpl {
+	return s.newTestEventsCacheWithMaxSizeInBytes(0)
+}
+
+func (s *eventsCacheSuite) newTestEventsCacheWithMaxSizeInBytes(maxSizeInBytes int) *CacheImpl {
 	shardId := int32(10)
 	return NewEventsCache(
 		shardId,
 		16,
 		32,
+		maxSizeInBytes,
 		time.Minute,
 		s.mockExecutionManager,
 		false,
@@ -227,3 +232,33 @@ func (s *eventsCacheSuite) TestEventsCacheDisableSuccess() {
 	s.Nil(err)
 	s.Equal(event2, actualEvent)
 }
+
+func (s *eventsCacheSuite) TestEventsCacheMaxSizeInBytes() {
+	namespaceID := "events-cache-max-size-in-bytes-namespace"
+	workflowID := "events-cache-max-size-in-bytes-workflow-id"
+	runID := "events-cache-max-size-in-bytes-run-id"
+	newEvent := func(eventID int64) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:    eventID,
+			EventType:  enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{}},
+		}
+	}
+	event1 := newEvent(23)
+	event2 := newEvent(32)
+	event3 := newEvent(41)
+	s.cache = s.newTestEventsCacheWithMaxSizeInBytes(event1.Size() + event2.Size())
+
+	s.cache.PutEvent(namespaceID, workflowID, runID, event1.GetEventId(), event1)
+	s.cache.PutEvent(namespaceID, workflowID, runID, event2.GetEventId(), event2)
+	s.Equal(int64(event1.Size()+event2.Size()), s.cache.Bytes())
+	s.cache.PutEvent(namespaceID, workflowID, runID, event3.GetEventId(), event3)
+	s.Equal(int64(event2.Size()+event3.Size()), s.cache.Bytes())
+	s.Equal(2, s.cache.Size())
+
+	// event1 is evicted
+	s.Nil(s.cache.Get(newEventKey(namespaceID, workflowID, runID, event1.GetEventId())))
+	actualEvent, err := s.cache.GetEvent(namespaceID, workflowID, runID, event3.GetEventId(), event3.GetEventId(), nil)
+	s.Nil(err)
+	s.Equal(event3, actualEvent)
+}
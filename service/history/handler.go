@@ -160,6 +160,16 @@ func (h *Handler) Start() {
 				WorkerCount: h.config.TaskSchedulerWorkerCount(),
 				RetryPolicy: common.CreatePersistanceRetryPolicy(),
 			}
+		case task.SchedulerTypeNamespaceFair:
+			queueTaskProcessorOptions.namespaceFairSchedulerOptions = &task.NamespaceFairTaskSchedulerOptions{
+				Weights:              h.config.TaskSchedulerRoundRobinWeights,
+				NamespaceFn:          newQueueTaskNamespaceFn(h.GetNamespaceCache()),
+				NamespaceWeight:      h.config.TaskSchedulerNamespaceWeight,
+				NamespaceMaxInFlight: h.config.TaskSchedulerNamespaceMaxInFlight,
+				QueueSize:            h.config.TaskSchedulerQueueSize(),
+				WorkerCount:          h.config.TaskSchedulerWorkerCount(),
+				RetryPolicy:          common.CreatePersistanceRetryPolicy(),
+			}
 		default:
 			h.GetLogger().Fatal("Unknown task scheduler type", tag.Value(schedulerType))
 		}
@@ -1423,6 +1433,64 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// DeleteWorkflowExecution deletes a closed workflow execution along with its history and visibility records.
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	execution := request.GetWorkflowExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	err = engine.DeleteWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		},
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return &historyservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// DescribeShardQueues returns the in-memory state of the task queue processors of a shard hosted by this instance
+func (h *Handler) DescribeShardQueues(ctx context.Context, request *historyservice.DescribeShardQueuesRequest) (_ *historyservice.DescribeShardQueuesResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.DescribeShardQueues(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
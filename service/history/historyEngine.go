@@ -31,6 +31,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -45,10 +46,12 @@ import (
 	"go.temporal.io/server/common/persistence/visibility"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/history"
@@ -61,6 +64,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -114,6 +118,7 @@ type (
 		matchingClient            matchingservice.MatchingServiceClient
 		rawMatchingClient         matchingservice.MatchingServiceClient
 		replicationDLQHandler     replicationDLQHandler
+		historyTaskDLQHandler     historyTaskDLQHandler
 		searchAttributesValidator *searchattribute.Validator
 	}
 )
@@ -198,6 +203,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.searchAttributesValidator = searchattribute.NewValidator(
 		logger,
 		shard.GetService().GetSearchAttributesProvider(),
+		shard.GetService().GetSearchAttributesMapper(),
 		config.SearchAttributesNumberOfKeysLimit,
 		config.SearchAttributesSizeOfValueLimit,
 		config.SearchAttributesTotalSizeLimit,
@@ -258,6 +264,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.replicationTaskProcessors = replicationTaskProcessors
 	replicationMessageHandler := newReplicationDLQHandler(shard, replicationTaskExecutors)
 	historyEngImpl.replicationDLQHandler = replicationMessageHandler
+	historyEngImpl.historyTaskDLQHandler = newHistoryTaskDLQHandler(shard)
 
 	shard.SetEngine(historyEngImpl)
 	return historyEngImpl
@@ -318,6 +325,7 @@ func (e *historyEngineImpl) Stop() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Stop()
 	}
+	e.historyCache.Stop()
 
 	if e.queueTaskProcessor != nil {
 		e.queueTaskProcessor.StopShardProcessor(e.shard)
@@ -519,7 +527,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 		return nil, serviceerror.NewInternal("unable to create 1st event batch")
 	}
 
-	historySize, err := weContext.PersistWorkflowEvents(newWorkflowEventsSeq[0])
+	historySize, err := weContext.PersistWorkflowEvents(ctx, newWorkflowEventsSeq[0])
 	if err != nil {
 		return nil, err
 	}
@@ -529,6 +537,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = weContext.CreateWorkflowExecution(
+		ctx,
 		now,
 		createMode,
 		prevRunID,
@@ -570,6 +579,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 				return nil, err
 			}
 			err = weContext.CreateWorkflowExecution(
+				ctx,
 				now,
 				createMode,
 				prevRunID,
@@ -758,7 +768,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 		return nil, err
 	}
 	defer func() { release(retErr) }()
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -967,7 +977,7 @@ func (e *historyEngineImpl) getMutableState(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1041,7 +1051,7 @@ func (e *historyEngineImpl) DescribeMutableState(
 	}
 
 	context.Clear()
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1111,7 +1121,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err1 := context.LoadWorkflowExecution()
+	mutableState, err1 := context.LoadWorkflowExecution(ctx)
 	if err1 != nil {
 		return nil, err1
 	}
@@ -1142,6 +1152,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			Status:               executionState.Status,
 			StateTransitionCount: executionInfo.StateTransitionCount,
 		},
+		CronSchedule: executionInfo.CronSchedule,
 	}
 
 	if executionInfo.ParentRunId != "" {
@@ -1871,7 +1882,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	Just_Signal_Loop:
 		for ; attempt <= conditionalRetryCount; attempt++ {
 			// workflow not exist, will create workflow then signal
-			mutableState, err1 := context.LoadWorkflowExecution()
+			mutableState, err1 := context.LoadWorkflowExecution(ctx)
 			if err1 != nil {
 				if _, ok := err1.(*serviceerror.NotFound); ok {
 					break
@@ -1911,7 +1922,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 			// the history and try the operation again.
-			if err := context.UpdateWorkflowExecutionAsActive(e.shard.GetTimeSource().Now()); err != nil {
+			if err := context.UpdateWorkflowExecutionAsActive(ctx, e.shard.GetTimeSource().Now()); err != nil {
 				if err == consts.ErrConflict {
 					continue Just_Signal_Loop
 				}
@@ -1939,7 +1950,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	if err := common.CheckEventBlobSizeLimit(
-		sRequest.GetSignalInput().Size(),
+		e.offloadedSize(sRequest.GetSignalInput(), namespace),
 		e.config.BlobSizeLimitWarn(namespace),
 		e.config.BlobSizeLimitError(namespace),
 		namespaceID,
@@ -2033,7 +2044,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		return nil, serviceerror.NewInternal("unable to create 1st event batch")
 	}
 
-	historySize, err := context.PersistWorkflowEvents(newWorkflowEventsSeq[0])
+	historySize, err := context.PersistWorkflowEvents(ctx, newWorkflowEventsSeq[0])
 	if err != nil {
 		return nil, err
 	}
@@ -2050,6 +2061,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		}
 	}
 	err = context.CreateWorkflowExecution(
+		ctx,
 		now,
 		createMode,
 		prevRunID,
@@ -2287,7 +2299,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}
 	defer func() { baseReleaseFn(retError) }()
 
-	baseMutableState, err := baseContext.LoadWorkflowExecution()
+	baseMutableState, err := baseContext.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2343,7 +2355,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}
 		defer func() { currentReleaseFn(retError) }()
 
-		currentMutableState, err = currentContext.LoadWorkflowExecution()
+		currentMutableState, err = currentContext.LoadWorkflowExecution(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -2417,7 +2429,7 @@ func (e *historyEngineImpl) updateWorkflow(
 	}
 	defer func() { workflowContext.getReleaseFn()(retError) }()
 
-	return e.updateWorkflowHelper(workflowContext, action)
+	return e.updateWorkflowHelper(ctx, workflowContext, action)
 }
 
 func (e *historyEngineImpl) updateWorkflowExecution(
@@ -2433,10 +2445,11 @@ func (e *historyEngineImpl) updateWorkflowExecution(
 	}
 	defer func() { workflowContext.getReleaseFn()(retError) }()
 
-	return e.updateWorkflowHelper(workflowContext, action)
+	return e.updateWorkflowHelper(ctx, workflowContext, action)
 }
 
 func (e *historyEngineImpl) updateWorkflowHelper(
+	ctx context.Context,
 	workflowContext workflowContext,
 	action updateWorkflowActionFunc,
 ) (retError error) {
@@ -2454,7 +2467,7 @@ UpdateHistoryLoop:
 				// Reload workflow execution history
 				workflowContext.getContext().Clear()
 				if attempt != conditionalRetryCount {
-					_, err = workflowContext.reloadMutableState()
+					_, err = workflowContext.reloadMutableState(ctx)
 					if err != nil {
 						return err
 					}
@@ -2479,10 +2492,10 @@ UpdateHistoryLoop:
 			}
 		}
 
-		err = workflowContext.getContext().UpdateWorkflowExecutionAsActive(e.shard.GetTimeSource().Now())
+		err = workflowContext.getContext().UpdateWorkflowExecutionAsActive(ctx, e.shard.GetTimeSource().Now())
 		if err == consts.ErrConflict {
 			if attempt != conditionalRetryCount {
-				_, err = workflowContext.reloadMutableState()
+				_, err = workflowContext.reloadMutableState(ctx)
 				if err != nil {
 					return err
 				}
@@ -2495,6 +2508,7 @@ UpdateHistoryLoop:
 }
 
 func (e *historyEngineImpl) failWorkflowTask(
+	ctx context.Context,
 	context workflow.Context,
 	scheduleID int64,
 	startedID int64,
@@ -2506,7 +2520,7 @@ func (e *historyEngineImpl) failWorkflowTask(
 	context.Clear()
 
 	// Reload workflow execution so we can apply the workflow task failure event
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2631,7 +2645,7 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	}
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		e.offloadedSize(request.GetInput(), namespace),
 		blobSizeLimitWarn,
 		blobSizeLimitError,
 		namespace,
@@ -3049,6 +3063,22 @@ func (e *historyEngineImpl) GetDLQMessages(
 	request *historyservice.GetDLQMessagesRequest,
 ) (*historyservice.GetDLQMessagesResponse, error) {
 
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		tasks, token, err := e.historyTaskDLQHandler.getMessages(
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.GetDLQMessagesResponse{
+			Type:          request.GetType(),
+			HistoryTasks:  tasks,
+			NextPageToken: token,
+		}, nil
+	}
+
 	_, ok := e.clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
 	if !ok {
 		return nil, consts.ErrUnknownCluster
@@ -3076,6 +3106,10 @@ func (e *historyEngineImpl) PurgeDLQMessages(
 	request *historyservice.PurgeDLQMessagesRequest,
 ) error {
 
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		return e.historyTaskDLQHandler.purgeMessages(request.GetInclusiveEndMessageId())
+	}
+
 	_, ok := e.clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
 	if !ok {
 		return consts.ErrUnknownCluster
@@ -3092,6 +3126,21 @@ func (e *historyEngineImpl) MergeDLQMessages(
 	request *historyservice.MergeDLQMessagesRequest,
 ) (*historyservice.MergeDLQMessagesResponse, error) {
 
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		token, err := e.historyTaskDLQHandler.mergeMessages(
+			ctx,
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+		}, nil
+	}
+
 	_, ok := e.clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
 	if !ok {
 		return nil, consts.ErrUnknownCluster
@@ -3135,7 +3184,7 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -3158,10 +3207,145 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 		return err
 	}
 
-	err = context.UpdateWorkflowExecutionAsActive(now)
+	err = context.UpdateWorkflowExecutionAsActive(ctx, now)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (e *historyEngineImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *historyservice.DescribeShardQueuesRequest,
+) (*historyservice.DescribeShardQueuesResponse, error) {
+
+	var continuation *tokenspb.ShardQueuesContinuation
+	if len(request.GetNextPageToken()) > 0 {
+		continuation = &tokenspb.ShardQueuesContinuation{}
+		if err := continuation.Unmarshal(request.GetNextPageToken()); err != nil {
+			return nil, consts.ErrDeserializingShardQueuesToken
+		}
+	}
+
+	var queues []*historyspb.QueueState
+	queues = append(queues, e.txProcessor.GetQueueStates()...)
+	queues = append(queues, e.timerProcessor.GetQueueStates()...)
+	if e.visibilityProcessor != nil {
+		queues = append(queues, e.visibilityProcessor.GetQueueStates()...)
+	}
+
+	response := &historyservice.DescribeShardQueuesResponse{
+		ShardId: e.shard.GetShardID(),
+		Queues:  queues,
+	}
+	if request.GetPageSize() <= 0 {
+		return response, nil
+	}
+
+	var nextContinuation *tokenspb.ShardQueuesContinuation
+	response.Queues, nextContinuation = pageQueueStates(queues, int(request.GetPageSize()), continuation)
+	if nextContinuation != nil {
+		nextPageToken, err := nextContinuation.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+
+	namespaceEntry, err := e.shard.GetNamespaceCache().GetNamespaceByID(namespaceUUID)
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	context, release, err := e.historyCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		execution,
+		workflow.CallerTypeAPI,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+
+	if mutableState.IsWorkflowExecutionRunning() {
+		return consts.ErrWorkflowNotCompleted
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
 	if err != nil {
 		return err
 	}
+	executionState := mutableState.GetExecutionState()
+	shardID := e.shard.GetShardID()
+
+	if err := e.shard.AddTasks(ctx, &persistence.AddTasksRequest{
+		ShardID: shardID,
+		// RangeID is set by shard
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+
+		VisibilityTasks: []persistence.Task{&persistence.DeleteExecutionVisibilityTask{
+			// TaskID is set by shard
+			VisibilityTimestamp: e.shard.GetTimeSource().Now(),
+			Version:             lastWriteVersion,
+		}},
+	}); err != nil {
+		return err
+	}
+
+	// history branches are deleted before the execution itself so that a failed attempt can be retried,
+	// the mutable state is still loadable until the very last step
+	for _, versionHistory := range mutableState.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if err := payloadoffload.DeleteHistoryBranch(
+			ctx,
+			e.shard.GetService().GetPayloadOffloader(),
+			e.shard.GetExecutionManager(),
+			&persistence.DeleteHistoryBranchRequest{
+				BranchToken: versionHistory.GetBranchToken(),
+				ShardID:     shardID,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := e.shard.GetExecutionManager().DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	}); err != nil {
+		return err
+	}
+
+	if err := e.shard.GetExecutionManager().DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	}); err != nil {
+		return err
+	}
+
+	// calling clear here to force accesses of mutable state to read database
+	// if this is not called then callers will get mutable state even though its been removed from database
+	context.Clear()
 	return nil
 }
 
@@ -3185,7 +3369,7 @@ func (e *historyEngineImpl) loadWorkflowOnce(
 		return nil, err
 	}
 
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		release(err)
 		return nil, err
@@ -3241,3 +3425,8 @@ func (e *historyEngineImpl) loadWorkflow(
 func (e *historyEngineImpl) metricsScope(ctx context.Context) metrics.Scope {
 	return interceptor.MetricsScope(ctx, e.logger)
 }
+
+// offloadedSize returns the size the message takes in history, once its large payloads are offloaded
+func (e *historyEngineImpl) offloadedSize(message proto.Message, namespace string) int {
+	return e.shard.GetService().GetPayloadOffloader().Size(message, e.config.PayloadOffloadThreshold(namespace))
+}
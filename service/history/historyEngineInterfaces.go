@@ -31,6 +31,7 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
@@ -63,8 +64,10 @@ type (
 		getFinishedChan() <-chan struct{}
 		readQueueTasks() ([]queueTaskInfo, bool, error)
 		completeQueueTask(taskID int64)
+		recordQueueTaskError(taskID int64, attempt int, err error)
 		getQueueAckLevel() int64
 		getQueueReadLevel() int64
+		getQueueState() *historyspb.QueueState
 		updateQueueAckLevel() error
 	}
 
@@ -101,6 +104,7 @@ type (
 	taskExecutor interface {
 		process(ctx context.Context, taskInfo *taskInfo) (int, error)
 		complete(taskInfo *taskInfo)
+		recordError(taskInfo *taskInfo, err error)
 		getTaskFilter() taskFilter
 	}
 
@@ -120,8 +124,10 @@ type (
 		getFinishedChan() <-chan struct{}
 		readTimerTasks() ([]*persistencespb.TimerTaskInfo, *persistencespb.TimerTaskInfo, bool, error)
 		completeTimerTask(timerTask *persistencespb.TimerTaskInfo)
+		recordTimerTaskError(timerTask *persistencespb.TimerTaskInfo, attempt int, err error)
 		getAckLevel() timerKey
 		getReadLevel() timerKey
+		getQueueState() *historyspb.QueueState
 		updateAckLevel() error
 	}
 
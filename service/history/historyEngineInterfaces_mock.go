@@ -35,6 +35,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	enums "go.temporal.io/server/api/enums/v1"
+	history "go.temporal.io/server/api/history/v1"
 	persistence "go.temporal.io/server/api/persistence/v1"
 	repication "go.temporal.io/server/api/replication/v1"
 	persistence0 "go.temporal.io/server/common/persistence"
@@ -267,6 +268,20 @@ func (mr *MockqueueAckMgrMockRecorder) getQueueReadLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getQueueReadLevel", reflect.TypeOf((*MockqueueAckMgr)(nil).getQueueReadLevel))
 }
 
+// getQueueState mocks base method.
+func (m *MockqueueAckMgr) getQueueState() *history.QueueState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getQueueState")
+	ret0, _ := ret[0].(*history.QueueState)
+	return ret0
+}
+
+// getQueueState indicates an expected call of getQueueState.
+func (mr *MockqueueAckMgrMockRecorder) getQueueState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getQueueState", reflect.TypeOf((*MockqueueAckMgr)(nil).getQueueState))
+}
+
 // readQueueTasks mocks base method.
 func (m *MockqueueAckMgr) readQueueTasks() ([]queueTaskInfo, bool, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +298,18 @@ func (mr *MockqueueAckMgrMockRecorder) readQueueTasks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "readQueueTasks", reflect.TypeOf((*MockqueueAckMgr)(nil).readQueueTasks))
 }
 
+// recordQueueTaskError mocks base method.
+func (m *MockqueueAckMgr) recordQueueTaskError(taskID int64, attempt int, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "recordQueueTaskError", taskID, attempt, err)
+}
+
+// recordQueueTaskError indicates an expected call of recordQueueTaskError.
+func (mr *MockqueueAckMgrMockRecorder) recordQueueTaskError(taskID, attempt, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recordQueueTaskError", reflect.TypeOf((*MockqueueAckMgr)(nil).recordQueueTaskError), taskID, attempt, err)
+}
+
 // updateQueueAckLevel mocks base method.
 func (m *MockqueueAckMgr) updateQueueAckLevel() error {
 	m.ctrl.T.Helper()
@@ -862,6 +889,18 @@ func (mr *MocktaskExecutorMockRecorder) process(ctx, taskInfo interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "process", reflect.TypeOf((*MocktaskExecutor)(nil).process), ctx, taskInfo)
 }
 
+// recordError mocks base method.
+func (m *MocktaskExecutor) recordError(taskInfo *taskInfo, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "recordError", taskInfo, err)
+}
+
+// recordError indicates an expected call of recordError.
+func (mr *MocktaskExecutorMockRecorder) recordError(taskInfo, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recordError", reflect.TypeOf((*MocktaskExecutor)(nil).recordError), taskInfo, err)
+}
+
 // Mockprocessor is a mock of processor interface.
 type Mockprocessor struct {
 	ctrl     *gomock.Controller
@@ -956,6 +995,18 @@ func (mr *MockprocessorMockRecorder) readTasks(readLevel interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "readTasks", reflect.TypeOf((*Mockprocessor)(nil).readTasks), readLevel)
 }
 
+// recordError mocks base method.
+func (m *Mockprocessor) recordError(taskInfo *taskInfo, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "recordError", taskInfo, err)
+}
+
+// recordError indicates an expected call of recordError.
+func (mr *MockprocessorMockRecorder) recordError(taskInfo, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recordError", reflect.TypeOf((*Mockprocessor)(nil).recordError), taskInfo, err)
+}
+
 // updateAckLevel mocks base method.
 func (m *Mockprocessor) updateAckLevel(taskID int64) error {
 	m.ctrl.T.Helper()
@@ -1046,6 +1097,18 @@ func (mr *MocktimerProcessorMockRecorder) process(ctx, taskInfo interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "process", reflect.TypeOf((*MocktimerProcessor)(nil).process), ctx, taskInfo)
 }
 
+// recordError mocks base method.
+func (m *MocktimerProcessor) recordError(taskInfo *taskInfo, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "recordError", taskInfo, err)
+}
+
+// recordError indicates an expected call of recordError.
+func (mr *MocktimerProcessorMockRecorder) recordError(taskInfo, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recordError", reflect.TypeOf((*MocktimerProcessor)(nil).recordError), taskInfo, err)
+}
+
 // MocktimerQueueAckMgr is a mock of timerQueueAckMgr interface.
 type MocktimerQueueAckMgr struct {
 	ctrl     *gomock.Controller
@@ -1109,6 +1172,20 @@ func (mr *MocktimerQueueAckMgrMockRecorder) getFinishedChan() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getFinishedChan", reflect.TypeOf((*MocktimerQueueAckMgr)(nil).getFinishedChan))
 }
 
+// getQueueState mocks base method.
+func (m *MocktimerQueueAckMgr) getQueueState() *history.QueueState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getQueueState")
+	ret0, _ := ret[0].(*history.QueueState)
+	return ret0
+}
+
+// getQueueState indicates an expected call of getQueueState.
+func (mr *MocktimerQueueAckMgrMockRecorder) getQueueState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getQueueState", reflect.TypeOf((*MocktimerQueueAckMgr)(nil).getQueueState))
+}
+
 // getReadLevel mocks base method.
 func (m *MocktimerQueueAckMgr) getReadLevel() timerKey {
 	m.ctrl.T.Helper()
@@ -1140,6 +1217,18 @@ func (mr *MocktimerQueueAckMgrMockRecorder) readTimerTasks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "readTimerTasks", reflect.TypeOf((*MocktimerQueueAckMgr)(nil).readTimerTasks))
 }
 
+// recordTimerTaskError mocks base method.
+func (m *MocktimerQueueAckMgr) recordTimerTaskError(timerTask *persistence.TimerTaskInfo, attempt int, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "recordTimerTaskError", timerTask, attempt, err)
+}
+
+// recordTimerTaskError indicates an expected call of recordTimerTaskError.
+func (mr *MocktimerQueueAckMgrMockRecorder) recordTimerTaskError(timerTask, attempt, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recordTimerTaskError", reflect.TypeOf((*MocktimerQueueAckMgr)(nil).recordTimerTaskError), timerTask, attempt, err)
+}
+
 // updateAckLevel mocks base method.
 func (m *MocktimerQueueAckMgr) updateAckLevel() error {
 	m.ctrl.T.Helper()
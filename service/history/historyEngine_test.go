@@ -140,6 +140,7 @@ func (s *engineSuite) SetupTest() {
 		s.mockShard.GetShardID(),
 		s.mockShard.GetConfig().EventsCacheInitialSize(),
 		s.mockShard.GetConfig().EventsCacheMaxSize(),
+		s.mockShard.GetConfig().EventsCacheMaxSizeBytes(),
 		s.mockShard.GetConfig().EventsCacheTTL(),
 		s.mockShard.GetExecutionManager(),
 		false,
@@ -629,7 +630,7 @@ func (s *engineSuite) TestQueryWorkflow_ConsistentQueryBufferFull() {
 		workflow.CallerTypeAPI,
 	)
 	s.NoError(err)
-	loadedMS, err := ctx.LoadWorkflowExecution()
+	loadedMS, err := ctx.LoadWorkflowExecution(context.Background())
 	s.NoError(err)
 	qr := workflow.NewQueryRegistry()
 	qr.BufferQuery(&querypb.WorkflowQuery{})
@@ -4204,7 +4205,7 @@ func (s *engineSuite) TestRequestCancel_RespondWorkflowTaskCompleted_SuccessWith
 		workflow.CallerTypeAPI,
 	)
 	s.NoError(err)
-	loadedMS, err := ctx.LoadWorkflowExecution()
+	loadedMS, err := ctx.LoadWorkflowExecution(context.Background())
 	s.NoError(err)
 	qr := workflow.NewQueryRegistry()
 	id1, _ := qr.BufferQuery(&querypb.WorkflowQuery{})
@@ -4926,6 +4927,56 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestDeleteWorkflowExecution_Running",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache, log.NewTestLogger(), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gweResponse, nil)
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), tests.NamespaceID, execution)
+	s.Equal(consts.ErrWorkflowNotCompleted, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Completed() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestDeleteWorkflowExecution_Completed",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache, log.NewTestLogger(), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	event := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, "some random identity")
+	addCompleteWorkflowEvent(msBuilder, event.GetEventId(), nil)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gweResponse, nil)
+	s.mockExecutionMgr.EXPECT().AddTasks(gomock.Any()).DoAndReturn(func(request *persistence.AddTasksRequest) error {
+		s.Len(request.VisibilityTasks, 1)
+		s.IsType(&persistence.DeleteExecutionVisibilityTask{}, request.VisibilityTasks[0])
+		return nil
+	})
+	s.mockExecutionMgr.EXPECT().DeleteHistoryBranch(gomock.Any()).Return(nil)
+	s.mockExecutionMgr.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any()).Return(nil)
+	s.mockExecutionMgr.EXPECT().DeleteWorkflowExecution(gomock.Any()).Return(nil)
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), tests.NamespaceID, execution)
+	s.NoError(err)
+}
+
 func (s *engineSuite) getBuilder(testNamespaceID string, we commonpb.WorkflowExecution) workflow.MutableState {
 	context, release, err := s.mockHistoryEngine.historyCache.GetOrCreateWorkflowExecution(
 		context.Background(),
@@ -0,0 +1,370 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination historyTaskDLQHandler_mock.go
+
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+)
+
+type (
+	// historyTaskDLQHandler is the interface handles history task DLQ messages
+	historyTaskDLQHandler interface {
+		getMessages(
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]*persistencespb.HistoryDLQTaskInfo, []byte, error)
+		purgeMessages(
+			lastMessageID int64,
+		) error
+		mergeMessages(
+			ctx context.Context,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]byte, error)
+	}
+
+	historyTaskDLQHandlerImpl struct {
+		shard  shard.Context
+		logger log.Logger
+	}
+)
+
+func newHistoryTaskDLQHandler(
+	shard shard.Context,
+) historyTaskDLQHandler {
+
+	return &historyTaskDLQHandlerImpl{
+		shard:  shard,
+		logger: shard.GetLogger(),
+	}
+}
+
+func (h *historyTaskDLQHandlerImpl) getMessages(
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistencespb.HistoryDLQTaskInfo, []byte, error) {
+
+	resp, err := h.shard.GetExecutionManager().GetHistoryTasksFromDLQ(&persistence.GetHistoryTasksFromDLQRequest{
+		ShardID:       h.shard.GetShardID(),
+		MinTaskID:     persistence.EmptyQueueMessageID,
+		MaxTaskID:     lastMessageID,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Tasks, resp.NextPageToken, nil
+}
+
+func (h *historyTaskDLQHandlerImpl) purgeMessages(
+	lastMessageID int64,
+) error {
+
+	return h.shard.GetExecutionManager().RangeDeleteHistoryTaskFromDLQ(&persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ShardID:              h.shard.GetShardID(),
+		ExclusiveBeginTaskID: persistence.EmptyQueueMessageID,
+		InclusiveEndTaskID:   lastMessageID,
+	})
+}
+
+func (h *historyTaskDLQHandlerImpl) mergeMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	tasks, token, err := h.getMessages(lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return token, nil
+	}
+
+	for _, task := range tasks {
+		if err := h.enqueueTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	// only the tasks of this page are removed, the rest of the range is merged by the next page
+	if err := h.purgeMessages(persistence.GetHistoryDLQTaskID(tasks[len(tasks)-1])); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// enqueueTask writes the task back to the transfer or timer queue of the shard under a new task ID
+func (h *historyTaskDLQHandlerImpl) enqueueTask(
+	ctx context.Context,
+	task *persistencespb.HistoryDLQTaskInfo,
+) error {
+
+	var taskInfo queueTaskInfo
+	var transferTasks, timerTasks []persistence.Task
+	switch info := task.TaskInfo.(type) {
+	case *persistencespb.HistoryDLQTaskInfo_TransferTaskInfo:
+		transferTask, err := transferTaskFromInfo(info.TransferTaskInfo)
+		if err != nil {
+			return err
+		}
+		taskInfo = info.TransferTaskInfo
+		transferTasks = []persistence.Task{transferTask}
+	case *persistencespb.HistoryDLQTaskInfo_TimerTaskInfo:
+		timerTask, err := timerTaskFromInfo(info.TimerTaskInfo)
+		if err != nil {
+			return err
+		}
+		taskInfo = info.TimerTaskInfo
+		timerTasks = []persistence.Task{timerTask}
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown history DLQ task: %v", task))
+	}
+
+	return h.shard.AddTasks(ctx, &persistence.AddTasksRequest{
+		ShardID: h.shard.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID:   taskInfo.GetNamespaceId(),
+		WorkflowID:    taskInfo.GetWorkflowId(),
+		RunID:         taskInfo.GetRunId(),
+		TransferTasks: transferTasks,
+		TimerTasks:    timerTasks,
+	})
+}
+
+// shouldMoveTaskToDLQ returns true if the transfer or timer task failed too many times to be
+// retried any longer. Tasks failing with a transient error are never moved to the DLQ, they
+// succeed once the dependency recovers and are retried with backoff until then
+func shouldMoveTaskToDLQ(
+	config *configs.Config,
+	taskInfo queueTaskInfo,
+	attempt int,
+	err error,
+) bool {
+
+	maxAttempts := config.TaskDLQMaxAttempts()
+	if maxAttempts <= 0 || attempt < maxAttempts {
+		return false
+	}
+	if isTransientTaskError(err) {
+		// including shard ownership lost, the task is processed by the new owner of the shard
+		return false
+	}
+
+	switch taskInfo.(type) {
+	case *persistencespb.TransferTaskInfo, *persistencespb.TimerTaskInfo:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransientTaskError returns true if the task failed because the persistence, the shard or a
+// service the task calls is unavailable or overloaded rather than because of the task itself.
+// Internal errors are not transient, they are returned for corrupted mutable state as well
+func isTransientTaskError(
+	err error,
+) bool {
+
+	switch err.(type) {
+	case *persistence.ShardOwnershipLostError,
+		*persistence.TimeoutError,
+		*serviceerror.NamespaceNotActive,
+		*serviceerror.Unavailable,
+		*serviceerror.ResourceExhausted:
+		return true
+	}
+	return common.IsContextDeadlineExceededErr(err) ||
+		common.IsContextCanceledErr(err)
+}
+
+// moveTaskToDLQ persists the transfer or timer task to the history task DLQ of the shard,
+// the task can be acked once this returns without error
+func moveTaskToDLQ(
+	shard shard.Context,
+	taskInfo queueTaskInfo,
+	attempt int,
+	taskErr error,
+) error {
+
+	dlqTask := &persistencespb.HistoryDLQTaskInfo{
+		Attempt:    int32(attempt),
+		LastError:  taskErr.Error(),
+		CreateTime: timestamp.TimePtr(shard.GetTimeSource().Now()),
+	}
+	switch task := taskInfo.(type) {
+	case *persistencespb.TransferTaskInfo:
+		dlqTask.TaskInfo = &persistencespb.HistoryDLQTaskInfo_TransferTaskInfo{TransferTaskInfo: task}
+	case *persistencespb.TimerTaskInfo:
+		dlqTask.TaskInfo = &persistencespb.HistoryDLQTaskInfo_TimerTaskInfo{TimerTaskInfo: task}
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Task type %v cannot be moved to DLQ", taskInfo.GetTaskType()))
+	}
+
+	return shard.GetExecutionManager().PutHistoryTaskToDLQ(&persistence.PutHistoryTaskToDLQRequest{
+		ShardID:  shard.GetShardID(),
+		TaskInfo: dlqTask,
+	})
+}
+
+func transferTaskFromInfo(
+	info *persistencespb.TransferTaskInfo,
+) (persistence.Task, error) {
+
+	visibilityTime := timestamp.TimeValue(info.GetVisibilityTime())
+	switch info.GetTaskType() {
+	case enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK:
+		return &persistence.ActivityTask{
+			VisibilityTimestamp: visibilityTime,
+			NamespaceID:         info.GetTargetNamespaceId(),
+			TaskQueue:           info.GetTaskQueue(),
+			ScheduleID:          info.GetScheduleId(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK:
+		return &persistence.WorkflowTask{
+			VisibilityTimestamp: visibilityTime,
+			NamespaceID:         info.GetTargetNamespaceId(),
+			TaskQueue:           info.GetTaskQueue(),
+			ScheduleID:          info.GetScheduleId(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_CANCEL_EXECUTION:
+		return &persistence.CancelExecutionTask{
+			VisibilityTimestamp:     visibilityTime,
+			TargetNamespaceID:       info.GetTargetNamespaceId(),
+			TargetWorkflowID:        info.GetTargetWorkflowId(),
+			TargetRunID:             info.GetTargetRunId(),
+			TargetChildWorkflowOnly: info.GetTargetChildWorkflowOnly(),
+			InitiatedID:             info.GetScheduleId(),
+			Version:                 info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_SIGNAL_EXECUTION:
+		return &persistence.SignalExecutionTask{
+			VisibilityTimestamp:     visibilityTime,
+			TargetNamespaceID:       info.GetTargetNamespaceId(),
+			TargetWorkflowID:        info.GetTargetWorkflowId(),
+			TargetRunID:             info.GetTargetRunId(),
+			TargetChildWorkflowOnly: info.GetTargetChildWorkflowOnly(),
+			InitiatedID:             info.GetScheduleId(),
+			Version:                 info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_START_CHILD_EXECUTION:
+		return &persistence.StartChildExecutionTask{
+			VisibilityTimestamp: visibilityTime,
+			TargetNamespaceID:   info.GetTargetNamespaceId(),
+			TargetWorkflowID:    info.GetTargetWorkflowId(),
+			InitiatedID:         info.GetScheduleId(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION:
+		return &persistence.CloseExecutionTask{
+			VisibilityTimestamp: visibilityTime,
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_TRANSFER_RESET_WORKFLOW:
+		return &persistence.ResetWorkflowTask{
+			VisibilityTimestamp: visibilityTime,
+			Version:             info.GetVersion(),
+		}, nil
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", info.GetTaskType()))
+	}
+}
+
+func timerTaskFromInfo(
+	info *persistencespb.TimerTaskInfo,
+) (persistence.Task, error) {
+
+	visibilityTime := timestamp.TimeValue(info.GetVisibilityTime())
+	switch info.GetTaskType() {
+	case enumsspb.TASK_TYPE_WORKFLOW_TASK_TIMEOUT:
+		return &persistence.WorkflowTaskTimeoutTask{
+			VisibilityTimestamp: visibilityTime,
+			EventID:             info.GetEventId(),
+			ScheduleAttempt:     info.GetScheduleAttempt(),
+			TimeoutType:         info.GetTimeoutType(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT:
+		return &persistence.ActivityTimeoutTask{
+			VisibilityTimestamp: visibilityTime,
+			TimeoutType:         info.GetTimeoutType(),
+			EventID:             info.GetEventId(),
+			Attempt:             info.GetScheduleAttempt(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_USER_TIMER:
+		return &persistence.UserTimerTask{
+			VisibilityTimestamp: visibilityTime,
+			EventID:             info.GetEventId(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER:
+		return &persistence.ActivityRetryTimerTask{
+			VisibilityTimestamp: visibilityTime,
+			EventID:             info.GetEventId(),
+			Attempt:             info.GetScheduleAttempt(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
+		return &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: visibilityTime,
+			WorkflowBackoffType: info.GetWorkflowBackoffType(),
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_WORKFLOW_RUN_TIMEOUT:
+		return &persistence.WorkflowTimeoutTask{
+			VisibilityTimestamp: visibilityTime,
+			Version:             info.GetVersion(),
+		}, nil
+	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
+		return &persistence.DeleteHistoryEventTask{
+			VisibilityTimestamp: visibilityTime,
+			Version:             info.GetVersion(),
+		}, nil
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", info.GetTaskType()))
+	}
+}
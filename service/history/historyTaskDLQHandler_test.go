@@ -0,0 +1,264 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+type (
+	historyTaskDLQHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		controller *gomock.Controller
+
+		mockShard          *shard.ContextTest
+		mockEngine         *shard.MockEngine
+		mockNamespaceCache *cache.MockNamespaceCache
+		executionManager   *persistence.MockExecutionManager
+		config             *configs.Config
+
+		dlqHandler *historyTaskDLQHandlerImpl
+	}
+)
+
+func TestHistoryTaskDLQHandlerSuite(t *testing.T) {
+	s := new(historyTaskDLQHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyTaskDLQHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.config = tests.NewDynamicConfig()
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfoWithFailover{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId: 0,
+				RangeId: 1,
+			}},
+		s.config,
+	)
+	s.mockEngine = shard.NewMockEngine(s.controller)
+	s.mockShard.SetEngine(s.mockEngine)
+	s.mockNamespaceCache = s.mockShard.Resource.NamespaceCache
+	s.executionManager = s.mockShard.Resource.ExecutionMgr
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.dlqHandler = newHistoryTaskDLQHandler(s.mockShard).(*historyTaskDLQHandlerImpl)
+}
+
+func (s *historyTaskDLQHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historyTaskDLQHandlerSuite) TestReadMessages() {
+	lastMessageID := int64(1394)
+	pageSize := 1
+	pageToken := []byte("some random token")
+
+	dlqTasks := []*persistencespb.HistoryDLQTaskInfo{s.newTransferDLQTask(12345)}
+	s.executionManager.EXPECT().GetHistoryTasksFromDLQ(&persistence.GetHistoryTasksFromDLQRequest{
+		ShardID:       s.mockShard.GetShardID(),
+		MinTaskID:     persistence.EmptyQueueMessageID,
+		MaxTaskID:     lastMessageID,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	}).Return(&persistence.GetHistoryTasksFromDLQResponse{
+		Tasks:         dlqTasks,
+		NextPageToken: pageToken,
+	}, nil)
+
+	tasks, token, err := s.dlqHandler.getMessages(lastMessageID, pageSize, pageToken)
+	s.NoError(err)
+	s.Equal(pageToken, token)
+	s.Equal(dlqTasks, tasks)
+}
+
+func (s *historyTaskDLQHandlerSuite) TestPurgeMessages() {
+	lastMessageID := int64(1)
+
+	s.executionManager.EXPECT().RangeDeleteHistoryTaskFromDLQ(&persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ShardID:              s.mockShard.GetShardID(),
+		ExclusiveBeginTaskID: persistence.EmptyQueueMessageID,
+		InclusiveEndTaskID:   lastMessageID,
+	}).Return(nil)
+
+	err := s.dlqHandler.purgeMessages(lastMessageID)
+	s.NoError(err)
+}
+
+func (s *historyTaskDLQHandlerSuite) TestMergeMessages() {
+	taskID := int64(12345)
+	lastMessageID := int64(1394)
+	pageSize := 1
+
+	s.executionManager.EXPECT().GetHistoryTasksFromDLQ(&persistence.GetHistoryTasksFromDLQRequest{
+		ShardID:   s.mockShard.GetShardID(),
+		MinTaskID: persistence.EmptyQueueMessageID,
+		MaxTaskID: lastMessageID,
+		BatchSize: pageSize,
+	}).Return(&persistence.GetHistoryTasksFromDLQResponse{
+		Tasks: []*persistencespb.HistoryDLQTaskInfo{s.newTransferDLQTask(taskID)},
+	}, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil)
+	s.executionManager.EXPECT().AddTasks(gomock.Any()).DoAndReturn(func(request *persistence.AddTasksRequest) error {
+		s.Equal(tests.NamespaceID, request.NamespaceID)
+		s.Equal(tests.WorkflowID, request.WorkflowID)
+		s.Equal(tests.RunID, request.RunID)
+		s.Len(request.TransferTasks, 1)
+		activityTask, ok := request.TransferTasks[0].(*persistence.ActivityTask)
+		s.True(ok)
+		s.Equal("some random task queue", activityTask.TaskQueue)
+		s.Equal(int64(123), activityTask.ScheduleID)
+		s.NotEqual(taskID, activityTask.TaskID)
+		return nil
+	})
+	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewVisibilityTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any())
+	s.executionManager.EXPECT().RangeDeleteHistoryTaskFromDLQ(&persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ShardID:              s.mockShard.GetShardID(),
+		ExclusiveBeginTaskID: persistence.EmptyQueueMessageID,
+		InclusiveEndTaskID:   taskID,
+	}).Return(nil)
+
+	token, err := s.dlqHandler.mergeMessages(context.Background(), lastMessageID, pageSize, nil)
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *historyTaskDLQHandlerSuite) TestShouldMoveTaskToDLQ() {
+	transferTask := s.newTransferDLQTask(12345).GetTransferTaskInfo()
+	// any other queue task, e.g. a replication task, is never moved to the DLQ
+	otherTask := NewMockqueueTaskInfo(s.controller)
+	taskErr := errors.New("some random error")
+
+	s.False(shouldMoveTaskToDLQ(s.config, transferTask, 100, taskErr))
+
+	s.config.TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(10)
+	s.False(shouldMoveTaskToDLQ(s.config, transferTask, 9, taskErr))
+	s.True(shouldMoveTaskToDLQ(s.config, transferTask, 10, taskErr))
+	s.True(shouldMoveTaskToDLQ(s.config, transferTask, 10, serviceerror.NewInvalidArgument("some random error")))
+	s.False(shouldMoveTaskToDLQ(s.config, otherTask, 10, taskErr))
+}
+
+func (s *historyTaskDLQHandlerSuite) TestShouldMoveTaskToDLQ_TransientError() {
+	transferTask := s.newTransferDLQTask(12345).GetTransferTaskInfo()
+	s.config.TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(10)
+
+	for _, taskErr := range []error{
+		serviceerror.NewResourceExhausted("some random error"),
+		serviceerror.NewUnavailable("some random error"),
+		serviceerror.NewDeadlineExceeded("some random error"),
+		serviceerror.NewNamespaceNotActive("some random namespace", "active", "standby"),
+		&persistence.TimeoutError{Msg: "some random error"},
+		context.DeadlineExceeded,
+	} {
+		// tasks failing with a transient error are retried no matter how many attempts they took
+		s.False(shouldMoveTaskToDLQ(s.config, transferTask, 100, taskErr), taskErr.Error())
+		s.False(shouldMoveTaskToDLQ(s.config, transferTask, 100000, taskErr), taskErr.Error())
+	}
+
+	// the shard is owned by another host which processes the task
+	shardOwnershipLost := &persistence.ShardOwnershipLostError{ShardID: 1, Msg: "some random error"}
+	s.False(shouldMoveTaskToDLQ(s.config, transferTask, 1000, shardOwnershipLost))
+}
+
+func (s *historyTaskDLQHandlerSuite) TestShouldMoveTaskToDLQ_CorruptedMutableState() {
+	transferTask := s.newTransferDLQTask(12345).GetTransferTaskInfo()
+	s.config.TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(10)
+
+	// corrupted mutable state is reported with internal errors, retrying the task never succeeds
+	for _, taskErr := range []error{
+		serviceerror.NewInternal("some random error"),
+		workflow.ErrMissingActivityInfo,
+		workflow.ErrMissingWorkflowStartEvent,
+	} {
+		s.False(shouldMoveTaskToDLQ(s.config, transferTask, 9, taskErr), taskErr.Error())
+		s.True(shouldMoveTaskToDLQ(s.config, transferTask, 10, taskErr), taskErr.Error())
+	}
+}
+
+func (s *historyTaskDLQHandlerSuite) TestMoveTaskToDLQ() {
+	transferTask := s.newTransferDLQTask(12345).GetTransferTaskInfo()
+	taskErr := errors.New("some random error")
+
+	s.executionManager.EXPECT().PutHistoryTaskToDLQ(gomock.Any()).DoAndReturn(func(request *persistence.PutHistoryTaskToDLQRequest) error {
+		s.Equal(s.mockShard.GetShardID(), request.ShardID)
+		s.Equal(transferTask, request.TaskInfo.GetTransferTaskInfo())
+		s.Equal(int32(10), request.TaskInfo.GetAttempt())
+		s.Equal(taskErr.Error(), request.TaskInfo.GetLastError())
+		return nil
+	})
+
+	err := moveTaskToDLQ(s.mockShard, transferTask, 10, taskErr)
+	s.NoError(err)
+}
+
+func (s *historyTaskDLQHandlerSuite) newTransferDLQTask(
+	taskID int64,
+) *persistencespb.HistoryDLQTaskInfo {
+	return &persistencespb.HistoryDLQTaskInfo{
+		TaskInfo: &persistencespb.HistoryDLQTaskInfo_TransferTaskInfo{
+			TransferTaskInfo: &persistencespb.TransferTaskInfo{
+				NamespaceId:       tests.NamespaceID,
+				WorkflowId:        tests.WorkflowID,
+				RunId:             tests.RunID,
+				TaskType:          enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+				TargetNamespaceId: tests.NamespaceID,
+				TaskQueue:         "some random task queue",
+				ScheduleId:        123,
+				TaskId:            taskID,
+				VisibilityTime:    timestamp.TimePtr(time.Now().UTC()),
+			},
+		},
+		Attempt:   10,
+		LastError: "some random error",
+	}
+}
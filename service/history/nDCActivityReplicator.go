@@ -112,7 +112,7 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); !ok {
 			return err
@@ -189,6 +189,7 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 	}
 
 	return context.UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		updateMode,
 		nil, // no new workflow
@@ -666,7 +666,9 @@ func (s *activityReplicatorSuite) TestSyncActivity_WorkflowClosed() {
 
 	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, runID)
 	weContext := workflow.NewMockContext(s.controller)
-	weContext.EXPECT().LoadWorkflowExecution().Return(s.mockMutableState, nil)
+	weContext.EXPECT().GetApproximateSize().Return(int64(0)).AnyTimes()
+	weContext.EXPECT().UpdateApproximateSize().AnyTimes()
+	weContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
 	weContext.EXPECT().Lock(gomock.Any(), workflow.CallerTypeAPI).Return(nil)
 	weContext.EXPECT().Unlock(workflow.CallerTypeAPI)
 	_, err := s.historyCache.PutIfNotExist(key, weContext)
@@ -741,7 +743,9 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityNotFound() {
 
 	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, runID)
 	weContext := workflow.NewMockContext(s.controller)
-	weContext.EXPECT().LoadWorkflowExecution().Return(s.mockMutableState, nil)
+	weContext.EXPECT().GetApproximateSize().Return(int64(0)).AnyTimes()
+	weContext.EXPECT().UpdateApproximateSize().AnyTimes()
+	weContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
 	weContext.EXPECT().Lock(gomock.Any(), workflow.CallerTypeAPI).Return(nil)
 	weContext.EXPECT().Unlock(workflow.CallerTypeAPI)
 	_, err := s.historyCache.PutIfNotExist(key, weContext)
@@ -817,7 +821,9 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityFound_Zombie() {
 
 	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, runID)
 	weContext := workflow.NewMockContext(s.controller)
-	weContext.EXPECT().LoadWorkflowExecution().Return(s.mockMutableState, nil)
+	weContext.EXPECT().GetApproximateSize().Return(int64(0)).AnyTimes()
+	weContext.EXPECT().UpdateApproximateSize().AnyTimes()
+	weContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
 	weContext.EXPECT().Lock(gomock.Any(), workflow.CallerTypeAPI).Return(nil)
 	weContext.EXPECT().Unlock(workflow.CallerTypeAPI)
 	_, err := s.historyCache.PutIfNotExist(key, weContext)
@@ -847,6 +853,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityFound_Zombie() {
 	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(version, version).Return(true)
 
 	weContext.EXPECT().UpdateWorkflowExecutionWithNew(
+		gomock.Any(),
 		gomock.Any(),
 		persistence.UpdateWorkflowModeBypassCurrent,
 		workflow.Context(nil),
@@ -908,7 +915,9 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityFound_NonZombie() {
 
 	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, runID)
 	weContext := workflow.NewMockContext(s.controller)
-	weContext.EXPECT().LoadWorkflowExecution().Return(s.mockMutableState, nil)
+	weContext.EXPECT().GetApproximateSize().Return(int64(0)).AnyTimes()
+	weContext.EXPECT().UpdateApproximateSize().AnyTimes()
+	weContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
 	weContext.EXPECT().Lock(gomock.Any(), workflow.CallerTypeAPI).Return(nil)
 	weContext.EXPECT().Unlock(workflow.CallerTypeAPI)
 	_, err := s.historyCache.PutIfNotExist(key, weContext)
@@ -938,6 +947,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityFound_NonZombie() {
 	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(version, version).Return(true)
 
 	weContext.EXPECT().UpdateWorkflowExecutionWithNew(
+		gomock.Any(),
 		gomock.Any(),
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		workflow.Context(nil),
@@ -185,6 +185,7 @@ func (r *nDCBranchMgrImpl) flushBufferedEvents(
 	}
 	// the workflow must be updated as active, to send out replication tasks
 	if err := targetWorkflow.context.UpdateWorkflowExecutionAsActive(
+		ctx,
 		r.shard.GetTimeSource().Now(),
 	); err != nil {
 		return nil, 0, err
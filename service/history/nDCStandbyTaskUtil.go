@@ -120,6 +120,7 @@ type (
 
 	pushActivityTaskToMatchingInfo struct {
 		activityTaskScheduleToStartTimeout time.Duration
+		priority                           int32
 	}
 
 	pushWorkflowTaskToMatchingInfo struct {
@@ -140,10 +141,12 @@ func newHistoryResendInfo(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout time.Duration,
+	priority int32,
 ) *pushActivityTaskToMatchingInfo {
 
 	return &pushActivityTaskToMatchingInfo{
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                           priority,
 	}
 }
 
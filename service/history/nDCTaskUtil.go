@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,9 +33,11 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
@@ -78,13 +81,14 @@ func verifyTaskVersion(
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTransferTask(
+	ctx context.Context,
 	context workflow.Context,
 	transferTask *persistencespb.TransferTaskInfo,
 	metricsClient metrics.Client,
 	logger log.Logger,
 ) (workflow.MutableState, error) {
 
-	msBuilder, err := context.LoadWorkflowExecution()
+	msBuilder, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
@@ -105,7 +109,7 @@ func loadMutableStateForTransferTask(
 		metricsClient.IncCounter(metrics.TransferQueueProcessorScope, metrics.StaleMutableStateCounter)
 		context.Clear()
 
-		msBuilder, err = context.LoadWorkflowExecution()
+		msBuilder, err = context.LoadWorkflowExecution(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -123,13 +127,14 @@ func loadMutableStateForTransferTask(
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTimerTask(
+	ctx context.Context,
 	context workflow.Context,
 	timerTask *persistencespb.TimerTaskInfo,
 	metricsClient metrics.Client,
 	logger log.Logger,
 ) (workflow.MutableState, error) {
 
-	msBuilder, err := context.LoadWorkflowExecution()
+	msBuilder, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
@@ -150,7 +155,7 @@ func loadMutableStateForTimerTask(
 		metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
 		context.Clear()
 
-		msBuilder, err = context.LoadWorkflowExecution()
+		msBuilder, err = context.LoadWorkflowExecution(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -165,6 +170,30 @@ func loadMutableStateForTimerTask(
 	return msBuilder, nil
 }
 
+// getActivityTaskPriority returns the dispatch priority of an activity task set through the
+// activity header, or 0 (the task queue default) if the activity does not specify a valid one
+func getActivityTaskPriority(
+	mutableState workflow.MutableState,
+	scheduleID int64,
+) (int32, error) {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(scheduleID)
+	if err != nil {
+		return 0, err
+	}
+	header := scheduledEvent.GetActivityTaskScheduledEventAttributes().GetHeader()
+	priorityPayload, ok := header.GetFields()[common.TaskPriorityHeaderName]
+	if !ok {
+		return 0, nil
+	}
+
+	var priority int32
+	if err := payload.Decode(priorityPayload, &priority); err != nil || priority < 0 {
+		return 0, nil
+	}
+	return priority, nil
+}
+
 func initializeLoggerForTask(
 	shardID int32,
 	task queueTaskInfo,
@@ -250,6 +250,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflow(
 	}()
 
 	if _, err := targetWorkflow.getContext().PersistWorkflowEvents(
+		ctx,
 		targetWorkflowEvents,
 	); err != nil {
 		return err
@@ -265,6 +266,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflow(
 	}
 
 	return targetWorkflow.getContext().UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		updateMode,
 		nil,
@@ -456,7 +458,7 @@ func (r *nDCTransactionMgrImpl) loadNDCWorkflow(
 		return nil, err
 	}
 
-	msBuilder, err := weContext.LoadWorkflowExecution()
+	msBuilder, err := weContext.LoadWorkflowExecution(ctx)
 	if err != nil {
 		// no matter what error happen, we need to retry
 		release(err)
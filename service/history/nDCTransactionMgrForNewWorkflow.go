@@ -167,6 +167,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) createAsCurrent(
 	}
 
 	targetWorkflowHistorySize, err := r.persistNewNDCWorkflowEvents(
+		ctx,
 		targetWorkflow,
 		targetWorkflowEventsSeq[0],
 	)
@@ -184,6 +185,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) createAsCurrent(
 			return err
 		}
 		return targetWorkflow.getContext().CreateWorkflowExecution(
+			ctx,
 			now,
 			createMode,
 			prevRunID,
@@ -199,6 +201,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) createAsCurrent(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	return targetWorkflow.getContext().CreateWorkflowExecution(
+		ctx,
 		now,
 		createMode,
 		prevRunID,
@@ -243,6 +246,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) createAsZombie(
 	}
 
 	targetWorkflowHistorySize, err := r.persistNewNDCWorkflowEvents(
+		ctx,
 		targetWorkflow,
 		targetWorkflowEventsSeq[0],
 	)
@@ -260,6 +264,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) createAsZombie(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = targetWorkflow.getContext().CreateWorkflowExecution(
+		ctx,
 		now,
 		createMode,
 		prevRunID,
@@ -297,6 +302,7 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) suppressCurrentAndCreateAsCurrent(
 	}
 
 	return currentWorkflow.getContext().UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		targetWorkflow.getContext(),
@@ -368,8 +374,9 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) cleanupTransaction(
 }
 
 func (r *nDCTransactionMgrForNewWorkflowImpl) persistNewNDCWorkflowEvents(
+	ctx context.Context,
 	targetNewWorkflow nDCWorkflow,
 	targetNewWorkflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
-	return targetNewWorkflow.getContext().PersistWorkflowEvents(targetNewWorkflowEvents)
+	return targetNewWorkflow.getContext().PersistWorkflowEvents(ctx, targetNewWorkflowEvents)
 }
@@ -137,9 +137,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_BrandN
 	).Return("", nil)
 
 	weContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		workflowEventsSeq[0],
 	).Return(workflowHistorySize, nil)
 	weContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeBrandNew,
 		"",
@@ -195,9 +197,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_BrandN
 	).Return("", nil)
 
 	weContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		workflowEventsSeq[0],
 	).Return(workflowHistorySize, nil)
 	weContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeBrandNew,
 		"",
@@ -272,9 +276,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	currentWorkflow.EXPECT().getVectorClock().Return(currentLastWriteVersion, int64(0), nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeWorkflowIDReuse,
 		currentRunID,
@@ -350,9 +356,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	currentWorkflow.EXPECT().getVectorClock().Return(currentLastWriteVersion, int64(0), nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeWorkflowIDReuse,
 		currentRunID,
@@ -422,9 +430,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	targetWorkflow.EXPECT().suppressBy(currentWorkflow).Return(workflow.TransactionPolicyPassive, nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeZombie,
 		"",
@@ -495,9 +505,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	targetWorkflow.EXPECT().suppressBy(currentWorkflow).Return(workflow.TransactionPolicyPassive, nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeZombie,
 		"",
@@ -568,9 +580,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	targetWorkflow.EXPECT().suppressBy(currentWorkflow).Return(workflow.TransactionPolicyPassive, nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeZombie,
 		"",
@@ -641,9 +655,11 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Create
 	targetWorkflow.EXPECT().suppressBy(currentWorkflow).Return(workflow.TransactionPolicyPassive, nil)
 
 	targetContext.EXPECT().PersistWorkflowEvents(
+		gomock.Any(),
 		targetWorkflowEventsSeq[0],
 	).Return(targetWorkflowHistorySize, nil)
 	targetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		now,
 		persistence.CreateWorkflowModeZombie,
 		"",
@@ -706,6 +722,7 @@ func (s *nDCTransactionMgrForNewWorkflowSuite) TestDispatchForNewWorkflow_Suppre
 	targetWorkflow.EXPECT().revive().Return(nil)
 
 	currentContext.EXPECT().UpdateWorkflowExecutionWithNew(
+		gomock.Any(),
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		targetContext,
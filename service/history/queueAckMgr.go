@@ -27,12 +27,17 @@ package history
 import (
 	"sort"
 	"sync"
+	"time"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
 )
@@ -52,11 +57,20 @@ type (
 		finishedChan  chan struct{}
 
 		sync.RWMutex
-		outstandingTasks map[int64]bool
+		outstandingTasks map[int64]*outstandingTask
 		readLevel        int64
 		ackLevel         int64
 		isReadFinished   bool
 	}
+
+	// outstandingTask is the in-memory state of a task loaded by an ack manager,
+	// it is kept until the ack level moves past the task.
+	outstandingTask struct {
+		info      queueTaskInfo
+		acked     bool
+		attempt   int
+		lastError error
+	}
 )
 
 const (
@@ -70,7 +84,7 @@ func newQueueAckMgr(shard shard.Context, options *QueueProcessorOptions, process
 		shard:            shard,
 		options:          options,
 		processor:        processor,
-		outstandingTasks: make(map[int64]bool),
+		outstandingTasks: make(map[int64]*outstandingTask),
 		readLevel:        ackLevel,
 		ackLevel:         ackLevel,
 		logger:           logger,
@@ -86,7 +100,7 @@ func newQueueFailoverAckMgr(shard shard.Context, options *QueueProcessorOptions,
 		shard:            shard,
 		options:          options,
 		processor:        processor,
-		outstandingTasks: make(map[int64]bool),
+		outstandingTasks: make(map[int64]*outstandingTask),
 		readLevel:        ackLevel,
 		ackLevel:         ackLevel,
 		logger:           logger,
@@ -135,7 +149,7 @@ TaskFilterLoop:
 		}
 		a.logger.Debug("Moving read level", tag.TaskID(task.GetTaskId()))
 		a.readLevel = task.GetTaskId()
-		a.outstandingTasks[task.GetTaskId()] = false
+		a.outstandingTasks[task.GetTaskId()] = newOutstandingTask(task)
 	}
 
 	return tasks, morePage, nil
@@ -143,8 +157,17 @@ TaskFilterLoop:
 
 func (a *queueAckMgrImpl) completeQueueTask(taskID int64) {
 	a.Lock()
-	if _, ok := a.outstandingTasks[taskID]; ok {
-		a.outstandingTasks[taskID] = true
+	if task, ok := a.outstandingTasks[taskID]; ok {
+		task.acked = true
+	}
+	a.Unlock()
+}
+
+func (a *queueAckMgrImpl) recordQueueTaskError(taskID int64, attempt int, err error) {
+	a.Lock()
+	if task, ok := a.outstandingTasks[taskID]; ok {
+		task.attempt = attempt
+		task.lastError = err
 	}
 	a.Unlock()
 }
@@ -161,6 +184,23 @@ func (a *queueAckMgrImpl) getQueueReadLevel() int64 {
 	return a.readLevel
 }
 
+func (a *queueAckMgrImpl) getQueueState() *historyspb.QueueState {
+	a.RLock()
+	defer a.RUnlock()
+
+	var taskIDs []int64
+	for k := range a.outstandingTasks {
+		taskIDs = append(taskIDs, k)
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+
+	tasks := make([]*outstandingTask, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		tasks = append(tasks, a.outstandingTasks[taskID])
+	}
+	return newQueueState(a.ackLevel, a.readLevel, tasks, a.shard.GetTimeSource().Now())
+}
+
 func (a *queueAckMgrImpl) getFinishedChan() <-chan struct{} {
 	return a.finishedChan
 }
@@ -194,8 +234,7 @@ func (a *queueAckMgrImpl) updateQueueAckLevel() error {
 
 MoveAckLevelLoop:
 	for _, current := range taskIDs {
-		acked := a.outstandingTasks[current]
-		if acked {
+		if a.outstandingTasks[current].acked {
 			ackLevel = current
 			delete(a.outstandingTasks, current)
 			a.logger.Debug("Moving timer ack level to", tag.AckLevel(ackLevel))
@@ -226,3 +265,106 @@ MoveAckLevelLoop:
 	}
 	return nil
 }
+
+func newOutstandingTask(info queueTaskInfo) *outstandingTask {
+	return &outstandingTask{
+		info:    info,
+		attempt: 1,
+	}
+}
+
+func (t *outstandingTask) toProto() *historyspb.QueueTaskState {
+	state := &historyspb.QueueTaskState{
+		TaskId:         t.info.GetTaskId(),
+		TaskType:       t.info.GetTaskType(),
+		NamespaceId:    t.info.GetNamespaceId(),
+		WorkflowId:     t.info.GetWorkflowId(),
+		RunId:          t.info.GetRunId(),
+		VisibilityTime: t.info.GetVisibilityTime(),
+		Acked:          t.acked,
+		Attempt:        int32(t.attempt),
+	}
+	if t.lastError != nil {
+		state.LastError = t.lastError.Error()
+	}
+	return state
+}
+
+// newQueueState converts the outstanding tasks of an ack manager, sorted by their keys, to a queue state.
+func newQueueState(ackLevel int64, readLevel int64, tasks []*outstandingTask, now time.Time) *historyspb.QueueState {
+	state := &historyspb.QueueState{
+		AckLevel:         ackLevel,
+		ReadLevel:        readLevel,
+		OutstandingTasks: make([]*historyspb.QueueTaskState, 0, len(tasks)),
+	}
+
+	var oldestPendingTime *time.Time
+	for _, task := range tasks {
+		state.OutstandingTasks = append(state.OutstandingTasks, task.toProto())
+		if task.acked {
+			continue
+		}
+		visibilityTime := timestamp.TimeValue(task.info.GetVisibilityTime())
+		if oldestPendingTime == nil || visibilityTime.Before(*oldestPendingTime) {
+			oldestPendingTime = &visibilityTime
+		}
+	}
+	if oldestPendingTime != nil {
+		state.OldestPendingTaskAge = timestamp.DurationPtr(now.Sub(*oldestPendingTime))
+	}
+	return state
+}
+
+// pageQueueStates returns the page of queue states which continues the previous page and contains at most
+// pageSize outstanding tasks, and the continuation of the next page if any queue or task is left.
+// Queues must be in the same order on every page and their outstanding tasks sorted by their keys,
+// so a page can continue after the last task of the previous page even if tasks were acked in between.
+func pageQueueStates(
+	queues []*historyspb.QueueState,
+	pageSize int,
+	continuation *tokenspb.ShardQueuesContinuation,
+) ([]*historyspb.QueueState, *tokenspb.ShardQueuesContinuation) {
+	start := int(continuation.GetQueueIndex())
+	var page []*historyspb.QueueState
+	for i := start; i < len(queues); i++ {
+		if pageSize == 0 {
+			return page, &tokenspb.ShardQueuesContinuation{QueueIndex: int32(i)}
+		}
+
+		queue := queues[i]
+		tasks := queue.GetOutstandingTasks()
+		if i == start && continuation != nil {
+			tasks = outstandingTasksAfter(queue.GetCategory(), tasks, continuation)
+		}
+		if len(tasks) > pageSize {
+			lastTask := tasks[pageSize-1]
+			queue.OutstandingTasks = tasks[:pageSize]
+			return append(page, queue), &tokenspb.ShardQueuesContinuation{
+				QueueIndex:     int32(i),
+				TaskId:         lastTask.GetTaskId(),
+				VisibilityTime: lastTask.GetVisibilityTime(),
+			}
+		}
+		queue.OutstandingTasks = tasks
+		pageSize -= len(tasks)
+		page = append(page, queue)
+	}
+	return page, nil
+}
+
+// outstandingTasksAfter returns the outstanding tasks which are sorted after the task the continuation points at.
+// Timer tasks are sorted by visibility time and task id, other tasks by task id.
+func outstandingTasksAfter(
+	category enumsspb.TaskCategory,
+	tasks []*historyspb.QueueTaskState,
+	continuation *tokenspb.ShardQueuesContinuation,
+) []*historyspb.QueueTaskState {
+	continuationKey := timerKeyFromTimePtr(continuation.GetVisibilityTime(), continuation.GetTaskId())
+	index := sort.Search(len(tasks), func(i int) bool {
+		if category == enumsspb.TASK_CATEGORY_TIMER {
+			return compareTimerIDLess(continuationKey, timerKeyFromTimePtr(tasks[i].GetVisibilityTime(), tasks[i].GetTaskId()))
+		}
+		return tasks[i].GetTaskId() > continuation.GetTaskId()
+	})
+	return tasks[index:]
+}
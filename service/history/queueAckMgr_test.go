@@ -25,9 +25,12 @@
 package history
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/shard"
@@ -150,7 +153,7 @@ func (s *queueAckMgrSuite) TestReadTimerTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false}, s.queueAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false}, queueTaskAcks(s.queueAckMgr.outstandingTasks))
 
 	moreInput = true
 	taskID2 := int64(60)
@@ -172,7 +175,7 @@ func (s *queueAckMgrSuite) TestReadTimerTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, s.queueAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, queueTaskAcks(s.queueAckMgr.outstandingTasks))
 }
 
 func (s *queueAckMgrSuite) TestReadCompleteTimerTasks() {
@@ -200,10 +203,74 @@ func (s *queueAckMgrSuite) TestReadCompleteTimerTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID: false}, s.queueAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID: false}, queueTaskAcks(s.queueAckMgr.outstandingTasks))
 
 	s.queueAckMgr.completeQueueTask(taskID)
-	s.Equal(map[int64]bool{taskID: true}, s.queueAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID: true}, queueTaskAcks(s.queueAckMgr.outstandingTasks))
+}
+
+func (s *queueAckMgrSuite) TestReadRecordErrorGetQueueState() {
+	readLevel := s.queueAckMgr.readLevel
+	taskID1 := int64(59)
+	taskID2 := int64(60)
+	task1 := &persistencespb.TransferTaskInfo{
+		NamespaceId:    TestNamespaceId,
+		WorkflowId:     "some random workflow ID",
+		RunId:          uuid.New(),
+		TaskId:         taskID1,
+		TaskQueue:      "some random taskqueue",
+		TaskType:       enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+		ScheduleId:     28,
+		VisibilityTime: timestamp.TimeNowPtrUtcAddSeconds(-10),
+	}
+	task2 := &persistencespb.TransferTaskInfo{
+		NamespaceId:    TestNamespaceId,
+		WorkflowId:     "some random workflow ID",
+		RunId:          uuid.New(),
+		TaskId:         taskID2,
+		TaskQueue:      "some random taskqueue",
+		TaskType:       enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+		ScheduleId:     29,
+		VisibilityTime: timestamp.TimeNowPtrUtcAddSeconds(-5),
+	}
+	s.mockProcessor.EXPECT().readTasks(readLevel).Return([]queueTaskInfo{task1, task2}, false, nil)
+	_, _, err := s.queueAckMgr.readQueueTasks()
+	s.Nil(err)
+
+	taskErr := errors.New("some random error")
+	s.queueAckMgr.completeQueueTask(taskID1)
+	s.queueAckMgr.recordQueueTaskError(taskID2, 2, taskErr)
+
+	state := s.queueAckMgr.getQueueState()
+	s.Equal(readLevel, state.GetAckLevel())
+	s.Equal(taskID2, state.GetReadLevel())
+	s.Equal([]*historyspb.QueueTaskState{
+		{
+			TaskId:         taskID1,
+			TaskType:       enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			NamespaceId:    TestNamespaceId,
+			WorkflowId:     task1.GetWorkflowId(),
+			RunId:          task1.GetRunId(),
+			VisibilityTime: task1.VisibilityTime,
+			Acked:          true,
+			Attempt:        1,
+		},
+		{
+			TaskId:         taskID2,
+			TaskType:       enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			NamespaceId:    TestNamespaceId,
+			WorkflowId:     task2.GetWorkflowId(),
+			RunId:          task2.GetRunId(),
+			VisibilityTime: task2.VisibilityTime,
+			Acked:          false,
+			Attempt:        2,
+			LastError:      taskErr.Error(),
+		},
+	}, state.GetOutstandingTasks())
+	// the acked task does not count as pending
+	oldestPendingTaskAge := timestamp.DurationValue(state.GetOldestPendingTaskAge())
+	s.True(oldestPendingTaskAge >= 5*time.Second)
+	s.True(oldestPendingTaskAge < 10*time.Second)
 }
 
 func (s *queueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
@@ -251,7 +318,7 @@ func (s *queueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false, taskID2: false, taskID3: false}, s.queueAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false, taskID2: false, taskID3: false}, queueTaskAcks(s.queueAckMgr.outstandingTasks))
 
 	s.mockProcessor.EXPECT().updateAckLevel(taskID1).Return(nil)
 	s.queueAckMgr.completeQueueTask(taskID1)
@@ -269,6 +336,64 @@ func (s *queueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.Equal(taskID3, s.queueAckMgr.getQueueAckLevel())
 }
 
+func (s *queueAckMgrSuite) TestPageQueueStates() {
+	now := time.Now().UTC()
+	// queue states are built from the ack managers on every page, tasks can be acked in between
+	newQueues := func(transferTaskIDs ...int64) []*historyspb.QueueState {
+		transferQueue := &historyspb.QueueState{Category: enumsspb.TASK_CATEGORY_TRANSFER}
+		for _, taskID := range transferTaskIDs {
+			transferQueue.OutstandingTasks = append(transferQueue.OutstandingTasks, &historyspb.QueueTaskState{TaskId: taskID})
+		}
+		timerQueue := &historyspb.QueueState{
+			Category: enumsspb.TASK_CATEGORY_TIMER,
+			OutstandingTasks: []*historyspb.QueueTaskState{
+				{TaskId: 7, VisibilityTime: timestamp.TimePtr(now)},
+				{TaskId: 6, VisibilityTime: timestamp.TimePtr(now.Add(time.Second))},
+			},
+		}
+		visibilityQueue := &historyspb.QueueState{Category: enumsspb.TASK_CATEGORY_VISIBILITY}
+		return []*historyspb.QueueState{transferQueue, timerQueue, visibilityQueue}
+	}
+	taskIDs := func(queue *historyspb.QueueState) []int64 {
+		var result []int64
+		for _, task := range queue.GetOutstandingTasks() {
+			result = append(result, task.GetTaskId())
+		}
+		return result
+	}
+
+	page, continuation := pageQueueStates(newQueues(1, 2, 3), 2, nil)
+	s.Len(page, 1)
+	s.Equal([]int64{1, 2}, taskIDs(page[0]))
+	s.NotNil(continuation)
+
+	// task 2 is acked before the next page is read
+	page, continuation = pageQueueStates(newQueues(1, 3), 2, continuation)
+	s.Len(page, 2)
+	s.Equal([]int64{3}, taskIDs(page[0]))
+	s.Equal(enumsspb.TASK_CATEGORY_TIMER, page[1].GetCategory())
+	s.Equal([]int64{7}, taskIDs(page[1]))
+	s.NotNil(continuation)
+
+	page, continuation = pageQueueStates(newQueues(1, 3), 2, continuation)
+	s.Len(page, 2)
+	s.Equal(enumsspb.TASK_CATEGORY_TIMER, page[0].GetCategory())
+	s.Equal([]int64{6}, taskIDs(page[0]))
+	s.Equal(enumsspb.TASK_CATEGORY_VISIBILITY, page[1].GetCategory())
+	s.Empty(page[1].GetOutstandingTasks())
+	s.Nil(continuation)
+
+	// the next page starts with the queue which didn't fit into the previous page
+	page, continuation = pageQueueStates(newQueues(1, 2), 2, nil)
+	s.Len(page, 1)
+	s.NotNil(continuation)
+	page, continuation = pageQueueStates(newQueues(1, 2), 2, continuation)
+	s.Len(page, 1)
+	s.Equal(enumsspb.TASK_CATEGORY_TIMER, page[0].GetCategory())
+	s.Equal([]int64{7, 6}, taskIDs(page[0]))
+	s.NotNil(continuation)
+}
+
 // Tests for failover ack manager
 func (s *queueFailoverAckMgrSuite) SetupSuite() {
 
@@ -337,7 +462,7 @@ func (s *queueFailoverAckMgrSuite) TestReadQueueTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false}, s.queueFailoverAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false}, queueTaskAcks(s.queueFailoverAckMgr.outstandingTasks))
 	s.False(s.queueFailoverAckMgr.isReadFinished)
 
 	moreInput = false
@@ -360,7 +485,7 @@ func (s *queueFailoverAckMgrSuite) TestReadQueueTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, s.queueFailoverAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, queueTaskAcks(s.queueFailoverAckMgr.outstandingTasks))
 	s.True(s.queueFailoverAckMgr.isReadFinished)
 }
 
@@ -399,10 +524,10 @@ func (s *queueFailoverAckMgrSuite) TestReadCompleteQueueTasks() {
 	s.Nil(err)
 	s.Equal(tasksOutput, tasksInput)
 	s.Equal(moreOutput, moreInput)
-	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, s.queueFailoverAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false, taskID2: false}, queueTaskAcks(s.queueFailoverAckMgr.outstandingTasks))
 
 	s.queueFailoverAckMgr.completeQueueTask(taskID2)
-	s.Equal(map[int64]bool{taskID1: false, taskID2: true}, s.queueFailoverAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: false, taskID2: true}, queueTaskAcks(s.queueFailoverAckMgr.outstandingTasks))
 	s.mockProcessor.EXPECT().updateAckLevel(s.queueFailoverAckMgr.getQueueAckLevel()).Return(nil)
 	s.queueFailoverAckMgr.updateQueueAckLevel()
 	select {
@@ -412,7 +537,7 @@ func (s *queueFailoverAckMgrSuite) TestReadCompleteQueueTasks() {
 	}
 
 	s.queueFailoverAckMgr.completeQueueTask(taskID1)
-	s.Equal(map[int64]bool{taskID1: true, taskID2: true}, s.queueFailoverAckMgr.outstandingTasks)
+	s.Equal(map[int64]bool{taskID1: true, taskID2: true}, queueTaskAcks(s.queueFailoverAckMgr.outstandingTasks))
 	s.mockProcessor.EXPECT().queueShutdown().Return(nil)
 	s.queueFailoverAckMgr.updateQueueAckLevel()
 	select {
@@ -421,3 +546,11 @@ func (s *queueFailoverAckMgrSuite) TestReadCompleteQueueTasks() {
 		s.Fail("finished channel should fire")
 	}
 }
+
+func queueTaskAcks(outstandingTasks map[int64]*outstandingTask) map[int64]bool {
+	acks := make(map[int64]bool, len(outstandingTasks))
+	for taskID, task := range outstandingTasks {
+		acks[taskID] = task.acked
+	}
+	return acks
+}
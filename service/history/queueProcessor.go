@@ -344,6 +344,14 @@ func (p *queueProcessorBase) complete(
 	p.ackMgr.completeQueueTask(task.GetTaskId())
 }
 
+func (p *queueProcessorBase) recordError(
+	task queueTaskInfo,
+	attempt int,
+	err error,
+) {
+	p.ackMgr.recordQueueTaskError(task.GetTaskId(), attempt, err)
+}
+
 func (p *queueProcessorBase) isPriorityTaskProcessorEnabled() bool {
 	return p.taskProcessor == nil
 }
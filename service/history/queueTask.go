@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -43,6 +44,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/task"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -220,6 +222,17 @@ func (t *timerQueueTask) Nack() {
 	t.redispatchQueue.Add(t)
 }
 
+func (t *timerQueueTask) HandleErr(
+	err error,
+) error {
+	if err = t.queueTaskBase.HandleErr(err); err != nil {
+		if timerTask, ok := t.queueTaskInfo.(*persistencespb.TimerTaskInfo); ok {
+			t.ackMgr.recordTimerTaskError(timerTask, t.attempt, err)
+		}
+	}
+	return err
+}
+
 func (t *timerQueueTask) GetQueueType() queueType {
 	return timerQueueType
 }
@@ -238,6 +251,15 @@ func (t *transferQueueTask) Nack() {
 	t.redispatchQueue.Add(t)
 }
 
+func (t *transferQueueTask) HandleErr(
+	err error,
+) error {
+	if err = t.queueTaskBase.HandleErr(err); err != nil {
+		t.ackMgr.recordQueueTaskError(t.GetTaskId(), t.attempt, err)
+	}
+	return err
+}
+
 func (t *transferQueueTask) GetQueueType() queueType {
 	return transferQueueType
 }
@@ -256,6 +278,15 @@ func (t *visibilityQueueTask) Nack() {
 	t.redispatchQueue.Add(t)
 }
 
+func (t *visibilityQueueTask) HandleErr(
+	err error,
+) error {
+	if err = t.queueTaskBase.HandleErr(err); err != nil {
+		t.ackMgr.recordQueueTaskError(t.GetTaskId(), t.attempt, err)
+	}
+	return err
+}
+
 func (t *visibilityQueueTask) GetQueueType() queueType {
 	return visibilityQueueType
 }
@@ -288,7 +319,10 @@ func (t *queueTaskBase) Execute() error {
 		}
 	}()
 
-	return t.taskExecutor.execute(ctx, t.queueTaskInfo, t.shouldProcessTask)
+	ctx, span := startQueueTaskSpan(ctx, t.queueTaskInfo)
+	err = t.taskExecutor.execute(ctx, t.queueTaskInfo, t.shouldProcessTask)
+	tracing.EndSpan(span, err)
+	return err
 }
 
 func (t *queueTaskBase) HandleErr(
@@ -344,6 +378,16 @@ func (t *queueTaskBase) HandleErr(
 	}
 
 	t.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
+
+	if shouldMoveTaskToDLQ(t.shard.GetConfig(), t.queueTaskInfo, t.attempt, err) {
+		if dlqErr := moveTaskToDLQ(t.shard, t.queueTaskInfo, t.attempt, err); dlqErr != nil {
+			t.logger.Error("Fail to move task to DLQ", tag.Error(dlqErr))
+			return err
+		}
+		t.scope.IncCounter(metrics.TaskMovedToDLQ)
+		t.logger.Error("Task moved to DLQ after too many attempts", tag.Error(err), tag.Attempt(int32(t.attempt)))
+		return nil
+	}
 	return err
 }
 
@@ -401,3 +445,19 @@ func (t *queueTaskBase) SetPriority(
 func (t *queueTaskBase) GetShard() shard.Context {
 	return t.shard
 }
+
+func startQueueTaskSpan(
+	ctx context.Context,
+	taskInfo queueTaskInfo,
+) (context.Context, trace.Span) {
+	return tracing.StartSpan(
+		ctx,
+		"task/"+taskInfo.GetTaskType().String(),
+		trace.SpanKindInternal,
+		tracing.NamespaceIDKey.String(taskInfo.GetNamespaceId()),
+		tracing.WorkflowIDKey.String(taskInfo.GetWorkflowId()),
+		tracing.RunIDKey.String(taskInfo.GetRunId()),
+		tracing.TaskIDKey.Int64(taskInfo.GetTaskId()),
+		tracing.TaskTypeKey.String(taskInfo.GetTaskType().String()),
+	)
+}
@@ -30,6 +30,7 @@ import (
 	"sync/atomic"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/task"
@@ -38,9 +39,10 @@ import (
 
 type (
 	queueTaskProcessorOptions struct {
-		schedulerType        task.SchedulerType
-		fifoSchedulerOptions *task.FIFOTaskSchedulerOptions
-		wRRSchedulerOptions  *task.WeightedRoundRobinTaskSchedulerOptions
+		schedulerType                 task.SchedulerType
+		fifoSchedulerOptions          *task.FIFOTaskSchedulerOptions
+		wRRSchedulerOptions           *task.WeightedRoundRobinTaskSchedulerOptions
+		namespaceFairSchedulerOptions *task.NamespaceFairTaskSchedulerOptions
 	}
 
 	queueTaskProcessorImpl struct {
@@ -79,6 +81,10 @@ func newQueueTaskProcessor(
 		if options.wRRSchedulerOptions == nil {
 			return nil, errTaskSchedulerOptionsNotSpecified
 		}
+	case task.SchedulerTypeNamespaceFair:
+		if options.namespaceFairSchedulerOptions == nil {
+			return nil, errTaskSchedulerOptionsNotSpecified
+		}
 	default:
 		return nil, errUnknownTaskSchedulerType
 	}
@@ -200,6 +206,12 @@ func (p *queueTaskProcessorImpl) getOrCreateTaskScheduler(
 			p.metricsClient,
 			p.options.wRRSchedulerOptions,
 		)
+	case task.SchedulerTypeNamespaceFair:
+		scheduler, err = task.NewNamespaceFairTaskScheduler(
+			p.logger,
+			p.metricsClient,
+			p.options.namespaceFairSchedulerOptions,
+		)
 	default:
 		err = errUnknownTaskSchedulerType
 	}
@@ -220,3 +232,22 @@ func (p *queueTaskProcessorImpl) getOrCreateTaskScheduler(
 func (p *queueTaskProcessorImpl) isRunning() bool {
 	return atomic.LoadInt32(&p.status) == common.DaemonStatusStarted
 }
+
+// newQueueTaskNamespaceFn returns the function used by namespace fair task scheduler to find
+// the namespace name of a queue task, namespace ID is used if the namespace can not be found
+func newQueueTaskNamespaceFn(
+	namespaceCache cache.NamespaceCache,
+) func(task.PriorityTask) string {
+	return func(t task.PriorityTask) string {
+		queueTask, ok := t.(queueTask)
+		if !ok {
+			return ""
+		}
+
+		namespaceID := queueTask.GetNamespaceId()
+		if namespace, err := namespaceCache.GetNamespaceName(namespaceID); err == nil {
+			return namespace
+		}
+		return namespaceID
+	}
+}
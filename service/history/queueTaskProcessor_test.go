@@ -250,6 +250,20 @@ func (s *queueTaskProcessorSuite) TestNewQueueTaskProcessor_SchedulerOptionNotSp
 	s.Nil(processor)
 }
 
+func (s *queueTaskProcessorSuite) TestQueueTaskNamespaceFn() {
+	mockNamespaceCache := s.mockShard.Resource.NamespaceCache
+	namespaceFn := newQueueTaskNamespaceFn(mockNamespaceCache)
+
+	mockTask := NewMockqueueTask(s.controller)
+	mockTask.EXPECT().GetNamespaceId().Return(tests.NamespaceID).AnyTimes()
+
+	mockNamespaceCache.EXPECT().GetNamespaceName(tests.NamespaceID).Return(tests.Namespace, nil)
+	s.Equal(tests.Namespace, namespaceFn(mockTask))
+
+	mockNamespaceCache.EXPECT().GetNamespaceName(tests.NamespaceID).Return("", errors.New("some random error"))
+	s.Equal(tests.NamespaceID, namespaceFn(mockTask))
+}
+
 func (s *queueTaskProcessorSuite) newTestQueueTaskProcessor() *queueTaskProcessorImpl {
 	processor, err := newQueueTaskProcessor(
 		s.mockPriorityAssigner,
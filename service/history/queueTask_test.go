@@ -35,9 +35,11 @@ import (
 	"github.com/uber-go/tally"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -85,6 +87,11 @@ func (s *queueTaskSuite) SetupTest() {
 	)
 	s.mockQueueTaskExecutor = NewMockqueueTaskExecutor(s.controller)
 	s.mockQueueTaskInfo = NewMockqueueTaskInfo(s.controller)
+	// task attributes are recorded on the tracing span of the task
+	s.mockQueueTaskInfo.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK).AnyTimes()
+	s.mockQueueTaskInfo.EXPECT().GetNamespaceId().Return(tests.NamespaceID).AnyTimes()
+	s.mockQueueTaskInfo.EXPECT().GetWorkflowId().Return(tests.WorkflowID).AnyTimes()
+	s.mockQueueTaskInfo.EXPECT().GetRunId().Return(tests.RunID).AnyTimes()
 
 	s.scope = metrics.NewClient(tally.NoopScope, metrics.History).Scope(0)
 	s.logger = log.NewTestLogger()
@@ -111,6 +118,7 @@ func (s *queueTaskSuite) TestExecute_ExecutionErr() {
 	})
 
 	executionErr := errors.New("some random error")
+	s.mockQueueTaskInfo.EXPECT().GetTaskId().Return(int64(12345)).AnyTimes()
 	s.mockQueueTaskExecutor.EXPECT().execute(gomock.Any(), queueTaskBase.queueTaskInfo, true).Return(executionErr)
 
 	err := queueTaskBase.Execute()
@@ -122,6 +130,7 @@ func (s *queueTaskSuite) TestExecute_Success() {
 		return true, nil
 	})
 
+	s.mockQueueTaskInfo.EXPECT().GetTaskId().Return(int64(12345)).AnyTimes()
 	s.mockQueueTaskExecutor.EXPECT().execute(gomock.Any(), queueTaskBase.queueTaskInfo, true).Return(nil)
 
 	err := queueTaskBase.Execute()
@@ -187,6 +196,58 @@ func (s *queueTaskSuite) TestHandleErr_UnknownErr() {
 	s.Equal(err, queueTaskBase.HandleErr(err))
 }
 
+func (s *queueTaskSuite) TestHandleErr_MovedToDLQ() {
+	s.mockShard.GetConfig().TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(1)
+	transferTask := &persistencespb.TransferTaskInfo{
+		NamespaceId: tests.NamespaceID,
+		WorkflowId:  tests.WorkflowID,
+		RunId:       tests.RunID,
+		TaskId:      123,
+	}
+	queueTaskBase := newQueueTaskBase(
+		s.mockShard,
+		transferTask,
+		s.scope,
+		s.logger,
+		func(task queueTaskInfo) (bool, error) {
+			return true, nil
+		},
+		s.mockQueueTaskExecutor,
+		s.timeSource,
+		s.maxRetryCount,
+	)
+
+	err := errors.New("some random error")
+	s.mockShard.Resource.ExecutionMgr.EXPECT().PutHistoryTaskToDLQ(gomock.Any()).Return(nil)
+	s.NoError(queueTaskBase.HandleErr(err))
+}
+
+func (s *queueTaskSuite) TestHandleErr_RecordedByAckMgr() {
+	mockAckMgr := NewMockqueueAckMgr(s.controller)
+	transferQueueTask := newTransferQueueTask(
+		s.mockShard,
+		s.mockQueueTaskInfo,
+		s.scope,
+		s.logger,
+		func(task queueTaskInfo) (bool, error) {
+			return true, nil
+		},
+		s.mockQueueTaskExecutor,
+		collection.NewConcurrentQueue(),
+		s.timeSource,
+		s.maxRetryCount,
+		mockAckMgr,
+	)
+
+	taskID := int64(123)
+	err := errors.New("some random error")
+	s.mockQueueTaskInfo.EXPECT().GetTaskId().Return(taskID).AnyTimes()
+	mockAckMgr.EXPECT().recordQueueTaskError(taskID, 2, err)
+	s.Equal(err, transferQueueTask.HandleErr(err))
+
+	s.NoError(transferQueueTask.HandleErr(serviceerror.NewNotFound("")))
+}
+
 func (s *queueTaskSuite) TestTaskState() {
 	queueTaskBase := s.newTestQueueTaskBase(func(task queueTaskInfo) (bool, error) {
 		return true, nil
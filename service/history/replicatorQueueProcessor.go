@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -88,6 +89,9 @@ func newReplicatorQueueProcessor(
 	retryPolicy.SetMaximumAttempts(10)
 	retryPolicy.SetBackoffCoefficient(1)
 
+	// replicated events must not reference the payload store of this cluster
+	executionMgr = payloadoffload.NewRehydratingExecutionManager(executionMgr, shard.GetService().GetPayloadOffloader())
+
 	return &replicatorQueueProcessorImpl{
 		currentClusterName: currentClusterName,
 		shard:              shard,
@@ -541,7 +545,7 @@ func (p *replicatorQueueProcessorImpl) processReplication(
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.LoadWorkflowExecution()
+	msBuilder, err := context.LoadWorkflowExecution(ctx)
 	switch err.(type) {
 	case nil:
 		if !processTaskIfClosed && !msBuilder.IsWorkflowExecutionRunning() {
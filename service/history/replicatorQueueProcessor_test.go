@@ -91,6 +91,7 @@ func (s *replicatorQueueProcessorSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockMutableState = workflow.NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().GetApproximatePersistedSize().Return(0).AnyTimes()
 
 	s.mockShard = shard.NewTestContext(
 		s.controller,
@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/configs"
 )
 
@@ -59,7 +60,8 @@ type Service struct {
 	handler *Handler
 	config  *configs.Config
 
-	server *grpc.Server
+	server            *grpc.Server
+	cancelRateLimiter func()
 }
 
 // NewService builds a new history service
@@ -84,6 +86,7 @@ func NewService(
 	visibilityManagerInitializer := func(
 		persistenceBean persistenceClient.Bean,
 		searchAttributesProvider searchattribute.Provider,
+		searchAttributesMapper searchattribute.Mapper,
 		logger log.Logger,
 	) (visibility.VisibilityManager, error) {
 		visibilityFromDB, err := visibilityclient.NewVisibilityManager(
@@ -91,6 +94,8 @@ func NewService(
 			serviceConfig.PersistenceMaxQPS,
 			params.MetricsClient,
 			params.PersistenceServiceResolver,
+			searchAttributesProvider,
+			params.ESConfig.GetVisibilityIndex(),
 			params.Logger,
 		)
 		if err != nil {
@@ -116,7 +121,7 @@ func NewService(
 			visibilityConfigForES := &config.VisibilityConfig{
 				ESProcessorAckTimeout: serviceConfig.ESProcessorAckTimeout,
 			}
-			visibilityFromES = elasticsearch.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES, searchAttributesProvider, esProcessor, params.MetricsClient, logger)
+			visibilityFromES = elasticsearch.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES, searchAttributesProvider, searchAttributesMapper, esProcessor, params.MetricsClient, logger)
 		}
 		return visibility.NewVisibilityManagerWrapper(
 			visibilityFromDB,
@@ -144,8 +149,9 @@ func NewService(
 		metrics.HistoryAPIMetricsScopes(),
 		logger,
 	)
+	rateLimiter, cancelRateLimiter := configs.NewPriorityRateLimiter(serviceConfig.RPS)
 	rateLimiterInterceptor := interceptor.NewRateLimitInterceptor(
-		configs.NewPriorityRateLimiter(func() float64 { return float64(serviceConfig.RPS()) }),
+		rateLimiter,
 		map[string]int{},
 	)
 
@@ -156,6 +162,7 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerInterceptor(common.HistoryServiceName),
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
@@ -165,11 +172,12 @@ func NewService(
 	)
 
 	return &Service{
-		Resource: serviceResource,
-		status:   common.DaemonStatusInitialized,
-		server:   grpc.NewServer(grpcServerOptions...),
-		handler:  NewHandler(serviceResource, serviceConfig),
-		config:   serviceConfig,
+		Resource:          serviceResource,
+		status:            common.DaemonStatusInitialized,
+		server:            grpc.NewServer(grpcServerOptions...),
+		cancelRateLimiter: cancelRateLimiter,
+		handler:           NewHandler(serviceResource, serviceConfig),
+		config:            serviceConfig,
 	}, nil
 }
 
@@ -239,6 +247,7 @@ func (s *Service) Stop() {
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
 	s.server.Stop()
+	s.cancelRateLimiter()
 
 	s.handler.Stop()
 	s.Resource.Stop()
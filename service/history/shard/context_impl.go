@@ -25,12 +25,14 @@
 package shard
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -43,6 +45,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/configs"
@@ -94,6 +97,7 @@ const (
 	logWarnTransferLevelDiff = 3000000 // 3 million
 	logWarnTimerLevelDiff    = time.Duration(30 * time.Minute)
 	historySizeLogThreshold  = 10 * 1024 * 1024
+	payloadOffloadTimeout    = 30 * time.Second
 )
 
 func (s *ContextImpl) GetShardID() int32 {
@@ -405,6 +409,7 @@ func (s *ContextImpl) UpdateTimerMaxReadLevel(cluster string) time.Time {
 }
 
 func (s *ContextImpl) CreateWorkflowExecution(
+	ctx context.Context,
 	request *persistence.CreateWorkflowExecutionRequest,
 ) (*persistence.CreateWorkflowExecutionResponse, error) {
 	if s.isStopped() {
@@ -439,7 +444,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 
 	currentRangeID := s.getRangeID()
 	request.RangeID = currentRangeID
-	resp, err := s.executionManager.CreateWorkflowExecution(request)
+	resp, err := persistence.ExecutionManagerWithContext(ctx, s.executionManager).CreateWorkflowExecution(request)
 	if err = s.handleError(err); err != nil {
 		return nil, err
 	}
@@ -447,6 +452,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 }
 
 func (s *ContextImpl) UpdateWorkflowExecution(
+	ctx context.Context,
 	request *persistence.UpdateWorkflowExecutionRequest,
 ) (*persistence.UpdateWorkflowExecutionResponse, error) {
 	if s.isStopped() {
@@ -494,7 +500,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 
 	currentRangeID := s.getRangeID()
 	request.RangeID = currentRangeID
-	resp, err := s.executionManager.UpdateWorkflowExecution(request)
+	resp, err := persistence.ExecutionManagerWithContext(ctx, s.executionManager).UpdateWorkflowExecution(request)
 	if err = s.handleError(err); err != nil {
 		return nil, err
 	}
@@ -502,6 +508,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 }
 
 func (s *ContextImpl) ConflictResolveWorkflowExecution(
+	ctx context.Context,
 	request *persistence.ConflictResolveWorkflowExecutionRequest,
 ) error {
 	if s.isStopped() {
@@ -562,11 +569,12 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 
 	currentRangeID := s.getRangeID()
 	request.RangeID = currentRangeID
-	err = s.executionManager.ConflictResolveWorkflowExecution(request)
+	err = persistence.ExecutionManagerWithContext(ctx, s.executionManager).ConflictResolveWorkflowExecution(request)
 	return s.handleError(err)
 }
 
 func (s *ContextImpl) AddTasks(
+	ctx context.Context,
 	request *persistence.AddTasksRequest,
 ) error {
 	if s.isStopped() {
@@ -600,7 +608,7 @@ func (s *ContextImpl) AddTasks(
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
 	request.RangeID = s.getRangeID()
-	err = s.executionManager.AddTasks(request)
+	err = persistence.ExecutionManagerWithContext(ctx, s.executionManager).AddTasks(request)
 	if err = s.handleError(err); err != nil {
 		return err
 	}
@@ -612,6 +620,7 @@ func (s *ContextImpl) AddTasks(
 }
 
 func (s *ContextImpl) AppendHistoryEvents(
+	ctx context.Context,
 	request *persistence.AppendHistoryNodesRequest,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -622,6 +631,10 @@ func (s *ContextImpl) AppendHistoryEvents(
 
 	request.ShardID = s.shardID
 
+	if err := s.offloadPayloads(request, namespaceID); err != nil {
+		return 0, err
+	}
+
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -641,13 +654,49 @@ func (s *ContextImpl) AppendHistoryEvents(
 				tag.WorkflowHistorySizeBytes(size))
 		}
 	}()
-	resp, err0 := s.GetExecutionManager().AppendHistoryNodes(request)
+	resp, err0 := persistence.ExecutionManagerWithContext(ctx, s.GetExecutionManager()).AppendHistoryNodes(request)
 	if resp != nil {
 		size = resp.Size
 	}
 	return size, err0
 }
 
+// offloadPayloads replaces the events to be persisted with copies whose large payloads are offloaded to blob storage,
+// events already handed to mutable state and the events cache keep their original payloads.
+func (s *ContextImpl) offloadPayloads(
+	request *persistence.AppendHistoryNodesRequest,
+	namespaceID string,
+) error {
+	offloader := s.GetPayloadOffloader()
+	if !offloader.Enabled() {
+		return nil
+	}
+
+	namespaceEntry, err := s.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	branch, err := serialization.HistoryBranchFromBlob(request.BranchToken, enumspb.ENCODING_TYPE_PROTO3.String())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), payloadOffloadTimeout)
+	defer cancel()
+	events, err := offloader.Offload(
+		ctx,
+		branch.GetTreeId(),
+		s.config.PayloadOffloadThreshold(namespaceEntry.GetInfo().Name),
+		request.Events,
+	)
+	if err != nil {
+		s.GetMetricsClient().IncCounter(metrics.HistoryPayloadOffloadScope, metrics.PayloadOffloadFailures)
+		return err
+	}
+	request.Events = events
+	return nil
+}
+
 func (s *ContextImpl) GetConfig() *configs.Config {
 	return s.config
 }
@@ -1128,6 +1177,7 @@ func acquireShard(
 		shardContext.GetShardID(),
 		shardContext.GetConfig().EventsCacheInitialSize(),
 		shardContext.GetConfig().EventsCacheMaxSize(),
+		shardContext.GetConfig().EventsCacheMaxSizeBytes(),
 		shardContext.GetConfig().EventsCacheTTL(),
 		shardContext.GetExecutionManager(),
 		false,
@@ -25,6 +25,7 @@
 package shard
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -137,6 +138,6 @@ func (s *contextSuite) TestAddTasks_Success() {
 	s.mockHistoryEngine.EXPECT().NotifyNewVisibilityTasks(visibilityTasks)
 	s.mockHistoryEngine.EXPECT().NotifyNewReplicationTasks(replicationTasks)
 
-	err := s.shardContext.AddTasks(addTasksRequest)
+	err := s.shardContext.AddTasks(context.Background(), addTasksRequest)
 	s.NoError(err)
 }
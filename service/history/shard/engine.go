@@ -77,6 +77,8 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		DeleteWorkflowExecution(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		DescribeShardQueues(ctx context.Context, request *historyservice.DescribeShardQueuesRequest) (*historyservice.DescribeShardQueuesResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -64,6 +64,20 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 	return m.recorder
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, namespaceUUID string, execution common.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, namespaceUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, namespaceUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, namespaceUUID, execution)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +93,21 @@ func (mr *MockEngineMockRecorder) DescribeMutableState(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), ctx, request)
 }
 
+// DescribeShardQueues mocks base method.
+func (m *MockEngine) DescribeShardQueues(ctx context.Context, request *historyservice.DescribeShardQueuesRequest) (*historyservice.DescribeShardQueuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardQueues", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeShardQueuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardQueues indicates an expected call of DescribeShardQueues.
+func (mr *MockEngineMockRecorder) DescribeShardQueues(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardQueues", reflect.TypeOf((*MockEngine)(nil).DescribeShardQueues), ctx, request)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockEngine) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
@@ -222,6 +223,7 @@ FilterLoop:
 		err := t.handleTaskError(scope, task, notificationChan, err)
 		if err != nil {
 			task.attempt++
+			task.processor.recordError(task, err)
 			if task.attempt > t.config.TimerTaskMaxRetryCount() {
 				scope.RecordDistribution(metrics.TaskAttemptTimer, task.attempt)
 				task.logger.Error("Critical error processing task, retrying.",
@@ -264,9 +266,10 @@ func (t *taskProcessor) processTaskOnce(
 	default:
 	}
 
-	ctx := context.Background()
+	ctx, span := startQueueTaskSpan(context.Background(), task.task)
 	startTime := t.timeSource.Now()
 	scopeIdx, err := task.processor.process(ctx, task)
+	tracing.EndSpan(span, err)
 	if duration, ok := metrics.ContextCounterGet(ctx, metrics.HistoryWorkflowExecutionCacheLatency); ok {
 		task.userLatency += time.Duration(duration)
 	}
@@ -339,6 +342,16 @@ func (t *taskProcessor) handleTaskError(
 	}
 
 	task.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
+
+	if shouldMoveTaskToDLQ(t.config, task.task, task.attempt, err) {
+		if dlqErr := moveTaskToDLQ(t.shard, task.task, task.attempt, err); dlqErr != nil {
+			task.logger.Error("Fail to move task to DLQ", tag.Error(dlqErr))
+			return err
+		}
+		scope.IncCounter(metrics.TaskMovedToDLQ)
+		task.logger.Error("Task moved to DLQ after too many attempts", tag.Error(err), tag.Attempt(int32(task.attempt)))
+		return nil
+	}
 	return err
 }
 
@@ -25,7 +25,6 @@
 package history
 
 import (
-	"context"
 	"errors"
 	"testing"
 	"time"
@@ -141,7 +140,7 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_NamespaceErrRetry_ProcessNoEr
 	}
 	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilterErr)
 	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilter)
-	s.mockProcessor.EXPECT().process(context.Background(), task).Return(s.scopeIdx, nil)
+	s.mockProcessor.EXPECT().process(gomock.Any(), task).Return(s.scopeIdx, nil)
 	s.mockProcessor.EXPECT().complete(task)
 	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil)
 	s.taskProcessor.processTaskAndAck(
@@ -158,7 +157,7 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_NamespaceFalse_ProcessNoErr()
 		return false, nil
 	}
 	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilter)
-	s.mockProcessor.EXPECT().process(context.Background(), task).Return(s.scopeIdx, nil)
+	s.mockProcessor.EXPECT().process(gomock.Any(), task).Return(s.scopeIdx, nil)
 	s.mockProcessor.EXPECT().complete(task)
 	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil)
 	s.taskProcessor.processTaskAndAck(
@@ -174,7 +173,7 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_NamespaceTrue_ProcessNoErr()
 		return true, nil
 	}
 	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilter)
-	s.mockProcessor.EXPECT().process(context.Background(), task).Return(s.scopeIdx, nil)
+	s.mockProcessor.EXPECT().process(gomock.Any(), task).Return(s.scopeIdx, nil)
 	s.mockProcessor.EXPECT().complete(task)
 	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil)
 	s.taskProcessor.processTaskAndAck(
@@ -191,14 +190,16 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_NamespaceTrue_ProcessErrNoErr
 		return true, nil
 	}
 	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilter)
-	s.mockProcessor.EXPECT().process(context.Background(), task).Return(s.scopeIdx, err)
-	s.mockProcessor.EXPECT().process(context.Background(), task).Return(s.scopeIdx, nil)
+	s.mockProcessor.EXPECT().process(gomock.Any(), task).Return(s.scopeIdx, err)
+	s.mockProcessor.EXPECT().recordError(task, err)
+	s.mockProcessor.EXPECT().process(gomock.Any(), task).Return(s.scopeIdx, nil)
 	s.mockProcessor.EXPECT().complete(task)
 	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).Times(2)
 	s.taskProcessor.processTaskAndAck(
 		s.notificationChan,
 		task,
 	)
+	s.Equal(2, task.attempt)
 }
 
 func (s *taskProcessorSuite) TestHandleTaskError_EntityNotExists() {
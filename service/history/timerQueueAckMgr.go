@@ -30,6 +30,7 @@ import (
 	"sync"
 	"time"
 
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -87,8 +88,8 @@ type (
 		finishedChan chan struct{}
 
 		sync.Mutex
-		// outstanding timer task -> finished (true)
-		outstandingTasks map[timerKey]bool
+		// outstanding timer task -> in-memory task state
+		outstandingTasks map[timerKey]*outstandingTask
 		// timer task ack level
 		ackLevel timerKey
 		// timer task read level, used by failover
@@ -166,7 +167,7 @@ func newTimerQueueAckMgr(
 		timeNow:             timeNow,
 		updateTimerAckLevel: updateTimerAckLevel,
 		timerQueueShutdown:  func() error { return nil },
-		outstandingTasks:    make(map[timerKey]bool),
+		outstandingTasks:    make(map[timerKey]*outstandingTask),
 		ackLevel:            ackLevel,
 		readLevel:           ackLevel,
 		minQueryLevel:       ackLevel.VisibilityTimestamp,
@@ -204,7 +205,7 @@ func newTimerQueueFailoverAckMgr(
 		timeNow:             timeNow,
 		updateTimerAckLevel: updateTimerAckLevel,
 		timerQueueShutdown:  timerQueueShutdown,
-		outstandingTasks:    make(map[timerKey]bool),
+		outstandingTasks:    make(map[timerKey]*outstandingTask),
 		ackLevel:            ackLevel,
 		readLevel:           ackLevel,
 		minQueryLevel:       ackLevel.VisibilityTimestamp,
@@ -275,7 +276,7 @@ TaskFilterLoop:
 		t.logger.Debug("Moving timer read level", tag.Task(timerKey))
 		t.readLevel = *timerKey
 
-		t.outstandingTasks[*timerKey] = false
+		t.outstandingTasks[*timerKey] = newOutstandingTask(task)
 		filteredTasks = append(filteredTasks, task)
 	}
 
@@ -330,7 +331,24 @@ func (t *timerQueueAckMgrImpl) completeTimerTask(task *persistencespb.TimerTaskI
 	t.Lock()
 	defer t.Unlock()
 
-	t.outstandingTasks[*timerKey] = true
+	if outstandingTask, ok := t.outstandingTasks[*timerKey]; ok {
+		outstandingTask.acked = true
+		return
+	}
+	outstandingTask := newOutstandingTask(task)
+	outstandingTask.acked = true
+	t.outstandingTasks[*timerKey] = outstandingTask
+}
+
+func (t *timerQueueAckMgrImpl) recordTimerTaskError(task *persistencespb.TimerTaskInfo, attempt int, err error) {
+	timerKey := &timerKey{VisibilityTimestamp: timestamp.TimeValue(task.GetVisibilityTime()), TaskID: task.GetTaskId()}
+	t.Lock()
+	defer t.Unlock()
+
+	if outstandingTask, ok := t.outstandingTasks[*timerKey]; ok {
+		outstandingTask.attempt = attempt
+		outstandingTask.lastError = err
+	}
 }
 
 func (t *timerQueueAckMgrImpl) getReadLevel() timerKey {
@@ -345,6 +363,26 @@ func (t *timerQueueAckMgrImpl) getAckLevel() timerKey {
 	return t.ackLevel
 }
 
+func (t *timerQueueAckMgrImpl) getQueueState() *historyspb.QueueState {
+	t.Lock()
+	defer t.Unlock()
+
+	var sequenceIDs timerKeys
+	for k := range t.outstandingTasks {
+		sequenceIDs = append(sequenceIDs, k)
+	}
+	sort.Sort(sequenceIDs)
+
+	tasks := make([]*outstandingTask, 0, len(sequenceIDs))
+	for _, sequenceID := range sequenceIDs {
+		tasks = append(tasks, t.outstandingTasks[sequenceID])
+	}
+	state := newQueueState(t.ackLevel.TaskID, t.readLevel.TaskID, tasks, t.timeNow())
+	state.AckLevelTime = timestamp.TimePtr(t.ackLevel.VisibilityTimestamp)
+	state.ReadLevelTime = timestamp.TimePtr(t.readLevel.VisibilityTimestamp)
+	return state
+}
+
 func (t *timerQueueAckMgrImpl) updateAckLevel() error {
 	t.metricsClient.IncCounter(t.scope, metrics.AckLevelUpdateCounter)
 
@@ -373,8 +411,7 @@ func (t *timerQueueAckMgrImpl) updateAckLevel() error {
 
 MoveAckLevelLoop:
 	for _, current := range sequenceIDs {
-		acked := outstandingTasks[current]
-		if acked {
+		if outstandingTasks[current].acked {
 			ackLevel = current
 			delete(outstandingTasks, current)
 			t.logger.Debug("Moving timer ack level", tag.AckLevel(ackLevel))
@@ -25,11 +25,14 @@
 package history
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -283,7 +286,7 @@ func (s *timerQueueAckMgrSuite) TestReadTimerTasks_NoLookAhead_NoNextPage() {
 	s.False(moreTasks)
 
 	timerSequenceID := timerKeyFromTimePtr(timer.VisibilityTime, timer.GetTaskId())
-	s.Equal(map[timerKey]bool{*timerSequenceID: false}, s.timerQueueAckMgr.outstandingTasks)
+	s.Equal(map[timerKey]bool{*timerSequenceID: false}, timerTaskAcks(s.timerQueueAckMgr.outstandingTasks))
 	s.Equal(ackLevel, s.timerQueueAckMgr.ackLevel)
 	s.Empty(s.timerQueueAckMgr.pageToken)
 	s.Equal(s.timerQueueAckMgr.minQueryLevel, s.timerQueueAckMgr.maxQueryLevel)
@@ -327,7 +330,7 @@ func (s *timerQueueAckMgrSuite) TestReadTimerTasks_NoLookAhead_HasNextPage() {
 	s.Nil(lookAheadTask)
 	s.True(moreTasks)
 	timerSequenceID := timerKeyFromTimePtr(timer.VisibilityTime, timer.GetTaskId())
-	s.Equal(map[timerKey]bool{*timerSequenceID: false}, s.timerQueueAckMgr.outstandingTasks)
+	s.Equal(map[timerKey]bool{*timerSequenceID: false}, timerTaskAcks(s.timerQueueAckMgr.outstandingTasks))
 	s.Equal(ackLevel, s.timerQueueAckMgr.ackLevel)
 	s.Equal(minQueryLevel, s.timerQueueAckMgr.minQueryLevel)
 	s.Equal(response.NextPageToken, s.timerQueueAckMgr.pageToken)
@@ -371,7 +374,7 @@ func (s *timerQueueAckMgrSuite) TestReadTimerTasks_HasLookAhead_NoNextPage() {
 	s.Equal(timer, lookAheadTask)
 	s.False(moreTasks)
 
-	s.Equal(map[timerKey]bool{}, s.timerQueueAckMgr.outstandingTasks)
+	s.Equal(map[timerKey]bool{}, timerTaskAcks(s.timerQueueAckMgr.outstandingTasks))
 	s.Equal(ackLevel, s.timerQueueAckMgr.ackLevel)
 	s.Equal(s.timerQueueAckMgr.maxQueryLevel, s.timerQueueAckMgr.minQueryLevel)
 	s.Empty(s.timerQueueAckMgr.pageToken)
@@ -415,7 +418,7 @@ func (s *timerQueueAckMgrSuite) TestReadTimerTasks_HasLookAhead_HasNextPage() {
 	s.Equal(timer, lookAheadTask)
 	s.False(moreTasks)
 
-	s.Equal(map[timerKey]bool{}, s.timerQueueAckMgr.outstandingTasks)
+	s.Equal(map[timerKey]bool{}, timerTaskAcks(s.timerQueueAckMgr.outstandingTasks))
 	s.Equal(ackLevel, s.timerQueueAckMgr.ackLevel)
 	s.Equal(s.timerQueueAckMgr.maxQueryLevel, s.timerQueueAckMgr.minQueryLevel)
 	s.Empty(s.timerQueueAckMgr.pageToken)
@@ -474,14 +477,14 @@ func (s *timerQueueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	timerSequenceID1 := timerKeyFromTimePtr(timer1.VisibilityTime, timer1.GetTaskId())
 	s.timerQueueAckMgr.completeTimerTask(timer1)
-	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID1])
+	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID1].acked)
 	_ = s.timerQueueAckMgr.updateAckLevel()
 	s.Equal(timer1.VisibilityTime.UnixNano(), s.mockShard.GetTimerClusterAckLevel(s.clusterName).UnixNano())
 
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	timerSequenceID3 := timerKeyFromTimePtr(timer3.VisibilityTime, timer3.GetTaskId())
 	s.timerQueueAckMgr.completeTimerTask(timer3)
-	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID3])
+	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID3].acked)
 	_ = s.timerQueueAckMgr.updateAckLevel()
 	// ack level remains unchanged
 	s.Equal(timer1.VisibilityTime.UnixNano(), s.mockShard.GetTimerClusterAckLevel(s.clusterName).UnixNano())
@@ -490,11 +493,60 @@ func (s *timerQueueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	timerSequenceID2 := timerKeyFromTimePtr(timer2.VisibilityTime, timer2.GetTaskId())
 	s.timerQueueAckMgr.completeTimerTask(timer2)
-	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID2])
+	s.True(s.timerQueueAckMgr.outstandingTasks[*timerSequenceID2].acked)
 	_ = s.timerQueueAckMgr.updateAckLevel()
 	s.Equal(timer3.VisibilityTime.UnixNano(), s.mockShard.GetTimerClusterAckLevel(s.clusterName).UnixNano())
 }
 
+func (s *timerQueueAckMgrSuite) TestReadRecordErrorGetQueueState() {
+	ackLevel := s.timerQueueAckMgr.ackLevel
+	timer := &persistencespb.TimerTaskInfo{
+		ScheduleAttempt: 1,
+		NamespaceId:     TestNamespaceId,
+		WorkflowId:      "some random workflow ID",
+		RunId:           uuid.New(),
+		VisibilityTime:  timestamp.TimeNowPtrUtcAddSeconds(-5),
+		TaskId:          int64(59),
+		TaskType:        enumsspb.TASK_TYPE_USER_TIMER,
+		EventId:         int64(28),
+	}
+	response := &persistence.GetTimerIndexTasksResponse{
+		Timers:        []*persistencespb.TimerTaskInfo{timer},
+		NextPageToken: nil,
+	}
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockExecutionMgr.EXPECT().GetTimerIndexTasks(gomock.Any()).Return(response, nil)
+	s.mockExecutionMgr.EXPECT().GetTimerIndexTasks(gomock.Any()).Return(&persistence.GetTimerIndexTasksResponse{}, nil)
+	_, _, _, err := s.timerQueueAckMgr.readTimerTasks()
+	s.Nil(err)
+
+	taskErr := errors.New("some random error")
+	s.timerQueueAckMgr.recordTimerTaskError(timer, 3, taskErr)
+
+	state := s.timerQueueAckMgr.getQueueState()
+	s.Equal(ackLevel.TaskID, state.GetAckLevel())
+	s.Equal(ackLevel.VisibilityTimestamp, timestamp.TimeValue(state.GetAckLevelTime()))
+	s.Equal(timer.GetTaskId(), state.GetReadLevel())
+	s.Equal(timestamp.TimeValue(timer.VisibilityTime), timestamp.TimeValue(state.GetReadLevelTime()))
+	s.Equal([]*historyspb.QueueTaskState{{
+		TaskId:         timer.GetTaskId(),
+		TaskType:       enumsspb.TASK_TYPE_USER_TIMER,
+		NamespaceId:    TestNamespaceId,
+		WorkflowId:     timer.GetWorkflowId(),
+		RunId:          timer.GetRunId(),
+		VisibilityTime: timer.VisibilityTime,
+		Acked:          false,
+		Attempt:        3,
+		LastError:      taskErr.Error(),
+	}}, state.GetOutstandingTasks())
+	s.True(timestamp.DurationValue(state.GetOldestPendingTaskAge()) >= 5*time.Second)
+
+	s.timerQueueAckMgr.completeTimerTask(timer)
+	state = s.timerQueueAckMgr.getQueueState()
+	s.True(state.GetOutstandingTasks()[0].GetAcked())
+	s.Nil(state.GetOldestPendingTaskAge())
+}
+
 func (s *timerQueueAckMgrSuite) TestReadLookAheadTask() {
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(s.clusterName).AnyTimes()
 	level := s.mockShard.UpdateTimerMaxReadLevel(s.clusterName)
@@ -771,7 +823,7 @@ func (s *timerQueueFailoverAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 
 	timerSequenceID2 := timerKeyFromTimePtr(timer2.VisibilityTime, timer2.GetTaskId())
 	s.timerQueueFailoverAckMgr.completeTimerTask(timer2)
-	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID2])
+	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID2].acked)
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	_ = s.timerQueueFailoverAckMgr.updateAckLevel()
 	select {
@@ -782,7 +834,7 @@ func (s *timerQueueFailoverAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 
 	timerSequenceID3 := timerKeyFromTimePtr(timer3.VisibilityTime, timer3.GetTaskId())
 	s.timerQueueFailoverAckMgr.completeTimerTask(timer3)
-	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID3])
+	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID3].acked)
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	_ = s.timerQueueFailoverAckMgr.updateAckLevel()
 	select {
@@ -793,7 +845,7 @@ func (s *timerQueueFailoverAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 
 	timerSequenceID1 := timerKeyFromTimePtr(timer1.VisibilityTime, timer1.GetTaskId())
 	s.timerQueueFailoverAckMgr.completeTimerTask(timer1)
-	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID1])
+	s.True(s.timerQueueFailoverAckMgr.outstandingTasks[*timerSequenceID1].acked)
 	s.mockShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
 	_ = s.timerQueueFailoverAckMgr.updateAckLevel()
 	select {
@@ -802,3 +854,11 @@ func (s *timerQueueFailoverAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 		s.Fail("timer queue ack mgr finished chan should be fired")
 	}
 }
+
+func timerTaskAcks(outstandingTasks map[timerKey]*outstandingTask) map[timerKey]bool {
+	acks := make(map[timerKey]bool, len(outstandingTasks))
+	for key, task := range outstandingTasks {
+		acks[key] = task.acked
+	}
+	return acks
+}
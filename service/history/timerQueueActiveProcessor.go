@@ -297,6 +297,17 @@ func (t *timerQueueActiveProcessorImpl) complete(
 	t.timerQueueProcessorBase.complete(timerTask)
 }
 
+func (t *timerQueueActiveProcessorImpl) recordError(
+	taskInfo *taskInfo,
+	err error,
+) {
+	timerTask, ok := taskInfo.task.(*persistencespb.TimerTaskInfo)
+	if !ok {
+		return
+	}
+	t.timerQueueProcessorBase.recordError(timerTask, taskInfo.attempt, err)
+}
+
 func (t *timerQueueActiveProcessorImpl) process(
 	ctx context.Context,
 	taskInfo *taskInfo,
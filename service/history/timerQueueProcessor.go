@@ -30,10 +30,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
@@ -57,6 +60,7 @@ type (
 		NotifyNewTimers(clusterName string, timerTask []persistence.Task)
 		LockTaskProcessing()
 		UnlockTaskProcessing()
+		GetQueueStates() []*historyspb.QueueState
 	}
 
 	timeNow                 func() time.Time
@@ -264,6 +268,25 @@ func (t *timerQueueProcessorImpl) UnlockTaskProcessing() {
 	t.taskAllocator.unlock()
 }
 
+// GetQueueStates returns the in-memory state of the active and standby timer queue processors
+func (t *timerQueueProcessorImpl) GetQueueStates() []*historyspb.QueueState {
+	activeState := t.activeTimerProcessor.timerQueueProcessorBase.getQueueState()
+	activeState.Category = enumsspb.TASK_CATEGORY_TIMER
+	activeState.ClusterName = t.currentClusterName
+
+	var standbyStates []*historyspb.QueueState
+	for clusterName, standbyTimerProcessor := range t.standbyTimerProcessors {
+		standbyState := standbyTimerProcessor.timerQueueProcessorBase.getQueueState()
+		standbyState.Category = enumsspb.TASK_CATEGORY_TIMER
+		standbyState.ClusterName = clusterName
+		standbyStates = append(standbyStates, standbyState)
+	}
+	sort.Slice(standbyStates, func(i, j int) bool {
+		return standbyStates[i].ClusterName < standbyStates[j].ClusterName
+	})
+	return append([]*historyspb.QueueState{activeState}, standbyStates...)
+}
+
 func (t *timerQueueProcessorImpl) completeTimersLoop() {
 	defer t.shutdownWG.Done()
 
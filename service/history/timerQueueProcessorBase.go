@@ -31,6 +31,7 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/configs"
@@ -427,6 +428,18 @@ func (t *timerQueueProcessorBase) complete(
 	atomic.AddUint64(&t.timerFiredCount, 1)
 }
 
+func (t *timerQueueProcessorBase) getQueueState() *historyspb.QueueState {
+	return t.timerQueueAckMgr.getQueueState()
+}
+
+func (t *timerQueueProcessorBase) recordError(
+	timerTask *persistencespb.TimerTaskInfo,
+	attempt int,
+	err error,
+) {
+	t.timerQueueAckMgr.recordTimerTaskError(timerTask, attempt, err)
+}
+
 func (t *timerQueueProcessorBase) isPriorityTaskProcessorEnabled() bool {
 	return t.taskProcessor == nil
 }
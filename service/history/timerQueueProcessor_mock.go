@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	history "go.temporal.io/server/api/history/v1"
 	persistence "go.temporal.io/server/common/persistence"
 )
 
@@ -70,6 +71,20 @@ func (mr *MocktimerQueueProcessorMockRecorder) FailoverNamespace(namespaceIDs in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverNamespace", reflect.TypeOf((*MocktimerQueueProcessor)(nil).FailoverNamespace), namespaceIDs)
 }
 
+// GetQueueStates mocks base method.
+func (m *MocktimerQueueProcessor) GetQueueStates() []*history.QueueState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStates")
+	ret0, _ := ret[0].([]*history.QueueState)
+	return ret0
+}
+
+// GetQueueStates indicates an expected call of GetQueueStates.
+func (mr *MocktimerQueueProcessorMockRecorder) GetQueueStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStates", reflect.TypeOf((*MocktimerQueueProcessor)(nil).GetQueueStates))
+}
+
 // LockTaskProcessing mocks base method.
 func (m *MocktimerQueueProcessor) LockTaskProcessing() {
 	m.ctrl.T.Helper()
@@ -202,6 +202,17 @@ func (t *timerQueueStandbyProcessorImpl) complete(
 	t.timerQueueProcessorBase.complete(timerTask)
 }
 
+func (t *timerQueueStandbyProcessorImpl) recordError(
+	taskInfo *taskInfo,
+	err error,
+) {
+	timerTask, ok := taskInfo.task.(*persistencespb.TimerTaskInfo)
+	if !ok {
+		return
+	}
+	t.timerQueueProcessorBase.recordError(timerTask, taskInfo.attempt, err)
+}
+
 func (t *timerQueueStandbyProcessorImpl) process(
 	ctx context.Context,
 	taskInfo *taskInfo,
@@ -254,7 +254,7 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityTimeoutTask(
 			return nil, err
 		}
 
-		err = context.UpdateWorkflowExecutionAsPassive(now)
+		err = context.UpdateWorkflowExecutionAsPassive(ctx, now)
 		return nil, err
 	}
 
@@ -437,7 +437,7 @@ func (t *timerQueueStandbyTaskExecutor) processTimer(
 		}
 	}()
 
-	mutableState, err := loadMutableStateForTimerTask(context, timerTask, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTimerTask(ctx, context, timerTask, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
@@ -96,7 +97,7 @@ func (t *timerQueueTaskExecutorBase) executeDeleteHistoryEventTask(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTimerTask(weContext, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTimerTask(ctx, weContext, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -124,20 +125,21 @@ func (t *timerQueueTaskExecutorBase) executeDeleteHistoryEventTask(
 	// TODO: @ycyang once archival backfill is in place cluster:paused && namespace:enabled should be a nop rather than a delete
 	if archiveHistory {
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupArchiveCount)
-		return t.archiveWorkflow(task, weContext, mutableState, namespaceCacheEntry)
+		return t.archiveWorkflow(ctx, task, weContext, mutableState, namespaceCacheEntry)
 	}
 
 	t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-	return t.deleteWorkflow(task, weContext, mutableState)
+	return t.deleteWorkflow(ctx, task, weContext, mutableState)
 }
 
 func (t *timerQueueTaskExecutorBase) deleteWorkflow(
+	ctx context.Context,
 	task *persistencespb.TimerTaskInfo,
 	workflowContext workflow.Context,
 	msBuilder workflow.MutableState,
 ) error {
 
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowVisibility(ctx, task); err != nil {
 		return err
 	}
 
@@ -160,6 +162,7 @@ func (t *timerQueueTaskExecutorBase) deleteWorkflow(
 }
 
 func (t *timerQueueTaskExecutorBase) archiveWorkflow(
+	ctx context.Context,
 	task *persistencespb.TimerTaskInfo,
 	workflowContext workflow.Context,
 	msBuilder workflow.MutableState,
@@ -190,7 +193,7 @@ func (t *timerQueueTaskExecutorBase) archiveWorkflow(
 		CallerService:        common.HistoryServiceName,
 		AttemptArchiveInline: false, // archive in workflow by default
 	}
-	executionStats, err := workflowContext.LoadExecutionStats()
+	executionStats, err := workflowContext.LoadExecutionStats(ctx)
 	if err == nil && executionStats.HistorySize < int64(t.config.TimerProcessorHistoryArchivalSizeLimit()) {
 		req.AttemptArchiveInline = true
 	}
@@ -212,7 +215,7 @@ func (t *timerQueueTaskExecutorBase) archiveWorkflow(
 
 	// delete visibility record here regardless if it's been archived inline or not
 	// since the entire record is included as part of the archive request.
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowVisibility(ctx, task); err != nil {
 		return err
 	}
 
@@ -277,20 +280,27 @@ func (t *timerQueueTaskExecutorBase) deleteWorkflowHistory(
 		if err != nil {
 			return err
 		}
-		return t.shard.GetExecutionManager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
-			BranchToken: branchToken,
-			ShardID:     t.shard.GetShardID(),
-		})
-
+		ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+		defer cancel()
+		return payloadoffload.DeleteHistoryBranch(
+			ctx,
+			t.shard.GetService().GetPayloadOffloader(),
+			t.shard.GetExecutionManager(),
+			&persistence.DeleteHistoryBranchRequest{
+				BranchToken: branchToken,
+				ShardID:     t.shard.GetShardID(),
+			},
+		)
 	}
 	return backoff.Retry(op, workflow.PersistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
 func (t *timerQueueTaskExecutorBase) deleteWorkflowVisibility(
+	ctx context.Context,
 	task *persistencespb.TimerTaskInfo,
 ) error {
 
-	return t.shard.AddTasks(&persistence.AddTasksRequest{
+	return t.shard.AddTasks(ctx, &persistence.AddTasksRequest{
 		ShardID: t.shard.GetShardID(),
 		// RangeID is set by shard
 		NamespaceID: task.GetNamespaceId(),
@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -38,8 +39,10 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/shard"
@@ -156,12 +159,52 @@ func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_NoErr() {
 	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any()).Return(nil)
 	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{1, 2, 3}, nil)
 
-	err := s.timerQueueTaskExecutorBase.deleteWorkflow(task, s.mockWorkflowExecutionContext, s.mockMutableState)
+	err := s.timerQueueTaskExecutorBase.deleteWorkflow(context.Background(), task, s.mockWorkflowExecutionContext, s.mockMutableState)
+	s.NoError(err)
+}
+
+func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_DeleteOffloadedPayloads() {
+	task := &persistencespb.TimerTaskInfo{
+		NamespaceId:     tests.NamespaceID,
+		WorkflowId:      tests.WorkflowID,
+		RunId:           tests.RunID,
+		ScheduleAttempt: 1,
+		TaskId:          12345,
+		VisibilityTime:  timestamp.TimeNowPtrUtc(),
+	}
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID("tree-id", "branch-id")
+	s.NoError(err)
+
+	uri, err := carchiver.NewURI("file:///tmp/payloads")
+	s.NoError(err)
+	mockPayloadStore := carchiver.NewMockPayloadStore(s.controller)
+	s.mockShard.Resource.PayloadOffloader = payloadoffload.NewOffloader(mockPayloadStore, uri)
+
+	s.mockWorkflowExecutionContext.EXPECT().Clear()
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockExecutionManager.EXPECT().AddTasks(gomock.Any()).Return(nil)
+	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewVisibilityTasks(gomock.Any())
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any())
+
+	s.mockExecutionManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any()).Return(nil)
+	s.mockExecutionManager.EXPECT().DeleteWorkflowExecution(gomock.Any()).Return(nil)
+	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil)
+	s.mockExecutionManager.EXPECT().GetHistoryTree(gomock.Any()).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*persistencespb.HistoryBranch{{TreeId: "tree-id", BranchId: "branch-id"}},
+	}, nil)
+	mockPayloadStore.EXPECT().DeletePrefix(gomock.Any(), uri, "tree-id/").Return(nil)
+	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any()).Return(nil)
+
+	err = s.timerQueueTaskExecutorBase.deleteWorkflow(context.Background(), task, s.mockWorkflowExecutionContext, s.mockMutableState)
 	s.NoError(err)
 }
 
 func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFailed() {
-	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats().Return(&persistencespb.ExecutionStats{
+	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats(gomock.Any()).Return(&persistencespb.ExecutionStats{
 		HistorySize: 1024,
 	}, nil)
 	s.mockWorkflowExecutionContext.EXPECT().Clear()
@@ -188,13 +231,13 @@ func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_NoErr_InlineArchiva
 	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false)
 
 	namespaceCacheEntry := cache.NewNamespaceCacheEntryForTest(&persistencespb.NamespaceInfo{}, &persistencespb.NamespaceConfig{}, false, nil, 0, nil)
-	err := s.timerQueueTaskExecutorBase.archiveWorkflow(&persistencespb.TimerTaskInfo{
+	err := s.timerQueueTaskExecutorBase.archiveWorkflow(context.Background(), &persistencespb.TimerTaskInfo{
 		ScheduleAttempt: 1}, s.mockWorkflowExecutionContext, s.mockMutableState, namespaceCacheEntry)
 	s.NoError(err)
 }
 
 func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_SendSignalErr() {
-	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats().Return(&persistencespb.ExecutionStats{
+	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats(gomock.Any()).Return(&persistencespb.ExecutionStats{
 		HistorySize: 1024 * 1024 * 1024,
 	}, nil)
 
@@ -207,7 +250,7 @@ func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_SendSignalErr() {
 	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false)
 
 	namespaceCacheEntry := cache.NewNamespaceCacheEntryForTest(&persistencespb.NamespaceInfo{}, &persistencespb.NamespaceConfig{}, false, nil, 0, nil)
-	err := s.timerQueueTaskExecutorBase.archiveWorkflow(&persistencespb.TimerTaskInfo{
+	err := s.timerQueueTaskExecutorBase.archiveWorkflow(context.Background(), &persistencespb.TimerTaskInfo{
 		ScheduleAttempt: 1}, s.mockWorkflowExecutionContext, s.mockMutableState, namespaceCacheEntry)
 	s.Error(err)
 }
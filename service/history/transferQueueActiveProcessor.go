@@ -322,6 +322,14 @@ func (t *transferQueueActiveProcessorImpl) complete(
 	t.queueProcessorBase.complete(taskInfo.task)
 }
 
+func (t *transferQueueActiveProcessorImpl) recordError(
+	taskInfo *taskInfo,
+	err error,
+) {
+
+	t.queueProcessorBase.recordError(taskInfo.task, taskInfo.attempt, err)
+}
+
 func (t *transferQueueActiveProcessorImpl) process(
 	ctx context.Context,
 	taskInfo *taskInfo,
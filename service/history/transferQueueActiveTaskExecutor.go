@@ -146,7 +146,7 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -165,12 +165,16 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
+	priority, err := getActivityTaskPriority(mutableState, task.GetScheduleId())
+	if err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, &timeout)
+	return t.pushActivity(task, &timeout, priority)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -192,7 +196,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -262,7 +266,7 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(weContext, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, weContext, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -378,7 +382,7 @@ func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -405,7 +409,7 @@ func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 	// handle workflow cancel itself
 	if task.GetNamespaceId() == task.GetTargetNamespaceId() && task.GetWorkflowId() == task.GetTargetWorkflowId() {
 		// it does not matter if the run ID is a mismatch
-		err = t.requestCancelExternalExecutionFailed(task, context, targetNamespace, task.GetTargetWorkflowId(), task.GetTargetRunId())
+		err = t.requestCancelExternalExecutionFailed(ctx, task, context, targetNamespace, task.GetTargetWorkflowId(), task.GetTargetRunId())
 		if _, ok := err.(*serviceerror.NotFound); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 			return nil
@@ -427,6 +431,7 @@ func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 			return err
 		}
 		return t.requestCancelExternalExecutionFailed(
+			ctx,
 			task,
 			context,
 			targetNamespace,
@@ -442,6 +447,7 @@ func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 
 	// Record ExternalWorkflowExecutionCancelRequested in source execution
 	return t.requestCancelExternalExecutionCompleted(
+		ctx,
 		task,
 		context,
 		targetNamespace,
@@ -469,7 +475,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(weContext, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, weContext, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -500,6 +506,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 	if task.GetNamespaceId() == task.GetTargetNamespaceId() && task.GetWorkflowId() == task.GetTargetWorkflowId() {
 		// it does not matter if the run ID is a mismatch
 		return t.signalExternalExecutionFailed(
+			ctx,
 			task,
 			weContext,
 			targetNamespace,
@@ -523,6 +530,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 			return err
 		}
 		return t.signalExternalExecutionFailed(
+			ctx,
 			task,
 			weContext,
 			targetNamespace,
@@ -538,6 +546,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 	)
 
 	err = t.signalExternalExecutionCompleted(
+		ctx,
 		task,
 		weContext,
 		targetNamespace,
@@ -587,7 +596,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -661,7 +670,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		// Check to see if the error is non-transient, in which case add StartChildWorkflowExecutionFailed
 		// event and complete transfer task by setting the err = nil
 		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
-			err = t.recordStartChildExecutionFailed(task, context, attributes)
+			err = t.recordStartChildExecutionFailed(ctx, task, context, attributes)
 		}
 
 		return err
@@ -671,7 +680,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		tag.WorkflowID(attributes.WorkflowId), tag.WorkflowRunID(childRunID))
 
 	// Child execution is successfully started, record ChildExecutionStartedEvent in parent execution
-	err = t.recordChildExecutionStarted(task, context, attributes, childRunID)
+	err = t.recordChildExecutionStarted(ctx, task, context, attributes, childRunID)
 	if err != nil {
 		return err
 	}
@@ -705,7 +714,7 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 	}
 	defer func() { currentRelease(retError) }()
 
-	currentMutableState, err := loadMutableStateForTransferTask(currentContext, task, t.metricsClient, t.logger)
+	currentMutableState, err := loadMutableStateForTransferTask(ctx, currentContext, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	}
@@ -795,7 +804,7 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 			return err
 		}
 		defer func() { baseRelease(retError) }()
-		baseMutableState, err = loadMutableStateForTransferTask(baseContext, task, t.metricsClient, t.logger)
+		baseMutableState, err = loadMutableStateForTransferTask(ctx, baseContext, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		}
@@ -821,13 +830,14 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 }
 
 func (t *transferQueueActiveTaskExecutor) recordChildExecutionStarted(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	initiatedAttributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string,
 ) error {
 
-	return t.updateWorkflowExecution(context, true,
+	return t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return serviceerror.NewNotFound("Workflow execution already completed.")
@@ -856,12 +866,13 @@ func (t *transferQueueActiveTaskExecutor) recordChildExecutionStarted(
 }
 
 func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	initiatedAttributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
 ) error {
 
-	return t.updateWorkflowExecution(context, true,
+	return t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return serviceerror.NewNotFound("Workflow execution already completed.")
@@ -909,6 +920,7 @@ func (t *transferQueueActiveTaskExecutor) createFirstWorkflowTask(
 }
 
 func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionCompleted(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	targetNamespace string,
@@ -916,7 +928,7 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionComplete
 	targetRunID string,
 ) error {
 
-	err := t.updateWorkflowExecution(context, true,
+	err := t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return &serviceerror.NotFound{Message: "Workflow execution already completed."}
@@ -946,6 +958,7 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionComplete
 }
 
 func (t *transferQueueActiveTaskExecutor) signalExternalExecutionCompleted(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	targetNamespace string,
@@ -954,7 +967,7 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionCompleted(
 	control string,
 ) error {
 
-	err := t.updateWorkflowExecution(context, true,
+	err := t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return &serviceerror.NotFound{Message: "Workflow execution already completed."}
@@ -985,6 +998,7 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionCompleted(
 }
 
 func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionFailed(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	targetNamespace string,
@@ -992,7 +1006,7 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionFailed(
 	targetRunID string,
 ) error {
 
-	err := t.updateWorkflowExecution(context, true,
+	err := t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return &serviceerror.NotFound{Message: "Workflow execution already completed."}
@@ -1023,6 +1037,7 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionFailed(
 }
 
 func (t *transferQueueActiveTaskExecutor) signalExternalExecutionFailed(
+	ctx context.Context,
 	task *persistencespb.TransferTaskInfo,
 	context workflow.Context,
 	targetNamespace string,
@@ -1031,7 +1046,7 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionFailed(
 	control string,
 ) error {
 
-	err := t.updateWorkflowExecution(context, true,
+	err := t.updateWorkflowExecution(ctx, context, true,
 		func(mutableState workflow.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return &serviceerror.NotFound{Message: "Workflow is not running."}
@@ -1063,12 +1078,13 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionFailed(
 }
 
 func (t *transferQueueActiveTaskExecutor) updateWorkflowExecution(
+	ctx context.Context,
 	context workflow.Context,
 	createWorkflowTask bool,
 	action func(builder workflow.MutableState) error,
 ) error {
 
-	mutableState, err := context.LoadWorkflowExecution()
+	mutableState, err := context.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -1085,7 +1101,7 @@ func (t *transferQueueActiveTaskExecutor) updateWorkflowExecution(
 		}
 	}
 
-	return context.UpdateWorkflowExecutionAsActive(t.shard.GetTimeSource().Now())
+	return context.UpdateWorkflowExecutionAsActive(ctx, t.shard.GetTimeSource().Now())
 }
 
 func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionWithRetry(
@@ -30,9 +30,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
@@ -57,6 +60,7 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskProcessing()
 		UnlockTaskPrrocessing()
+		GetQueueStates() []*historyspb.QueueState
 	}
 
 	taskFilter func(task queueTaskInfo) (bool, error)
@@ -268,6 +272,25 @@ func (t *transferQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// GetQueueStates returns the in-memory state of the active and standby transfer queue processors
+func (t *transferQueueProcessorImpl) GetQueueStates() []*historyspb.QueueState {
+	activeState := t.activeTaskProcessor.getQueueState()
+	activeState.Category = enumsspb.TASK_CATEGORY_TRANSFER
+	activeState.ClusterName = t.currentClusterName
+
+	var standbyStates []*historyspb.QueueState
+	for clusterName, standbyTaskProcessor := range t.standbyTaskProcessors {
+		standbyState := standbyTaskProcessor.getQueueState()
+		standbyState.Category = enumsspb.TASK_CATEGORY_TRANSFER
+		standbyState.ClusterName = clusterName
+		standbyStates = append(standbyStates, standbyState)
+	}
+	sort.Slice(standbyStates, func(i, j int) bool {
+		return standbyStates[i].ClusterName < standbyStates[j].ClusterName
+	})
+	return append([]*historyspb.QueueState{activeState}, standbyStates...)
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	history "go.temporal.io/server/api/history/v1"
 	persistence "go.temporal.io/server/common/persistence"
 )
 
@@ -70,6 +71,20 @@ func (mr *MocktransferQueueProcessorMockRecorder) FailoverNamespace(namespaceIDs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverNamespace", reflect.TypeOf((*MocktransferQueueProcessor)(nil).FailoverNamespace), namespaceIDs)
 }
 
+// GetQueueStates mocks base method.
+func (m *MocktransferQueueProcessor) GetQueueStates() []*history.QueueState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStates")
+	ret0, _ := ret[0].([]*history.QueueState)
+	return ret0
+}
+
+// GetQueueStates indicates an expected call of GetQueueStates.
+func (mr *MocktransferQueueProcessorMockRecorder) GetQueueStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStates", reflect.TypeOf((*MocktransferQueueProcessor)(nil).GetQueueStates))
+}
+
 // LockTaskProcessing mocks base method.
 func (m *MocktransferQueueProcessor) LockTaskProcessing() {
 	m.ctrl.T.Helper()
@@ -190,6 +190,14 @@ func (t *transferQueueStandbyProcessorImpl) complete(
 	t.queueProcessorBase.complete(taskInfo.task)
 }
 
+func (t *transferQueueStandbyProcessorImpl) recordError(
+	taskInfo *taskInfo,
+	err error,
+) {
+
+	t.queueProcessorBase.recordError(taskInfo.task, taskInfo.attempt, err)
+}
+
 func (t *transferQueueStandbyProcessorImpl) process(
 	ctx context.Context,
 	taskInfo *taskInfo,
@@ -138,7 +138,11 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedId == common.EmptyEventID {
-			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout), nil
+			priority, err := getActivityTaskPriority(mutableState, transferTask.GetScheduleId())
+			if err != nil {
+				return nil, err
+			}
+			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout, priority), nil
 		}
 
 		return nil, nil
@@ -437,7 +441,7 @@ func (t *transferQueueStandbyTaskExecutor) processTransfer(
 		}
 	}()
 
-	mutableState, err := loadMutableStateForTransferTask(context, transferTask, t.metricsClient, t.logger)
+	mutableState, err := loadMutableStateForTransferTask(ctx, context, transferTask, t.metricsClient, t.logger)
 	if err != nil || mutableState == nil {
 		return err
 	}
@@ -472,6 +476,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 	return t.transferQueueTaskExecutorBase.pushActivity(
 		task.(*persistencespb.TransferTaskInfo),
 		&timeout,
+		pushActivityInfo.priority,
 	)
 }
 
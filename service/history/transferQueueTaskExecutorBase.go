@@ -99,6 +99,7 @@ func (t *transferQueueTaskExecutorBase) getNamespaceIDAndWorkflowExecution(
 func (t *transferQueueTaskExecutorBase) pushActivity(
 	task *persistencespb.TransferTaskInfo,
 	activityScheduleToStartTimeout *time.Duration,
+	priority int32,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -121,6 +122,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		},
 		ScheduleId:             task.GetScheduleId(),
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
+		Priority:               priority,
 	})
 
 	return err
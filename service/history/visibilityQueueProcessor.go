@@ -31,6 +31,7 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
@@ -48,6 +49,7 @@ type (
 	visibilityQueueProcessor interface {
 		common.Daemon
 		NotifyNewTask(visibilityTasks []persistence.Task)
+		GetQueueStates() []*historyspb.QueueState
 	}
 
 	updateVisibilityAckLevel func(ackLevel int64) error
@@ -229,6 +231,14 @@ func (t *visibilityQueueProcessorImpl) NotifyNewTask(
 	}
 }
 
+// GetQueueStates returns the in-memory state of the visibility queue processor
+func (t *visibilityQueueProcessorImpl) GetQueueStates() []*historyspb.QueueState {
+	state := t.getQueueState()
+	state.Category = enumsspb.TASK_CATEGORY_VISIBILITY
+	state.ClusterName = t.clusterName
+	return []*historyspb.QueueState{state}
+}
+
 func (t *visibilityQueueProcessorImpl) completeTaskLoop() {
 	timer := time.NewTimer(t.config.VisibilityProcessorCompleteTaskInterval())
 	defer timer.Stop()
@@ -307,6 +317,14 @@ func (t *visibilityQueueProcessorImpl) complete(
 	t.queueProcessorBase.complete(taskInfo.task)
 }
 
+func (t *visibilityQueueProcessorImpl) recordError(
+	taskInfo *taskInfo,
+	err error,
+) {
+
+	t.queueProcessorBase.recordError(taskInfo.task, taskInfo.attempt, err)
+}
+
 func (t *visibilityQueueProcessorImpl) process(
 	ctx context.Context,
 	taskInfo *taskInfo,
@@ -146,7 +146,7 @@ func (t *visibilityQueueTaskExecutor) processStartOrUpsertExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := weContext.LoadWorkflowExecution()
+	mutableState, err := weContext.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -327,7 +327,7 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err := weContext.LoadWorkflowExecution()
+	mutableState, err := weContext.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
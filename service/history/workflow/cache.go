@@ -55,6 +55,8 @@ type (
 		logger           log.Logger
 		metricsClient    metrics.Client
 		config           *configs.Config
+
+		cancelSubscriptions []func()
 	}
 )
 
@@ -71,15 +73,40 @@ func NewCache(shard shard.Context) *Cache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	metricsClient := shard.GetMetricsClient()
+	opts.EvictedFunc = func(_ interface{}) {
+		metricsClient.IncCounter(metrics.HistoryCacheGetOrCreateScope, metrics.CacheEvictionCounter)
+	}
+	// the size of the entries is always tracked, so the byte limit can be enabled without a shard restart
+	opts.SizeFunc = func(value interface{}) int64 {
+		return value.(Context).GetApproximateSize()
+	}
 
-	return &Cache{
-		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
+	// the limits are set by the subscriptions below, which are invoked with the current values right away
+	historyCache := &Cache{
+		Cache:            cache.New(0, opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
 		logger:           log.With(shard.GetLogger(), tag.ComponentHistoryCache),
-		metricsClient:    shard.GetMetricsClient(),
+		metricsClient:    metricsClient,
 		config:           config,
 	}
+	historyCache.cancelSubscriptions = []func(){
+		config.HistoryCacheMaxSize(func(maxSize int) {
+			historyCache.SetMaxSize(maxSize)
+		}),
+		config.HistoryCacheMaxSizeBytes(func(maxSizeInBytes int) {
+			historyCache.SetMaxBytes(int64(maxSizeInBytes))
+		}),
+	}
+	return historyCache
+}
+
+// Stop stops reacting to the changes of the cache size limits
+func (c *Cache) Stop() {
+	for _, cancel := range c.cancelSubscriptions {
+		cancel()
+	}
 }
 
 func (c *Cache) GetOrCreateCurrentWorkflowExecution(
@@ -189,6 +216,7 @@ func (c *Cache) makeReleaseFunc(
 		if atomic.CompareAndSwapInt32(&status, cacheNotReleased, cacheReleased) {
 			if rec := recover(); rec != nil {
 				context.Clear()
+				context.UpdateApproximateSize()
 				context.Unlock(caller)
 				c.Release(key)
 				panic(rec)
@@ -197,9 +225,14 @@ func (c *Cache) makeReleaseFunc(
 					// TODO see issue #668, there are certain type or errors which can bypass the clear
 					context.Clear()
 				}
+				// size is re-evaluated while the context is still locked, so the cache doesn't have to
+				context.UpdateApproximateSize()
 				context.Unlock(caller)
 				c.Release(key)
 			}
+			if bytes := c.Bytes(); bytes > 0 {
+				c.metricsClient.RecordDistribution(metrics.HistoryCacheGetOrCreateScope, metrics.CacheSizeBytes, int(bytes))
+			}
 		}
 	}
 }
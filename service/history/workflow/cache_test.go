@@ -98,6 +98,7 @@ func (s *historyCacheSuite) TestHistoryCacheBasic() {
 		RunId:      uuid.New(),
 	}
 	mockMS1 := NewMockMutableState(s.controller)
+	mockMS1.EXPECT().GetApproximatePersistedSize().Return(0).AnyTimes()
 	context, release, err := s.cache.GetOrCreateWorkflowExecution(
 		ctx.Background(),
 		namespaceID,
@@ -133,7 +134,7 @@ func (s *historyCacheSuite) TestHistoryCacheBasic() {
 }
 
 func (s *historyCacheSuite) TestHistoryCachePinning() {
-	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(1)
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertySubscriptionFn(1)
 	namespaceID := "test_namespace_id"
 	s.cache = NewCache(s.mockShard)
 	we := commonpb.WorkflowExecution{
@@ -187,8 +188,63 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheMaxSizeInBytes() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertySubscriptionFn(20)
+	s.mockShard.GetConfig().HistoryCacheMaxSizeBytes = dynamicconfig.GetIntPropertySubscriptionFn(2000)
+	namespaceID := "test_namespace_id"
+	s.cache = NewCache(s.mockShard)
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wf-cache-test-max-size-in-bytes",
+		RunId:      uuid.New(),
+	}
+	we2 := commonpb.WorkflowExecution{
+		WorkflowId: "wf-cache-test-max-size-in-bytes",
+		RunId:      uuid.New(),
+	}
+
+	context, release, err := s.cache.GetOrCreateWorkflowExecution(
+		ctx.Background(),
+		namespaceID,
+		we,
+		CallerTypeAPI,
+	)
+	s.Nil(err)
+	mockMS := NewMockMutableState(s.controller)
+	// mutable state is sized once on release, not every time the cache evaluates the context
+	mockMS.EXPECT().GetApproximatePersistedSize().Return(1000).Times(1)
+	context.(*ContextImpl).MutableState = mockMS
+	release(nil)
+	s.True(s.cache.Bytes() > 1000)
+
+	// Size of the second context is re-evaluated on release and the first one is evicted to fit
+	context2, release2, err := s.cache.GetOrCreateWorkflowExecution(
+		ctx.Background(),
+		namespaceID,
+		we2,
+		CallerTypeAPI,
+	)
+	s.Nil(err)
+	mockMS2 := NewMockMutableState(s.controller)
+	mockMS2.EXPECT().GetApproximatePersistedSize().Return(1500).Times(1)
+	context2.(*ContextImpl).MutableState = mockMS2
+	release2(nil)
+	s.Equal(1, s.cache.Size())
+	s.True(s.cache.Bytes() > 1500)
+
+	newContext, release, err := s.cache.GetOrCreateWorkflowExecution(
+		ctx.Background(),
+		namespaceID,
+		we,
+		CallerTypeAPI,
+	)
+	s.Nil(err)
+	s.False(context == newContext)
+	s.Nil(newContext.(*ContextImpl).MutableState)
+	release(nil)
+}
+
 func (s *historyCacheSuite) TestHistoryCacheClear() {
-	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertySubscriptionFn(20)
 	namespaceID := "test_namespace_id"
 	s.cache = NewCache(s.mockShard)
 	we := commonpb.WorkflowExecution{
@@ -205,7 +261,9 @@ func (s *historyCacheSuite) TestHistoryCacheClear() {
 	s.Nil(err)
 	// since we are just testing whether the release function will clear the cache
 	// all we need is a fake MutableState
-	context.(*ContextImpl).MutableState = NewMockMutableState(s.controller)
+	mockMS := NewMockMutableState(s.controller)
+	mockMS.EXPECT().GetApproximatePersistedSize().Return(0).AnyTimes()
+	context.(*ContextImpl).MutableState = mockMS
 	release(nil)
 
 	// since last time, the release function receive a nil error
@@ -234,7 +292,7 @@ func (s *historyCacheSuite) TestHistoryCacheClear() {
 }
 
 func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
-	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertySubscriptionFn(20)
 	namespaceID := "test_namespace_id"
 	s.cache = NewCache(s.mockShard)
 	we := commonpb.WorkflowExecution{
@@ -258,7 +316,9 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 		s.Nil(context.(*ContextImpl).MutableState)
 		// since we are just testing whether the release function will clear the cache
 		// all we need is a fake MutableState
-		context.(*ContextImpl).MutableState = NewMockMutableState(s.controller)
+		mockMS := NewMockMutableState(s.controller)
+		mockMS.EXPECT().GetApproximatePersistedSize().Return(0).AnyTimes()
+		context.(*ContextImpl).MutableState = mockMS
 		release(errors.New("some random error message"))
 		waitGroup.Done()
 	}
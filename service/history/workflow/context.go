@@ -29,6 +29,7 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -69,9 +70,9 @@ type (
 		GetNamespaceID() string
 		GetExecution() *commonpb.WorkflowExecution
 
-		LoadWorkflowExecution() (MutableState, error)
-		LoadWorkflowExecutionForReplication(incomingVersion int64) (MutableState, error)
-		LoadExecutionStats() (*persistencespb.ExecutionStats, error)
+		LoadWorkflowExecution(ctx context.Context) (MutableState, error)
+		LoadWorkflowExecutionForReplication(ctx context.Context, incomingVersion int64) (MutableState, error)
+		LoadExecutionStats(ctx context.Context) (*persistencespb.ExecutionStats, error)
 		Clear()
 
 		Lock(ctx context.Context, caller CallerType) error
@@ -79,16 +80,20 @@ type (
 
 		GetHistorySize() int64
 		SetHistorySize(size int64)
+		GetApproximateSize() int64
+		UpdateApproximateSize()
 
 		ReapplyEvents(
 			eventBatches []*persistence.WorkflowEvents,
 		) error
 
 		PersistWorkflowEvents(
+			ctx context.Context,
 			workflowEvents *persistence.WorkflowEvents,
 		) (int64, error)
 
 		CreateWorkflowExecution(
+			ctx context.Context,
 			now time.Time,
 			createMode persistence.CreateWorkflowMode,
 			prevRunID string,
@@ -98,6 +103,7 @@ type (
 			historySize int64,
 		) error
 		ConflictResolveWorkflowExecution(
+			ctx context.Context,
 			now time.Time,
 			conflictResolveMode persistence.ConflictResolveWorkflowMode,
 			resetMutableState MutableState,
@@ -108,22 +114,27 @@ type (
 			currentTransactionPolicy *TransactionPolicy,
 		) error
 		UpdateWorkflowExecutionAsActive(
+			ctx context.Context,
 			now time.Time,
 		) error
 		UpdateWorkflowExecutionWithNewAsActive(
+			ctx context.Context,
 			now time.Time,
 			newContext Context,
 			newMutableState MutableState,
 		) error
 		UpdateWorkflowExecutionAsPassive(
+			ctx context.Context,
 			now time.Time,
 		) error
 		UpdateWorkflowExecutionWithNewAsPassive(
+			ctx context.Context,
 			now time.Time,
 			newContext Context,
 			newMutableState MutableState,
 		) error
 		UpdateWorkflowExecutionWithNew(
+			ctx context.Context,
 			now time.Time,
 			updateMode persistence.UpdateWorkflowMode,
 			newContext Context,
@@ -149,6 +160,8 @@ type (
 		mutex        locks.PriorityMutex
 		MutableState MutableState
 		stats        *persistencespb.ExecutionStats
+
+		approximateSize int64
 	}
 )
 
@@ -164,7 +177,7 @@ func NewContext(
 	shard shard.Context,
 	logger log.Logger,
 ) *ContextImpl {
-	return &ContextImpl{
+	workflowContext := &ContextImpl{
 		namespaceID:       namespaceID,
 		workflowExecution: execution,
 		shard:             shard,
@@ -179,6 +192,8 @@ func NewContext(
 			HistorySize: 0,
 		},
 	}
+	workflowContext.UpdateApproximateSize()
+	return workflowContext
 }
 
 func (c *ContextImpl) Lock(
@@ -240,8 +255,24 @@ func (c *ContextImpl) SetHistorySize(size int64) {
 	c.stats.HistorySize = size
 }
 
-func (c *ContextImpl) LoadExecutionStats() (*persistencespb.ExecutionStats, error) {
-	_, err := c.LoadWorkflowExecution()
+// GetApproximateSize returns approximate size of workflow context in bytes as of the last UpdateApproximateSize.
+// It doesn't require the lock, therefore the history cache can size the context while the context is in use.
+func (c *ContextImpl) GetApproximateSize() int64 {
+	return atomic.LoadInt64(&c.approximateSize)
+}
+
+// UpdateApproximateSize re-evaluates approximate size of workflow context, which is dominated
+// by size of mutable state if it is loaded. It must be called while holding the lock.
+func (c *ContextImpl) UpdateApproximateSize() {
+	size := int64(len(c.namespaceID) + c.workflowExecution.Size())
+	if c.MutableState != nil {
+		size += int64(c.MutableState.GetApproximatePersistedSize())
+	}
+	atomic.StoreInt64(&c.approximateSize, size)
+}
+
+func (c *ContextImpl) LoadExecutionStats(ctx context.Context) (*persistencespb.ExecutionStats, error) {
+	_, err := c.LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -249,6 +280,7 @@ func (c *ContextImpl) LoadExecutionStats() (*persistencespb.ExecutionStats, erro
 }
 
 func (c *ContextImpl) LoadWorkflowExecutionForReplication(
+	ctx context.Context,
 	incomingVersion int64,
 ) (MutableState, error) {
 
@@ -258,7 +290,7 @@ func (c *ContextImpl) LoadWorkflowExecutionForReplication(
 	}
 
 	if c.MutableState == nil {
-		response, err := getWorkflowExecutionWithRetry(c.shard, &persistence.GetWorkflowExecutionRequest{
+		response, err := getWorkflowExecutionWithRetry(ctx, c.shard, &persistence.GetWorkflowExecutionRequest{
 			ShardID:     c.shard.GetShardID(),
 			NamespaceID: c.namespaceID,
 			Execution:   c.workflowExecution,
@@ -316,6 +348,7 @@ func (c *ContextImpl) LoadWorkflowExecutionForReplication(
 		}
 
 		if err = c.UpdateWorkflowExecutionAsActive(
+			ctx,
 			c.shard.GetTimeSource().Now(),
 		); err != nil {
 			return nil, err
@@ -332,7 +365,7 @@ func (c *ContextImpl) LoadWorkflowExecutionForReplication(
 	return c.MutableState, nil
 }
 
-func (c *ContextImpl) LoadWorkflowExecution() (MutableState, error) {
+func (c *ContextImpl) LoadWorkflowExecution(ctx context.Context) (MutableState, error) {
 
 	namespaceEntry, err := c.shard.GetNamespaceCache().GetNamespaceByID(c.namespaceID)
 	if err != nil {
@@ -340,7 +373,7 @@ func (c *ContextImpl) LoadWorkflowExecution() (MutableState, error) {
 	}
 
 	if c.MutableState == nil {
-		response, err := getWorkflowExecutionWithRetry(c.shard, &persistence.GetWorkflowExecutionRequest{
+		response, err := getWorkflowExecutionWithRetry(ctx, c.shard, &persistence.GetWorkflowExecutionRequest{
 			ShardID:     c.shard.GetShardID(),
 			NamespaceID: c.namespaceID,
 			Execution:   c.workflowExecution,
@@ -387,6 +420,7 @@ func (c *ContextImpl) LoadWorkflowExecution() (MutableState, error) {
 	}
 
 	if err = c.UpdateWorkflowExecutionAsActive(
+		ctx,
 		c.shard.GetTimeSource().Now(),
 	); err != nil {
 		return nil, err
@@ -404,12 +438,14 @@ func (c *ContextImpl) LoadWorkflowExecution() (MutableState, error) {
 }
 
 func (c *ContextImpl) PersistWorkflowEvents(
+	ctx context.Context,
 	workflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
-	return PersistWorkflowEvents(c.shard, workflowEvents)
+	return PersistWorkflowEvents(ctx, c.shard, workflowEvents)
 }
 
 func (c *ContextImpl) CreateWorkflowExecution(
+	ctx context.Context,
 	_ time.Time,
 	createMode persistence.CreateWorkflowMode,
 	prevRunID string,
@@ -442,6 +478,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 	}
 
 	if err := createWorkflowExecutionWithRetry(
+		ctx,
 		c.shard,
 		createRequest,
 	); err != nil {
@@ -455,6 +492,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 }
 
 func (c *ContextImpl) ConflictResolveWorkflowExecution(
+	ctx context.Context,
 	now time.Time,
 	conflictResolveMode persistence.ConflictResolveWorkflowMode,
 	resetMutableState MutableState,
@@ -536,6 +574,7 @@ func (c *ContextImpl) ConflictResolveWorkflowExecution(
 	}
 
 	if resetWorkflowSizeDiff, newWorkflowSizeDiff, currentWorkflowSizeDiff, err := c.transaction.ConflictResolveWorkflowExecution(
+		ctx,
 		conflictResolveMode,
 		resetWorkflow,
 		resetWorkflowEventsSeq,
@@ -563,16 +602,18 @@ func (c *ContextImpl) ConflictResolveWorkflowExecution(
 }
 
 func (c *ContextImpl) UpdateWorkflowExecutionAsActive(
+	ctx context.Context,
 	now time.Time,
 ) error {
 
 	// We only perform this check on active cluster for the namespace
-	forceTerminate, err := c.enforceSizeCheck()
+	forceTerminate, err := c.enforceSizeCheck(ctx)
 	if err != nil {
 		return err
 	}
 
 	if err := c.UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		nil,
@@ -594,12 +635,14 @@ func (c *ContextImpl) UpdateWorkflowExecutionAsActive(
 }
 
 func (c *ContextImpl) UpdateWorkflowExecutionWithNewAsActive(
+	ctx context.Context,
 	now time.Time,
 	newContext Context,
 	newMutableState MutableState,
 ) error {
 
 	return c.UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		newContext,
@@ -610,10 +653,12 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNewAsActive(
 }
 
 func (c *ContextImpl) UpdateWorkflowExecutionAsPassive(
+	ctx context.Context,
 	now time.Time,
 ) error {
 
 	return c.UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		nil,
@@ -624,12 +669,14 @@ func (c *ContextImpl) UpdateWorkflowExecutionAsPassive(
 }
 
 func (c *ContextImpl) UpdateWorkflowExecutionWithNewAsPassive(
+	ctx context.Context,
 	now time.Time,
 	newContext Context,
 	newMutableState MutableState,
 ) error {
 
 	return c.UpdateWorkflowExecutionWithNew(
+		ctx,
 		now,
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		newContext,
@@ -640,6 +687,7 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNewAsPassive(
 }
 
 func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
+	ctx context.Context,
 	now time.Time,
 	updateMode persistence.UpdateWorkflowMode,
 	newContext Context,
@@ -703,6 +751,7 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 	}
 
 	if currentWorkflowSizeDiff, newWorkflowSizeDiff, err := c.transaction.UpdateWorkflowExecution(
+		ctx,
 		updateMode,
 		currentWorkflow,
 		currentWorkflowEventsSeq,
@@ -904,7 +953,9 @@ func (c *ContextImpl) ReapplyEvents(
 }
 
 // Returns true if execution is forced terminated
-func (c *ContextImpl) enforceSizeCheck() (bool, error) {
+func (c *ContextImpl) enforceSizeCheck(
+	ctx context.Context,
+) (bool, error) {
 	historySizeLimitWarn := c.config.HistorySizeLimitWarn(c.GetNamespace())
 	historySizeLimitError := c.config.HistorySizeLimitError(c.GetNamespace())
 	historyCountLimitWarn := c.config.HistoryCountLimitWarn(c.GetNamespace())
@@ -927,7 +978,7 @@ func (c *ContextImpl) enforceSizeCheck() (bool, error) {
 		c.Clear()
 
 		// Reload mutable state
-		mutableState, err := c.LoadWorkflowExecution()
+		mutableState, err := c.LoadWorkflowExecution(ctx)
 		if err != nil {
 			return false, err
 		}
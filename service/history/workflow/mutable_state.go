@@ -147,6 +147,7 @@ type (
 		GetCurrentVersion() int64
 		GetExecutionInfo() *persistencespb.WorkflowExecutionInfo
 		GetExecutionState() *persistencespb.WorkflowExecutionState
+		GetApproximatePersistedSize() int
 		GetInFlightWorkflowTask() (*WorkflowTaskInfo, bool)
 		GetPendingWorkflowTask() (*WorkflowTaskInfo, bool)
 		GetLastFirstEventIDTxnID() (int64, int64)
@@ -424,6 +424,34 @@ func (e *MutableStateImpl) GetExecutionState() *persistencespb.WorkflowExecution
 	return e.executionState
 }
 
+// GetApproximatePersistedSize returns approximate size of mutable state in bytes
+// based on size of its persistence protos.
+func (e *MutableStateImpl) GetApproximatePersistedSize() int {
+	size := e.executionInfo.Size() + e.executionState.Size()
+	for _, ai := range e.pendingActivityInfoIDs {
+		size += ai.Size()
+	}
+	for _, ti := range e.pendingTimerInfoIDs {
+		size += ti.Size()
+	}
+	for _, ci := range e.pendingChildExecutionInfoIDs {
+		size += ci.Size()
+	}
+	for _, rci := range e.pendingRequestCancelInfoIDs {
+		size += rci.Size()
+	}
+	for _, si := range e.pendingSignalInfoIDs {
+		size += si.Size()
+	}
+	for requestID := range e.pendingSignalRequestedIDs {
+		size += len(requestID)
+	}
+	for _, event := range e.bufferEventsInDB {
+		size += event.Size()
+	}
+	return size
+}
+
 func (e *MutableStateImpl) FlushBufferedEvents() {
 	if e.HasInFlightWorkflowTask() {
 		return
@@ -25,6 +25,8 @@
 package workflow
 
 import (
+	"context"
+
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -32,12 +34,14 @@ import (
 type (
 	Transaction interface {
 		CreateWorkflowExecution(
+			ctx context.Context,
 			createMode persistence.CreateWorkflowMode,
 			newWorkflowSnapshot *persistence.WorkflowSnapshot,
 			newWorkflowEventsSeq []*persistence.WorkflowEvents,
 		) (int64, error)
 
 		ConflictResolveWorkflowExecution(
+			ctx context.Context,
 			conflictResolveMode persistence.ConflictResolveWorkflowMode,
 			resetWorkflowSnapshot *persistence.WorkflowSnapshot,
 			resetWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -48,6 +52,7 @@ type (
 		) (int64, int64, int64, error)
 
 		UpdateWorkflowExecution(
+			ctx context.Context,
 			updateMode persistence.UpdateWorkflowMode,
 			currentWorkflowMutation *persistence.WorkflowMutation,
 			currentWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -25,6 +25,8 @@
 package workflow
 
 import (
+	"context"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -58,6 +60,7 @@ func NewTransaction(
 }
 
 func (t *TransactionImpl) CreateWorkflowExecution(
+	ctx context.Context,
 	createMode persistence.CreateWorkflowMode,
 	newWorkflowSnapshot *persistence.WorkflowSnapshot,
 	newWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -66,7 +69,7 @@ func (t *TransactionImpl) CreateWorkflowExecution(
 	newWorkflowHistorySizeDiff := int64(0)
 
 	for _, workflowEvents := range newWorkflowEventsSeq {
-		eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+		eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 		if err != nil {
 			return 0, err
 		}
@@ -74,7 +77,7 @@ func (t *TransactionImpl) CreateWorkflowExecution(
 	}
 	newWorkflowSnapshot.ExecutionInfo.ExecutionStats.HistorySize += newWorkflowHistorySizeDiff
 
-	if err := createWorkflowExecutionWithRetry(t.shard, &persistence.CreateWorkflowExecutionRequest{
+	if err := createWorkflowExecutionWithRetry(ctx, t.shard, &persistence.CreateWorkflowExecutionRequest{
 		ShardID: t.shard.GetShardID(),
 		// RangeID , this is set by shard context
 		Mode:                createMode,
@@ -93,6 +96,7 @@ func (t *TransactionImpl) CreateWorkflowExecution(
 }
 
 func (t *TransactionImpl) ConflictResolveWorkflowExecution(
+	ctx context.Context,
 	conflictResolveMode persistence.ConflictResolveWorkflowMode,
 	resetWorkflowSnapshot *persistence.WorkflowSnapshot,
 	resetWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -107,7 +111,7 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 	currentWorkflowHistorySizeDiff := int64(0)
 
 	for _, workflowEvents := range resetWorkflowEventsSeq {
-		eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+		eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 		if err != nil {
 			return 0, 0, 0, err
 		}
@@ -117,7 +121,7 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 
 	if newWorkflowSnapshot != nil {
 		for _, workflowEvents := range newWorkflowEventsSeq {
-			eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+			eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 			if err != nil {
 				return 0, 0, 0, err
 			}
@@ -128,7 +132,7 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 
 	if currentWorkflowMutation != nil {
 		for _, workflowEvents := range currentWorkflowEventsSeq {
-			eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+			eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 			if err != nil {
 				return 0, 0, 0, err
 			}
@@ -137,7 +141,7 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 		currentWorkflowMutation.ExecutionInfo.ExecutionStats.HistorySize += currentWorkflowHistorySizeDiff
 	}
 
-	if err := t.shard.ConflictResolveWorkflowExecution(&persistence.ConflictResolveWorkflowExecutionRequest{
+	if err := t.shard.ConflictResolveWorkflowExecution(ctx, &persistence.ConflictResolveWorkflowExecutionRequest{
 		ShardID: t.shard.GetShardID(),
 		// RangeID , this is set by shard context
 		Mode:                    conflictResolveMode,
@@ -166,6 +170,7 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 }
 
 func (t *TransactionImpl) UpdateWorkflowExecution(
+	ctx context.Context,
 	updateMode persistence.UpdateWorkflowMode,
 	currentWorkflowMutation *persistence.WorkflowMutation,
 	currentWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -177,7 +182,7 @@ func (t *TransactionImpl) UpdateWorkflowExecution(
 	newWorkflowHistorySizeDiff := int64(0)
 
 	for _, workflowEvents := range currentWorkflowEventsSeq {
-		eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+		eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 		if err != nil {
 			return 0, 0, err
 		}
@@ -187,7 +192,7 @@ func (t *TransactionImpl) UpdateWorkflowExecution(
 
 	if newWorkflowSnapshot != nil {
 		for _, workflowEvents := range newWorkflowEventsSeq {
-			eventsSize, err := PersistWorkflowEvents(t.shard, workflowEvents)
+			eventsSize, err := PersistWorkflowEvents(ctx, t.shard, workflowEvents)
 			if err != nil {
 				return 0, 0, err
 			}
@@ -196,7 +201,7 @@ func (t *TransactionImpl) UpdateWorkflowExecution(
 		newWorkflowSnapshot.ExecutionInfo.ExecutionStats.HistorySize += newWorkflowHistorySizeDiff
 	}
 
-	if err := updateWorkflowExecutionWithRetry(t.shard, &persistence.UpdateWorkflowExecutionRequest{
+	if err := updateWorkflowExecutionWithRetry(ctx, t.shard, &persistence.UpdateWorkflowExecutionRequest{
 		ShardID: t.shard.GetShardID(),
 		// RangeID , this is set by shard context
 		Mode:                   updateMode,
@@ -220,6 +225,7 @@ func (t *TransactionImpl) UpdateWorkflowExecution(
 }
 
 func PersistWorkflowEvents(
+	ctx context.Context,
 	shard shard.Context,
 	workflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
@@ -230,12 +236,13 @@ func PersistWorkflowEvents(
 
 	firstEventID := workflowEvents.Events[0].EventId
 	if firstEventID == common.FirstEventID {
-		return persistFirstWorkflowEvents(shard, workflowEvents)
+		return persistFirstWorkflowEvents(ctx, shard, workflowEvents)
 	}
-	return persistNonFirstWorkflowEvents(shard, workflowEvents)
+	return persistNonFirstWorkflowEvents(ctx, shard, workflowEvents)
 }
 
 func persistFirstWorkflowEvents(
+	ctx context.Context,
 	shard shard.Context,
 	workflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
@@ -253,6 +260,7 @@ func persistFirstWorkflowEvents(
 	txnID := workflowEvents.TxnID
 
 	size, err := appendHistoryV2EventsWithRetry(
+		ctx,
 		shard,
 		namespaceID,
 		execution,
@@ -269,6 +277,7 @@ func persistFirstWorkflowEvents(
 }
 
 func persistNonFirstWorkflowEvents(
+	ctx context.Context,
 	shard shard.Context,
 	workflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
@@ -288,6 +297,7 @@ func persistNonFirstWorkflowEvents(
 	txnID := workflowEvents.TxnID
 
 	size, err := appendHistoryV2EventsWithRetry(
+		ctx,
 		shard,
 		namespaceID,
 		execution,
@@ -303,6 +313,7 @@ func persistNonFirstWorkflowEvents(
 }
 
 func appendHistoryV2EventsWithRetry(
+	ctx context.Context,
 	shard shard.Context,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -312,7 +323,7 @@ func appendHistoryV2EventsWithRetry(
 	resp := 0
 	op := func() error {
 		var err error
-		resp, err = shard.AppendHistoryEvents(request, namespaceID, execution)
+		resp, err = shard.AppendHistoryEvents(ctx, request, namespaceID, execution)
 		return err
 	}
 
@@ -325,12 +336,13 @@ func appendHistoryV2EventsWithRetry(
 }
 
 func createWorkflowExecutionWithRetry(
+	ctx context.Context,
 	shard shard.Context,
 	request *persistence.CreateWorkflowExecutionRequest,
 ) error {
 
 	op := func() error {
-		_, err := shard.CreateWorkflowExecution(request)
+		_, err := shard.CreateWorkflowExecution(ctx, request)
 		return err
 	}
 
@@ -362,6 +374,7 @@ func createWorkflowExecutionWithRetry(
 }
 
 func getWorkflowExecutionWithRetry(
+	ctx context.Context,
 	shard shard.Context,
 	request *persistence.GetWorkflowExecutionRequest,
 ) (*persistence.GetWorkflowExecutionResponse, error) {
@@ -369,7 +382,7 @@ func getWorkflowExecutionWithRetry(
 	var resp *persistence.GetWorkflowExecutionResponse
 	op := func() error {
 		var err error
-		resp, err = shard.GetExecutionManager().GetWorkflowExecution(request)
+		resp, err = persistence.ExecutionManagerWithContext(ctx, shard.GetExecutionManager()).GetWorkflowExecution(request)
 
 		return err
 	}
@@ -399,6 +412,7 @@ func getWorkflowExecutionWithRetry(
 }
 
 func updateWorkflowExecutionWithRetry(
+	ctx context.Context,
 	shard shard.Context,
 	request *persistence.UpdateWorkflowExecutionRequest,
 ) error {
@@ -406,7 +420,7 @@ func updateWorkflowExecutionWithRetry(
 	var resp *persistence.UpdateWorkflowExecutionResponse
 	var err error
 	op := func() error {
-		resp, err = shard.UpdateWorkflowExecution(request)
+		resp, err = shard.UpdateWorkflowExecution(ctx, request)
 		return err
 	}
 
@@ -25,13 +25,15 @@
 package history
 
 import (
+	"context"
+
 	"go.temporal.io/server/service/history/workflow"
 )
 
 type workflowContext interface {
 	getContext() workflow.Context
 	getMutableState() workflow.MutableState
-	reloadMutableState() (workflow.MutableState, error)
+	reloadMutableState(ctx context.Context) (workflow.MutableState, error)
 	getReleaseFn() workflow.ReleaseCacheFunc
 	getWorkflowID() string
 	getRunID() string
@@ -67,8 +69,10 @@ func (w *workflowContextImpl) getMutableState() workflow.MutableState {
 	return w.mutableState
 }
 
-func (w *workflowContextImpl) reloadMutableState() (workflow.MutableState, error) {
-	mutableState, err := w.getContext().LoadWorkflowExecution()
+func (w *workflowContextImpl) reloadMutableState(
+	ctx context.Context,
+) (workflow.MutableState, error) {
+	mutableState, err := w.getContext().LoadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
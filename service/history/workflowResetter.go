@@ -230,6 +230,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 	}
 
 	return r.persistToDB(
+		ctx,
 		currentWorkflow,
 		currentWorkflowMutation,
 		currentWorkflowEventsSeq,
@@ -340,6 +341,7 @@ func (r *workflowResetterImpl) reapplyEventsToResetWorkflow(
 }
 
 func (r *workflowResetterImpl) persistToDB(
+	ctx context.Context,
 	currentWorkflow nDCWorkflow,
 	currentWorkflowMutation *persistence.WorkflowMutation,
 	currentWorkflowEventsSeq []*persistence.WorkflowEvents,
@@ -360,6 +362,7 @@ func (r *workflowResetterImpl) persistToDB(
 
 	if currentWorkflowMutation != nil {
 		if currentWorkflowSizeDiff, resetWorkflowSizeDiff, err := r.transaction.UpdateWorkflowExecution(
+			ctx,
 			persistence.UpdateWorkflowModeUpdateCurrent,
 			currentWorkflowMutation,
 			currentWorkflowEventsSeq,
@@ -383,13 +386,14 @@ func (r *workflowResetterImpl) persistToDB(
 
 	var resetHistorySize int64
 	for _, workflowEvents := range resetWorkflowEventsSeq {
-		size, err := resetWorkflow.getContext().PersistWorkflowEvents(workflowEvents)
+		size, err := resetWorkflow.getContext().PersistWorkflowEvents(ctx, workflowEvents)
 		if err != nil {
 			return err
 		}
 		resetHistorySize += size
 	}
 	return resetWorkflow.getContext().CreateWorkflowExecution(
+		ctx,
 		now,
 		persistence.CreateWorkflowModeContinueAsNew,
 		currentRunID,
@@ -636,7 +640,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 		}
 		defer func() { release(retError) }()
 
-		mutableState, err := context.LoadWorkflowExecution()
+		mutableState, err := context.LoadWorkflowExecution(ctx)
 		if err != nil {
 			// no matter what error happen, we need to retry
 			return 0, nil, err
@@ -186,6 +186,7 @@ func (s *workflowResetterSuite) TestPersistToDB_CurrentTerminated() {
 	resetContext.EXPECT().SetHistorySize(resetEventsSize + resetNewEventsSize)
 
 	s.mockTransaction.EXPECT().UpdateWorkflowExecution(
+		gomock.Any(),
 		persistence.UpdateWorkflowModeUpdateCurrent,
 		currentMutation,
 		currentEventsSeq,
@@ -193,7 +194,7 @@ func (s *workflowResetterSuite) TestPersistToDB_CurrentTerminated() {
 		resetEventsSeq,
 	).Return(currentNewEventsSize, resetNewEventsSize, nil)
 
-	err := s.workflowResetter.persistToDB(currentWorkflow, currentMutation, currentEventsSeq, resetWorkflow)
+	err := s.workflowResetter.persistToDB(context.Background(), currentWorkflow, currentMutation, currentEventsSeq, resetWorkflow)
 	s.NoError(err)
 	// persistToDB function is not charged of releasing locks
 	s.False(currentReleaseCalled)
@@ -245,8 +246,9 @@ func (s *workflowResetterSuite) TestPersistToDB_CurrentNotTerminated() {
 		workflow.TransactionPolicyActive,
 	).Return(resetSnapshot, resetEventsSeq, nil)
 	resetContext.EXPECT().GetHistorySize().Return(resetEventsSize).AnyTimes()
-	resetContext.EXPECT().PersistWorkflowEvents(resetEventsSeq[0]).Return(resetNewEventsSize, nil)
+	resetContext.EXPECT().PersistWorkflowEvents(gomock.Any(), resetEventsSeq[0]).Return(resetNewEventsSize, nil)
 	resetContext.EXPECT().CreateWorkflowExecution(
+		gomock.Any(),
 		gomock.Any(),
 		persistence.CreateWorkflowModeContinueAsNew,
 		s.currentRunID,
@@ -256,7 +258,7 @@ func (s *workflowResetterSuite) TestPersistToDB_CurrentNotTerminated() {
 		resetNewEventsSize,
 	).Return(nil)
 
-	err := s.workflowResetter.persistToDB(currentWorkflow, nil, nil, resetWorkflow)
+	err := s.workflowResetter.persistToDB(context.Background(), currentWorkflow, nil, nil, resetWorkflow)
 	s.NoError(err)
 	// persistToDB function is not charged of releasing locks
 	s.False(currentReleaseCalled)
@@ -680,10 +682,12 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_WithConti
 	}, nil)
 
 	resetContext := workflow.NewMockContext(s.controller)
+	resetContext.EXPECT().GetApproximateSize().Return(int64(0)).AnyTimes()
+	resetContext.EXPECT().UpdateApproximateSize().AnyTimes()
 	resetContext.EXPECT().Lock(gomock.Any(), workflow.CallerTypeAPI).Return(nil)
 	resetContext.EXPECT().Unlock(workflow.CallerTypeAPI)
 	resetMutableState := workflow.NewMockMutableState(s.controller)
-	resetContext.EXPECT().LoadWorkflowExecution().Return(resetMutableState, nil)
+	resetContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(resetMutableState, nil)
 	resetMutableState.EXPECT().GetNextEventID().Return(newNextEventID).AnyTimes()
 	resetMutableState.EXPECT().GetCurrentBranchToken().Return(newBranchToken, nil).AnyTimes()
 	resetContextCacheKey := definition.NewWorkflowIdentifier(s.namespaceID, s.workflowID, newRunID)
@@ -214,7 +214,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetInput()),
 		"ScheduleActivityTaskCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -339,7 +339,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandCompleteWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION.String()),
-		attr.GetResult().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetResult()),
 		"CompleteWorkflowExecutionCommandAttributes.Result exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -414,7 +414,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandFailWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_FAIL_WORKFLOW_EXECUTION.String()),
-		attr.GetFailure().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetFailure()),
 		"FailWorkflowExecutionCommandAttributes.Failure exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -655,7 +655,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetInput()),
 		"ContinueAsNewWorkflowExecutionCommandAttributes. Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -766,7 +766,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetInput()),
 		"StartChildWorkflowExecutionCommandAttributes. Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -849,7 +849,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.offloadedSize(attr.GetInput()),
 		"SignalExternalWorkflowExecutionCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
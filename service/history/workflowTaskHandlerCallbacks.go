@@ -326,14 +326,14 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 
 Update_History_Loop:
 	for attempt := 1; attempt <= conditionalRetryCount; attempt++ {
-		msBuilder, err := weContext.LoadWorkflowExecution()
+		msBuilder, err := weContext.LoadWorkflowExecution(ctx)
 		if err != nil {
 			return nil, err
 		}
 		if !msBuilder.IsWorkflowExecutionRunning() {
 			return nil, consts.ErrWorkflowCompleted
 		}
-		executionStats, err := weContext.LoadExecutionStats()
+		executionStats, err := weContext.LoadExecutionStats(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -425,6 +425,8 @@ Update_History_Loop:
 				handler.config.HistorySizeLimitError(namespace),
 				handler.config.HistoryCountLimitWarn(namespace),
 				handler.config.HistoryCountLimitError(namespace),
+				handler.shard.GetService().GetPayloadOffloader(),
+				handler.config.PayloadOffloadThreshold(namespace),
 				completedEvent.GetEventId(),
 				msBuilder,
 				handler.historyEngine.searchAttributesValidator,
@@ -471,7 +473,7 @@ Update_History_Loop:
 				tag.WorkflowID(token.GetWorkflowId()),
 				tag.WorkflowRunID(token.GetRunId()),
 				tag.WorkflowNamespaceID(namespaceID))
-			msBuilder, err = handler.historyEngine.failWorkflowTask(weContext, scheduleID, startedID, wtFailedCause, request)
+			msBuilder, err = handler.historyEngine.failWorkflowTask(ctx, weContext, scheduleID, startedID, wtFailedCause, request)
 			if err != nil {
 				return nil, err
 			}
@@ -522,6 +524,7 @@ Update_History_Loop:
 			continueAsNewExecutionInfo := continueAsNewBuilder.GetExecutionInfo()
 			continueAsNewExecutionState := continueAsNewBuilder.GetExecutionState()
 			updateErr = weContext.UpdateWorkflowExecutionWithNewAsActive(
+				ctx,
 				handler.shard.GetTimeSource().Now(),
 				workflow.NewContext(
 					continueAsNewExecutionInfo.NamespaceId,
@@ -535,7 +538,7 @@ Update_History_Loop:
 				continueAsNewBuilder,
 			)
 		} else {
-			updateErr = weContext.UpdateWorkflowExecutionAsActive(handler.shard.GetTimeSource().Now())
+			updateErr = weContext.UpdateWorkflowExecutionAsActive(ctx, handler.shard.GetTimeSource().Now())
 		}
 
 		if updateErr != nil {
@@ -549,7 +552,7 @@ Update_History_Loop:
 			case *persistence.TransactionSizeLimitError:
 				// must reload mutable state because the first call to updateWorkflowExecutionWithContext or continueAsNewWorkflowExecution
 				// clears mutable state if error is returned
-				msBuilder, err = weContext.LoadWorkflowExecution()
+				msBuilder, err = weContext.LoadWorkflowExecution(ctx)
 				if err != nil {
 					return nil, err
 				}
@@ -565,6 +568,7 @@ Update_History_Loop:
 					return nil, err
 				}
 				if err := weContext.UpdateWorkflowExecutionAsActive(
+					ctx,
 					handler.shard.GetTimeSource().Now(),
 				); err != nil {
 					return nil, err
@@ -38,7 +38,7 @@ type (
 		PersistenceMaxQPS       dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS dynamicconfig.IntPropertyFn
 		SyncMatchWaitDuration   dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
-		RPS                     dynamicconfig.IntPropertyFn
+		RPS                     dynamicconfig.IntPropertySubscriptionFn
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
 
 		// taskQueueManager configuration
@@ -56,9 +56,14 @@ type (
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ResilientSyncMatch           dynamicconfig.BoolPropertyFn
 
+		// task queue priority configuration
+		NumTaskqueuePriorityLevels dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		DefaultTaskPriority        dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PriorityStarvationInterval dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
-		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MinTaskThrottlingBurstSize dynamicconfig.IntPropertySubscriptionFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 
 		// taskWriter configuration
@@ -67,8 +72,8 @@ type (
 
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 
-		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertyFnWithNamespaceFilter
-		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertySubscriptionFnWithNamespaceFilter
+		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertySubscriptionFnWithTaskQueueInfoFilters
 	}
 
 	forwarderConfig struct {
@@ -88,18 +93,22 @@ type (
 		UpdateAckInterval          func() time.Duration
 		IdleTaskqueueCheckInterval func() time.Duration
 		MaxTaskqueueIdleTime       func() time.Duration
-		MinTaskThrottlingBurstSize func() int
+		MinTaskThrottlingBurstSize func(callback func(int)) (cancel func())
 		MaxTaskDeleteBatchSize     func() int
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// priority configuration
+		NumPriorityLevels          func() int
+		DefaultTaskPriority        func() int
+		PriorityStarvationInterval func() int
 
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
-		AdminNamespaceToPartitionDispatchRate func() float64
+		AdminNamespaceToPartitionDispatchRate func(callback func(float64)) (cancel func())
 		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
-		AdminNamespaceTaskQueueToPartitionDispatchRate func() float64
+		AdminNamespaceTaskQueueToPartitionDispatchRate func(callback func(float64)) (cancel func())
 
 		// ResilientSyncMatch enables or disables sync-matching while
 		// persistence is unavailable
@@ -113,14 +122,14 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
 		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS, 0),
 		SyncMatchWaitDuration:           dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingSyncMatchWaitDuration, 200*time.Millisecond),
-		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
+		RPS:                             dc.SubscribeIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
 		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
 		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
 		IdleTaskqueueCheckInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingIdleTaskqueueCheckInterval, 5*time.Minute),
 		MaxTaskqueueIdleTime:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MaxTaskqueueIdleTime, 5*time.Minute),
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
-		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
+		MinTaskThrottlingBurstSize:      dc.SubscribeIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
@@ -133,9 +142,12 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ResilientSyncMatch:              dc.GetBoolProperty(dynamicconfig.ResilientSyncMatch, false),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		NumTaskqueuePriorityLevels:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueuePriorityLevels, 1),
+		DefaultTaskPriority:             dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingDefaultTaskPriority, 1),
+		PriorityStarvationInterval:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPriorityStarvationInterval, 10),
 
-		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
-		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
+		AdminNamespaceToPartitionDispatchRate:          dc.SubscribeFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
+		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.SubscribeFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
 	}
 }
 
@@ -170,8 +182,8 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		MaxTaskqueueIdleTime: func() time.Duration {
 			return config.MaxTaskqueueIdleTime(namespace, taskQueueName, taskType)
 		},
-		MinTaskThrottlingBurstSize: func() int {
-			return config.MinTaskThrottlingBurstSize(namespace, taskQueueName, taskType)
+		MinTaskThrottlingBurstSize: func(callback func(int)) func() {
+			return config.MinTaskThrottlingBurstSize(namespace, taskQueueName, taskType, callback)
 		},
 		SyncMatchWaitDuration: func() time.Duration {
 			return config.SyncMatchWaitDuration(namespace, taskQueueName, taskType)
@@ -190,11 +202,21 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		},
 		NumWritePartitions: writePartition,
 		NumReadPartitions:  readPartition,
-		AdminNamespaceToPartitionDispatchRate: func() float64 {
-			return config.AdminNamespaceToPartitionDispatchRate(namespace)
+		NumPriorityLevels: func() int {
+			levels := config.NumTaskqueuePriorityLevels(namespace, id.GetRoot(), taskType)
+			return common.MinInt(maxTaskQueuePriorityLevels, common.MaxInt(1, levels))
+		},
+		DefaultTaskPriority: func() int {
+			return config.DefaultTaskPriority(namespace, id.GetRoot(), taskType)
+		},
+		PriorityStarvationInterval: func() int {
+			return config.PriorityStarvationInterval(namespace, id.GetRoot(), taskType)
+		},
+		AdminNamespaceToPartitionDispatchRate: func(callback func(float64)) func() {
+			return config.AdminNamespaceToPartitionDispatchRate(namespace, callback)
 		},
-		AdminNamespaceTaskQueueToPartitionDispatchRate: func() float64 {
-			return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace, taskQueueName, taskType)
+		AdminNamespaceTaskQueueToPartitionDispatchRate: func(callback func(float64)) func() {
+			return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace, taskQueueName, taskType, callback)
 		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
@@ -25,9 +25,15 @@
 package configs
 
 import (
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/quotas"
 )
 
+const (
+	// rateBurstRatio is the ratio of the burst to the rate of incoming requests
+	rateBurstRatio = 2
+)
+
 var (
 	APIToPriority = map[string]int{
 		"AddActivityTask":           0,
@@ -46,12 +52,23 @@ var (
 	}
 )
 
+// NewPriorityRateLimiter returns a rate limiter for incoming requests whose
+// rate follows the subscribed RPS and is updated as soon as the RPS changes.
+// The returned cancel function stops following the RPS
 func NewPriorityRateLimiter(
-	rateFn quotas.RateFn,
-) quotas.RequestRateLimiter {
+	rps dynamicconfig.IntPropertySubscriptionFn,
+) (quotas.RequestRateLimiter, func()) {
 	rateLimiters := make(map[int]quotas.RateLimiter)
+	var priorityRateLimiters []*quotas.RateLimiterImpl
 	for priority := range APIPriorities {
-		rateLimiters[priority] = quotas.NewDefaultIncomingDynamicRateLimiter(rateFn)
+		rateLimiter := quotas.NewRateLimiter(0, 0)
+		rateLimiters[priority] = rateLimiter
+		priorityRateLimiters = append(priorityRateLimiters, rateLimiter)
 	}
-	return quotas.NewPriorityRateLimiter(APIToPriority, rateLimiters)
+	cancel := rps(func(rps int) {
+		for _, rateLimiter := range priorityRateLimiters {
+			rateLimiter.SetRateBurst(float64(rps), rateBurstRatio*rps)
+		}
+	})
+	return quotas.NewPriorityRateLimiter(APIToPriority, rateLimiters), cancel
 }
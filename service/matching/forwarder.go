@@ -155,6 +155,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.name,
+			Priority:               task.event.Data.GetPriority(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -169,6 +170,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.name,
+			Priority:               task.event.Data.GetPriority(),
 		})
 	default:
 		return errInvalidTaskQueueType
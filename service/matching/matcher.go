@@ -26,10 +26,12 @@ package matching
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
 )
@@ -40,8 +42,10 @@ import (
 type TaskMatcher struct {
 	config *taskQueueConfig
 
-	// synchronous task channel to match producer/consumer
-	taskC chan *internalTask
+	// synchronous task channels to match producer/consumer, one for each
+	// dispatch priority level of the task queue, highest priority first.
+	// Channels of the priority levels not in use are nil
+	taskC [maxTaskQueuePriorityLevels]chan *internalTask
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when namespace is
@@ -54,10 +58,19 @@ type TaskMatcher struct {
 	dynamicBurst quotas.DynamicBurst
 	// rateLimiter that limits the rate at which tasks can be dispatched to consumers
 	rateLimiter quotas.RateLimiter
+	// minTaskThrottlingBurstSize is the lower bound of the dynamic burst,
+	// kept up to date by a dynamic config subscription
+	minTaskThrottlingBurstSize quotas.DynamicBurst
+	// cancelSubscriptions cancels the dynamic config subscriptions of the matcher
+	cancelSubscriptions []func()
 
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // namespace metric scope
 	numPartitions func() int           // number of task queue partitions
+
+	// pollCount is the number of polls served, used to let every
+	// PriorityStarvationInterval-th poll prefer a lower priority level
+	pollCount int64
 }
 
 const (
@@ -71,30 +84,44 @@ const (
 func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scopeFunc func() metrics.Scope) *TaskMatcher {
 	dynamicRate := quotas.NewDynamicRate(defaultTaskDispatchRPS)
 	dynamicBurst := quotas.NewDynamicBurst(int(defaultTaskDispatchRPS))
+	taskQueueLimiter := quotas.NewRateLimiter(defaultTaskDispatchRPS, int(defaultTaskDispatchRPS))
+	namespaceLimiter := quotas.NewRateLimiter(defaultTaskDispatchRPS, int(defaultTaskDispatchRPS))
 	limiter := quotas.NewMultiRateLimiter([]quotas.RateLimiter{
 		quotas.NewDynamicRateLimiter(
 			dynamicRate.RateFn(),
 			dynamicBurst.BurstFn(),
 			defaultTaskDispatchRPSTTL,
 		),
-		quotas.NewDefaultOutgoingDynamicRateLimiter(
-			config.AdminNamespaceTaskQueueToPartitionDispatchRate,
-		),
-		quotas.NewDefaultOutgoingDynamicRateLimiter(
-			config.AdminNamespaceToPartitionDispatchRate,
-		),
+		taskQueueLimiter,
+		namespaceLimiter,
 	})
-	return &TaskMatcher{
-		config:        config,
-		dynamicRate:   dynamicRate,
-		dynamicBurst:  dynamicBurst,
-		rateLimiter:   limiter,
-		scope:         scopeFunc,
-		fwdr:          fwdr,
-		taskC:         make(chan *internalTask),
-		queryTaskC:    make(chan *internalTask),
-		numPartitions: config.NumReadPartitions,
+	tm := &TaskMatcher{
+		config:                     config,
+		dynamicRate:                dynamicRate,
+		dynamicBurst:               dynamicBurst,
+		rateLimiter:                limiter,
+		minTaskThrottlingBurstSize: quotas.NewDynamicBurst(0),
+		scope:                      scopeFunc,
+		fwdr:                       fwdr,
+		queryTaskC:                 make(chan *internalTask),
+		numPartitions:              config.NumReadPartitions,
+	}
+	tm.cancelSubscriptions = []func(){
+		config.MinTaskThrottlingBurstSize(func(burst int) {
+			tm.minTaskThrottlingBurstSize.Store(burst)
+		}),
+		config.AdminNamespaceTaskQueueToPartitionDispatchRate(func(rate float64) {
+			taskQueueLimiter.SetRateBurst(rate, int(rate))
+		}),
+		config.AdminNamespaceToPartitionDispatchRate(func(rate float64) {
+			namespaceLimiter.SetRateBurst(rate, int(rate))
+		}),
+	}
+	// the number of priority levels is fixed for the lifetime of the matcher
+	for i := 0; i < config.NumPriorityLevels(); i++ {
+		tm.taskC[i] = make(chan *internalTask)
 	}
+	return tm
 }
 
 // Offer offers a task to a potential consumer (poller)
@@ -135,7 +162,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskChan(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -170,7 +197,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskChan(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -230,10 +257,12 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 		return err
 	}
 
+	taskC := tm.taskChan(task)
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -243,7 +272,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
@@ -256,7 +285,7 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					cancel()
 					return nil
 				case <-childCtx.Done():
@@ -306,7 +335,7 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	}
 
 	burst := int(rate)
-	minTaskThrottlingBurstSize := tm.config.MinTaskThrottlingBurstSize()
+	minTaskThrottlingBurstSize := tm.minTaskThrottlingBurstSize.Load()
 	if burst < minTaskThrottlingBurstSize {
 		burst = minTaskThrottlingBurstSize
 	}
@@ -315,6 +344,13 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	tm.dynamicBurst.Store(burst)
 }
 
+// Stop cancels the dynamic config subscriptions of the matcher
+func (tm *TaskMatcher) Stop() {
+	for _, cancel := range tm.cancelSubscriptions {
+		cancel()
+	}
+}
+
 // Rate returns the current rate at which tasks are dispatched
 func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
@@ -323,7 +359,7 @@ func (tm *TaskMatcher) Rate() float64 {
 func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
 	taskC, queryTaskC := tm.taskC, tm.queryTaskC
 	if queryOnly {
-		taskC = nil
+		taskC = [maxTaskQueuePriorityLevels]chan *internalTask{}
 	}
 
 	// We want to effectively do a prioritized select, but Go select is random
@@ -344,14 +380,15 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	default:
 	}
 
-	// 2. taskC and queryTaskC
-	select {
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
+	// 2. taskC (in priority order) and queryTaskC
+	for _, priority := range tm.pollOrder(queryOnly) {
+		select {
+		case task := <-taskC[priority]:
+			return tm.polledTask(task), nil
+		default:
 		}
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
+	}
+	select {
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskQueueCounter)
@@ -364,12 +401,18 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	case <-ctx.Done():
 		tm.scope().IncCounter(metrics.PollTimeoutPerTaskQueueCounter)
 		return nil, ErrNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		}
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
+	// one case for each of the maxTaskQueuePriorityLevels levels,
+	// the channels of the levels not in use are nil and never ready
+	case task := <-taskC[0]:
+		return tm.polledTask(task), nil
+	case task := <-taskC[1]:
+		return tm.polledTask(task), nil
+	case task := <-taskC[2]:
+		return tm.polledTask(task), nil
+	case task := <-taskC[3]:
+		return tm.polledTask(task), nil
+	case task := <-taskC[4]:
+		return tm.polledTask(task), nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskQueueCounter)
@@ -384,6 +427,51 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	}
 }
 
+func (tm *TaskMatcher) polledTask(task *internalTask) *internalTask {
+	if task.responseC != nil {
+		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
+	}
+	tm.scope().IncCounter(metrics.PollSuccessPerTaskQueueCounter)
+	return task
+}
+
+// pollOrder returns the order in which the priority levels are checked for a task by a poll.
+// Levels are checked from the highest priority to the lowest except for every
+// PriorityStarvationInterval-th poll, which starts at one of the lower levels (in a round
+// robin fashion) so that a steady stream of high priority tasks does not starve them
+func (tm *TaskMatcher) pollOrder(queryOnly bool) []int {
+	levels := tm.numPriorityLevels()
+	if queryOnly || levels == 1 {
+		return []int{0}
+	}
+
+	start := 0
+	count := atomic.AddInt64(&tm.pollCount, 1)
+	if interval := int64(tm.config.PriorityStarvationInterval()); interval > 0 && count%interval == 0 {
+		start = 1 + int((count/interval)%int64(levels-1))
+	}
+
+	order := make([]int, 0, levels)
+	for i := 0; i < levels; i++ {
+		order = append(order, (start+i)%levels)
+	}
+	return order
+}
+
+// taskChan returns the channel to offer the task on, based on the task priority
+func (tm *TaskMatcher) taskChan(task *internalTask) chan *internalTask {
+	priority := common.MinInt(task.priority(), tm.numPriorityLevels())
+	return tm.taskC[priority-1]
+}
+
+func (tm *TaskMatcher) numPriorityLevels() int {
+	levels := 0
+	for levels < maxTaskQueuePriorityLevels && tm.taskC[levels] != nil {
+		levels++
+	}
+	return levels
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return nil
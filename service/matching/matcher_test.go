@@ -117,6 +117,35 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	t.True(syncMatch)
 }
 
+func (t *MatcherTestSuite) TestPriorityMatch() {
+	cfg := *t.cfg
+	cfg.NumPriorityLevels = func() int { return 3 }
+	cfg.PriorityStarvationInterval = func() int { return 3 }
+	matcher := newTaskMatcher(&cfg, nil, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+
+	// two backlog tasks waiting on the highest and the lowest priority levels each
+	for _, priority := range []int32{3, 1, 3, 1} {
+		info := randomTaskInfo()
+		info.Data.Priority = priority
+		task := newInternalTask(info, nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		go func() {
+			_ = matcher.MustOffer(context.Background(), task)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	var priorities []int
+	for i := 0; i < 4; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		task, err := matcher.Poll(ctx)
+		cancel()
+		t.NoError(err)
+		priorities = append(priorities, task.priority())
+	}
+	// every third poll prefers the lower priority levels
+	t.Equal([]int{1, 1, 3, 3}, priorities)
+}
+
 func (t *MatcherTestSuite) TestRemoteSyncMatch() {
 	t.testRemoteSyncMatch(enumsspb.TASK_SOURCE_HISTORY)
 }
@@ -275,6 +275,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		ExpiryTime:  expirationTime,
 		CreateTime:  now,
+		Priority:    addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -327,6 +328,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		CreateTime:  now,
 		ExpiryTime:  expirationTime,
+		Priority:    addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
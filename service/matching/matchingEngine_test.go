@@ -648,7 +648,7 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	mgrImpl, ok := mgr.(*taskQueueManagerImpl)
 	s.True(ok)
 
-	mgrImpl.matcher.config.MinTaskThrottlingBurstSize = func() int { return 0 }
+	mgrImpl.matcher.minTaskThrottlingBurstSize.Store(0)
 	mgrImpl.matcher.rateLimiter = quotas.NewRateLimiter(
 		defaultTaskDispatchRPS,
 		defaultTaskDispatchRPS,
@@ -859,7 +859,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 	s.NoError(err)
 
 	mgrImpl := mgr.(*taskQueueManagerImpl)
-	mgrImpl.matcher.config.MinTaskThrottlingBurstSize = func() int { return 0 }
+	mgrImpl.matcher.minTaskThrottlingBurstSize.Store(0)
 	mgrImpl.matcher.rateLimiter = quotas.NewRateLimiter(
 		defaultTaskDispatchRPS,
 		defaultTaskDispatchRPS,
@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/matching/configs"
 )
 
@@ -54,7 +55,8 @@ type Service struct {
 	handler *Handler
 	config  *Config
 
-	server *grpc.Server
+	server            *grpc.Server
+	cancelRateLimiter func()
 }
 
 // NewService builds a new matching service
@@ -73,6 +75,7 @@ func NewService(
 		func(
 			persistenceBean persistenceClient.Bean,
 			searchAttributesProvider searchattribute.Provider,
+			searchAttributesMapper searchattribute.Mapper,
 			logger log.Logger,
 		) (visibility.VisibilityManager, error) {
 			return nil, nil
@@ -88,8 +91,9 @@ func NewService(
 		metrics.MatchingAPIMetricsScopes(),
 		logger,
 	)
+	rateLimiter, cancelRateLimiter := configs.NewPriorityRateLimiter(serviceConfig.RPS)
 	rateLimiterInterceptor := interceptor.NewRateLimitInterceptor(
-		configs.NewPriorityRateLimiter(func() float64 { return float64(serviceConfig.RPS()) }),
+		rateLimiter,
 		map[string]int{},
 	)
 
@@ -101,6 +105,7 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerInterceptor(common.MatchingServiceName),
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
@@ -110,11 +115,12 @@ func NewService(
 	)
 
 	return &Service{
-		Resource: serviceResource,
-		status:   common.DaemonStatusInitialized,
-		config:   serviceConfig,
-		server:   grpc.NewServer(grpcServerOptions...),
-		handler:  NewHandler(serviceResource, serviceConfig),
+		Resource:          serviceResource,
+		status:            common.DaemonStatusInitialized,
+		config:            serviceConfig,
+		server:            grpc.NewServer(grpcServerOptions...),
+		cancelRateLimiter: cancelRateLimiter,
+		handler:           NewHandler(serviceResource, serviceConfig),
 	}, nil
 }
 
@@ -155,6 +161,7 @@ func (s *Service) Stop() {
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
 	s.server.Stop()
+	s.cancelRateLimiter()
 
 	s.handler.Stop()
 	s.Resource.Stop()
@@ -119,6 +119,14 @@ func (task *internalTask) isForwarded() bool {
 	return task.forwardedFrom != ""
 }
 
+// priority returns the dispatch priority level of the task, 1 being the highest
+func (task *internalTask) priority() int {
+	if task.event == nil || task.event.Data.GetPriority() < 1 {
+		return 1
+	}
+	return int(task.event.Data.GetPriority())
+}
+
 func (task *internalTask) workflowExecution() *commonpb.WorkflowExecution {
 	switch {
 	case task.event != nil:
@@ -142,6 +142,10 @@ type (
 		shutdownCh           chan struct{} // Delivers stop to the pump that populates taskBuffer
 		signalFatalProblem   func(id *taskQueueID)
 		startGate            *sync.WaitGroup
+		// priorityQueues are the backlogs of the lower priority levels of the task queue,
+		// starting with priority level 2. They share the matcher of this task queue manager
+		// and are started and stopped along with it
+		priorityQueues []*taskQueueManagerImpl
 	}
 )
 
@@ -157,6 +161,18 @@ func withIDBlockAllocator(ibl idBlockAllocator) taskQueueManagerOpt {
 	}
 }
 
+// withPriorityParent makes the task queue manager own the backlog of a lower priority
+// level of the parent task queue manager
+func withPriorityParent(parent *taskQueueManagerImpl) taskQueueManagerOpt {
+	return func(tqm *taskQueueManagerImpl) {
+		tqm.matcher.Stop()
+		tqm.matcher = parent.matcher
+		tqm.signalFatalProblem = func(*taskQueueID) {
+			parent.signalFatalProblem(parent.taskQueueID)
+		}
+	}
+}
+
 func newTaskQueueManager(
 	e *matchingEngineImpl,
 	taskQueue *taskQueueID,
@@ -170,7 +186,7 @@ func newTaskQueueManager(
 		return nil, err
 	}
 
-	db := newTaskQueueDB(e.taskManager, taskQueue.namespaceID, taskQueue.name, taskQueue.taskType, taskQueueKind, e.logger)
+	db := newTaskQueueDB(e.taskManager, taskQueue.namespaceID, taskQueue.persistenceName(), taskQueue.taskType, taskQueueKind, e.logger)
 
 	startGate := &sync.WaitGroup{}
 	startGate.Add(1)
@@ -218,6 +234,17 @@ func newTaskQueueManager(
 	for _, opt := range opts {
 		opt(tlMgr)
 	}
+
+	if taskQueue.priority == 0 && taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		for priority := 2; priority <= tlMgr.matcher.numPriorityLevels(); priority++ {
+			pq, err := newTaskQueueManager(e, newTaskQueuePriorityID(taskQueue, priority), taskQueueKind, config, withPriorityParent(tlMgr))
+			if err != nil {
+				tlMgr.matcher.Stop()
+				return nil, err
+			}
+			tlMgr.priorityQueues = append(tlMgr.priorityQueues, pq.(*taskQueueManagerImpl))
+		}
+	}
 	return tlMgr, nil
 }
 
@@ -245,11 +272,19 @@ func (c *taskQueueManagerImpl) Start() error {
 	if err != nil && c.errShouldUnload(err) {
 		return err
 	}
+	for _, pq := range c.priorityQueues {
+		if err := pq.Start(); err != nil {
+			return err
+		}
+	}
 	if err == nil {
 		idblock = c.rangeIDToTaskIDBlock(state.rangeID)
 		c.taskAckManager.setAckLevel(state.ackLevel)
 	}
-	c.liveness.Start()
+	if c.taskQueueID.priority == 0 {
+		// the backlogs of the lower priority levels are kept alive by their parent
+		c.liveness.Start()
+	}
 	c.taskWriter.Start(idblock)
 	c.taskReader.Start()
 	c.startGate.Done()
@@ -274,6 +309,17 @@ func (c *taskQueueManagerImpl) Stop() {
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	for _, pq := range c.priorityQueues {
+		pq.Stop()
+	}
+	if c.taskQueueID.priority != 0 {
+		// the backlog of a lower priority level cannot be dispatched on its own,
+		// make sure the parent task queue manager is unloaded as well
+		c.signalFatalProblem(c.taskQueueID)
+	} else {
+		// the matcher is shared with the lower priority levels, only its owner stops it
+		c.matcher.Stop()
+	}
 	c.engine.removeTaskQueueManager(c.taskQueueID)
 	c.logger.Info("", tag.LifeCycleStopped)
 }
@@ -291,6 +337,10 @@ func (c *taskQueueManagerImpl) AddTask(
 		c.liveness.markAlive(time.Now())
 	}
 
+	if pq := c.priorityQueue(params.taskInfo); pq != nil {
+		return pq.AddTask(ctx, params)
+	}
+
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 		td := params.taskInfo
@@ -384,7 +434,7 @@ func (c *taskQueueManagerImpl) GetTask(
 	}
 
 	task.namespace = c.namespace()
-	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	task.backlogCountHint = c.backlogCountHint()
 	return task, nil
 }
 
@@ -442,7 +492,7 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	response.TaskQueueStatus = &taskqueuepb.TaskQueueStatus{
 		ReadLevel:        c.taskAckManager.getReadLevel(),
 		AckLevel:         c.taskAckManager.getAckLevel(),
-		BacklogCountHint: c.taskAckManager.getBacklogCountHint(),
+		BacklogCountHint: c.backlogCountHint(),
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIdBlock: &taskqueuepb.TaskIdBlock{
 			StartId: taskIDBlock.start,
@@ -508,6 +558,29 @@ func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskIn
 	c.taskGC.Run(ackLevel)
 }
 
+// priorityQueue resolves the dispatch priority of the task and returns the task queue manager
+// owning the backlog of that priority level. Returns nil if the task belongs to the backlog
+// of this task queue manager
+func (c *taskQueueManagerImpl) priorityQueue(taskInfo *persistencespb.TaskInfo) *taskQueueManagerImpl {
+	if taskInfo.GetPriority() < 1 {
+		taskInfo.Priority = int32(c.config.DefaultTaskPriority())
+	}
+	index := common.MinInt(int(taskInfo.GetPriority()), len(c.priorityQueues)+1) - 2
+	if index < 0 {
+		return nil
+	}
+	return c.priorityQueues[index]
+}
+
+// backlogCountHint returns the approximate number of tasks in the backlogs of all priority levels
+func (c *taskQueueManagerImpl) backlogCountHint() int64 {
+	count := c.taskAckManager.getBacklogCountHint()
+	for _, pq := range c.priorityQueues {
+		count += pq.taskAckManager.getBacklogCountHint()
+	}
+	return count
+}
+
 func (c *taskQueueManagerImpl) renewLeaseWithRetry(fsb fatalSignalBehavior) (taskQueueState, error) {
 	var newState taskQueueState
 	op := func() (err error) {
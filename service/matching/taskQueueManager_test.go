@@ -408,6 +408,34 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
 }
 
+func TestPriorityQueues(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.NumTaskqueuePriorityLevels = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(3)
+	cfg.DefaultTaskPriority = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	tlm, err := createTestTaskQueueManagerWithConfigAndStart(controller, cfg, false)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(tlm.priorityQueues))
+	for i, pq := range tlm.priorityQueues {
+		require.Equal(t, i+2, pq.taskQueueID.priority)
+		require.Equal(t, tlm.taskQueueID.name, pq.taskQueueID.name)
+		require.NotEqual(t, tlm.taskQueueID.persistenceName(), pq.taskQueueID.persistenceName())
+		require.True(t, tlm.matcher == pq.matcher)
+		require.Empty(t, pq.priorityQueues)
+	}
+
+	taskInfo := &persistencespb.TaskInfo{}
+	require.True(t, tlm.priorityQueue(taskInfo) == tlm.priorityQueues[0])
+	require.Equal(t, int32(2), taskInfo.GetPriority())
+	require.Nil(t, tlm.priorityQueue(&persistencespb.TaskInfo{Priority: 1}))
+	require.True(t, tlm.priorityQueue(&persistencespb.TaskInfo{Priority: 3}) == tlm.priorityQueues[1])
+	// priorities beyond the lowest level fall into the lowest level
+	require.True(t, tlm.priorityQueue(&persistencespb.TaskInfo{Priority: 10}) == tlm.priorityQueues[1])
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskQueueManagerImpl) {
 	go tlm.taskReader.dispatchBufferedTasks()
 	go tlm.taskReader.getTasksPump()
@@ -39,6 +39,9 @@ type (
 		qualifiedTaskQueueName
 		namespaceID string
 		taskType    enumspb.TaskQueueType
+		// priority is the dispatch priority level of the backlog, zero
+		// for the backlog of the highest (default) priority level
+		priority int
 	}
 	// qualifiedTaskQueueName refers to the fully qualified task queue name
 	qualifiedTaskQueueName struct {
@@ -51,6 +54,10 @@ type (
 const (
 	// taskQueuePartitionPrefix is the required naming prefix for any task queue partition other than partition 0
 	taskQueuePartitionPrefix = "/_sys/"
+	// taskQueuePriorityPrefix is the naming prefix for the priority level of a task queue backlog
+	taskQueuePriorityPrefix = "p"
+	// maxTaskQueuePriorityLevels is the max number of dispatch priority levels of a task queue
+	maxTaskQueuePriorityLevels = 5
 )
 
 // newTaskQueueName returns a fully qualified task queue name.
@@ -143,6 +150,28 @@ func newTaskQueueID(namespaceID string, taskQueueName string, taskType enumspb.T
 	}, nil
 }
 
+// newTaskQueuePriorityID returns the taskQueueID of the backlog for the given priority level of a task queue
+func newTaskQueuePriorityID(id *taskQueueID, priority int) *taskQueueID {
+	priorityID := *id
+	priorityID.priority = priority
+	if priority <= 1 {
+		priorityID.priority = 0
+	}
+	return &priorityID
+}
+
+// persistenceName returns the name under which the backlog of this task queue is persisted.
+// The backlog of the highest priority level is persisted under the task queue name and
+// the backlogs of the other levels are persisted under names of the form
+//
+//     /_sys/[original-name]/[partitionID]/p[priority]
+func (tid *taskQueueID) persistenceName() string {
+	if tid.priority == 0 {
+		return tid.name
+	}
+	return fmt.Sprintf("%v%v/%v/%v%v", taskQueuePartitionPrefix, tid.baseName, tid.partition, taskQueuePriorityPrefix, tid.priority)
+}
+
 func (tid *taskQueueID) String() string {
 	var b bytes.Buffer
 	b.WriteString("[")
@@ -154,6 +183,10 @@ func (tid *taskQueueID) String() string {
 	} else {
 		b.WriteString("workflow")
 	}
+	if tid.priority != 0 {
+		b.WriteString("priority=")
+		b.WriteString(strconv.Itoa(tid.priority))
+	}
 	b.WriteString("]")
 	return b.String()
 }
@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
 )
 
 func TestValidTaskQueueNames(t *testing.T) {
@@ -119,3 +120,29 @@ func TestInvalidTaskqueueNames(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskQueuePersistenceName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		priority int
+		output   string
+	}{
+		{"list0", 0, "list0"},
+		{"list0", 1, "list0"},
+		{"list0", 2, "/_sys/list0/0/p2"},
+		{"/_sys/list0/1", 0, "/_sys/list0/1"},
+		{"/_sys/list0/1", 3, "/_sys/list0/1/p3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name+"#"+strconv.Itoa(tc.priority), func(t *testing.T) {
+			id, err := newTaskQueueID("namespace-id", tc.name, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+			require.NoError(t, err)
+			priorityID := newTaskQueuePriorityID(id, tc.priority)
+			require.Equal(t, tc.name, priorityID.name)
+			require.Equal(t, tc.output, priorityID.persistenceName())
+			_, err = newTaskQueueName(priorityID.persistenceName())
+			require.Equal(t, tc.output != tc.name, err != nil)
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -110,7 +111,7 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			err = temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
 		}
 	}()
-	err = container.HistoryV2Manager.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+	err = payloadoffload.DeleteHistoryBranch(ctx, container.PayloadOffloader, container.HistoryV2Manager, &persistence.DeleteHistoryBranchRequest{
 		BranchToken: request.BranchToken,
 		ShardID:     request.ShardID,
 	})
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -252,6 +253,7 @@ func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryabl
 		Logger:           s.logger,
 		MetricsClient:    s.metricsClient,
 		HistoryV2Manager: s.mockExecutionMgr,
+		PayloadOffloader: payloadoffload.NewDisabledOffloader(),
 	}
 	env := s.NewTestActivityEnvironment()
 	s.registerWorkflows(env)
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -64,6 +65,7 @@ type (
 		NamespaceCache   cache.NamespaceCache
 		Config           *Config
 		ArchiverProvider provider.ArchiverProvider
+		PayloadOffloader payloadoffload.Offloader
 	}
 
 	// Config for ClientWorker
@@ -100,6 +100,7 @@ func (s *Batcher) Start() error {
 	batchWorker := worker.New(s.svcClient, BatcherTaskQueueName, workerOpts)
 	batchWorker.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
 	batchWorker.RegisterActivityWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
+	batchWorker.RegisterActivityWithOptions(BatchPageActivity, activity.RegisterOptions{Name: batchPageActivityName})
 
 	return batchWorker.Start()
 }
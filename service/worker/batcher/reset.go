@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func resetWorkflow(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	workflowTaskFinishEventID, err := getResetEventID(ctx, client, batchParams.Namespace, workflowID, runID, batchParams.ResetParams)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:                    batchParams.Reason,
+		WorkflowTaskFinishEventId: workflowTaskFinishEventID,
+		RequestId:                 requestID,
+	})
+	return err
+}
+
+func getResetEventID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+	resetParams ResetParams,
+) (int64, error) {
+	switch resetParams.ResetType {
+	case ResetTypeFirstWorkflowTask:
+		return getWorkflowTaskEventID(ctx, client, namespace, workflowID, runID, true)
+	case ResetTypeLastWorkflowTask:
+		return getWorkflowTaskEventID(ctx, client, namespace, workflowID, runID, false)
+	case ResetTypeBadBinary:
+		return getBadBinaryWorkflowTaskEventID(ctx, client, namespace, workflowID, runID, resetParams.BadBinaryChecksum)
+	default:
+		return 0, fmt.Errorf("not supported reset type: %v", resetParams.ResetType)
+	}
+}
+
+// getWorkflowTaskEventID returns the ID of the first (or last) completed workflow task event,
+// or the ID of the event after the scheduled workflow task if no workflow task has completed.
+func getWorkflowTaskEventID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+	first bool,
+) (int64, error) {
+	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: pageSize,
+	}
+
+	var workflowTaskEventID int64
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			switch e.GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+				workflowTaskEventID = e.GetEventId()
+				if first {
+					return workflowTaskEventID, nil
+				}
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+				if !first || workflowTaskEventID == 0 {
+					workflowTaskEventID = e.GetEventId() + 1
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+
+	if workflowTaskEventID == 0 {
+		return 0, serviceerror.NewInvalidArgument("unable to find any scheduled or completed workflow task")
+	}
+	return workflowTaskEventID, nil
+}
+
+// getBadBinaryWorkflowTaskEventID returns the ID of the first workflow task completed by the given binary.
+func getBadBinaryWorkflowTaskEventID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (int64, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	point := findResetPoint(resp.GetWorkflowExecutionInfo().GetAutoResetPoints(), binaryChecksum)
+	if point == nil {
+		// workflow did not run the bad binary, nothing to reset
+		return 0, serviceerror.NewNotFound(fmt.Sprintf("unable to find resettable point for binary checksum %v", binaryChecksum))
+	}
+	return point.GetFirstWorkflowTaskCompletedId(), nil
+}
+
+func findResetPoint(
+	resetPoints *workflowpb.ResetPoints,
+	binaryChecksum string,
+) *workflowpb.ResetPointInfo {
+	now := time.Now().UTC()
+	for _, p := range resetPoints.GetPoints() {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		expireTime := timestamp.TimeValue(p.GetExpireTime())
+		if !expireTime.IsZero() && now.After(expireTime) {
+			// reset point has expired and history may already be deleted
+			continue
+		}
+		return p
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	resetSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		mockClient *workflowservicemock.MockWorkflowServiceClient
+	}
+)
+
+func TestResetSuite(t *testing.T) {
+	s := new(resetSuite)
+	suite.Run(t, s)
+}
+
+func (s *resetSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+}
+
+func (s *resetSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *resetSuite) TestValidateParams_Reset() {
+	params := BatchParams{
+		Namespace: "test-namespace",
+		Query:     "WorkflowType = 'test-workflow-type'",
+		Reason:    "test-reason",
+		BatchType: BatchTypeReset,
+	}
+	s.Error(validateParams(params))
+
+	params.ResetParams.ResetType = ResetTypeLastWorkflowTask
+	s.NoError(validateParams(params))
+
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.Error(validateParams(params))
+
+	params.ResetParams.BadBinaryChecksum = "test-checksum"
+	s.NoError(validateParams(params))
+}
+
+func (s *resetSuite) TestGetWorkflowTaskEventID() {
+	history := &historypb.History{
+		Events: []*historypb.HistoryEvent{
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+			{EventId: 4, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+			{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+			{EventId: 6, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			{EventId: 7, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+			{EventId: 8, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+			{EventId: 9, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+			{EventId: 10, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		},
+	}
+	s.mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&workflowservice.GetWorkflowExecutionHistoryResponse{
+		History: history,
+	}, nil).Times(2)
+
+	eventID, err := getWorkflowTaskEventID(context.Background(), s.mockClient, "test-namespace", "test-workflow-id", "test-run-id", true)
+	s.NoError(err)
+	s.Equal(int64(4), eventID)
+
+	eventID, err = getWorkflowTaskEventID(context.Background(), s.mockClient, "test-namespace", "test-workflow-id", "test-run-id", false)
+	s.NoError(err)
+	s.Equal(int64(11), eventID)
+}
+
+func (s *resetSuite) TestGetWorkflowTaskEventID_NoWorkflowTask() {
+	s.mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&workflowservice.GetWorkflowExecutionHistoryResponse{
+		History: &historypb.History{
+			Events: []*historypb.HistoryEvent{
+				{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			},
+		},
+	}, nil)
+
+	_, err := getWorkflowTaskEventID(context.Background(), s.mockClient, "test-namespace", "test-workflow-id", "test-run-id", false)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *resetSuite) TestFindResetPoint() {
+	resetPoints := &workflowpb.ResetPoints{
+		Points: []*workflowpb.ResetPointInfo{
+			{
+				BinaryChecksum:               "expired-checksum",
+				FirstWorkflowTaskCompletedId: 4,
+				ExpireTime:                   timestamp.TimePtr(time.Now().UTC().Add(-time.Hour)),
+				Resettable:                   true,
+			},
+			{
+				BinaryChecksum:               "not-resettable-checksum",
+				FirstWorkflowTaskCompletedId: 8,
+				Resettable:                   false,
+			},
+			{
+				BinaryChecksum:               "bad-checksum",
+				FirstWorkflowTaskCompletedId: 12,
+				ExpireTime:                   timestamp.TimePtr(time.Now().UTC().Add(time.Hour)),
+				Resettable:                   true,
+			},
+		},
+	}
+
+	s.Nil(findResetPoint(resetPoints, "expired-checksum"))
+	s.Nil(findResetPoint(resetPoints, "not-resettable-checksum"))
+	s.Nil(findResetPoint(resetPoints, "unknown-checksum"))
+	s.Equal(int64(12), findResetPoint(resetPoints, "bad-checksum").GetFirstWorkflowTaskCompletedId())
+}
@@ -26,18 +26,21 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -49,11 +52,27 @@ const (
 	// BatcherTaskQueueName is the taskqueue name
 	BatcherTaskQueueName = "temporal-sys-batcher-taskqueue"
 	// BatchWFTypeName is the workflow type
-	BatchWFTypeName   = "temporal-sys-batch-workflow"
-	batchActivityName = "temporal-sys-batch-activity"
+	BatchWFTypeName       = "temporal-sys-batch-workflow"
+	batchActivityName     = "temporal-sys-batch-activity"
+	batchPageActivityName = "temporal-sys-batch-page-activity"
 	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
 	InfiniteDuration = 20 * 365 * 24 * time.Hour
 	pageSize         = 1000
+	// pagesPerRun is the number of pages processed before the workflow continues as new
+	pagesPerRun = 100
+	// maxFailedWorkflowIDs is the max number of failed workflow IDs kept as a sample in the progress
+	maxFailedWorkflowIDs = 10
+	// pageActivityChangeID is the version change ID of processing one page per activity
+	pageActivityChangeID = "batch-page-activity"
+
+	// BatchProgressQueryName is the query type to get the progress of a batch operation
+	BatchProgressQueryName = "batch-progress"
+	// BatchPauseSignalName is the signal name to pause a batch operation
+	BatchPauseSignalName = "batch-pause"
+	// BatchResumeSignalName is the signal name to resume a paused batch operation
+	BatchResumeSignalName = "batch-resume"
+	// BatchUpdateRPSSignalName is the signal name to change the RPS of a batch operation
+	BatchUpdateRPSSignalName = "batch-update-rps"
 
 	// DefaultRPS is the default RPS
 	DefaultRPS = 50
@@ -72,10 +91,26 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting closed workflows
+	BatchTypeDelete = "delete"
+)
+
+const (
+	// ResetTypeFirstWorkflowTask resets workflows to the first completed workflow task
+	ResetTypeFirstWorkflowTask = "FirstWorkflowTask"
+	// ResetTypeLastWorkflowTask resets workflows to the last completed workflow task
+	ResetTypeLastWorkflowTask = "LastWorkflowTask"
+	// ResetTypeBadBinary resets workflows to the first workflow task completed by a bad binary
+	ResetTypeBadBinary = "BadBinary"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReset, BatchTypeDelete}
+
+// AllResetTypes is the reset types supported by BatchTypeReset
+var AllResetTypes = []string{ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeBadBinary}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -100,15 +135,24 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// Supporting: FirstWorkflowTask,LastWorkflowTask,BadBinary
+		ResetType string
+		// Binary checksum to reset from, only for ResetTypeBadBinary
+		BadBinaryChecksum string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
 		Namespace string
-		// To get the target workflows for processing
+		// To get the target workflows for processing. Workflows are processed in the default order
+		// (by StartTime), so it shouldn't have ORDER BY clause.
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,reset,delete
 		BatchType string
 
 		// Below are all optional
@@ -118,6 +162,8 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
 		RPS int
@@ -131,10 +177,15 @@ type (
 		NonRetryableErrors []string
 		// internal conversion for NonRetryableErrors
 		_nonRetryableErrors map[string]struct{}
+
+		// Progress carried over from the previous run when the workflow continues as new
+		Progress BatchProgress
 	}
 
 	// HeartBeatDetails is the struct for heartbeat details
 	HeartBeatDetails struct {
+		// Page token of the next page of target workflows. For pages processed one activity each it is
+		// the start time and run ID of the last listed workflow, so it doesn't expire between activities.
 		PageToken   []byte
 		CurrentPage int
 		// This is just an estimation for visibility
@@ -143,6 +194,19 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Number of workflows skipped because they no longer exist or have nothing to process
+		SkipCount int
+		// Sample of the workflow IDs that give up due to errors, up to maxFailedWorkflowIDs
+		FailedWorkflowIDs []string
+	}
+
+	// BatchProgress is the result of BatchProgressQueryName query
+	BatchProgress struct {
+		HeartBeatDetails
+		// Whether processing is paused by BatchPauseSignalName
+		Paused bool
+		// Current RPS of processing
+		RPS int
 	}
 
 	taskDetail struct {
@@ -151,8 +215,16 @@ type (
 		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 		hbd HeartBeatDetails
 	}
+
+	taskResult struct {
+		execution commonpb.WorkflowExecution
+		err       error
+	}
 )
 
+// errTaskSkipped indicates the target workflow of a task no longer exists or has nothing to process
+var errTaskSkipped = errors.New("batch operation task is skipped")
+
 var (
 	batchActivityRetryPolicy = temporal.RetryPolicy{
 		InitialInterval:    10 * time.Second,
@@ -176,9 +248,67 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	}
 	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
-	var result HeartBeatDetails
-	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
-	return result, err
+
+	if workflow.GetVersion(ctx, pageActivityChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		// batch job started before pages were processed one activity each
+		var result HeartBeatDetails
+		err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
+		return result, err
+	}
+
+	progress := batchParams.Progress
+	progress.RPS = batchParams.RPS
+	if err := workflow.SetQueryHandler(ctx, BatchProgressQueryName, func() (BatchProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return HeartBeatDetails{}, err
+	}
+
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, BatchPauseSignalName), func(c workflow.ReceiveChannel, more bool) {
+		c.Receive(ctx, nil)
+		progress.Paused = true
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, BatchResumeSignalName), func(c workflow.ReceiveChannel, more bool) {
+		c.Receive(ctx, nil)
+		progress.Paused = false
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, BatchUpdateRPSSignalName), func(c workflow.ReceiveChannel, more bool) {
+		var rps int
+		c.Receive(ctx, &rps)
+		if rps > 0 {
+			progress.RPS = rps
+		}
+	})
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			selector.Select(ctx)
+		}
+	})
+
+	for page := 0; ; page++ {
+		// pause and RPS change take effect between pages
+		if err := workflow.Await(ctx, func() bool { return !progress.Paused }); err != nil {
+			return progress.HeartBeatDetails, err
+		}
+		batchParams.RPS = progress.RPS
+
+		if page >= pagesPerRun {
+			batchParams.Progress = progress
+			return progress.HeartBeatDetails, workflow.NewContinueAsNewError(ctx, BatchWFTypeName, batchParams)
+		}
+
+		var result HeartBeatDetails
+		err = workflow.ExecuteActivity(opt, batchPageActivityName, batchParams, progress.HeartBeatDetails).Get(ctx, &result)
+		if err != nil {
+			return progress.HeartBeatDetails, err
+		}
+		progress.HeartBeatDetails = result
+
+		if len(progress.PageToken) == 0 {
+			return progress.HeartBeatDetails, nil
+		}
+	}
 }
 
 func validateParams(params BatchParams) error {
@@ -194,7 +324,19 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate:
+	case BatchTypeReset:
+		switch params.ResetParams.ResetType {
+		case ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask:
+			return nil
+		case ResetTypeBadBinary:
+			if params.ResetParams.BadBinaryChecksum == "" {
+				return fmt.Errorf("must provide bad binary checksum")
+			}
+			return nil
+		default:
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -228,108 +370,200 @@ func setDefaultParams(params BatchParams) BatchParams {
 
 // BatchActivity is activity for processing batch operation
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
-	batcher := ctx.Value(batcherContextKey).(*Batcher)
-	client := batcher.clientBean.GetFrontendClient()
-
 	hbd := HeartBeatDetails{}
-	startOver := true
 	if activity.HasHeartbeatDetails(ctx) {
-		if err := activity.GetHeartbeatDetails(ctx, &hbd); err == nil {
-			startOver = false
-		} else {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
 			batcher := ctx.Value(batcherContextKey).(*Batcher)
 			batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			hbd = HeartBeatDetails{}
+		}
+	}
+
+	processor, err := newPageProcessor(ctx, batchParams)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+	// heartbeat details of batch jobs started before pages were processed one activity each have scan page token
+	processor.scan = true
+	for {
+		hbd, err = processor.processPage(hbd)
+		if err != nil {
+			return HeartBeatDetails{}, err
+		}
+		activity.RecordHeartbeat(ctx, hbd)
+
+		if len(hbd.PageToken) == 0 {
+			break
 		}
 	}
 
-	if startOver {
-		resp, err := client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+	return hbd, nil
+}
+
+// BatchPageActivity is activity for processing one page of batch operation
+func BatchPageActivity(ctx context.Context, batchParams BatchParams, hbd HeartBeatDetails) (HeartBeatDetails, error) {
+	// stop task processors once the page is done
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	processor, err := newPageProcessor(ctx, batchParams)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+	return processor.processPage(hbd)
+}
+
+type pageProcessor struct {
+	ctx         context.Context
+	batchParams BatchParams
+	client      workflowservice.WorkflowServiceClient
+	taskCh      chan taskDetail
+	respCh      chan taskResult
+	// scan is true if pages are read with ScanWorkflowExecutions instead of ListWorkflowExecutions
+	scan bool
+}
+
+func newPageProcessor(ctx context.Context, batchParams BatchParams) (*pageProcessor, error) {
+	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	client := batcher.clientBean.GetFrontendClient()
+
+	var namespaceID string
+	if batchParams.BatchType == BatchTypeDelete {
+		// history service is addressed by namespace ID rather than by name
+		resp, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
 			Namespace: batchParams.Namespace,
-			Query:     batchParams.Query,
 		})
 		if err != nil {
-			return HeartBeatDetails{}, err
+			return nil, err
 		}
-		hbd.TotalEstimate = resp.GetCount()
+		namespaceID = resp.GetNamespaceInfo().GetId()
+	}
+
+	p := &pageProcessor{
+		ctx:         ctx,
+		batchParams: batchParams,
+		client:      client,
+		taskCh:      make(chan taskDetail, pageSize),
+		respCh:      make(chan taskResult, pageSize),
 	}
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
-	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
+		go startTaskProcessor(ctx, batchParams, namespaceID, p.taskCh, p.respCh, rateLimiter, client)
 	}
+	return p, nil
+}
 
-	for {
-		// TODO https://github.com/uber/cadence/issues/2154
-		//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
-		//  And we can't use list API because terminate / reset will mutate the result.
-		resp, err := client.ScanWorkflowExecutions(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
-			Namespace:     batchParams.Namespace,
-			PageSize:      int32(pageSize),
-			NextPageToken: hbd.PageToken,
-			Query:         batchParams.Query,
+// processPage processes the page of hbd.PageToken and returns the heartbeat details pointing to the next page
+func (p *pageProcessor) processPage(hbd HeartBeatDetails) (HeartBeatDetails, error) {
+	if hbd.CurrentPage == 0 {
+		resp, err := p.client.CountWorkflowExecutions(p.ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: p.batchParams.Namespace,
+			Query:     p.batchParams.Query,
 		})
 		if err != nil {
 			return HeartBeatDetails{}, err
 		}
-		batchCount := len(resp.Executions)
-		if batchCount <= 0 {
-			break
-		}
+		hbd.TotalEstimate = resp.GetCount()
+	}
 
-		// send all tasks
-		for _, wf := range resp.Executions {
-			taskCh <- taskDetail{
-				execution: *wf.Execution,
-				attempts:  1,
-				hbd:       hbd,
-			}
+	executions, nextPageToken, err := p.getPage(hbd.PageToken)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+	batchCount := len(executions)
+	if batchCount <= 0 {
+		hbd.PageToken = nil
+		return hbd, nil
+	}
+
+	// send all tasks
+	for _, wf := range executions {
+		p.taskCh <- taskDetail{
+			execution: *wf.Execution,
+			attempts:  1,
+			hbd:       hbd,
 		}
+	}
 
-		succCount := 0
-		errCount := 0
-		// wait for counters indicate this batch is done
-	Loop:
-		for {
-			select {
-			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
-				}
-				if succCount+errCount == batchCount {
-					break Loop
+	succCount := 0
+	errCount := 0
+	skipCount := 0
+	// wait for counters indicate this batch is done
+Loop:
+	for {
+		select {
+		case result := <-p.respCh:
+			switch result.err {
+			case nil:
+				succCount++
+			case errTaskSkipped:
+				skipCount++
+			default:
+				errCount++
+				if len(hbd.FailedWorkflowIDs) < maxFailedWorkflowIDs {
+					hbd.FailedWorkflowIDs = append(hbd.FailedWorkflowIDs, result.execution.GetWorkflowId())
 				}
-			case <-ctx.Done():
-				return HeartBeatDetails{}, ctx.Err()
 			}
+			if succCount+errCount+skipCount == batchCount {
+				break Loop
+			}
+		case <-p.ctx.Done():
+			return HeartBeatDetails{}, p.ctx.Err()
 		}
+	}
 
-		hbd.CurrentPage++
-		hbd.PageToken = resp.NextPageToken
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
-		activity.RecordHeartbeat(ctx, hbd)
+	hbd.CurrentPage++
+	hbd.PageToken = nextPageToken
+	hbd.SuccessCount += succCount
+	hbd.ErrorCount += errCount
+	hbd.SkipCount += skipCount
+	return hbd, nil
+}
 
-		if len(hbd.PageToken) == 0 {
-			break
+func (p *pageProcessor) getPage(pageToken []byte) ([]*workflowpb.WorkflowExecutionInfo, []byte, error) {
+	if p.scan {
+		// TODO https://github.com/uber/cadence/issues/2154
+		//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
+		resp, err := p.client.ScanWorkflowExecutions(p.ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+			Namespace:     p.batchParams.Namespace,
+			PageSize:      int32(pageSize),
+			NextPageToken: pageToken,
+			Query:         p.batchParams.Query,
+		})
+		if err != nil {
+			return nil, nil, err
 		}
+		return resp.Executions, resp.NextPageToken, nil
 	}
 
-	return hbd, nil
+	// List page token holds sort values (StartTime and RunId) of the last workflow of the page and the next page
+	// is searched after them. It doesn't keep any resource open on the server, so it stays valid while the batch
+	// is paused or the activity is retried, and terminate / reset don't shift it because they don't change
+	// StartTime or RunId of processed workflows.
+	resp, err := p.client.ListWorkflowExecutions(p.ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     p.batchParams.Namespace,
+		PageSize:      int32(pageSize),
+		NextPageToken: pageToken,
+		Query:         p.batchParams.Query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Executions, resp.NextPageToken, nil
 }
 
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID string,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResult,
 	limiter *rate.Limiter,
 	client workflowservice.WorkflowServiceClient,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	historyClient := batcher.clientBean.GetHistoryClient()
 	for {
 		select {
 		case <-ctx.Done():
@@ -388,14 +622,35 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, requestID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+							NamespaceId: namespaceID,
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+						})
+						return err
+					})
 			}
-			if err != nil {
+			if err == errTaskSkipped {
+				respCh <- taskResult{execution: task.execution, err: err}
+			} else if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
-					respCh <- err
+				// InvalidArgument will not go away by retrying, e.g. deleting a running workflow
+				_, isInvalidArgument := err.(*serviceerror.InvalidArgument)
+				if ok || isInvalidArgument || task.attempts > batchParams.AttemptsOnRetryableError {
+					respCh <- taskResult{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -403,7 +658,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResult{execution: task.execution}
 			}
 		}
 	}
@@ -419,6 +674,9 @@ func processTask(
 	procFn func(string, string) error,
 ) error {
 	wfs := []commonpb.WorkflowExecution{task.execution}
+	// the first one is the target workflow, the rest are its children
+	isTarget := true
+	skipped := false
 	for len(wfs) > 0 {
 		wf := wfs[0]
 
@@ -434,7 +692,11 @@ func processTask(
 			if _, ok := err.(*serviceerror.NotFound); !ok {
 				return err
 			}
+			if isTarget {
+				skipped = true
+			}
 		}
+		isTarget = false
 		wfs = wfs[1:]
 		resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
 			Namespace: batchParams.Namespace,
@@ -464,6 +726,9 @@ func processTask(
 		}
 	}
 
+	if skipped {
+		return errTaskSkipped
+	}
 	return nil
 }
 
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package batcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	batchParams BatchParams
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.batchParams = BatchParams{
+		Namespace: "test-namespace",
+		Query:     "WorkflowType = 'test-workflow-type'",
+		Reason:    "test-reason",
+		BatchType: BatchTypeTerminate,
+	}
+}
+
+func (s *workflowSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+	env.RegisterActivityWithOptions(BatchPageActivity, activity.RegisterOptions{Name: batchPageActivityName})
+	return env
+}
+
+func (s *workflowSuite) TestBatchWorkflow_AllPages() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(batchPageActivityName, mock.Anything, mock.Anything, HeartBeatDetails{}).Return(HeartBeatDetails{
+		PageToken:     []byte("page-2"),
+		CurrentPage:   1,
+		TotalEstimate: 3,
+		SuccessCount:  2,
+	}, nil).Once()
+	env.OnActivity(batchPageActivityName, mock.Anything, mock.Anything, mock.Anything).Return(HeartBeatDetails{
+		CurrentPage:       2,
+		TotalEstimate:     3,
+		SuccessCount:      2,
+		ErrorCount:        1,
+		FailedWorkflowIDs: []string{"test-workflow-id"},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(BatchWFTypeName, s.batchParams)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(2, result.CurrentPage)
+	s.Equal(1, result.ErrorCount)
+	s.Equal([]string{"test-workflow-id"}, result.FailedWorkflowIDs)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestBatchWorkflow_PauseResumeAndUpdateRPS() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(batchPageActivityName, mock.Anything, mock.Anything, HeartBeatDetails{}).Return(HeartBeatDetails{
+		PageToken:    []byte("page-2"),
+		CurrentPage:  1,
+		SuccessCount: 2,
+	}, nil).After(10 * time.Minute).Once()
+	env.OnActivity(batchPageActivityName, mock.Anything, mock.MatchedBy(func(params BatchParams) bool {
+		return params.RPS == 100
+	}), mock.Anything).Return(HeartBeatDetails{
+		CurrentPage:  2,
+		SuccessCount: 3,
+		SkipCount:    1,
+	}, nil).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(BatchPauseSignalName, nil)
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(BatchUpdateRPSSignalName, 100)
+	}, 20*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		resp, err := env.QueryWorkflow(BatchProgressQueryName)
+		s.NoError(err)
+		var progress BatchProgress
+		s.NoError(resp.Get(&progress))
+		s.True(progress.Paused)
+		s.Equal(100, progress.RPS)
+		s.Equal(1, progress.CurrentPage)
+		s.Equal(2, progress.SuccessCount)
+
+		env.SignalWorkflow(BatchResumeSignalName, nil)
+	}, 30*time.Minute)
+
+	env.ExecuteWorkflow(BatchWFTypeName, s.batchParams)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(2, result.CurrentPage)
+	s.Equal(1, result.SkipCount)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestBatchWorkflow_ContinueAsNew() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(batchPageActivityName, mock.Anything, mock.Anything, mock.Anything).Return(HeartBeatDetails{
+		PageToken:   []byte("next-page"),
+		CurrentPage: 1,
+	}, nil).Times(pagesPerRun)
+
+	env.ExecuteWorkflow(BatchWFTypeName, s.batchParams)
+
+	s.True(env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(env.GetWorkflowError(), &continueAsNewErr), "Called ContinueAsNew")
+	env.AssertExpectations(s.T())
+}
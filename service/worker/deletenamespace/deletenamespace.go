@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Config defines the configuration for the delete namespace workflow.
+	Config struct {
+		// ActivityRPS is the rate of executions and task queues deleted per second.
+		ActivityRPS dynamicconfig.IntPropertyFn
+		// PageSize is the page size used to list executions and task queues.
+		PageSize dynamicconfig.IntPropertyFn
+		// NumHistoryShards is the number of history shards whose executions are listed.
+		NumHistoryShards int32
+	}
+
+	// deleteNamespace is the background sub-system that execute workflow to delete namespaces.
+	deleteNamespace struct {
+		sdkClient        sdkclient.Client
+		config           *Config
+		metadataMgr      persistence.MetadataManager
+		taskMgr          persistence.TaskManager
+		executionManager persistence.ExecutionManager
+		historyClient    historyservice.HistoryServiceClient
+		metricsClient    metrics.Client
+		logger           log.Logger
+	}
+)
+
+// New returns a new instance of deleteNamespace.
+func New(
+	sdkClient sdkclient.Client,
+	config *Config,
+	metadataMgr persistence.MetadataManager,
+	taskMgr persistence.TaskManager,
+	executionManager persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *deleteNamespace {
+	return &deleteNamespace{
+		sdkClient:        sdkClient,
+		config:           config,
+		metadataMgr:      metadataMgr,
+		taskMgr:          taskMgr,
+		executionManager: executionManager,
+		historyClient:    historyClient,
+		metricsClient:    metricsClient,
+		logger:           log.With(logger, tag.ComponentDeleteNamespace),
+	}
+}
+
+// Start service.
+func (s *deleteNamespace) Start() error {
+	workerOpts := worker.Options{}
+
+	wrk := worker.New(s.sdkClient, TaskQueueName, workerOpts)
+
+	a := newActivities(
+		s.config,
+		s.metadataMgr,
+		s.taskMgr,
+		s.executionManager,
+		s.historyClient,
+		s.metricsClient,
+		s.logger,
+	)
+
+	wrk.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	wrk.RegisterActivity(a)
+
+	return wrk.Start()
+}
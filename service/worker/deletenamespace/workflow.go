@@ -0,0 +1,482 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// TaskQueueName is the task queue name.
+	TaskQueueName = "temporal-sys-delete-namespace-task-queue"
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-delete-namespace-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow ID, the namespace ID is appended to it.
+	WorkflowIDPrefix = "temporal-sys-delete-namespace-"
+
+	// StageDeletingExecutions is the stage in which the executions of the namespace are terminated, if still open, and deleted.
+	StageDeletingExecutions = "deleting-executions"
+	// StageDeletingTaskQueues is the stage in which the task queues of the namespace are deleted.
+	StageDeletingTaskQueues = "deleting-task-queues"
+	// StageDeletingNamespace is the stage in which the namespace itself is deleted.
+	StageDeletingNamespace = "deleting-namespace"
+
+	// The progress of the deletion is saved in the namespace data under these keys,
+	// so that it is visible when the namespace is described.
+	DataKeyStage                = "temporal.io/deletion-stage"
+	DataKeyTerminatedExecutions = "temporal.io/deletion-terminated-executions"
+	DataKeyDeletedExecutions    = "temporal.io/deletion-deleted-executions"
+	DataKeyDeletedTaskQueues    = "temporal.io/deletion-deleted-task-queues"
+
+	// pagesPerRun is the number of pages processed before the workflow continues as new
+	pagesPerRun = 100
+	// executionsRoundInterval is the wait time between two rounds of deleting executions,
+	// it gives the executions started during the previous round, e.g. child workflows, the time to show up
+	executionsRoundInterval = 10 * time.Second
+	// maximumActivityAttempts caps the retries of the activities, the deletion is started again by deleting
+	// the namespace again once the failure is fixed
+	maximumActivityAttempts = 100
+	terminateReason         = "namespace is being deleted"
+)
+
+type (
+	// WorkflowParams is the parameters for delete namespace workflow.
+	WorkflowParams struct {
+		Namespace   string
+		NamespaceID string
+		// Progress of the deletion, it is carried over when the workflow continues as new.
+		Progress Progress
+	}
+
+	// Progress is the progress of the namespace deletion.
+	Progress struct {
+		Stage string
+		// ShardID is the shard whose executions are being deleted, shard IDs start from 1.
+		// It is reset to 0 once the last shard of the round is processed.
+		ShardID       int32
+		NextPageToken []byte
+		// Number of running executions of the namespace found in the current round of deleting executions.
+		// Only running executions start new executions, e.g. child workflows, so the executions are deleted
+		// once a whole round does not find any running execution.
+		RoundPending         int
+		TerminatedExecutions int64
+		DeletedExecutions    int64
+		DeletedTaskQueues    int64
+	}
+
+	activities struct {
+		config           *Config
+		metadataMgr      persistence.MetadataManager
+		taskMgr          persistence.TaskManager
+		executionManager persistence.ExecutionManager
+		historyClient    historyservice.HistoryServiceClient
+		rateLimiter      quotas.RateLimiter
+		metricsClient    metrics.Client
+		logger           log.Logger
+	}
+)
+
+var (
+	deleteNamespaceActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 1 * time.Minute,
+			MaximumAttempts: maximumActivityAttempts,
+		},
+		StartToCloseTimeout: 10 * time.Minute,
+	}
+
+	ErrUnableToExecuteActivity = errors.New("unable to execute activity")
+	ErrUnableToUpdateNamespace = errors.New("unable to update namespace")
+	ErrGlobalNamespace         = errors.New("global namespace can't be deleted, the deletion is not replicated")
+)
+
+func newActivities(
+	config *Config,
+	metadataMgr persistence.MetadataManager,
+	taskMgr persistence.TaskManager,
+	executionManager persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		config:           config,
+		metadataMgr:      metadataMgr,
+		taskMgr:          taskMgr,
+		executionManager: executionManager,
+		historyClient:    historyClient,
+		rateLimiter: quotas.NewDefaultOutgoingDynamicRateLimiter(
+			func() float64 { return float64(config.ActivityRPS()) },
+		),
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+// DeleteNamespaceWorkflow is the workflow that deletes a namespace with all its executions and task queues.
+// Each activity processes one page of executions of one shard or one page of task queues, the workflow moves
+// to the next stage once the last page of the current stage is processed.
+func DeleteNamespaceWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", "wf-type", WorkflowName, "namespace", params.Namespace)
+
+	var a *activities
+	ctx = workflow.WithActivityOptions(ctx, deleteNamespaceActivityOptions)
+
+	if params.Progress.Stage == "" {
+		err := workflow.ExecuteActivity(ctx, a.MarkNamespaceDeletedActivity, params).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("%w: MarkNamespaceDeletedActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		params.Progress.Stage = StageDeletingExecutions
+	}
+
+	for page := 0; params.Progress.Stage != StageDeletingNamespace; page++ {
+		if page == pagesPerRun {
+			return workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+		}
+
+		var pageActivity interface{}
+		switch params.Progress.Stage {
+		case StageDeletingExecutions:
+			pageActivity = a.DeleteExecutionsActivity
+		case StageDeletingTaskQueues:
+			pageActivity = a.DeleteTaskQueuesActivity
+		default:
+			return temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown stage: %v", params.Progress.Stage), "", nil)
+		}
+		err := workflow.ExecuteActivity(ctx, pageActivity, params).Get(ctx, &params.Progress)
+		if err != nil {
+			return fmt.Errorf("%w: %v: %v", ErrUnableToExecuteActivity, params.Progress.Stage, err)
+		}
+		if len(params.Progress.NextPageToken) > 0 || params.Progress.ShardID != 0 {
+			continue
+		}
+
+		switch params.Progress.Stage {
+		case StageDeletingExecutions:
+			if params.Progress.RoundPending == 0 {
+				params.Progress.Stage = StageDeletingTaskQueues
+				break
+			}
+			// start another round, the running executions may have started executions in the meantime
+			params.Progress.RoundPending = 0
+			if err := workflow.Sleep(ctx, executionsRoundInterval); err != nil {
+				return err
+			}
+		case StageDeletingTaskQueues:
+			params.Progress.Stage = StageDeletingNamespace
+		}
+	}
+
+	err := workflow.ExecuteActivity(ctx, a.DeleteNamespaceActivity, params).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: DeleteNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	logger.Info("Workflow finished successfully.", "wf-type", WorkflowName, "namespace", params.Namespace)
+	return nil
+}
+
+func (a *activities) MarkNamespaceDeletedActivity(_ context.Context, params WorkflowParams) error {
+	resp, err := a.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{ID: params.NamespaceID})
+	if err != nil {
+		return err
+	}
+	if resp.IsGlobalNamespace {
+		return temporal.NewNonRetryableApplicationError(ErrGlobalNamespace.Error(), "", nil)
+	}
+
+	err = a.updateNamespace(params.NamespaceID, func(info *persistencespb.NamespaceInfo) {
+		info.State = enumspb.NAMESPACE_STATE_DELETED
+		info.Data[DataKeyStage] = StageDeletingExecutions
+	})
+	if err != nil {
+		a.logger.Error("Unable to mark namespace as deleted.", tag.WorkflowNamespace(params.Namespace), tag.Error(err))
+		return err
+	}
+	a.logger.Info("Namespace marked as deleted.", tag.WorkflowNamespace(params.Namespace))
+	return nil
+}
+
+// DeleteExecutionsActivity terminates, if still open, and deletes one page of the executions of the namespace
+// in the shard. The executions are listed from the database rather than from visibility,
+// so executions missing from visibility are deleted too. Deleting an execution through history also deletes its
+// history branches, its visibility record and its offloaded payloads.
+func (a *activities) DeleteExecutionsActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	progress := params.Progress
+	if progress.ShardID == 0 {
+		progress.ShardID = 1
+	}
+	resp, err := a.executionManager.ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		ShardID:     progress.ShardID,
+		NamespaceID: params.NamespaceID,
+		PageSize:    a.config.PageSize(),
+		PageToken:   progress.NextPageToken,
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	var pageDeleted int64
+	for _, mutableState := range resp.States {
+		execution := &commonpb.WorkflowExecution{
+			WorkflowId: mutableState.GetExecutionInfo().GetWorkflowId(),
+			RunId:      mutableState.GetExecutionState().GetRunId(),
+		}
+
+		switch mutableState.GetExecutionState().GetState() {
+		case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+			terminated, err := a.terminateExecution(ctx, params, execution)
+			if err != nil {
+				return Progress{}, err
+			}
+			if terminated {
+				progress.TerminatedExecutions++
+			}
+			progress.RoundPending++
+		}
+
+		deleted, err := a.deleteExecution(ctx, params, execution)
+		if err != nil {
+			return Progress{}, err
+		}
+		if deleted {
+			progress.DeletedExecutions++
+			pageDeleted++
+		}
+	}
+
+	progress.NextPageToken = resp.PageToken
+	if len(progress.NextPageToken) == 0 {
+		if progress.ShardID >= a.config.NumHistoryShards {
+			progress.ShardID = 0
+		} else {
+			progress.ShardID++
+		}
+	}
+	// most shards do not contain executions of the namespace once the first round is done
+	if pageDeleted == 0 && progress.TerminatedExecutions == params.Progress.TerminatedExecutions {
+		return progress, nil
+	}
+	return progress, a.saveProgress(params.NamespaceID, progress)
+}
+
+// DeleteTaskQueuesActivity deletes the task queues of the namespace found in one page of all the task queues.
+func (a *activities) DeleteTaskQueuesActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	progress := params.Progress
+	resp, err := a.taskMgr.ListTaskQueue(&persistence.ListTaskQueueRequest{
+		PageSize:  a.config.PageSize(),
+		PageToken: progress.NextPageToken,
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	for _, item := range resp.Items {
+		if item.Data.GetNamespaceId() != params.NamespaceID {
+			continue
+		}
+		key := &persistence.TaskQueueKey{
+			NamespaceID: item.Data.GetNamespaceId(),
+			Name:        item.Data.GetName(),
+			TaskType:    item.Data.GetTaskType(),
+		}
+		if err := a.deleteTaskQueue(ctx, key, item.RangeID); err != nil {
+			a.logger.Error("Unable to delete task queue.", tag.WorkflowNamespace(params.Namespace), tag.WorkflowTaskQueueName(key.Name), tag.Error(err))
+			a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteNamespaceFailuresCount)
+			return Progress{}, err
+		}
+		progress.DeletedTaskQueues++
+	}
+
+	progress.NextPageToken = resp.NextPageToken
+	return progress, a.saveProgress(params.NamespaceID, progress)
+}
+
+func (a *activities) DeleteNamespaceActivity(_ context.Context, params WorkflowParams) error {
+	err := a.metadataMgr.DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: params.NamespaceID})
+	if err != nil {
+		a.logger.Error("Unable to delete namespace.", tag.WorkflowNamespace(params.Namespace), tag.Error(err))
+		a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteNamespaceFailuresCount)
+		return err
+	}
+	a.logger.Info("Namespace deleted.", tag.WorkflowNamespace(params.Namespace), tag.WorkflowNamespaceID(params.NamespaceID))
+	return nil
+}
+
+// deleteTaskQueue completes all the tasks of the task queue and then deletes it,
+// the delete is conditional on the range ID so it fails if matching still owns the task queue
+func (a *activities) deleteTaskQueue(ctx context.Context, key *persistence.TaskQueueKey, rangeID int64) error {
+	batchSize := a.config.PageSize()
+	for {
+		resp, err := a.taskMgr.GetTasks(&persistence.GetTasksRequest{
+			NamespaceID: key.NamespaceID,
+			TaskQueue:   key.Name,
+			TaskType:    key.TaskType,
+			ReadLevel:   -1, // get the first N tasks sorted by taskID
+			BatchSize:   batchSize,
+		})
+		if err != nil {
+			return err
+		}
+		nTasks := len(resp.Tasks)
+		if nTasks == 0 {
+			break
+		}
+		if err := a.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		if _, err := a.taskMgr.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			NamespaceID:   key.NamespaceID,
+			TaskQueueName: key.Name,
+			TaskType:      key.TaskType,
+			TaskID:        resp.Tasks[nTasks-1].GetTaskId(),
+			Limit:         nTasks,
+		}); err != nil {
+			return err
+		}
+		if nTasks < batchSize {
+			break
+		}
+	}
+
+	if err := a.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	return a.taskMgr.DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+		TaskQueue: key,
+		RangeID:   rangeID,
+	})
+}
+
+// terminateExecution terminates the execution, it returns false if the execution is already closed or deleted
+func (a *activities) terminateExecution(ctx context.Context, params WorkflowParams, execution *commonpb.WorkflowExecution) (bool, error) {
+	if err := a.rateLimiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	_, err := a.historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+		NamespaceId: params.NamespaceID,
+		TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         params.Namespace,
+			WorkflowExecution: execution,
+			Reason:            terminateReason,
+			Identity:          WorkflowName,
+		},
+	})
+	if err != nil {
+		// NotFound means the execution is already closed or deleted
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return false, nil
+		}
+		a.logger.Error("Unable to terminate execution.", tag.WorkflowNamespace(params.Namespace), tag.WorkflowID(execution.GetWorkflowId()), tag.WorkflowRunID(execution.GetRunId()), tag.Error(err))
+		a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteNamespaceFailuresCount)
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteExecution deletes the closed execution, it returns false if the execution is already deleted or still open
+func (a *activities) deleteExecution(ctx context.Context, params WorkflowParams, execution *commonpb.WorkflowExecution) (bool, error) {
+	if err := a.rateLimiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	_, err := a.historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       params.NamespaceID,
+		WorkflowExecution: execution,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *serviceerror.NotFound:
+			// the execution is already deleted
+		case *serviceerror.InvalidArgument:
+			// the execution is still running, it is terminated in the next round
+		default:
+			a.logger.Error("Unable to delete execution.", tag.WorkflowNamespace(params.Namespace), tag.WorkflowID(execution.GetWorkflowId()), tag.WorkflowRunID(execution.GetRunId()), tag.Error(err))
+			a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteNamespaceFailuresCount)
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (a *activities) saveProgress(namespaceID string, progress Progress) error {
+	return a.updateNamespace(namespaceID, func(info *persistencespb.NamespaceInfo) {
+		info.Data[DataKeyStage] = progress.Stage
+		info.Data[DataKeyTerminatedExecutions] = strconv.FormatInt(progress.TerminatedExecutions, 10)
+		info.Data[DataKeyDeletedExecutions] = strconv.FormatInt(progress.DeletedExecutions, 10)
+		info.Data[DataKeyDeletedTaskQueues] = strconv.FormatInt(progress.DeletedTaskQueues, 10)
+	})
+}
+
+func (a *activities) updateNamespace(namespaceID string, updateFn func(info *persistencespb.NamespaceInfo)) error {
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := a.metadataMgr.GetMetadata()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnableToUpdateNamespace, err)
+	}
+	resp, err := a.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{ID: namespaceID})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnableToUpdateNamespace, err)
+	}
+
+	ns := resp.Namespace
+	if ns.Info.Data == nil {
+		ns.Info.Data = make(map[string]string)
+	}
+	updateFn(ns.Info)
+	ns.ConfigVersion++
+	err = a.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           ns,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnableToUpdateNamespace, err)
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	params WorkflowParams
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.params = WorkflowParams{
+		Namespace:   "test-namespace",
+		NamespaceID: "test-namespace-id",
+	}
+}
+
+func (s *workflowSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	env.RegisterActivity(&activities{})
+	return env
+}
+
+func (s *workflowSuite) TestDeleteNamespaceWorkflow() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity("MarkNamespaceDeletedActivity", mock.Anything, s.params).Return(nil).Once()
+	// first round terminates and deletes one execution of each of the two shards
+	env.OnActivity("DeleteExecutionsActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ShardID == 0 && params.Progress.DeletedExecutions == 0
+	})).Return(Progress{
+		Stage:                StageDeletingExecutions,
+		ShardID:              2,
+		RoundPending:         1,
+		TerminatedExecutions: 1,
+		DeletedExecutions:    1,
+	}, nil).Once()
+	env.OnActivity("DeleteExecutionsActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ShardID == 2
+	})).Return(Progress{
+		Stage:                StageDeletingExecutions,
+		RoundPending:         1,
+		TerminatedExecutions: 1,
+		DeletedExecutions:    2,
+	}, nil).Once()
+	// second round does not find any running execution
+	env.OnActivity("DeleteExecutionsActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ShardID == 0 && params.Progress.RoundPending == 0 && params.Progress.DeletedExecutions == 2
+	})).Return(Progress{
+		Stage:                StageDeletingExecutions,
+		TerminatedExecutions: 1,
+		DeletedExecutions:    2,
+	}, nil).Once()
+	env.OnActivity("DeleteTaskQueuesActivity", mock.Anything, mock.Anything).Return(Progress{
+		Stage:                StageDeletingTaskQueues,
+		TerminatedExecutions: 1,
+		DeletedExecutions:    2,
+		DeletedTaskQueues:    2,
+	}, nil).Once()
+	env.OnActivity("DeleteNamespaceActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.Stage == StageDeletingNamespace && params.Progress.DeletedTaskQueues == 2
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, s.params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestDeleteNamespaceWorkflow_ContinueAsNew() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity("MarkNamespaceDeletedActivity", mock.Anything, s.params).Return(nil).Once()
+	env.OnActivity("DeleteExecutionsActivity", mock.Anything, mock.Anything).Return(Progress{
+		Stage:         StageDeletingExecutions,
+		ShardID:       1,
+		NextPageToken: []byte("next-page"),
+	}, nil).Times(pagesPerRun)
+
+	env.ExecuteWorkflow(WorkflowName, s.params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(s.T())
+}
+
+type activitiesSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller           *gomock.Controller
+	mockMetadataMgr      *persistence.MockMetadataManager
+	mockTaskMgr          *persistence.MockTaskManager
+	mockExecutionManager *persistence.MockExecutionManager
+	mockHistoryClient    *historyservicemock.MockHistoryServiceClient
+
+	activities *activities
+	params     WorkflowParams
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
+	s.mockTaskMgr = persistence.NewMockTaskManager(s.controller)
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+
+	s.activities = newActivities(
+		&Config{
+			ActivityRPS:      dynamicconfig.GetIntPropertyFn(1000),
+			PageSize:         dynamicconfig.GetIntPropertyFn(10),
+			NumHistoryShards: 2,
+		},
+		s.mockMetadataMgr,
+		s.mockTaskMgr,
+		s.mockExecutionManager,
+		s.mockHistoryClient,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+	s.params = WorkflowParams{
+		Namespace:   "test-namespace",
+		NamespaceID: "test-namespace-id",
+		Progress:    Progress{Stage: StageDeletingExecutions},
+	}
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) newMutableState(namespaceID string, workflowID string, state enumsspb.WorkflowExecutionState) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: namespaceID,
+			WorkflowId:  workflowID,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: workflowID + "-run-id",
+			State: state,
+		},
+	}
+}
+
+func (s *activitiesSuite) TestDeleteExecutionsActivity() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		ShardID:     1,
+		NamespaceID: "test-namespace-id",
+		PageSize:    10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newMutableState("test-namespace-id", "running-wid", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+			s.newMutableState("test-namespace-id", "closed-wid", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED),
+			s.newMutableState("test-namespace-id", "deleted-wid", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED),
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.TerminateWorkflowExecutionRequest, _ ...interface{}) (*historyservice.TerminateWorkflowExecutionResponse, error) {
+			s.Equal("running-wid", request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
+			return &historyservice.TerminateWorkflowExecutionResponse{}, nil
+		},
+	)
+	for _, workflowID := range []string{"running-wid", "closed-wid"} {
+		s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+			NamespaceId:       "test-namespace-id",
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run-id"},
+		}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil)
+	}
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       "test-namespace-id",
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "deleted-wid", RunId: "deleted-wid-run-id"},
+	}).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+	s.expectSaveProgress()
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	result, err := env.ExecuteActivity(s.activities.DeleteExecutionsActivity, s.params)
+	s.NoError(err)
+	var progress Progress
+	s.NoError(result.Get(&progress))
+	s.Equal(Progress{
+		Stage:                StageDeletingExecutions,
+		ShardID:              2,
+		RoundPending:         1,
+		TerminatedExecutions: 1,
+		DeletedExecutions:    2,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestDeleteExecutionsActivity_LastShard() {
+	s.params.Progress.ShardID = 2
+	s.params.Progress.RoundPending = 1
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		ShardID:     2,
+		NamespaceID: "test-namespace-id",
+		PageSize:    10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	result, err := env.ExecuteActivity(s.activities.DeleteExecutionsActivity, s.params)
+	s.NoError(err)
+	var progress Progress
+	s.NoError(result.Get(&progress))
+	s.Equal(Progress{
+		Stage:        StageDeletingExecutions,
+		RoundPending: 1,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestDeleteTaskQueuesActivity() {
+	s.mockTaskMgr.EXPECT().ListTaskQueue(&persistence.ListTaskQueueRequest{PageSize: 10}).Return(&persistence.ListTaskQueueResponse{
+		Items: []*persistence.PersistedTaskQueueInfo{
+			{Data: &persistencespb.TaskQueueInfo{NamespaceId: "other-namespace-id", Name: "other-task-queue"}, RangeID: 1},
+			{Data: &persistencespb.TaskQueueInfo{NamespaceId: "test-namespace-id", Name: "test-task-queue"}, RangeID: 2},
+		},
+	}, nil)
+	s.mockTaskMgr.EXPECT().GetTasks(gomock.Any()).Return(&persistence.GetTasksResponse{}, nil)
+	s.mockTaskMgr.EXPECT().DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+		TaskQueue: &persistence.TaskQueueKey{NamespaceID: "test-namespace-id", Name: "test-task-queue"},
+		RangeID:   2,
+	}).Return(nil)
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{ID: "test-namespace-id"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+			Config: &persistencespb.NamespaceConfig{},
+		},
+	}, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).Return(nil)
+
+	s.params.Progress.Stage = StageDeletingTaskQueues
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	result, err := env.ExecuteActivity(s.activities.DeleteTaskQueuesActivity, s.params)
+	s.NoError(err)
+	var progress Progress
+	s.NoError(result.Get(&progress))
+	s.Equal(Progress{
+		Stage:             StageDeletingTaskQueues,
+		DeletedTaskQueues: 1,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestMarkNamespaceDeletedActivity_GlobalNamespace() {
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{ID: "test-namespace-id"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+			Config: &persistencespb.NamespaceConfig{},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	_, err := env.ExecuteActivity(s.activities.MarkNamespaceDeletedActivity, s.params)
+	var applicationErr *temporal.ApplicationError
+	s.ErrorAs(err, &applicationErr)
+	s.True(applicationErr.NonRetryable())
+}
+
+func (s *activitiesSuite) expectSaveProgress() {
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{ID: "test-namespace-id"}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+			Config: &persistencespb.NamespaceConfig{},
+		},
+	}, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		s.Equal("2", request.Namespace.Info.Data[DataKeyDeletedExecutions])
+		s.Equal("1", request.Namespace.Info.Data[DataKeyTerminatedExecutions])
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rebuildvisibility
+
+import (
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// Config defines the configuration for the rebuild visibility workflow.
+	Config struct {
+		// ActivityRPS is the rate of executions processed per second.
+		ActivityRPS dynamicconfig.IntPropertyFn
+		// PageSize is the page size used to list executions of a shard.
+		PageSize dynamicconfig.IntPropertyFn
+		// NumHistoryShards is the number of history shards to scan.
+		NumHistoryShards int32
+	}
+
+	// rebuildVisibility is the background sub-system that execute workflow to rebuild Elasticsearch visibility.
+	rebuildVisibility struct {
+		sdkClient                sdkclient.Client
+		config                   *Config
+		executionManager         persistence.ExecutionManager
+		namespaceCache           cache.NamespaceCache
+		esClient                 esclient.Client
+		indexName                string
+		visibilityManager        visibility.VisibilityManager
+		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
+		metricsClient            metrics.Client
+		logger                   log.Logger
+	}
+)
+
+// New returns a new instance of rebuildVisibility.
+func New(
+	sdkClient sdkclient.Client,
+	config *Config,
+	executionManager persistence.ExecutionManager,
+	namespaceCache cache.NamespaceCache,
+	esClient esclient.Client,
+	indexName string,
+	visibilityManager visibility.VisibilityManager,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *rebuildVisibility {
+	return &rebuildVisibility{
+		sdkClient:                sdkClient,
+		config:                   config,
+		executionManager:         executionManager,
+		namespaceCache:           namespaceCache,
+		esClient:                 esClient,
+		indexName:                indexName,
+		visibilityManager:        visibilityManager,
+		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
+		metricsClient:            metricsClient,
+		logger:                   log.With(logger, tag.ComponentRebuildVisibility),
+	}
+}
+
+// Start service.
+func (s *rebuildVisibility) Start() error {
+	workerOpts := worker.Options{}
+
+	wrk := worker.New(s.sdkClient, TaskQueueName, workerOpts)
+
+	a := newActivities(
+		s.config,
+		s.executionManager,
+		s.namespaceCache,
+		s.esClient,
+		s.indexName,
+		s.visibilityManager,
+		s.searchAttributesProvider,
+		s.searchAttributesMapper,
+		s.metricsClient,
+		s.logger,
+	)
+
+	wrk.RegisterWorkflowWithOptions(RebuildVisibilityWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	wrk.RegisterActivity(a)
+
+	return wrk.Start()
+}
@@ -0,0 +1,746 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rebuildvisibility
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// TaskQueueName is the task queue name.
+	TaskQueueName = "temporal-sys-rebuild-visibility-task-queue"
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-rebuild-visibility-workflow"
+	// WorkflowID is the workflow ID, only one rebuild can run at a time.
+	WorkflowID = "temporal-sys-rebuild-visibility"
+	// QueryTypeProgress is the query type which returns the progress of the rebuild.
+	QueryTypeProgress = "progress"
+
+	// pagesPerRun is the number of pages processed before the workflow continues as new
+	pagesPerRun = 100
+)
+
+type (
+	// WorkflowParams is the parameters for rebuild visibility workflow.
+	WorkflowParams struct {
+		// DryRun only reports the drift between Elasticsearch and the database without indexing documents.
+		DryRun bool
+		// Progress of the rebuild, it is carried over when the workflow continues as new.
+		Progress Progress
+	}
+
+	// Progress is the progress of the rebuild, it is also the report of the drift found.
+	Progress struct {
+		// ShardID is the shard being scanned, shard IDs start from 1.
+		ShardID int32
+		// ScanningDocuments is true once all shards are scanned, the Elasticsearch documents are then scanned
+		// for documents without execution in the database.
+		ScanningDocuments bool
+		// NextPageToken is the page token of the executions of the shard or, once scanning documents, of the documents.
+		NextPageToken []byte
+		Done          bool
+
+		ScannedExecutions int64
+		// MissingDocuments is the number of executions without Elasticsearch document.
+		MissingDocuments int64
+		// StaleDocuments is the number of Elasticsearch documents which differ from the execution in the database.
+		StaleDocuments int64
+		// IndexedDocuments is the number of missing or stale documents written to Elasticsearch. Documents updated
+		// by visibility tasks while the rebuild is running are not overwritten and not counted.
+		IndexedDocuments int64
+		// FailedExecutions is the number of executions which visibility record can't be built from the database
+		// and of documents which execution can't be read from the database.
+		FailedExecutions int64
+		// ScannedDocuments is the number of Elasticsearch documents checked for an execution in the database.
+		ScannedDocuments int64
+		// OrphanedDocuments is the number of Elasticsearch documents without execution in the database.
+		// They are reported but never deleted.
+		OrphanedDocuments int64
+	}
+
+	activities struct {
+		config                   *Config
+		executionManager         persistence.ExecutionManager
+		namespaceCache           cache.NamespaceCache
+		esClient                 esclient.Client
+		indexName                string
+		visibilityManager        visibility.VisibilityManager
+		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
+		rateLimiter              quotas.RateLimiter
+		metricsClient            metrics.Client
+		logger                   log.Logger
+	}
+
+	// visibilityRecord is the visibility record of the execution built from the database.
+	visibilityRecord struct {
+		docID         string
+		closed        bool
+		base          *visibility.VisibilityRequestBase
+		closeTime     time.Time
+		historyLength int64
+		retention     time.Duration
+	}
+
+	// visibilityDocument is the part of Elasticsearch document which is compared with the database.
+	visibilityDocument struct {
+		ExecutionStatus      string
+		StateTransitionCount int64
+		Memo                 []byte
+		MemoEncoding         string
+
+		memo *commonpb.Memo
+		// fields are all the fields of the document, search attributes are compared with them.
+		fields  map[string]interface{}
+		version int64
+	}
+
+	// documentExecution is the execution of an Elasticsearch document.
+	documentExecution struct {
+		NamespaceID string `json:"NamespaceId"`
+		WorkflowID  string `json:"WorkflowId"`
+		RunID       string `json:"RunId"`
+	}
+)
+
+var (
+	rebuildVisibilityActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 1 * time.Minute,
+		},
+		StartToCloseTimeout: 10 * time.Minute,
+	}
+
+	ErrUnableToExecuteActivity = errors.New("unable to execute activity")
+	ErrVisibilityNotConfigured = errors.New("Elasticsearch visibility is not configured")
+)
+
+func newActivities(
+	config *Config,
+	executionManager persistence.ExecutionManager,
+	namespaceCache cache.NamespaceCache,
+	esClient esclient.Client,
+	indexName string,
+	visibilityManager visibility.VisibilityManager,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		config:                   config,
+		executionManager:         executionManager,
+		namespaceCache:           namespaceCache,
+		esClient:                 esClient,
+		indexName:                indexName,
+		visibilityManager:        visibilityManager,
+		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
+		rateLimiter: quotas.NewDefaultOutgoingDynamicRateLimiter(
+			func() float64 { return float64(config.ActivityRPS()) },
+		),
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+// RebuildVisibilityWorkflow is the workflow that rebuilds Elasticsearch visibility from the executions in the database.
+// Each activity processes one page of executions of one shard. Once the last page of the last shard is processed,
+// the Elasticsearch documents are scanned page by page for documents of executions which don't exist in the database
+// anymore, these are reported but not deleted. The progress can be queried with QueryTypeProgress.
+func RebuildVisibilityWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+
+	err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return params.Progress, nil
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	if params.Progress.ShardID == 0 {
+		logger.Info("Workflow started.", "wf-type", WorkflowName, "dry-run", params.DryRun)
+		params.Progress.ShardID = 1
+	}
+
+	var a *activities
+	ctx = workflow.WithActivityOptions(ctx, rebuildVisibilityActivityOptions)
+	for page := 0; !params.Progress.Done; page++ {
+		if page == pagesPerRun {
+			return Progress{}, workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+		}
+
+		var progress Progress
+		if !params.Progress.ScanningDocuments {
+			err := workflow.ExecuteActivity(ctx, a.RebuildVisibilityActivity, params).Get(ctx, &progress)
+			if err != nil {
+				return Progress{}, fmt.Errorf("%w: RebuildVisibilityActivity: %v", ErrUnableToExecuteActivity, err)
+			}
+		} else {
+			err := workflow.ExecuteActivity(ctx, a.FindOrphanedDocumentsActivity, params).Get(ctx, &progress)
+			if err != nil {
+				return Progress{}, fmt.Errorf("%w: FindOrphanedDocumentsActivity: %v", ErrUnableToExecuteActivity, err)
+			}
+		}
+		params.Progress = progress
+	}
+
+	logger.Info("Workflow finished successfully.", "wf-type", WorkflowName,
+		"scanned-executions", params.Progress.ScannedExecutions,
+		"missing-documents", params.Progress.MissingDocuments,
+		"stale-documents", params.Progress.StaleDocuments,
+		"indexed-documents", params.Progress.IndexedDocuments,
+		"failed-executions", params.Progress.FailedExecutions,
+		"scanned-documents", params.Progress.ScannedDocuments,
+		"orphaned-documents", params.Progress.OrphanedDocuments,
+	)
+	return params.Progress, nil
+}
+
+// RebuildVisibilityActivity compares one page of executions of the shard with their Elasticsearch documents
+// and indexes the documents which are missing or stale.
+func (a *activities) RebuildVisibilityActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	if a.esClient == nil || a.visibilityManager == nil {
+		return Progress{}, temporal.NewNonRetryableApplicationError(ErrVisibilityNotConfigured.Error(), "", nil)
+	}
+
+	progress := params.Progress
+	resp, err := a.executionManager.ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		ShardID:   progress.ShardID,
+		PageSize:  a.config.PageSize(),
+		PageToken: progress.NextPageToken,
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	var records []*visibilityRecord
+	for _, mutableState := range resp.States {
+		if err := a.rateLimiter.Wait(ctx); err != nil {
+			return Progress{}, err
+		}
+		record, err := a.buildVisibilityRecord(progress.ShardID, mutableState)
+		if err != nil {
+			a.logger.Error("Unable to build visibility record.",
+				tag.ShardID(progress.ShardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityFailuresCount)
+			progress.FailedExecutions++
+			continue
+		}
+		if record != nil {
+			records = append(records, record)
+		}
+	}
+
+	docs, err := a.getVisibilityDocuments(ctx, records)
+	if err != nil {
+		return Progress{}, err
+	}
+	typeMap, err := a.searchAttributesProvider.GetSearchAttributes(a.indexName, false)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	var driftedRecords []*visibilityRecord
+	for _, record := range records {
+		progress.ScannedExecutions++
+		doc, ok := docs[record.docID]
+		if ok && doc.version >= record.base.TaskID && a.isStale(record, doc, typeMap) {
+			// The document was written by a visibility task newer than the execution read from the database,
+			// e.g. the one which closed the execution. The execution is read again, after the document,
+			// so the document is superseded only by a state which is at least as new as the document.
+			if err := a.rateLimiter.Wait(ctx); err != nil {
+				return Progress{}, err
+			}
+			reloadedRecord, err := a.reloadVisibilityRecord(progress.ShardID, record)
+			if err != nil {
+				a.logger.Error("Unable to reload visibility record.",
+					tag.ShardID(progress.ShardID),
+					tag.WorkflowNamespace(record.base.Namespace),
+					tag.ESDocID(record.docID),
+					tag.Error(err),
+				)
+				a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityFailuresCount)
+				progress.FailedExecutions++
+				continue
+			}
+			if reloadedRecord == nil {
+				continue
+			}
+			record = reloadedRecord
+			if doc.version >= record.base.TaskID {
+				record.base.TaskID = doc.version + 1
+			}
+		}
+		switch {
+		case !ok:
+			a.logger.Warn("Visibility document is missing.", tag.ShardID(progress.ShardID), tag.WorkflowNamespace(record.base.Namespace), tag.ESDocID(record.docID))
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityMissingDocumentsCount)
+			progress.MissingDocuments++
+		case a.isStale(record, doc, typeMap):
+			a.logger.Warn("Visibility document is stale.", tag.ShardID(progress.ShardID), tag.WorkflowNamespace(record.base.Namespace), tag.ESDocID(record.docID))
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityStaleDocumentsCount)
+			progress.StaleDocuments++
+		default:
+			continue
+		}
+		driftedRecords = append(driftedRecords, record)
+	}
+
+	if !params.DryRun {
+		indexed, err := a.indexVisibilityRecords(driftedRecords)
+		if err != nil {
+			return Progress{}, err
+		}
+		progress.IndexedDocuments += indexed
+	}
+
+	progress.NextPageToken = resp.PageToken
+	if len(progress.NextPageToken) == 0 {
+		if progress.ShardID >= a.config.NumHistoryShards {
+			progress.ScanningDocuments = true
+		} else {
+			progress.ShardID++
+		}
+	}
+	return progress, nil
+}
+
+// FindOrphanedDocumentsActivity checks one page of Elasticsearch documents for executions which don't exist
+// in the database. Documents are paged in the order of workflow ID and run ID.
+func (a *activities) FindOrphanedDocumentsActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	if a.esClient == nil {
+		return Progress{}, temporal.NewNonRetryableApplicationError(ErrVisibilityNotConfigured.Error(), "", nil)
+	}
+
+	progress := params.Progress
+	var searchAfter []interface{}
+	if len(progress.NextPageToken) != 0 {
+		if err := json.Unmarshal(progress.NextPageToken, &searchAfter); err != nil {
+			return Progress{}, temporal.NewNonRetryableApplicationError("invalid documents page token", "", err)
+		}
+	}
+	pageSize := a.config.PageSize()
+	searchResult, err := a.esClient.Search(ctx, &esclient.SearchParameters{
+		Index:    a.indexName,
+		Query:    elastic.NewMatchAllQuery(),
+		PageSize: pageSize,
+		Sorter: []elastic.Sorter{
+			elastic.NewFieldSort(searchattribute.WorkflowID).Asc(),
+			elastic.NewFieldSort(searchattribute.RunID).Asc(),
+		},
+		SearchAfter: searchAfter,
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	var hits []*elastic.SearchHit
+	if searchResult.Hits != nil {
+		hits = searchResult.Hits.Hits
+	}
+	for _, hit := range hits {
+		if err := a.rateLimiter.Wait(ctx); err != nil {
+			return Progress{}, err
+		}
+		progress.ScannedDocuments++
+		var execution documentExecution
+		if err := json.Unmarshal(hit.Source, &execution); err != nil {
+			a.logger.Error("Unable to JSON unmarshal Elasticsearch SearchHit.Source.", tag.Error(err), tag.ESDocID(hit.Id))
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityFailuresCount)
+			progress.FailedExecutions++
+			continue
+		}
+		shardID := common.WorkflowIDToHistoryShard(execution.NamespaceID, execution.WorkflowID, a.config.NumHistoryShards)
+		_, err := a.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: execution.NamespaceID,
+			Execution: commonpb.WorkflowExecution{
+				WorkflowId: execution.WorkflowID,
+				RunId:      execution.RunID,
+			},
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// the document may also be deleted by a visibility task which is not processed yet
+			a.logger.Warn("Visibility document has no execution.", tag.ShardID(shardID), tag.WorkflowNamespaceID(execution.NamespaceID), tag.ESDocID(hit.Id))
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityOrphanedDocumentsCount)
+			progress.OrphanedDocuments++
+		default:
+			a.logger.Error("Unable to read execution of visibility document.",
+				tag.ShardID(shardID),
+				tag.WorkflowNamespaceID(execution.NamespaceID),
+				tag.ESDocID(hit.Id),
+				tag.Error(err),
+			)
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityFailuresCount)
+			progress.FailedExecutions++
+		}
+	}
+
+	if len(hits) < pageSize {
+		progress.NextPageToken = nil
+		progress.Done = true
+		return progress, nil
+	}
+	progress.NextPageToken, err = json.Marshal(hits[len(hits)-1].Sort)
+	if err != nil {
+		return Progress{}, err
+	}
+	return progress, nil
+}
+
+// buildVisibilityRecord builds the visibility record of the execution in the same way as visibility queue task executor does.
+// It returns nil if the execution is not visible.
+func (a *activities) buildVisibilityRecord(shardID int32, mutableState *persistencespb.WorkflowMutableState) (*visibilityRecord, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+
+	var closed bool
+	switch executionState.GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		closed = false
+	case enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		closed = true
+	default:
+		// zombie executions are not visible
+		return nil, nil
+	}
+
+	workflowID := executionInfo.GetWorkflowId()
+	namespaceEntry, err := a.namespaceCache.GetNamespaceByID(executionInfo.GetNamespaceId())
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// the namespace is deleted, so are its executions
+			return nil, nil
+		}
+		return nil, err
+	}
+	// if sampled for longer retention is enabled, only sampled executions are visible
+	if namespaceEntry.IsSampledForLongerRetentionEnabled(workflowID) && !namespaceEntry.IsSampledForLongerRetention(workflowID) {
+		return nil, nil
+	}
+
+	var memo *commonpb.Memo
+	if executionInfo.GetMemo() != nil {
+		memo = &commonpb.Memo{Fields: executionInfo.GetMemo()}
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	if executionInfo.GetSearchAttributes() != nil {
+		searchAttributes = &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+	}
+
+	record := &visibilityRecord{
+		docID:  elasticsearch.GetDocID(workflowID, executionState.GetRunId()),
+		closed: closed,
+		base: &visibility.VisibilityRequestBase{
+			NamespaceID: executionInfo.GetNamespaceId(),
+			Namespace:   namespaceEntry.GetInfo().Name,
+			Execution: commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      executionState.GetRunId(),
+			},
+			WorkflowTypeName:     executionInfo.GetWorkflowTypeName(),
+			StartTime:            timestamp.TimeValue(executionInfo.GetStartTime()),
+			ExecutionTime:        timestamp.TimeValue(executionInfo.GetExecutionTime()),
+			StateTransitionCount: executionInfo.GetStateTransitionCount(),
+			// Transaction IDs are allocated from the same sequence as task IDs, the last one is used
+			// as the version of documents. It is raised above the version of newer stale documents
+			// only when the execution is read again after them.
+			TaskID:           executionInfo.GetLastFirstEventTxnId(),
+			ShardID:          shardID,
+			Status:           executionState.GetStatus(),
+			TaskQueue:        executionInfo.GetTaskQueue(),
+			Memo:             memo,
+			SearchAttributes: searchAttributes,
+			// documents written by visibility tasks after they were read are newer and must not be overwritten
+			FailOnVersionConflict: true,
+		},
+	}
+
+	if closed {
+		record.closeTime, err = a.getCloseTime(shardID, mutableState)
+		if err != nil {
+			return nil, err
+		}
+		record.historyLength = mutableState.GetNextEventId() - 1
+		record.retention = namespaceEntry.GetRetention(workflowID)
+	}
+	return record, nil
+}
+
+// reloadVisibilityRecord reads the execution of the record from the database again and builds its visibility record.
+// It returns nil if the execution doesn't exist anymore or is not visible.
+func (a *activities) reloadVisibilityRecord(shardID int32, record *visibilityRecord) (*visibilityRecord, error) {
+	resp, err := a.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: record.base.NamespaceID,
+		Execution:   record.base.Execution,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return a.buildVisibilityRecord(shardID, resp.State)
+}
+
+// getCloseTime reads the completion event batch of the execution and returns the time of the completion event.
+func (a *activities) getCloseTime(shardID int32, mutableState *persistencespb.WorkflowMutableState) (time.Time, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := a.executionManager.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: currentVersionHistory.GetBranchToken(),
+		MinEventID:  mutableState.GetExecutionInfo().GetCompletionEventBatchId(),
+		MaxEventID:  mutableState.GetNextEventId(),
+		PageSize:    1,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(resp.HistoryEvents) == 0 {
+		return time.Time{}, serviceerror.NewInternal("completion event is not found")
+	}
+	// completion event is always the last event of the execution
+	completionEvent := resp.HistoryEvents[len(resp.HistoryEvents)-1]
+	return timestamp.TimeValue(completionEvent.GetEventTime()), nil
+}
+
+// getVisibilityDocuments returns the Elasticsearch documents of the records with their versions by document ID.
+func (a *activities) getVisibilityDocuments(ctx context.Context, records []*visibilityRecord) (map[string]*visibilityDocument, error) {
+	docs := make(map[string]*visibilityDocument, len(records))
+	if len(records) == 0 {
+		return docs, nil
+	}
+
+	docIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		docIDs = append(docIDs, record.docID)
+	}
+	source, err := elastic.NewSearchSource().
+		Query(elastic.NewIdsQuery().Ids(docIDs...)).
+		Size(len(docIDs)).
+		Version(true).
+		Source()
+	if err != nil {
+		return nil, err
+	}
+	query, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	searchResult, err := a.esClient.SearchWithDSL(ctx, a.indexName, string(query))
+	if err != nil {
+		return nil, err
+	}
+
+	serializer := serialization.NewSerializer()
+	for _, hit := range searchResult.Hits.Hits {
+		var doc visibilityDocument
+		if err := json.Unmarshal(hit.Source, &doc); err != nil {
+			a.logger.Error("Unable to JSON unmarshal Elasticsearch SearchHit.Source.", tag.Error(err), tag.ESDocID(hit.Id))
+			continue
+		}
+		if err := unmarshalJSON(hit.Source, &doc.fields); err != nil {
+			a.logger.Error("Unable to JSON unmarshal Elasticsearch SearchHit.Source.", tag.Error(err), tag.ESDocID(hit.Id))
+			continue
+		}
+		if doc.MemoEncoding != "" {
+			// the memo is left empty if it can't be deserialized, so the document is stale
+			if doc.memo, err = serializer.DeserializeVisibilityMemo(persistence.NewDataBlob(doc.Memo, doc.MemoEncoding)); err != nil {
+				a.logger.Error("Unable to deserialize memo.", tag.Error(err), tag.ESDocID(hit.Id))
+			}
+		}
+		if hit.Version != nil {
+			doc.version = *hit.Version
+		}
+		docs[hit.Id] = &doc
+	}
+	return docs, nil
+}
+
+// indexVisibilityRecords indexes the records concurrently, visibility manager batches them into bulk requests.
+// It returns the number of documents written to Elasticsearch. Records rejected by Elasticsearch, including
+// the ones superseded by visibility tasks, are not counted.
+func (a *activities) indexVisibilityRecords(records []*visibilityRecord) (int64, error) {
+	errs := make([]error, len(records))
+	var wg sync.WaitGroup
+	wg.Add(len(records))
+	for i, record := range records {
+		go func(i int, record *visibilityRecord) {
+			defer wg.Done()
+			errs[i] = a.indexVisibilityRecord(record)
+		}(i, record)
+	}
+	wg.Wait()
+
+	var indexed int64
+	for i, err := range errs {
+		switch err.(type) {
+		case nil:
+			indexed++
+		case *elasticsearch.VisibilityTaskNAckError:
+			a.logger.Warn("Visibility document is not indexed.", tag.WorkflowNamespace(records[i].base.Namespace), tag.ESDocID(records[i].docID), tag.Error(err))
+		default:
+			a.logger.Error("Unable to index visibility document.", tag.WorkflowNamespace(records[i].base.Namespace), tag.ESDocID(records[i].docID), tag.Error(err))
+			a.metricsClient.IncCounter(metrics.RebuildVisibilityWorkflowScope, metrics.RebuildVisibilityFailuresCount)
+			return 0, err
+		}
+	}
+	return indexed, nil
+}
+
+func (a *activities) indexVisibilityRecord(record *visibilityRecord) error {
+	if record.closed {
+		return a.visibilityManager.RecordWorkflowExecutionClosed(&visibility.RecordWorkflowExecutionClosedRequest{
+			VisibilityRequestBase: record.base,
+			CloseTime:             record.closeTime,
+			HistoryLength:         record.historyLength,
+			Retention:             &record.retention,
+		})
+	}
+	return a.visibilityManager.UpsertWorkflowExecution(&visibility.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: record.base,
+	})
+}
+
+// isStale returns true if the document doesn't match the execution status, memo, search attributes or,
+// for closed executions, the number of state transitions. The number of state transitions of open executions
+// is updated in the document only when the search attributes are upserted, so it isn't compared.
+func (a *activities) isStale(record *visibilityRecord, doc *visibilityDocument, typeMap searchattribute.NameTypeMap) bool {
+	if doc.ExecutionStatus != record.base.Status.String() {
+		return true
+	}
+	if record.closed && doc.StateTransitionCount != record.base.StateTransitionCount {
+		return true
+	}
+	if len(record.base.Memo.GetFields()) != 0 || len(doc.memo.GetFields()) != 0 {
+		if !record.base.Memo.Equal(doc.memo) {
+			return true
+		}
+	}
+
+	// search attributes are decoded in the same way as when the document is generated,
+	// values which can't be decoded are null in the document
+	searchAttributes, _ := searchattribute.Decode(a.aliasesToFieldNames(record, typeMap), &typeMap)
+	for fieldName := range doc.fields {
+		if _, ok := searchAttributes[fieldName]; !ok && isSearchAttributeField(fieldName, typeMap) {
+			return true
+		}
+	}
+	for saName, saValue := range searchAttributes {
+		data, err := json.Marshal(saValue)
+		if err != nil {
+			return true
+		}
+		var value interface{}
+		if err := unmarshalJSON(data, &value); err != nil {
+			return true
+		}
+		if !reflect.DeepEqual(value, doc.fields[saName]) {
+			return true
+		}
+	}
+	return false
+}
+
+// aliasesToFieldNames replaces names of custom search attributes registered in the namespace with names
+// of the generic fields which store them in the document, in the same way as visibility manager does.
+func (a *activities) aliasesToFieldNames(record *visibilityRecord, typeMap searchattribute.NameTypeMap) *commonpb.SearchAttributes {
+	searchAttributes := record.base.SearchAttributes
+	if a.searchAttributesMapper == nil || len(searchAttributes.GetIndexedFields()) == 0 {
+		return searchAttributes
+	}
+
+	indexedFields := make(map[string]*commonpb.Payload, len(searchAttributes.GetIndexedFields()))
+	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		if !typeMap.IsDefined(saName) {
+			if fieldName, err := a.searchAttributesMapper.GetFieldName(saName, record.base.Namespace); err == nil {
+				saName = fieldName
+			}
+		}
+		indexedFields[saName] = saPayload
+	}
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
+}
+
+// isSearchAttributeField returns true if the document field is written from the search attributes of the execution.
+func isSearchAttributeField(fieldName string, typeMap searchattribute.NameTypeMap) bool {
+	if searchattribute.IsSystem(fieldName) {
+		return false
+	}
+	if _, err := typeMap.GetGenericFieldType(fieldName); err == nil {
+		return true
+	}
+	return typeMap.IsDefined(fieldName)
+}
+
+// unmarshalJSON unmarshals numbers as json.Number, so the values of the document and the execution
+// are compared without loss of precision.
+func unmarshalJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,502 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rebuildvisibility
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(RebuildVisibilityWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	env.RegisterActivity(&activities{})
+	return env
+}
+
+func (s *workflowSuite) TestRebuildVisibilityWorkflow() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity("RebuildVisibilityActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ShardID == 1
+	})).Return(Progress{
+		ShardID:           2,
+		ScannedExecutions: 2,
+		MissingDocuments:  1,
+		IndexedDocuments:  1,
+	}, nil).Once()
+	env.OnActivity("RebuildVisibilityActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ShardID == 2
+	})).Return(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		ScannedExecutions: 3,
+		MissingDocuments:  1,
+		StaleDocuments:    1,
+		IndexedDocuments:  2,
+	}, nil).Once()
+	env.OnActivity("FindOrphanedDocumentsActivity", mock.Anything, mock.MatchedBy(func(params WorkflowParams) bool {
+		return params.Progress.ScanningDocuments
+	})).Return(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		Done:              true,
+		ScannedExecutions: 3,
+		MissingDocuments:  1,
+		StaleDocuments:    1,
+		IndexedDocuments:  2,
+		ScannedDocuments:  4,
+		OrphanedDocuments: 1,
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{})
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var report Progress
+	s.NoError(env.GetWorkflowResult(&report))
+	s.Equal(int64(3), report.ScannedExecutions)
+	s.Equal(int64(1), report.MissingDocuments)
+	s.Equal(int64(1), report.StaleDocuments)
+	s.Equal(int64(2), report.IndexedDocuments)
+	s.Equal(int64(4), report.ScannedDocuments)
+	s.Equal(int64(1), report.OrphanedDocuments)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestRebuildVisibilityWorkflow_ContinueAsNew() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity("RebuildVisibilityActivity", mock.Anything, mock.Anything).Return(Progress{
+		ShardID:       1,
+		NextPageToken: []byte("next-page"),
+	}, nil).Times(pagesPerRun)
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{})
+
+	s.True(env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(s.T())
+}
+
+type activitiesSuite struct {
+	suite.Suite
+
+	controller            *gomock.Controller
+	mockExecutionManager  *persistence.MockExecutionManager
+	mockNamespaceCache    *cache.MockNamespaceCache
+	mockESClient          *esclient.MockClient
+	mockVisibilityManager *visibility.MockVisibilityManager
+	mockMapper            *searchattribute.MockMapper
+
+	activities *activities
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockESClient = esclient.NewMockClient(s.controller)
+	s.mockVisibilityManager = visibility.NewMockVisibilityManager(s.controller)
+	s.mockMapper = searchattribute.NewMockMapper(s.controller)
+
+	s.activities = newActivities(
+		&Config{
+			ActivityRPS:      dynamicconfig.GetIntPropertyFn(1000),
+			PageSize:         dynamicconfig.GetIntPropertyFn(10),
+			NumHistoryShards: 2,
+		},
+		s.mockExecutionManager,
+		s.mockNamespaceCache,
+		s.mockESClient,
+		"test-index",
+		s.mockVisibilityManager,
+		searchattribute.NewTestProvider(),
+		s.mockMapper,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID("test-namespace-id").Return(namespaceEntry, nil).AnyTimes()
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) newRunningMutableState(workflowID string) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:         "test-namespace-id",
+			WorkflowId:          workflowID,
+			WorkflowTypeName:    "test-workflow-type",
+			TaskQueue:           "test-task-queue",
+			LastFirstEventTxnId: 42,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  workflowID + "-run-id",
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventId: 3,
+	}
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newRunningMutableState("missing-wid"),
+			s.newRunningMutableState("stale-wid"),
+			s.newRunningMutableState("valid-wid"),
+		},
+	}, nil)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{Id: "stale-wid~stale-wid-run-id", Version: convert.Int64Ptr(50), Source: []byte(`{"ExecutionStatus":"Completed"}`)},
+				{Id: "valid-wid~valid-wid-run-id", Version: convert.Int64Ptr(50), Source: []byte(`{"ExecutionStatus":"Running"}`)},
+			},
+		},
+	}, nil)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "test-namespace-id",
+		Execution:   commonpb.WorkflowExecution{WorkflowId: "stale-wid", RunId: "stale-wid-run-id"},
+	}).Return(&persistence.GetWorkflowExecutionResponse{State: s.newRunningMutableState("stale-wid")}, nil)
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *visibility.UpsertWorkflowExecutionRequest) error {
+			s.Equal("test-namespace", request.Namespace)
+			s.True(request.FailOnVersionConflict)
+			switch request.Execution.GetWorkflowId() {
+			case "missing-wid":
+				s.Equal(int64(42), request.TaskID)
+			case "stale-wid":
+				// the document is newer than the listed execution, so the execution is read again before
+				// the document is superseded
+				s.Equal(int64(51), request.TaskID)
+			default:
+				s.Fail("unexpected execution", request.Execution.GetWorkflowId())
+			}
+			return nil
+		}).Times(2)
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{Progress: Progress{ShardID: 1}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScannedExecutions: 3,
+		MissingDocuments:  1,
+		StaleDocuments:    1,
+		IndexedDocuments:  2,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity_StaleSearchAttributesAndMemo() {
+	memo, err := payload.Encode("test-memo")
+	s.NoError(err)
+	memoBlob, err := serialization.NewSerializer().SerializeVisibilityMemo(&commonpb.Memo{Fields: map[string]*commonpb.Payload{"Memo": memo}}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	staleMemoMutableState := s.newRunningMutableState("stale-memo-wid")
+	staleMemoMutableState.ExecutionInfo.Memo = map[string]*commonpb.Payload{"Memo": memo}
+	staleSearchAttributesMutableState := s.newRunningMutableState("stale-sa-wid")
+	intField, err := payload.Encode(int64(2))
+	s.NoError(err)
+	staleSearchAttributesMutableState.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{"CustomIntField": intField}
+	validMutableState := s.newRunningMutableState("valid-wid")
+	validMutableState.ExecutionInfo.SearchAttributes = staleSearchAttributesMutableState.ExecutionInfo.SearchAttributes
+	validMutableState.ExecutionInfo.Memo = staleMemoMutableState.ExecutionInfo.Memo
+	validSource, err := json.Marshal(map[string]interface{}{
+		"ExecutionStatus": "Running",
+		"CustomIntField":  2,
+		"Memo":            memoBlob.GetData(),
+		"MemoEncoding":    memoBlob.GetEncodingType().String(),
+	})
+	s.NoError(err)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			staleMemoMutableState,
+			staleSearchAttributesMutableState,
+			validMutableState,
+		},
+	}, nil)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{Id: "stale-memo-wid~stale-memo-wid-run-id", Version: convert.Int64Ptr(40), Source: []byte(`{"ExecutionStatus":"Running"}`)},
+				{Id: "stale-sa-wid~stale-sa-wid-run-id", Version: convert.Int64Ptr(40), Source: []byte(`{"ExecutionStatus":"Running","CustomIntField":1}`)},
+				{Id: "valid-wid~valid-wid-run-id", Version: convert.Int64Ptr(40), Source: validSource},
+			},
+		},
+	}, nil)
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *visibility.UpsertWorkflowExecutionRequest) error {
+			s.Contains([]string{"stale-memo-wid", "stale-sa-wid"}, request.Execution.GetWorkflowId())
+			s.Equal(int64(42), request.TaskID)
+			return nil
+		}).Times(2)
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{Progress: Progress{ShardID: 2}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		ScannedExecutions: 3,
+		StaleDocuments:    2,
+		IndexedDocuments:  2,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity_NamespaceSearchAttributes() {
+	intField, err := payload.Encode(int64(2))
+	s.NoError(err)
+	staleMutableState := s.newRunningMutableState("stale-wid")
+	staleMutableState.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{"AliasIntField": intField}
+	validMutableState := s.newRunningMutableState("valid-wid")
+	validMutableState.ExecutionInfo.SearchAttributes = staleMutableState.ExecutionInfo.SearchAttributes
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			staleMutableState,
+			validMutableState,
+		},
+	}, nil)
+	// namespace search attributes are stored in the generic fields assigned to them
+	s.mockMapper.EXPECT().GetFieldName("AliasIntField", "test-namespace").Return("Int01", nil).Times(2)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{Id: "stale-wid~stale-wid-run-id", Version: convert.Int64Ptr(40), Source: []byte(`{"ExecutionStatus":"Running","Int01":1}`)},
+				{Id: "valid-wid~valid-wid-run-id", Version: convert.Int64Ptr(40), Source: []byte(`{"ExecutionStatus":"Running","Int01":2}`)},
+			},
+		},
+	}, nil)
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *visibility.UpsertWorkflowExecutionRequest) error {
+			s.Equal("stale-wid", request.Execution.GetWorkflowId())
+			return nil
+		})
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{Progress: Progress{ShardID: 1}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScannedExecutions: 2,
+		StaleDocuments:    1,
+		IndexedDocuments:  1,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity_DocumentNewerThanExecution() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newRunningMutableState("upserted-wid"),
+			s.newRunningMutableState("deleted-wid"),
+		},
+	}, nil)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{Id: "upserted-wid~upserted-wid-run-id", Version: convert.Int64Ptr(50), Source: []byte(`{"ExecutionStatus":"Running","CustomIntField":2}`)},
+				{Id: "deleted-wid~deleted-wid-run-id", Version: convert.Int64Ptr(50), Source: []byte(`{"ExecutionStatus":"Completed"}`)},
+			},
+		},
+	}, nil)
+	// the search attributes were upserted after the execution was listed, the document written by the visibility task
+	// matches the execution read again
+	intField, err := payload.Encode(int64(2))
+	s.NoError(err)
+	upsertedMutableState := s.newRunningMutableState("upserted-wid")
+	upsertedMutableState.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{"CustomIntField": intField}
+	upsertedMutableState.ExecutionInfo.LastFirstEventTxnId = 48
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		ShardID:     2,
+		NamespaceID: "test-namespace-id",
+		Execution:   commonpb.WorkflowExecution{WorkflowId: "upserted-wid", RunId: "upserted-wid-run-id"},
+	}).Return(&persistence.GetWorkflowExecutionResponse{State: upsertedMutableState}, nil)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		ShardID:     2,
+		NamespaceID: "test-namespace-id",
+		Execution:   commonpb.WorkflowExecution{WorkflowId: "deleted-wid", RunId: "deleted-wid-run-id"},
+	}).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{Progress: Progress{ShardID: 2}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		ScannedExecutions: 2,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity_VersionConflict() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newRunningMutableState("missing-wid"),
+		},
+	}, nil)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{Hits: &elastic.SearchHits{}}, nil)
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any()).Return(&elasticsearch.VisibilityTaskNAckError{VisibilityTaskKey: "1~42"})
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{Progress: Progress{ShardID: 2}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		ScannedExecutions: 1,
+		MissingDocuments:  1,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestRebuildVisibilityActivity_DryRun() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newRunningMutableState("missing-wid"),
+		},
+	}, nil)
+	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), "test-index", gomock.Any()).Return(&elastic.SearchResult{Hits: &elastic.SearchHits{}}, nil)
+
+	progress, err := s.activities.RebuildVisibilityActivity(context.Background(), WorkflowParams{DryRun: true, Progress: Progress{ShardID: 2}})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		ScannedExecutions: 1,
+		MissingDocuments:  1,
+	}, progress)
+}
+
+func (s *activitiesSuite) TestFindOrphanedDocumentsActivity() {
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p *esclient.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal("test-index", p.Index)
+			s.Equal(2, p.PageSize)
+			s.Empty(p.SearchAfter)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{
+					Hits: []*elastic.SearchHit{
+						{
+							Id:     "orphaned-wid~orphaned-wid-run-id",
+							Source: []byte(`{"NamespaceId":"test-namespace-id","WorkflowId":"orphaned-wid","RunId":"orphaned-wid-run-id"}`),
+							Sort:   []interface{}{"orphaned-wid", "orphaned-wid-run-id"},
+						},
+						{
+							Id:     "valid-wid~valid-wid-run-id",
+							Source: []byte(`{"NamespaceId":"test-namespace-id","WorkflowId":"valid-wid","RunId":"valid-wid-run-id"}`),
+							Sort:   []interface{}{"valid-wid", "valid-wid-run-id"},
+						},
+					},
+				},
+			}, nil
+		})
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			s.Equal("test-namespace-id", request.NamespaceID)
+			s.Equal(common.WorkflowIDToHistoryShard("test-namespace-id", request.Execution.GetWorkflowId(), 2), request.ShardID)
+			if request.Execution.GetWorkflowId() == "orphaned-wid" {
+				return nil, serviceerror.NewNotFound("workflow execution not found")
+			}
+			return &persistence.GetWorkflowExecutionResponse{State: s.newRunningMutableState("valid-wid")}, nil
+		}).Times(2)
+
+	s.activities.config.PageSize = dynamicconfig.GetIntPropertyFn(2)
+	progress, err := s.activities.FindOrphanedDocumentsActivity(context.Background(), WorkflowParams{Progress: Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+	}})
+	s.NoError(err)
+	s.Equal(`["valid-wid","valid-wid-run-id"]`, string(progress.NextPageToken))
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		NextPageToken:     progress.NextPageToken,
+		ScannedDocuments:  2,
+		OrphanedDocuments: 1,
+	}, progress)
+
+	// the last page is shorter than the page size
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p *esclient.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal([]interface{}{"valid-wid", "valid-wid-run-id"}, p.SearchAfter)
+			return &elastic.SearchResult{Hits: &elastic.SearchHits{}}, nil
+		})
+	progress, err = s.activities.FindOrphanedDocumentsActivity(context.Background(), WorkflowParams{Progress: progress})
+	s.NoError(err)
+	s.Equal(Progress{
+		ShardID:           2,
+		ScanningDocuments: true,
+		Done:              true,
+		ScannedDocuments:  2,
+		OrphanedDocuments: 1,
+	}, progress)
+}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
@@ -59,6 +60,7 @@ type (
 	Scavenger struct {
 		numShards   int32
 		db          persistence.ExecutionManager
+		offloader   payloadoffload.Offloader
 		client      historyservice.HistoryServiceClient
 		rateLimiter quotas.RateLimiter
 		metrics     metrics.Client
@@ -102,6 +104,7 @@ const (
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
+	offloader payloadoffload.Offloader,
 	rps int,
 	client historyservice.HistoryServiceClient,
 	hbd ScavengerHeartbeatDetails,
@@ -112,6 +115,7 @@ func NewScavenger(
 	return &Scavenger{
 		numShards: numShards,
 		db:        db,
+		offloader: offloader,
 		client:    client,
 		rateLimiter: quotas.NewDefaultOutgoingDynamicRateLimiter(
 			func() float64 { return float64(rps) },
@@ -274,7 +278,7 @@ func (s *Scavenger) handleTask(
 		return err
 	}
 
-	err = s.db.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+	err = payloadoffload.DeleteHistoryBranch(ctx, s.offloader, s.db, &persistence.DeleteHistoryBranchRequest{
 		ShardID:     task.shardID,
 		BranchToken: branchToken,
 	})
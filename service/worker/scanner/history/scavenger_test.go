@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
@@ -87,7 +88,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	controller := gomock.NewController(s.T())
 	db := persistence.NewMockExecutionManager(controller)
 	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
-	scvgr := NewScavenger(s.numShards, db, rps, historyClient, ScavengerHeartbeatDetails{}, s.metric, s.logger)
+	scvgr := NewScavenger(s.numShards, db, payloadoffload.NewDisabledOffloader(), rps, historyClient, ScavengerHeartbeatDetails{}, s.metric, s.logger)
 	scvgr.isInTest = true
 	return db, historyClient, scvgr, controller
 }
@@ -160,6 +160,7 @@ func HistoryScavengerActivity(
 	scavenger := history.NewScavenger(
 		numShards,
 		ctx.GetExecutionManager(),
+		ctx.GetPayloadOffloader(),
 		rps,
 		ctx.GetHistoryClient(),
 		hbd,
@@ -26,12 +26,14 @@ package worker
 
 import (
 	"sync/atomic"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -39,13 +41,16 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
+	"go.temporal.io/server/service/worker/rebuildvisibility"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
 )
@@ -61,6 +66,7 @@ type (
 		stopC     chan struct{}
 		sdkClient sdkclient.Client
 		esClient  client.Client
+		esConfig  *config.Elasticsearch
 		config    *Config
 	}
 
@@ -70,6 +76,10 @@ type (
 		ScannerCfg                    *scanner.Config
 		ParentCloseCfg                *parentclosepolicy.Config
 		BatcherCfg                    *batcher.Config
+		DeleteNamespaceCfg            *deletenamespace.Config
+		RebuildVisibilityCfg          *rebuildvisibility.Config
+		ESProcessorCfg                *elasticsearch.ProcessorConfig
+		ESProcessorAckTimeout         dynamicconfig.DurationPropertyFn
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
@@ -94,9 +104,29 @@ func NewService(
 		func(
 			persistenceBean persistenceClient.Bean,
 			searchAttributesProvider searchattribute.Provider,
+			searchAttributesMapper searchattribute.Mapper,
 			logger log.Logger,
 		) (visibility.VisibilityManager, error) {
-			return nil, nil
+			// Worker writes Elasticsearch visibility only when it is rebuilt from the database.
+			if params.ESConfig == nil || params.ESClient == nil {
+				return nil, nil
+			}
+			esProcessor := elasticsearch.NewProcessor(serviceConfig.ESProcessorCfg, params.ESClient, logger, params.MetricsClient)
+			esProcessor.Start()
+
+			visibilityConfigForES := &config.VisibilityConfig{
+				ESProcessorAckTimeout: serviceConfig.ESProcessorAckTimeout,
+			}
+			return elasticsearch.NewVisibilityManager(
+				params.ESConfig.GetVisibilityIndex(),
+				params.ESClient,
+				visibilityConfigForES,
+				searchAttributesProvider,
+				searchAttributesMapper,
+				esProcessor,
+				params.MetricsClient,
+				logger,
+			), nil
 		},
 	)
 	if err != nil {
@@ -109,6 +139,7 @@ func NewService(
 		config:    serviceConfig,
 		sdkClient: params.SdkClient,
 		esClient:  params.ESClient,
+		esConfig:  params.ESConfig,
 		stopC:     make(chan struct{}),
 	}, nil
 }
@@ -151,6 +182,24 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			HistoryScannerEnabled:    dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
 			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
 		},
+		DeleteNamespaceCfg: &deletenamespace.Config{
+			ActivityRPS:      dc.GetIntProperty(dynamicconfig.DeleteNamespaceActivityRPS, 100),
+			PageSize:         dc.GetIntProperty(dynamicconfig.DeleteNamespacePageSize, 1000),
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		},
+		RebuildVisibilityCfg: &rebuildvisibility.Config{
+			ActivityRPS:      dc.GetIntProperty(dynamicconfig.RebuildVisibilityActivityRPS, 100),
+			PageSize:         dc.GetIntProperty(dynamicconfig.RebuildVisibilityPageSize, 100),
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		},
+		ESProcessorCfg: &elasticsearch.ProcessorConfig{
+			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 100),
+			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
+			ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 500),
+			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 16*1024*1024),
+			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 200*time.Millisecond),
+		},
+		ESProcessorAckTimeout:         dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 1*time.Minute),
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
@@ -188,6 +237,8 @@ func (s *Service) Start() {
 	}
 
 	s.startAddSearchAttributes()
+	s.startDeleteNamespace()
+	s.startRebuildVisibility()
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -247,6 +298,41 @@ func (s *Service) startAddSearchAttributes() {
 	}
 }
 
+func (s *Service) startDeleteNamespace() {
+	deleteNamespaceService := deletenamespace.New(
+		s.sdkClient,
+		s.config.DeleteNamespaceCfg,
+		s.GetMetadataManager(),
+		s.GetTaskManager(),
+		s.GetExecutionManager(),
+		s.GetHistoryClient(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	if err := deleteNamespaceService.Start(); err != nil {
+		s.GetLogger().Fatal("error starting delete namespace service", tag.Error(err))
+	}
+}
+
+func (s *Service) startRebuildVisibility() {
+	rebuildVisibilityService := rebuildvisibility.New(
+		s.sdkClient,
+		s.config.RebuildVisibilityCfg,
+		s.GetExecutionManager(),
+		s.GetNamespaceCache(),
+		s.esClient,
+		s.esConfig.GetVisibilityIndex(),
+		s.GetVisibilityManager(),
+		s.GetSearchAttributesProvider(),
+		s.GetSearchAttributesMapper(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	if err := rebuildVisibilityService.Start(); err != nil {
+		s.GetLogger().Fatal("error starting rebuild visibility service", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -283,6 +369,7 @@ func (s *Service) startArchiver() {
 		NamespaceCache:   s.GetNamespaceCache(),
 		Config:           s.config.ArchiverConfig,
 		ArchiverProvider: s.GetArchiverProvider(),
+		PayloadOffloader: s.GetPayloadOffloader(),
 	}
 	clientWorker := archiver.NewClientWorker(bc)
 	if err := clientWorker.Start(); err != nil {
@@ -42,7 +42,9 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadoffload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -55,6 +57,7 @@ import (
 	"go.temporal.io/server/common/ringpop"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/encryption"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/matching"
@@ -68,14 +71,20 @@ const (
 type (
 	// Server is temporal server.
 	Server struct {
-		so                *serverOptions
-		services          map[string]common.Daemon
-		serviceStoppedChs map[string]chan struct{}
-		stoppedCh         chan interface{}
-		logger            log.Logger
-		namespaceLogger   log.Logger
-		serverReporter    metrics.Reporter
-		sdkReporter       metrics.Reporter
+		so                   *serverOptions
+		services             map[string]common.Daemon
+		serviceStoppedChs    map[string]chan struct{}
+		stoppedCh            chan interface{}
+		logger               log.Logger
+		namespaceLogger      log.Logger
+		serverReporter       metrics.Reporter
+		sdkReporter          metrics.Reporter
+		dynamicConfigFactory persistenceClient.Factory
+		dynamicConfigManager persistence.DynamicConfigManager
+		// cancelLogLevels cancels the dynamic config subscriptions of the log levels
+		cancelLogLevels []func()
+		// stopTracing flushes the pending spans and stops the tracer provider
+		stopTracing func()
 	}
 )
 
@@ -110,7 +119,7 @@ func (s *Server) Start() error {
 
 	s.logger = s.so.logger
 	if s.logger == nil {
-		s.logger = log.NewZapLogger(log.BuildZapLogger(s.so.config.Log))
+		s.logger, s.so.logLevels = log.NewLeveledZapLogger(s.so.config.Log)
 	}
 	s.namespaceLogger = s.so.namespaceLogger
 
@@ -146,6 +155,32 @@ func (s *Server) Start() error {
 		return fmt.Errorf("ringpop config validation error: %w", err)
 	}
 
+	// values persisted through the admin API take precedence over the static dynamic config,
+	// the persistence based client and its manager are shared by all services of the server.
+	// The manager is created before cluster metadata and system namespaces are initialized, so in-memory
+	// databases, which are destroyed together with their last connection, are kept until the server stops.
+	s.dynamicConfigFactory = persistenceClient.NewFactory(
+		&s.so.config.Persistence,
+		s.so.persistenceServiceResolver,
+		nil,
+		s.so.customDataStoreFactory,
+		s.so.config.ClusterMetadata.CurrentClusterName,
+		nil,
+		s.logger,
+	)
+	dynamicConfigClient, dynamicConfigManager, err := newPersistenceBasedDynamicConfigClient(
+		s.dynamicConfigFactory,
+		s.so.dynamicConfigClient,
+		s.logger,
+		s.stoppedCh)
+	if err != nil {
+		s.logger.Error("Error creating persistence based dynamic config client, persisted dynamic config values are ignored.", tag.Error(err))
+	} else {
+		s.so.dynamicConfigClient = dynamicConfigClient
+		s.dynamicConfigManager = dynamicConfigManager
+		dc = dynamicconfig.NewCollection(s.so.dynamicConfigClient, s.logger)
+	}
+
 	err = updateClusterMetadataConfig(s.so.config, s.so.persistenceServiceResolver, s.logger, s.so.customDataStoreFactory)
 	if err != nil {
 		return fmt.Errorf("unable to initialize cluster metadata: %w", err)
@@ -161,6 +196,10 @@ func (s *Server) Start() error {
 		return fmt.Errorf("unable to initialize system namespace: %w", err)
 	}
 
+	if s.so.logLevels != nil {
+		s.cancelLogLevels = subscribeLogLevels(dc, s.so.config.Log, s.so.logLevels)
+	}
+
 	// todo: Replace this with Client or Scope implementation.
 	var globalMetricsScope tally.Scope = nil
 
@@ -177,6 +216,13 @@ func (s *Server) Start() error {
 		}
 	}
 
+	if s.so.config.Global.Tracing != nil {
+		s.stopTracing, err = tracing.InitTracerProvider(s.so.config.Global.Tracing, s.logger)
+		if err != nil {
+			return fmt.Errorf("unable to initialize tracing: %w", err)
+		}
+	}
+
 	if s.so.tlsConfigProvider == nil {
 		s.so.tlsConfigProvider, err = encryption.NewTLSConfigProviderFromConfig(
 			s.so.config.Global.TLS, globalMetricsScope, s.logger, nil)
@@ -260,6 +306,26 @@ func (s *Server) Stop() {
 	if s.serverReporter != nil {
 		s.serverReporter.Stop(s.logger)
 	}
+
+	if s.dynamicConfigManager != nil {
+		s.dynamicConfigManager.Close()
+	}
+	if s.dynamicConfigFactory != nil {
+		s.dynamicConfigFactory.Close()
+	}
+
+	// Authorizer may reload its rules in background (i.e. policy authorizer).
+	if authorizer, ok := s.so.authorizer.(interface{ Stop() }); ok {
+		authorizer.Stop()
+	}
+
+	for _, cancel := range s.cancelLogLevels {
+		cancel()
+	}
+
+	if s.stopTracing != nil {
+		s.stopTracing()
+	}
 }
 
 // Populates parameters for a service
@@ -278,6 +344,7 @@ func (s *Server) newBootstrapParams(
 		NamespaceLogger:          s.namespaceLogger,
 		PersistenceConfig:        s.so.config.Persistence,
 		DynamicConfigClient:      s.so.dynamicConfigClient,
+		DynamicConfigManager:     s.dynamicConfigManager,
 		ClusterMetadataConfig:    s.so.config.ClusterMetadata,
 		DCRedirectionPolicy:      s.so.config.DCRedirectionPolicy,
 		AbstractDatastoreFactory: s.so.customDataStoreFactory,
@@ -299,6 +366,16 @@ func (s *Server) newBootstrapParams(
 
 	params.MembershipFactoryInitializer =
 		func(persistenceBean persistenceClient.Bean, logger log.Logger) (resource.MembershipMonitorFactory, error) {
+			if s.so.config.Global.Membership.Provider == config.MembershipProviderDatabase {
+				return membership.NewDBMonitorFactory(
+					svcName,
+					servicePortMap,
+					rpcFactory.GetGRPCListener,
+					s.so.config.Global.Membership.BroadcastAddress,
+					logger,
+					persistenceBean.GetClusterMetadataManager(),
+				), nil
+			}
 			return ringpop.NewRingpopFactory(
 				&s.so.config.Global.Membership,
 				rpcFactory.GetRingpopChannel(),
@@ -365,7 +442,13 @@ func (s *Server) newBootstrapParams(
 	)
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.so.config.Archival.History.Provider, s.so.config.Archival.Visibility.Provider)
+	params.PayloadOffloader, err = s.newPayloadOffloader()
+	if err != nil {
+		return nil, err
+	}
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	params.PersistenceConfig.EnableCompression = dc.GetBoolProperty(dynamicconfig.EnablePersistenceCompression, false)
+	params.PersistenceConfig.FaultInjection = dc.SubscribeMapProperty(dynamicconfig.PersistenceFaultInjection, nil)
 
 	if s.so.authorizer != nil {
 		params.Authorizer = s.so.authorizer
@@ -384,6 +467,26 @@ func (s *Server) newBootstrapParams(
 	return params, nil
 }
 
+func (s *Server) newPayloadOffloader() (payloadoffload.Offloader, error) {
+	offloadConfig := s.so.config.PayloadOffload
+	if len(offloadConfig.URI) == 0 {
+		return payloadoffload.NewDisabledOffloader(), nil
+	}
+
+	uri, err := archiver.NewURI(offloadConfig.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload offload URI: %w", err)
+	}
+	store, err := provider.NewPayloadStore(uri.Scheme(), offloadConfig.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create payload store: %w", err)
+	}
+	if err := store.ValidateURI(uri); err != nil {
+		return nil, fmt.Errorf("invalid payload offload URI: %w", err)
+	}
+	return payloadoffload.NewOffloader(store, uri), nil
+}
+
 func (s *Server) getESConfigClient(advancedVisibilityWritingMode string) (*config.Elasticsearch, client.Client, error) {
 	if advancedVisibilityWritingMode == common.AdvancedVisibilityWritingModeOff {
 		return nil, nil, nil
@@ -523,6 +626,58 @@ func initSystemNamespaces(
 	return nil
 }
 
+func newPersistenceBasedDynamicConfigClient(
+	factory persistenceClient.Factory,
+	base dynamicconfig.Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (dynamicconfig.Client, persistence.DynamicConfigManager, error) {
+	dynamicConfigManager, err := factory.NewDynamicConfigManager()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to initialize dynamic config manager: %w", err)
+	}
+	dcClient, err := dynamicconfig.NewPersistenceBasedClient(dynamicConfigManager, base, logger, doneCh)
+	if err != nil {
+		dynamicConfigManager.Close()
+		return nil, nil, err
+	}
+	return dcClient, dynamicConfigManager, nil
+}
+
+// subscribeLogLevels applies the log levels from dynamic config to the logger as soon as
+// they change, the levels of the static log config are used when they are not set
+func subscribeLogLevels(
+	dc *dynamicconfig.Collection,
+	cfg log.Config,
+	levels *log.Levels,
+) []func() {
+	return []func(){
+		dc.SubscribeStringProperty(dynamicconfig.LogLevel, cfg.Level)(levels.SetLevel),
+		dc.SubscribeMapProperty(dynamicconfig.LogServiceLevels, toInterfaceMap(cfg.ServiceLevels))(func(value map[string]interface{}) {
+			levels.SetServiceLevels(toStringMap(value))
+		}),
+		dc.SubscribeMapProperty(dynamicconfig.LogComponentLevels, toInterfaceMap(cfg.ComponentLevels))(func(value map[string]interface{}) {
+			levels.SetComponentLevels(toStringMap(value))
+		}),
+	}
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func toStringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = fmt.Sprintf("%v", v)
+	}
+	return result
+}
+
 func (s *Server) extractTallyScopeForSDK(sdkReporter metrics.Reporter) (tally.Scope, error) {
 	if sdkTallyReporter, ok := sdkReporter.(*metrics.TallyReporter); ok {
 		return sdkTallyReporter.GetScope(), nil
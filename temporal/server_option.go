@@ -75,6 +75,13 @@ func WithLogger(logger log.Logger) ServerOption {
 	})
 }
 
+// Sets the levels of the logger, so they can be changed at runtime through dynamic config
+func WithLogLevels(levels *log.Levels) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.logLevels = levels
+	})
+}
+
 // Sets optional logger for all frontend operations
 func WithNamespaceLogger(namespaceLogger log.Logger) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
@@ -51,6 +51,7 @@ type (
 		blockingStart bool
 
 		logger                     log.Logger
+		logLevels                  *log.Levels
 		namespaceLogger            log.Logger
 		authorizer                 authorization.Authorizer
 		tlsConfigProvider          encryption.TLSConfigProvider
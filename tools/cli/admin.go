@@ -256,6 +256,20 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminRemoveTask(c)
 			},
 		},
+		{
+			Name:    "queue-state",
+			Aliases: []string{"qs"},
+			Usage:   "Describe the in-memory state of the task queue processors of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "The ID of the shard",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShardQueues(c)
+			},
+		},
 	}
 }
 
@@ -390,6 +404,48 @@ func newAdminNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, true).DescribeNamespace(c)
 			},
 		},
+		{
+			Name:  "delete",
+			Usage: "Delete namespace with all its workflow executions and task queues, the data is deleted in the background",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the deletion",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator deleting the namespace",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteNamespace(c)
+			},
+		},
+		{
+			Name:  "rename",
+			Usage: "Rename namespace, the previous name keeps resolving to the namespace until its alias expires",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNewName,
+					Usage: "New name of the namespace",
+				},
+				cli.StringFlag{
+					Name:  FlagAliasTTL,
+					Usage: "How long the previous name keeps resolving to the namespace, e.g. 3d or 12h (default is 7 days)",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the rename",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator renaming the namespace",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRenameNamespace(c)
+			},
+		},
 		{
 			Name:    "get_namespaceidorname",
 			Aliases: []string{"getdn"},
@@ -490,6 +546,28 @@ func newAdminElasticSearchCommands() []cli.Command {
 				GenerateReport(c)
 			},
 		},
+		{
+			Name:    "rebuild",
+			Aliases: []string{"rb"},
+			Usage:   "Start a system workflow which rebuilds visibility documents on Elasticsearch from the executions in the persistence store",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only report missing and stale visibility documents without indexing them",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRebuildVisibility(c)
+			},
+		},
+		{
+			Name:    "rebuild-status",
+			Aliases: []string{"rbs"},
+			Usage:   "Show progress of the visibility rebuild workflow",
+			Action: func(c *cli.Context) {
+				AdminRebuildVisibilityStatus(c)
+			},
+		},
 	}
 }
 
@@ -573,6 +651,10 @@ func newAdminClusterCommands() []cli.Command {
 					Name:  FlagTypeWithAlias,
 					Usage: fmt.Sprintf("Search attribute type: %v (multiply values are supported)", allowedEnumValues(enumspb.IndexedValueType_name)),
 				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Namespace to register search attributes for (optional, cluster-wide if omitted)",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminAddSearchAttributes(c)
@@ -592,11 +674,34 @@ func newAdminClusterCommands() []cli.Command {
 					Name:  FlagNameWithAlias,
 					Usage: "Search attribute name",
 				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Namespace to remove search attributes from (optional, cluster-wide if omitted)",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminRemoveSearchAttributes(c)
 			},
 		},
+		{
+			Name:    "reclaim-search-attribute-fields",
+			Aliases: []string{"rsaf"},
+			Usage:   "Clear values of search attributes removed from the namespace and make their fields available to new search attributes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:   FlagIndex,
+					Usage:  "Elasticsearch index name (optional)",
+					Hidden: true, // don't show it for now
+				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Namespace to reclaim search attribute fields of",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReclaimSearchAttributeFields(c)
+			},
+		},
 		{
 			Name:    "get-search-attributes",
 			Aliases: []string{"gsa"},
@@ -606,6 +711,10 @@ func newAdminClusterCommands() []cli.Command {
 					Name:  FlagPrintJSONWithAlias,
 					Usage: "Output in JSON format",
 				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Namespace to show search attributes of (optional, cluster-wide if omitted)",
+				},
 				cli.StringFlag{
 					Name:   FlagIndex,
 					Usage:  "Elasticsearch index name (optional)",
@@ -644,11 +753,11 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
-					Usage: "Source cluster",
+					Usage: "Source cluster, not needed by task DLQ",
 				},
 				cli.IntFlag{
 					Name:  FlagShardIDWithAlias,
@@ -678,11 +787,11 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
-					Usage: "Source cluster",
+					Usage: "Source cluster, not needed by task DLQ",
 				},
 				cli.IntFlag{
 					Name:  FlagShardIDWithAlias,
@@ -704,11 +813,11 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
-					Usage: "Source cluster",
+					Usage: "Source cluster, not needed by task DLQ",
 				},
 				cli.IntFlag{
 					Name:  FlagShardIDWithAlias,
@@ -822,7 +931,7 @@ func newDecodeCommands() []cli.Command {
 	return []cli.Command{
 		{
 			Name:  "proto",
-			Usage: "Decode proto payload",
+			Usage: "Decode proto payload, compressed persistence blobs are decompressed first",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagProtoType,
@@ -864,3 +973,102 @@ func newDecodeCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminDynamicConfigCommands() []cli.Command {
+	constraintFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagConstraintNamespace,
+			Usage: "Optional namespace the value is constrained to",
+		},
+		cli.StringFlag{
+			Name:  FlagConstraintTaskQueue,
+			Usage: "Optional task queue the value is constrained to",
+		},
+		cli.StringFlag{
+			Name:  FlagConstraintTaskQueueType,
+			Usage: "Optional task queue type the value is constrained to [workflow|activity]",
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:  "get",
+			Usage: "Get the persisted values of a dynamic config key",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Set a dynamic config value, the value is picked up by all hosts within seconds",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "Dynamic config value in YAML format, i.e. 100, true, 10s or {key: value}",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the change",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator making the change",
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) {
+				AdminSetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a dynamic config value, the key falls back to the static config",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the change",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator making the change",
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) {
+				AdminDeleteDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List all persisted dynamic config values",
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "history",
+			Usage: "Show the history of dynamic config changes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Optional dynamic config key to show the changes of",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfigHistory(c)
+			},
+		},
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/fatih/color"
 	"github.com/olivere/elastic/v7"
 	"github.com/urfave/cli"
 	commonpb "go.temporal.io/api/common/v1"
@@ -241,7 +242,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		fmt.Println("Deleting history events for:")
 		prettyPrintJSONObject(branchInfo)
 		execStore := cassandra.NewExecutionStore(session, log.NewNoopLogger())
-		execMgr := persistence.NewExecutionManager(execStore, log.NewNoopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+		execMgr := persistence.NewExecutionManager(execStore, serialization.NewSerializer(), log.NewNoopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 		err = execMgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
 			BranchToken: branchToken,
 			ShardID:     int32(shardIDInt),
@@ -369,6 +370,73 @@ func connectToCassandra(c *cli.Context) gocql.Session {
 	return session
 }
 
+// AdminDeleteNamespace deletes a namespace with all its data
+func AdminDeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	reason := getRequiredOption(c, FlagReason)
+	identity := getCliIdentity()
+	if c.IsSet(FlagIdentity) {
+		identity = c.String(FlagIdentity)
+	}
+
+	promptMsg := color.RedString(fmt.Sprintf(
+		"You are about to delete namespace %s with all its workflow executions and task queues, this cannot be undone. Continue? Y/N",
+		namespace,
+	))
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{
+		Namespace: namespace,
+		Identity:  identity,
+		Reason:    reason,
+	})
+	if err != nil {
+		ErrorAndExit("Delete namespace failed", err)
+	}
+	fmt.Printf("Namespace %s is being deleted by workflow %s (run %s) in namespace %s.\n",
+		namespace, resp.GetWorkflowId(), resp.GetRunId(), common.SystemLocalNamespace)
+	fmt.Println("Run namespace describe to see the progress of the deletion.")
+}
+
+// AdminRenameNamespace renames namespace
+func AdminRenameNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	newName := getRequiredOption(c, FlagNewName)
+	reason := c.String(FlagReason)
+	identity := getCliIdentity()
+	if c.IsSet(FlagIdentity) {
+		identity = c.String(FlagIdentity)
+	}
+	var aliasTTL *time.Duration
+	if c.IsSet(FlagAliasTTL) {
+		ttl, err := timestamp.ParseDurationDefaultDays(c.String(FlagAliasTTL))
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagAliasTTL), err)
+		}
+		aliasTTL = &ttl
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   newName,
+		AliasTtl:  aliasTTL,
+		Identity:  identity,
+		Reason:    reason,
+	})
+	if err != nil {
+		ErrorAndExit("Rename namespace failed", err)
+	}
+	fmt.Printf("Namespace %s (id %s) is renamed to %s.\n", namespace, resp.GetNamespaceId(), newName)
+}
+
 // AdminGetNamespaceIDOrName map namespace
 func AdminGetNamespaceIDOrName(c *cli.Context) {
 	namespaceID := c.String(FlagNamespaceID)
@@ -647,6 +715,44 @@ func AdminShardManagement(c *cli.Context) {
 	}
 }
 
+// AdminDescribeShardQueues describes the ack levels and in-memory tasks of the task queues of a shard
+func AdminDescribeShardQueues(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	sid := getRequiredIntOption(c, FlagShardID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	// a queue which doesn't fit into a page is continued by the next page, its tasks are merged back
+	result := &adminservice.DescribeShardQueuesResponse{ShardId: int32(sid)}
+	var nextPageToken []byte
+	for {
+		resp, err := adminClient.DescribeShardQueues(ctx, &adminservice.DescribeShardQueuesRequest{
+			ShardId:       int32(sid),
+			PageSize:      defaultPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			ErrorAndExit("Describe shard queues failed", err)
+		}
+		queues := resp.GetQueues()
+		if len(nextPageToken) > 0 && len(queues) > 0 && len(result.Queues) > 0 {
+			last := result.Queues[len(result.Queues)-1]
+			if last.GetCategory() == queues[0].GetCategory() && last.GetClusterName() == queues[0].GetClusterName() {
+				last.OutstandingTasks = append(last.OutstandingTasks, queues[0].GetOutstandingTasks()...)
+				queues = queues[1:]
+			}
+		}
+		result.Queues = append(result.Queues, queues...)
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	prettyPrintJSONObject(result)
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context) {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -29,15 +29,18 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/urfave/cli"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/persistence"
 )
 
 const (
@@ -51,7 +54,7 @@ func AdminGetDLQMessages(c *cli.Context) {
 
 	adminClient := cFactory.AdminClient(c)
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagCluster)
+	sourceCluster := getDLQSourceCluster(c, dlqType)
 	shardID := getRequiredIntOption(c, FlagShardID)
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
 	defer outputFile.Close()
@@ -81,6 +84,9 @@ func AdminGetDLQMessages(c *cli.Context) {
 		for _, item := range resp.GetReplicationTasks() {
 			paginateItems = append(paginateItems, item)
 		}
+		for _, item := range resp.GetHistoryTasks() {
+			paginateItems = append(paginateItems, item)
+		}
 		return paginateItems, resp.GetNextPageToken(), err
 	}
 
@@ -92,14 +98,21 @@ func AdminGetDLQMessages(c *cli.Context) {
 			ErrorAndExit(fmt.Sprintf("fail to read dlq message. Last read message id: %v", lastReadMessageID), err)
 		}
 
-		task := item.(*replicationspb.ReplicationTask)
+		var task proto.Message
+		var taskID int64
+		switch t := item.(type) {
+		case *replicationspb.ReplicationTask:
+			task, taskID = t, t.SourceTaskId
+		case *persistencespb.HistoryDLQTaskInfo:
+			task, taskID = t, persistence.GetHistoryDLQTaskID(t)
+		}
 		encoder := codec.NewJSONPBIndentEncoder(" ")
 		taskStr, err := encoder.Encode(task)
 		if err != nil {
 			ErrorAndExit(fmt.Sprintf("fail to encode dlq message. Last read message id: %v", lastReadMessageID), err)
 		}
 
-		lastReadMessageID = int(task.SourceTaskId)
+		lastReadMessageID = int(taskID)
 		remainingMessageCount--
 		_, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(taskStr)))
 		if err != nil {
@@ -114,7 +127,7 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 	defer cancel()
 
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagCluster)
+	sourceCluster := getDLQSourceCluster(c, dlqType)
 	shardID := getRequiredIntOption(c, FlagShardID)
 
 	var lastMessageID int64
@@ -142,7 +155,7 @@ func AdminMergeDLQMessages(c *cli.Context) {
 	defer cancel()
 
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagCluster)
+	sourceCluster := getDLQSourceCluster(c, dlqType)
 	shardID := getRequiredIntOption(c, FlagShardID)
 
 	var lastMessageID int64
@@ -181,12 +194,22 @@ func toQueueType(dlqType string) enumsspb.DeadLetterQueueType {
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE
 	case "history":
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION
+	case "task":
+		return enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK
 	default:
 		ErrorAndExit("The queue type is not supported.", fmt.Errorf("the queue type is not supported. Type: %v", dlqType))
 	}
 	return enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE
 }
 
+// getDLQSourceCluster returns the source cluster flag, which is not needed by the history task DLQ
+func getDLQSourceCluster(c *cli.Context, dlqType string) string {
+	if toQueueType(dlqType) == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		return c.String(FlagCluster)
+	}
+	return getRequiredOption(c, FlagCluster)
+}
+
 func confirmOrExit(message string) {
 	fmt.Println(message + " (Y/n)")
 	reader := bufio.NewReader(os.Stdin)
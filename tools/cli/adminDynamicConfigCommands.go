@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/collection"
+)
+
+// AdminGetDynamicConfig prints the persisted values of a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	key := getRequiredOption(c, FlagDynamicConfigKey)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Key: key,
+	})
+	if err != nil {
+		ErrorAndExit("Get dynamic config failed", err)
+	}
+	prettyPrintJSONObject(resp.GetValues())
+}
+
+// AdminSetDynamicConfig persists a dynamic config value
+func AdminSetDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	key := getRequiredOption(c, FlagDynamicConfigKey)
+	value := getRequiredOption(c, FlagDynamicConfigValue)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{
+			Key:         key,
+			Constraints: getDynamicConfigConstraints(c),
+			Value:       value,
+		},
+		Identity: getDynamicConfigIdentity(c),
+		Reason:   reason,
+	})
+	if err != nil {
+		ErrorAndExit("Set dynamic config failed", err)
+	}
+	fmt.Println("Dynamic config value has been set.")
+}
+
+// AdminDeleteDynamicConfig deletes a persisted dynamic config value
+func AdminDeleteDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	key := getRequiredOption(c, FlagDynamicConfigKey)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{
+		Key:         key,
+		Constraints: getDynamicConfigConstraints(c),
+		Identity:    getDynamicConfigIdentity(c),
+		Reason:      reason,
+	})
+	if err != nil {
+		ErrorAndExit("Delete dynamic config failed", err)
+	}
+	fmt.Println("Dynamic config value has been deleted.")
+}
+
+// AdminListDynamicConfig prints all persisted dynamic config values
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	if err != nil {
+		ErrorAndExit("List dynamic config failed", err)
+	}
+	prettyPrintJSONObject(resp.GetValues())
+}
+
+// AdminListDynamicConfigHistory prints the audit history of the persisted dynamic config
+func AdminListDynamicConfigHistory(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	key := c.String(FlagDynamicConfigKey)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		resp, err := adminClient.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{
+			Key:           key,
+			PageSize:      defaultPageSize,
+			NextPageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		var paginateItems []interface{}
+		for _, change := range resp.GetChanges() {
+			paginateItems = append(paginateItems, change)
+		}
+		return paginateItems, resp.GetNextPageToken(), nil
+	}
+
+	var changes []*persistencespb.DynamicConfigChange
+	iterator := collection.NewPagingIterator(paginationFunc)
+	for iterator.HasNext() {
+		item, err := iterator.Next()
+		if err != nil {
+			ErrorAndExit("List dynamic config history failed", err)
+		}
+		changes = append(changes, item.(*persistencespb.DynamicConfigChange))
+	}
+	prettyPrintJSONObject(changes)
+}
+
+func getDynamicConfigConstraints(c *cli.Context) *persistencespb.DynamicConfigConstraints {
+	constraints := &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagConstraintNamespace),
+		TaskQueueName: c.String(FlagConstraintTaskQueue),
+	}
+	if c.IsSet(FlagConstraintTaskQueueType) {
+		constraints.TaskType = strToTaskQueueType(c.String(FlagConstraintTaskQueueType))
+	}
+	return constraints
+}
+
+func getDynamicConfigIdentity(c *cli.Context) string {
+	if c.IsSet(FlagIdentity) {
+		return c.String(FlagIdentity)
+	}
+	return getCliIdentity()
+}
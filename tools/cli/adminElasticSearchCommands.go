@@ -38,6 +38,8 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
 	dc "go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -48,6 +50,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch/esql"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/rebuildvisibility"
 	"go.uber.org/atomic"
 )
 
@@ -159,7 +162,7 @@ func AdminIndex(c *cli.Context) {
 	}
 
 	// TODO: build search attribute provider to get search attributes from command line args.
-	visibilityManager := elasticsearch.NewVisibilityManager(indexName, esClient, visibilityConfigForES, searchattribute.NewSystemProvider(), esProcessor, metrics.NewNoopMetricsClient(), logger)
+	visibilityManager := elasticsearch.NewVisibilityManager(indexName, esClient, visibilityConfigForES, searchattribute.NewSystemProvider(), nil, esProcessor, metrics.NewNoopMetricsClient(), logger)
 
 	successLines := &atomic.Int32{}
 	wg := &sync.WaitGroup{}
@@ -525,3 +528,49 @@ func wrapWithTag(content string, tag string, property string) string {
 	}
 	return "<" + tag + property + ">" + content + "</" + tag + ">\n"
 }
+
+// AdminRebuildVisibility starts the system workflow which rebuilds visibility documents on Elasticsearch
+func AdminRebuildVisibility(c *cli.Context) {
+	dryRun := c.Bool(FlagDryRun)
+	if !dryRun {
+		prompt("Missing and stale visibility documents will be re-indexed on Elasticsearch. Continue?", c.GlobalBool(FlagAutoConfirm))
+	}
+
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	options := sdkclient.StartWorkflowOptions{
+		ID:        rebuildvisibility.WorkflowID,
+		TaskQueue: rebuildvisibility.TaskQueueName,
+	}
+	params := rebuildvisibility.WorkflowParams{
+		DryRun: dryRun,
+	}
+	wf, err := client.ExecuteWorkflow(ctx, options, rebuildvisibility.WorkflowName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start visibility rebuild workflow", err)
+	}
+	output := map[string]interface{}{
+		"msg":        "visibility rebuild workflow is started",
+		"workflowId": wf.GetID(),
+		"runId":      wf.GetRunID(),
+		"dryRun":     dryRun,
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminRebuildVisibilityStatus shows progress of the visibility rebuild workflow
+func AdminRebuildVisibilityStatus(c *cli.Context) {
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.QueryWorkflow(ctx, rebuildvisibility.WorkflowID, "", rebuildvisibility.QueryTypeProgress)
+	if err != nil {
+		ErrorAndExit("Failed to query visibility rebuild workflow", err)
+	}
+	var progress rebuildvisibility.Progress
+	if err := resp.Get(&progress); err != nil {
+		ErrorAndExit("Failed to decode visibility rebuild progress", err)
+	}
+	prettyPrintJSONObject(progress)
+}
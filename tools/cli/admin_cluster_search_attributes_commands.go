@@ -90,6 +90,7 @@ func AdminAddSearchAttributes(c *cli.Context) {
 		SearchAttributes: searchAttributes,
 		IndexName:        c.String(FlagIndex),
 		SkipSchemaUpdate: c.Bool(FlagSkipSchemaUpdate),
+		Namespace:        c.String(FlagNamespace),
 	}
 
 	ctx, cancel := newContext(c)
@@ -124,6 +125,7 @@ func AdminRemoveSearchAttributes(c *cli.Context) {
 	request := &adminservice.RemoveSearchAttributesRequest{
 		SearchAttributes: names,
 		IndexName:        c.String(FlagIndex),
+		Namespace:        c.String(FlagNamespace),
 	}
 
 	_, err := adminClient.RemoveSearchAttributes(ctx, request)
@@ -139,6 +141,32 @@ func AdminRemoveSearchAttributes(c *cli.Context) {
 	color.HiGreen("Search attributes have been removed successfully.")
 }
 
+// AdminReclaimSearchAttributeFields to clear values of removed namespace search attributes and reuse their fields
+func AdminReclaimSearchAttributeFields(c *cli.Context) {
+	namespace := getRequiredOption(c, FlagNamespace)
+
+	// ask user for confirmation
+	promptMsg := fmt.Sprintf(
+		"You are about to clear values of search attributes removed from namespace %s. Continue? Y/N",
+		color.YellowString(namespace),
+	)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &adminservice.ReclaimSearchAttributeFieldsRequest{
+		Namespace: namespace,
+		IndexName: c.String(FlagIndex),
+	}
+
+	_, err := adminClient.ReclaimSearchAttributeFields(ctx, request)
+	if err != nil {
+		ErrorAndExit("Unable to reclaim search attribute fields.", err)
+	}
+	color.HiGreen("Search attribute fields have been reclaimed successfully.")
+}
+
 // AdminGetSearchAttributes to print search attributes
 func AdminGetSearchAttributes(c *cli.Context) {
 	adminClient := cFactory.AdminClient(c)
@@ -158,6 +186,7 @@ func getSearchAttributes(c *cli.Context, adminClient adminservice.AdminServiceCl
 	defer cancel()
 	request := &adminservice.GetSearchAttributesRequest{
 		IndexName: c.String(FlagIndex),
+		Namespace: c.String(FlagNamespace),
 	}
 	return adminClient.GetSearchAttributes(ctx, request)
 }
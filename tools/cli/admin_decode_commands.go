@@ -36,6 +36,7 @@ import (
 	"github.com/urfave/cli"
 
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 func AdminDecodeProto(c *cli.Context) {
@@ -85,6 +86,13 @@ func AdminDecodeProto(c *cli.Context) {
 		ErrorAndExit("No data flag is specified", nil)
 	}
 
+	if serialization.IsCompressedBlob(protoData) {
+		protoData, err = serialization.DecompressBlob(protoData)
+		if err != nil {
+			ErrorAndExit("Unable to decompress data", err)
+		}
+	}
+
 	messageType := proto.MessageType(protoType)
 	if messageType == nil {
 		ErrorAndExit(fmt.Sprintf("Unable to find %s type", protoType), nil)
@@ -208,6 +208,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Decode payload",
 					Subcommands: newDecodeCommands(),
 				},
+				{
+					Name:        "dynamicconfig",
+					Aliases:     []string{"dc"},
+					Usage:       "Run admin operation on persisted dynamic config",
+					Subcommands: newAdminDynamicConfigCommands(),
+				},
 			},
 		},
 		{
@@ -599,6 +599,16 @@ func (s *cliAppSuite) TestAdminRemoveSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminReclaimSearchAttributeFields() {
+	request := &adminservice.ReclaimSearchAttributeFieldsRequest{
+		Namespace: cliTestNamespace,
+	}
+	s.serverAdminClient.EXPECT().ReclaimSearchAttributeFields(gomock.Any(), request)
+
+	err := s.app.Run([]string{"", "--auto_confirm", "admin", "cl", "rsaf", "--namespace", cliTestNamespace})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminGetSearchAttributes() {
 	getRequest := &adminservice.GetSearchAttributesRequest{}
 	s.serverAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), getRequest)
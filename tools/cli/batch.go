@@ -65,6 +65,49 @@ func newBatchCommands() []cli.Command {
 				TerminateBatchJob(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a running batch operation job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch Job Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseBatchJob(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "resume a paused batch operation job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch Job Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResumeBatchJob(c)
+			},
+		},
+		{
+			Name:  "update-rps",
+			Usage: "change the RPS of a running batch operation job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch Job Id",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "New RPS of processing",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateBatchJobRPS(c)
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
@@ -105,6 +148,14 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, types supported: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Required for batch reset with resetType of " + batcher.ResetTypeBadBinary,
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -140,6 +140,8 @@ const (
 	FlagVisibilityArchivalURIWithAlias        = FlagVisibilityArchivalURI + ", vuri"
 	FlagName                                  = "name"
 	FlagNameWithAlias                         = FlagName + ", n"
+	FlagNewName                               = "new_name"
+	FlagAliasTTL                              = "alias_ttl"
 	FlagOutputFilename                        = "output_filename"
 	FlagOutputFilenameWithAlias               = FlagOutputFilename + ", of"
 	FlagOutputFormat                          = "output"
@@ -237,6 +239,11 @@ const (
 	FlagType                                  = "type"
 	FlagTypeWithAlias                         = FlagType + ", t"
 	FlagVersion                               = "version"
+	FlagDynamicConfigKey                      = "key"
+	FlagDynamicConfigValue                    = "value"
+	FlagConstraintNamespace                   = "constraint_namespace"
+	FlagConstraintTaskQueue                   = "constraint_taskqueue"
+	FlagConstraintTaskQueueType               = "constraint_taskqueuetype"
 
 	FlagProtoType  = "type"
 	FlagHexData    = "hex_data"
@@ -666,7 +673,7 @@ func getESFlags(index bool) []cli.Flag {
 		cli.StringFlag{
 			Name:  FlagVersion,
 			Value: "v7",
-			Usage: "Version of Elasticsearch cluster: v6 or v7 (default)",
+			Usage: "Version of Elasticsearch cluster: v6, v7 (default), v8, opensearch1 or opensearch2",
 		},
 	}
 	if index {
@@ -41,6 +41,7 @@ import (
 
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 type (
@@ -343,6 +344,16 @@ func printNamespace(resp *workflowservice.DescribeNamespaceResponse) {
 		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
 		descValues = append(descValues, resp.Config.GetVisibilityArchivalUri())
 	}
+	if resp.NamespaceInfo.GetState() == enumspb.NAMESPACE_STATE_DELETED {
+		data := resp.NamespaceInfo.GetData()
+		formatStr = formatStr + "DeletionStage: %v\nDeletionProgress: %v executions terminated, %v executions deleted, %v task queues deleted\n"
+		descValues = append(descValues,
+			data[deletenamespace.DataKeyStage],
+			data[deletenamespace.DataKeyTerminatedExecutions],
+			data[deletenamespace.DataKeyDeletedExecutions],
+			data[deletenamespace.DataKeyDeletedTaskQueues],
+		)
+	}
 	fmt.Printf(formatStr, descValues...)
 	if resp.Config.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
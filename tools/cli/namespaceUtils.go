@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 const (
@@ -266,6 +267,7 @@ func initializeNamespaceHandler(
 		initializeNamespaceReplicator(logger),
 		archivalMetadata,
 		archiverProvider,
+		searchattribute.NewSystemProvider(),
 	)
 }
 
@@ -37,6 +37,7 @@ import (
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 )
@@ -103,7 +104,7 @@ func CreateDefaultDBConfig(c *cli.Context) (config.DataStore, error) {
 			TLS:      tls,
 		}
 		defaultStore.Cassandra = defaultConfig
-	case mysql.PluginName, postgresql.PluginName:
+	case mysql.PluginName, postgresql.PluginName, sqlite.PluginName:
 		addr := fmt.Sprintf("%v:%v", c.String(FlagDBAddress), c.Int(FlagDBPort))
 		defaultConfig := &config.SQL{
 			User:         c.String(FlagUsername),
@@ -78,22 +78,98 @@ func DescribeBatchJob(c *cli.Context) {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
+			var hbd batcher.HeartBeatDetails
+			err := client.GetWorkflow(tcCtx, jobID, "").Get(tcCtx, &hbd)
+			if err != nil {
+				ErrorAndExit("Failed to get batch job result", err)
+			}
+			output["progress"] = newBatchProgressOutput(batcher.BatchProgress{HeartBeatDetails: hbd})
 		}
 	} else {
 		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
+		resp, err := client.QueryWorkflow(tcCtx, jobID, "", batcher.BatchProgressQueryName)
+		if err == nil {
+			var progress batcher.BatchProgress
+			if err := resp.Get(&progress); err != nil {
+				ErrorAndExit("Failed to describe batch job", err)
+			}
+			if progress.Paused {
+				output["msg"] = "batch job is paused"
+			}
+			output["progress"] = newBatchProgressOutput(progress)
+		} else if len(wf.PendingActivities) > 0 {
+			// batch jobs started by older servers report progress only through heartbeat
 			hbdPayload := wf.PendingActivities[0].HeartbeatDetails
 			var hbd batcher.HeartBeatDetails
 			err := payloads.Decode(hbdPayload, &hbd)
 			if err != nil {
 				ErrorAndExit("Failed to describe batch job", err)
 			}
-			output["progress"] = hbd
+			output["progress"] = newBatchProgressOutput(batcher.BatchProgress{HeartBeatDetails: hbd})
 		}
 	}
 	prettyPrintJSONObject(output)
 }
 
+// PauseBatchJob pauses a running batch job
+func PauseBatchJob(c *cli.Context) {
+	signalBatchJob(c, batcher.BatchPauseSignalName, nil)
+	output := map[string]interface{}{
+		"msg": "batch job is paused",
+	}
+	prettyPrintJSONObject(output)
+}
+
+// ResumeBatchJob resumes a paused batch job
+func ResumeBatchJob(c *cli.Context) {
+	signalBatchJob(c, batcher.BatchResumeSignalName, nil)
+	output := map[string]interface{}{
+		"msg": "batch job is resumed",
+	}
+	prettyPrintJSONObject(output)
+}
+
+// UpdateBatchJobRPS changes the RPS of a running batch job
+func UpdateBatchJobRPS(c *cli.Context) {
+	if !c.IsSet(FlagRPS) || c.Int(FlagRPS) <= 0 {
+		ErrorAndExit("Option "+FlagRPS+" is required to be a positive number", nil)
+	}
+	rps := c.Int(FlagRPS)
+	signalBatchJob(c, batcher.BatchUpdateRPSSignalName, rps)
+	output := map[string]interface{}{
+		"msg": fmt.Sprintf("batch job rps is updated to %v", rps),
+	}
+	prettyPrintJSONObject(output)
+}
+
+func signalBatchJob(c *cli.Context, signalName string, arg interface{}) {
+	jobID := getRequiredOption(c, FlagJobID)
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.SignalWorkflow(tcCtx, jobID, "", signalName, arg)
+	if err != nil {
+		ErrorAndExit("Failed to signal batch job", err)
+	}
+}
+
+func newBatchProgressOutput(progress batcher.BatchProgress) map[string]interface{} {
+	output := map[string]interface{}{
+		"totalEstimate": progress.TotalEstimate,
+		"currentPage":   progress.CurrentPage,
+		"successCount":  progress.SuccessCount,
+		"errorCount":    progress.ErrorCount,
+		"skipCount":     progress.SkipCount,
+	}
+	if len(progress.FailedWorkflowIDs) > 0 {
+		output["failedWorkflowIdsSample"] = progress.FailedWorkflowIDs
+	}
+	if progress.RPS > 0 {
+		output["rps"] = progress.RPS
+	}
+	return output
+}
+
 // ListBatchJobs list the started batch jobs
 func ListBatchJobs(c *cli.Context) {
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
@@ -157,6 +233,16 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetType, badBinaryChecksum string
+	if batchType == batcher.BatchTypeReset {
+		resetType = getRequiredOption(c, FlagResetType)
+		if !validateResetType(resetType) {
+			ErrorAndExit("resetType is not valid, supported:"+strings.Join(batcher.AllResetTypes, ","), nil)
+		}
+		if resetType == batcher.ResetTypeBadBinary {
+			badBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+	}
 	rps := c.Int(FlagRPS)
 
 	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
@@ -214,6 +300,10 @@ func StartBatchJob(c *cli.Context) {
 			SignalName: sigName,
 			Input:      sigInput,
 		},
+		ResetParams: batcher.ResetParams{
+			ResetType:         resetType,
+			BadBinaryChecksum: badBinaryChecksum,
+		},
 		RPS: rps,
 	}
 	wf, err := client.ExecuteWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
@@ -235,3 +325,12 @@ func validateBatchType(bt string) bool {
 	}
 	return false
 }
+
+func validateResetType(rt string) bool {
+	for _, r := range batcher.AllResetTypes {
+		if r == rt {
+			return true
+		}
+	}
+	return false
+}
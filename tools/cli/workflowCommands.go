@@ -58,11 +58,14 @@ import (
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	clispb "go.temporal.io/server/api/cli/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -874,13 +877,14 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 	ctx, cancel := newContext(c)
 	defer cancel()
 
+	var header metadata.MD
 	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
 		Namespace: namespace,
 		Execution: &commonpb.WorkflowExecution{
 			WorkflowId: wid,
 			RunId:      rid,
 		},
-	})
+	}, grpc.Header(&header))
 	if err != nil {
 		ErrorAndExit("Describe workflow execution failed", err)
 	}
@@ -893,7 +897,20 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 	if printRaw {
 		prettyPrintJSONObject(resp)
 	} else {
-		prettyPrintJSONObject(convertDescribeWorkflowExecutionResponse(resp))
+		describeResp := convertDescribeWorkflowExecutionResponse(resp)
+		setCronSchedule(describeResp.WorkflowExecutionInfo, header)
+		prettyPrintJSONObject(describeResp)
+	}
+}
+
+// setCronSchedule sets cron schedule and time zone it is evaluated in from headers
+// the server returns with DescribeWorkflowExecution response.
+func setCronSchedule(info *clispb.WorkflowExecutionInfo, header metadata.MD) {
+	if values := header.Get(headers.CronScheduleHeaderName); len(values) > 0 {
+		info.CronSchedule = values[0]
+	}
+	if values := header.Get(headers.CronTimeZoneHeaderName); len(values) > 0 {
+		info.CronTimeZone = values[0]
 	}
 }
 
@@ -34,6 +34,7 @@ import (
 
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/tools/common/schema"
 )
 
@@ -181,6 +182,14 @@ func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 
 // ValidateConnectConfig validates params
 func ValidateConnectConfig(cfg *config.SQL) error {
+	if cfg.PluginName == sqlite.PluginName {
+		// sqlite databases are files on the local host, the endpoint is not used
+		if cfg.DatabaseName == "" {
+			return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument")
+		}
+		return nil
+	}
+
 	host, _, err := net.SplitHostPort(cfg.ConnectAddr)
 	if err != nil {
 		return schema.NewConfigError("invalid host and port " + cfg.ConnectAddr)
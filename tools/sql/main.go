@@ -86,7 +86,7 @@ func BuildCLIOptions() *cli.App {
 		cli.StringFlag{
 			Name:   schema.CLIFlagDatabase,
 			Value:  "temporal",
-			Usage:  "name of the sql database",
+			Usage:  "name of the sql database, path of the database file for sqlite",
 			EnvVar: "SQL_DATABASE",
 		},
 		cli.StringFlag{